@@ -93,6 +93,14 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 	Formatters: applicationserver.FormattersConfig{
 		MaxParameterLength: 40960,
 	},
+	DeviceStates: applicationserver.DeviceStatesConfig{
+		Limit: 5,
+		TTL:   7 * 24 * time.Hour,
+	},
+	Replay: applicationserver.ReplayConfig{
+		Rate:        10,
+		MaxMessages: 10000,
+	},
 	DeviceLastSeen: applicationserver.LastSeenConfig{
 		BatchSize:     1000,
 		FlushInterval: 10 * time.Second,
@@ -29,11 +29,13 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 var (
@@ -172,6 +174,10 @@ var (
 		"network_server_disabled",
 		"Network Server is disabled",
 	)
+	errConflictingRelayAssignmentFlags = errors.DefineInvalidArgument(
+		"conflicting_relay_assignment_flags",
+		"approve and reject flags cannot be used together",
+	)
 	errEndDeviceClaimInfo = errors.DefineFailedPrecondition(
 		"end_device_claim_info",
 		"get end device claim info from DCS",
@@ -1443,6 +1449,268 @@ Devices not found in the Identity Server are skipped and no error is returned.`,
 			return io.Write(os.Stdout, config.OutputFormat, res)
 		},
 	}
+	endDevicesLinkStatsCommand = &cobra.Command{
+		Use:   "link-stats [application-id] [device-id]",
+		Short: "Get link statistics of an end device",
+		Long: `Get link statistics of an end device
+
+The Network Server computes the link statistics from the most recent data uplinks
+of the end device: packet error rate, SNR and RSSI percentiles, data rates, gateway
+diversity and retransmissions.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !config.NetworkServerEnabled {
+				return errNetworkServerDisabled.New()
+			}
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			if reset, _ := cmd.Flags().GetBool("reset"); reset {
+				_, err := ttnpb.NewNsClient(ns).ResetEndDeviceLinkStats(ctx, devID)
+				return err
+			}
+			res, err := ttnpb.NewNsClient(ns).GetEndDeviceLinkStats(ctx, devID)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesSimulateMACCommand = &cobra.Command{
+		Use:   "simulate-mac [application-id] [device-id]",
+		Short: "Simulate the MAC layer of an end device",
+		Long: `Simulate the MAC layer of an end device
+
+The Network Server runs a sequence of synthetic uplinks through its MAC command
+handlers and ADR, starting from the stored MAC state of the end device, or from
+the end device template in the input. The output contains the MAC state and the
+MAC commands the Network Server would send after each uplink. The simulation does
+not change the end device.
+
+The input is read from stdin or from the input file:
+
+  {
+    "end_device": {...},
+    "uplinks": [
+      {"snr": 7.5, "rssi": -90, "gateway_count": 2},
+      {"snr": 8, "rssi": -88, "mac_commands": [{"cid": "CID_LINK_ADR", "link_adr_ans": {...}}]}
+    ]
+  }`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !config.NetworkServerEnabled {
+				return errNetworkServerDisabled.New()
+			}
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.SimulateMACRequest{}
+			if inputDecoder != nil {
+				if err := inputDecoder.Decode(req); err != nil {
+					return err
+				}
+			} else {
+				r, err := getDataReader("input", cmd.Flags())
+				if err != nil {
+					return err
+				}
+				if err := jsonpb.TTN().NewDecoder(r).Decode(req); err != nil {
+					return err
+				}
+			}
+			req.EndDeviceIds = devID
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsClient(ns).SimulateMAC(ctx, req)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesRelayAssignmentCommand = &cobra.Command{
+		Use:   "relay-assignment [application-id] [device-id]",
+		Short: "Get the relay assignment state of an end device",
+		Long: `Get the relay assignment state of an end device
+
+The Network Server assigns end devices with relayed or poor uplinks to relays,
+and releases them when direct coverage improves. In approval mode, the assignment
+or release is suggested first, and must be approved or rejected.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !config.NetworkServerEnabled {
+				return errNetworkServerDisabled.New()
+			}
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			approve, _ := cmd.Flags().GetBool("approve")
+			reject, _ := cmd.Flags().GetBool("reject")
+			if approve && reject {
+				return errConflictingRelayAssignmentFlags.New()
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			var res *ttnpb.EndDeviceRelayAssignment
+			switch {
+			case reject:
+				_, err := ttnpb.NewNsClient(ns).RejectEndDeviceRelayAssignment(ctx, devID)
+				return err
+			case approve:
+				res, err = ttnpb.NewNsClient(ns).ApproveEndDeviceRelayAssignment(ctx, devID)
+			default:
+				res, err = ttnpb.NewNsClient(ns).GetEndDeviceRelayAssignment(ctx, devID)
+			}
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesFCntResyncCommand = &cobra.Command{
+		Use:   "f-cnt-resync [application-id] [device-id]",
+		Short: "Get the frame counter resynchronization state of an end device",
+		Long: `Get the frame counter resynchronization state of an end device
+
+The Network Server detects when the frame counter of an ABP or LoRaWAN 1.0.x
+end device is desynchronized, i.e. when consecutive uplinks with a valid MIC
+are rejected because their frame counter was reset or exceeds the maximum gap.
+A desynchronized frame counter can be resynchronized manually, or automatically
+when the automatic resynchronization policy is enabled in the MAC settings of
+the end device with end-devices set --mac-settings.auto-f-cnt-resync.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !config.NetworkServerEnabled {
+				return errNetworkServerDisabled.New()
+			}
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			var res *ttnpb.EndDeviceFCntResync
+			if resync, _ := cmd.Flags().GetBool("resync"); resync {
+				res, err = ttnpb.NewNsClient(ns).ResyncEndDeviceFCnt(ctx, devID)
+			} else {
+				res, err = ttnpb.NewNsClient(ns).GetEndDeviceFCntResync(ctx, devID)
+			}
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesRejoinCommand = &cobra.Command{
+		Use:   "rejoin [application-id] [device-id]",
+		Short: "Get the rejoin state of an end device",
+		Long: `Get the rejoin state of an end device
+
+The Network Server can force a LoRaWAN 1.1 end device to transmit a type 0 or
+type 2 rejoin-request with a ForceRejoinReq, which is sent in the downlinks to
+the end device until it rejoins. A periodic rejoin policy issues a
+ForceRejoinReq whenever the policy interval elapsed since the last rejoin.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !config.NetworkServerEnabled {
+				return errNetworkServerDisabled.New()
+			}
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			client := ttnpb.NewNsClient(ns)
+			force, _ := cmd.Flags().GetBool("force")
+			cancelForce, _ := cmd.Flags().GetBool("cancel-force")
+			deletePolicy, _ := cmd.Flags().GetBool("delete-policy")
+			var res *ttnpb.EndDeviceRejoin
+			switch {
+			case cmd.Flags().Changed("policy-interval"):
+				req, err := forceRejoinReq(cmd.Flags())
+				if err != nil {
+					return err
+				}
+				interval, _ := cmd.Flags().GetDuration("policy-interval")
+				res, err = client.SetEndDeviceRejoinPolicy(ctx, &ttnpb.SetEndDeviceRejoinPolicyRequest{
+					EndDeviceIds:   devID,
+					ForceRejoinReq: req,
+					Interval:       durationpb.New(interval),
+				})
+				if err != nil {
+					return err
+				}
+			case deletePolicy:
+				res, err = client.DeleteEndDeviceRejoinPolicy(ctx, devID)
+				if err != nil {
+					return err
+				}
+			case force:
+				req, err := forceRejoinReq(cmd.Flags())
+				if err != nil {
+					return err
+				}
+				res, err = client.ForceEndDeviceRejoin(ctx, &ttnpb.ForceEndDeviceRejoinRequest{
+					EndDeviceIds:   devID,
+					ForceRejoinReq: req,
+				})
+				if err != nil {
+					return err
+				}
+			case cancelForce:
+				res, err = client.CancelEndDeviceForceRejoin(ctx, devID)
+				if err != nil {
+					return err
+				}
+			default:
+				res, err = client.GetEndDeviceRejoin(ctx, devID)
+				if err != nil {
+					return err
+				}
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	endDevicesForceRejoinCommand = &cobra.Command{
+		Use:   "force-rejoin [application-id] [device-id...]",
+		Short: "Force end devices of an application to rejoin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !config.NetworkServerEnabled {
+				return errNetworkServerDisabled.New()
+			}
+			if len(args) < 2 {
+				return errNoEndDeviceID.New()
+			}
+			req, err := forceRejoinReq(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsClient(ns).ForceEndDevicesRejoin(ctx, &ttnpb.ForceEndDevicesRejoinRequest{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: args[0]},
+				DeviceIds:      args[1:],
+				ForceRejoinReq: req,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 	endDevicesGetNetIDCommand = &cobra.Command{
 		Use:               "get-net-id",
 		Short:             "Get Network Server configured Net ID",
@@ -1483,6 +1751,26 @@ Devices not found in the Identity Server are skipped and no error is returned.`,
 			return io.Write(os.Stdout, config.OutputFormat, res)
 		},
 	}
+	endDevicesGetDevAddrPrefixUtilizationCommand = &cobra.Command{
+		Use:               "get-dev-addr-prefix-utilization",
+		Short:             "Get Network Server device address prefix utilization (admin only)",
+		PersistentPreRunE: preRun(),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if !config.NetworkServerEnabled {
+				return errNetworkServerDisabled.New()
+			}
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsClient(ns).GetDevAddrPrefixUtilization(ctx, ttnpb.Empty)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 	endDevicesListBandsCommand = &cobra.Command{
 		Use:               "list-bands",
 		Short:             "List available band definitions",
@@ -1652,12 +1940,39 @@ func init() {
 	endDevicesGetDefaultMACSettingsCommand.Flags().AddFlagSet(getDefaultMACSettingsFlags)
 	endDevicesCommand.AddCommand(endDevicesGetDefaultMACSettingsCommand)
 
+	endDevicesLinkStatsCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesLinkStatsCommand.Flags().Bool("reset", false, "reset the link statistics")
+	endDevicesCommand.AddCommand(endDevicesLinkStatsCommand)
+
+	endDevicesSimulateMACCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesSimulateMACCommand.Flags().AddFlagSet(dataFlags("input", "simulation input"))
+	endDevicesCommand.AddCommand(endDevicesSimulateMACCommand)
+
+	endDevicesRelayAssignmentCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesRelayAssignmentCommand.Flags().Bool("approve", false, "approve the suggested assignment or release")
+	endDevicesRelayAssignmentCommand.Flags().Bool("reject", false, "reject the suggested assignment or release")
+	endDevicesCommand.AddCommand(endDevicesRelayAssignmentCommand)
+	endDevicesFCntResyncCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesFCntResyncCommand.Flags().Bool("resync", false, "resynchronize the desynchronized frame counter")
+	endDevicesCommand.AddCommand(endDevicesFCntResyncCommand)
+	endDevicesRejoinCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesRejoinCommand.Flags().AddFlagSet(forceRejoinFlags())
+	endDevicesRejoinCommand.Flags().Bool("force", false, "force the end device to rejoin")
+	endDevicesRejoinCommand.Flags().Bool("cancel-force", false, "cancel the pending force rejoin request")
+	endDevicesRejoinCommand.Flags().Duration("policy-interval", 0, "set the periodic rejoin policy with the given interval")
+	endDevicesRejoinCommand.Flags().Bool("delete-policy", false, "delete the periodic rejoin policy")
+	endDevicesCommand.AddCommand(endDevicesRejoinCommand)
+	endDevicesForceRejoinCommand.Flags().AddFlagSet(forceRejoinFlags())
+	endDevicesCommand.AddCommand(endDevicesForceRejoinCommand)
+
 	endDevicesGetNetIDCommand.Flags().AddFlagSet(getNetIDFlags)
 	endDevicesCommand.AddCommand(endDevicesGetNetIDCommand)
 
 	endDevicesGetDevAddrPrefixesCommand.Flags().AddFlagSet(getDevAddrPrefixesFlags)
 	endDevicesCommand.AddCommand(endDevicesGetDevAddrPrefixesCommand)
 
+	endDevicesCommand.AddCommand(endDevicesGetDevAddrPrefixUtilizationCommand)
+
 	endDevicesListBandsCommand.Flags().AddFlagSet(listBandsFlags)
 	endDevicesCommand.AddCommand(endDevicesListBandsCommand)
 
@@ -1680,6 +1995,33 @@ func init() {
 	endDeviceTemplatesExecuteCommand.Flags().AddFlagSet(setEndDeviceFlags)
 }
 
+func forceRejoinFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("rejoin-type", ttnpb.RejoinRequestType_CONTEXT.String(), "type of the rejoin-request (CONTEXT, KEYS)")
+	flagSet.String("data-rate-index", "0", "data rate index of the rejoin-request")
+	flagSet.Uint32("max-retries", 0, "number of retransmissions of the rejoin-request")
+	flagSet.String("period-exponent", ttnpb.RejoinPeriodExponent_REJOIN_PERIOD_0.String(), "period exponent of the retransmissions of the rejoin-request")
+	return flagSet
+}
+
+func forceRejoinReq(flagSet *pflag.FlagSet) (*ttnpb.MACCommand_ForceRejoinReq, error) {
+	params := &ttnpb.MACCommand_ForceRejoinReq{}
+	rejoinType, _ := flagSet.GetString("rejoin-type")
+	if err := params.RejoinType.UnmarshalText([]byte(rejoinType)); err != nil {
+		return nil, err
+	}
+	dataRateIndex, _ := flagSet.GetString("data-rate-index")
+	if err := params.DataRateIndex.UnmarshalText([]byte(dataRateIndex)); err != nil {
+		return nil, err
+	}
+	params.MaxRetries, _ = flagSet.GetUint32("max-retries")
+	periodExponent, _ := flagSet.GetString("period-exponent")
+	if err := params.PeriodExponent.UnmarshalText([]byte(periodExponent)); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
 var errAddressMismatchEndDevice = errors.DefineAborted("end_device_server_address_mismatch", "Network/Application/Join Server address mismatch")
 
 func compareServerAddressesEndDevice(device *ttnpb.EndDevice, config *Config) (nsMismatch, asMismatch, jsMismatch bool) {
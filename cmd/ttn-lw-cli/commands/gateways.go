@@ -618,6 +618,33 @@ If both the parameter and the flag are provided, the flag is ignored.`,
 			return nil
 		},
 	}
+	gatewaysClassBCapacityCommand = &cobra.Command{
+		Use:   "class-b-capacity [gateway-id]",
+		Short: "Get the class B capacity of a gateway",
+		Long: `Get the class B capacity of a gateway
+
+The Network Server tracks the class B end devices of which the gateway is the
+primary gateway, and the occupancy of the ping slots of the gateway in the
+previous and the current beacon period.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !config.NetworkServerEnabled {
+				return errNetworkServerDisabled.New()
+			}
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewNsClient(ns).GetGatewayClassBCapacity(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 )
 
 func init() {
@@ -674,6 +701,8 @@ func init() {
 	gatewaysPurgeCommand.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysPurgeCommand.Flags().AddFlagSet(forceFlags())
 	gatewaysCommand.AddCommand(gatewaysPurgeCommand)
+	gatewaysClassBCapacityCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysClassBCapacityCommand)
 	gatewaysCommand.AddCommand(gatewaysBatchDeleteCommand)
 	Root.AddCommand(gatewaysCommand)
 }
@@ -15,14 +15,18 @@
 package commands
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/v3/pkg/cleanup"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
 	nsredis "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
 	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
 
@@ -211,6 +215,184 @@ var (
 			return nil
 		},
 	}
+	nsDBDevAddrIndexCommand = &cobra.Command{
+		Use:   "dev-addr-index",
+		Short: "Rebuild the DevAddr occupancy index of the Network Server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.Redis.IsZero() {
+				panic("Only Redis is supported by this command")
+			}
+
+			logger.Info("Connecting to Redis database...")
+			devices := &nsredis.DeviceRegistry{
+				Redis:   NewNetworkServerDeviceRegistryRedis(config),
+				LockTTL: defaultLockTTL,
+			}
+			if err := devices.Init(ctx); err != nil {
+				return err
+			}
+			defer devices.Redis.Close()
+			allocator := NewNetworkServerDevAddrAllocator(config)
+			defer allocator.Redis.Close()
+
+			var indexed uint64
+			var rangeErr error
+			err := devices.Range(ctx, []string{
+				"pending_session.dev_addr",
+				"session.dev_addr",
+			}, func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice) bool {
+				var devAddrs []types.DevAddr
+				for _, b := range [][]byte{
+					dev.GetSession().GetDevAddr(),
+					dev.GetPendingSession().GetDevAddr(),
+				} {
+					if b != nil {
+						devAddrs = append(devAddrs, types.MustDevAddr(b).OrZero())
+					}
+				}
+				if err := allocator.Reconcile(ctx, unique.ID(ctx, ids), devAddrs...); err != nil {
+					rangeErr = err
+					return false
+				}
+				indexed++
+				return true
+			})
+			if err != nil {
+				return err
+			}
+			if rangeErr != nil {
+				return rangeErr
+			}
+			logger.WithField("device_count", indexed).Info("Rebuilt DevAddr occupancy index")
+			return nil
+		},
+	}
+	nsDBExportCommand = &cobra.Command{
+		Use:   "export",
+		Short: "Export Network Server end devices with their sessions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.Redis.IsZero() {
+				panic("Only Redis is supported by this command")
+			}
+
+			kekLabel, err := cmd.Flags().GetString("kek-label")
+			if err != nil {
+				return err
+			}
+			var appIDs *ttnpb.ApplicationIdentifiers
+			if appID, _ := cmd.Flags().GetString("application-id"); appID != "" {
+				appIDs = &ttnpb.ApplicationIdentifiers{ApplicationId: appID}
+				if err := appIDs.ValidateFields(); err != nil {
+					return err
+				}
+			}
+			ks, err := config.KeyVault.KeyService(ctx, nil)
+			if err != nil {
+				return err
+			}
+			w := os.Stdout
+			if output, _ := cmd.Flags().GetString("output"); output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+
+			logger.Info("Connecting to Redis database...")
+			devices := &nsredis.DeviceRegistry{
+				Redis:   NewNetworkServerDeviceRegistryRedis(config),
+				LockTTL: defaultLockTTL,
+			}
+			if err := devices.Init(ctx); err != nil {
+				return err
+			}
+			defer devices.Redis.Close()
+
+			var exported uint64
+			if err := networkserver.ExportEndDevices(ctx, devices, ks, appIDs, kekLabel,
+				func(dev *ttnpb.EndDevice) error {
+					exported++
+					return networkserver.WriteEndDevices(w, dev)
+				},
+			); err != nil {
+				return err
+			}
+			logger.WithField("device_count", exported).Info("Exported end devices")
+			return nil
+		},
+	}
+	nsDBImportCommand = &cobra.Command{
+		Use:   "import",
+		Short: "Import Network Server end devices with their sessions",
+		Long: `Import Network Server end devices with their sessions.
+
+The end devices are read from an export of the export command. Either all end
+devices are imported, or none are. The network session keys are wrapped with
+the device KEK of the Network Server configuration.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if config.Redis.IsZero() {
+				panic("Only Redis is supported by this command")
+			}
+
+			replace, err := cmd.Flags().GetBool("replace")
+			if err != nil {
+				return err
+			}
+			ks, err := config.KeyVault.KeyService(ctx, nil)
+			if err != nil {
+				return err
+			}
+			r := os.Stdin
+			if input, _ := cmd.Flags().GetString("input"); input != "" {
+				f, err := os.Open(input)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+			devs, err := networkserver.ReadEndDevices(r)
+			if err != nil {
+				return err
+			}
+
+			logger.Info("Connecting to Redis database...")
+			devices := &nsredis.DeviceRegistry{
+				Redis:   NewNetworkServerDeviceRegistryRedis(config),
+				LockTTL: defaultLockTTL,
+			}
+			if err := devices.Init(ctx); err != nil {
+				return err
+			}
+			defer devices.Redis.Close()
+			allocator := NewNetworkServerDevAddrAllocator(config)
+			defer allocator.Redis.Close()
+
+			if err := networkserver.ImportEndDevices(
+				ctx, devices, ks, config.NS.DeviceKEKLabel, replace, devs...,
+			); err != nil {
+				return err
+			}
+			for _, dev := range devs {
+				var devAddrs []types.DevAddr
+				for _, b := range [][]byte{
+					dev.GetSession().GetDevAddr(),
+					dev.GetPendingSession().GetDevAddr(),
+				} {
+					if b != nil {
+						devAddrs = append(devAddrs, types.MustDevAddr(b).OrZero())
+					}
+				}
+				if err := allocator.Reconcile(ctx, unique.ID(ctx, dev.Ids), devAddrs...); err != nil {
+					return err
+				}
+			}
+			logger.WithField("device_count", len(devs)).Info("Imported end devices")
+			return nil
+		},
+	}
 	nsDBPurgeCommand = &cobra.Command{
 		Use:   "purge",
 		Short: "Purge Network Server application data",
@@ -270,4 +452,12 @@ func init() {
 	nsDBCleanupCommand.Flags().Duration("pagination-delay", 100, "Delay between batch requests")
 	nsDBCommand.AddCommand(nsDBCleanupCommand)
 	nsDBCommand.AddCommand(nsDBPurgeCommand)
+	nsDBCommand.AddCommand(nsDBDevAddrIndexCommand)
+	nsDBExportCommand.Flags().String("application-id", "", "Export the end devices of this application only")
+	nsDBExportCommand.Flags().String("kek-label", "", "Label of the KEK to wrap the session keys with")
+	nsDBExportCommand.Flags().String("output", "", "Output file (default stdout)")
+	nsDBCommand.AddCommand(nsDBExportCommand)
+	nsDBImportCommand.Flags().String("input", "", "Input file (default stdin)")
+	nsDBImportCommand.Flags().Bool("replace", false, "Replace existing end devices")
+	nsDBCommand.AddCommand(nsDBImportCommand)
 }
@@ -15,6 +15,7 @@
 package commands
 
 import (
+	"database/sql"
 	"math"
 	"net/http"
 	"strings"
@@ -23,12 +24,16 @@ import (
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	asdevicestateredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/devicestate/redis"
 	asdistribredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution/redis"
+	asgeofenceredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/geofence/redis"
 	asioapredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/redis"
 	asiopsredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/redis"
 	asiowebredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
 	asmetaredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/metadata/redis"
+	aspostgres "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/postgres"
 	asredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
+	asshadowredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/shadow/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/console"
 	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
@@ -42,8 +47,11 @@ import (
 	gsredis "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver"
+	jspostgres "go.thethings.network/lorawan-stack/v3/pkg/joinserver/postgres"
 	jsredis "go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	nsmemory "go.thethings.network/lorawan-stack/v3/pkg/networkserver/memory"
+	nspostgres "go.thethings.network/lorawan-stack/v3/pkg/networkserver/postgres"
 	nsredis "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbrokeragent"
 	"go.thethings.network/lorawan-stack/v3/pkg/qrcodegenerator"
@@ -51,6 +59,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/redis"
 	telemetry "go.thethings.network/lorawan-stack/v3/pkg/telemetry/exporter"
 	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/web"
 )
 
@@ -79,6 +88,12 @@ func NewNetworkServerDownlinkTaskRedis(conf *Config) *redis.Client {
 	return redis.New(conf.Redis.WithNamespace("ns", "tasks"))
 }
 
+// NewNetworkServerDevAddrAllocator instantiates a new Network Server DevAddr allocator
+// with the Network Server DevAddr namespace.
+func NewNetworkServerDevAddrAllocator(conf *Config) *nsredis.DevAddrAllocator {
+	return nsredis.NewDevAddrAllocator(redis.New(conf.Redis.WithNamespace("ns", "dev-addrs")))
+}
+
 // NewNetworkServerMACSettingsProfileRegistryRedis instantiates a new redis client
 // with the Network Server MAC Settings Profile Registry namespace.
 func NewNetworkServerMACSettingsProfileRegistryRedis(conf *Config) *redis.Client {
@@ -151,7 +166,12 @@ func NewRateLimitingRedis(conf *Config) *redis.Client {
 	return redis.New(conf.Cache.Redis.WithNamespace("rate-limiting"))
 }
 
-var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
+var (
+	errUnknownComponent      = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
+	errDeviceRegistryBackend = errors.DefineInvalidArgument(
+		"device_registry_backend", "invalid device registry backend `{value}`",
+	)
+)
 
 var startCommand = &cobra.Command{
 	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|dcs|all]... [flags]",
@@ -244,6 +264,22 @@ var startCommand = &cobra.Command{
 			}
 		}()
 
+		var deviceRegistryDB *sql.DB
+		switch config.DeviceRegistry.Backend {
+		case RedisDeviceRegistryBackend:
+		case PostgreSQLDeviceRegistryBackend:
+			if start.NetworkServer || start.ApplicationServer || start.JoinServer {
+				logger.Info("Connecting to device registry database")
+				deviceRegistryDB, err = storeutil.OpenDB(ctx, config.DeviceRegistry.DatabaseURI)
+				if err != nil {
+					return err
+				}
+				defer deviceRegistryDB.Close()
+			}
+		default:
+			return errDeviceRegistryBackend.WithAttributes("value", config.DeviceRegistry.Backend)
+		}
+
 		logger.Info("Setting up core component")
 
 		var rootRedirect web.Registerer
@@ -346,41 +382,64 @@ var startCommand = &cobra.Command{
 			if config.NS.ApplicationUplinkQueue.BufferSize > math.MaxInt64 {
 				applicationUplinkQueueSize = math.MaxInt64
 			}
-			applicationUplinkQueue := nsredis.NewApplicationUplinkQueue(
-				NewNetworkServerApplicationUplinkQueueRedis(config),
-				int64(applicationUplinkQueueSize),
-				redisConsumerGroup,
-				time.Minute,
-			)
-			if err := applicationUplinkQueue.Init(ctx); err != nil {
-				return shared.ErrInitializeNetworkServer.WithCause(err)
-			}
-			defer applicationUplinkQueue.Close(ctx)
-			config.NS.ApplicationUplinkQueue.Queue = applicationUplinkQueue
-			devices := &nsredis.DeviceRegistry{
-				Redis:   NewNetworkServerDeviceRegistryRedis(config),
-				LockTTL: defaultLockTTL,
-			}
-			if err := devices.Init(ctx); err != nil {
-				return shared.ErrInitializeNetworkServer.WithCause(err)
-			}
-			config.NS.Devices = devices
-			config.NS.UplinkDeduplicator = &nsredis.UplinkDeduplicator{
-				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "uplink-deduplication")),
-			}
-			downlinkTasks := nsredis.NewDownlinkTaskQueue(
-				NewNetworkServerDownlinkTaskRedis(config),
-				100000,
-				redisConsumerGroup,
-				redis.DefaultStreamBlockLimit,
-			)
-			if err := downlinkTasks.Init(ctx); err != nil {
-				return shared.ErrInitializeNetworkServer.WithCause(err)
-			}
-			defer downlinkTasks.Close(ctx)
-			config.NS.DownlinkTaskQueue.Queue = downlinkTasks
-			config.NS.ScheduledDownlinkMatcher = &nsredis.ScheduledDownlinkMatcher{
-				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "scheduled-downlinks")),
+			if deviceRegistryDB != nil {
+				devices := &nspostgres.DeviceRegistry{
+					DB: deviceRegistryDB,
+				}
+				if err := devices.Init(ctx); err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				config.NS.Devices = devices
+			} else {
+				devices := &nsredis.DeviceRegistry{
+					Redis:   NewNetworkServerDeviceRegistryRedis(config),
+					LockTTL: defaultLockTTL,
+				}
+				if err := devices.Init(ctx); err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				config.NS.Devices = devices
+			}
+			switch config.NS.QueueBackend {
+			case networkserver.MemoryQueueBackend:
+				logger.Warn("Using in-memory Network Server queues, which are lost on restart and not shared between instances")
+				maxLen := int(applicationUplinkQueueSize)
+				if uint64(maxLen) != applicationUplinkQueueSize {
+					maxLen = math.MaxInt
+				}
+				config.NS.ApplicationUplinkQueue.Queue = nsmemory.NewApplicationUplinkQueue(maxLen, time.Minute)
+				config.NS.UplinkDeduplicator = nsmemory.NewUplinkDeduplicator()
+				config.NS.DownlinkTaskQueue.Queue = nsmemory.NewDownlinkTaskQueue()
+				config.NS.ScheduledDownlinkMatcher = nsmemory.NewScheduledDownlinkMatcher()
+			default:
+				applicationUplinkQueue := nsredis.NewApplicationUplinkQueue(
+					NewNetworkServerApplicationUplinkQueueRedis(config),
+					int64(applicationUplinkQueueSize),
+					redisConsumerGroup,
+					time.Minute,
+				)
+				if err := applicationUplinkQueue.Init(ctx); err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				defer applicationUplinkQueue.Close(ctx)
+				config.NS.ApplicationUplinkQueue.Queue = applicationUplinkQueue
+				config.NS.UplinkDeduplicator = &nsredis.UplinkDeduplicator{
+					Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "uplink-deduplication")),
+				}
+				downlinkTasks := nsredis.NewDownlinkTaskQueue(
+					NewNetworkServerDownlinkTaskRedis(config),
+					100000,
+					redisConsumerGroup,
+					redis.DefaultStreamBlockLimit,
+				)
+				if err := downlinkTasks.Init(ctx); err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				defer downlinkTasks.Close(ctx)
+				config.NS.DownlinkTaskQueue.Queue = downlinkTasks
+				config.NS.ScheduledDownlinkMatcher = &nsredis.ScheduledDownlinkMatcher{
+					Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "scheduled-downlinks")),
+				}
 			}
 			macSettingsProfiles := &nsredis.MACSettingsProfileRegistry{
 				Redis:   NewNetworkServerMACSettingsProfileRegistryRedis(config),
@@ -390,6 +449,31 @@ var startCommand = &cobra.Command{
 				return shared.ErrInitializeNetworkServer.WithCause(err)
 			}
 			config.NS.MACSettingsProfileRegistry = macSettingsProfiles
+			config.NS.Budgets.Store = nsredis.NewBudgetStore(
+				redis.New(config.Redis.WithNamespace("ns", "budgets")),
+			)
+			config.NS.DevAddrAllocation.Allocator = NewNetworkServerDevAddrAllocator(config)
+			config.NS.HandoverRoaming.Registry = nsredis.NewHandoverRegistry(
+				redis.New(config.Redis.WithNamespace("ns", "handover")),
+			)
+			config.NS.MulticastGatewaySelection.Members = nsredis.NewMulticastMemberRegistry(
+				redis.New(config.Redis.WithNamespace("ns", "multicast-members")),
+			)
+			config.NS.LinkStats.Registry = nsredis.NewLinkStatsRegistry(
+				redis.New(config.Redis.WithNamespace("ns", "link-stats")),
+			)
+			config.NS.RelayAssignment.Registry = nsredis.NewRelayAssignmentRegistry(
+				redis.New(config.Redis.WithNamespace("ns", "relay-assignment")),
+			)
+			config.NS.ClassBCapacity.Registry = nsredis.NewPingSlotOccupancyRegistry(
+				redis.New(config.Redis.WithNamespace("ns", "ping-slot-occupancy")),
+			)
+			config.NS.FCntResync.Registry = nsredis.NewFCntResyncRegistry(
+				redis.New(config.Redis.WithNamespace("ns", "f-cnt-resync")),
+			)
+			config.NS.Rejoin.Registry = nsredis.NewRejoinRegistry(
+				redis.New(config.Redis.WithNamespace("ns", "rejoin")),
+			)
 			ns, err := networkserver.New(c, &config.NS)
 			if err != nil {
 				return shared.ErrInitializeNetworkServer.WithCause(err)
@@ -407,14 +491,24 @@ var startCommand = &cobra.Command{
 				return shared.ErrInitializeApplicationServer.WithCause(err)
 			}
 			config.AS.Links = linkRegistry
-			deviceRegistry := &asredis.DeviceRegistry{
-				Redis:   NewApplicationServerDeviceRegistryRedis(config),
-				LockTTL: defaultLockTTL,
-			}
-			if err := deviceRegistry.Init(ctx); err != nil {
-				return shared.ErrInitializeApplicationServer.WithCause(err)
+			if deviceRegistryDB != nil {
+				deviceRegistry := &aspostgres.DeviceRegistry{
+					DB: deviceRegistryDB,
+				}
+				if err := deviceRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.Devices = deviceRegistry
+			} else {
+				deviceRegistry := &asredis.DeviceRegistry{
+					Redis:   NewApplicationServerDeviceRegistryRedis(config),
+					LockTTL: defaultLockTTL,
+				}
+				if err := deviceRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.Devices = deviceRegistry
 			}
-			config.AS.Devices = deviceRegistry
 			config.AS.Distribution.Global.PubSub = &asdistribredis.PubSub{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("as", "traffic")),
 			}
@@ -455,6 +549,36 @@ var startCommand = &cobra.Command{
 					cache.Enable = false
 				}
 			}
+			geofenceRegistry := &asgeofenceredis.GeofenceRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("as", "geofences")),
+				LockTTL: defaultLockTTL,
+			}
+			if err := geofenceRegistry.Init(ctx); err != nil {
+				return shared.ErrInitializeApplicationServer.WithCause(err)
+			}
+			config.AS.Geofences.Registry = geofenceRegistry
+			geofenceStates := &asgeofenceredis.DeviceStateRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("as", "geofences", "states")),
+				LockTTL: defaultLockTTL,
+			}
+			if err := geofenceStates.Init(ctx); err != nil {
+				return shared.ErrInitializeApplicationServer.WithCause(err)
+			}
+			config.AS.Geofences.States = geofenceStates
+			shadowRegistry := &asshadowredis.ShadowRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("as", "shadows")),
+				LockTTL: defaultLockTTL,
+			}
+			if err := shadowRegistry.Init(ctx); err != nil {
+				return shared.ErrInitializeApplicationServer.WithCause(err)
+			}
+			config.AS.Shadows.Registry = shadowRegistry
+			config.AS.Shadows.Settings = shadowRegistry
+			config.AS.DeviceStates.Registry = &asdevicestateredis.DeviceStateRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("as", "devicestates")),
+				Limit: config.AS.DeviceStates.Limit,
+				TTL:   config.AS.DeviceStates.TTL,
+			}
 			locationRegistry, err := config.AS.EndDeviceMetadataStorage.Location.NewRegistry(ctx, c)
 			if err != nil {
 				return shared.ErrInitializeApplicationServer.WithCause(err)
@@ -469,14 +593,24 @@ var startCommand = &cobra.Command{
 
 		if start.JoinServer {
 			logger.Info("Setting up Join Server")
-			deviceRegistry := &jsredis.DeviceRegistry{
-				Redis:   NewJoinServerDeviceRegistryRedis(config),
-				LockTTL: defaultLockTTL,
-			}
-			if err := deviceRegistry.Init(ctx); err != nil {
-				return shared.ErrInitializeJoinServer.WithCause(err)
+			if deviceRegistryDB != nil {
+				deviceRegistry := &jspostgres.DeviceRegistry{
+					DB: deviceRegistryDB,
+				}
+				if err := deviceRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.Devices = deviceRegistry
+			} else {
+				deviceRegistry := &jsredis.DeviceRegistry{
+					Redis:   NewJoinServerDeviceRegistryRedis(config),
+					LockTTL: defaultLockTTL,
+				}
+				if err := deviceRegistry.Init(ctx); err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.Devices = deviceRegistry
 			}
-			config.JS.Devices = deviceRegistry
 			keyRegistry := &jsredis.KeyRegistry{
 				Redis:   NewJoinServerSessionKeyRegistryRedis(config),
 				LockTTL: defaultLockTTL,
@@ -24,7 +24,9 @@ import (
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/devicestate"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/geofence"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	iogrpc "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
@@ -35,6 +37,8 @@ import (
 	ioweb "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/lastseen"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/metadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/replay"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/shadow"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
@@ -82,6 +86,10 @@ type ApplicationServer struct {
 	linkRegistry           LinkRegistry
 	deviceRegistry         DeviceRegistry
 	locationRegistry       metadata.EndDeviceLocationRegistry
+	geofences              *geofence.Evaluator
+	shadows                *shadow.Manager
+	deviceStates           devicestate.Registry
+	replayer               *replay.Replayer
 	formatters             messageprocessors.MapPayloadProcessor
 	webhooks               ioweb.Webhooks
 	webhookTemplates       ioweb.TemplateStore
@@ -182,6 +190,16 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		interopID:     conf.Interop.ID,
 	}
 
+	if conf.Geofences.Registry != nil && conf.Geofences.States != nil {
+		as.geofences = geofence.NewEvaluator(conf.Geofences.Registry, conf.Geofences.States)
+	}
+	if conf.Shadows.Registry != nil && conf.Shadows.Settings != nil {
+		as.shadows = shadow.NewManager(as, conf.Shadows.Registry, conf.Shadows.Settings)
+	}
+	if conf.DeviceStates.Limit > 0 {
+		as.deviceStates = conf.DeviceStates.Registry
+	}
+
 	as.formatters[ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT] = javascript.New()
 	as.formatters[ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP] = cayennelpp.New()
 	as.formatters[ttnpb.PayloadFormatter_FORMATTER_REPOSITORY] = devicerepository.New(as.formatters, as)
@@ -209,6 +227,14 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		AS:       as,
 		kekLabel: conf.DeviceKEKLabel,
 	}
+
+	if sources := as.replaySources(); conf.Replay.Rate > 0 && len(sources) > 0 {
+		as.replayer = replay.NewReplayer(replayServer{as}, sources, as.validateReplayTarget, replay.Config{
+			Rate:        conf.Replay.Rate,
+			MaxMessages: conf.Replay.MaxMessages,
+		})
+	}
+
 	as.grpc.appAs = iogrpc.New(as,
 		iogrpc.WithMQTTConfigProvider(as),
 		iogrpc.WithGetEndDeviceIdentifiers(func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceIdentifiers, error) {
@@ -219,6 +245,8 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 			return dev.Ids, nil
 		}),
 		iogrpc.WithPayloadProcessor(as.formatters),
+		iogrpc.WithDeviceStateRegistry(as.deviceStates),
+		iogrpc.WithReplayer(as.replayer),
 		iogrpc.WithSkipPayloadCrypto(func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (bool, error) {
 			link, err := as.getLink(ctx, ids.ApplicationIds, []string{"skip_payload_crypto"})
 			if err != nil {
@@ -377,6 +405,12 @@ func (as *ApplicationServer) RegisterRoutes(s *web.Server) {
 	if pkgs := as.appPackages; pkgs != nil {
 		pkgs.RegisterRoutes(s)
 	}
+	if as.geofences != nil {
+		geofence.NewRoutes(as.config.Geofences.Registry, as.RateLimiter()).RegisterRoutes(s)
+	}
+	if as.shadows != nil {
+		shadow.NewRoutes(as.shadows, as.RateLimiter()).RegisterRoutes(s)
+	}
 }
 
 // Roles returns the roles that the Application Server fulfills.
@@ -449,6 +483,10 @@ func (as *ApplicationServer) processUp(ctx context.Context, up *ttnpb.Applicatio
 		return nil
 	}
 
+	if as.deviceStates != nil {
+		as.storeDeviceState(ctx, up)
+	}
+
 	if err := as.publishUp(ctx, up); err != nil {
 		log.FromContext(ctx).WithError(err).Warn("Failed to broadcast upstream message")
 		registerDropUp(ctx, up, err)
@@ -459,6 +497,26 @@ func (as *ApplicationServer) processUp(ctx context.Context, up *ttnpb.Applicatio
 	return nil
 }
 
+// storeDeviceState stores the uplink message in the device state registry if it contains a decoded or normalized
+// payload. Simulated uplink messages are not stored.
+func (as *ApplicationServer) storeDeviceState(ctx context.Context, up *ttnpb.ApplicationUp) {
+	if up.Simulated {
+		return
+	}
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		if p.UplinkMessage.DecodedPayload == nil {
+			return
+		}
+	case *ttnpb.ApplicationUp_UplinkNormalized:
+	default:
+		return
+	}
+	if err := as.deviceStates.Push(ctx, up); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to store device state")
+	}
+}
+
 func (as *ApplicationServer) publishUp(ctx context.Context, up *ttnpb.ApplicationUp) error {
 	defer trace.StartRegion(ctx, "publish up").End()
 
@@ -468,6 +526,63 @@ func (as *ApplicationServer) publishUp(ctx context.Context, up *ttnpb.Applicatio
 	return as.clusterDistributor.Publish(ctx, up)
 }
 
+// replayServer publishes replayed upstream messages directly to the frontends, without processing them again.
+type replayServer struct {
+	*ApplicationServer
+}
+
+// Publish implements replay.Server.
+func (s replayServer) Publish(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	if err := s.publishUp(ctx, up); err != nil {
+		return err
+	}
+	registerReplayUp(ctx, up)
+	return nil
+}
+
+// replaySources returns the available sources of replayed upstream messages.
+func (as *ApplicationServer) replaySources() map[ttnpb.ReplayApplicationUpsRequest_Source]replay.Source {
+	sources := make(map[ttnpb.ReplayApplicationUpsRequest_Source]replay.Source)
+	if as.deviceStates != nil {
+		sources[ttnpb.ReplayApplicationUpsRequest_SOURCE_STORAGE] = replay.StorageSource{Registry: as.deviceStates}
+	}
+	if subscriber, ok := events.DefaultPubSub().(events.SubscriberWithHistory); ok {
+		sources[ttnpb.ReplayApplicationUpsRequest_SOURCE_EVENTS] = replay.EventsSource{Subscriber: subscriber}
+	}
+	return sources
+}
+
+var errReplayTargetUnavailable = errors.DefineFailedPrecondition(
+	"replay_target_unavailable", "replay target integration `{integration}` unavailable",
+)
+
+// validateReplayTarget validates that the webhook or pub/sub that is the target of a replay exists.
+func (as *ApplicationServer) validateReplayTarget(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, target *ttnpb.ApplicationUpReplayTarget,
+) error {
+	switch integration := target.GetIntegration().(type) {
+	case *ttnpb.ApplicationUpReplayTarget_Webhook_:
+		if as.webhooks == nil {
+			return errReplayTargetUnavailable.WithAttributes("integration", "webhook")
+		}
+		_, err := as.webhooks.Registry().Get(ctx, &ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIds: ids,
+			WebhookId:      integration.Webhook.GetWebhookId(),
+		}, []string{"ids"})
+		return err
+	case *ttnpb.ApplicationUpReplayTarget_PubSub_:
+		if as.pubsub == nil || as.config.PubSub.Registry == nil {
+			return errReplayTargetUnavailable.WithAttributes("integration", "pubsub")
+		}
+		_, err := as.config.PubSub.Registry.Get(ctx, &ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIds: ids,
+			PubSubId:       integration.PubSub.GetPubSubId(),
+		}, []string{"ids"})
+		return err
+	}
+	return nil
+}
+
 // skipPayloadCrypto indicates whether LoRaWAN FRMPayload encryption and decryption should be skipped.
 // This method returns true if the AppSKey of the given session is wrapped and cannot be unwrapped by the Application
 // Server, and if the end device's skip_payload_crypto_override is true or if the link's skip_payload_crypto is true.
@@ -922,7 +1037,7 @@ func (as *ApplicationServer) handleUp(ctx context.Context, up *ttnpb.Application
 	case *ttnpb.ApplicationUp_DownlinkNack:
 		return true, as.handleDownlinkNack(ctx, up.EndDeviceIds, up.ReceivedAt, p.DownlinkNack, link)
 	case *ttnpb.ApplicationUp_LocationSolved:
-		return true, as.handleLocationSolved(ctx, up.EndDeviceIds, p.LocationSolved, link)
+		return true, as.handleLocationSolved(ctx, up.EndDeviceIds, up.ReceivedAt, p.LocationSolved, link)
 	case *ttnpb.ApplicationUp_ServiceData:
 		return true, nil
 	default:
@@ -1215,6 +1330,15 @@ func (as *ApplicationServer) handleUplink(ctx context.Context, info uplinkInfo)
 		if err := as.publishNormalizedUplink(ctx, info); err != nil {
 			return err
 		}
+		if as.shadows != nil && info.uplink.DecodedPayload != nil {
+			reportedAt := time.Now()
+			if info.receivedAt != nil {
+				reportedAt = info.receivedAt.AsTime()
+			}
+			if _, err := as.shadows.Report(ctx, info.ids, info.uplink.DecodedPayload, reportedAt); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to update reported device shadow")
+			}
+		}
 	} else if appSKey := dev.GetSession().GetKeys().GetAppSKey(); appSKey != nil {
 		info.uplink.AppSKey = appSKey
 		info.uplink.LastAFCntDown = dev.Session.LastAFCntDown
@@ -1252,6 +1376,7 @@ func (as *ApplicationServer) handleUplink(ctx context.Context, info uplinkInfo)
 			log.FromContext(ctx).WithError(err).Warn("Failed to publish location solved message from location in payload")
 		}
 	}
+	as.evaluateGeofences(ctx, info.ids, info.receivedAt, info.uplink.Locations)
 
 	// If the device has not been activated before, publish the activation event.
 	if dev.ActivatedAt == nil {
@@ -1460,17 +1585,58 @@ func (as *ApplicationServer) handleDownlinkNack(
 
 // handleLocationSolved saves the provided *ttnpb.ApplicationLocation in the Entity Registry as part of the device locations.
 // Locations provided by other services will be maintained.
-func (as *ApplicationServer) handleLocationSolved(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationLocation, link *ttnpb.ApplicationLink) error {
+// If geofences are enabled, the geofence rules are evaluated and the resulting notifications are published.
+func (as *ApplicationServer) handleLocationSolved(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, receivedAt *timestamppb.Timestamp, msg *ttnpb.ApplicationLocation, link *ttnpb.ApplicationLink) error {
 	defer trace.StartRegion(ctx, "handle location solved").End()
 
-	if _, err := as.locationRegistry.Merge(ctx, ids, map[string]*ttnpb.Location{
+	update := map[string]*ttnpb.Location{
 		msg.Service: msg.Location,
-	}); err != nil {
+	}
+	locations, err := as.locationRegistry.Merge(ctx, ids, update)
+	if err != nil {
 		log.FromContext(ctx).WithError(err).Warn("Failed to merge end device locations")
+		locations = update
 	}
+	as.evaluateGeofences(ctx, ids, receivedAt, locations)
 	return nil
 }
 
+// evaluateGeofences evaluates the geofence rules for the end device locations and publishes the resulting notifications.
+// Only the locations that changed since the last evaluation can cause the end device to enter or exit geofences, so
+// locations in the registry and locations set by the user are evaluated when they are first seen by the Application
+// Server.
+func (as *ApplicationServer) evaluateGeofences(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	receivedAt *timestamppb.Timestamp,
+	locations map[string]*ttnpb.Location,
+) {
+	if as.geofences == nil || len(locations) == 0 {
+		return
+	}
+	at := time.Now()
+	if receivedAt != nil {
+		at = receivedAt.AsTime()
+	}
+	notifications, err := as.geofences.Evaluate(ctx, ids, locations, at)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to evaluate geofences")
+		return
+	}
+	for _, n := range notifications {
+		if err := as.Publish(ctx, &ttnpb.ApplicationUp{
+			EndDeviceIds:   ids,
+			CorrelationIds: events.CorrelationIDsFromContext(ctx),
+			ReceivedAt:     timestamppb.New(at),
+			Up: &ttnpb.ApplicationUp_ServiceData{
+				ServiceData: n.ServiceData(),
+			},
+		}); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to publish geofence notification")
+		}
+	}
+}
+
 // decryptDownlinkMessage decrypts the downlink message.
 // If application payload crypto is skipped, this method returns nil.
 func (as *ApplicationServer) decryptDownlinkMessage(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink, link *ttnpb.ApplicationLink) error {
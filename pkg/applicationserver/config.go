@@ -18,10 +18,13 @@ import (
 	"context"
 	"time"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/devicestate"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/geofence"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	alcsyncv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
+	gatewayglsv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/gatewaygls/v1"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
 	loracloudgeolocationv3 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loragls/v3"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
@@ -29,6 +32,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/sink"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/lastseen"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/metadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/shadow"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -73,6 +77,33 @@ type EndDeviceMetadataStorageConfig struct {
 	Location EndDeviceLocationStorageConfig `name:"location"`
 }
 
+// GeofencesConfig represents the configuration of geofences.
+// Geofences are evaluated on location updates of end devices if both registries are set.
+type GeofencesConfig struct {
+	Registry geofence.Registry            `name:"-"`
+	States   geofence.DeviceStateRegistry `name:"-"`
+}
+
+// ShadowsConfig represents the configuration of device shadows.
+// Device shadows are enabled if both registries are set.
+type ShadowsConfig struct {
+	Registry shadow.Registry         `name:"-"`
+	Settings shadow.SettingsRegistry `name:"-"`
+}
+
+// DeviceStatesConfig represents the configuration of the storage of the last decoded uplink messages of end devices.
+type DeviceStatesConfig struct {
+	Registry devicestate.Registry `name:"-"`
+	Limit    int64                `name:"limit" description:"Number of decoded uplink messages to keep per end device and FPort (0 to disable)"`
+	TTL      time.Duration        `name:"ttl" description:"Time to keep the state of an end device since its last uplink message"`
+}
+
+// ReplayConfig represents the configuration of uplink replays to integrations.
+type ReplayConfig struct {
+	Rate        float64 `name:"rate" description:"Number of uplink messages replayed per second (0 to disable)"`
+	MaxMessages int     `name:"max-messages" description:"Maximum number of uplink messages in a single replay"`
+}
+
 // EndDeviceLocationStorageConfig represents the configuration of end device locations storage.
 type EndDeviceLocationStorageConfig struct {
 	Registry metadata.EndDeviceLocationRegistry  `name:"-"`
@@ -120,6 +151,10 @@ type Config struct {
 	Distribution             DistributionConfig             `name:"distribution" description:"Distribution configuration"`
 	EndDeviceFetcher         EndDeviceFetcherConfig         `name:"fetcher" description:"Deprecated - End Device fetcher configuration"`
 	EndDeviceMetadataStorage EndDeviceMetadataStorageConfig `name:"end-device-metadata-storage" description:"End device metadata storage configuration"`
+	Geofences                GeofencesConfig                `name:"geofences" description:"Geofences configuration"`
+	Shadows                  ShadowsConfig                  `name:"shadows" description:"Device shadows configuration"`
+	DeviceStates             DeviceStatesConfig             `name:"device-states" description:"Last decoded uplink messages storage configuration"`
+	Replay                   ReplayConfig                   `name:"replay" description:"Uplink replay configuration"`
 	MQTT                     config.MQTT                    `name:"mqtt" description:"MQTT configuration"`
 	Webhooks                 WebhooksConfig                 `name:"webhooks" description:"Webhooks configuration"`
 	PubSub                   PubSubConfig                   `name:"pubsub" description:"Pub/sub messaging configuration"`
@@ -307,6 +342,9 @@ func (c ApplicationPackagesConfig) NewApplicationPackages(ctx context.Context, s
 	// Initialize LoRa Application Layer Clock Synchronization v1 package handler.
 	handlers[alcsyncv1.PackageName] = alcsyncv1.New(server, c.Registry)
 
+	// Initialize gateway metadata geolocation v1 package handler.
+	handlers[gatewayglsv1.PackageName] = gatewayglsv1.New(server, c.Registry)
+
 	return packages.New(ctx, server, c.Registry, handlers, c.Workers, c.Timeout)
 }
 
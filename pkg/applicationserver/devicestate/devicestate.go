@@ -0,0 +1,49 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package devicestate implements the storage of the last decoded uplink messages of end devices in the Application
+// Server, which allows querying the latest state of end devices without subscribing to their traffic.
+package devicestate
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Filter filters the state of end devices.
+type Filter struct {
+	// FPort is the FPort of the decoded uplink messages to return. If zero, the messages of all FPorts are returned.
+	FPort uint32
+	// Limit is the maximum number of decoded uplink messages to return per FPort.
+	// If zero, all stored messages are returned.
+	Limit int
+}
+
+// Registry stores the last decoded uplink messages of end devices.
+type Registry interface {
+	// Push stores the uplink message.
+	// Uplink messages with a decoded payload are stored per FPort, up to the configured limit.
+	// Normalized uplink messages replace the last normalized uplink message.
+	// Other messages are ignored.
+	Push(ctx context.Context, up *ttnpb.ApplicationUp) error
+	// Get returns the state of the end device.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, filter Filter) (*ttnpb.ApplicationEndDeviceState, error)
+	// BatchGet returns the states of the end devices of the application.
+	// If no device IDs are given, the states of the most recently updated end devices are returned.
+	// End devices without state are omitted.
+	BatchGet(
+		ctx context.Context, ids *ttnpb.ApplicationIdentifiers, deviceIDs []string, filter Filter,
+	) ([]*ttnpb.ApplicationEndDeviceState, error)
+}
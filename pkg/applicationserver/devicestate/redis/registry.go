@@ -0,0 +1,287 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the device state registry using Redis.
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/devicestate"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// MaxBatchDevices is the maximum number of end devices of which the state is returned by BatchGet.
+const MaxBatchDevices = 1000
+
+var (
+	errNotFound   = errors.DefineNotFound("not_found", "state of end device `{device_uid}` not found")
+	errTooManyIDs = errors.DefineInvalidArgument("too_many_ids", "too many device IDs, maximum is {max}")
+)
+
+// DeviceStateRegistry is a Redis device state registry.
+type DeviceStateRegistry struct {
+	Redis *ttnredis.Client
+	// Limit is the number of uplink messages to keep per end device and FPort.
+	Limit int64
+	// TTL is the time to keep the state of an end device since its last update.
+	// If zero, the state does not expire.
+	TTL time.Duration
+}
+
+var _ devicestate.Registry = (*DeviceStateRegistry)(nil)
+
+func (r *DeviceStateRegistry) uplinksKey(devUID string, fPort uint32) string {
+	return r.Redis.Key("uid", devUID, "uplinks", strconv.FormatUint(uint64(fPort), 10))
+}
+
+func (r *DeviceStateRegistry) fPortsKey(devUID string) string {
+	return r.Redis.Key("uid", devUID, "f_ports")
+}
+
+func (r *DeviceStateRegistry) normalizedKey(devUID string) string {
+	return r.Redis.Key("uid", devUID, "normalized")
+}
+
+// appKey is the key of the sorted set of device IDs of the application, scored by the time of the last update.
+func (r *DeviceStateRegistry) appKey(appUID string) string {
+	return r.Redis.Key("application", appUID)
+}
+
+// Push implements devicestate.Registry.
+func (r *DeviceStateRegistry) Push(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	ids := up.GetEndDeviceIds()
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+	devUID := unique.ID(ctx, ids)
+	appUID := unique.ID(ctx, ids.ApplicationIds)
+
+	s, err := ttnredis.MarshalProto(up)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		switch pld := up.Up.(type) {
+		case *ttnpb.ApplicationUp_UplinkMessage:
+			fPort := pld.UplinkMessage.GetFPort()
+			uk := r.uplinksKey(devUID, fPort)
+			p.LPush(ctx, uk, s)
+			p.LTrim(ctx, uk, 0, r.Limit-1)
+			fk := r.fPortsKey(devUID)
+			p.SAdd(ctx, fk, fPort)
+			if r.TTL > 0 {
+				p.PExpire(ctx, uk, r.TTL)
+				p.PExpire(ctx, fk, r.TTL)
+			}
+		case *ttnpb.ApplicationUp_UplinkNormalized:
+			p.Set(ctx, r.normalizedKey(devUID), s, r.TTL)
+		default:
+			return nil
+		}
+		ak := r.appKey(appUID)
+		p.ZAdd(ctx, ak, redis.Z{
+			Score:  float64(now.UnixMilli()),
+			Member: ids.DeviceId,
+		})
+		if r.TTL > 0 {
+			p.ZRemRangeByScore(ctx, ak, "-inf", strconv.FormatInt(now.Add(-r.TTL).UnixMilli(), 10))
+			p.PExpire(ctx, ak, r.TTL)
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+type stateCmds struct {
+	fPorts     map[uint32]*redis.StringSliceCmd
+	normalized *redis.StringCmd
+}
+
+// queueGet queues the retrieval of the state of the end device in the pipeline.
+// The FPorts of the end device must be known before calling this function.
+func (r *DeviceStateRegistry) queueGet(
+	ctx context.Context, p redis.Pipeliner, devUID string, fPorts []uint32, filter devicestate.Filter,
+) *stateCmds {
+	cmds := &stateCmds{
+		fPorts: make(map[uint32]*redis.StringSliceCmd, len(fPorts)),
+	}
+	stop := int64(-1)
+	if filter.Limit > 0 {
+		stop = int64(filter.Limit) - 1
+	}
+	for _, fPort := range fPorts {
+		if filter.FPort != 0 && filter.FPort != fPort {
+			continue
+		}
+		cmds.fPorts[fPort] = p.LRange(ctx, r.uplinksKey(devUID, fPort), 0, stop)
+	}
+	cmds.normalized = p.Get(ctx, r.normalizedKey(devUID))
+	return cmds
+}
+
+func (cmds *stateCmds) state(ids *ttnpb.EndDeviceIdentifiers) (*ttnpb.ApplicationEndDeviceState, error) {
+	st := &ttnpb.ApplicationEndDeviceState{
+		EndDeviceIds: ids,
+	}
+	for fPort, cmd := range cmds.fPorts {
+		vals, err := cmd.Result()
+		if err != nil {
+			return nil, err
+		}
+		if len(vals) == 0 {
+			continue
+		}
+		ups := make([]*ttnpb.ApplicationUp, 0, len(vals))
+		for _, v := range vals {
+			up := &ttnpb.ApplicationUp{}
+			if err := ttnredis.UnmarshalProto(v, up); err != nil {
+				return nil, err
+			}
+			ups = append(ups, up)
+		}
+		if st.Uplinks == nil {
+			st.Uplinks = make(map[uint32]*ttnpb.ApplicationEndDeviceState_Uplinks)
+		}
+		st.Uplinks[fPort] = &ttnpb.ApplicationEndDeviceState_Uplinks{Uplinks: ups}
+	}
+	switch v, err := cmds.normalized.Result(); {
+	case errors.Is(err, redis.Nil):
+	case err != nil:
+		return nil, err
+	default:
+		up := &ttnpb.ApplicationUp{}
+		if err := ttnredis.UnmarshalProto(v, up); err != nil {
+			return nil, err
+		}
+		st.LastNormalized = up
+	}
+	return st, nil
+}
+
+func parseFPorts(vals []string) ([]uint32, error) {
+	fPorts := make([]uint32, 0, len(vals))
+	for _, v := range vals {
+		fPort, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		fPorts = append(fPorts, uint32(fPort))
+	}
+	return fPorts, nil
+}
+
+// getStates returns the states of the given end devices. End devices without state are omitted.
+func (r *DeviceStateRegistry) getStates(
+	ctx context.Context, devIDs []*ttnpb.EndDeviceIdentifiers, filter devicestate.Filter,
+) ([]*ttnpb.ApplicationEndDeviceState, error) {
+	devUIDs := make([]string, len(devIDs))
+	fPortCmds := make([]*redis.StringSliceCmd, len(devIDs))
+	if _, err := r.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i, ids := range devIDs {
+			devUIDs[i] = unique.ID(ctx, ids)
+			fPortCmds[i] = p.SMembers(ctx, r.fPortsKey(devUIDs[i]))
+		}
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+
+	cmds := make([]*stateCmds, len(devIDs))
+	if _, err := r.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i := range devIDs {
+			fPorts, err := parseFPorts(fPortCmds[i].Val())
+			if err != nil {
+				return err
+			}
+			cmds[i] = r.queueGet(ctx, p, devUIDs[i], fPorts, filter)
+		}
+		return nil
+	}); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, ttnredis.ConvertError(err)
+	}
+
+	states := make([]*ttnpb.ApplicationEndDeviceState, 0, len(devIDs))
+	for i, ids := range devIDs {
+		st, err := cmds[i].state(ids)
+		if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		if len(st.Uplinks) == 0 && st.LastNormalized == nil {
+			continue
+		}
+		states = append(states, st)
+	}
+	return states, nil
+}
+
+// Get implements devicestate.Registry.
+func (r *DeviceStateRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, filter devicestate.Filter,
+) (*ttnpb.ApplicationEndDeviceState, error) {
+	states, err := r.getStates(ctx, []*ttnpb.EndDeviceIdentifiers{ids}, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, errNotFound.WithAttributes("device_uid", unique.ID(ctx, ids))
+	}
+	return states[0], nil
+}
+
+// BatchGet implements devicestate.Registry.
+func (r *DeviceStateRegistry) BatchGet(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, deviceIDs []string, filter devicestate.Filter,
+) ([]*ttnpb.ApplicationEndDeviceState, error) {
+	if len(deviceIDs) > MaxBatchDevices {
+		return nil, errTooManyIDs.WithAttributes("max", MaxBatchDevices)
+	}
+	if len(deviceIDs) == 0 {
+		var err error
+		minScore := "-inf"
+		if r.TTL > 0 {
+			minScore = strconv.FormatInt(time.Now().Add(-r.TTL).UnixMilli(), 10)
+		}
+		deviceIDs, err = r.Redis.ZRevRangeByScore(ctx, r.appKey(unique.ID(ctx, ids)), &redis.ZRangeBy{
+			Min:   minScore,
+			Max:   "+inf",
+			Count: MaxBatchDevices,
+		}).Result()
+		if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		if len(deviceIDs) == 0 {
+			return nil, nil
+		}
+	}
+	devIDs := make([]*ttnpb.EndDeviceIdentifiers, 0, len(deviceIDs))
+	for _, deviceID := range deviceIDs {
+		devIDs = append(devIDs, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: ids,
+			DeviceId:       deviceID,
+		})
+	}
+	return r.getStates(ctx, devIDs, filter)
+}
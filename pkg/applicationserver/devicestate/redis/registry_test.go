@@ -0,0 +1,138 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/devicestate"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDeviceStateRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+
+	registry := &DeviceStateRegistry{
+		Redis: cl,
+		Limit: 2,
+		TTL:   time.Hour,
+	}
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "myapp"}
+	devIDs := func(id string) *ttnpb.EndDeviceIdentifiers {
+		return &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: id}
+	}
+	uplink := func(id string, fPort uint32, fCnt uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIds: devIDs(id),
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort: fPort,
+					FCnt:  fCnt,
+					DecodedPayload: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"f_cnt": structpb.NewNumberValue(float64(fCnt)),
+					}},
+				},
+			},
+		}
+	}
+	fCnts := func(ups *ttnpb.ApplicationEndDeviceState_Uplinks) []uint32 {
+		var res []uint32
+		for _, up := range ups.GetUplinks() {
+			res = append(res, up.GetUplinkMessage().GetFCnt())
+		}
+		return res
+	}
+
+	_, err := registry.Get(ctx, devIDs("dev-1"), devicestate.Filter{})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	for _, up := range []*ttnpb.ApplicationUp{
+		uplink("dev-1", 1, 1),
+		uplink("dev-1", 2, 2),
+		uplink("dev-1", 1, 3),
+		uplink("dev-1", 1, 4),
+		uplink("dev-2", 1, 1),
+		{
+			EndDeviceIds: devIDs("dev-2"),
+			Up: &ttnpb.ApplicationUp_UplinkNormalized{
+				UplinkNormalized: &ttnpb.ApplicationUplinkNormalized{FPort: 1, FCnt: 1},
+			},
+		},
+		{
+			EndDeviceIds: devIDs("dev-3"),
+			Up: &ttnpb.ApplicationUp_JoinAccept{
+				JoinAccept: &ttnpb.ApplicationJoinAccept{},
+			},
+		},
+	} {
+		if !a.So(registry.Push(ctx, up), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	st, err := registry.Get(ctx, devIDs("dev-1"), devicestate.Filter{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(st.Uplinks, should.HaveLength, 2)
+	a.So(fCnts(st.Uplinks[1]), should.Resemble, []uint32{4, 3})
+	a.So(fCnts(st.Uplinks[2]), should.Resemble, []uint32{2})
+	a.So(st.LastNormalized, should.BeNil)
+
+	st, err = registry.Get(ctx, devIDs("dev-1"), devicestate.Filter{FPort: 1, Limit: 1})
+	a.So(err, should.BeNil)
+	a.So(st.Uplinks, should.HaveLength, 1)
+	a.So(fCnts(st.Uplinks[1]), should.Resemble, []uint32{4})
+
+	st, err = registry.Get(ctx, devIDs("dev-2"), devicestate.Filter{})
+	a.So(err, should.BeNil)
+	a.So(fCnts(st.Uplinks[1]), should.Resemble, []uint32{1})
+	a.So(st.LastNormalized.GetUplinkNormalized().GetFCnt(), should.Equal, 1)
+
+	_, err = registry.Get(ctx, devIDs("dev-3"), devicestate.Filter{})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	states, err := registry.BatchGet(ctx, appIDs, nil, devicestate.Filter{FPort: 2})
+	a.So(err, should.BeNil)
+	if a.So(states, should.HaveLength, 2) {
+		// The most recently updated end device is returned first.
+		a.So(states[0].EndDeviceIds.DeviceId, should.Equal, "dev-2")
+		a.So(states[0].Uplinks, should.BeEmpty)
+		a.So(states[0].LastNormalized, should.NotBeNil)
+		a.So(states[1].EndDeviceIds.DeviceId, should.Equal, "dev-1")
+		a.So(fCnts(states[1].Uplinks[2]), should.Resemble, []uint32{2})
+	}
+
+	states, err = registry.BatchGet(ctx, appIDs, []string{"dev-1", "dev-3"}, devicestate.Filter{})
+	a.So(err, should.BeNil)
+	if a.So(states, should.HaveLength, 1) {
+		a.So(states[0].EndDeviceIds.DeviceId, should.Equal, "dev-1")
+	}
+
+	_, err = registry.BatchGet(ctx, appIDs, make([]string, MaxBatchDevices+1), devicestate.Filter{})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
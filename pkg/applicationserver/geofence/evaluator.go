@@ -0,0 +1,207 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geofence
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ServiceName is the service name of geofence notifications in application service data messages.
+const ServiceName = "geofence"
+
+// Notification is a rule hit of an end device in a geofence.
+type Notification struct {
+	GeofenceID   string
+	GeofenceName string
+	Trigger      Trigger
+	Location     *ttnpb.Location
+	// EnteredAt is the time at which the end device entered the geofence.
+	// It is set for enter and dwell notifications.
+	EnteredAt time.Time
+	// At is the time of the location update which triggered the notification.
+	At time.Time
+}
+
+// ServiceData returns the notification as application service data.
+func (n *Notification) ServiceData() *ttnpb.ApplicationServiceData {
+	fields := map[string]*structpb.Value{
+		"geofence_id": structpb.NewStringValue(n.GeofenceID),
+		"trigger":     structpb.NewStringValue(string(n.Trigger)),
+		"location": structpb.NewStructValue(&structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"latitude":  structpb.NewNumberValue(n.Location.GetLatitude()),
+				"longitude": structpb.NewNumberValue(n.Location.GetLongitude()),
+				"altitude":  structpb.NewNumberValue(float64(n.Location.GetAltitude())),
+				"accuracy":  structpb.NewNumberValue(float64(n.Location.GetAccuracy())),
+				"source":    structpb.NewStringValue(n.Location.GetSource().String()),
+			},
+		}),
+	}
+	if n.GeofenceName != "" {
+		fields["geofence_name"] = structpb.NewStringValue(n.GeofenceName)
+	}
+	if !n.EnteredAt.IsZero() {
+		fields["entered_at"] = structpb.NewStringValue(n.EnteredAt.UTC().Format(time.RFC3339Nano))
+		if n.Trigger == TriggerDwell {
+			fields["dwell_time"] = structpb.NewStringValue(n.At.Sub(n.EnteredAt).String())
+		}
+	}
+	return &ttnpb.ApplicationServiceData{
+		Service: ServiceName,
+		Data:    &structpb.Struct{Fields: fields},
+	}
+}
+
+// Evaluator evaluates the geofence rules on location updates of end devices.
+type Evaluator struct {
+	geofences Registry
+	states    DeviceStateRegistry
+}
+
+// NewEvaluator returns a new Evaluator.
+func NewEvaluator(geofences Registry, states DeviceStateRegistry) *Evaluator {
+	return &Evaluator{
+		geofences: geofences,
+		states:    states,
+	}
+}
+
+// Evaluate updates the geofence states of the end device with the given locations by location service and returns the
+// notifications of the rules that are hit.
+// Only the locations that changed since the last evaluation are evaluated, in order of location service, so that
+// locations of different services that do not change do not cause the end device to enter and exit geofences.
+// If no location changed, the last evaluated location is evaluated again, so that dwelling is notified.
+func (e *Evaluator) Evaluate(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, locations map[string]*ttnpb.Location, at time.Time,
+) ([]*Notification, error) {
+	geofences, err := e.geofences.List(ctx, ids.ApplicationIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(geofences) == 0 {
+		return nil, nil
+	}
+	var notifications []*Notification
+	if err := e.states.SetDeviceStates(ctx, ids,
+		func(states *DeviceStates) (*DeviceStates, error) {
+			states, notifications = evaluateLocations(geofences, states, locations, at)
+			return states, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	for _, n := range notifications {
+		registerNotification(ctx, ids, n)
+	}
+	return notifications, nil
+}
+
+// evaluateLocations returns the updated states of the end device after evaluating the changed locations, and the
+// notifications of the rules that are hit.
+func evaluateLocations(
+	geofences []*Geofence, stored *DeviceStates, locations map[string]*ttnpb.Location, at time.Time,
+) (*DeviceStates, []*Notification) {
+	states := &DeviceStates{
+		Locations: make(map[string]*ttnpb.Location, len(locations)),
+	}
+	if stored != nil {
+		states.Geofences, states.Service = stored.Geofences, stored.Service
+		for service, loc := range stored.Locations {
+			states.Locations[service] = loc
+		}
+	}
+	services := make([]string, 0, len(locations))
+	for service, loc := range locations {
+		if loc == nil || proto.Equal(loc, states.Locations[service]) {
+			continue
+		}
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	var notifications []*Notification
+	if len(services) == 0 {
+		if loc, ok := states.Locations[states.Service]; ok {
+			states.Geofences, notifications = evaluate(geofences, states.Geofences, loc, at)
+		}
+		return states, notifications
+	}
+	for _, service := range services {
+		loc := locations[service]
+		var ns []*Notification
+		states.Geofences, ns = evaluate(geofences, states.Geofences, loc, at)
+		notifications = append(notifications, ns...)
+		states.Locations[service], states.Service = loc, service
+	}
+	return states, notifications
+}
+
+// evaluate returns the updated states of the end device and the notifications of the rules that are hit.
+// An end device without a state for a geofence is considered to be outside of the geofence.
+// The states of geofences which no longer exist are removed.
+func evaluate(
+	geofences []*Geofence, states map[string]*DeviceState, loc *ttnpb.Location, at time.Time,
+) (map[string]*DeviceState, []*Notification) {
+	p := Point{
+		Latitude:  loc.GetLatitude(),
+		Longitude: loc.GetLongitude(),
+	}
+	updated := make(map[string]*DeviceState, len(geofences))
+	var notifications []*Notification
+	for _, g := range geofences {
+		st := &DeviceState{}
+		if prev, ok := states[g.ID]; ok {
+			*st = *prev
+		}
+		notify := func(trigger Trigger, enteredAt time.Time) {
+			notifications = append(notifications, &Notification{
+				GeofenceID:   g.ID,
+				GeofenceName: g.Name,
+				Trigger:      trigger,
+				Location:     loc,
+				EnteredAt:    enteredAt,
+				At:           at,
+			})
+		}
+		inside := g.Contains(p)
+		switch {
+		case inside && !st.Inside:
+			st.Inside, st.EnteredAt, st.DwellNotified = true, at, false
+			if g.Rules.Enter {
+				notify(TriggerEnter, at)
+			}
+		case !inside && st.Inside:
+			st.Inside, st.EnteredAt, st.DwellNotified = false, time.Time{}, false
+			if g.Rules.Exit {
+				notify(TriggerExit, time.Time{})
+			}
+		}
+		if dwell := time.Duration(g.Rules.Dwell); st.Inside && dwell > 0 && !st.DwellNotified &&
+			at.Sub(st.EnteredAt) >= dwell {
+			st.DwellNotified = true
+			notify(TriggerDwell, st.EnteredAt)
+		}
+		if st.Inside {
+			updated[g.ID] = st
+		}
+	}
+	return updated, notifications
+}
@@ -0,0 +1,186 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geofence
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	geofences := []*Geofence{
+		{
+			ID:      "yard",
+			Name:    "Yard",
+			Polygon: testSquare,
+			Rules:   Rules{Enter: true, Exit: true, Dwell: Duration(time.Hour)},
+		},
+		{
+			ID:     "gate",
+			Circle: &Circle{Center: Point{Latitude: 52.0, Longitude: 4.0}, Radius: 500},
+			Rules:  Rules{Exit: true},
+		},
+	}
+	inside := &ttnpb.Location{Latitude: 52.05, Longitude: 4.05}
+	atGate := &ttnpb.Location{Latitude: 52.001, Longitude: 4.001}
+	outside := &ttnpb.Location{Latitude: 53, Longitude: 5}
+
+	start := time.Unix(1700000000, 0).UTC()
+
+	triggers := func(notifications []*Notification) []string {
+		var res []string
+		for _, n := range notifications {
+			res = append(res, n.GeofenceID+":"+string(n.Trigger))
+		}
+		return res
+	}
+
+	// Enter the yard.
+	states, notifications := evaluate(geofences, nil, inside, start)
+	a.So(triggers(notifications), should.Resemble, []string{"yard:enter"})
+	a.So(states, should.Resemble, map[string]*DeviceState{
+		"yard": {Inside: true, EnteredAt: start},
+	})
+
+	// Stay inside, not long enough to dwell.
+	states, notifications = evaluate(geofences, states, inside, start.Add(30*time.Minute))
+	a.So(notifications, should.BeEmpty)
+
+	// Dwell in the yard.
+	states, notifications = evaluate(geofences, states, inside, start.Add(time.Hour))
+	a.So(triggers(notifications), should.Resemble, []string{"yard:dwell"})
+	a.So(notifications[0].EnteredAt, should.Equal, start)
+	a.So(states["yard"].DwellNotified, should.BeTrue)
+
+	// Dwelling is notified only once.
+	states, notifications = evaluate(geofences, states, inside, start.Add(2*time.Hour))
+	a.So(notifications, should.BeEmpty)
+
+	// Move to the gate, which is in the yard as well. Entering the gate is not notified.
+	states, notifications = evaluate(geofences, states, atGate, start.Add(3*time.Hour))
+	a.So(notifications, should.BeEmpty)
+	a.So(states, should.HaveLength, 2)
+
+	// Exit both.
+	states, notifications = evaluate(geofences, states, outside, start.Add(4*time.Hour))
+	a.So(triggers(notifications), should.Resemble, []string{"yard:exit", "gate:exit"})
+	a.So(states, should.BeEmpty)
+
+	// States of deleted geofences are removed.
+	states, notifications = evaluate(nil, map[string]*DeviceState{
+		"deleted": {Inside: true, EnteredAt: start},
+	}, inside, start)
+	a.So(notifications, should.BeEmpty)
+	a.So(states, should.BeEmpty)
+}
+
+func TestEvaluateLocations(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	geofences := []*Geofence{
+		{
+			ID:      "yard",
+			Polygon: testSquare,
+			Rules:   Rules{Enter: true, Exit: true, Dwell: Duration(time.Hour)},
+		},
+	}
+	user := &ttnpb.Location{Latitude: 52.05, Longitude: 4.05, Source: ttnpb.LocationSource_SOURCE_REGISTRY}
+	solved := &ttnpb.Location{Latitude: 53, Longitude: 5, Source: ttnpb.LocationSource_SOURCE_GPS}
+	moved := &ttnpb.Location{Latitude: 52.06, Longitude: 4.06, Source: ttnpb.LocationSource_SOURCE_GPS}
+
+	start := time.Unix(1700000000, 0).UTC()
+
+	triggers := func(notifications []*Notification) []string {
+		var res []string
+		for _, n := range notifications {
+			res = append(res, n.GeofenceID+":"+string(n.Trigger))
+		}
+		return res
+	}
+
+	// The location set by the user is evaluated.
+	states, notifications := evaluateLocations(geofences, nil, map[string]*ttnpb.Location{
+		"user": user,
+	}, start)
+	a.So(triggers(notifications), should.Resemble, []string{"yard:enter"})
+	a.So(states.Service, should.Equal, "user")
+
+	// A solved location outside the yard exits it.
+	states, notifications = evaluateLocations(geofences, states, map[string]*ttnpb.Location{
+		"user": user,
+		"gnss": solved,
+	}, start.Add(10*time.Minute))
+	a.So(triggers(notifications), should.Resemble, []string{"yard:exit"})
+	a.So(states.Service, should.Equal, "gnss")
+
+	// The unchanged location set by the user does not enter the yard again.
+	states, notifications = evaluateLocations(geofences, states, map[string]*ttnpb.Location{
+		"user": user,
+		"gnss": solved,
+	}, start.Add(20*time.Minute))
+	a.So(notifications, should.BeEmpty)
+
+	// Moving the end device in the registry enters the yard.
+	states, notifications = evaluateLocations(geofences, states, map[string]*ttnpb.Location{
+		"user": moved,
+		"gnss": solved,
+	}, start.Add(30*time.Minute))
+	a.So(triggers(notifications), should.Resemble, []string{"yard:enter"})
+	a.So(states.Service, should.Equal, "user")
+
+	// Dwelling is notified without the location changing.
+	_, notifications = evaluateLocations(geofences, states, map[string]*ttnpb.Location{
+		"user": moved,
+	}, start.Add(90*time.Minute))
+	a.So(triggers(notifications), should.Resemble, []string{"yard:dwell"})
+}
+
+func TestNotificationServiceData(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	start := time.Unix(1700000000, 0).UTC()
+	n := &Notification{
+		GeofenceID:   "yard",
+		GeofenceName: "Yard",
+		Trigger:      TriggerDwell,
+		Location: &ttnpb.Location{
+			Latitude:  52.05,
+			Longitude: 4.05,
+			Source:    ttnpb.LocationSource_SOURCE_GPS,
+		},
+		EnteredAt: start,
+		At:        start.Add(time.Hour),
+	}
+	sd := n.ServiceData()
+	a.So(sd.Service, should.Equal, ServiceName)
+	fields := sd.Data.GetFields()
+	a.So(fields["geofence_id"].GetStringValue(), should.Equal, "yard")
+	a.So(fields["geofence_name"].GetStringValue(), should.Equal, "Yard")
+	a.So(fields["trigger"].GetStringValue(), should.Equal, "dwell")
+	a.So(fields["entered_at"].GetStringValue(), should.Equal, "2023-11-14T22:13:20Z")
+	a.So(fields["dwell_time"].GetStringValue(), should.Equal, "1h0m0s")
+	loc := fields["location"].GetStructValue().GetFields()
+	a.So(loc["latitude"].GetNumberValue(), should.Equal, 52.05)
+	a.So(loc["source"].GetStringValue(), should.Equal, "SOURCE_GPS")
+}
@@ -0,0 +1,193 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geofence implements geofences for end devices in the Application Server.
+//
+// Geofences are defined per application as circles or polygons, with rules that trigger when an end device enters,
+// exits or dwells in the geofence. The rules are evaluated on each location update of an end device.
+package geofence
+
+import (
+	"encoding/json"
+	"math"
+	"regexp"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// Trigger is the type of transition which triggers a rule.
+type Trigger string
+
+const (
+	// TriggerEnter is triggered when an end device enters a geofence.
+	TriggerEnter Trigger = "enter"
+	// TriggerExit is triggered when an end device exits a geofence.
+	TriggerExit Trigger = "exit"
+	// TriggerDwell is triggered when an end device is inside a geofence for at least the dwell time.
+	TriggerDwell Trigger = "dwell"
+)
+
+// earthRadius is the mean radius of the Earth in meters.
+const earthRadius = 6371008.8
+
+// maxPolygonVertices is the maximum number of vertices of a polygon.
+const maxPolygonVertices = 256
+
+var idRegexp = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// Duration is a time.Duration which is encoded as a duration string in JSON.
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// Point is a geographical position.
+type Point struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (p Point) valid() bool {
+	return p.Latitude >= -90 && p.Latitude <= 90 && p.Longitude >= -180 && p.Longitude <= 180
+}
+
+// distance returns the great-circle distance to the given point in meters.
+func (p Point) distance(q Point) float64 {
+	lat1, lat2 := p.Latitude*math.Pi/180, q.Latitude*math.Pi/180
+	dLat, dLon := lat2-lat1, (q.Longitude-p.Longitude)*math.Pi/180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Circle is a circular area.
+type Circle struct {
+	Center Point `json:"center"`
+	// Radius is the radius in meters.
+	Radius float64 `json:"radius"`
+}
+
+// Rules define the transitions which produce notifications.
+type Rules struct {
+	Enter bool `json:"enter,omitempty"`
+	Exit  bool `json:"exit,omitempty"`
+	// Dwell is the minimum time an end device must be inside the geofence to trigger a dwell notification.
+	// Dwelling is not notified if zero.
+	Dwell Duration `json:"dwell,omitempty"`
+}
+
+// Geofence is a geographical area of an application.
+// Exactly one of Circle and Polygon is set.
+type Geofence struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Circle      *Circle   `json:"circle,omitempty"`
+	Polygon     []Point   `json:"polygon,omitempty"`
+	Rules       Rules     `json:"rules"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+var (
+	errInvalidID      = errors.DefineInvalidArgument("invalid_id", "invalid geofence ID `{id}`")
+	errInvalidShape   = errors.DefineInvalidArgument("invalid_shape", "exactly one of circle and polygon must be set")
+	errInvalidRadius  = errors.DefineInvalidArgument("invalid_radius", "invalid circle radius `{radius}`")
+	errInvalidPoint   = errors.DefineInvalidArgument("invalid_point", "invalid point `{latitude}`,`{longitude}`")
+	errInvalidPolygon = errors.DefineInvalidArgument("invalid_polygon", "polygon must have between 3 and {max} vertices")
+	errInvalidDwell   = errors.DefineInvalidArgument("invalid_dwell", "invalid dwell time `{dwell}`")
+	errNoRules        = errors.DefineInvalidArgument("no_rules", "no rules enabled")
+)
+
+// Validate returns an error if the geofence is invalid.
+func (g *Geofence) Validate() error {
+	if !idRegexp.MatchString(g.ID) || len(g.ID) > 36 {
+		return errInvalidID.WithAttributes("id", g.ID)
+	}
+	switch {
+	case g.Circle != nil && len(g.Polygon) == 0:
+		if !g.Circle.Center.valid() {
+			return errInvalidPoint.WithAttributes(
+				"latitude", g.Circle.Center.Latitude,
+				"longitude", g.Circle.Center.Longitude,
+			)
+		}
+		if !(g.Circle.Radius > 0) {
+			return errInvalidRadius.WithAttributes("radius", g.Circle.Radius)
+		}
+	case g.Circle == nil && len(g.Polygon) > 0:
+		if len(g.Polygon) < 3 || len(g.Polygon) > maxPolygonVertices {
+			return errInvalidPolygon.WithAttributes("max", maxPolygonVertices)
+		}
+		for _, p := range g.Polygon {
+			if !p.valid() {
+				return errInvalidPoint.WithAttributes(
+					"latitude", p.Latitude,
+					"longitude", p.Longitude,
+				)
+			}
+		}
+	default:
+		return errInvalidShape.New()
+	}
+	if g.Rules.Dwell < 0 {
+		return errInvalidDwell.WithAttributes("dwell", time.Duration(g.Rules.Dwell))
+	}
+	if !g.Rules.Enter && !g.Rules.Exit && g.Rules.Dwell == 0 {
+		return errNoRules.New()
+	}
+	return nil
+}
+
+// Contains returns whether the given point is inside the geofence.
+func (g *Geofence) Contains(p Point) bool {
+	if g.Circle != nil {
+		return g.Circle.Center.distance(p) <= g.Circle.Radius
+	}
+	return polygonContains(g.Polygon, p)
+}
+
+// polygonContains returns whether the point is inside the polygon using the even-odd rule.
+// The polygon edges are interpreted as straight lines in the equirectangular projection, which is accurate for
+// polygons of the size of typical geofences that do not cross the antimeridian.
+func polygonContains(polygon []Point, p Point) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Latitude > p.Latitude) == (b.Latitude > p.Latitude) {
+			continue
+		}
+		lon := a.Longitude + (p.Latitude-a.Latitude)/(b.Latitude-a.Latitude)*(b.Longitude-a.Longitude)
+		if p.Longitude < lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
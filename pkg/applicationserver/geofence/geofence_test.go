@@ -0,0 +1,188 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geofence
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var testSquare = []Point{
+	{Latitude: 52.0, Longitude: 4.0},
+	{Latitude: 52.0, Longitude: 4.1},
+	{Latitude: 52.1, Longitude: 4.1},
+	{Latitude: 52.1, Longitude: 4.0},
+}
+
+func TestGeofenceValidate(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name           string
+		Geofence       *Geofence
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Circle",
+			Geofence: &Geofence{
+				ID:     "office",
+				Circle: &Circle{Center: Point{Latitude: 52.37, Longitude: 4.89}, Radius: 100},
+				Rules:  Rules{Enter: true},
+			},
+		},
+		{
+			Name: "Polygon",
+			Geofence: &Geofence{
+				ID:      "yard",
+				Polygon: testSquare,
+				Rules:   Rules{Dwell: Duration(time.Hour)},
+			},
+		},
+		{
+			Name: "InvalidID",
+			Geofence: &Geofence{
+				ID:     "Office",
+				Circle: &Circle{Radius: 100},
+				Rules:  Rules{Enter: true},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NoShape",
+			Geofence: &Geofence{
+				ID:    "office",
+				Rules: Rules{Enter: true},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "BothShapes",
+			Geofence: &Geofence{
+				ID:      "office",
+				Circle:  &Circle{Radius: 100},
+				Polygon: testSquare,
+				Rules:   Rules{Enter: true},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "ZeroRadius",
+			Geofence: &Geofence{
+				ID:     "office",
+				Circle: &Circle{},
+				Rules:  Rules{Enter: true},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "InvalidCenter",
+			Geofence: &Geofence{
+				ID:     "office",
+				Circle: &Circle{Center: Point{Latitude: 91}, Radius: 100},
+				Rules:  Rules{Enter: true},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "TooFewVertices",
+			Geofence: &Geofence{
+				ID:      "yard",
+				Polygon: testSquare[:2],
+				Rules:   Rules{Enter: true},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NegativeDwell",
+			Geofence: &Geofence{
+				ID:      "yard",
+				Polygon: testSquare,
+				Rules:   Rules{Dwell: Duration(-time.Second)},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NoRules",
+			Geofence: &Geofence{
+				ID:      "yard",
+				Polygon: testSquare,
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			err := tc.Geofence.Validate()
+			if tc.ErrorAssertion == nil {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestGeofenceContains(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	circle := &Geofence{
+		Circle: &Circle{Center: Point{Latitude: 52.0, Longitude: 4.0}, Radius: 1000},
+	}
+	a.So(circle.Contains(Point{Latitude: 52.0, Longitude: 4.0}), should.BeTrue)
+	// 0.008 degrees of latitude is approximately 890 meters.
+	a.So(circle.Contains(Point{Latitude: 52.008, Longitude: 4.0}), should.BeTrue)
+	// 0.01 degrees of latitude is approximately 1110 meters.
+	a.So(circle.Contains(Point{Latitude: 52.01, Longitude: 4.0}), should.BeFalse)
+
+	polygon := &Geofence{Polygon: testSquare}
+	a.So(polygon.Contains(Point{Latitude: 52.05, Longitude: 4.05}), should.BeTrue)
+	a.So(polygon.Contains(Point{Latitude: 52.15, Longitude: 4.05}), should.BeFalse)
+	a.So(polygon.Contains(Point{Latitude: 52.05, Longitude: 3.95}), should.BeFalse)
+
+	concave := &Geofence{Polygon: []Point{
+		{Latitude: 0, Longitude: 0},
+		{Latitude: 0, Longitude: 2},
+		{Latitude: 2, Longitude: 2},
+		{Latitude: 1, Longitude: 1},
+		{Latitude: 2, Longitude: 0},
+	}}
+	a.So(concave.Contains(Point{Latitude: 0.5, Longitude: 1}), should.BeTrue)
+	a.So(concave.Contains(Point{Latitude: 1.5, Longitude: 1}), should.BeFalse)
+	a.So(concave.Contains(Point{Latitude: 1.5, Longitude: 0.2}), should.BeTrue)
+}
+
+func TestDurationJSON(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	b, err := json.Marshal(Rules{Dwell: Duration(90 * time.Second)})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(string(b), should.Equal, `{"dwell":"1m30s"}`)
+
+	var rules Rules
+	a.So(json.Unmarshal(b, &rules), should.BeNil)
+	a.So(rules.Dwell, should.Equal, Duration(90*time.Second))
+
+	a.So(json.Unmarshal([]byte(`{"dwell":"invalid"}`), &rules), should.NotBeNil)
+}
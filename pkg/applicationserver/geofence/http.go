@@ -0,0 +1,189 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geofence
+
+import (
+	"context"
+	"encoding/json"
+	stdio "io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+)
+
+// maxBodySize is the maximum size of a geofence request body.
+const maxBodySize = 1 << 16
+
+var (
+	errGeofenceNotFound = errors.DefineNotFound("geofence_not_found", "geofence `{id}` not found")
+	errReadBody         = errors.DefineCanceled("read_body", "read body")
+	errDecodeBody       = errors.DefineInvalidArgument("decode_body", "decode body")
+)
+
+// Routes exposes the geofence registry over HTTP.
+type Routes struct {
+	registry Registry
+	limiter  ratelimit.Interface
+}
+
+// NewRoutes returns new Routes for the given registry.
+func NewRoutes(registry Registry, limiter ratelimit.Interface) *Routes {
+	return &Routes{
+		registry: registry,
+		limiter:  limiter,
+	}
+}
+
+type applicationIDsKeyType struct{}
+
+var applicationIDsKey applicationIDsKeyType
+
+func applicationIDsFromContext(ctx context.Context) *ttnpb.ApplicationIdentifiers {
+	ids, _ := ctx.Value(applicationIDsKey).(*ttnpb.ApplicationIdentifiers)
+	return ids
+}
+
+func validateAndFillIDs(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		ids := &ttnpb.ApplicationIdentifiers{
+			ApplicationId: mux.Vars(r)["application_id"],
+		}
+		if err := ids.ValidateContext(ctx); err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, applicationIDsKey, ids)))
+	})
+}
+
+func requireApplicationRights(required ...ttnpb.Right) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if err := rights.RequireApplication(r.Context(), applicationIDsFromContext(r.Context()), required...); err != nil {
+				webhandlers.Error(w, r, err)
+				return
+			}
+			next(w, r)
+		}
+	}
+}
+
+// RegisterRoutes implements web.Registerer.
+func (s *Routes) RegisterRoutes(server *web.Server) {
+	router := server.Prefix(ttnpb.HTTPAPIPrefix + "/as/applications/{application_id}/geofences").Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("applicationserver/geofence")),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+		ratelimit.HTTPMiddleware(s.limiter, "http:as:geofences"),
+		validateAndFillIDs,
+	)
+	read := requireApplicationRights(ttnpb.Right_RIGHT_APPLICATION_INFO)
+	write := requireApplicationRights(ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC)
+
+	router.Handle("", read(s.handleList)).Methods(http.MethodGet)
+	router.Handle("/{geofence_id}", read(s.handleGet)).Methods(http.MethodGet)
+	router.Handle("/{geofence_id}", write(s.handleSet)).Methods(http.MethodPut)
+	router.Handle("/{geofence_id}", write(s.handleDelete)).Methods(http.MethodDelete)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(v) //nolint:errcheck
+}
+
+func (s *Routes) handleList(w http.ResponseWriter, r *http.Request) {
+	geofences, err := s.registry.List(r.Context(), applicationIDsFromContext(r.Context()))
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if geofences == nil {
+		geofences = []*Geofence{}
+	}
+	writeJSON(w, struct {
+		Geofences []*Geofence `json:"geofences"`
+	}{
+		Geofences: geofences,
+	})
+}
+
+func (s *Routes) handleGet(w http.ResponseWriter, r *http.Request) {
+	g, err := s.registry.Get(r.Context(), applicationIDsFromContext(r.Context()), mux.Vars(r)["geofence_id"])
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	writeJSON(w, g)
+}
+
+func (s *Routes) handleSet(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["geofence_id"]
+	body, err := stdio.ReadAll(stdio.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		webhandlers.Error(w, r, errReadBody.WithCause(err))
+		return
+	}
+	update := &Geofence{}
+	if err := json.Unmarshal(body, update); err != nil {
+		webhandlers.Error(w, r, errDecodeBody.WithCause(err))
+		return
+	}
+	update.ID = id
+	if err := update.Validate(); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	g, err := s.registry.Set(r.Context(), applicationIDsFromContext(r.Context()), id,
+		func(stored *Geofence) (*Geofence, error) {
+			now := time.Now().UTC()
+			update.CreatedAt, update.UpdatedAt = now, now
+			if stored != nil {
+				update.CreatedAt = stored.CreatedAt
+			}
+			return update, nil
+		},
+	)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	writeJSON(w, g)
+}
+
+func (s *Routes) handleDelete(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["geofence_id"]
+	if _, err := s.registry.Set(r.Context(), applicationIDsFromContext(r.Context()), id,
+		func(stored *Geofence) (*Geofence, error) {
+			if stored == nil {
+				return nil, errGeofenceNotFound.WithAttributes("id", id)
+			}
+			return nil, nil
+		},
+	); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
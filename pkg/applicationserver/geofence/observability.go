@@ -0,0 +1,58 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geofence
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtEnter = events.Define(
+		"as.geofence.enter", "enter geofence",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationServiceData{}),
+		events.WithPropagateToParent(),
+	)
+	evtExit = events.Define(
+		"as.geofence.exit", "exit geofence",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationServiceData{}),
+		events.WithPropagateToParent(),
+	)
+	evtDwell = events.Define(
+		"as.geofence.dwell", "dwell in geofence",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationServiceData{}),
+		events.WithPropagateToParent(),
+	)
+)
+
+func registerNotification(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, n *Notification) {
+	var def events.Builder
+	switch n.Trigger {
+	case TriggerEnter:
+		def = evtEnter
+	case TriggerExit:
+		def = evtExit
+	case TriggerDwell:
+		def = evtDwell
+	default:
+		return
+	}
+	events.Publish(def.NewWithIdentifiersAndData(ctx, ids, n.ServiceData()))
+}
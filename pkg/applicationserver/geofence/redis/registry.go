@@ -0,0 +1,259 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the geofence registries using Redis.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/geofence"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errEncode = errors.DefineInternal("encode", "encode geofence data")
+	errDecode = errors.DefineCorruption("decode", "decode geofence data")
+)
+
+// GeofenceRegistry is a Redis geofence registry.
+type GeofenceRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+}
+
+var _ geofence.Registry = (*GeofenceRegistry)(nil)
+
+// Init initializes the GeofenceRegistry.
+func (r *GeofenceRegistry) Init(ctx context.Context) error {
+	return ttnredis.InitMutex(ctx, r.Redis)
+}
+
+func (r *GeofenceRegistry) appKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+func (r *GeofenceRegistry) idKey(appUID, id string) string {
+	return r.Redis.Key("uid", appUID, id)
+}
+
+func getGeofence(ctx context.Context, c redis.Cmdable, key string) (*geofence.Geofence, error) {
+	b, err := c.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	g := &geofence.Geofence{}
+	if err := json.Unmarshal(b, g); err != nil {
+		return nil, errDecode.WithCause(err)
+	}
+	return g, nil
+}
+
+// Get implements geofence.Registry.
+func (r *GeofenceRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, id string,
+) (*geofence.Geofence, error) {
+	g, err := getGeofence(ctx, r.Redis, r.idKey(unique.ID(ctx, ids), id))
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return g, nil
+}
+
+// List implements geofence.Registry.
+func (r *GeofenceRegistry) List(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) ([]*geofence.Geofence, error) {
+	appUID := unique.ID(ctx, ids)
+	geofenceIDs, err := r.Redis.SMembers(ctx, r.appKey(appUID)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(geofenceIDs) == 0 {
+		return nil, nil
+	}
+	sort.Strings(geofenceIDs)
+	keys := make([]string, 0, len(geofenceIDs))
+	for _, id := range geofenceIDs {
+		keys = append(keys, r.idKey(appUID, id))
+	}
+	vals, err := r.Redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	geofences := make([]*geofence.Geofence, 0, len(vals))
+	for _, v := range vals {
+		s, ok := v.(string)
+		if !ok {
+			// The geofence has been deleted after the members were retrieved.
+			continue
+		}
+		g := &geofence.Geofence{}
+		if err := json.Unmarshal([]byte(s), g); err != nil {
+			return nil, errDecode.WithCause(err)
+		}
+		geofences = append(geofences, g)
+	}
+	return geofences, nil
+}
+
+// Set implements geofence.Registry.
+func (r *GeofenceRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	id string,
+	f func(*geofence.Geofence) (*geofence.Geofence, error),
+) (*geofence.Geofence, error) {
+	appUID := unique.ID(ctx, ids)
+	ik := r.idKey(appUID, id)
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var g *geofence.Geofence
+	err = ttnredis.LockedWatch(ctx, r.Redis, ik, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		stored, err := getGeofence(ctx, tx, ik)
+		if errors.Is(err, redis.Nil) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		g, err = f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && g == nil {
+			return nil
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if g == nil {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(ctx, ik)
+				p.SRem(ctx, r.appKey(appUID), id)
+				return nil
+			}
+		} else {
+			g.ID = id
+			if err := g.Validate(); err != nil {
+				return err
+			}
+			b, err := json.Marshal(g)
+			if err != nil {
+				return errEncode.WithCause(err)
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				p.Set(ctx, ik, b, 0)
+				p.SAdd(ctx, r.appKey(appUID), id)
+				return nil
+			}
+		}
+		_, err = tx.TxPipelined(ctx, pipelined)
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return g, nil
+}
+
+// DeviceStateRegistry is a Redis registry for the geofence states of end devices.
+type DeviceStateRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+	// TTL is the time to live of the states of an end device since the last update.
+	// If zero, the states do not expire.
+	TTL time.Duration
+}
+
+var _ geofence.DeviceStateRegistry = (*DeviceStateRegistry)(nil)
+
+// Init initializes the DeviceStateRegistry.
+func (r *DeviceStateRegistry) Init(ctx context.Context) error {
+	return ttnredis.InitMutex(ctx, r.Redis)
+}
+
+func (r *DeviceStateRegistry) devKey(uid string) string {
+	return r.Redis.Key("devices", uid)
+}
+
+// SetDeviceStates implements geofence.DeviceStateRegistry.
+func (r *DeviceStateRegistry) SetDeviceStates(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	f func(*geofence.DeviceStates) (*geofence.DeviceStates, error),
+) error {
+	dk := r.devKey(unique.ID(ctx, ids))
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return err
+	}
+
+	err = ttnredis.LockedWatch(ctx, r.Redis, dk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		var stored *geofence.DeviceStates
+		b, err := tx.Get(ctx, dk).Bytes()
+		switch {
+		case errors.Is(err, redis.Nil):
+		case err != nil:
+			return err
+		default:
+			stored = &geofence.DeviceStates{}
+			if err := json.Unmarshal(b, stored); err != nil {
+				return errDecode.WithCause(err)
+			}
+		}
+
+		states, err := f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && states == nil {
+			return nil
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if states == nil {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(ctx, dk)
+				return nil
+			}
+		} else {
+			b, err := json.Marshal(states)
+			if err != nil {
+				return errEncode.WithCause(err)
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				p.Set(ctx, dk, b, r.TTL)
+				return nil
+			}
+		}
+		_, err = tx.TxPipelined(ctx, pipelined)
+		return err
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
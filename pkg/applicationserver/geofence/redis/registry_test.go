@@ -0,0 +1,168 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/geofence"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGeofenceRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+
+	registry := &GeofenceRegistry{
+		Redis:   cl,
+		LockTTL: test.Delay << 10,
+	}
+	if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: "myapp"}
+	office := &geofence.Geofence{
+		ID:     "office",
+		Circle: &geofence.Circle{Center: geofence.Point{Latitude: 52.37, Longitude: 4.89}, Radius: 100},
+		Rules:  geofence.Rules{Enter: true, Exit: true},
+	}
+	yard := &geofence.Geofence{
+		ID: "yard",
+		Polygon: []geofence.Point{
+			{Latitude: 52.0, Longitude: 4.0},
+			{Latitude: 52.0, Longitude: 4.1},
+			{Latitude: 52.1, Longitude: 4.1},
+		},
+		Rules: geofence.Rules{Dwell: geofence.Duration(time.Hour)},
+	}
+
+	_, err := registry.Get(ctx, ids, "office")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	list, err := registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(list, should.BeEmpty)
+
+	for _, g := range []*geofence.Geofence{yard, office} {
+		g := g
+		stored, err := registry.Set(ctx, ids, g.ID, func(stored *geofence.Geofence) (*geofence.Geofence, error) {
+			a.So(stored, should.BeNil)
+			return g, nil
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(stored, should.Resemble, g)
+	}
+
+	// Invalid geofences are not stored.
+	_, err = registry.Set(ctx, ids, "office", func(stored *geofence.Geofence) (*geofence.Geofence, error) {
+		a.So(stored, should.Resemble, office)
+		return &geofence.Geofence{}, nil
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	stored, err := registry.Get(ctx, ids, "office")
+	a.So(err, should.BeNil)
+	a.So(stored, should.Resemble, office)
+
+	list, err = registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(list, should.Resemble, []*geofence.Geofence{office, yard})
+
+	list, err = registry.List(ctx, &ttnpb.ApplicationIdentifiers{ApplicationId: "other-app"})
+	a.So(err, should.BeNil)
+	a.So(list, should.BeEmpty)
+
+	stored, err = registry.Set(ctx, ids, "office", func(*geofence.Geofence) (*geofence.Geofence, error) {
+		return nil, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(stored, should.BeNil)
+
+	_, err = registry.Get(ctx, ids, "office")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	list, err = registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(list, should.Resemble, []*geofence.Geofence{yard})
+}
+
+func TestDeviceStateRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+
+	registry := &DeviceStateRegistry{
+		Redis:   cl,
+		LockTTL: test.Delay << 10,
+	}
+	if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "myapp"},
+		DeviceId:       "mydev",
+	}
+	enteredAt := time.Unix(1700000000, 0).UTC()
+	states := &geofence.DeviceStates{
+		Geofences: map[string]*geofence.DeviceState{
+			"yard": {Inside: true, EnteredAt: enteredAt},
+		},
+		Locations: map[string]*ttnpb.Location{
+			"user": {Latitude: 52.05, Longitude: 4.05, Source: ttnpb.LocationSource_SOURCE_REGISTRY},
+		},
+		Service: "user",
+	}
+
+	err := registry.SetDeviceStates(ctx, ids,
+		func(stored *geofence.DeviceStates) (*geofence.DeviceStates, error) {
+			a.So(stored, should.BeNil)
+			return states, nil
+		},
+	)
+	a.So(err, should.BeNil)
+
+	err = registry.SetDeviceStates(ctx, ids,
+		func(stored *geofence.DeviceStates) (*geofence.DeviceStates, error) {
+			a.So(stored, should.Resemble, states)
+			return nil, nil
+		},
+	)
+	a.So(err, should.BeNil)
+
+	err = registry.SetDeviceStates(ctx, ids,
+		func(stored *geofence.DeviceStates) (*geofence.DeviceStates, error) {
+			a.So(stored, should.BeNil)
+			return nil, nil
+		},
+	)
+	a.So(err, should.BeNil)
+}
@@ -0,0 +1,66 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geofence
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// DeviceState is the state of an end device with respect to a geofence.
+type DeviceState struct {
+	// Inside indicates whether the last known location of the end device is inside the geofence.
+	Inside bool `json:"inside"`
+	// EnteredAt is the time at which the end device entered the geofence.
+	EnteredAt time.Time `json:"entered_at,omitempty"`
+	// DwellNotified indicates whether dwelling has been notified since the end device entered the geofence.
+	DwellNotified bool `json:"dwell_notified,omitempty"`
+}
+
+// DeviceStates are the geofence states of an end device.
+type DeviceStates struct {
+	// Geofences are the states of the end device by geofence ID.
+	Geofences map[string]*DeviceState `json:"geofences,omitempty"`
+	// Locations are the last evaluated locations of the end device by location service.
+	Locations map[string]*ttnpb.Location `json:"locations,omitempty"`
+	// Service is the location service of the last evaluated location that changed.
+	Service string `json:"service,omitempty"`
+}
+
+// Registry is a registry for geofences.
+type Registry interface {
+	// Get returns the geofence by the application identifiers and geofence ID.
+	Get(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, id string) (*Geofence, error)
+	// List returns the geofences of the application.
+	List(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) ([]*Geofence, error)
+	// Set creates, updates or deletes the geofence by the application identifiers and geofence ID.
+	// If the function returns nil, the geofence is deleted.
+	Set(
+		ctx context.Context, ids *ttnpb.ApplicationIdentifiers, id string, f func(*Geofence) (*Geofence, error),
+	) (*Geofence, error)
+}
+
+// DeviceStateRegistry is a registry for the geofence states of end devices.
+type DeviceStateRegistry interface {
+	// SetDeviceStates updates the geofence states of the end device.
+	// If the function returns nil, the states are deleted.
+	SetDeviceStates(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		f func(*DeviceStates) (*DeviceStates, error),
+	) error
+}
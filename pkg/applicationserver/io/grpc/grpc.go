@@ -17,7 +17,9 @@ package grpc
 import (
 	"context"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/devicestate"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/replay"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -68,6 +70,8 @@ type impl struct {
 	mqttConfigProvider config.MQTTConfigProvider
 	processor          messageprocessors.PayloadProcessor
 	skipPayloadCrypto  SkipPayloadCryptoFunc
+	deviceStates       devicestate.Registry
+	replayer           *replay.Replayer
 }
 
 // WithMQTTConfigProvider sets the MQTT configuration provider for the gRPC frontend.
@@ -98,6 +102,20 @@ func WithSkipPayloadCrypto(f SkipPayloadCryptoFunc) Option {
 	})
 }
 
+// WithDeviceStateRegistry sets the end device state registry that will be used by the gRPC frontend.
+func WithDeviceStateRegistry(registry devicestate.Registry) Option {
+	return optionFunc(func(i *impl) {
+		i.deviceStates = registry
+	})
+}
+
+// WithReplayer sets the replayer of upstream messages that will be used by the gRPC frontend.
+func WithReplayer(replayer *replay.Replayer) Option {
+	return optionFunc(func(i *impl) {
+		i.replayer = replayer
+	})
+}
+
 // New returns a new gRPC frontend.
 func New(server io.Server, opts ...Option) ttnpb.AppAsServer {
 	i := &impl{
@@ -286,3 +304,84 @@ func (s *impl) DecodeDownlink(ctx context.Context, req *ttnpb.DecodeDownlinkRequ
 		Downlink: req.Downlink,
 	}, nil
 }
+
+var errDeviceStatesDisabled = errors.DefineFailedPrecondition(
+	"device_states_disabled", "end device states are not enabled",
+)
+
+func (s *impl) GetEndDeviceState(
+	ctx context.Context, req *ttnpb.GetApplicationEndDeviceStateRequest,
+) (*ttnpb.ApplicationEndDeviceState, error) {
+	if err := rights.RequireApplication(
+		ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+	); err != nil {
+		return nil, err
+	}
+	if s.deviceStates == nil {
+		return nil, errDeviceStatesDisabled.New()
+	}
+	return s.deviceStates.Get(ctx, req.EndDeviceIds, devicestate.Filter{
+		FPort: req.FPort,
+		Limit: int(req.Limit),
+	})
+}
+
+func (s *impl) BatchGetEndDeviceStates(
+	ctx context.Context, req *ttnpb.BatchGetApplicationEndDeviceStatesRequest,
+) (*ttnpb.ApplicationEndDeviceStates, error) {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+	); err != nil {
+		return nil, err
+	}
+	if s.deviceStates == nil {
+		return nil, errDeviceStatesDisabled.New()
+	}
+	states, err := s.deviceStates.BatchGet(ctx, req.ApplicationIds, req.DeviceIds, devicestate.Filter{
+		FPort: req.FPort,
+		Limit: int(req.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationEndDeviceStates{
+		States: states,
+	}, nil
+}
+
+var errReplayDisabled = errors.DefineFailedPrecondition(
+	"replay_disabled", "replay of upstream messages is not enabled",
+)
+
+func (s *impl) ReplayUps(
+	ctx context.Context, req *ttnpb.ReplayApplicationUpsRequest,
+) (*ttnpb.ReplayApplicationUpsResponse, error) {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+		ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+	); err != nil {
+		return nil, err
+	}
+	if s.replayer == nil {
+		return nil, errReplayDisabled.New()
+	}
+	return s.replayer.Start(ctx, req)
+}
+
+func (s *impl) CancelReplayUps(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*emptypb.Empty, error) {
+	if err := rights.RequireApplication(
+		ctx, ids,
+		ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+		ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+	); err != nil {
+		return nil, err
+	}
+	if s.replayer == nil {
+		return nil, errReplayDisabled.New()
+	}
+	if err := s.replayer.Cancel(ctx, ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/devicestate"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mock"
@@ -421,6 +422,128 @@ func TestMQTTConfig(t *testing.T) {
 	})
 }
 
+var errStateNotFound = errors.DefineNotFound("state_not_found", "state not found")
+
+type mockDeviceStateRegistry struct {
+	devicestate.Registry
+
+	filter devicestate.Filter
+	states map[string]*ttnpb.ApplicationEndDeviceState
+}
+
+func (r *mockDeviceStateRegistry) Get(
+	_ context.Context, ids *ttnpb.EndDeviceIdentifiers, filter devicestate.Filter,
+) (*ttnpb.ApplicationEndDeviceState, error) {
+	r.filter = filter
+	st, ok := r.states[ids.DeviceId]
+	if !ok {
+		return nil, errStateNotFound.New()
+	}
+	return st, nil
+}
+
+func (r *mockDeviceStateRegistry) BatchGet(
+	_ context.Context, _ *ttnpb.ApplicationIdentifiers, deviceIDs []string, filter devicestate.Filter,
+) ([]*ttnpb.ApplicationEndDeviceState, error) {
+	r.filter = filter
+	var res []*ttnpb.ApplicationEndDeviceState
+	for _, deviceID := range deviceIDs {
+		if st, ok := r.states[deviceID]; ok {
+			res = append(res, st)
+		}
+	}
+	return res, nil
+}
+
+func TestEndDeviceStates(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	is, isAddr, closeIS := mockis.New(ctx)
+	defer closeIS()
+	is.ApplicationRegistry().Add(ctx, registeredApplicationID, registeredApplicationKey, testRights...)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: registeredApplicationID,
+		DeviceId:       "dev1",
+	}
+	st := &ttnpb.ApplicationEndDeviceState{
+		EndDeviceIds: ids,
+		Uplinks: map[uint32]*ttnpb.ApplicationEndDeviceState_Uplinks{
+			1: {
+				Uplinks: []*ttnpb.ApplicationUp{{
+					EndDeviceIds: ids,
+					Up: &ttnpb.ApplicationUp_UplinkMessage{
+						UplinkMessage: &ttnpb.ApplicationUplink{FPort: 1, FCnt: 42},
+					},
+				}},
+			},
+		},
+	}
+	registry := &mockDeviceStateRegistry{
+		states: map[string]*ttnpb.ApplicationEndDeviceState{"dev1": st},
+	}
+	as := mock.NewServer(c)
+	srv := New(as, WithDeviceStateRegistry(registry))
+	c.RegisterGRPC(&mockRegisterer{ctx, srv})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	client := ttnpb.NewAppAsClient(c.LoopbackConn())
+
+	creds := grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     registeredApplicationKey,
+		AllowInsecure: true,
+	})
+
+	res, err := client.GetEndDeviceState(ctx, &ttnpb.GetApplicationEndDeviceStateRequest{
+		EndDeviceIds: ids,
+		FPort:        1,
+		Limit:        10,
+	}, creds)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res, should.Resemble, st)
+	a.So(registry.filter, should.Resemble, devicestate.Filter{FPort: 1, Limit: 10})
+
+	_, err = client.GetEndDeviceState(ctx, &ttnpb.GetApplicationEndDeviceStateRequest{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: registeredApplicationID,
+			DeviceId:       "dev2",
+		},
+	}, creds)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	batch, err := client.BatchGetEndDeviceStates(ctx, &ttnpb.BatchGetApplicationEndDeviceStatesRequest{
+		ApplicationIds: registeredApplicationID,
+		DeviceIds:      []string{"dev1", "dev2"},
+	}, creds)
+	if a.So(err, should.BeNil) {
+		a.So(batch.States, should.Resemble, []*ttnpb.ApplicationEndDeviceState{st})
+	}
+
+	_, err = client.GetEndDeviceState(ctx, &ttnpb.GetApplicationEndDeviceStateRequest{
+		EndDeviceIds: ids,
+	})
+	a.So(errors.IsUnauthenticated(err), should.BeTrue)
+}
+
 func TestSimulateUplink(t *testing.T) {
 	t.Parallel()
 	a := assertions.New(t)
@@ -172,7 +172,11 @@ func (s *Subscription) Protocol() string { return s.protocol }
 func (s *Subscription) ApplicationIDs() *ttnpb.ApplicationIdentifiers { return s.ids }
 
 // Publish publishes an upstream message.
+// Replayed upstream messages are only published if the subscription protocol matches the replay target.
 func (s *Subscription) Publish(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	if replay := up.GetReplay(); replay != nil && ReplayTargetProtocol(replay.Target) != s.protocol {
+		return nil
+	}
 	ctxUp := &ContextualApplicationUp{
 		Context:       ctx,
 		ApplicationUp: up,
@@ -0,0 +1,155 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayglsv1
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	minGatewaysField      = "min_gateways"
+	tdoaField             = "tdoa"
+	pathLossExponentField = "path_loss_exponent"
+	referenceRSSIField    = "reference_rssi"
+)
+
+const (
+	defaultMinGateways = 1
+	defaultTDOA        = true
+	// defaultPathLossExponent is a typical path loss exponent for suburban environments.
+	defaultPathLossExponent = 3.0
+	// defaultReferenceRSSI corresponds to a 14 dBm transmitter and the free space path loss at 868 MHz over one meter.
+	defaultReferenceRSSI = -17.0
+)
+
+// packageData contains the package configuration.
+type packageData struct {
+	MinGateways      *int
+	TDOA             *bool
+	PathLossExponent *float64
+	ReferenceRSSI    *float64
+}
+
+func numberFromValue(field string, v *structpb.Value) (float64, error) {
+	nv, ok := v.GetKind().(*structpb.Value_NumberValue)
+	if !ok {
+		return 0, errInvalidFieldType.WithAttributes(
+			"field", field,
+			"type", "number",
+		)
+	}
+	return nv.NumberValue, nil
+}
+
+func (d *packageData) fromStruct(st *structpb.Struct) error {
+	fields := st.GetFields()
+	if value, ok := fields[minGatewaysField]; ok {
+		n, err := numberFromValue(minGatewaysField, value)
+		if err != nil {
+			return err
+		}
+		minGateways := int(n)
+		d.MinGateways = &minGateways
+	}
+	if value, ok := fields[tdoaField]; ok {
+		bv, ok := value.GetKind().(*structpb.Value_BoolValue)
+		if !ok {
+			return errInvalidFieldType.WithAttributes(
+				"field", tdoaField,
+				"type", "bool",
+			)
+		}
+		d.TDOA = &bv.BoolValue
+	}
+	if value, ok := fields[pathLossExponentField]; ok {
+		n, err := numberFromValue(pathLossExponentField, value)
+		if err != nil {
+			return err
+		}
+		d.PathLossExponent = &n
+	}
+	if value, ok := fields[referenceRSSIField]; ok {
+		n, err := numberFromValue(referenceRSSIField, value)
+		if err != nil {
+			return err
+		}
+		d.ReferenceRSSI = &n
+	}
+	return nil
+}
+
+// solverConfig returns the solver configuration with the defaults applied.
+func (d *packageData) solverConfig() solverConfig {
+	conf := solverConfig{
+		MinGateways:      defaultMinGateways,
+		TDOA:             defaultTDOA,
+		PathLossExponent: defaultPathLossExponent,
+		ReferenceRSSI:    defaultReferenceRSSI,
+	}
+	if d.MinGateways != nil {
+		conf.MinGateways = *d.MinGateways
+	}
+	if d.TDOA != nil {
+		conf.TDOA = *d.TDOA
+	}
+	if d.PathLossExponent != nil {
+		conf.PathLossExponent = *d.PathLossExponent
+	}
+	if d.ReferenceRSSI != nil {
+		conf.ReferenceRSSI = *d.ReferenceRSSI
+	}
+	return conf
+}
+
+func (d *packageData) validate() error {
+	if d.MinGateways != nil && *d.MinGateways < 1 {
+		return errInvalidFieldValue.WithAttributes("field", minGatewaysField)
+	}
+	if d.PathLossExponent != nil && *d.PathLossExponent <= 0 {
+		return errInvalidFieldValue.WithAttributes("field", pathLossExponentField)
+	}
+	return nil
+}
+
+func mergePackageData(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+) (*packageData, error) {
+	var defaultData, associationData packageData
+	if err := defaultData.fromStruct(def.GetData()); err != nil {
+		return nil, errPkgDataMerge.WithCause(err)
+	}
+	if err := associationData.fromStruct(assoc.GetData()); err != nil {
+		return nil, errPkgDataMerge.WithCause(err)
+	}
+	merged := defaultData
+	if associationData.MinGateways != nil {
+		merged.MinGateways = associationData.MinGateways
+	}
+	if associationData.TDOA != nil {
+		merged.TDOA = associationData.TDOA
+	}
+	if associationData.PathLossExponent != nil {
+		merged.PathLossExponent = associationData.PathLossExponent
+	}
+	if associationData.ReferenceRSSI != nil {
+		merged.ReferenceRSSI = associationData.ReferenceRSSI
+	}
+	if err := merged.validate(); err != nil {
+		return nil, err
+	}
+	return &merged, nil
+}
@@ -0,0 +1,134 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayglsv1
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestPackageDataMerge(t *testing.T) {
+	t.Parallel()
+
+	intPtr := func(v int) *int { return &v }
+	boolPtr := func(v bool) *bool { return &v }
+	floatPtr := func(v float64) *float64 { return &v }
+
+	for _, tc := range []struct {
+		Name           string
+		Default        *ttnpb.ApplicationPackageDefaultAssociation
+		Association    *ttnpb.ApplicationPackageAssociation
+		Expected       *packageData
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:     "Empty",
+			Expected: &packageData{},
+		},
+		{
+			Name: "DefaultOnly",
+			Default: &ttnpb.ApplicationPackageDefaultAssociation{
+				Data: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						minGatewaysField:      structpb.NewNumberValue(2),
+						tdoaField:             structpb.NewBoolValue(false),
+						pathLossExponentField: structpb.NewNumberValue(2.7),
+					},
+				},
+			},
+			Expected: &packageData{
+				MinGateways:      intPtr(2),
+				TDOA:             boolPtr(false),
+				PathLossExponent: floatPtr(2.7),
+			},
+		},
+		{
+			Name: "AssociationOverridesDefault",
+			Default: &ttnpb.ApplicationPackageDefaultAssociation{
+				Data: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						minGatewaysField: structpb.NewNumberValue(2),
+						tdoaField:        structpb.NewBoolValue(false),
+					},
+				},
+			},
+			Association: &ttnpb.ApplicationPackageAssociation{
+				Data: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						tdoaField:          structpb.NewBoolValue(true),
+						referenceRSSIField: structpb.NewNumberValue(-20),
+					},
+				},
+			},
+			Expected: &packageData{
+				MinGateways:   intPtr(2),
+				TDOA:          boolPtr(true),
+				ReferenceRSSI: floatPtr(-20),
+			},
+		},
+		{
+			Name: "InvalidType",
+			Association: &ttnpb.ApplicationPackageAssociation{
+				Data: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						tdoaField: structpb.NewStringValue("yes"),
+					},
+				},
+			},
+			ErrorAssertion: errPkgDataMerge.Is,
+		},
+		{
+			Name: "InvalidValue",
+			Association: &ttnpb.ApplicationPackageAssociation{
+				Data: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						minGatewaysField: structpb.NewNumberValue(0),
+					},
+				},
+			},
+			ErrorAssertion: errInvalidFieldValue.Is,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+
+			data, err := mergePackageData(tc.Default, tc.Association)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(data, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestPackageDataSolverConfig(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	a.So((&packageData{}).solverConfig(), should.Resemble, solverConfig{
+		MinGateways:      defaultMinGateways,
+		TDOA:             defaultTDOA,
+		PathLossExponent: defaultPathLossExponent,
+		ReferenceRSSI:    defaultReferenceRSSI,
+	})
+}
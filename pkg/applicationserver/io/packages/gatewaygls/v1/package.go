@@ -0,0 +1,116 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gatewayglsv1 enables self-hosted geolocation of end devices based on gateway metadata.
+package gatewayglsv1
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PackageName defines the package name.
+const PackageName = "gateway-gls-v1"
+
+// GeolocationPackage is the gateway metadata geolocation application package.
+// It solves the location of end devices using the locations of the gateways which received the uplinks, the signal
+// strength and, if available, the fine timestamps of the receptions.
+type GeolocationPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) HandleUp(
+	ctx context.Context,
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/gatewaygls/v1")
+	ctx = events.ContextWithCorrelationID(
+		ctx, append(
+			up.CorrelationIds,
+			fmt.Sprintf("as:packages:gatewayglsv1:%s", events.NewCorrelationID()),
+		)...,
+	)
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIds, err)
+		}
+	}()
+
+	data, err := mergePackageData(def, assoc)
+	if err != nil {
+		return err
+	}
+
+	obs := observationsFromRxMetadata(msg.RxMetadata)
+	result := solve(obs, data.solverConfig())
+	if result == nil {
+		logger.WithField("gateway_count", len(obs)).Debug("Insufficient gateway metadata to solve location")
+		return nil
+	}
+	logger.WithFields(log.Fields(
+		"gateway_count", result.Gateways,
+		"source", result.Source,
+		"accuracy", result.Accuracy,
+	)).Debug("Solved location")
+
+	return p.server.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds:   up.EndDeviceIds,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:     timestamppb.Now(),
+		Up: &ttnpb.ApplicationUp_LocationSolved{
+			LocationSolved: &ttnpb.ApplicationLocation{
+				Service:  PackageName,
+				Location: result.Location(),
+			},
+		},
+	})
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*GeolocationPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 196,
+	}
+}
+
+// New instantiates the gateway metadata geolocation package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &GeolocationPackage{
+		server:   server,
+		registry: registry,
+	}
+}
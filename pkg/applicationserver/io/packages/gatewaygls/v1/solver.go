@@ -0,0 +1,414 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayglsv1
+
+import (
+	"math"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// earthRadius is the mean radius of the Earth in meters.
+	earthRadius = 6371008.8
+	// speedOfLight is the propagation speed of radio waves in meters per nanosecond.
+	speedOfLight = 0.299792458
+	// nanosecondsPerSecond is the period of the fine timestamp counter.
+	nanosecondsPerSecond = 1e9
+
+	// maxIterations is the maximum number of Gauss-Newton iterations.
+	maxIterations = 32
+	// convergenceThreshold is the position update size, in meters, under which the solver stops iterating.
+	convergenceThreshold = 0.1
+	// minDistance is the minimum distance, in meters, used to avoid singularities close to a gateway.
+	minDistance = 1.0
+)
+
+// observation is the reception of an uplink by a single gateway antenna with a known location.
+type observation struct {
+	Latitude  float64
+	Longitude float64
+	Altitude  float64
+	RSSI      float64
+	SNR       float64
+	// FineTimestamp is the nanosecond fraction of the GPS second in which the uplink was received.
+	// It is only set if HasFineTimestamp is true.
+	FineTimestamp    uint64
+	HasFineTimestamp bool
+}
+
+// observationsFromRxMetadata returns the observations from the given metadata.
+// Metadata without a usable gateway location is skipped. If a gateway antenna is present multiple times,
+// only the observation with the highest RSSI is kept.
+func observationsFromRxMetadata(mds []*ttnpb.RxMetadata) []observation {
+	type antennaKey struct {
+		uid   string
+		index uint32
+	}
+	byAntenna := make(map[antennaKey]observation, len(mds))
+	keys := make([]antennaKey, 0, len(mds))
+	for _, md := range mds {
+		loc := md.GetLocation()
+		if loc == nil || (loc.Latitude == 0 && loc.Longitude == 0) {
+			continue
+		}
+		if md.GetPacketBroker() != nil || md.GetRelay() != nil {
+			// Forwarded and relayed metadata does not describe the path from the end device to the gateway.
+			continue
+		}
+		rssi := md.Rssi
+		if md.SignalRssi != nil {
+			rssi = md.SignalRssi.Value
+		}
+		obs := observation{
+			Latitude:         loc.Latitude,
+			Longitude:        loc.Longitude,
+			Altitude:         float64(loc.Altitude),
+			RSSI:             float64(rssi),
+			SNR:              float64(md.Snr),
+			FineTimestamp:    md.FineTimestamp,
+			HasFineTimestamp: md.FineTimestamp != 0 && md.FineTimestamp < nanosecondsPerSecond,
+		}
+		key := antennaKey{
+			uid:   md.GetGatewayIds().GetGatewayId(),
+			index: md.AntennaIndex,
+		}
+		existing, ok := byAntenna[key]
+		if !ok {
+			keys = append(keys, key)
+		}
+		if !ok || existing.RSSI < obs.RSSI {
+			byAntenna[key] = obs
+		}
+	}
+	res := make([]observation, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, byAntenna[key])
+	}
+	return res
+}
+
+// point is a position in the local tangent plane, in meters.
+type point struct {
+	X, Y float64
+}
+
+func (p point) distance(q point) float64 {
+	return math.Hypot(p.X-q.X, p.Y-q.Y)
+}
+
+// projection is an equirectangular projection centered around a reference position.
+// The approximation is accurate for the distances covered by a single LoRaWAN uplink.
+type projection struct {
+	latitude, longitude float64
+	cosLatitude         float64
+}
+
+func newProjection(latitude, longitude float64) projection {
+	return projection{
+		latitude:    latitude,
+		longitude:   longitude,
+		cosLatitude: math.Cos(latitude * math.Pi / 180),
+	}
+}
+
+func (p projection) forward(latitude, longitude float64) point {
+	return point{
+		X: (longitude - p.longitude) * math.Pi / 180 * earthRadius * p.cosLatitude,
+		Y: (latitude - p.latitude) * math.Pi / 180 * earthRadius,
+	}
+}
+
+func (p projection) inverse(pt point) (latitude, longitude float64) {
+	latitude = p.latitude + pt.Y/earthRadius*180/math.Pi
+	longitude = p.longitude + pt.X/(earthRadius*p.cosLatitude)*180/math.Pi
+	return latitude, longitude
+}
+
+// solverConfig configures the location solver.
+type solverConfig struct {
+	// MinGateways is the minimum number of gateways required to solve a location.
+	MinGateways int
+	// TDOA enables time difference of arrival solving when enough fine timestamps are available.
+	TDOA bool
+	// PathLossExponent is the exponent of the log-distance path loss model.
+	PathLossExponent float64
+	// ReferenceRSSI is the expected RSSI, in dBm, at one meter distance of the end device.
+	ReferenceRSSI float64
+}
+
+// solution is a solved location.
+type solution struct {
+	Latitude  float64
+	Longitude float64
+	Altitude  float64
+	// Accuracy is the estimated accuracy in meters.
+	Accuracy float64
+	Source   ttnpb.LocationSource
+	// Gateways is the number of gateways used to solve the location.
+	Gateways int
+}
+
+// Location returns the solution as a location.
+func (s *solution) Location() *ttnpb.Location {
+	return &ttnpb.Location{
+		Latitude:  s.Latitude,
+		Longitude: s.Longitude,
+		Altitude:  int32(math.Round(s.Altitude)),
+		Accuracy:  int32(math.Ceil(s.Accuracy)),
+		Source:    s.Source,
+	}
+}
+
+// solve estimates the location of the end device from the given observations.
+// It returns nil if the observations are insufficient.
+func solve(obs []observation, conf solverConfig) *solution {
+	if len(obs) == 0 || len(obs) < conf.MinGateways {
+		return nil
+	}
+
+	// Center the projection on the strongest gateway, which is also the TDOA reference.
+	obs = append([]observation(nil), obs...)
+	sort.SliceStable(obs, func(i, j int) bool {
+		return signalWeight(obs[i]) > signalWeight(obs[j])
+	})
+	proj := newProjection(obs[0].Latitude, obs[0].Longitude)
+	positions := make([]point, len(obs))
+	distances := make([]float64, len(obs))
+	weights := make([]float64, len(obs))
+	var altitude, weightSum float64
+	for i, o := range obs {
+		positions[i] = proj.forward(o.Latitude, o.Longitude)
+		distances[i] = estimateDistance(o.RSSI, conf)
+		weights[i] = signalWeight(o)
+		altitude += o.Altitude * weights[i]
+		weightSum += weights[i]
+	}
+	altitude /= weightSum
+
+	estimate := weightedCentroid(positions, weights)
+	source := ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION
+	var accuracy float64
+	if len(obs) < 3 {
+		// One or two circles do not resolve a position, so the centroid, biased towards the stronger gateway,
+		// is used with the estimated range as accuracy.
+		for i, d := range distances {
+			accuracy += d * weights[i]
+		}
+		accuracy /= weightSum
+	} else {
+		estimate = multilaterate(estimate, positions, distances, weights)
+		accuracy = rmsResidual(estimate, positions, distances, weights)
+	}
+
+	if conf.TDOA {
+		if tdoa, tdoaAccuracy, ok := solveTDOA(estimate, obs, positions); ok {
+			estimate, accuracy = tdoa, tdoaAccuracy
+			source = ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION
+		}
+	}
+
+	latitude, longitude := proj.inverse(estimate)
+	return &solution{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Altitude:  altitude,
+		Accuracy:  math.Max(accuracy, minDistance),
+		Source:    source,
+		Gateways:  len(obs),
+	}
+}
+
+// estimateDistance estimates the distance in meters based on the log-distance path loss model.
+func estimateDistance(rssi float64, conf solverConfig) float64 {
+	return math.Max(math.Pow(10, (conf.ReferenceRSSI-rssi)/(10*conf.PathLossExponent)), minDistance)
+}
+
+// signalWeight returns the relative weight of the observation.
+// Observations with stronger signal and higher SNR are weighted more, as their distance estimates are more reliable.
+func signalWeight(o observation) float64 {
+	snr := math.Min(math.Max(o.SNR, -20), 10)
+	return math.Pow(10, o.RSSI/20) * (1 + (snr+20)/10)
+}
+
+func weightedCentroid(positions []point, weights []float64) point {
+	var c point
+	var sum float64
+	for i, p := range positions {
+		c.X += p.X * weights[i]
+		c.Y += p.Y * weights[i]
+		sum += weights[i]
+	}
+	c.X /= sum
+	c.Y /= sum
+	return c
+}
+
+// normalizedWeights returns the weights scaled to a maximum of 1, such that the Gauss-Newton system remains well
+// conditioned regardless of the absolute signal levels.
+func normalizedWeights(weights []float64) []float64 {
+	var max float64
+	for _, w := range weights {
+		max = math.Max(max, w)
+	}
+	res := make([]float64, len(weights))
+	for i, w := range weights {
+		res[i] = w / max
+	}
+	return res
+}
+
+func rmsResidual(estimate point, positions []point, distances, weights []float64) float64 {
+	weights = normalizedWeights(weights)
+	var sum, weightSum float64
+	for i, p := range positions {
+		r := estimate.distance(p) - distances[i]
+		sum += weights[i] * r * r
+		weightSum += weights[i]
+	}
+	return math.Sqrt(sum / weightSum)
+}
+
+// solve2x2 solves the normal equations of a two-dimensional least squares problem.
+func solve2x2(a11, a12, a22, b1, b2 float64) (dx, dy float64, ok bool) {
+	det := a11*a22 - a12*a12
+	if math.Abs(det) < 1e-12 {
+		return 0, 0, false
+	}
+	return (a22*b1 - a12*b2) / det, (a11*b2 - a12*b1) / det, true
+}
+
+// multilaterate minimizes the weighted squared difference between the distances from the estimate to the gateways
+// and the estimated distances using the Gauss-Newton method.
+func multilaterate(estimate point, positions []point, distances, weights []float64) point {
+	weights = normalizedWeights(weights)
+	for i := 0; i < maxIterations; i++ {
+		var a11, a12, a22, b1, b2 float64
+		for j, p := range positions {
+			d := math.Max(estimate.distance(p), minDistance)
+			jx, jy := (estimate.X-p.X)/d, (estimate.Y-p.Y)/d
+			r := distances[j] - d
+			w := weights[j]
+			a11 += w * jx * jx
+			a12 += w * jx * jy
+			a22 += w * jy * jy
+			b1 += w * jx * r
+			b2 += w * jy * r
+		}
+		dx, dy, ok := solve2x2(a11, a12, a22, b1, b2)
+		if !ok {
+			break
+		}
+		estimate.X += dx
+		estimate.Y += dy
+		if math.Hypot(dx, dy) < convergenceThreshold {
+			break
+		}
+	}
+	return estimate
+}
+
+// fineTimestampDifference returns the difference t - ref in nanoseconds, accounting for the wrap around of the
+// fine timestamp at the GPS second boundary.
+func fineTimestampDifference(t, ref uint64) float64 {
+	diff := float64(t) - float64(ref)
+	switch {
+	case diff > nanosecondsPerSecond/2:
+		diff -= nanosecondsPerSecond
+	case diff < -nanosecondsPerSecond/2:
+		diff += nanosecondsPerSecond
+	}
+	return diff
+}
+
+// solveTDOA solves the location based on the time difference of arrival of the uplink at the gateways with
+// fine timestamps. The reference gateway is the gateway with the strongest signal that has a fine timestamp.
+// At least three gateways with fine timestamps are required.
+func solveTDOA(initial point, obs []observation, positions []point) (point, float64, bool) {
+	var (
+		ref     = -1
+		indices []int
+	)
+	for i, o := range obs {
+		if !o.HasFineTimestamp {
+			continue
+		}
+		if ref < 0 {
+			ref = i
+			continue
+		}
+		indices = append(indices, i)
+	}
+	if ref < 0 || len(indices) < 2 {
+		return point{}, 0, false
+	}
+	rangeDiffs := make([]float64, len(indices))
+	for i, j := range indices {
+		rangeDiffs[i] = fineTimestampDifference(obs[j].FineTimestamp, obs[ref].FineTimestamp) * speedOfLight
+	}
+	residuals := func(estimate point) []float64 {
+		dRef := estimate.distance(positions[ref])
+		res := make([]float64, len(indices))
+		for i, j := range indices {
+			res[i] = rangeDiffs[i] - (estimate.distance(positions[j]) - dRef)
+		}
+		return res
+	}
+
+	estimate := initial
+	for i := 0; i < maxIterations; i++ {
+		dRef := math.Max(estimate.distance(positions[ref]), minDistance)
+		refX, refY := (estimate.X-positions[ref].X)/dRef, (estimate.Y-positions[ref].Y)/dRef
+		var a11, a12, a22, b1, b2 float64
+		for k, r := range residuals(estimate) {
+			p := positions[indices[k]]
+			d := math.Max(estimate.distance(p), minDistance)
+			jx, jy := (estimate.X-p.X)/d-refX, (estimate.Y-p.Y)/d-refY
+			a11 += jx * jx
+			a12 += jx * jy
+			a22 += jy * jy
+			b1 += jx * r
+			b2 += jy * r
+		}
+		dx, dy, ok := solve2x2(a11, a12, a22, b1, b2)
+		if !ok {
+			return point{}, 0, false
+		}
+		estimate.X += dx
+		estimate.Y += dy
+		if math.Hypot(dx, dy) < convergenceThreshold {
+			break
+		}
+	}
+	if math.IsNaN(estimate.X) || math.IsNaN(estimate.Y) {
+		return point{}, 0, false
+	}
+
+	var sum float64
+	for _, r := range residuals(estimate) {
+		sum += r * r
+	}
+	accuracy := math.Sqrt(sum / float64(len(indices)))
+	if len(indices) == 2 {
+		// With exactly three gateways, the system is fully determined and the residual does not reflect the
+		// timestamp uncertainty.
+		accuracy = math.Max(accuracy, fineTimestampUncertainty*speedOfLight)
+	}
+	return estimate, accuracy, true
+}
+
+// fineTimestampUncertainty is the typical uncertainty of gateway fine timestamps in nanoseconds.
+const fineTimestampUncertainty = 50
@@ -0,0 +1,209 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayglsv1
+
+import (
+	"math"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var testSolverConfig = solverConfig{
+	MinGateways:      1,
+	TDOA:             true,
+	PathLossExponent: defaultPathLossExponent,
+	ReferenceRSSI:    defaultReferenceRSSI,
+}
+
+// makeObservations returns ideal observations of an end device at the given position by gateways at the given
+// positions, relative to the projection.
+func makeObservations(proj projection, device point, gateways []point, fineTimestamps bool) []observation {
+	obs := make([]observation, 0, len(gateways))
+	for _, gtw := range gateways {
+		d := device.distance(gtw)
+		latitude, longitude := proj.inverse(gtw)
+		o := observation{
+			Latitude:  latitude,
+			Longitude: longitude,
+			RSSI:      testSolverConfig.ReferenceRSSI - 10*testSolverConfig.PathLossExponent*math.Log10(d),
+			SNR:       5,
+		}
+		if fineTimestamps {
+			o.FineTimestamp = uint64(999_990_000+d/speedOfLight) % nanosecondsPerSecond
+			o.HasFineTimestamp = true
+		}
+		obs = append(obs, o)
+	}
+	return obs
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+
+	proj := newProjection(52.3676, 4.9041)
+	device := point{X: 1200, Y: -800}
+	gateways := []point{
+		{X: 0, Y: 0},
+		{X: 3000, Y: 0},
+		{X: 0, Y: -3000},
+		{X: 2500, Y: -2500},
+	}
+
+	for _, tc := range []struct {
+		Name         string
+		Observations []observation
+		Config       solverConfig
+		MaxError     float64
+		Source       ttnpb.LocationSource
+	}{
+		{
+			Name:         "RSSI/SingleGateway",
+			Observations: makeObservations(proj, device, gateways[:1], false),
+			Config:       testSolverConfig,
+			MaxError:     1500,
+			Source:       ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION,
+		},
+		{
+			Name:         "RSSI/FourGateways",
+			Observations: makeObservations(proj, device, gateways, false),
+			Config:       testSolverConfig,
+			MaxError:     10,
+			Source:       ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION,
+		},
+		{
+			Name:         "TDOA/FourGateways",
+			Observations: makeObservations(proj, device, gateways, true),
+			Config:       testSolverConfig,
+			MaxError:     10,
+			Source:       ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION,
+		},
+		{
+			Name:         "TDOA/Disabled",
+			Observations: makeObservations(proj, device, gateways, true),
+			Config: func() solverConfig {
+				conf := testSolverConfig
+				conf.TDOA = false
+				return conf
+			}(),
+			MaxError: 10,
+			Source:   ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION,
+		},
+		{
+			Name:         "TDOA/InsufficientTimestamps",
+			Observations: append(makeObservations(proj, device, gateways[:2], true), makeObservations(proj, device, gateways[2:], false)...),
+			Config:       testSolverConfig,
+			MaxError:     10,
+			Source:       ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+
+			res := solve(tc.Observations, tc.Config)
+			if !a.So(res, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(res.Source, should.Equal, tc.Source)
+			a.So(res.Gateways, should.Equal, len(tc.Observations))
+			a.So(proj.forward(res.Latitude, res.Longitude).distance(device), should.BeLessThan, tc.MaxError)
+			a.So(res.Accuracy, should.BeGreaterThanOrEqualTo, minDistance)
+		})
+	}
+}
+
+func TestSolveInsufficient(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	proj := newProjection(52.3676, 4.9041)
+	obs := makeObservations(proj, point{X: 100, Y: 100}, []point{{X: 0, Y: 0}, {X: 1000, Y: 0}}, false)
+
+	a.So(solve(nil, testSolverConfig), should.BeNil)
+	conf := testSolverConfig
+	conf.MinGateways = 3
+	a.So(solve(obs, conf), should.BeNil)
+}
+
+func TestObservationsFromRxMetadata(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	obs := observationsFromRxMetadata([]*ttnpb.RxMetadata{
+		{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"},
+			Rssi:       -100,
+			Snr:        5,
+			Location:   &ttnpb.Location{Latitude: 52.1, Longitude: 4.1},
+		},
+		{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"},
+			Rssi:       -90,
+			SignalRssi: wrapperspb.Float(-91),
+			Snr:        7,
+			Location:   &ttnpb.Location{Latitude: 52.1, Longitude: 4.1},
+		},
+		{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"},
+			Rssi:       -110,
+		},
+		{
+			GatewayIds:    &ttnpb.GatewayIdentifiers{GatewayId: "packetbroker"},
+			Rssi:          -110,
+			Location:      &ttnpb.Location{Latitude: 52.2, Longitude: 4.2},
+			PacketBroker:  &ttnpb.PacketBrokerMetadata{},
+			FineTimestamp: 1000,
+		},
+		{
+			GatewayIds:    &ttnpb.GatewayIdentifiers{GatewayId: "gtw-3"},
+			Rssi:          -120,
+			Snr:           -5,
+			Location:      &ttnpb.Location{Latitude: 52.3, Longitude: 4.3, Altitude: 12},
+			FineTimestamp: 12345,
+		},
+	})
+	a.So(obs, should.Resemble, []observation{
+		{
+			Latitude:  52.1,
+			Longitude: 4.1,
+			RSSI:      -91,
+			SNR:       7,
+		},
+		{
+			Latitude:         52.3,
+			Longitude:        4.3,
+			Altitude:         12,
+			RSSI:             -120,
+			SNR:              -5,
+			FineTimestamp:    12345,
+			HasFineTimestamp: true,
+		},
+	})
+}
+
+func TestFineTimestampDifference(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	a.So(fineTimestampDifference(1500, 1000), should.Equal, 500)
+	a.So(fineTimestampDifference(1000, 1500), should.Equal, -500)
+	a.So(fineTimestampDifference(100, 999_999_900), should.Equal, 200)
+	a.So(fineTimestampDifference(999_999_900, 100), should.Equal, -200)
+}
@@ -131,6 +131,10 @@ func (i *integration) handleUp(ctx context.Context) {
 			logger.WithError(ctx.Err()).Debug("Done sending upstream messages")
 			return
 		case up := <-i.sub.Up():
+			if replay := up.ApplicationUp.GetReplay(); replay != nil &&
+				replay.GetTarget().GetPubSub().GetPubSubId() != i.Ids.PubSubId {
+				continue
+			}
 			var topic *pubsub.Topic
 			switch up.ApplicationUp.Up.(type) {
 			case *ttnpb.ApplicationUp_UplinkMessage:
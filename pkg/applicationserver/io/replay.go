@@ -0,0 +1,47 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewReplayUp returns a copy of the upstream message that is marked as a replay with the given replay ID, and which is
+// only delivered to the given target.
+func NewReplayUp(
+	up *ttnpb.ApplicationUp, replayID string, target *ttnpb.ApplicationUpReplayTarget,
+) *ttnpb.ApplicationUp {
+	up = proto.Clone(up).(*ttnpb.ApplicationUp)
+	up.Replay = &ttnpb.ApplicationUpReplay{
+		ReplayId: replayID,
+		Target:   target,
+	}
+	return up
+}
+
+// ReplayTargetProtocol returns the subscription protocol of the integration that is the replay target.
+func ReplayTargetProtocol(target *ttnpb.ApplicationUpReplayTarget) string {
+	switch target.GetIntegration().(type) {
+	case *ttnpb.ApplicationUpReplayTarget_Webhook_:
+		return "webhooks"
+	case *ttnpb.ApplicationUpReplayTarget_PubSub_:
+		return "pubsub"
+	case *ttnpb.ApplicationUpReplayTarget_Mqtt:
+		return "mqtt"
+	default:
+		return ""
+	}
+}
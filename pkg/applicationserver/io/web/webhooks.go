@@ -127,9 +127,13 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 	if err != nil {
 		return err
 	}
+	replay := msg.GetReplay()
 	wg := sync.WaitGroup{}
 	for i := range hooks {
 		hook := hooks[i]
+		if replay != nil && hook.Ids.WebhookId != replay.GetTarget().GetWebhook().GetWebhookId() {
+			continue
+		}
 		ctx := internal.WithWebhookData(ctx, &internal.WebhookData{
 			EndDeviceIDs: msg.EndDeviceIds,
 			WebhookIDs:   hook.Ids,
@@ -83,6 +83,12 @@ var (
 		events.WithDataType(&ttnpb.ApplicationUp{}),
 		events.WithPropagateToParent(),
 	)
+	evtForwardReplayUp = events.Define(
+		"as.up.replay.forward", "forward replayed uplink message",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationUp{}),
+		events.WithPropagateToParent(),
+	)
 	evtForwardNormalizedUp = events.Define(
 		"as.up.normalized.forward", "forward normalized uplink message",
 		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
@@ -301,6 +307,10 @@ func registerReceiveUp(ctx context.Context, msg *ttnpb.ApplicationUp) {
 	asMetrics.uplinkReceived.WithLabelValues(ctx).Inc()
 }
 
+func registerReplayUp(ctx context.Context, msg *ttnpb.ApplicationUp) {
+	events.Publish(evtForwardReplayUp.NewWithIdentifiersAndData(ctx, msg.EndDeviceIds, msg))
+}
+
 func registerForwardUp(ctx context.Context, msg *ttnpb.ApplicationUp) {
 	switch msg.Up.(type) {
 	case *ttnpb.ApplicationUp_JoinAccept:
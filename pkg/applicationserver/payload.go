@@ -85,6 +85,8 @@ func (as *ApplicationServer) encryptDownlinks(ctx context.Context, dev *ttnpb.En
 				Priority:       item.Priority,
 				CorrelationIds: item.CorrelationIds,
 				ConfirmedRetry: item.ConfirmedRetry,
+				ExpiresAt:      item.ExpiresAt,
+				Ttl:            item.Ttl,
 			}
 			if !skipPayloadCrypto {
 				if err := as.encryptDownlink(ctx, dev, session, encryptedItem, link.DefaultFormatters); err != nil {
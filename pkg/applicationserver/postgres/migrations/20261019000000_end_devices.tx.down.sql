@@ -0,0 +1 @@
+DROP TABLE IF EXISTS as_end_devices;
@@ -0,0 +1,8 @@
+CREATE TABLE as_end_devices (
+  uid        TEXT PRIMARY KEY,
+  join_eui   BYTEA,
+  dev_eui    BYTEA,
+  end_device BYTEA NOT NULL
+);
+--bun:split
+CREATE UNIQUE INDEX as_end_devices_eui_index ON as_end_devices (join_eui, dev_eui);
@@ -0,0 +1,358 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postgres implements the Application Server device registry in PostgreSQL.
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"runtime/trace"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/postgres/migrations"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/internal/registry"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errDatabaseCorruption = errors.DefineCorruption("database_corruption", "database is corrupted")
+)
+
+// rangeBatchSize is the number of devices which are read at once by Range.
+const rangeBatchSize = 1024
+
+// DeviceRegistry is a PostgreSQL device registry.
+type DeviceRegistry struct {
+	DB *sql.DB
+}
+
+// Init initializes the DeviceRegistry.
+func (r *DeviceRegistry) Init(ctx context.Context) error {
+	return store.Migrate(ctx, r.DB, migrations.Migrations, "as_device_registry_migrations")
+}
+
+func unmarshalDevice(b []byte) (*ttnpb.EndDevice, error) {
+	dev := &ttnpb.EndDevice{}
+	if err := proto.Unmarshal(b, dev); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return dev, nil
+}
+
+func euiBytes(ids *ttnpb.EndDeviceIdentifiers) (joinEUI, devEUI []byte) {
+	if ids.JoinEui == nil || ids.DevEui == nil {
+		return nil, nil
+	}
+	j, d := types.MustEUI64(ids.JoinEui).OrZero(), types.MustEUI64(ids.DevEui).OrZero()
+	return j[:], d[:]
+}
+
+// Get returns the end device by its identifiers.
+func (r *DeviceRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string,
+) (*ttnpb.EndDevice, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device").End()
+
+	var b []byte
+	if err := r.DB.QueryRowContext(ctx,
+		`SELECT end_device FROM as_end_devices WHERE uid = $1`, unique.ID(ctx, ids),
+	).Scan(&b); err != nil {
+		return nil, store.WrapDriverError(err)
+	}
+	pb, err := unmarshalDevice(b)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(pb, paths...)
+}
+
+// Set creates, updates or deletes the end device by its identifiers.
+func (r *DeviceRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	gets []string,
+	f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.EndDevice, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "set end device").End()
+
+	var pb *ttnpb.EndDevice
+	if err := store.Transact(ctx, r.DB, func(tx *sql.Tx) error {
+		if err := store.LockKey(ctx, tx, store.ApplicationServerDevicesLockNamespace, uid); err != nil {
+			return err
+		}
+		var (
+			storedBytes []byte
+			stored      *ttnpb.EndDevice
+		)
+		err := tx.QueryRowContext(ctx,
+			`SELECT end_device FROM as_end_devices WHERE uid = $1 FOR UPDATE`, uid,
+		).Scan(&storedBytes)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return store.WrapDriverError(err)
+		default:
+			if stored, err = unmarshalDevice(storedBytes); err != nil {
+				return err
+			}
+		}
+
+		if stored != nil {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(pb)
+		if err != nil {
+			return err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return errInvalidFieldmask.WithCause(err)
+		}
+
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			return err
+		}
+		if pb == nil && len(sets) == 0 {
+			_, err := tx.ExecContext(ctx, `DELETE FROM as_end_devices WHERE uid = $1`, uid)
+			return store.WrapDriverError(err)
+		}
+
+		if pb == nil {
+			pb = &ttnpb.EndDevice{}
+		}
+		if pb.Ids.ApplicationIds.ApplicationId != ids.ApplicationIds.ApplicationId || pb.Ids.DeviceId != ids.DeviceId {
+			return errInvalidIdentifiers.New()
+		}
+
+		pb.UpdatedAt = timestamppb.Now()
+		sets = append(append(sets[:0:0], sets...),
+			"updated_at",
+		)
+
+		updated := &ttnpb.EndDevice{}
+		if stored == nil {
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.device_id",
+			); err != nil {
+				return errInvalidFieldmask.WithCause(err)
+			}
+
+			pb.CreatedAt = pb.UpdatedAt
+			sets = append(sets, "created_at")
+
+			updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+			if err != nil {
+				return err
+			}
+			if updated.Ids.ApplicationIds.ApplicationId != ids.ApplicationIds.ApplicationId ||
+				updated.Ids.DeviceId != ids.DeviceId {
+				return errInvalidIdentifiers.New()
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") &&
+				pb.Ids.ApplicationIds.ApplicationId != stored.Ids.ApplicationIds.ApplicationId {
+				return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.device_id") && pb.Ids.DeviceId != stored.Ids.DeviceId {
+				return errReadOnlyField.WithAttributes("field", "ids.device_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.join_eui") && !bytes.Equal(pb.Ids.JoinEui, stored.Ids.JoinEui) {
+				return errReadOnlyField.WithAttributes("field", "ids.join_eui")
+			}
+			if ttnpb.HasAnyField(sets, "ids.dev_eui") && !bytes.Equal(pb.Ids.DevEui, stored.Ids.DevEui) {
+				return errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+			}
+			updated, err = ttnpb.ApplyEndDeviceFieldMask(proto.Clone(stored).(*ttnpb.EndDevice), pb, sets...)
+			if err != nil {
+				return err
+			}
+		}
+		if err := updated.ValidateFields(); err != nil {
+			return err
+		}
+
+		// NOTE: The EUIs are only indexed on creation, like in the Redis device registry.
+		joinEUI, devEUI := euiBytes(updated.Ids)
+		if stored != nil {
+			joinEUI, devEUI = euiBytes(stored.Ids)
+		} else if joinEUI != nil {
+			var storedUID string
+			err := tx.QueryRowContext(ctx,
+				`SELECT uid FROM as_end_devices WHERE join_eui = $1 AND dev_eui = $2`, joinEUI, devEUI,
+			).Scan(&storedUID)
+			switch {
+			case err == nil:
+				return registry.UniqueEUIViolationErr(
+					ctx,
+					types.MustEUI64(updated.Ids.JoinEui).OrZero(),
+					types.MustEUI64(updated.Ids.DevEui).OrZero(),
+					storedUID,
+				)
+			case !errors.Is(err, sql.ErrNoRows):
+				return store.WrapDriverError(err)
+			}
+		}
+
+		b, err := proto.Marshal(updated)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO as_end_devices (uid, join_eui, dev_eui, end_device)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (uid) DO UPDATE SET end_device = EXCLUDED.end_device`,
+			uid, joinEUI, devEUI, b,
+		); err != nil {
+			return store.WrapDriverError(err)
+		}
+		pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Range ranges over the end devices and calls the callback function, until false is returned.
+func (r *DeviceRegistry) Range(
+	ctx context.Context, paths []string, f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	var after string
+	for {
+		devs, lastUID, err := r.rangeBatch(ctx, after)
+		if err != nil {
+			return err
+		}
+		for _, dev := range devs {
+			dev, err := ttnpb.FilterGetEndDevice(dev, paths...)
+			if err != nil {
+				return err
+			}
+			if !f(ctx, dev.Ids, dev) {
+				return nil
+			}
+		}
+		if len(devs) < rangeBatchSize {
+			return nil
+		}
+		after = lastUID
+	}
+}
+
+func (r *DeviceRegistry) rangeBatch(ctx context.Context, after string) ([]*ttnpb.EndDevice, string, error) {
+	rows, err := r.DB.QueryContext(ctx,
+		`SELECT uid, end_device FROM as_end_devices WHERE uid > $1 ORDER BY uid LIMIT $2`, after, rangeBatchSize,
+	)
+	if err != nil {
+		return nil, "", store.WrapDriverError(err)
+	}
+	defer rows.Close()
+	var (
+		devs    []*ttnpb.EndDevice
+		lastUID string
+	)
+	for rows.Next() {
+		var b []byte
+		if err := rows.Scan(&lastUID, &b); err != nil {
+			return nil, "", store.WrapDriverError(err)
+		}
+		dev, err := unmarshalDevice(b)
+		if err != nil {
+			return nil, "", err
+		}
+		devs = append(devs, dev)
+	}
+	return devs, lastUID, store.WrapDriverError(rows.Err())
+}
+
+// BatchDelete deletes a batch of end devices in a single transaction.
+func (r *DeviceRegistry) BatchDelete(
+	ctx context.Context,
+	appIDs *ttnpb.ApplicationIdentifiers,
+	deviceIDs []string,
+) ([]*ttnpb.EndDeviceIdentifiers, error) {
+	uids := make([]string, 0, len(deviceIDs))
+	for _, devID := range deviceIDs {
+		uids = append(uids, unique.ID(ctx, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appIDs,
+			DeviceId:       devID,
+		}))
+	}
+	// NOTE: Lock the devices in a consistent order to avoid deadlocks between concurrent batch deletions.
+	sort.Strings(uids)
+
+	defer trace.StartRegion(ctx, "batch delete end device").End()
+
+	var ret []*ttnpb.EndDeviceIdentifiers
+	if err := store.Transact(ctx, r.DB, func(tx *sql.Tx) error {
+		ret = make([]*ttnpb.EndDeviceIdentifiers, 0, len(uids))
+		for _, uid := range uids {
+			if err := store.LockKey(ctx, tx, store.ApplicationServerDevicesLockNamespace, uid); err != nil {
+				return err
+			}
+			var b []byte
+			err := tx.QueryRowContext(ctx,
+				`DELETE FROM as_end_devices WHERE uid = $1 RETURNING end_device`, uid,
+			).Scan(&b)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return store.WrapDriverError(err)
+			}
+			dev, err := unmarshalDevice(b)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to decode stored end device")
+				continue
+			}
+			ret = append(ret, dev.Ids)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/postgres"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -267,6 +268,24 @@ func TestDeviceRegistry(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "PostgreSQL",
+			New: func(ctx context.Context) (DeviceRegistry, func() error, error) {
+				db, drop := test.NewPostgreSQL(ctx)
+				registry := &postgres.DeviceRegistry{
+					DB: db,
+				}
+				if err := registry.Init(ctx); err != nil {
+					drop()
+					return nil, nil, err
+				}
+				return registry, func() error {
+					drop()
+					return nil
+				}, nil
+			},
+			N: 8,
+		},
 	} {
 		for i := 0; i < int(tc.N); i++ {
 			test.RunSubtest(t, test.SubtestConfig{
@@ -0,0 +1,50 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtReplayStart = events.Define(
+		"as.replay.start", "start uplink replay",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	)
+	evtReplayFinish = events.Define(
+		"as.replay.finish", "finish uplink replay",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	)
+	evtReplayFail = events.Define(
+		"as.replay.fail", "fail uplink replay",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+)
+
+func registerStart(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) {
+	events.Publish(evtReplayStart.NewWithIdentifiersAndData(ctx, ids, nil))
+}
+
+func registerFinish(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) {
+	events.Publish(evtReplayFinish.NewWithIdentifiersAndData(ctx, ids, nil))
+}
+
+func registerFail(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, err error) {
+	events.Publish(evtReplayFail.NewWithIdentifiersAndData(ctx, ids, err))
+}
@@ -0,0 +1,214 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replay implements the replay of historical upstream messages to the integrations of the Application Server.
+package replay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errTimeRange         = errors.DefineInvalidArgument("time_range", "invalid time range")
+	errSource            = errors.DefineInvalidArgument("source", "source `{source}` not available")
+	errIntegration       = errors.DefineInvalidArgument("integration", "missing replay target integration")
+	errIntegrationID     = errors.DefineInvalidArgument("integration_id", "missing identifier of integration `{integration}`")
+	errNoUplinks         = errors.DefineNotFound("no_uplinks", "no uplinks found in time range")
+	errReplayInProgress  = errors.DefineAborted("replay_in_progress", "replay `{replay_id}` in progress")
+	errNoReplay          = errors.DefineNotFound("no_replay", "no replay in progress")
+	errTooManyMessages   = errors.DefineResourceExhausted("too_many_messages", "more than `{max}` uplinks found")
+	errReplayUnavailable = errors.DefineUnavailable("replay_unavailable", "replay unavailable")
+)
+
+func validateTarget(target *ttnpb.ApplicationUpReplayTarget) error {
+	protocol := io.ReplayTargetProtocol(target)
+	switch {
+	case protocol == "":
+		return errIntegration.New()
+	case protocol == "webhooks" && target.GetWebhook().GetWebhookId() == "",
+		protocol == "pubsub" && target.GetPubSub().GetPubSubId() == "":
+		return errIntegrationID.WithAttributes("integration", protocol)
+	}
+	return nil
+}
+
+// correlationID returns the correlation ID of the events of the given replay.
+func correlationID(replayID string) string {
+	return "as:replay:" + replayID
+}
+
+// Server represents the Application Server to the replayer.
+type Server interface {
+	task.Starter
+	FromRequestContext(context.Context) context.Context
+	// Publish publishes the upstream message to the integrations, without processing it.
+	Publish(context.Context, *ttnpb.ApplicationUp) error
+}
+
+// TargetValidator validates that the target integration exists.
+type TargetValidator func(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, target *ttnpb.ApplicationUpReplayTarget,
+) error
+
+// Config is the configuration of the replayer.
+type Config struct {
+	// Rate is the number of upstream messages replayed per second.
+	Rate float64
+	// MaxMessages is the maximum number of upstream messages in a single replay.
+	MaxMessages int
+}
+
+type activeReplay struct {
+	id     string
+	cancel context.CancelFunc
+}
+
+// Replayer replays historical upstream messages to integrations.
+// Only one replay per application can be active at a time.
+type Replayer struct {
+	server   Server
+	sources  map[ttnpb.ReplayApplicationUpsRequest_Source]Source
+	validate TargetValidator
+	config   Config
+
+	active sync.Map // Application UID to *activeReplay.
+}
+
+// NewReplayer returns a new Replayer with the given sources.
+// If validate is nil, the targets are not validated.
+func NewReplayer(
+	server Server,
+	sources map[ttnpb.ReplayApplicationUpsRequest_Source]Source,
+	validate TargetValidator,
+	config Config,
+) *Replayer {
+	return &Replayer{
+		server:   server,
+		sources:  sources,
+		validate: validate,
+		config:   config,
+	}
+}
+
+// Start fetches the upstream messages and starts replaying them in the background.
+func (r *Replayer) Start(
+	ctx context.Context, req *ttnpb.ReplayApplicationUpsRequest,
+) (*ttnpb.ReplayApplicationUpsResponse, error) {
+	startTime, endTime := req.GetStartTime().AsTime(), req.GetEndTime().AsTime()
+	if req.StartTime == nil || req.EndTime == nil || !startTime.Before(endTime) {
+		return nil, errTimeRange.New()
+	}
+	source, ok := r.sources[req.Source]
+	if !ok {
+		return nil, errSource.WithAttributes("source", req.Source.String())
+	}
+	if err := validateTarget(req.Target); err != nil {
+		return nil, err
+	}
+	if r.validate != nil {
+		if err := r.validate(ctx, req.ApplicationIds, req.Target); err != nil {
+			return nil, err
+		}
+	}
+	uid := unique.ID(ctx, req.ApplicationIds)
+	if v, ok := r.active.Load(uid); ok {
+		return nil, errReplayInProgress.WithAttributes("replay_id", v.(*activeReplay).id)
+	}
+
+	ups, err := source.Uplinks(ctx, req.ApplicationIds, req.DeviceIds, startTime, endTime, r.config.MaxMessages)
+	if err != nil {
+		return nil, err
+	}
+	if len(ups) == 0 {
+		return nil, errNoUplinks.New()
+	}
+
+	res := &ttnpb.ReplayApplicationUpsResponse{
+		ReplayId: events.NewCorrelationID(),
+		Messages: uint32(len(ups)),
+	}
+	ctx = events.ContextWithCorrelationID(ctx, correlationID(res.ReplayId))
+	taskCtx, cancel := context.WithCancel(r.server.FromRequestContext(ctx))
+	taskCtx = events.ContextWithCorrelationID(taskCtx, correlationID(res.ReplayId))
+	taskCtx = log.NewContextWithFields(taskCtx, log.Fields(
+		"application_uid", uid,
+		"replay_id", res.ReplayId,
+	))
+	if v, loaded := r.active.LoadOrStore(uid, &activeReplay{id: res.ReplayId, cancel: cancel}); loaded {
+		cancel()
+		return nil, errReplayInProgress.WithAttributes("replay_id", v.(*activeReplay).id)
+	}
+	registerStart(ctx, req.ApplicationIds)
+	r.server.StartTask(&task.Config{
+		Context: taskCtx,
+		ID:      "replay_uplinks",
+		Func: func(ctx context.Context) error {
+			defer r.active.Delete(uid)
+			defer cancel()
+			if err := r.replay(ctx, ups, res.ReplayId, req.Target); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to replay uplinks")
+				registerFail(ctx, req.ApplicationIds, err)
+				return err
+			}
+			registerFinish(ctx, req.ApplicationIds)
+			return nil
+		},
+		Restart: task.RestartNever,
+		Backoff: task.DefaultBackoffConfig,
+	})
+	return res, nil
+}
+
+// Cancel cancels the active replay of the application.
+func (r *Replayer) Cancel(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) error {
+	v, ok := r.active.Load(unique.ID(ctx, ids))
+	if !ok {
+		return errNoReplay.New()
+	}
+	v.(*activeReplay).cancel()
+	return nil
+}
+
+func (r *Replayer) replay(
+	ctx context.Context, ups []*ttnpb.ApplicationUp, replayID string, target *ttnpb.ApplicationUpReplayTarget,
+) error {
+	if r.config.Rate <= 0 {
+		return errReplayUnavailable.New()
+	}
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / r.config.Rate))
+	defer ticker.Stop()
+	for i, up := range ups {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+		}
+		if err := r.server.Publish(ctx, io.NewReplayUp(up, replayID, target)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
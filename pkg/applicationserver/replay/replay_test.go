@@ -0,0 +1,284 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/devicestate"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockServer struct {
+	tasks     []*task.Config
+	published []*ttnpb.ApplicationUp
+}
+
+func (s *mockServer) StartTask(conf *task.Config) {
+	s.tasks = append(s.tasks, conf)
+}
+
+func (*mockServer) FromRequestContext(ctx context.Context) context.Context {
+	return ctx
+}
+
+func (s *mockServer) Publish(_ context.Context, up *ttnpb.ApplicationUp) error {
+	s.published = append(s.published, up)
+	return nil
+}
+
+type mockRegistry struct {
+	devicestate.Registry
+	states []*ttnpb.ApplicationEndDeviceState
+}
+
+func (r mockRegistry) BatchGet(
+	context.Context, *ttnpb.ApplicationIdentifiers, []string, devicestate.Filter,
+) ([]*ttnpb.ApplicationEndDeviceState, error) {
+	return r.states, nil
+}
+
+type sourceFunc func(
+	context.Context, *ttnpb.ApplicationIdentifiers, []string, time.Time, time.Time, int,
+) ([]*ttnpb.ApplicationUp, error)
+
+func (f sourceFunc) Uplinks(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, deviceIDs []string, start, end time.Time, max int,
+) ([]*ttnpb.ApplicationUp, error) {
+	return f(ctx, ids, deviceIDs, start, end, max)
+}
+
+func TestNewReplayUp(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	up := &ttnpb.ApplicationUp{CorrelationIds: []string{"test"}}
+	for _, tc := range []struct {
+		Target   *ttnpb.ApplicationUpReplayTarget
+		Protocol string
+	}{
+		{
+			Target: &ttnpb.ApplicationUpReplayTarget{
+				Integration: &ttnpb.ApplicationUpReplayTarget_Webhook_{
+					Webhook: &ttnpb.ApplicationUpReplayTarget_Webhook{WebhookId: "my-hook"},
+				},
+			},
+			Protocol: "webhooks",
+		},
+		{
+			Target: &ttnpb.ApplicationUpReplayTarget{
+				Integration: &ttnpb.ApplicationUpReplayTarget_PubSub_{
+					PubSub: &ttnpb.ApplicationUpReplayTarget_PubSub{PubSubId: "my-pubsub"},
+				},
+			},
+			Protocol: "pubsub",
+		},
+		{
+			Target: &ttnpb.ApplicationUpReplayTarget{
+				Integration: &ttnpb.ApplicationUpReplayTarget_Mqtt{
+					Mqtt: &ttnpb.ApplicationUpReplayTarget_MQTT{},
+				},
+			},
+			Protocol: "mqtt",
+		},
+	} {
+		replayed := io.NewReplayUp(up, "my-replay", tc.Target)
+		a.So(up.Replay, should.BeNil)
+		a.So(replayed.CorrelationIds, should.Resemble, []string{"test"})
+		a.So(replayed.Replay, should.Resemble, &ttnpb.ApplicationUpReplay{
+			ReplayId: "my-replay",
+			Target:   tc.Target,
+		})
+		a.So(io.ReplayTargetProtocol(replayed.Replay.Target), should.Equal, tc.Protocol)
+	}
+}
+
+func TestReplayer(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "myapp"}
+	start := time.Unix(1000, 0)
+	end := start.Add(time.Hour)
+	ups := []*ttnpb.ApplicationUp{
+		{ReceivedAt: timestamppb.New(start.Add(time.Minute))},
+		{ReceivedAt: timestamppb.New(start.Add(2 * time.Minute))},
+	}
+	var (
+		gotDeviceIDs []string
+		gotMax       int
+	)
+	source := sourceFunc(func(
+		_ context.Context, _ *ttnpb.ApplicationIdentifiers, deviceIDs []string, _, _ time.Time, max int,
+	) ([]*ttnpb.ApplicationUp, error) {
+		gotDeviceIDs, gotMax = deviceIDs, max
+		return ups, nil
+	})
+	server := &mockServer{}
+	replayer := NewReplayer(server, map[ttnpb.ReplayApplicationUpsRequest_Source]Source{
+		ttnpb.ReplayApplicationUpsRequest_SOURCE_STORAGE: source,
+	}, nil, Config{
+		Rate:        1000,
+		MaxMessages: 2,
+	})
+
+	mqttTarget := &ttnpb.ApplicationUpReplayTarget{
+		Integration: &ttnpb.ApplicationUpReplayTarget_Mqtt{
+			Mqtt: &ttnpb.ApplicationUpReplayTarget_MQTT{},
+		},
+	}
+	for _, tc := range []struct {
+		Name      string
+		Request   *ttnpb.ReplayApplicationUpsRequest
+		Assertion func(error) bool
+	}{
+		{
+			Name: "InvalidTimeRange",
+			Request: &ttnpb.ReplayApplicationUpsRequest{
+				ApplicationIds: appIDs,
+				Source:         ttnpb.ReplayApplicationUpsRequest_SOURCE_STORAGE,
+				Target:         mqttTarget,
+				StartTime:      timestamppb.New(end),
+				EndTime:        timestamppb.New(start),
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "UnavailableSource",
+			Request: &ttnpb.ReplayApplicationUpsRequest{
+				ApplicationIds: appIDs,
+				Source:         ttnpb.ReplayApplicationUpsRequest_SOURCE_EVENTS,
+				Target:         mqttTarget,
+				StartTime:      timestamppb.New(start),
+				EndTime:        timestamppb.New(end),
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "MissingTarget",
+			Request: &ttnpb.ReplayApplicationUpsRequest{
+				ApplicationIds: appIDs,
+				Source:         ttnpb.ReplayApplicationUpsRequest_SOURCE_STORAGE,
+				StartTime:      timestamppb.New(start),
+				EndTime:        timestamppb.New(end),
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "MissingWebhookID",
+			Request: &ttnpb.ReplayApplicationUpsRequest{
+				ApplicationIds: appIDs,
+				Source:         ttnpb.ReplayApplicationUpsRequest_SOURCE_STORAGE,
+				Target: &ttnpb.ApplicationUpReplayTarget{
+					Integration: &ttnpb.ApplicationUpReplayTarget_Webhook_{
+						Webhook: &ttnpb.ApplicationUpReplayTarget_Webhook{},
+					},
+				},
+				StartTime: timestamppb.New(start),
+				EndTime:   timestamppb.New(end),
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+	} {
+		_, err := replayer.Start(ctx, tc.Request)
+		if !a.So(tc.Assertion(err), should.BeTrue) {
+			t.Fatalf("%s: unexpected error: %v", tc.Name, err)
+		}
+	}
+
+	target := &ttnpb.ApplicationUpReplayTarget{
+		Integration: &ttnpb.ApplicationUpReplayTarget_Webhook_{
+			Webhook: &ttnpb.ApplicationUpReplayTarget_Webhook{WebhookId: "my-hook"},
+		},
+	}
+	req := &ttnpb.ReplayApplicationUpsRequest{
+		ApplicationIds: appIDs,
+		DeviceIds:      []string{"mydev"},
+		Source:         ttnpb.ReplayApplicationUpsRequest_SOURCE_STORAGE,
+		Target:         target,
+		StartTime:      timestamppb.New(start),
+		EndTime:        timestamppb.New(end),
+	}
+	res, err := replayer.Start(ctx, req)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.Messages, should.Equal, 2)
+	a.So(gotDeviceIDs, should.Resemble, []string{"mydev"})
+	a.So(gotMax, should.Equal, 2)
+	if !a.So(server.tasks, should.HaveLength, 1) {
+		t.FailNow()
+	}
+
+	// Only one replay per application can be active.
+	_, err = replayer.Start(ctx, req)
+	a.So(errors.IsAborted(err), should.BeTrue)
+
+	a.So(server.tasks[0].Func(server.tasks[0].Context), should.BeNil)
+	if a.So(server.published, should.HaveLength, 2) {
+		for _, up := range server.published {
+			a.So(up.Replay, should.Resemble, &ttnpb.ApplicationUpReplay{
+				ReplayId: res.ReplayId,
+				Target:   target,
+			})
+		}
+	}
+	a.So(ups[0].Replay, should.BeNil)
+	a.So(replayer.Cancel(ctx, appIDs), should.NotBeNil)
+}
+
+func TestStorageSourceMaxMessages(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "myapp"}
+	start := time.Unix(1000, 0)
+	end := start.Add(time.Hour)
+	newUp := func(d time.Duration) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{ReceivedAt: timestamppb.New(start.Add(d))}
+	}
+	source := StorageSource{
+		Registry: mockRegistry{
+			states: []*ttnpb.ApplicationEndDeviceState{
+				{
+					Uplinks: map[uint32]*ttnpb.ApplicationEndDeviceState_Uplinks{
+						1: {Uplinks: []*ttnpb.ApplicationUp{newUp(3 * time.Minute), newUp(time.Minute)}},
+					},
+					LastNormalized: newUp(2 * time.Hour),
+				},
+				{
+					LastNormalized: newUp(2 * time.Minute),
+				},
+			},
+		},
+	}
+
+	ups, err := source.Uplinks(ctx, appIDs, nil, start, end, 3)
+	if a.So(err, should.BeNil) && a.So(ups, should.HaveLength, 3) {
+		a.So(ups[0].ReceivedAt.AsTime(), should.Equal, start.Add(time.Minute))
+		a.So(ups[2].ReceivedAt.AsTime(), should.Equal, start.Add(3*time.Minute))
+	}
+
+	_, err = source.Uplinks(ctx, appIDs, nil, start, end, 2)
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+}
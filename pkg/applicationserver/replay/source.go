@@ -0,0 +1,154 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replay
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/devicestate"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Source is a source of historical upstream messages.
+type Source interface {
+	// Uplinks returns the upstream messages of the application received in the given time range.
+	// If device IDs are given, only the upstream messages of these end devices are returned.
+	// If max is positive and more than max upstream messages are found, Uplinks stops fetching and returns an error.
+	Uplinks(
+		ctx context.Context, ids *ttnpb.ApplicationIdentifiers, deviceIDs []string, start, end time.Time, max int,
+	) ([]*ttnpb.ApplicationUp, error)
+}
+
+// uplinkCollector collects the upstream messages in a time range, up to a maximum.
+type uplinkCollector struct {
+	start, end time.Time
+	max        int
+	ups        []*ttnpb.ApplicationUp
+}
+
+// add adds the upstream message if it is received in the time range.
+// It returns an error if the maximum number of upstream messages is exceeded.
+func (c *uplinkCollector) add(up *ttnpb.ApplicationUp) error {
+	if up == nil {
+		return nil
+	}
+	return c.addAt(up, up.GetReceivedAt().AsTime())
+}
+
+// addAt is like add, but considers the upstream message received at the given time.
+func (c *uplinkCollector) addAt(up *ttnpb.ApplicationUp, receivedAt time.Time) error {
+	if !inRange(receivedAt, c.start, c.end) {
+		return nil
+	}
+	if c.max > 0 && len(c.ups) >= c.max {
+		return errTooManyMessages.WithAttributes("max", c.max)
+	}
+	c.ups = append(c.ups, up)
+	return nil
+}
+
+func inRange(t, start, end time.Time) bool {
+	return !t.Before(start) && !t.After(end)
+}
+
+func sortByReceivedAt(ups []*ttnpb.ApplicationUp) {
+	sort.SliceStable(ups, func(i, j int) bool {
+		return ups[i].GetReceivedAt().AsTime().Before(ups[j].GetReceivedAt().AsTime())
+	})
+}
+
+// StorageSource is a Source of the upstream messages stored in the device state registry.
+type StorageSource struct {
+	Registry devicestate.Registry
+}
+
+// Uplinks implements Source.
+func (s StorageSource) Uplinks(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, deviceIDs []string, start, end time.Time, max int,
+) ([]*ttnpb.ApplicationUp, error) {
+	states, err := s.Registry.BatchGet(ctx, ids, deviceIDs, devicestate.Filter{})
+	if err != nil {
+		return nil, err
+	}
+	c := &uplinkCollector{start: start, end: end, max: max}
+	for _, st := range states {
+		for _, fPortUps := range st.Uplinks {
+			for _, up := range fPortUps.Uplinks {
+				if err := c.add(up); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if err := c.add(st.LastNormalized); err != nil {
+			return nil, err
+		}
+	}
+	sortByReceivedAt(c.ups)
+	return c.ups, nil
+}
+
+// eventNames are the names of the events that contain the upstream messages forwarded to the integrations.
+var eventNames = []string{
+	"as.up.data.forward",
+	"as.up.normalized.forward",
+}
+
+// EventsSource is a Source of the upstream messages in the events store.
+type EventsSource struct {
+	Subscriber events.SubscriberWithHistory
+}
+
+// Uplinks implements Source.
+// Upstream messages that were replayed before are not returned.
+func (s EventsSource) Uplinks(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, deviceIDs []string, start, end time.Time, max int,
+) ([]*ttnpb.ApplicationUp, error) {
+	var entityIDs []*ttnpb.EntityIdentifiers
+	if len(deviceIDs) == 0 {
+		entityIDs = append(entityIDs, ids.GetEntityIdentifiers())
+	}
+	for _, deviceID := range deviceIDs {
+		entityIDs = append(entityIDs, (&ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: ids,
+			DeviceId:       deviceID,
+		}).GetEntityIdentifiers())
+	}
+	evts, err := s.Subscriber.FetchHistory(ctx, eventNames, entityIDs, &start, 0)
+	if err != nil {
+		return nil, err
+	}
+	c := &uplinkCollector{start: start, end: end, max: max}
+	for _, evt := range evts {
+		if evt.Time().After(end) {
+			continue
+		}
+		up, ok := evt.Data().(*ttnpb.ApplicationUp)
+		if !ok || up.GetReplay() != nil {
+			continue
+		}
+		receivedAt := evt.Time()
+		if up.ReceivedAt != nil {
+			receivedAt = up.ReceivedAt.AsTime()
+		}
+		if err := c.addAt(up, receivedAt); err != nil {
+			return nil, err
+		}
+	}
+	sortByReceivedAt(c.ups)
+	return c.ups, nil
+}
@@ -0,0 +1,113 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shadow
+
+import (
+	"context"
+	"fmt"
+	"runtime/trace"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	js "go.thethings.network/lorawan-stack/v3/pkg/scripting/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errEncoderOutput       = errors.Define("encoder_output", "invalid encoder output")
+	errEncoderOutputErrors = errors.DefineAborted("encoder_output_errors", "encoder output errors: {errors}")
+	errEncoderFPort        = errors.DefineInvalidArgument("encoder_f_port", "invalid encoder output FPort `{f_port}`")
+)
+
+type encodeDeltaInput struct {
+	Delta    map[string]any `json:"delta"`
+	Desired  map[string]any `json:"desired"`
+	Reported map[string]any `json:"reported"`
+}
+
+type encodeDeltaOutput struct {
+	Bytes     []uint8  `json:"bytes"`
+	FPort     *uint8   `json:"fPort"`
+	Confirmed bool     `json:"confirmed"`
+	Warnings  []string `json:"warnings"`
+	Errors    []string `json:"errors"`
+	Skip      bool     `json:"skip"`
+}
+
+func wrapDeltaEncoderScript(script string) string {
+	return fmt.Sprintf(`
+		%s
+
+		function main(input) {
+			const result = encodeDelta(input);
+			if (result === undefined || result === null) {
+				return { skip: true };
+			}
+			return result;
+		}
+	`, script)
+}
+
+// Encoder encodes shadow deltas to downlink messages using JavaScript encoders.
+type Encoder struct {
+	engine scripting.Engine
+}
+
+// NewEncoder returns a new Encoder.
+func NewEncoder() *Encoder {
+	return &Encoder{
+		engine: js.New(scripting.DefaultOptions),
+	}
+}
+
+// Encode runs the encoder script with the delta of the shadow.
+// If the script returns null, Encode returns nil.
+func (e *Encoder) Encode(ctx context.Context, script string, shadow *Shadow) (*ttnpb.ApplicationDownlink, error) {
+	defer trace.StartRegion(ctx, "encode shadow delta").End()
+
+	input := encodeDeltaInput{
+		Delta:    shadow.Delta(),
+		Desired:  shadow.Desired,
+		Reported: shadow.Reported,
+	}
+	valueAs, err := e.engine.Run(ctx, wrapDeltaEncoderScript(script), "main", input)
+	if err != nil {
+		return nil, err
+	}
+	var output encodeDeltaOutput
+	if err := valueAs(&output); err != nil {
+		return nil, errEncoderOutput.WithCause(err)
+	}
+	if output.Skip {
+		return nil, nil
+	}
+	if len(output.Errors) > 0 {
+		return nil, errEncoderOutputErrors.WithAttributes("errors", strings.Join(output.Errors, ", "))
+	}
+	fPort := uint32(1)
+	if output.FPort != nil {
+		fPort = uint32(*output.FPort)
+	}
+	if fPort == 0 || fPort > 223 {
+		return nil, errEncoderFPort.WithAttributes("f_port", fPort)
+	}
+	return &ttnpb.ApplicationDownlink{
+		FPort:                  fPort,
+		FrmPayload:             output.Bytes,
+		Confirmed:              output.Confirmed,
+		DecodedPayloadWarnings: output.Warnings,
+	}, nil
+}
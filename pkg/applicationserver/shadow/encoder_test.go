@@ -0,0 +1,137 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shadow
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestEncoder(t *testing.T) {
+	t.Parallel()
+	shadow := &Shadow{
+		Desired:  Document{"led": "on", "interval": 60.0},
+		Reported: Document{"led": "off", "interval": 60.0},
+	}
+	for _, tc := range []struct {
+		Name           string
+		Script         string
+		Shadow         *Shadow
+		Expected       *ttnpb.ApplicationDownlink
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Encode",
+			Script: `
+				function encodeDelta(input) {
+					return {
+						fPort: 10,
+						bytes: [input.delta.led === "on" ? 1 : 0, input.reported.interval],
+						confirmed: true,
+						warnings: ["led only"],
+					};
+				}
+			`,
+			Shadow: shadow,
+			Expected: &ttnpb.ApplicationDownlink{
+				FPort:                  10,
+				FrmPayload:             []byte{1, 60},
+				Confirmed:              true,
+				DecodedPayloadWarnings: []string{"led only"},
+			},
+		},
+		{
+			Name: "DefaultFPort",
+			Script: `
+				function encodeDelta(input) {
+					return { bytes: [1] };
+				}
+			`,
+			Shadow: shadow,
+			Expected: &ttnpb.ApplicationDownlink{
+				FPort:      1,
+				FrmPayload: []byte{1},
+			},
+		},
+		{
+			Name: "Skip",
+			Script: `
+				function encodeDelta(input) {
+					return null;
+				}
+			`,
+			Shadow: shadow,
+		},
+		{
+			Name: "SkipUndefined",
+			Script: `
+				function encodeDelta(input) {}
+			`,
+			Shadow: shadow,
+		},
+		{
+			Name: "EmptyDocuments",
+			Script: `
+				function encodeDelta(input) {
+					return { bytes: [Object.keys(input.delta || {}).length] };
+				}
+			`,
+			Shadow: &Shadow{},
+			Expected: &ttnpb.ApplicationDownlink{
+				FPort:      1,
+				FrmPayload: []byte{0},
+			},
+		},
+		{
+			Name: "OutputErrors",
+			Script: `
+				function encodeDelta(input) {
+					return { errors: ["unknown led state"] };
+				}
+			`,
+			Shadow:         shadow,
+			ErrorAssertion: errors.IsAborted,
+		},
+		{
+			Name: "InvalidFPort",
+			Script: `
+				function encodeDelta(input) {
+					return { fPort: 224, bytes: [1] };
+				}
+			`,
+			Shadow:         shadow,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, ctx := test.New(t)
+			down, err := NewEncoder().Encode(ctx, tc.Script, tc.Shadow)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(down, should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shadow
+
+import (
+	"context"
+	"encoding/json"
+	stdio "io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+)
+
+// maxBodySize is the maximum size of a device shadow request body.
+const maxBodySize = 1 << 16
+
+var (
+	errSettingsNotFound = errors.DefineNotFound("settings_not_found", "device shadow settings not found")
+	errReadBody         = errors.DefineCanceled("read_body", "read body")
+	errDecodeBody       = errors.DefineInvalidArgument("decode_body", "decode body")
+)
+
+// Routes exposes the device shadows over HTTP.
+type Routes struct {
+	manager *Manager
+	limiter ratelimit.Interface
+}
+
+// NewRoutes returns new Routes for the given manager.
+func NewRoutes(manager *Manager, limiter ratelimit.Interface) *Routes {
+	return &Routes{
+		manager: manager,
+		limiter: limiter,
+	}
+}
+
+type applicationIDsKeyType struct{}
+
+var applicationIDsKey applicationIDsKeyType
+
+func applicationIDsFromContext(ctx context.Context) *ttnpb.ApplicationIdentifiers {
+	ids, _ := ctx.Value(applicationIDsKey).(*ttnpb.ApplicationIdentifiers)
+	return ids
+}
+
+func validateAndFillApplicationIDs(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		ids := &ttnpb.ApplicationIdentifiers{
+			ApplicationId: mux.Vars(r)["application_id"],
+		}
+		if err := ids.ValidateContext(ctx); err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, applicationIDsKey, ids)))
+	})
+}
+
+func endDeviceIDsFromRequest(r *http.Request) (*ttnpb.EndDeviceIdentifiers, error) {
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: applicationIDsFromContext(r.Context()),
+		DeviceId:       mux.Vars(r)["device_id"],
+	}
+	if err := ids.ValidateContext(r.Context()); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func requireApplicationRights(required ...ttnpb.Right) func(http.HandlerFunc) http.HandlerFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if err := rights.RequireApplication(r.Context(), applicationIDsFromContext(r.Context()), required...); err != nil {
+				webhandlers.Error(w, r, err)
+				return
+			}
+			next(w, r)
+		}
+	}
+}
+
+// RegisterRoutes implements web.Registerer.
+func (s *Routes) RegisterRoutes(server *web.Server) {
+	router := server.Prefix(ttnpb.HTTPAPIPrefix + "/as/applications/{application_id}").Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("applicationserver/shadow")),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+		ratelimit.HTTPMiddleware(s.limiter, "http:as:shadows"),
+		validateAndFillApplicationIDs,
+	)
+	readDevices := requireApplicationRights(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ)
+	writeDevices := requireApplicationRights(ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE)
+	readSettings := requireApplicationRights(ttnpb.Right_RIGHT_APPLICATION_INFO)
+	writeSettings := requireApplicationRights(ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC)
+
+	router.Handle("/shadow-settings", readSettings(s.handleGetSettings)).Methods(http.MethodGet)
+	router.Handle("/shadow-settings", writeSettings(s.handleSetSettings)).Methods(http.MethodPut)
+	router.Handle("/shadow-settings", writeSettings(s.handleDeleteSettings)).Methods(http.MethodDelete)
+	router.Handle("/devices/{device_id}/shadow", readDevices(s.handleGet)).Methods(http.MethodGet)
+	router.Handle("/devices/{device_id}/shadow", writeDevices(s.handleDelete)).Methods(http.MethodDelete)
+	router.Handle("/devices/{device_id}/shadow/desired", writeDevices(s.handleSetDesired)).
+		Methods(http.MethodPut, http.MethodPatch)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(v) //nolint:errcheck
+}
+
+func readJSON(r *http.Request, v any) error {
+	body, err := stdio.ReadAll(stdio.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return errReadBody.WithCause(err)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return errDecodeBody.WithCause(err)
+	}
+	return nil
+}
+
+// shadowResponse is the HTTP representation of a shadow.
+type shadowResponse struct {
+	*Shadow
+	Delta Document `json:"delta,omitempty"`
+}
+
+func writeShadow(w http.ResponseWriter, s *Shadow) {
+	writeJSON(w, shadowResponse{
+		Shadow: s,
+		Delta:  s.Delta(),
+	})
+}
+
+func (s *Routes) handleGet(w http.ResponseWriter, r *http.Request) {
+	ids, err := endDeviceIDsFromRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	sh, err := s.manager.Get(r.Context(), ids)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	writeShadow(w, sh)
+}
+
+func (s *Routes) handleDelete(w http.ResponseWriter, r *http.Request) {
+	ids, err := endDeviceIDsFromRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := s.manager.Delete(r.Context(), ids); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Routes) handleSetDesired(w http.ResponseWriter, r *http.Request) {
+	ids, err := endDeviceIDsFromRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	var desired Document
+	if err := readJSON(r, &desired); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	sh, err := s.manager.SetDesired(r.Context(), ids, desired, r.Method == http.MethodPut, time.Now().UTC())
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	writeShadow(w, sh)
+}
+
+func (s *Routes) handleGetSettings(w http.ResponseWriter, r *http.Request) {
+	settings, err := s.manager.settings.GetSettings(r.Context(), applicationIDsFromContext(r.Context()))
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	writeJSON(w, settings)
+}
+
+func (s *Routes) handleSetSettings(w http.ResponseWriter, r *http.Request) {
+	update := &Settings{}
+	if err := readJSON(r, update); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	settings, err := s.manager.settings.SetSettings(r.Context(), applicationIDsFromContext(r.Context()),
+		func(*Settings) (*Settings, error) {
+			return update, nil
+		},
+	)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	writeJSON(w, settings)
+}
+
+func (s *Routes) handleDeleteSettings(w http.ResponseWriter, r *http.Request) {
+	if _, err := s.manager.settings.SetSettings(r.Context(), applicationIDsFromContext(r.Context()),
+		func(stored *Settings) (*Settings, error) {
+			if stored == nil {
+				return nil, errSettingsNotFound.New()
+			}
+			return nil, nil
+		},
+	); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,283 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shadow
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/goproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ServiceName is the service name of device shadow delta messages in application service data messages.
+const ServiceName = "shadow"
+
+var errEncodeServiceData = errors.DefineInternal("encode_service_data", "encode service data")
+
+const correlationIDPrefix = "as:shadow"
+
+var appendCorrelationID = events.RegisterCorrelationIDPrefix("shadow", correlationIDPrefix)
+
+// Server represents the Application Server to device shadows.
+type Server interface {
+	// Publish publishes the upstream message to the integrations.
+	Publish(ctx context.Context, up *ttnpb.ApplicationUp) error
+	// DownlinkQueuePush pushes the given downlink messages to the end device's application downlink queue.
+	DownlinkQueuePush(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error
+	// DownlinkQueueReplace replaces the end device's application downlink queue with the given downlink messages.
+	DownlinkQueueReplace(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error
+	// DownlinkQueueList lists the application downlink queue of the given end device.
+	DownlinkQueueList(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlink, error)
+}
+
+// Manager manages the device shadows.
+type Manager struct {
+	server   Server
+	shadows  Registry
+	settings SettingsRegistry
+	encoder  *Encoder
+}
+
+// NewManager returns a new Manager.
+func NewManager(server Server, shadows Registry, settings SettingsRegistry) *Manager {
+	return &Manager{
+		server:   server,
+		shadows:  shadows,
+		settings: settings,
+		encoder:  NewEncoder(),
+	}
+}
+
+// Get returns the shadow of the end device.
+func (m *Manager) Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*Shadow, error) {
+	return m.shadows.Get(ctx, ids)
+}
+
+// Delete deletes the shadow of the end device.
+func (m *Manager) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	_, err := m.shadows.Set(ctx, ids, func(*Shadow) (*Shadow, error) {
+		return nil, nil
+	})
+	return err
+}
+
+// Report merges the reported state into the reported document of the end device shadow.
+func (m *Manager) Report(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, reported *structpb.Struct, at time.Time,
+) (*Shadow, error) {
+	patch, err := goproto.Map(reported)
+	if err != nil {
+		return nil, err
+	}
+	return m.update(ctx, ids, func(s *Shadow) {
+		s.Reported = Merge(s.Reported, patch)
+		s.ReportedAt = at
+	})
+}
+
+// SetDesired updates the desired document of the end device shadow.
+// If replace is true, the desired document is replaced. Otherwise, it is merged as a JSON merge patch.
+func (m *Manager) SetDesired(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, desired Document, replace bool, at time.Time,
+) (*Shadow, error) {
+	return m.update(ctx, ids, func(s *Shadow) {
+		if replace {
+			s.Desired = Merge(nil, desired)
+		} else {
+			s.Desired = Merge(s.Desired, desired)
+		}
+		s.DesiredAt = at
+	})
+}
+
+func (m *Manager) update(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, f func(*Shadow)) (*Shadow, error) {
+	var prevDelta Document
+	s, err := m.shadows.Set(ctx, ids, func(stored *Shadow) (*Shadow, error) {
+		s := &Shadow{}
+		if stored != nil {
+			*s = *stored
+		}
+		prevDelta = s.Delta()
+		f(s)
+		if stored == nil || !valuesEqual(map[string]any(stored.Reported), map[string]any(s.Reported)) ||
+			!valuesEqual(map[string]any(stored.Desired), map[string]any(s.Desired)) {
+			s.Version++
+		}
+		return s, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	delta := s.Delta()
+	changed := !valuesEqual(map[string]any(prevDelta), map[string]any(delta))
+	if changed {
+		m.publishDelta(ctx, ids, s, delta)
+	}
+	if changed || len(delta) > 0 || s.DownlinkCorrelationID != "" {
+		m.syncDownlink(ctx, ids, s, changed)
+	}
+	return s, nil
+}
+
+// publishDelta publishes the changed delta to the integrations.
+// Errors are logged in events, as the shadow has been updated already.
+func (m *Manager) publishDelta(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, s *Shadow, delta Document) {
+	data, err := deltaServiceData(s, delta)
+	if err != nil {
+		registerDeltaEncodeFail(ctx, ids, err)
+		return
+	}
+	registerDeltaChange(ctx, ids, data)
+	if err := m.server.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds:   ids,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:     timestamppb.Now(),
+		Up: &ttnpb.ApplicationUp_ServiceData{
+			ServiceData: data,
+		},
+	}); err != nil {
+		registerDeltaEncodeFail(ctx, ids, err)
+	}
+}
+
+// syncDownlink keeps at most one downlink message of the shadow in the downlink queue of the end device.
+// The queued downlink message is identified by its correlation ID, which is stored in the shadow.
+// If the delta is empty, the queued downlink message is removed. If the delta changed, the queued downlink message is
+// replaced by the encoded delta. If the delta did not change but the downlink message is no longer queued, i.e. it
+// has been sent but the end device did not report the desired state, the encoded delta is pushed again.
+// Errors are logged in events, as the shadow has been updated already.
+func (m *Manager) syncDownlink(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, s *Shadow, changed bool) {
+	queue, err := m.server.DownlinkQueueList(ctx, ids)
+	if err != nil {
+		registerDeltaEncodeFail(ctx, ids, err)
+		return
+	}
+	delta := s.Delta()
+	i := queuedDownlinkIndex(queue, s.DownlinkCorrelationID)
+	if i >= 0 && len(delta) > 0 && !changed {
+		return
+	}
+
+	var down *ttnpb.ApplicationDownlink
+	if len(delta) > 0 {
+		if down, err = m.encodeDelta(ctx, ids, s); err != nil {
+			registerDeltaEncodeFail(ctx, ids, err)
+			return
+		}
+	}
+	var cid string
+	if down != nil {
+		ctx = appendCorrelationID(ctx)
+		cid = shadowCorrelationID(events.CorrelationIDsFromContext(ctx))
+		down.CorrelationIds = events.CorrelationIDsFromContext(ctx)
+	}
+
+	switch {
+	case i >= 0 && down != nil:
+		queue[i] = down
+		err = m.server.DownlinkQueueReplace(ctx, ids, queue)
+	case i >= 0:
+		err = m.server.DownlinkQueueReplace(ctx, ids, append(queue[:i:i], queue[i+1:]...))
+	case down != nil:
+		err = m.server.DownlinkQueuePush(ctx, ids, []*ttnpb.ApplicationDownlink{down})
+	}
+	if err != nil {
+		registerDeltaEncodeFail(ctx, ids, err)
+		return
+	}
+	if down != nil {
+		registerDeltaForward(ctx, ids, down)
+	}
+	if cid == s.DownlinkCorrelationID {
+		return
+	}
+	if _, err := m.shadows.Set(ctx, ids, func(stored *Shadow) (*Shadow, error) {
+		if stored == nil {
+			return nil, nil
+		}
+		updated := *stored
+		updated.DownlinkCorrelationID = cid
+		return &updated, nil
+	}); err != nil {
+		registerDeltaEncodeFail(ctx, ids, err)
+		return
+	}
+	s.DownlinkCorrelationID = cid
+}
+
+// queuedDownlinkIndex returns the index of the downlink message with the given correlation ID in the queue, or -1 if
+// it is not queued.
+func queuedDownlinkIndex(queue []*ttnpb.ApplicationDownlink, cid string) int {
+	if cid == "" {
+		return -1
+	}
+	for i, down := range queue {
+		for _, id := range down.CorrelationIds {
+			if id == cid {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// shadowCorrelationID returns the device shadow correlation ID from the correlation IDs.
+func shadowCorrelationID(cids []string) string {
+	for _, cid := range cids {
+		if strings.HasPrefix(cid, correlationIDPrefix+":") {
+			return cid
+		}
+	}
+	return ""
+}
+
+func (m *Manager) encodeDelta(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, s *Shadow,
+) (*ttnpb.ApplicationDownlink, error) {
+	settings, err := m.settings.GetSettings(ctx, ids.ApplicationIds)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if settings.EncoderScript == "" {
+		return nil, nil
+	}
+	return m.encoder.Encode(ctx, settings.EncoderScript, s)
+}
+
+// deltaServiceData returns the delta as application service data.
+func deltaServiceData(s *Shadow, delta Document) (*ttnpb.ApplicationServiceData, error) {
+	deltaStruct, err := goproto.Struct(delta)
+	if err != nil {
+		return nil, errEncodeServiceData.WithCause(err)
+	}
+	return &ttnpb.ApplicationServiceData{
+		Service: ServiceName,
+		Data: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"delta":   structpb.NewStructValue(deltaStruct),
+				"version": structpb.NewNumberValue(float64(s.Version)),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,259 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shadow
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var errNotFound = errors.DefineNotFound("not_found", "not found")
+
+type memRegistry struct {
+	mu       sync.Mutex
+	shadows  map[string]*Shadow
+	settings map[string]*Settings
+}
+
+func newMemRegistry() *memRegistry {
+	return &memRegistry{
+		shadows:  make(map[string]*Shadow),
+		settings: make(map[string]*Settings),
+	}
+}
+
+func (r *memRegistry) Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*Shadow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.shadows[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return s, nil
+}
+
+func (r *memRegistry) Set(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, f func(*Shadow) (*Shadow, error),
+) (*Shadow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	s, err := f(r.shadows[uid])
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		delete(r.shadows, uid)
+	} else {
+		r.shadows[uid] = s
+	}
+	return s, nil
+}
+
+func (r *memRegistry) GetSettings(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*Settings, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.settings[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return s, nil
+}
+
+func (r *memRegistry) SetSettings(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, f func(*Settings) (*Settings, error),
+) (*Settings, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	s, err := f(r.settings[uid])
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		delete(r.settings, uid)
+	} else {
+		r.settings[uid] = s
+	}
+	return s, nil
+}
+
+type mockServer struct {
+	ups   []*ttnpb.ApplicationUp
+	queue []*ttnpb.ApplicationDownlink
+}
+
+func (s *mockServer) Publish(_ context.Context, up *ttnpb.ApplicationUp) error {
+	s.ups = append(s.ups, up)
+	return nil
+}
+
+func (s *mockServer) DownlinkQueuePush(
+	_ context.Context, _ *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink,
+) error {
+	s.queue = append(s.queue, items...)
+	return nil
+}
+
+func (s *mockServer) DownlinkQueueReplace(
+	_ context.Context, _ *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink,
+) error {
+	s.queue = items
+	return nil
+}
+
+func (s *mockServer) DownlinkQueueList(
+	context.Context, *ttnpb.EndDeviceIdentifiers,
+) ([]*ttnpb.ApplicationDownlink, error) {
+	return append([]*ttnpb.ApplicationDownlink(nil), s.queue...), nil
+}
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	registry := newMemRegistry()
+	server := &mockServer{}
+	manager := NewManager(server, registry, registry)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "myapp"},
+		DeviceId:       "mydev",
+	}
+	now := time.Unix(1700000000, 0).UTC()
+
+	deltas := func() []map[string]any {
+		var res []map[string]any
+		for _, up := range server.ups {
+			sd := up.GetServiceData()
+			a.So(sd.GetService(), should.Equal, ServiceName)
+			res = append(res, sd.GetData().GetFields()["delta"].GetStructValue().AsMap())
+		}
+		return res
+	}
+
+	// Reporting without desired state does not change the delta.
+	s, err := manager.Report(ctx, ids, &structpb.Struct{Fields: map[string]*structpb.Value{
+		"led":         structpb.NewStringValue("off"),
+		"temperature": structpb.NewNumberValue(21.5),
+	}}, now)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(s.Version, should.Equal, 1)
+	a.So(s.Reported, should.Resemble, Document{"led": "off", "temperature": 21.5})
+	a.So(s.ReportedAt, should.Equal, now)
+	a.So(server.ups, should.BeEmpty)
+
+	// Setting the desired state without an encoder publishes the delta.
+	s, err = manager.SetDesired(ctx, ids, Document{"led": "on"}, false, now)
+	a.So(err, should.BeNil)
+	a.So(s.Version, should.Equal, 2)
+	a.So(deltas(), should.Resemble, []map[string]any{{"led": "on"}})
+	a.So(server.queue, should.BeEmpty)
+
+	// Setting the same desired state does not publish the delta again.
+	s, err = manager.SetDesired(ctx, ids, Document{"led": "on"}, false, now)
+	a.So(err, should.BeNil)
+	a.So(s.Version, should.Equal, 2)
+	a.So(server.ups, should.HaveLength, 1)
+
+	// Changing the delta with an encoder pushes a downlink.
+	_, err = registry.SetSettings(ctx, ids.ApplicationIds, func(*Settings) (*Settings, error) {
+		return &Settings{EncoderScript: `
+			function encodeDelta(input) {
+				const bytes = [input.delta.led === "on" ? 1 : 0];
+				if (input.delta.interval) {
+					bytes.push(input.delta.interval);
+				}
+				return { fPort: 2, bytes };
+			}
+		`}, nil
+	})
+	a.So(err, should.BeNil)
+	other := &ttnpb.ApplicationDownlink{FPort: 1, FrmPayload: []byte{0x42}}
+	server.queue = []*ttnpb.ApplicationDownlink{other}
+	_, err = manager.SetDesired(ctx, ids, Document{"interval": 60.0}, false, now)
+	a.So(err, should.BeNil)
+	a.So(deltas(), should.Resemble, []map[string]any{{"led": "on"}, {"led": "on", "interval": 60.0}})
+	if a.So(server.queue, should.HaveLength, 2) {
+		a.So(server.queue[1].FPort, should.Equal, 2)
+		a.So(server.queue[1].FrmPayload, should.Resemble, []byte{1, 60})
+	}
+
+	// Reporting part of the desired state changes the delta, which replaces the queued downlink.
+	_, err = manager.Report(ctx, ids, &structpb.Struct{Fields: map[string]*structpb.Value{
+		"interval": structpb.NewNumberValue(60),
+	}}, now)
+	a.So(err, should.BeNil)
+	a.So(deltas()[2], should.Resemble, map[string]any{"led": "on"})
+	if a.So(server.queue, should.HaveLength, 2) {
+		a.So(server.queue[0], should.Equal, other)
+		a.So(server.queue[1].FrmPayload, should.Resemble, []byte{1})
+	}
+	queued := server.queue[1]
+
+	// Reporting a state that does not change the delta keeps the queued downlink.
+	_, err = manager.Report(ctx, ids, &structpb.Struct{Fields: map[string]*structpb.Value{
+		"temperature": structpb.NewNumberValue(22),
+	}}, now)
+	a.So(err, should.BeNil)
+	a.So(server.ups, should.HaveLength, 3)
+	a.So(server.queue, should.Resemble, []*ttnpb.ApplicationDownlink{other, queued})
+
+	// If the downlink has been sent and the end device still does not report the desired state, the downlink is
+	// queued again.
+	server.queue = nil
+	_, err = manager.Report(ctx, ids, &structpb.Struct{Fields: map[string]*structpb.Value{
+		"temperature": structpb.NewNumberValue(22.5),
+	}}, now)
+	a.So(err, should.BeNil)
+	a.So(server.ups, should.HaveLength, 3)
+	if a.So(server.queue, should.HaveLength, 1) {
+		a.So(server.queue[0].FrmPayload, should.Resemble, []byte{1})
+		a.So(server.queue[0].CorrelationIds, should.NotContain, queued.CorrelationIds[0])
+	}
+	server.queue = append(server.queue, other)
+
+	// Reporting the desired state converges the shadow, which publishes an empty delta and removes the queued
+	// downlink.
+	s, err = manager.Report(ctx, ids, &structpb.Struct{Fields: map[string]*structpb.Value{
+		"led": structpb.NewStringValue("on"),
+	}}, now)
+	a.So(err, should.BeNil)
+	a.So(s.Delta(), should.BeNil)
+	a.So(s.DownlinkCorrelationID, should.BeEmpty)
+	a.So(deltas()[3], should.BeEmpty)
+	a.So(server.queue, should.Resemble, []*ttnpb.ApplicationDownlink{other})
+
+	// Replacing the desired state removes the other desired values.
+	s, err = manager.SetDesired(ctx, ids, Document{"led": "off"}, true, now)
+	a.So(err, should.BeNil)
+	a.So(s.Desired, should.Resemble, Document{"led": "off"})
+	a.So(s.Delta(), should.Resemble, Document{"led": "off"})
+
+	a.So(manager.Delete(ctx, ids), should.BeNil)
+	_, err = manager.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shadow
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtDeltaChange = events.Define(
+		"as.shadow.delta.change", "change device shadow delta",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.ApplicationServiceData{}),
+		events.WithPropagateToParent(),
+	)
+	evtDeltaEncodeFail = events.Define(
+		"as.shadow.delta.encode.fail", "fail to encode device shadow delta",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithErrorDataType(),
+		events.WithPropagateToParent(),
+	)
+	evtDeltaForward = events.Define(
+		"as.shadow.delta.forward", "forward device shadow delta downlink",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationDownlink{}),
+		events.WithPropagateToParent(),
+	)
+)
+
+func registerDeltaChange(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, data *ttnpb.ApplicationServiceData) {
+	events.Publish(evtDeltaChange.NewWithIdentifiersAndData(ctx, ids, data))
+}
+
+func registerDeltaEncodeFail(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, err error) {
+	events.Publish(evtDeltaEncodeFail.NewWithIdentifiersAndData(ctx, ids, err))
+}
+
+func registerDeltaForward(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, down *ttnpb.ApplicationDownlink) {
+	events.Publish(evtDeltaForward.NewWithIdentifiersAndData(ctx, ids, down))
+}
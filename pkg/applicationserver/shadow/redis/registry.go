@@ -0,0 +1,155 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the device shadow registries using Redis.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/shadow"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errEncode = errors.DefineInternal("encode", "encode device shadow data")
+	errDecode = errors.DefineCorruption("decode", "decode device shadow data")
+)
+
+// ShadowRegistry is a Redis device shadow registry.
+type ShadowRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+}
+
+var (
+	_ shadow.Registry         = (*ShadowRegistry)(nil)
+	_ shadow.SettingsRegistry = (*ShadowRegistry)(nil)
+)
+
+// Init initializes the ShadowRegistry.
+func (r *ShadowRegistry) Init(ctx context.Context) error {
+	return ttnredis.InitMutex(ctx, r.Redis)
+}
+
+func (r *ShadowRegistry) devKey(uid string) string {
+	return r.Redis.Key("devices", uid)
+}
+
+func (r *ShadowRegistry) appKey(uid string) string {
+	return r.Redis.Key("applications", uid)
+}
+
+func getJSON(ctx context.Context, c redis.Cmdable, key string, v any) error {
+	b, err := c.Get(ctx, key).Bytes()
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errDecode.WithCause(err)
+	}
+	return nil
+}
+
+// setJSON calls f with the stored value decoded in a new value of type T, or nil if the key does not exist.
+// If f returns nil, the key is deleted. Otherwise, the key is set to the encoded value returned by f.
+func setJSON[T any](
+	ctx context.Context, r *ttnredis.Client, lockTTL time.Duration, key string, f func(*T) (*T, error),
+) (*T, error) {
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var v *T
+	err = ttnredis.LockedWatch(ctx, r, key, lockerID, lockTTL, func(tx *redis.Tx) error {
+		stored := new(T)
+		if err := getJSON(ctx, tx, key, stored); errors.Is(err, redis.Nil) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		v, err = f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && v == nil {
+			return nil
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if v == nil {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(ctx, key)
+				return nil
+			}
+		} else {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return errEncode.WithCause(err)
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				p.Set(ctx, key, b, 0)
+				return nil
+			}
+		}
+		_, err = tx.TxPipelined(ctx, pipelined)
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return v, nil
+}
+
+// Get implements shadow.Registry.
+func (r *ShadowRegistry) Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*shadow.Shadow, error) {
+	s := &shadow.Shadow{}
+	if err := getJSON(ctx, r.Redis, r.devKey(unique.ID(ctx, ids)), s); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return s, nil
+}
+
+// Set implements shadow.Registry.
+func (r *ShadowRegistry) Set(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, f func(*shadow.Shadow) (*shadow.Shadow, error),
+) (*shadow.Shadow, error) {
+	return setJSON(ctx, r.Redis, r.LockTTL, r.devKey(unique.ID(ctx, ids)), f)
+}
+
+// GetSettings implements shadow.SettingsRegistry.
+func (r *ShadowRegistry) GetSettings(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*shadow.Settings, error) {
+	s := &shadow.Settings{}
+	if err := getJSON(ctx, r.Redis, r.appKey(unique.ID(ctx, ids)), s); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return s, nil
+}
+
+// SetSettings implements shadow.SettingsRegistry.
+func (r *ShadowRegistry) SetSettings(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, f func(*shadow.Settings) (*shadow.Settings, error),
+) (*shadow.Settings, error) {
+	return setJSON(ctx, r.Redis, r.LockTTL, r.appKey(unique.ID(ctx, ids)), f)
+}
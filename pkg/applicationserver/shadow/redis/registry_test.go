@@ -0,0 +1,125 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/shadow"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestShadowRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+
+	registry := &ShadowRegistry{
+		Redis:   cl,
+		LockTTL: test.Delay << 10,
+	}
+	if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "myapp"},
+		DeviceId:       "mydev",
+	}
+
+	_, err := registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	expected := &shadow.Shadow{
+		Reported:   shadow.Document{"led": "off", "config": map[string]any{"interval": 30.0}},
+		Desired:    shadow.Document{"led": "on"},
+		Version:    2,
+		ReportedAt: time.Unix(1700000000, 0).UTC(),
+		DesiredAt:  time.Unix(1700000060, 0).UTC(),
+	}
+	s, err := registry.Set(ctx, ids, func(stored *shadow.Shadow) (*shadow.Shadow, error) {
+		a.So(stored, should.BeNil)
+		return expected, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(s, should.Resemble, expected)
+
+	s, err = registry.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(s, should.Resemble, expected)
+
+	s, err = registry.Set(ctx, ids, func(stored *shadow.Shadow) (*shadow.Shadow, error) {
+		a.So(stored, should.Resemble, expected)
+		return nil, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(s, should.BeNil)
+
+	_, err = registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestShadowSettingsRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+
+	registry := &ShadowRegistry{
+		Redis:   cl,
+		LockTTL: test.Delay << 10,
+	}
+	if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: "myapp"}
+
+	_, err := registry.GetSettings(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	expected := &shadow.Settings{
+		EncoderScript: "function encodeDelta(input) { return null; }",
+	}
+	s, err := registry.SetSettings(ctx, ids, func(stored *shadow.Settings) (*shadow.Settings, error) {
+		a.So(stored, should.BeNil)
+		return expected, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(s, should.Resemble, expected)
+
+	s, err = registry.GetSettings(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(s, should.Resemble, expected)
+
+	_, err = registry.SetSettings(ctx, ids, func(*shadow.Settings) (*shadow.Settings, error) {
+		return nil, nil
+	})
+	a.So(err, should.BeNil)
+
+	_, err = registry.GetSettings(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
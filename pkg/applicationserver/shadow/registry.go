@@ -0,0 +1,52 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shadow
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Settings are the device shadow settings of an application.
+type Settings struct {
+	// EncoderScript is the JavaScript encoder that encodes the delta to a downlink message.
+	// The script defines a function encodeDelta(input), where input contains the delta, desired and reported
+	// documents. The function returns an object with bytes, fPort and optionally confirmed, warnings and errors, or
+	// null if no downlink message should be sent.
+	EncoderScript string `json:"encoder_script,omitempty"`
+}
+
+// Registry is a registry for device shadows.
+type Registry interface {
+	// Get returns the shadow of the end device.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*Shadow, error)
+	// Set creates, updates or deletes the shadow of the end device.
+	// If the function returns nil, the shadow is deleted.
+	Set(
+		ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, f func(*Shadow) (*Shadow, error),
+	) (*Shadow, error)
+}
+
+// SettingsRegistry is a registry for the device shadow settings of applications.
+type SettingsRegistry interface {
+	// GetSettings returns the device shadow settings of the application.
+	GetSettings(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*Settings, error)
+	// SetSettings creates, updates or deletes the device shadow settings of the application.
+	// If the function returns nil, the settings are deleted.
+	SetSettings(
+		ctx context.Context, ids *ttnpb.ApplicationIdentifiers, f func(*Settings) (*Settings, error),
+	) (*Settings, error)
+}
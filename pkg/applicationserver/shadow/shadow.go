@@ -0,0 +1,205 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package shadow implements device shadows in the Application Server.
+//
+// A device shadow consists of a reported document, which is updated from the decoded payload of uplink messages,
+// and a desired document, which is set via the API. The difference between the two is the delta. When the delta
+// changes, it is published to the integrations and, if the application has an encoder configured, it is encoded into
+// a downlink message in the downlink queue of the end device. The downlink queue contains at most one downlink message
+// of the shadow, which is replaced when the delta changes, and which is queued again when the end device reports a
+// state that does not match the desired state.
+package shadow
+
+import (
+	"reflect"
+	"time"
+)
+
+// Document is a JSON document.
+type Document map[string]any
+
+// Clone returns a deep copy of the document.
+func (d Document) Clone() Document {
+	if d == nil {
+		return nil
+	}
+	return cloneValue(map[string]any(d)).(map[string]any)
+}
+
+func cloneValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		res := make(map[string]any, len(v))
+		for k, v := range v {
+			res[k] = cloneValue(v)
+		}
+		return res
+	case Document:
+		return cloneValue(map[string]any(v))
+	case []any:
+		res := make([]any, len(v))
+		for i, v := range v {
+			res[i] = cloneValue(v)
+		}
+		return res
+	default:
+		return v
+	}
+}
+
+// Merge applies the patch to the document as a JSON merge patch (RFC 7386) and returns the result.
+// Null values in the patch remove the corresponding keys from the document.
+// The document is not modified.
+func Merge(doc, patch Document) Document {
+	res := doc.Clone()
+	if res == nil {
+		res = make(Document, len(patch))
+	}
+	mergeInto(res, patch)
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+func mergeInto(dst, patch map[string]any) {
+	for k, v := range patch {
+		switch pv := v.(type) {
+		case nil:
+			delete(dst, k)
+		case map[string]any:
+			dv, ok := dst[k].(map[string]any)
+			if !ok {
+				dv = make(map[string]any, len(pv))
+			}
+			mergeInto(dv, pv)
+			dst[k] = dv
+		default:
+			dst[k] = cloneValue(pv)
+		}
+	}
+}
+
+// Delta returns the values of the desired document that differ from the reported document.
+// Nested objects are compared recursively, while other values, including arrays, are compared as a whole.
+// If the reported document matches the desired document, the delta is nil.
+func Delta(desired, reported Document) Document {
+	res := delta(desired, reported)
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+func delta(desired, reported map[string]any) map[string]any {
+	var res map[string]any
+	for k, dv := range desired {
+		rv, ok := reported[k]
+		if ok && valuesEqual(dv, rv) {
+			continue
+		}
+		if dm, ok := dv.(map[string]any); ok {
+			if rm, ok := rv.(map[string]any); ok {
+				nested := delta(dm, rm)
+				if len(nested) == 0 {
+					continue
+				}
+				dv = nested
+			}
+		}
+		if res == nil {
+			res = make(map[string]any)
+		}
+		res[k] = cloneValue(dv)
+	}
+	return res
+}
+
+// valuesEqual returns whether the JSON values are equal.
+// Numbers are compared by value, regardless of their Go type.
+func valuesEqual(a, b any) bool {
+	if af, ok := toFloat(a); ok {
+		bf, ok := toFloat(b)
+		return ok && af == bf
+	}
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			w, ok := bv[k]
+			if !ok || !valuesEqual(v, w) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !valuesEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+func toFloat(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// Shadow is the shadow of an end device.
+type Shadow struct {
+	Reported Document `json:"reported,omitempty"`
+	Desired  Document `json:"desired,omitempty"`
+	// Version is incremented on every change of the shadow.
+	Version    uint64    `json:"version"`
+	ReportedAt time.Time `json:"reported_at,omitempty"`
+	DesiredAt  time.Time `json:"desired_at,omitempty"`
+	// DownlinkCorrelationID is the correlation ID of the downlink message of the delta in the downlink queue.
+	DownlinkCorrelationID string `json:"downlink_correlation_id,omitempty"`
+}
+
+// Delta returns the delta between the desired and the reported document of the shadow.
+func (s *Shadow) Delta() Document {
+	if s == nil {
+		return nil
+	}
+	return Delta(s.Desired, s.Reported)
+}
@@ -0,0 +1,154 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shadow
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name     string
+		Document Document
+		Patch    Document
+		Expected Document
+	}{
+		{
+			Name:     "Empty",
+			Expected: nil,
+		},
+		{
+			Name:     "NewDocument",
+			Patch:    Document{"led": "on", "config": map[string]any{"interval": 60.0, "unset": nil}},
+			Expected: Document{"led": "on", "config": map[string]any{"interval": 60.0}},
+		},
+		{
+			Name:     "Replace",
+			Document: Document{"led": "off", "interval": 30.0},
+			Patch:    Document{"led": "on"},
+			Expected: Document{"led": "on", "interval": 30.0},
+		},
+		{
+			Name:     "Remove",
+			Document: Document{"led": "off", "interval": 30.0},
+			Patch:    Document{"led": nil},
+			Expected: Document{"interval": 30.0},
+		},
+		{
+			Name:     "RemoveAll",
+			Document: Document{"led": "off"},
+			Patch:    Document{"led": nil},
+			Expected: nil,
+		},
+		{
+			Name: "Nested",
+			Document: Document{
+				"config": map[string]any{"interval": 30.0, "mode": "eco"},
+				"tags":   []any{"a", "b"},
+			},
+			Patch: Document{
+				"config": map[string]any{"interval": 60.0, "mode": nil},
+				"tags":   []any{"c"},
+			},
+			Expected: Document{
+				"config": map[string]any{"interval": 60.0},
+				"tags":   []any{"c"},
+			},
+		},
+		{
+			Name:     "ReplaceScalarWithObject",
+			Document: Document{"config": "default"},
+			Patch:    Document{"config": map[string]any{"interval": 60.0}},
+			Expected: Document{"config": map[string]any{"interval": 60.0}},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			original := tc.Document.Clone()
+			a.So(Merge(tc.Document, tc.Patch), should.Resemble, tc.Expected)
+			a.So(tc.Document, should.Resemble, original)
+		})
+	}
+}
+
+func TestDelta(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name     string
+		Desired  Document
+		Reported Document
+		Expected Document
+	}{
+		{
+			Name:     "Empty",
+			Reported: Document{"temperature": 21.5},
+			Expected: nil,
+		},
+		{
+			Name:     "Converged",
+			Desired:  Document{"led": "on", "interval": 60.0},
+			Reported: Document{"led": "on", "interval": 60, "temperature": 21.5},
+			Expected: nil,
+		},
+		{
+			Name:     "Missing",
+			Desired:  Document{"led": "on"},
+			Expected: Document{"led": "on"},
+		},
+		{
+			Name:     "Different",
+			Desired:  Document{"led": "on", "interval": 60.0},
+			Reported: Document{"led": "off", "interval": 60.0},
+			Expected: Document{"led": "on"},
+		},
+		{
+			Name: "Nested",
+			Desired: Document{
+				"config": map[string]any{"interval": 60.0, "mode": "eco"},
+			},
+			Reported: Document{
+				"config": map[string]any{"interval": 30.0, "mode": "eco", "version": "1.0"},
+			},
+			Expected: Document{
+				"config": map[string]any{"interval": 60.0},
+			},
+		},
+		{
+			Name:     "Array",
+			Desired:  Document{"channels": []any{1.0, 2.0}},
+			Reported: Document{"channels": []any{1.0}},
+			Expected: Document{"channels": []any{1.0, 2.0}},
+		},
+		{
+			Name:     "TypeMismatch",
+			Desired:  Document{"led": true},
+			Reported: Document{"led": "on"},
+			Expected: Document{"led": true},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			a.So(Delta(tc.Desired, tc.Reported), should.Resemble, tc.Expected)
+		})
+	}
+}
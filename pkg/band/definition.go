@@ -0,0 +1,426 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package band
+
+import (
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// maxCustomChannels is the maximum number of channels of a custom band.
+// Custom bands use a dynamic channel plan, of which the channels are masked with a single ChMask.
+const maxCustomChannels = 16
+
+var (
+	errBandExists            = errors.DefineAlreadyExists("band_exists", "band `{id}` already exists")
+	errInvalidBandDefinition = errors.DefineInvalidArgument("invalid_band_definition", "invalid definition of band `{id}`")
+	errInvalidBandField      = errors.DefineInvalidArgument("invalid_band_field", "invalid `{field}`")
+	errMissingBandField      = errors.DefineInvalidArgument("missing_band_field", "missing `{field}`")
+	errUnknownDataRateIndex  = errors.DefineInvalidArgument(
+		"unknown_data_rate_index", "data rate index `{index}` of `{field}` is not defined",
+	)
+)
+
+// ChannelDefinition defines a default channel of a custom band.
+type ChannelDefinition struct {
+	Frequency   uint64 `yaml:"frequency"`
+	MinDataRate uint32 `yaml:"min-data-rate"`
+	MaxDataRate uint32 `yaml:"max-data-rate"`
+}
+
+// SubBandDefinition defines a sub-band of a custom band.
+type SubBandDefinition struct {
+	MinFrequency uint64  `yaml:"min-frequency"`
+	MaxFrequency uint64  `yaml:"max-frequency"`
+	DutyCycle    float32 `yaml:"duty-cycle"`
+	MaxEIRP      float32 `yaml:"max-eirp"`
+}
+
+// LoRaDataRateDefinition defines a LoRa data rate of a custom band.
+type LoRaDataRateDefinition struct {
+	SpreadingFactor uint32 `yaml:"spreading-factor"`
+	Bandwidth       uint32 `yaml:"bandwidth"`
+	CodingRate      string `yaml:"coding-rate,omitempty"`
+}
+
+// FSKDataRateDefinition defines a FSK data rate of a custom band.
+type FSKDataRateDefinition struct {
+	BitRate uint32 `yaml:"bit-rate"`
+}
+
+// DataRateDefinition defines a data rate of a custom band.
+type DataRateDefinition struct {
+	LoRa *LoRaDataRateDefinition `yaml:"lora,omitempty"`
+	FSK  *FSKDataRateDefinition  `yaml:"fsk,omitempty"`
+	// MaxMACPayloadSize is the maximum MAC payload size without dwell time restrictions.
+	MaxMACPayloadSize uint16 `yaml:"max-mac-payload-size"`
+	// DwellTimeMaxMACPayloadSize is the maximum MAC payload size with dwell time restrictions.
+	// If not set, MaxMACPayloadSize applies.
+	DwellTimeMaxMACPayloadSize uint16 `yaml:"dwell-time-max-mac-payload-size,omitempty"`
+}
+
+// Rx2Definition defines the default Rx2 parameters of a custom band.
+type Rx2Definition struct {
+	DataRateIndex uint32 `yaml:"data-rate-index"`
+	Frequency     uint64 `yaml:"frequency"`
+}
+
+// BeaconDefinition defines the beacon of a custom band.
+type BeaconDefinition struct {
+	DataRateIndex uint32   `yaml:"data-rate-index"`
+	CodingRate    string   `yaml:"coding-rate,omitempty"`
+	Frequencies   []uint64 `yaml:"frequencies"`
+}
+
+// DwellTimeDefinition defines the boot dwell time of a custom band.
+type DwellTimeDefinition struct {
+	Uplinks   *bool `yaml:"uplinks,omitempty"`
+	Downlinks *bool `yaml:"downlinks,omitempty"`
+}
+
+// Definition defines a custom band in the YAML format.
+// Custom bands use a dynamic channel plan with up to 16 channels and frequency CFLists, and share the MAC timing
+// parameters of the Regional Parameters.
+type Definition struct {
+	// ID is the unique identifier of the band. It must not be the ID of a band of the Regional Parameters.
+	ID string `yaml:"id"`
+	// PHYVersions are the versions of the Regional Parameters with which the band can be used.
+	PHYVersions []ttnpb.PHYVersion `yaml:"phy-versions"`
+
+	// MaxUplinkChannels is the maximum amount of uplink channels that can be defined. The default is 16.
+	MaxUplinkChannels uint8               `yaml:"max-uplink-channels,omitempty"`
+	UplinkChannels    []ChannelDefinition `yaml:"uplink-channels"`
+	// MaxDownlinkChannels is the maximum amount of downlink channels that can be defined. The default is 16.
+	MaxDownlinkChannels uint8 `yaml:"max-downlink-channels,omitempty"`
+	// DownlinkChannels are the default downlink channels. If not set, the uplink channels are used.
+	DownlinkChannels []ChannelDefinition `yaml:"downlink-channels,omitempty"`
+
+	SubBands []SubBandDefinition `yaml:"sub-bands"`
+
+	DataRates           map[uint32]DataRateDefinition `yaml:"data-rates"`
+	MaxADRDataRateIndex uint32                        `yaml:"max-adr-data-rate-index"`
+	// Rx1DataRates is the Rx1 data rate table, which maps the uplink data rate index and the Rx1 data rate offset
+	// to the Rx1 data rate index.
+	Rx1DataRates [][]uint32 `yaml:"rx1-data-rates"`
+
+	// DefaultMaxEIRP in dBm.
+	DefaultMaxEIRP float32 `yaml:"default-max-eirp"`
+	// TxOffset in dB by Tx power index.
+	TxOffset []float32 `yaml:"tx-offset"`
+
+	Rx2 Rx2Definition `yaml:"rx2"`
+
+	Beacon              *BeaconDefinition `yaml:"beacon,omitempty"`
+	PingSlotFrequencies []uint64          `yaml:"ping-slot-frequencies,omitempty"`
+
+	BootDwellTime DwellTimeDefinition `yaml:"boot-dwell-time,omitempty"`
+
+	SupportsDynamicADR     bool `yaml:"supports-dynamic-adr"`
+	ImplementsCFList       bool `yaml:"implements-cf-list"`
+	TxParamSetupReqSupport bool `yaml:"tx-param-setup-req-support"`
+	StrictCodingRate       bool `yaml:"strict-coding-rate"`
+}
+
+func channels(
+	field string,
+	maxChannels uint8,
+	defs []ChannelDefinition,
+	dataRates map[ttnpb.DataRateIndex]DataRate,
+	subBands []SubBandParameters,
+) ([]Channel, error) {
+	if len(defs) == 0 {
+		return nil, errMissingBandField.WithAttributes("field", field)
+	}
+	if len(defs) > int(maxChannels) {
+		return nil, errInvalidChannelCount.New()
+	}
+	chs := make([]Channel, 0, len(defs))
+	for _, def := range defs {
+		ch := Channel{
+			Frequency:   def.Frequency,
+			MinDataRate: ttnpb.DataRateIndex(def.MinDataRate),
+			MaxDataRate: ttnpb.DataRateIndex(def.MaxDataRate),
+		}
+		for _, idx := range []ttnpb.DataRateIndex{ch.MinDataRate, ch.MaxDataRate} {
+			if _, ok := dataRates[idx]; !ok {
+				return nil, errUnknownDataRateIndex.WithAttributes("index", idx, "field", field)
+			}
+		}
+		if ch.MinDataRate > ch.MaxDataRate || !comprisedBySubBand(ch.Frequency, subBands) {
+			return nil, errInvalidBandField.WithAttributes("field", field)
+		}
+		chs = append(chs, ch)
+	}
+	return chs, nil
+}
+
+func comprisedBySubBand(frequency uint64, subBands []SubBandParameters) bool {
+	for _, sb := range subBands {
+		if sb.Comprises(frequency) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d Definition) subBands() ([]SubBandParameters, error) {
+	if len(d.SubBands) == 0 {
+		return nil, errMissingBandField.WithAttributes("field", "sub-bands")
+	}
+	subBands := make([]SubBandParameters, 0, len(d.SubBands))
+	for _, def := range d.SubBands {
+		if def.MinFrequency == 0 || def.MinFrequency >= def.MaxFrequency || def.DutyCycle <= 0 || def.DutyCycle > 1 {
+			return nil, errInvalidBandField.WithAttributes("field", "sub-bands")
+		}
+		subBands = append(subBands, SubBandParameters{
+			MinFrequency: def.MinFrequency,
+			MaxFrequency: def.MaxFrequency,
+			DutyCycle:    def.DutyCycle,
+			MaxEIRP:      def.MaxEIRP,
+		})
+	}
+	sorted := append(subBands[:0:0], subBands...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].MinFrequency < sorted[j].MinFrequency })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].MinFrequency <= sorted[i-1].MaxFrequency {
+			return nil, errInvalidBandField.WithAttributes("field", "sub-bands")
+		}
+	}
+	return subBands, nil
+}
+
+func (d Definition) dataRates() (map[ttnpb.DataRateIndex]DataRate, error) {
+	if len(d.DataRates) == 0 {
+		return nil, errMissingBandField.WithAttributes("field", "data-rates")
+	}
+	dataRates := make(map[ttnpb.DataRateIndex]DataRate, len(d.DataRates))
+	for idx, def := range d.DataRates {
+		if idx > uint32(ttnpb.DataRateIndex_DATA_RATE_15) || def.MaxMACPayloadSize == 0 {
+			return nil, errInvalidBandField.WithAttributes("field", "data-rates")
+		}
+		maxMACPayloadSize := makeConstMaxMACPayloadSizeFunc(def.MaxMACPayloadSize)
+		if def.DwellTimeMaxMACPayloadSize != 0 {
+			maxMACPayloadSize = makeDwellTimeMaxMACPayloadSizeFunc(def.MaxMACPayloadSize, def.DwellTimeMaxMACPayloadSize)
+		}
+		switch {
+		case def.LoRa != nil && def.FSK == nil:
+			lora := def.LoRa
+			if lora.SpreadingFactor < 5 || lora.SpreadingFactor > 12 || lora.Bandwidth == 0 {
+				return nil, errInvalidBandField.WithAttributes("field", "data-rates")
+			}
+			dataRates[ttnpb.DataRateIndex(idx)] = makeLoRaDataRate(
+				uint8(lora.SpreadingFactor), lora.Bandwidth, lora.CodingRate, maxMACPayloadSize,
+			)
+		case def.FSK != nil && def.LoRa == nil:
+			if def.FSK.BitRate == 0 {
+				return nil, errInvalidBandField.WithAttributes("field", "data-rates")
+			}
+			dataRates[ttnpb.DataRateIndex(idx)] = makeFSKDataRate(def.FSK.BitRate, maxMACPayloadSize)
+		default:
+			return nil, errInvalidBandField.WithAttributes("field", "data-rates")
+		}
+	}
+	return dataRates, nil
+}
+
+func (d Definition) rx1DataRate(
+	dataRates map[ttnpb.DataRateIndex]DataRate,
+) (func(ttnpb.DataRateIndex, ttnpb.DataRateOffset, bool) (ttnpb.DataRateIndex, error), error) {
+	table := make([][]ttnpb.DataRateIndex, len(d.Rx1DataRates))
+	for i, row := range d.Rx1DataRates {
+		if len(row) == 0 {
+			return nil, errInvalidBandField.WithAttributes("field", "rx1-data-rates")
+		}
+		table[i] = make([]ttnpb.DataRateIndex, len(row))
+		for j, idx := range row {
+			if _, ok := dataRates[ttnpb.DataRateIndex(idx)]; !ok {
+				return nil, errUnknownDataRateIndex.WithAttributes("index", idx, "field", "rx1-data-rates")
+			}
+			table[i][j] = ttnpb.DataRateIndex(idx)
+		}
+	}
+	for idx := range dataRates {
+		if int(idx) >= len(table) {
+			return nil, errMissingBandField.WithAttributes("field", "rx1-data-rates")
+		}
+	}
+	return func(idx ttnpb.DataRateIndex, offset ttnpb.DataRateOffset, _ bool) (ttnpb.DataRateIndex, error) {
+		if int(idx) >= len(table) {
+			return 0, errDataRateIndexTooHigh.WithAttributes("max", len(table)-1)
+		}
+		row := table[idx]
+		if int(offset) >= len(row) {
+			return 0, errDataRateOffsetTooHigh.WithAttributes("max", len(row)-1)
+		}
+		return row[offset], nil
+	}, nil
+}
+
+// Band validates the definition and returns the band it defines.
+func (d Definition) Band() (Band, error) {
+	b, err := d.band()
+	if err != nil {
+		return Band{}, errInvalidBandDefinition.WithAttributes("id", d.ID).WithCause(err)
+	}
+	return b, nil
+}
+
+func (d Definition) band() (Band, error) {
+	if d.ID == "" {
+		return Band{}, errMissingBandField.WithAttributes("field", "id")
+	}
+	if _, ok := All[d.ID]; ok {
+		return Band{}, errBandExists.WithAttributes("id", d.ID)
+	}
+	if len(d.PHYVersions) == 0 {
+		return Band{}, errMissingBandField.WithAttributes("field", "phy-versions")
+	}
+	for _, ver := range d.PHYVersions {
+		if _, ok := ttnpb.PHYVersion_name[int32(ver)]; !ok || ver == ttnpb.PHYVersion_PHY_UNKNOWN {
+			return Band{}, errInvalidBandField.WithAttributes("field", "phy-versions")
+		}
+	}
+	subBands, err := d.subBands()
+	if err != nil {
+		return Band{}, err
+	}
+	dataRates, err := d.dataRates()
+	if err != nil {
+		return Band{}, err
+	}
+	maxUplinkChannels, maxDownlinkChannels := d.MaxUplinkChannels, d.MaxDownlinkChannels
+	if maxUplinkChannels == 0 {
+		maxUplinkChannels = maxCustomChannels
+	}
+	if maxDownlinkChannels == 0 {
+		maxDownlinkChannels = maxCustomChannels
+	}
+	if maxUplinkChannels > maxCustomChannels || maxDownlinkChannels > maxCustomChannels {
+		return Band{}, errInvalidChannelCount.New()
+	}
+	uplinkChannels, err := channels("uplink-channels", maxUplinkChannels, d.UplinkChannels, dataRates, subBands)
+	if err != nil {
+		return Band{}, err
+	}
+	downlinkChannels := uplinkChannels
+	if len(d.DownlinkChannels) > 0 {
+		downlinkChannels, err = channels(
+			"downlink-channels", maxDownlinkChannels, d.DownlinkChannels, dataRates, subBands,
+		)
+		if err != nil {
+			return Band{}, err
+		}
+	} else if len(uplinkChannels) > int(maxDownlinkChannels) {
+		return Band{}, errInvalidChannelCount.New()
+	}
+	rx1DataRate, err := d.rx1DataRate(dataRates)
+	if err != nil {
+		return Band{}, err
+	}
+	maxADRDataRateIndex := ttnpb.DataRateIndex(d.MaxADRDataRateIndex)
+	if _, ok := dataRates[maxADRDataRateIndex]; !ok {
+		return Band{}, errUnknownDataRateIndex.WithAttributes(
+			"index", maxADRDataRateIndex, "field", "max-adr-data-rate-index",
+		)
+	}
+	if len(d.TxOffset) == 0 || len(d.TxOffset) > 16 || d.TxOffset[0] != 0 {
+		return Band{}, errInvalidBandField.WithAttributes("field", "tx-offset")
+	}
+	for i := 1; i < len(d.TxOffset); i++ {
+		if d.TxOffset[i] > d.TxOffset[i-1] {
+			return Band{}, errInvalidBandField.WithAttributes("field", "tx-offset")
+		}
+	}
+	rx2 := Rx2Parameters{
+		DataRateIndex: ttnpb.DataRateIndex(d.Rx2.DataRateIndex),
+		Frequency:     d.Rx2.Frequency,
+	}
+	if _, ok := dataRates[rx2.DataRateIndex]; !ok {
+		return Band{}, errUnknownDataRateIndex.WithAttributes("index", rx2.DataRateIndex, "field", "rx2")
+	}
+	if !comprisedBySubBand(rx2.Frequency, subBands) {
+		return Band{}, errInvalidBandField.WithAttributes("field", "rx2")
+	}
+	var beacon Beacon
+	if d.Beacon != nil {
+		beacon = Beacon{
+			DataRateIndex: ttnpb.DataRateIndex(d.Beacon.DataRateIndex),
+			CodingRate:    d.Beacon.CodingRate,
+			Frequencies:   d.Beacon.Frequencies,
+		}
+		if _, ok := dataRates[beacon.DataRateIndex]; !ok {
+			return Band{}, errUnknownDataRateIndex.WithAttributes("index", beacon.DataRateIndex, "field", "beacon")
+		}
+		if len(beacon.Frequencies) == 0 {
+			return Band{}, errMissingBandField.WithAttributes("field", "beacon.frequencies")
+		}
+		for _, freq := range beacon.Frequencies {
+			if !comprisedBySubBand(freq, subBands) {
+				return Band{}, errInvalidBandField.WithAttributes("field", "beacon.frequencies")
+			}
+		}
+	}
+	for _, freq := range d.PingSlotFrequencies {
+		if !comprisedBySubBand(freq, subBands) {
+			return Band{}, errInvalidBandField.WithAttributes("field", "ping-slot-frequencies")
+		}
+	}
+	return Band{
+		ID: d.ID,
+
+		Beacon:              beacon,
+		PingSlotFrequencies: d.PingSlotFrequencies,
+
+		SupportsDynamicADR: d.SupportsDynamicADR,
+
+		MaxUplinkChannels: maxUplinkChannels,
+		UplinkChannels:    uplinkChannels,
+
+		MaxDownlinkChannels: maxDownlinkChannels,
+		DownlinkChannels:    downlinkChannels,
+
+		SubBands: subBands,
+
+		DataRates:           dataRates,
+		MaxADRDataRateIndex: maxADRDataRateIndex,
+		StrictCodingRate:    d.StrictCodingRate,
+
+		DefaultMaxEIRP: d.DefaultMaxEIRP,
+		TxOffset:       d.TxOffset,
+
+		TxParamSetupReqSupport: d.TxParamSetupReqSupport,
+
+		Rx1Channel:  channelIndexIdentity,
+		Rx1DataRate: rx1DataRate,
+
+		GenerateChMasks: generateChMask16,
+		ParseChMask:     parseChMask16,
+
+		FreqMultiplier:   100,
+		ImplementsCFList: d.ImplementsCFList,
+		CFListType:       ttnpb.CFListType_FREQUENCIES,
+
+		DefaultRx2Parameters: rx2,
+
+		BootDwellTime: DwellTime{
+			Uplinks:   d.BootDwellTime.Uplinks,
+			Downlinks: d.BootDwellTime.Downlinks,
+		},
+
+		SharedParameters: universalSharedParameters,
+	}, nil
+}
@@ -0,0 +1,203 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package band_test
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func trialDefinition() Definition {
+	return Definition{
+		ID:          "EU_873_TRIAL",
+		PHYVersions: []ttnpb.PHYVersion{ttnpb.PHYVersion_RP001_V1_0_3_REV_A, ttnpb.PHYVersion_RP002_V1_0_4},
+		UplinkChannels: []ChannelDefinition{
+			{Frequency: 873100000, MaxDataRate: 5},
+			{Frequency: 873300000, MaxDataRate: 5},
+			{Frequency: 873500000, MaxDataRate: 5},
+		},
+		SubBands: []SubBandDefinition{
+			{MinFrequency: 873000000, MaxFrequency: 873600000, DutyCycle: 0.01, MaxEIRP: 16.15},
+			{MinFrequency: 873700000, MaxFrequency: 874000000, DutyCycle: 0.1, MaxEIRP: 29.15},
+		},
+		DataRates: map[uint32]DataRateDefinition{
+			0: {LoRa: &LoRaDataRateDefinition{SpreadingFactor: 12, Bandwidth: 125000, CodingRate: Cr4_5}, MaxMACPayloadSize: 59},
+			1: {LoRa: &LoRaDataRateDefinition{SpreadingFactor: 11, Bandwidth: 125000, CodingRate: Cr4_5}, MaxMACPayloadSize: 59},
+			2: {LoRa: &LoRaDataRateDefinition{SpreadingFactor: 10, Bandwidth: 125000, CodingRate: Cr4_5}, MaxMACPayloadSize: 59},
+			3: {LoRa: &LoRaDataRateDefinition{SpreadingFactor: 9, Bandwidth: 125000, CodingRate: Cr4_5}, MaxMACPayloadSize: 123},
+			4: {LoRa: &LoRaDataRateDefinition{SpreadingFactor: 8, Bandwidth: 125000, CodingRate: Cr4_5}, MaxMACPayloadSize: 250},
+			5: {
+				LoRa:                       &LoRaDataRateDefinition{SpreadingFactor: 7, Bandwidth: 125000, CodingRate: Cr4_5},
+				MaxMACPayloadSize:          250,
+				DwellTimeMaxMACPayloadSize: 230,
+			},
+			7: {FSK: &FSKDataRateDefinition{BitRate: 50000}, MaxMACPayloadSize: 250},
+		},
+		MaxADRDataRateIndex: 5,
+		Rx1DataRates: [][]uint32{
+			{0, 0},
+			{1, 0},
+			{2, 1},
+			{3, 2},
+			{4, 3},
+			{5, 4},
+			{5, 5},
+			{7, 5},
+		},
+		DefaultMaxEIRP: 16,
+		TxOffset:       []float32{0, -2, -4, -6},
+		Rx2:            Rx2Definition{DataRateIndex: 0, Frequency: 873800000},
+		Beacon: &BeaconDefinition{
+			DataRateIndex: 3,
+			CodingRate:    Cr4_5,
+			Frequencies:   []uint64{873800000},
+		},
+		PingSlotFrequencies:    []uint64{873800000},
+		SupportsDynamicADR:     true,
+		ImplementsCFList:       true,
+		TxParamSetupReqSupport: false,
+		StrictCodingRate:       true,
+	}
+}
+
+func TestDefinition(t *testing.T) {
+	a := assertions.New(t)
+
+	b, err := trialDefinition().Band()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(b.ID, should.Equal, "EU_873_TRIAL")
+	a.So(b.MaxUplinkChannels, should.Equal, 16)
+	a.So(b.UplinkChannels, should.Resemble, []Channel{
+		{Frequency: 873100000, MaxDataRate: ttnpb.DataRateIndex_DATA_RATE_5},
+		{Frequency: 873300000, MaxDataRate: ttnpb.DataRateIndex_DATA_RATE_5},
+		{Frequency: 873500000, MaxDataRate: ttnpb.DataRateIndex_DATA_RATE_5},
+	})
+	a.So(b.DownlinkChannels, should.Resemble, b.UplinkChannels)
+	a.So(b.DataRates, should.HaveLength, 7)
+	a.So(b.DataRates[ttnpb.DataRateIndex_DATA_RATE_3].Rate, should.Resemble, (&ttnpb.LoRaDataRate{
+		SpreadingFactor: 9,
+		Bandwidth:       125000,
+		CodingRate:      Cr4_5,
+	}).DataRate())
+	a.So(b.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].MaxMACPayloadSize(false), should.Equal, 250)
+	a.So(b.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].MaxMACPayloadSize(true), should.Equal, 230)
+	a.So(b.DataRates[ttnpb.DataRateIndex_DATA_RATE_7].Rate, should.Resemble, (&ttnpb.FSKDataRate{
+		BitRate: 50000,
+	}).DataRate())
+	a.So(b.MaxTxPowerIndex(), should.Equal, 3)
+	a.So(b.DefaultRx2Parameters, should.Resemble, Rx2Parameters{
+		DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_0,
+		Frequency:     873800000,
+	})
+	a.So(b.CFListType, should.Equal, ttnpb.CFListType_FREQUENCIES)
+	a.So(b.ReceiveDelay1, should.Equal, EU_863_870_RP2_V1_0_4.ReceiveDelay1)
+
+	idx, err := b.Rx1DataRate(ttnpb.DataRateIndex_DATA_RATE_4, 1, false)
+	a.So(err, should.BeNil)
+	a.So(idx, should.Equal, ttnpb.DataRateIndex_DATA_RATE_3)
+	_, err = b.Rx1DataRate(ttnpb.DataRateIndex_DATA_RATE_4, 2, false)
+	a.So(err, should.NotBeNil)
+	_, err = b.Rx1DataRate(ttnpb.DataRateIndex_DATA_RATE_8, 0, false)
+	a.So(err, should.NotBeNil)
+
+	sb, ok := b.FindSubBand(873800000)
+	a.So(ok, should.BeTrue)
+	a.So(sb.DutyCycle, should.Equal, float32(0.1))
+}
+
+func TestInvalidDefinition(t *testing.T) {
+	for _, tc := range []struct {
+		Name   string
+		Modify func(*Definition)
+	}{
+		{
+			Name:   "no ID",
+			Modify: func(d *Definition) { d.ID = "" },
+		},
+		{
+			Name:   "ID of the Regional Parameters",
+			Modify: func(d *Definition) { d.ID = EU_863_870 },
+		},
+		{
+			Name:   "no PHY versions",
+			Modify: func(d *Definition) { d.PHYVersions = nil },
+		},
+		{
+			Name:   "unknown PHY version",
+			Modify: func(d *Definition) { d.PHYVersions = []ttnpb.PHYVersion{ttnpb.PHYVersion_PHY_UNKNOWN} },
+		},
+		{
+			Name: "too many channels",
+			Modify: func(d *Definition) {
+				for i := 0; i < 16; i++ {
+					d.UplinkChannels = append(d.UplinkChannels, d.UplinkChannels[0])
+				}
+			},
+		},
+		{
+			Name:   "channel outside sub-bands",
+			Modify: func(d *Definition) { d.UplinkChannels[0].Frequency = 868100000 },
+		},
+		{
+			Name:   "channel with unknown data rate",
+			Modify: func(d *Definition) { d.UplinkChannels[0].MaxDataRate = 6 },
+		},
+		{
+			Name: "overlapping sub-bands",
+			Modify: func(d *Definition) {
+				d.SubBands[1].MinFrequency = d.SubBands[0].MaxFrequency
+			},
+		},
+		{
+			Name: "ambiguous data rate",
+			Modify: func(d *Definition) {
+				dr := d.DataRates[7]
+				dr.LoRa = &LoRaDataRateDefinition{SpreadingFactor: 7, Bandwidth: 250000}
+				d.DataRates[7] = dr
+			},
+		},
+		{
+			Name:   "incomplete Rx1 data rate table",
+			Modify: func(d *Definition) { d.Rx1DataRates = d.Rx1DataRates[:7] },
+		},
+		{
+			Name:   "increasing Tx offset",
+			Modify: func(d *Definition) { d.TxOffset = []float32{0, -2, 2} },
+		},
+		{
+			Name:   "Rx2 outside sub-bands",
+			Modify: func(d *Definition) { d.Rx2.Frequency = 869525000 },
+		},
+		{
+			Name:   "beacon without frequencies",
+			Modify: func(d *Definition) { d.Beacon.Frequencies = nil },
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			d := trialDefinition()
+			tc.Modify(&d)
+			_, err := d.Band()
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		})
+	}
+}
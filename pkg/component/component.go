@@ -202,6 +202,9 @@ func New(logger log.Stack, config *Config, opts ...Option) (c *Component, err er
 		return nil, err
 	}
 	c.frequencyPlans = frequencyplans.NewStore(fpsFetcher)
+	if err := c.frequencyPlans.RegisterBands(config.FrequencyPlans.Bands...); err != nil {
+		return nil, err
+	}
 
 	caStoreFetcher, err := config.MTLSAuthCAStoreFetcher(ctx, c)
 	if err != nil {
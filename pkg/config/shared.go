@@ -342,6 +342,7 @@ type FrequencyPlansConfig struct {
 	Directory    string            `name:"directory" description:"OS filesystem directory, which contains frequency plans"` //nolint:lll
 	URL          string            `name:"url" description:"URL, which contains frequency plans"`
 	Blob         BlobPathConfig    `name:"blob"`
+	Bands        []string          `name:"bands" description:"Files in the source of the frequency plans, which contain custom band definitions"` //nolint:lll
 }
 
 // Fetcher returns a fetch.Interface based on the configuration.
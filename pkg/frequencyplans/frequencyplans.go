@@ -423,10 +423,14 @@ var (
 
 // Validate returns an error if the frequency plan is invalid.
 func (fp FrequencyPlan) Validate() error {
-	_, err := band.GetLatest(fp.BandID)
-	if err != nil {
+	if _, err := band.GetLatest(fp.BandID); err != nil {
 		return err
 	}
+	return fp.validate()
+}
+
+// validate returns an error if the frequency plan is invalid, regardless of its band.
+func (fp FrequencyPlan) validate() error {
 	fpdt := fp.DwellTime
 	if (fpdt.GetUplinks() || fpdt.GetDownlinks()) && fpdt.Duration == nil {
 		return errNoDwellTimeDuration.New()
@@ -584,6 +588,9 @@ type Store struct {
 
 	frequencyPlansCache map[string]queryResult
 	frequencyPlansMu    sync.Mutex
+
+	bands   map[string]map[ttnpb.PHYVersion]*band.Band
+	bandsMu sync.RWMutex
 }
 
 // NewStore of frequency plans.
@@ -683,7 +690,10 @@ func (s *Store) getByID(id string) (*FrequencyPlan, error) {
 		}
 		proto = baseProto.Extend(proto)
 	}
-	if err := proto.Validate(); err != nil {
+	if _, err := s.GetLatestBand(proto.BandID); err != nil {
+		return nil, errInvalid.WithCause(err)
+	}
+	if err := proto.validate(); err != nil {
 		return nil, errInvalid.WithCause(err)
 	}
 	return &proto, nil
@@ -713,6 +723,105 @@ func (s *Store) GetByID(id string) (*FrequencyPlan, error) {
 	return fp, err
 }
 
+var (
+	errReadBand      = errors.Define("read_band", "read the band definition in `{file}`")
+	errNoBandVersion = errors.DefineNotFound("no_band_version", "version `{version}` of band `{id}` not found")
+)
+
+// RegisterBands fetches the custom band definitions in the files and registers the bands in the store, so that
+// the frequency plans of the store can reference them. Registering a custom band again replaces it.
+func (s *Store) RegisterBands(files ...string) error {
+	if len(files) == 0 {
+		return nil
+	}
+	if s == nil || s.Fetcher == nil {
+		return errNotConfigured.New()
+	}
+	bands := make(map[string]map[ttnpb.PHYVersion]*band.Band, len(files))
+	for _, file := range files {
+		content, err := s.Fetcher.File(file)
+		if err != nil {
+			return errReadBand.WithCause(errFetchFailed.WithCause(err)).WithAttributes("file", file)
+		}
+		var def band.Definition
+		if err := yaml.UnmarshalStrict(content, &def); err != nil {
+			return errReadBand.WithCause(errParseFile.WithCause(err)).WithAttributes("file", file)
+		}
+		b, err := def.Band()
+		if err != nil {
+			return errReadBand.WithCause(err).WithAttributes("file", file)
+		}
+		versions := make(map[ttnpb.PHYVersion]*band.Band, len(def.PHYVersions))
+		for _, ver := range def.PHYVersions {
+			versions[ver] = &b
+		}
+		bands[b.ID] = versions
+	}
+
+	s.bandsMu.Lock()
+	if s.bands == nil {
+		s.bands = make(map[string]map[ttnpb.PHYVersion]*band.Band, len(bands))
+	}
+	for id, versions := range bands {
+		s.bands[id] = versions
+	}
+	s.bandsMu.Unlock()
+
+	// NOTE: Frequency plans which reference the registered bands may have been cached as invalid.
+	s.frequencyPlansMu.Lock()
+	s.frequencyPlansCache = make(map[string]queryResult)
+	s.frequencyPlansMu.Unlock()
+	return nil
+}
+
+// GetBand returns the band with the given ID and version of the Regional Parameters.
+// The bands of the Regional Parameters take precedence over the custom bands registered in the store.
+func (s *Store) GetBand(id string, version ttnpb.PHYVersion) (*band.Band, error) {
+	b, err := band.Get(id, version)
+	if err == nil {
+		return &b, nil
+	}
+	if s == nil {
+		return nil, err
+	}
+	s.bandsMu.RLock()
+	defer s.bandsMu.RUnlock()
+	versions, ok := s.bands[id]
+	if !ok {
+		return nil, err
+	}
+	custom, ok := versions[version]
+	if !ok {
+		return nil, errNoBandVersion.WithAttributes("id", id, "version", version)
+	}
+	return custom, nil
+}
+
+// GetLatestBand returns the latest version of the band with the given ID.
+// The bands of the Regional Parameters take precedence over the custom bands registered in the store.
+func (s *Store) GetLatestBand(id string) (*band.Band, error) {
+	b, err := band.GetLatest(id)
+	if err == nil {
+		return &b, nil
+	}
+	if s == nil {
+		return nil, err
+	}
+	s.bandsMu.RLock()
+	defer s.bandsMu.RUnlock()
+	versions, ok := s.bands[id]
+	if !ok {
+		return nil, err
+	}
+	latest := ttnpb.PHYVersion_PHY_UNKNOWN
+	for ver := range versions {
+		if ver > latest {
+			latest = ver
+		}
+	}
+	return versions[latest], nil
+}
+
 // GetAllIDs returns the list of IDs of the available frequency plans.
 func (s *Store) GetAllIDs() ([]string, error) {
 	if s == nil {
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
@@ -243,6 +244,108 @@ uplink-channels:
 	}
 }
 
+func TestRegisterBands(t *testing.T) {
+	a := assertions.New(t)
+
+	store := frequencyplans.NewStore(fetch.NewMemFetcher(map[string][]byte{
+		"frequency-plans.yml": []byte(`- id: EU_873_TRIAL
+  description: Trial allocation
+  base-frequency: 868
+  file: EU_873_TRIAL.yml
+`),
+		"EU_873_TRIAL.yml": []byte(`band-id: EU_873_TRIAL
+uplink-channels:
+- frequency: 873100000
+  min-data-rate: 0
+  max-data-rate: 5
+`),
+		"bands/EU_873_TRIAL.yml": []byte(`id: EU_873_TRIAL
+phy-versions:
+- RP001_V1_0_3_REV_A
+- RP002_V1_0_4
+uplink-channels:
+- frequency: 873100000
+  max-data-rate: 5
+sub-bands:
+- min-frequency: 873000000
+  max-frequency: 874000000
+  duty-cycle: 0.01
+  max-eirp: 16.15
+data-rates:
+  0:
+    lora:
+      spreading-factor: 12
+      bandwidth: 125000
+      coding-rate: 4/5
+    max-mac-payload-size: 59
+  5:
+    lora:
+      spreading-factor: 7
+      bandwidth: 125000
+      coding-rate: 4/5
+    max-mac-payload-size: 250
+max-adr-data-rate-index: 5
+rx1-data-rates:
+- [0]
+- [0]
+- [0]
+- [0]
+- [0]
+- [5, 0]
+default-max-eirp: 16
+tx-offset: [0, -2, -4]
+rx2:
+  data-rate-index: 0
+  frequency: 873800000
+supports-dynamic-adr: true
+implements-cf-list: true
+strict-coding-rate: true
+`),
+		"bands/invalid.yml": []byte(`id: EU_873_INVALID
+unknown-field: true
+`),
+		"bands/builtin.yml": []byte(`id: EU_863_870
+`),
+	}))
+
+	_, err := store.GetByID("EU_873_TRIAL")
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+
+	a.So(store.RegisterBands("bands/invalid.yml"), should.NotBeNil)
+	a.So(store.RegisterBands("bands/builtin.yml"), should.NotBeNil)
+	a.So(store.RegisterBands("bands/unknown.yml"), should.NotBeNil)
+
+	if !a.So(store.RegisterBands("bands/EU_873_TRIAL.yml"), should.BeNil) {
+		t.FailNow()
+	}
+	phy, err := store.GetLatestBand("EU_873_TRIAL")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(phy.DefaultRx2Parameters.Frequency, should.Equal, 873800000)
+	_, err = store.GetBand("EU_873_TRIAL", ttnpb.PHYVersion_RP001_V1_0_3_REV_A)
+	a.So(err, should.BeNil)
+	_, err = store.GetBand("EU_873_TRIAL", ttnpb.PHYVersion_RP001_V1_0_2)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	_, err = store.GetBand(band.EU_863_870, ttnpb.PHYVersion_RP002_V1_0_4)
+	a.So(err, should.BeNil)
+
+	// Custom bands are registered in the store only.
+	_, err = band.GetLatest("EU_873_TRIAL")
+	a.So(err, should.NotBeNil)
+	_, err = frequencyplans.NewStore(store.Fetcher).GetByID("EU_873_TRIAL")
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+
+	// Registering the band again replaces it.
+	a.So(store.RegisterBands("bands/EU_873_TRIAL.yml"), should.BeNil)
+
+	fp, err := store.GetByID("EU_873_TRIAL")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(fp.BandID, should.Equal, "EU_873_TRIAL")
+}
+
 func TestProtoConversion(t *testing.T) {
 	for i, tc := range []struct {
 		Input  *frequencyplans.FrequencyPlan
@@ -330,9 +330,125 @@ type prefixJoinServerClient struct {
 	prefix types.EUI64Prefix
 }
 
+type networkServerHTTPClient struct {
+	clientProvider     httpclient.Provider
+	clientOpts         []httpclient.Option
+	protocol           ProtocolVersion
+	scheme, fqdn, path string
+	port               uint32
+	headers            map[string]string
+	username, password string
+	senderNSID         *types.EUI64
+}
+
+func (cl networkServerHTTPClient) exchange(ctx context.Context, header *NsNsMessageHeader, pld, res any) error {
+	if cl.protocol.RequiresNSID() && cl.senderNSID == nil {
+		return errMissingNSID.New()
+	}
+	if !cl.protocol.RequiresNSID() && cl.senderNSID != nil {
+		return errNSIDNotSupported.New()
+	}
+	header.ProtocolVersion = cl.protocol
+	header.SenderNSID = (*EUI64)(cl.senderNSID)
+
+	client, err := cl.clientProvider.HTTPClient(ctx, cl.clientOpts...)
+	if err != nil {
+		return err
+	}
+	if cl.scheme != "" && cl.scheme != "https" {
+		log.FromContext(ctx).WithField("scheme", cl.scheme).Warn("Use non-https scheme for contacting interop Network Server")
+	}
+	req, err := newHTTPRequest(
+		serverURL(cl.scheme, cl.fqdn, cl.path, cl.port), pld, cl.headers, cl.username, cl.password,
+	)
+	if err != nil {
+		return err
+	}
+	return httpExchange(ctx, req.WithContext(ctx), res, client.Do)
+}
+
+// PRStartRequest performs passive roaming start request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	req.MessageType = MessageTypePRStartReq
+	ans := &PRStartAns{}
+	if err := cl.exchange(ctx, &req.NsNsMessageHeader, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// PRStopRequest performs passive roaming stop request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) PRStopRequest(ctx context.Context, req *PRStopReq) (*PRStopAns, error) {
+	req.MessageType = MessageTypePRStopReq
+	ans := &PRStopAns{}
+	if err := cl.exchange(ctx, &req.NsNsMessageHeader, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// XmitDataRequest performs transmit data request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) XmitDataRequest(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+	req.MessageType = MessageTypeXmitDataReq
+	ans := &XmitDataAns{}
+	if err := cl.exchange(ctx, &req.NsNsMessageHeader, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// HRStartRequest performs handover roaming start request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) HRStartRequest(ctx context.Context, req *HRStartReq) (*HRStartAns, error) {
+	req.MessageType = MessageTypeHRStartReq
+	ans := &HRStartAns{}
+	if err := cl.exchange(ctx, &req.NsNsMessageHeader, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// HRStopRequest performs handover roaming stop request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) HRStopRequest(ctx context.Context, req *HRStopReq) (*HRStopAns, error) {
+	req.MessageType = MessageTypeHRStopReq
+	ans := &HRStopAns{}
+	if err := cl.exchange(ctx, &req.NsNsMessageHeader, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// ProfileRequest performs profile request according to LoRaWAN Backend Interfaces specification.
+func (cl networkServerHTTPClient) ProfileRequest(ctx context.Context, req *ProfileReq) (*ProfileAns, error) {
+	req.MessageType = MessageTypeProfileReq
+	ans := &ProfileAns{}
+	if err := cl.exchange(ctx, &req.NsNsMessageHeader, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
 // Client is an interop client.
 type Client struct {
-	joinServers []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	joinServers    []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	networkServers map[types.NetID]NetworkServer
 }
 
 var (
@@ -377,6 +493,10 @@ func NewClient(
 			Components []ComponentSelector `yaml:"components"`
 			JoinEUIs   []types.EUI64Prefix `yaml:"join-euis"`
 		} `yaml:"join-servers"`
+		NetworkServers []struct {
+			File   string        `yaml:"file"`
+			NetIDs []types.NetID `yaml:"net-ids"`
+		} `yaml:"network-servers"`
 	}
 	if err := yaml.UnmarshalStrict(confFileBytes, &yamlConf); err != nil {
 		return nil, err
@@ -466,6 +586,65 @@ func NewClient(
 			})
 		}
 	}
+	nss := make(map[types.NetID]NetworkServer, len(yamlConf.NetworkServers))
+	for _, nsEntry := range yamlConf.NetworkServers {
+		// Roaming partners are only contacted by Network Servers.
+		if selector != SelectorNetworkServer {
+			continue
+		}
+
+		fileParts := strings.Split(filepath.ToSlash(nsEntry.File), "/")
+		fetcher := fetch.WithBasePath(fetcher, fileParts[:len(fileParts)-1]...)
+		nsFileBytes, err := fetcher.File(fileParts[len(fileParts)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		var nsConf struct {
+			ComponentConfig `yaml:",inline"`
+			Path            string          `yaml:"path"`
+			Protocol        ProtocolVersion `yaml:"protocol"`
+			SenderNSID      *types.EUI64    `yaml:"sender-ns-id,omitempty"`
+		}
+		if err := yaml.UnmarshalStrict(nsFileBytes, &nsConf); err != nil {
+			return nil, err
+		}
+
+		var ns NetworkServer
+		switch nsConf.Protocol {
+		case ProtocolV1_0, ProtocolV1_1:
+			var opts []httpclient.Option
+			if !nsConf.TLS.IsZero() {
+				tlsConf, err := nsConf.TLS.TLSConfig(fetcher, c.KeyService())
+				if err != nil {
+					return nil, err
+				}
+				opts = append(opts, httpclient.WithTLSConfig(tlsConf))
+			}
+			if nsConf.DNSSuffix != "" || nsConf.FQDN == "" {
+				return nil, errDNSLookupNotSupported.New()
+			}
+			ns = &networkServerHTTPClient{
+				clientProvider: c,
+				clientOpts:     opts,
+				protocol:       nsConf.Protocol,
+				senderNSID:     nsConf.SenderNSID,
+				scheme:         nsConf.Scheme,
+				fqdn:           nsConf.FQDN,
+				path:           nsConf.Path,
+				port:           nsConf.Port,
+				headers:        nsConf.Headers,
+				username:       nsConf.BasicAuth.Username,
+				password:       nsConf.BasicAuth.Password,
+			}
+		default:
+			return nil, errUnknownProtocol.New()
+		}
+		for _, netID := range nsEntry.NetIDs {
+			nss[netID] = ns
+		}
+	}
+
 	sort.Slice(jss, func(i, j int) bool {
 		pi, pj := jss[i].prefix, jss[j].prefix
 		if pi.Length != pj.Length {
@@ -474,7 +653,8 @@ func NewClient(
 		return pi.EUI64.MarshalNumber() > pj.EUI64.MarshalNumber()
 	})
 	return &Client{
-		joinServers: jss,
+		joinServers:    jss,
+		networkServers: nss,
 	}, nil
 }
 
@@ -554,3 +734,57 @@ func (cl Client) HandleJoinRequest(
 		return js.HandleJoinRequest(ctx, netID, nsID, req)
 	}, jss)
 }
+
+// PRStartRequest performs passive roaming start request to the Network Server associated with req.ReceiverID.
+func (cl Client) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.PRStartRequest(ctx, req)
+}
+
+// PRStopRequest performs passive roaming stop request to the Network Server associated with req.ReceiverID.
+func (cl Client) PRStopRequest(ctx context.Context, req *PRStopReq) (*PRStopAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.PRStopRequest(ctx, req)
+}
+
+// XmitDataRequest performs transmit data request to the Network Server associated with req.ReceiverID.
+func (cl Client) XmitDataRequest(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.XmitDataRequest(ctx, req)
+}
+
+// HRStartRequest performs handover roaming start request to the Network Server associated with req.ReceiverID.
+func (cl Client) HRStartRequest(ctx context.Context, req *HRStartReq) (*HRStartAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.HRStartRequest(ctx, req)
+}
+
+// HRStopRequest performs handover roaming stop request to the Network Server associated with req.ReceiverID.
+func (cl Client) HRStopRequest(ctx context.Context, req *HRStopReq) (*HRStopAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.HRStopRequest(ctx, req)
+}
+
+// ProfileRequest performs profile request to the Network Server associated with req.ReceiverID.
+func (cl Client) ProfileRequest(ctx context.Context, req *ProfileReq) (*ProfileAns, error) {
+	ns, ok := cl.networkServers[types.NetID(req.ReceiverID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns.ProfileRequest(ctx, req)
+}
@@ -15,6 +15,7 @@
 package interop_test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -865,3 +866,273 @@ func TestJoinServerRace(t *testing.T) { //nolint:paralleltest
 		})
 	}
 }
+
+func TestNetworkServerRequests(t *testing.T) { //nolint:paralleltest
+	recvTime := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct { //nolint:paralleltest
+		Name           string
+		Handler        func(*assertions.Assertion, map[string]any) map[string]any
+		Do             func(context.Context, *Client) (any, error)
+		Expected       any
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "PRStartReq/Success",
+			Handler: func(a *assertions.Assertion, req map[string]any) map[string]any {
+				a.So(req, should.Resemble, map[string]any{
+					"ProtocolVersion": "1.0",
+					"TransactionID":   0.0,
+					"MessageType":     "PRStartReq",
+					"SenderID":        "000001",
+					"ReceiverID":      "000013",
+					"PHYPayload":      "40040302268000010001",
+					"ULMetaData": map[string]any{
+						"DevAddr":   "26020304",
+						"Confirmed": false,
+						"DataRate":  5.0,
+						"ULFreq":    868.1,
+						"RecvTime":  "2026-10-19T12:00:00Z",
+						"RFRegion":  "EU868",
+						"GWCnt":     1.0,
+						"GWInfo": []any{
+							map[string]any{
+								"ID":        "0102030405060708",
+								"RSSI":      -42.0,
+								"SNR":       5.5,
+								"ULToken":   "AABB",
+								"DLAllowed": true,
+							},
+						},
+					},
+				})
+				return map[string]any{
+					"ProtocolVersion": "1.0",
+					"TransactionID":   0.0,
+					"MessageType":     "PRStartAns",
+					"SenderID":        "000013",
+					"ReceiverID":      "000001",
+					"Result": map[string]any{
+						"ResultCode": "Success",
+					},
+					"Lifetime": 0.0,
+				}
+			},
+			Do: func(ctx context.Context, cl *Client) (any, error) {
+				return cl.PRStartRequest(ctx, &PRStartReq{
+					NsNsMessageHeader: NsNsMessageHeader{
+						SenderID:   NetID{0x0, 0x0, 0x01},
+						ReceiverID: NetID{0x0, 0x0, 0x13},
+					},
+					PHYPayload: Buffer{0x40, 0x04, 0x03, 0x02, 0x26, 0x80, 0x00, 0x01, 0x00, 0x01},
+					ULMetaData: ULMetaData{
+						DevAddr:  &DevAddr{0x26, 0x02, 0x03, 0x04},
+						DataRate: func(v uint32) *uint32 { return &v }(5),
+						ULFreq:   func(v float64) *float64 { return &v }(868.1),
+						RecvTime: recvTime,
+						RFRegion: "EU868",
+						GWCnt:    1,
+						GWInfo: []GWInfoElement{
+							{
+								ID:        Buffer{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+								RSSI:      func(v int32) *int32 { return &v }(-42),
+								SNR:       func(v float32) *float32 { return &v }(5.5),
+								ULToken:   Buffer{0xaa, 0xbb},
+								DLAllowed: true,
+							},
+						},
+					},
+				})
+			},
+			Expected: &PRStartAns{
+				NsNsMessageHeader: NsNsMessageHeader{
+					MessageHeader: MessageHeader{
+						ProtocolVersion: ProtocolV1_0,
+						MessageType:     MessageTypePRStartAns,
+					},
+					SenderID:   NetID{0x0, 0x0, 0x13},
+					ReceiverID: NetID{0x0, 0x0, 0x01},
+				},
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+				Lifetime: func(v uint32) *uint32 { return &v }(0),
+			},
+			ErrorAssertion: func(err error) bool { return err == nil },
+		},
+		{
+			Name: "PRStartReq/UnknownDevAddr",
+			Handler: func(a *assertions.Assertion, req map[string]any) map[string]any {
+				a.So(req["MessageType"], should.Equal, "PRStartReq")
+				return map[string]any{
+					"ProtocolVersion": "1.0",
+					"TransactionID":   0.0,
+					"MessageType":     "PRStartAns",
+					"SenderID":        "000013",
+					"ReceiverID":      "000001",
+					"Result": map[string]any{
+						"ResultCode": "UnknownDevAddr",
+					},
+				}
+			},
+			Do: func(ctx context.Context, cl *Client) (any, error) {
+				return cl.PRStartRequest(ctx, &PRStartReq{
+					NsNsMessageHeader: NsNsMessageHeader{
+						SenderID:   NetID{0x0, 0x0, 0x01},
+						ReceiverID: NetID{0x0, 0x0, 0x13},
+					},
+				})
+			},
+			ErrorAssertion: func(err error) bool { return errors.Resemble(err, ErrUnknownDevAddr) },
+		},
+		{
+			Name: "PRStartReq/NotRegistered",
+			Do: func(ctx context.Context, cl *Client) (any, error) {
+				return cl.PRStartRequest(ctx, &PRStartReq{
+					NsNsMessageHeader: NsNsMessageHeader{
+						SenderID:   NetID{0x0, 0x0, 0x01},
+						ReceiverID: NetID{0x0, 0x0, 0x14},
+					},
+				})
+			},
+			ErrorAssertion: errors.IsNotFound,
+		},
+		{
+			Name: "XmitDataReq/Success",
+			Handler: func(a *assertions.Assertion, req map[string]any) map[string]any {
+				a.So(req, should.Resemble, map[string]any{
+					"ProtocolVersion": "1.0",
+					"TransactionID":   0.0,
+					"MessageType":     "XmitDataReq",
+					"SenderID":        "000001",
+					"ReceiverID":      "000013",
+					"PHYPayload":      "60040302260000000001",
+					"DLMetaData": map[string]any{
+						"Confirmed":      false,
+						"DLFreq1":        868.1,
+						"RXDelay1":       1.0,
+						"ClassMode":      "A",
+						"DataRate1":      5.0,
+						"GWInfo":         []any{map[string]any{"ULToken": "AABB", "DLAllowed": true}},
+						"HiPriorityFlag": false,
+					},
+				})
+				return map[string]any{
+					"ProtocolVersion": "1.0",
+					"TransactionID":   0.0,
+					"MessageType":     "XmitDataAns",
+					"SenderID":        "000013",
+					"ReceiverID":      "000001",
+					"Result": map[string]any{
+						"ResultCode": "Success",
+					},
+					"DLFreq1": 868.1,
+				}
+			},
+			Do: func(ctx context.Context, cl *Client) (any, error) {
+				return cl.XmitDataRequest(ctx, &XmitDataReq{
+					NsNsMessageHeader: NsNsMessageHeader{
+						SenderID:   NetID{0x0, 0x0, 0x01},
+						ReceiverID: NetID{0x0, 0x0, 0x13},
+					},
+					PHYPayload: Buffer{0x60, 0x04, 0x03, 0x02, 0x26, 0x00, 0x00, 0x00, 0x00, 0x01},
+					DLMetaData: &DLMetaData{
+						DLFreq1:   func(v float64) *float64 { return &v }(868.1),
+						RXDelay1:  1,
+						ClassMode: "A",
+						DataRate1: func(v uint32) *uint32 { return &v }(5),
+						GWInfo: []GWInfoElement{
+							{
+								ULToken:   Buffer{0xaa, 0xbb},
+								DLAllowed: true,
+							},
+						},
+					},
+				})
+			},
+			Expected: &XmitDataAns{
+				NsNsMessageHeader: NsNsMessageHeader{
+					MessageHeader: MessageHeader{
+						ProtocolVersion: ProtocolV1_0,
+						MessageType:     MessageTypeXmitDataAns,
+					},
+					SenderID:   NetID{0x0, 0x0, 0x13},
+					ReceiverID: NetID{0x0, 0x0, 0x01},
+				},
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+				DLFreq1: func(v float64) *float64 { return &v }(868.1),
+			},
+			ErrorAssertion: func(err error) bool { return err == nil },
+		},
+		{
+			Name: "XmitDataReq/XmitFailed",
+			Handler: func(a *assertions.Assertion, req map[string]any) map[string]any {
+				a.So(req["MessageType"], should.Equal, "XmitDataReq")
+				return map[string]any{
+					"ProtocolVersion": "1.0",
+					"TransactionID":   0.0,
+					"MessageType":     "XmitDataAns",
+					"SenderID":        "000013",
+					"ReceiverID":      "000001",
+					"Result": map[string]any{
+						"ResultCode": "XmitFailed",
+					},
+				}
+			},
+			Do: func(ctx context.Context, cl *Client) (any, error) {
+				return cl.XmitDataRequest(ctx, &XmitDataReq{
+					NsNsMessageHeader: NsNsMessageHeader{
+						SenderID:   NetID{0x0, 0x0, 0x01},
+						ReceiverID: NetID{0x0, 0x0, 0x13},
+					},
+				})
+			},
+			ErrorAssertion: func(err error) bool { return errors.Resemble(err, ErrTransmitFailed) },
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ctx := test.Context()
+			ctx = log.NewContext(ctx, test.GetLogger(t))
+
+			srv := newTLSServer(9185, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				a.So(r.Method, should.Equal, http.MethodPost)
+				a.So(r.URL.Path, should.Equal, "/test-roaming-path")
+				a.So(r.Header.Get("TestHeader"), should.Equal, "baz")
+				if tc.Handler == nil {
+					t.Error("Unexpected request")
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				var req map[string]any
+				test.Must[any](nil, json.Unmarshal(test.Must(io.ReadAll(r.Body)), &req))
+				test.Must[any](nil, json.NewEncoder(w).Encode(tc.Handler(a, req)))
+			}))
+			defer srv.Close()
+
+			c := componenttest.NewComponent(t, &component.Config{})
+			componenttest.StartComponent(t, c)
+			defer c.Close()
+
+			cl, err := NewClient(ctx, config.InteropClient{
+				ConfigSource: "directory",
+				Directory:    "testdata/client",
+			}, c, SelectorNetworkServer)
+			if !a.So(err, should.BeNil) {
+				t.Fatalf("Failed to create new client: %s", err)
+			}
+
+			res, err := tc.Do(ctx, cl)
+			if !a.So(tc.ErrorAssertion(err), should.BeTrue) {
+				t.Fatalf("Unexpected error: %v", errors.Stack(err))
+			}
+			if err == nil {
+				a.So(res, should.Resemble, tc.Expected)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@
 package interop
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -169,3 +171,190 @@ type HomeNSAns struct {
 	HNSID  *EUI64 `json:",omitempty"`
 	HNetID NetID
 }
+
+// NsNsMessageHeader contains the message header for NS to NS messages.
+type NsNsMessageHeader struct {
+	MessageHeader
+	SenderID     NetID
+	SenderNSID   *EUI64 `json:",omitempty"`
+	ReceiverID   NetID
+	ReceiverNSID *EUI64 `json:",omitempty"`
+}
+
+// AnswerHeader returns the header of the answer message.
+func (h NsNsMessageHeader) AnswerHeader() (NsNsMessageHeader, error) {
+	header, err := h.MessageHeader.AnswerHeader()
+	if err != nil {
+		return NsNsMessageHeader{}, err
+	}
+	return NsNsMessageHeader{
+		MessageHeader: header,
+		SenderID:      h.ReceiverID,
+		SenderNSID:    h.ReceiverNSID,
+		ReceiverID:    h.SenderID,
+		ReceiverNSID:  h.SenderNSID,
+	}, nil
+}
+
+// GWInfoElement contains the metadata of a gateway that received an uplink message.
+type GWInfoElement struct {
+	ID        Buffer   `json:",omitempty"`
+	RFRegion  string   `json:",omitempty"`
+	RSSI      *int32   `json:",omitempty"`
+	SNR       *float32 `json:",omitempty"`
+	Lat       *float64 `json:",omitempty"`
+	Lon       *float64 `json:",omitempty"`
+	ULToken   Buffer   `json:",omitempty"`
+	DLAllowed bool
+}
+
+// ULMetaData contains the metadata of an uplink message.
+type ULMetaData struct {
+	DevEUI     *EUI64   `json:",omitempty"`
+	DevAddr    *DevAddr `json:",omitempty"`
+	FPort      *uint32  `json:",omitempty"`
+	FCntDown   *uint32  `json:",omitempty"`
+	FCntUp     *uint32  `json:",omitempty"`
+	Confirmed  bool
+	DataRate   *uint32  `json:",omitempty"`
+	ULFreq     *float64 `json:",omitempty"` // MHz.
+	Margin     *int32   `json:",omitempty"`
+	Battery    *uint32  `json:",omitempty"`
+	FNSULToken Buffer   `json:",omitempty"`
+	RecvTime   time.Time
+	RFRegion   string `json:",omitempty"`
+	GWCnt      uint32
+	GWInfo     []GWInfoElement
+}
+
+// DLMetaData contains the metadata of a downlink message.
+type DLMetaData struct {
+	DevEUI         *EUI64  `json:",omitempty"`
+	FPort          *uint32 `json:",omitempty"`
+	FCntDown       *uint32 `json:",omitempty"`
+	Confirmed      bool
+	DLFreq1        *float64 `json:",omitempty"` // MHz.
+	DLFreq2        *float64 `json:",omitempty"` // MHz.
+	RXDelay1       uint32
+	ClassMode      string  `json:",omitempty"`
+	DataRate1      *uint32 `json:",omitempty"`
+	DataRate2      *uint32 `json:",omitempty"`
+	FNSULToken     Buffer  `json:",omitempty"`
+	GWInfo         []GWInfoElement
+	HiPriorityFlag bool
+}
+
+// PRStartReq is a passive roaming start request message.
+type PRStartReq struct {
+	NsNsMessageHeader
+	PHYPayload Buffer
+	ULMetaData ULMetaData
+}
+
+// PRStartAns is an answer to a PRStartReq message.
+type PRStartAns struct {
+	NsNsMessageHeader
+	Result      Result
+	PHYPayload  Buffer       `json:",omitempty"`
+	DevEUI      *EUI64       `json:",omitempty"`
+	Lifetime    *uint32      `json:",omitempty"`
+	FNwkSIntKey *KeyEnvelope `json:",omitempty"`
+	NwkSKey     *KeyEnvelope `json:",omitempty"`
+	FCntUp      *uint32      `json:",omitempty"`
+	DLMetaData  *DLMetaData  `json:",omitempty"`
+}
+
+// PRStopReq is a passive roaming stop request message.
+type PRStopReq struct {
+	NsNsMessageHeader
+	DevEUI   EUI64
+	Lifetime *uint32 `json:",omitempty"`
+}
+
+// PRStopAns is an answer to a PRStopReq message.
+type PRStopAns struct {
+	NsNsMessageHeader
+	Result Result
+}
+
+// DeviceProfile is the profile of an end device as specified in LoRaWAN Backend Interfaces.
+type DeviceProfile struct {
+	DeviceProfileID    string `json:",omitempty"`
+	SupportsClassB     bool
+	SupportsClassC     bool
+	ClassCTimeout      uint32
+	MACVersion         MACVersion
+	RegParamsRevision  RegParamsRevision
+	SupportsJoin       bool
+	RXDelay1           uint32
+	RXDROffset1        uint32
+	RXDataRate2        uint32
+	RXFreq2            float64   // MHz.
+	FactoryPresetFreqs []float64 `json:",omitempty"` // MHz.
+	RFRegion           string
+	Supports32bitFCnt  bool
+}
+
+// HRStartReq is a handover roaming start request message.
+// The session keys are encrypted with a KEK that is shared between the Network Servers.
+type HRStartReq struct {
+	NsNsMessageHeader
+	DevEUI        EUI64
+	DevAddr       DevAddr
+	DeviceProfile *DeviceProfile `json:",omitempty"`
+	Lifetime      *uint32        `json:",omitempty"`
+	FNwkSIntKey   *KeyEnvelope   `json:",omitempty"`
+	SNwkSIntKey   *KeyEnvelope   `json:",omitempty"`
+	NwkSEncKey    *KeyEnvelope   `json:",omitempty"`
+	NwkSKey       *KeyEnvelope   `json:",omitempty"`
+}
+
+// HRStartAns is an answer to a HRStartReq message.
+type HRStartAns struct {
+	NsNsMessageHeader
+	Result   Result
+	Lifetime *uint32 `json:",omitempty"`
+}
+
+// HRStopReq is a handover roaming stop request message.
+type HRStopReq struct {
+	NsNsMessageHeader
+	DevEUI EUI64
+}
+
+// HRStopAns is an answer to a HRStopReq message.
+type HRStopAns struct {
+	NsNsMessageHeader
+	Result Result
+}
+
+// ProfileReq is a request message for the profile of an end device.
+type ProfileReq struct {
+	NsNsMessageHeader
+	DevEUI EUI64
+}
+
+// ProfileAns is an answer to a ProfileReq message.
+type ProfileAns struct {
+	NsNsMessageHeader
+	Result                Result
+	DeviceProfile         *DeviceProfile        `json:",omitempty"`
+	RoamingActivationType RoamingActivationType `json:",omitempty"`
+}
+
+// XmitDataReq is a request message to transmit data.
+type XmitDataReq struct {
+	NsNsMessageHeader
+	PHYPayload Buffer      `json:",omitempty"`
+	FRMPayload Buffer      `json:",omitempty"`
+	ULMetaData *ULMetaData `json:",omitempty"`
+	DLMetaData *DLMetaData `json:",omitempty"`
+}
+
+// XmitDataAns is an answer to a XmitDataReq message.
+type XmitDataAns struct {
+	NsNsMessageHeader
+	Result  Result
+	DLFreq1 *float64 `json:",omitempty"` // MHz.
+	DLFreq2 *float64 `json:",omitempty"` // MHz.
+}
@@ -51,6 +51,16 @@ type JoinServer interface {
 	HomeNSRequest(context.Context, *HomeNSReq) (*TTIHomeNSAns, error)
 }
 
+// NetworkServer represents a Network Server as specified in LoRaWAN Backend Interfaces.
+type NetworkServer interface {
+	PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error)
+	PRStopRequest(context.Context, *PRStopReq) (*PRStopAns, error)
+	XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error)
+	HRStartRequest(context.Context, *HRStartReq) (*HRStartAns, error)
+	HRStopRequest(context.Context, *HRStopReq) (*HRStopAns, error)
+	ProfileRequest(context.Context, *ProfileReq) (*ProfileAns, error)
+}
+
 type noopServer struct{}
 
 func (noopServer) JoinRequest(context.Context, *JoinReq) (*JoinAns, error) {
@@ -65,6 +75,30 @@ func (noopServer) HomeNSRequest(context.Context, *HomeNSReq) (*TTIHomeNSAns, err
 	return nil, ErrMalformedMessage.New()
 }
 
+func (noopServer) PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error) {
+	return nil, ErrNoRoamingAgreement.New()
+}
+
+func (noopServer) PRStopRequest(context.Context, *PRStopReq) (*PRStopAns, error) {
+	return nil, ErrNoRoamingAgreement.New()
+}
+
+func (noopServer) XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error) {
+	return nil, ErrNoRoamingAgreement.New()
+}
+
+func (noopServer) HRStartRequest(context.Context, *HRStartReq) (*HRStartAns, error) {
+	return nil, ErrNoRoamingAgreement.New()
+}
+
+func (noopServer) HRStopRequest(context.Context, *HRStopReq) (*HRStopAns, error) {
+	return nil, ErrNoRoamingAgreement.New()
+}
+
+func (noopServer) ProfileRequest(context.Context, *ProfileReq) (*ProfileAns, error) {
+	return nil, ErrNoRoamingAgreement.New()
+}
+
 // Server is the server.
 type Server struct {
 	config config.InteropServer
@@ -79,6 +113,7 @@ type Server struct {
 
 	is IdentityServer
 	js JoinServer
+	ns NetworkServer
 }
 
 // Component represents the Component to the Interop Server.
@@ -127,6 +162,7 @@ func NewServer(c Component, contextFiller fillcontext.Filler, conf config.Intero
 		senderClientCAPool: senderClientCAPool,
 		tokenVerifiers:     tokenVerifiers,
 		js:                 &noopServer{},
+		ns:                 &noopServer{},
 	}
 
 	s.router = mux.NewRouter()
@@ -165,6 +201,11 @@ func (s *Server) RegisterJS(js JoinServer) {
 	s.js = js
 }
 
+// RegisterNS registers the Network Server for NS-NS messages.
+func (s *Server) RegisterNS(ns NetworkServer) {
+	s.ns = ns
+}
+
 // ClientCAPool returns a certificate pool of all configured client CAs.
 // TODO: Remove (https://github.com/TheThingsNetwork/lorawan-stack/issues/6026)
 func (s *Server) ClientCAPool() *x509.CertPool {
@@ -182,10 +223,16 @@ func (s *Server) SenderClientCAs(_ context.Context, senderID string) ([]*x509.Ce
 
 func (s *Server) handle() http.Handler {
 	senderAuthenticators := map[MessageType]senderAuthenticator{
-		MessageTypeJoinReq:    senderAuthenticatorFunc(s.authenticateNS),
-		MessageTypeRejoinReq:  senderAuthenticatorFunc(s.authenticateNS),
-		MessageTypeAppSKeyReq: senderAuthenticatorFunc(s.authenticateAS),
-		MessageTypeHomeNSReq:  senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeJoinReq:     senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeRejoinReq:   senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeAppSKeyReq:  senderAuthenticatorFunc(s.authenticateAS),
+		MessageTypeHomeNSReq:   senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypePRStartReq:  senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypePRStopReq:   senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeXmitDataReq: senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeHRStartReq:  senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeHRStopReq:   senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeProfileReq:  senderAuthenticatorFunc(s.authenticateNS),
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -241,6 +288,18 @@ func (s *Server) handle() http.Handler {
 			msg = &AppSKeyReq{}
 		case MessageTypeHomeNSReq:
 			msg = &HomeNSReq{}
+		case MessageTypePRStartReq:
+			msg = &PRStartReq{}
+		case MessageTypePRStopReq:
+			msg = &PRStopReq{}
+		case MessageTypeXmitDataReq:
+			msg = &XmitDataReq{}
+		case MessageTypeHRStartReq:
+			msg = &HRStartReq{}
+		case MessageTypeHRStopReq:
+			msg = &HRStopReq{}
+		case MessageTypeProfileReq:
+			msg = &ProfileReq{}
 		default:
 			writeError(w, r, header, ErrMalformedMessage.New())
 			return
@@ -264,6 +323,18 @@ func (s *Server) handle() http.Handler {
 			ans, err = js.HomeNSRequest(ctx, req)
 		case *AppSKeyReq:
 			ans, err = s.js.AppSKeyRequest(ctx, req)
+		case *PRStartReq:
+			ans, err = s.ns.PRStartRequest(ctx, req)
+		case *PRStopReq:
+			ans, err = s.ns.PRStopRequest(ctx, req)
+		case *XmitDataReq:
+			ans, err = s.ns.XmitDataRequest(ctx, req)
+		case *HRStartReq:
+			ans, err = s.ns.HRStartRequest(ctx, req)
+		case *HRStopReq:
+			ans, err = s.ns.HRStopRequest(ctx, req)
+		case *ProfileReq:
+			ans, err = s.ns.ProfileRequest(ctx, req)
 		default:
 			writeError(w, r, header, ErrMalformedMessage.New())
 			return
@@ -78,6 +78,57 @@ func (m mockTarget) HomeNSRequest(ctx context.Context, req *interop.HomeNSReq) (
 	panic("HomeNSRequest called but not registered")
 }
 
+type mockNetworkServer struct {
+	PRStartRequestFunc  func(context.Context, *interop.PRStartReq) (*interop.PRStartAns, error)
+	PRStopRequestFunc   func(context.Context, *interop.PRStopReq) (*interop.PRStopAns, error)
+	XmitDataRequestFunc func(context.Context, *interop.XmitDataReq) (*interop.XmitDataAns, error)
+	HRStartRequestFunc  func(context.Context, *interop.HRStartReq) (*interop.HRStartAns, error)
+	HRStopRequestFunc   func(context.Context, *interop.HRStopReq) (*interop.HRStopAns, error)
+	ProfileRequestFunc  func(context.Context, *interop.ProfileReq) (*interop.ProfileAns, error)
+}
+
+func (m mockNetworkServer) PRStartRequest(ctx context.Context, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	if m.PRStartRequestFunc != nil {
+		return m.PRStartRequestFunc(ctx, req)
+	}
+	panic("PRStartRequest called but not registered")
+}
+
+func (m mockNetworkServer) PRStopRequest(ctx context.Context, req *interop.PRStopReq) (*interop.PRStopAns, error) {
+	if m.PRStopRequestFunc != nil {
+		return m.PRStopRequestFunc(ctx, req)
+	}
+	panic("PRStopRequest called but not registered")
+}
+
+func (m mockNetworkServer) XmitDataRequest(ctx context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	if m.XmitDataRequestFunc != nil {
+		return m.XmitDataRequestFunc(ctx, req)
+	}
+	panic("XmitDataRequest called but not registered")
+}
+
+func (m mockNetworkServer) HRStartRequest(ctx context.Context, req *interop.HRStartReq) (*interop.HRStartAns, error) {
+	if m.HRStartRequestFunc != nil {
+		return m.HRStartRequestFunc(ctx, req)
+	}
+	panic("HRStartRequest called but not registered")
+}
+
+func (m mockNetworkServer) HRStopRequest(ctx context.Context, req *interop.HRStopReq) (*interop.HRStopAns, error) {
+	if m.HRStopRequestFunc != nil {
+		return m.HRStopRequestFunc(ctx, req)
+	}
+	panic("HRStopRequest called but not registered")
+}
+
+func (m mockNetworkServer) ProfileRequest(ctx context.Context, req *interop.ProfileReq) (*interop.ProfileAns, error) {
+	if m.ProfileRequestFunc != nil {
+		return m.ProfileRequestFunc(ctx, req)
+	}
+	panic("ProfileRequest called but not registered")
+}
+
 func TestServer(t *testing.T) { //nolint:gocyclo
 	t.Parallel()
 
@@ -86,6 +137,7 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 	for _, tc := range []struct {
 		Name              string
 		JS                interop.JoinServer
+		NS                interop.NetworkServer
 		ClientTLSConfig   *tls.Config
 		PacketBrokerToken bool
 		RequestBody       any
@@ -306,6 +358,134 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 					a.So(msg.HNSID, should.Resemble, &interop.EUI64{0x42, 0x42, 0x42, 0x0, 0x0, 0x0, 0x0, 0x0})
 			},
 		},
+		{
+			Name:            "ClientTLS/PRStartReq/NotRegistered",
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.PRStartReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypePRStartReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypePRStartAns) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultNoRoamingAgreement)
+			},
+		},
+		{
+			Name: "ClientTLS/PRStartReq/Success",
+			NS: mockNetworkServer{
+				PRStartRequestFunc: func(ctx context.Context, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+					if err := authorizer.RequireNetID(ctx, types.NetID{0x0, 0x0, 0x1}); err != nil {
+						return nil, err
+					}
+					if !types.DevAddr(*req.ULMetaData.DevAddr).Equal(types.DevAddr{0x26, 0x0, 0x0, 0x1}) {
+						return nil, interop.ErrUnknownDevAddr.New()
+					}
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					return &interop.PRStartAns{
+						NsNsMessageHeader: header,
+						Result: interop.Result{
+							ResultCode: interop.ResultSuccess,
+						},
+					}, nil
+				},
+			},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.PRStartReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypePRStartReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+				ULMetaData: interop.ULMetaData{
+					DevAddr: &interop.DevAddr{0x26, 0x0, 0x0, 0x1},
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.PRStartAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultSuccess) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypePRStartAns) &&
+					a.So(msg.SenderID, should.Resemble, interop.NetID{0x0, 0x0, 0x13}) &&
+					a.So(msg.ReceiverID, should.Resemble, interop.NetID{0x0, 0x0, 0x01})
+			},
+		},
+		{
+			Name: "ClientTLS/HRStartReq/Success",
+			NS: mockNetworkServer{
+				HRStartRequestFunc: func(ctx context.Context, req *interop.HRStartReq) (*interop.HRStartAns, error) {
+					if err := authorizer.RequireNetID(ctx, types.NetID{0x0, 0x0, 0x1}); err != nil {
+						return nil, err
+					}
+					if req.DeviceProfile == nil ||
+						req.DeviceProfile.RegParamsRevision != interop.RegParamsRevision(ttnpb.PHYVersion_RP001_V1_0_2_REV_B) {
+						return nil, interop.ErrMalformedMessage.New()
+					}
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					lifetime := uint32(0)
+					return &interop.HRStartAns{
+						NsNsMessageHeader: header,
+						Result: interop.Result{
+							ResultCode: interop.ResultSuccess,
+						},
+						Lifetime: &lifetime,
+					}, nil
+				},
+			},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.HRStartReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeHRStartReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+				DevEUI:  interop.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+				DevAddr: interop.DevAddr{0x02, 0x0, 0x0, 0x1},
+				DeviceProfile: &interop.DeviceProfile{
+					MACVersion:        interop.MACVersion(ttnpb.MACVersion_MAC_V1_0_2),
+					RegParamsRevision: interop.RegParamsRevision(ttnpb.PHYVersion_RP001_V1_0_2_REV_B),
+					RFRegion:          "EU868",
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.HRStartAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultSuccess) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypeHRStartAns) &&
+					a.So(*msg.Lifetime, should.Equal, 0)
+			},
+		},
 	} {
 		tc := tc
 		test.RunSubtest(t, test.SubtestConfig{
@@ -334,6 +514,9 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 				if tc.JS != nil {
 					s.RegisterJS(tc.JS)
 				}
+				if tc.NS != nil {
+					s.RegisterNS(tc.NS)
+				}
 
 				srv := newTLSServer(0, s)
 				defer srv.Close()
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"strings"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -269,6 +270,53 @@ func (v *MACVersion) UnmarshalText(data []byte) error {
 	return nil
 }
 
+// RegParamsRevision is the revision of the Regional Parameters.
+type RegParamsRevision ttnpb.PHYVersion
+
+var regParamsRevisions = map[ttnpb.PHYVersion]string{
+	ttnpb.PHYVersion_TS001_V1_0:         "1.0",
+	ttnpb.PHYVersion_TS001_V1_0_1:       "1.0.1",
+	ttnpb.PHYVersion_RP001_V1_0_2:       "A",
+	ttnpb.PHYVersion_RP001_V1_0_2_REV_B: "B",
+	ttnpb.PHYVersion_RP001_V1_0_3_REV_A: "RP001-1.0.3-A",
+	ttnpb.PHYVersion_RP001_V1_1_REV_A:   "RP001-1.1-A",
+	ttnpb.PHYVersion_RP001_V1_1_REV_B:   "RP001-1.1-B",
+	ttnpb.PHYVersion_RP002_V1_0_0:       "RP002-1.0.0",
+	ttnpb.PHYVersion_RP002_V1_0_1:       "RP002-1.0.1",
+	ttnpb.PHYVersion_RP002_V1_0_2:       "RP002-1.0.2",
+	ttnpb.PHYVersion_RP002_V1_0_3:       "RP002-1.0.3",
+	ttnpb.PHYVersion_RP002_V1_0_4:       "RP002-1.0.4",
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v RegParamsRevision) MarshalText() ([]byte, error) {
+	res, ok := regParamsRevisions[ttnpb.PHYVersion(v)]
+	if !ok {
+		return nil, errUnknownRegParamsRevision.New()
+	}
+	return []byte(res), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *RegParamsRevision) UnmarshalText(data []byte) error {
+	for phyVersion, revision := range regParamsRevisions {
+		if revision == string(data) {
+			*v = RegParamsRevision(phyVersion)
+			return nil
+		}
+	}
+	return errUnknownRegParamsRevision.New()
+}
+
+// RoamingActivationType is the type of roaming that is used for an end device.
+type RoamingActivationType string
+
+// Roaming activation types.
+const (
+	RoamingActivationPassive  RoamingActivationType = "Passive"
+	RoamingActivationHandover RoamingActivationType = "Handover"
+)
+
 // Buffer is a binary buffer that is represented as hexadecimal in text.
 type Buffer []byte
 
@@ -419,3 +467,36 @@ func (n *DevAddr) UnmarshalText(data []byte) error {
 	copy(n[:], buf)
 	return nil
 }
+
+var rfRegionBandIDs = map[string]string{
+	"EU868":   band.EU_863_870,
+	"US915":   band.US_902_928,
+	"CN779":   band.CN_779_787,
+	"EU433":   band.EU_433,
+	"AU915":   band.AU_915_928,
+	"CN470":   band.CN_470_510,
+	"AS923":   band.AS_923,
+	"AS923-2": band.AS_923_2,
+	"AS923-3": band.AS_923_3,
+	"AS923-4": band.AS_923_4,
+	"KR920":   band.KR_920_923,
+	"IN865":   band.IN_865_867,
+	"RU864":   band.RU_864_870,
+	"ISM2400": band.ISM_2400,
+}
+
+// BandIDFromRFRegion returns the band ID of the given LoRaWAN Regional Parameters region name, e.g. EU868.
+func BandIDFromRFRegion(rfRegion string) (string, bool) {
+	id, ok := rfRegionBandIDs[rfRegion]
+	return id, ok
+}
+
+// RFRegionFromBandID returns the LoRaWAN Regional Parameters region name of the given band ID.
+func RFRegionFromBandID(bandID string) (string, bool) {
+	for rfRegion, id := range rfRegionBandIDs {
+		if id == bandID {
+			return rfRegion, true
+		}
+	}
+	return "", false
+}
@@ -102,3 +102,31 @@ func TestUnmarshalTypes(t *testing.T) { //nolint:paralleltest
 		}
 	}
 }
+
+func TestRegParamsRevision(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	for _, phyVersion := range []ttnpb.PHYVersion{
+		ttnpb.PHYVersion_RP001_V1_0_2,
+		ttnpb.PHYVersion_RP001_V1_0_2_REV_B,
+		ttnpb.PHYVersion_RP001_V1_1_REV_B,
+		ttnpb.PHYVersion_RP002_V1_0_3,
+	} {
+		data, err := interop.RegParamsRevision(phyVersion).MarshalText()
+		if !a.So(err, should.BeNil) {
+			continue
+		}
+		var revision interop.RegParamsRevision
+		if a.So(revision.UnmarshalText(data), should.BeNil) {
+			a.So(revision, should.Equal, interop.RegParamsRevision(phyVersion))
+		}
+	}
+
+	data, err := interop.RegParamsRevision(ttnpb.PHYVersion_RP001_V1_0_2_REV_B).MarshalText()
+	a.So(err, should.BeNil)
+	a.So(string(data), should.Equal, "B")
+
+	var revision interop.RegParamsRevision
+	a.So(revision.UnmarshalText([]byte("unknown")), should.NotBeNil)
+}
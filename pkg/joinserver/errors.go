@@ -44,7 +44,9 @@ var (
 	errNoNwkSEncKey                   = errors.DefineCorruption("no_nwk_s_enc_key", "no NwkSEncKey specified")
 	errNoSNwkSIntKey                  = errors.DefineCorruption("no_s_nwk_s_int_key", "no SNwkSIntKey specified")
 	errProvisionerNotFound            = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
+	errNoRejoinRequest                = errors.DefineInvalidArgument("no_rejoin_request", "no RejoinRequest specified")
 	errRegistryOperation              = errors.Define("registry_operation", "registry operation failed")
+	errRejoinCountTooSmall            = errors.DefineInvalidArgument("rejoin_count_too_small", "RJcount1 is too small")
 	errReuseDevNonce                  = errors.DefineInvalidArgument("reuse_dev_nonce", "DevNonce has already been used")
 	errUnauthenticated                = errors.DefineUnauthenticated("unauthenticated", "unauthenticated")
 	errUnknownJoinEUI                 = errors.DefineInvalidArgument("unknown_join_eui", "JoinEUI specified is not known")
@@ -255,6 +255,9 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 		return nil, errUnsupportedMACVersion.WithAttributes("version", req.SelectedMacVersion)
 	}
 
+	// NOTE: Type 0 and 2 rejoin-requests do not contain the JoinEUI, which is provided by the Network Server instead.
+	rejoinJoinEUI := req.Payload.GetRejoinRequestPayload().GetJoinEui()
+
 	req.Payload = &ttnpb.Message{}
 	if err = lorawan.UnmarshalMessage(req.RawPayload, req.Payload); err != nil {
 		return nil, errDecodePayload.WithCause(err)
@@ -263,19 +266,54 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 	if req.Payload.MHdr.Major != ttnpb.Major_LORAWAN_R1 {
 		return nil, errUnsupportedLoRaWANMajorVersion.WithAttributes("major", req.Payload.MHdr.Major)
 	}
-	if req.Payload.MHdr.MType != ttnpb.MType_JOIN_REQUEST {
-		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MHdr.MType)
-	}
 
-	pld := req.Payload.GetJoinRequestPayload()
-	if pld == nil {
-		return nil, errNoJoinRequest.New()
+	var (
+		pld       *ttnpb.JoinRequestPayload
+		rejoinPld *ttnpb.RejoinRequestPayload
+		devEUI    types.EUI64
+		joinEUI   types.EUI64
+	)
+	switch req.Payload.MHdr.MType {
+	case ttnpb.MType_JOIN_REQUEST:
+		pld = req.Payload.GetJoinRequestPayload()
+		if pld == nil {
+			return nil, errNoJoinRequest.New()
+		}
+		devEUI = types.MustEUI64(pld.DevEui).OrZero()
+		joinEUI = types.MustEUI64(pld.JoinEui).OrZero()
+
+	case ttnpb.MType_REJOIN_REQUEST:
+		if !macspec.UseNwkKey(req.SelectedMacVersion) {
+			return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MHdr.MType)
+		}
+		rejoinPld = req.Payload.GetRejoinRequestPayload()
+		if rejoinPld == nil {
+			return nil, errNoRejoinRequest.New()
+		}
+		devEUI = types.MustEUI64(rejoinPld.DevEui).OrZero()
+		switch rejoinPld.RejoinType {
+		case ttnpb.RejoinRequestType_SESSION:
+			joinEUI = types.MustEUI64(rejoinPld.JoinEui).OrZero()
+		default:
+			if !bytes.Equal(rejoinPld.NetId, req.NetId) {
+				return nil, errNetIDMismatch.WithAttributes("net_id", types.MustNetID(rejoinPld.NetId).OrZero())
+			}
+			if len(rejoinJoinEUI) == 0 {
+				return nil, errNoJoinEUI.New()
+			}
+			joinEUI = types.MustEUI64(rejoinJoinEUI).OrZero()
+		}
+		logger = logger.WithFields(log.Fields(
+			"rejoin_type", rejoinPld.RejoinType,
+			"rejoin_count", rejoinPld.RejoinCnt,
+		))
+
+	default:
+		return nil, errWrongPayloadType.WithAttributes("type", req.Payload.MHdr.MType)
 	}
-	devEUI := types.MustEUI64(pld.DevEui).OrZero()
 	if devEUI.IsZero() {
 		return nil, errNoDevEUI.New()
 	}
-	joinEUI := types.MustEUI64(pld.JoinEui).OrZero()
 	logger = logger.WithFields(log.Fields(
 		"join_eui", joinEUI,
 		"dev_eui", devEUI,
@@ -300,6 +338,7 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 			"application_server_kek_label",
 			"last_dev_nonce",
 			"last_join_nonce",
+			"last_rj_count_1",
 			"net_id",
 			"network_server_address",
 			"network_server_kek_label",
@@ -361,8 +400,28 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 
 			paths := make([]string, 0, 3)
 
-			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
-			if macspec.IncrementDevNonce(req.SelectedMacVersion) {
+			var devNonce types.DevNonce
+			switch {
+			case rejoinPld != nil:
+				// NOTE: The rejoin counter takes the place of the DevNonce in the join-accept and the session keys.
+				binary.BigEndian.PutUint16(devNonce[:], uint16(rejoinPld.RejoinCnt))
+				switch rejoinPld.RejoinType {
+				case ttnpb.RejoinRequestType_SESSION:
+					if (rejoinPld.RejoinCnt != 0 || dev.LastRjCount_1 != 0) && !dev.ResetsJoinNonces &&
+						rejoinPld.RejoinCnt <= dev.LastRjCount_1 {
+						return nil, nil, errRejoinCountTooSmall.New()
+					}
+					dev.LastRjCount_1 = rejoinPld.RejoinCnt
+					paths = append(paths, "last_rj_count_1")
+				default:
+					// NOTE: RJcount0 is reset on every session, the Network Server protects against replays.
+					dev.LastRjCount_0 = rejoinPld.RejoinCnt
+					paths = append(paths, "last_rj_count_0")
+				}
+
+			case macspec.IncrementDevNonce(req.SelectedMacVersion):
+				devNonce = types.MustDevNonce(pld.DevNonce).OrZero()
+				dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
 				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
 					if dn <= dev.LastDevNonce {
 						registerDevNonceTooSmall(ctx, req)
@@ -371,7 +430,10 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 				}
 				dev.LastDevNonce = dn
 				paths = append(paths, "last_dev_nonce")
-			} else {
+
+			default:
+				devNonce = types.MustDevNonce(pld.DevNonce).OrZero()
+				dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
 				isReuse := false
 				for i := len(dev.UsedDevNonces) - 1; i >= 0; i-- {
 					if dev.UsedDevNonces[i] == dn {
@@ -499,19 +561,49 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 			if err := cryptoDev.SetFields(dev, "ids", "provisioner_id", "provisioning_data"); err != nil {
 				return nil, nil, err
 			}
-			reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMacVersion, req.RawPayload[:19])
-			if err != nil {
-				return nil, nil, errComputeMIC.WithCause(err)
-			}
-			if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
-				return nil, nil, errMICMismatch.New()
+			joinReqType := byte(0xff)
+			switch {
+			case rejoinPld == nil:
+				reqMIC, err := networkCryptoService.JoinRequestMIC(ctx, cryptoDev, req.SelectedMacVersion, req.RawPayload[:19])
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if !bytes.Equal(reqMIC[:], req.RawPayload[19:]) {
+					return nil, nil, errMICMismatch.New()
+				}
+
+			case rejoinPld.RejoinType == ttnpb.RejoinRequestType_SESSION:
+				joinReqType = byte(rejoinPld.RejoinType)
+				nwkKey, err := networkCryptoService.GetNwkKey(ctx, cryptoDev)
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if nwkKey == nil {
+					return nil, nil, errNoNwkKey.New()
+				}
+				n := len(req.RawPayload) - 4
+				reqMIC, err := crypto.ComputeRejoinRequestMIC(crypto.DeriveJSIntKey(*nwkKey, devEUI), req.RawPayload[:n])
+				if err != nil {
+					return nil, nil, errComputeMIC.WithCause(err)
+				}
+				if !bytes.Equal(reqMIC[:], req.RawPayload[n:]) {
+					return nil, nil, errMICMismatch.New()
+				}
+
+			default:
+				// NOTE: The MIC of type 0 and 2 rejoin-requests is computed with the SNwkSIntKey of the current session,
+				// and is verified by the Network Server.
+				joinReqType = byte(rejoinPld.RejoinType)
 			}
-			devNonce := types.MustDevNonce(pld.DevNonce).OrZero()
-			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMacVersion, 0xff, devNonce, b)
+			resMIC, err := networkCryptoService.JoinAcceptMIC(ctx, cryptoDev, req.SelectedMacVersion, joinReqType, devNonce, b)
 			if err != nil {
 				return nil, nil, errComputeMIC.WithCause(err)
 			}
-			enc, err := networkCryptoService.EncryptJoinAccept(ctx, cryptoDev, req.SelectedMacVersion, append(b[1:], resMIC[:]...))
+			encrypt := networkCryptoService.EncryptJoinAccept
+			if rejoinPld != nil {
+				encrypt = networkCryptoService.EncryptRejoinAccept
+			}
+			enc, err := encrypt(ctx, cryptoDev, req.SelectedMacVersion, append(b[1:], resMIC[:]...))
 			if err != nil {
 				return nil, nil, errEncryptPayload.WithCause(err)
 			}
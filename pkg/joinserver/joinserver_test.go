@@ -2560,6 +2560,190 @@ func TestHandleJoin(t *testing.T) {
 	}
 }
 
+func rejoinRequestPayload(rejoinType ttnpb.RejoinRequestType, id []byte, devEUI types.EUI64, rjCount uint16) []byte {
+	b := []byte{0xc0, byte(rejoinType)}
+	for i := len(id) - 1; i >= 0; i-- {
+		b = append(b, id[i])
+	}
+	for i := len(devEUI) - 1; i >= 0; i-- {
+		b = append(b, devEUI[i])
+	}
+	b = append(b, byte(rjCount), byte(rjCount>>8))
+	key := crypto.DeriveJSIntKey(nwkKey, devEUI)
+	if rejoinType != ttnpb.RejoinRequestType_SESSION {
+		// NOTE: The MIC of type 0 and 2 rejoin-requests is verified by the Network Server.
+		key = types.AES128Key{}
+	}
+	mic := test.Must(crypto.ComputeRejoinRequestMIC(key, b))
+	return append(b, mic[:]...)
+}
+
+func TestHandleRejoin(t *testing.T) {
+	_, ctx := test.New(t)
+
+	var (
+		devEUI  = types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+		joinEUI = types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+		netID   = types.NetID{0x42, 0xff, 0xff}
+	)
+	for _, tc := range []struct {
+		Name           string
+		LastRjCount1   uint32
+		RejoinType     ttnpb.RejoinRequestType
+		RejoinCount    uint16
+		Payload        *ttnpb.Message
+		MACVersion     ttnpb.MACVersion
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:        "type 1",
+			RejoinType:  ttnpb.RejoinRequestType_SESSION,
+			RejoinCount: 1,
+			MACVersion:  ttnpb.MACVersion_MAC_V1_1,
+		},
+		{
+			Name:           "type 1/replay",
+			LastRjCount1:   1,
+			RejoinType:     ttnpb.RejoinRequestType_SESSION,
+			RejoinCount:    1,
+			MACVersion:     ttnpb.MACVersion_MAC_V1_1,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:        "type 2",
+			RejoinType:  ttnpb.RejoinRequestType_KEYS,
+			RejoinCount: 3,
+			Payload: &ttnpb.Message{
+				MHdr: &ttnpb.MHDR{MType: ttnpb.MType_REJOIN_REQUEST},
+				Payload: &ttnpb.Message_RejoinRequestPayload{
+					RejoinRequestPayload: &ttnpb.RejoinRequestPayload{
+						RejoinType: ttnpb.RejoinRequestType_KEYS,
+						JoinEui:    joinEUI.Bytes(),
+					},
+				},
+			},
+			MACVersion: ttnpb.MACVersion_MAC_V1_1,
+		},
+		{
+			Name:           "type 0/no JoinEUI",
+			RejoinType:     ttnpb.RejoinRequestType_CONTEXT,
+			MACVersion:     ttnpb.MACVersion_MAC_V1_1,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "type 1/1.0.3",
+			RejoinType:     ttnpb.RejoinRequestType_SESSION,
+			RejoinCount:    1,
+			MACVersion:     ttnpb.MACVersion_MAC_V1_0_3,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		test.RunSubtestFromContext(ctx, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				ctx = clusterauth.NewContext(ctx, nil)
+
+				redisClient, flush := test.NewRedis(ctx, "joinserver_test")
+				defer flush()
+				defer redisClient.Close()
+				devReg := &redis.DeviceRegistry{Redis: redisClient, LockTTL: test.Delay << 10}
+				if err := devReg.Init(ctx); !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				keyReg := &redis.KeyRegistry{Redis: redisClient, LockTTL: test.Delay << 10}
+				if err := keyReg.Init(ctx); !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				aasReg, aasRegCloseFn := NewRedisApplicationActivationSettingRegistry(ctx)
+				defer aasRegCloseFn()
+
+				c := componenttest.NewComponent(t, &component.Config{})
+				js := test.Must(joinserver.New(
+					c,
+					&joinserver.Config{
+						ApplicationActivationSettings: aasReg,
+						Devices:                       devReg,
+						Keys:                          keyReg,
+						JoinEUIPrefixes:               joinEUIPrefixes,
+						DevNonceLimit:                 defaultDevNonceLimit,
+					},
+				))
+				componenttest.StartComponent(t, c)
+
+				ids := &ttnpb.EndDeviceIdentifiers{
+					DevEui:         devEUI.Bytes(),
+					JoinEui:        joinEUI.Bytes(),
+					ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+					DeviceId:       "test-dev",
+				}
+				_, err := devReg.SetByID(ctx, ids.ApplicationIds, ids.DeviceId, nil,
+					func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+						return &ttnpb.EndDevice{
+							Ids: ids,
+							RootKeys: &ttnpb.RootKeys{
+								AppKey: &ttnpb.KeyEnvelope{Key: appKey.Bytes()},
+								NwkKey: &ttnpb.KeyEnvelope{Key: nwkKey.Bytes()},
+							},
+							LastRjCount_1:        tc.LastRjCount1,
+							LorawanVersion:       tc.MACVersion,
+							NetworkServerAddress: nsAddr,
+						}, []string{
+							"ids.application_ids",
+							"ids.dev_eui",
+							"ids.device_id",
+							"ids.join_eui",
+							"last_rj_count_1",
+							"lorawan_version",
+							"network_server_address",
+							"root_keys",
+						}, nil
+					},
+				)
+				if !a.So(err, should.BeNil) {
+					t.Fatalf("Failed to create device: %s", err)
+				}
+
+				id := joinEUI.Bytes()
+				if tc.RejoinType != ttnpb.RejoinRequestType_SESSION {
+					id = netID.Bytes()
+				}
+				res, err := js.HandleJoin(ctx, &ttnpb.JoinRequest{
+					SelectedMacVersion: tc.MACVersion,
+					RawPayload:         rejoinRequestPayload(tc.RejoinType, id, devEUI, tc.RejoinCount),
+					Payload:            tc.Payload,
+					DevAddr:            types.DevAddr{0x42, 0xff, 0xff, 0xff}.Bytes(),
+					NetId:              netID.Bytes(),
+					DownlinkSettings:   &ttnpb.DLSettings{OptNeg: true},
+					RxDelay:            0x1,
+				}, joinserver.ClusterAuthorizer(ctx))
+				if tc.ErrorAssertion != nil {
+					if !a.So(err, should.BeError) || !a.So(tc.ErrorAssertion(err), should.BeTrue) {
+						t.Fatalf("Received an unexpected error: %s", err)
+					}
+					return
+				}
+				if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+					t.FailNow()
+				}
+
+				dec := test.Must(crypto.DecryptJoinAccept(crypto.DeriveJSEncKey(nwkKey, devEUI), res.RawPayload[1:]))
+				n := len(dec) - 4
+				devNonce := types.DevNonce{byte(tc.RejoinCount >> 8), byte(tc.RejoinCount)}
+				mic := test.Must(crypto.ComputeJoinAcceptMIC(
+					crypto.DeriveJSIntKey(nwkKey, devEUI), byte(tc.RejoinType), joinEUI, devNonce,
+					append(res.RawPayload[:1:1], dec[:n]...),
+				))
+				a.So(dec[n:], should.Resemble, mic[:])
+				a.So(res.SessionKeys.FNwkSIntKey, should.Resemble, &ttnpb.KeyEnvelope{
+					Key: crypto.DeriveFNwkSIntKey(nwkKey, types.JoinNonce{0x00, 0x00, 0x01}, joinEUI, devNonce).Bytes(),
+				})
+			},
+		})
+	}
+}
+
 func TestGetNwkSKeys(t *testing.T) {
 	_, ctx := test.New(t)
 	errTest := errors.New("test")
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS js_end_devices;
@@ -0,0 +1,13 @@
+CREATE TABLE js_end_devices (
+  uid                   TEXT PRIMARY KEY,
+  join_eui              BYTEA NOT NULL,
+  dev_eui               BYTEA NOT NULL,
+  provisioner_id        TEXT,
+  provisioner_unique_id TEXT,
+  end_device            BYTEA NOT NULL
+);
+--bun:split
+CREATE UNIQUE INDEX js_end_devices_eui_index ON js_end_devices (join_eui, dev_eui);
+--bun:split
+CREATE UNIQUE INDEX js_end_devices_provisioner_index
+  ON js_end_devices (provisioner_id, provisioner_unique_id);
@@ -0,0 +1,529 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postgres implements the Join Server device registry in PostgreSQL.
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"runtime/trace"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/internal/registry"
+	"go.thethings.network/lorawan-stack/v3/pkg/joinserver/postgres/migrations"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/provisioning"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errAlreadyProvisioned  = errors.DefineAlreadyExists("already_provisioned", "device already provisioned")
+	errInvalidFieldmask    = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers  = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField       = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errProvisionerNotFound = errors.DefineNotFound("provisioner_not_found", "provisioner `{id}` not found")
+	errDatabaseCorruption  = errors.DefineCorruption("database_corruption", "database is corrupted")
+)
+
+// rangeBatchSize is the number of devices which are read at once by RangeByID.
+const rangeBatchSize = 1024
+
+// DeviceRegistry is an implementation of joinserver.DeviceRegistry.
+type DeviceRegistry struct {
+	DB *sql.DB
+}
+
+// Init initializes the DeviceRegistry.
+func (r *DeviceRegistry) Init(ctx context.Context) error {
+	return store.Migrate(ctx, r.DB, migrations.Migrations, "js_device_registry_migrations")
+}
+
+func provisionerUniqueID(dev *ttnpb.EndDevice) (string, error) {
+	if dev.ProvisionerId == "" {
+		return "", nil
+	}
+	provisioner := provisioning.Get(dev.ProvisionerId)
+	if provisioner == nil {
+		return "", errProvisionerNotFound.WithAttributes("id", dev.ProvisionerId)
+	}
+	return provisioner.UniqueID(dev.ProvisioningData)
+}
+
+func unmarshalDevice(b []byte) (*ttnpb.EndDevice, error) {
+	dev := &ttnpb.EndDevice{}
+	if err := proto.Unmarshal(b, dev); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return dev, nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// GetByID gets device by appID, devID.
+func (r *DeviceRegistry) GetByID(
+	ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string,
+) (*ttnpb.EndDevice, error) {
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appID,
+		DeviceId:       devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device by id").End()
+
+	var b []byte
+	if err := r.DB.QueryRowContext(ctx,
+		`SELECT end_device FROM js_end_devices WHERE uid = $1`, unique.ID(ctx, ids),
+	).Scan(&b); err != nil {
+		return nil, store.WrapDriverError(err)
+	}
+	pb, err := unmarshalDevice(b)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.FilterGetEndDevice(pb, paths...)
+}
+
+// GetByEUI gets device by joinEUI, devEUI.
+func (r *DeviceRegistry) GetByEUI(
+	ctx context.Context, joinEUI, devEUI types.EUI64, paths []string,
+) (*ttnpb.ContextualEndDevice, error) {
+	if devEUI.IsZero() {
+		return nil, errInvalidIdentifiers.New()
+	}
+
+	defer trace.StartRegion(ctx, "get end device by eui").End()
+
+	var (
+		uid string
+		b   []byte
+	)
+	if err := r.DB.QueryRowContext(ctx,
+		`SELECT uid, end_device FROM js_end_devices WHERE join_eui = $1 AND dev_eui = $2`, joinEUI[:], devEUI[:],
+	).Scan(&uid, &b); err != nil {
+		return nil, store.WrapDriverError(err)
+	}
+	ctx, err := unique.WithContext(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	pb, err := unmarshalDevice(b)
+	if err != nil {
+		return nil, err
+	}
+	filtered, err := ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ContextualEndDevice{
+		Context:   ctx,
+		EndDevice: filtered,
+	}, nil
+}
+
+func (r *DeviceRegistry) set(
+	ctx context.Context,
+	tx *sql.Tx,
+	uid string,
+	gets []string,
+	f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.ContextualEndDevice, error) {
+	ctx, err := unique.WithContext(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		storedBytes []byte
+		stored      *ttnpb.EndDevice
+	)
+	err = tx.QueryRowContext(ctx,
+		`SELECT end_device FROM js_end_devices WHERE uid = $1 FOR UPDATE`, uid,
+	).Scan(&storedBytes)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return nil, store.WrapDriverError(err)
+	default:
+		if stored, err = unmarshalDevice(storedBytes); err != nil {
+			return nil, err
+		}
+	}
+
+	var pb *ttnpb.EndDevice
+	if stored != nil {
+		pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var sets []string
+	pb, sets, err = f(ctx, pb)
+	if err != nil {
+		return nil, err
+	}
+	if err := ttnpb.ProhibitFields(sets,
+		"created_at",
+		"updated_at",
+	); err != nil {
+		return nil, errInvalidFieldmask.WithCause(err)
+	}
+
+	if stored == nil && pb == nil {
+		return nil, nil
+	}
+	if pb != nil && len(sets) == 0 {
+		filtered, err := ttnpb.FilterGetEndDevice(stored, gets...)
+		if err != nil {
+			return nil, err
+		}
+		return &ttnpb.ContextualEndDevice{
+			Context:   ctx,
+			EndDevice: filtered,
+		}, nil
+	}
+	if pb == nil && len(sets) == 0 {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM js_end_devices WHERE uid = $1`, uid); err != nil {
+			return nil, store.WrapDriverError(err)
+		}
+		return &ttnpb.ContextualEndDevice{
+			Context: ctx,
+		}, nil
+	}
+
+	if pb == nil {
+		pb = &ttnpb.EndDevice{}
+	}
+
+	pb.UpdatedAt = timestamppb.Now()
+	sets = append(append(sets[:0:0], sets...),
+		"updated_at",
+	)
+
+	updated := &ttnpb.EndDevice{}
+	var updatedPID string
+	if stored == nil {
+		if err := ttnpb.RequireFields(sets,
+			"ids.application_ids",
+			"ids.dev_eui",
+			"ids.device_id",
+			"ids.join_eui",
+		); err != nil {
+			return nil, errInvalidFieldmask.WithCause(err)
+		}
+
+		pb.CreatedAt = pb.UpdatedAt
+		sets = append(sets, "created_at")
+
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+		updatedPID, err = provisionerUniqueID(updated)
+		if err != nil {
+			return nil, err
+		}
+		if updated.Ids.JoinEui == nil || types.MustEUI64(updated.Ids.DevEui).OrZero().IsZero() {
+			return nil, errInvalidIdentifiers.New()
+		}
+	} else {
+		if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") &&
+			pb.Ids.ApplicationIds.ApplicationId != stored.Ids.ApplicationIds.ApplicationId {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.device_id") && pb.Ids.DeviceId != stored.Ids.DeviceId {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.device_id")
+		}
+		if ttnpb.HasAnyField(sets, "ids.join_eui") && !bytes.Equal(pb.Ids.JoinEui, stored.Ids.JoinEui) {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.join_eui")
+		}
+		if ttnpb.HasAnyField(sets, "ids.dev_eui") && !bytes.Equal(pb.Ids.DevEui, stored.Ids.DevEui) {
+			return nil, errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+		}
+		if ttnpb.HasAnyField(sets, "provisioner_id") && pb.ProvisionerId != stored.ProvisionerId {
+			return nil, errReadOnlyField.WithAttributes("field", "provisioner_id")
+		}
+		if ttnpb.HasAnyField(sets, "provisioning_data") && !proto.Equal(pb.ProvisioningData, stored.ProvisioningData) {
+			return nil, errReadOnlyField.WithAttributes("field", "provisioning_data")
+		}
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(proto.Clone(stored).(*ttnpb.EndDevice), pb, sets...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := updated.ValidateFields(); err != nil {
+		return nil, err
+	}
+
+	b, err := proto.Marshal(updated)
+	if err != nil {
+		return nil, err
+	}
+	if stored != nil {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE js_end_devices SET end_device = $2 WHERE uid = $1`, uid, b,
+		); err != nil {
+			return nil, store.WrapDriverError(err)
+		}
+	} else {
+		joinEUI := types.MustEUI64(updated.Ids.JoinEui).OrZero()
+		devEUI := types.MustEUI64(updated.Ids.DevEui).OrZero()
+		var storedUID string
+		err := tx.QueryRowContext(ctx,
+			`SELECT uid FROM js_end_devices WHERE join_eui = $1 AND dev_eui = $2`, joinEUI[:], devEUI[:],
+		).Scan(&storedUID)
+		switch {
+		case err == nil:
+			return nil, registry.UniqueEUIViolationErr(ctx, joinEUI, devEUI, storedUID)
+		case !errors.Is(err, sql.ErrNoRows):
+			return nil, store.WrapDriverError(err)
+		}
+		if updatedPID != "" {
+			var exists bool
+			if err := tx.QueryRowContext(ctx,
+				`SELECT EXISTS (
+					SELECT 1 FROM js_end_devices WHERE provisioner_id = $1 AND provisioner_unique_id = $2
+				)`, updated.ProvisionerId, updatedPID,
+			).Scan(&exists); err != nil {
+				return nil, store.WrapDriverError(err)
+			}
+			if exists {
+				return nil, errAlreadyProvisioned.New()
+			}
+		}
+		if _, err := tx.ExecContext(ctx, `INSERT INTO js_end_devices
+			(uid, join_eui, dev_eui, provisioner_id, provisioner_unique_id, end_device)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			uid, joinEUI[:], devEUI[:], nullString(updated.ProvisionerId), nullString(updatedPID), b,
+		); err != nil {
+			return nil, store.WrapDriverError(err)
+		}
+	}
+	pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ContextualEndDevice{
+		Context:   ctx,
+		EndDevice: pb,
+	}, nil
+}
+
+// SetByEUI sets device by joinEUI, devEUI.
+// SetByEUI will only succeed if the device is set via SetByID first.
+func (r *DeviceRegistry) SetByEUI(
+	ctx context.Context,
+	joinEUI types.EUI64,
+	devEUI types.EUI64,
+	gets []string,
+	f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.ContextualEndDevice, error) {
+	if devEUI.IsZero() {
+		return nil, errInvalidIdentifiers.New()
+	}
+
+	defer trace.StartRegion(ctx, "set end device by eui").End()
+
+	findUID := func(tx *sql.Tx) (string, error) {
+		var uid string
+		if err := tx.QueryRowContext(ctx,
+			`SELECT uid FROM js_end_devices WHERE join_eui = $1 AND dev_eui = $2`, joinEUI[:], devEUI[:],
+		).Scan(&uid); err != nil {
+			return "", store.WrapDriverError(err)
+		}
+		return uid, nil
+	}
+
+	var pb *ttnpb.ContextualEndDevice
+	if err := store.Transact(ctx, r.DB, func(tx *sql.Tx) error {
+		uid, err := findUID(tx)
+		if err != nil {
+			return err
+		}
+		if err := store.LockKey(ctx, tx, store.JoinServerDevicesLockNamespace, uid); err != nil {
+			return err
+		}
+		// NOTE: The device may have been deleted or recreated before the lock was acquired.
+		lockedUID, err := findUID(tx)
+		if err != nil {
+			return err
+		}
+		if lockedUID != uid {
+			return store.ErrNotFound.New()
+		}
+		pb, err = r.set(ctx, tx, uid, gets, f)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// SetByID sets device by appID, devID.
+func (r *DeviceRegistry) SetByID(
+	ctx context.Context,
+	appID *ttnpb.ApplicationIdentifiers,
+	devID string,
+	gets []string,
+	f func(pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.EndDevice, error) {
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appID,
+		DeviceId:       devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "set end device by id").End()
+
+	var pb *ttnpb.ContextualEndDevice
+	if err := store.Transact(ctx, r.DB, func(tx *sql.Tx) error {
+		if err := store.LockKey(ctx, tx, store.JoinServerDevicesLockNamespace, uid); err != nil {
+			return err
+		}
+		var err error
+		pb, err = r.set(ctx, tx, uid, gets, func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			updated, sets, err := f(stored)
+			if err != nil {
+				return nil, nil, err
+			}
+			if stored == nil && updated != nil &&
+				(updated.Ids.ApplicationIds.ApplicationId != appID.ApplicationId || updated.Ids.DeviceId != devID) {
+				return nil, nil, errInvalidIdentifiers.New()
+			}
+			return updated, sets, nil
+		})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if pb == nil {
+		return nil, nil
+	}
+	return pb.EndDevice, nil
+}
+
+// RangeByID ranges over the end devices and calls the callback function, until false is returned.
+func (r *DeviceRegistry) RangeByID(
+	ctx context.Context, paths []string, f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	var after string
+	for {
+		devs, lastUID, err := r.rangeBatch(ctx, after)
+		if err != nil {
+			return err
+		}
+		for _, dev := range devs {
+			dev, err := ttnpb.FilterGetEndDevice(dev, paths...)
+			if err != nil {
+				return err
+			}
+			if !f(ctx, dev.Ids, dev) {
+				return nil
+			}
+		}
+		if len(devs) < rangeBatchSize {
+			return nil
+		}
+		after = lastUID
+	}
+}
+
+func (r *DeviceRegistry) rangeBatch(ctx context.Context, after string) ([]*ttnpb.EndDevice, string, error) {
+	rows, err := r.DB.QueryContext(ctx,
+		`SELECT uid, end_device FROM js_end_devices WHERE uid > $1 ORDER BY uid LIMIT $2`, after, rangeBatchSize,
+	)
+	if err != nil {
+		return nil, "", store.WrapDriverError(err)
+	}
+	defer rows.Close()
+	var (
+		devs    []*ttnpb.EndDevice
+		lastUID string
+	)
+	for rows.Next() {
+		var b []byte
+		if err := rows.Scan(&lastUID, &b); err != nil {
+			return nil, "", store.WrapDriverError(err)
+		}
+		dev, err := unmarshalDevice(b)
+		if err != nil {
+			return nil, "", err
+		}
+		devs = append(devs, dev)
+	}
+	return devs, lastUID, store.WrapDriverError(rows.Err())
+}
+
+// BatchDelete deletes a batch of end devices in a single transaction.
+func (r *DeviceRegistry) BatchDelete(
+	ctx context.Context,
+	appIDs *ttnpb.ApplicationIdentifiers,
+	deviceIDs []string,
+) ([]*ttnpb.EndDeviceIdentifiers, error) {
+	uids := make([]string, 0, len(deviceIDs))
+	for _, devID := range deviceIDs {
+		uids = append(uids, unique.ID(ctx, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appIDs,
+			DeviceId:       devID,
+		}))
+	}
+	// NOTE: Lock the devices in a consistent order to avoid deadlocks between concurrent batch deletions.
+	sort.Strings(uids)
+
+	var ret []*ttnpb.EndDeviceIdentifiers
+	if err := store.Transact(ctx, r.DB, func(tx *sql.Tx) error {
+		ret = make([]*ttnpb.EndDeviceIdentifiers, 0, len(uids))
+		for _, uid := range uids {
+			if err := store.LockKey(ctx, tx, store.JoinServerDevicesLockNamespace, uid); err != nil {
+				return err
+			}
+			var b []byte
+			err := tx.QueryRowContext(ctx,
+				`DELETE FROM js_end_devices WHERE uid = $1 RETURNING end_device`, uid,
+			).Scan(&b)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return store.WrapDriverError(err)
+			}
+			dev, err := unmarshalDevice(b)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to decode stored end device")
+				continue
+			}
+			ret = append(ret, dev.Ids)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
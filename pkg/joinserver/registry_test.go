@@ -24,6 +24,7 @@ import (
 	"github.com/smarty/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	. "go.thethings.network/lorawan-stack/v3/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/joinserver/postgres"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -328,6 +329,24 @@ func TestDeviceRegistries(t *testing.T) {
 			},
 			N: 8,
 		},
+		{
+			Name: "PostgreSQL",
+			New: func(ctx context.Context) (DeviceRegistry, func() error, error) {
+				db, drop := test.NewPostgreSQL(ctx)
+				devReg := &postgres.DeviceRegistry{
+					DB: db,
+				}
+				if err := devReg.Init(ctx); err != nil {
+					drop()
+					return nil, nil, err
+				}
+				return devReg, func() error {
+					drop()
+					return nil
+				}, nil
+			},
+			N: 8,
+		},
 	} {
 		tc := tc
 		for i := 0; i < int(tc.N); i++ {
@@ -0,0 +1,349 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// clusterApplicationServerAddress is the address of the Application Server of the cluster.
+// It may be used in the secondary Application Servers to order it after other Application Servers.
+const clusterApplicationServerAddress = "cluster"
+
+var errApplicationServerConnection = errors.DefineUnavailable(
+	"application_server_connection", "connect to Application Server `{address}`",
+)
+
+// applicationServerTarget is an Application Server to which application uplinks are sent.
+// applicationServerTarget tracks the health of the Application Server by the consecutive failures to send to it.
+type applicationServerTarget struct {
+	address string
+	client  func(context.Context) (ttnpb.NsAsClient, error)
+	auth    grpc.CallOption
+	close   func() error
+
+	mu             sync.Mutex
+	failures       uint32
+	unhealthyUntil time.Time
+}
+
+func (t *applicationServerTarget) healthy(now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return !now.Before(t.unhealthyUntil)
+}
+
+func (t *applicationServerTarget) succeeded() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failures = 0
+	t.unhealthyUntil = time.Time{}
+}
+
+// failed records a failure and returns true if the target became unhealthy.
+func (t *applicationServerTarget) failed(now time.Time, threshold uint32, retryInterval time.Duration) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failures++
+	if t.failures < threshold {
+		return false
+	}
+	wasHealthy := !now.Before(t.unhealthyUntil)
+	t.unhealthyUntil = now.Add(retryInterval)
+	return wasHealthy
+}
+
+func (t *applicationServerTarget) handleUplink(ctx context.Context, req *ttnpb.NsAsHandleUplinkRequest) error {
+	cl, err := t.client(ctx)
+	if err != nil {
+		return errApplicationServerConnection.WithAttributes("address", t.address).WithCause(err)
+	}
+	_, err = cl.HandleUplink(ctx, req, t.auth)
+	return err
+}
+
+// applicationServerDialConfig is the configuration of the connection to a secondary Application Server.
+type applicationServerDialConfig struct {
+	address string
+	// rootCA is the location of the TLS root CA certificate of the Application Server.
+	// If empty, the root CAs of the Network Server are used.
+	rootCA string
+	// insecure indicates that the Application Server is reached without TLS.
+	insecure bool
+}
+
+// applicationServerDialFunc returns a function which returns a client of a secondary Application Server, and a
+// function which closes the connection to it.
+type applicationServerDialFunc func(
+	applicationServerDialConfig,
+) (client func(context.Context) (ttnpb.NsAsClient, error), closeConn func() error)
+
+// applicationServerFailover sends application uplinks to the Application Server of the cluster and fails over to
+// the secondary Application Servers of the application when it is unhealthy.
+type applicationServerFailover struct {
+	cluster          *applicationServerTarget
+	secondaries      map[string][]*applicationServerTarget
+	fanOut           map[string]struct{}
+	failureThreshold uint32
+	retryInterval    time.Duration
+	closers          []func() error
+}
+
+// newApplicationServerFailover returns a new applicationServerFailover.
+// The Application Server of the cluster is called with the cluster authentication clusterAuth. The secondary
+// Application Servers are external to the cluster, and are called with their own cluster key in the configuration.
+func newApplicationServerFailover(
+	conf ApplicationServerFailoverConfig,
+	clusterClient func(context.Context) (ttnpb.NsAsClient, error),
+	clusterAuth grpc.CallOption,
+	dial applicationServerDialFunc,
+) (*applicationServerFailover, error) {
+	if len(conf.Secondaries) > 0 && conf.FailureThreshold == 0 {
+		return nil, errInvalidConfiguration.WithCause(errors.New("ApplicationServerFailover.FailureThreshold is zero"))
+	}
+	insecureAddresses := make(map[string]bool, len(conf.Insecure))
+	for _, address := range conf.Insecure {
+		insecureAddresses[address] = true
+	}
+	f := &applicationServerFailover{
+		cluster: &applicationServerTarget{
+			address: clusterApplicationServerAddress,
+			client:  clusterClient,
+			auth:    clusterAuth,
+		},
+		secondaries:      make(map[string][]*applicationServerTarget, len(conf.Secondaries)),
+		fanOut:           make(map[string]struct{}, len(conf.FanOut)),
+		failureThreshold: conf.FailureThreshold,
+		retryInterval:    conf.RetryInterval,
+	}
+	// NOTE: Targets are shared between applications, such that the health of an Application Server is tracked once.
+	targets := map[string]*applicationServerTarget{
+		clusterApplicationServerAddress: f.cluster,
+	}
+	for appID, addresses := range conf.Secondaries {
+		if err := (&ttnpb.ApplicationIdentifiers{ApplicationId: appID}).ValidateFields(); err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+		for _, address := range addresses {
+			if address == "" {
+				return nil, errInvalidConfiguration.WithCause(errors.New("empty secondary Application Server address"))
+			}
+			t, ok := targets[address]
+			if !ok {
+				key := conf.Keys[address]
+				if key == "" {
+					return nil, errInvalidConfiguration.WithCause(
+						errors.New("no key for secondary Application Server " + address),
+					)
+				}
+				if _, err := hex.DecodeString(key); err != nil {
+					return nil, errInvalidConfiguration.WithCause(err)
+				}
+				dialConf := applicationServerDialConfig{
+					address:  address,
+					rootCA:   conf.RootCAs[address],
+					insecure: insecureAddresses[address],
+				}
+				client, closeConn := dial(dialConf)
+				t = &applicationServerTarget{
+					address: address,
+					client:  client,
+					auth: grpc.PerRPCCredentials(rpcmetadata.MD{
+						AuthType:      clusterauth.AuthType,
+						AuthValue:     key,
+						AllowInsecure: dialConf.insecure,
+					}),
+					close: closeConn,
+				}
+				targets[address] = t
+			}
+			f.secondaries[appID] = append(f.secondaries[appID], t)
+		}
+	}
+	for _, appID := range conf.FanOut {
+		f.fanOut[appID] = struct{}{}
+	}
+	for _, t := range targets {
+		if t.close != nil {
+			f.closers = append(f.closers, t.close)
+		}
+	}
+	return f, nil
+}
+
+// close closes the connections to the secondary Application Servers.
+func (f *applicationServerFailover) close() error {
+	var err error
+	for _, closeConn := range f.closers {
+		if closeErr := closeConn(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+// targets returns the Application Servers of the application in order of preference.
+// The Application Server of the cluster comes first, unless it is listed in the secondary Application Servers.
+// Unhealthy Application Servers are ordered after the healthy ones, such that they are only tried as a last resort.
+func (f *applicationServerFailover) targets(appID string, now time.Time) []*applicationServerTarget {
+	secondaries := f.secondaries[appID]
+	all := make([]*applicationServerTarget, 0, 1+len(secondaries))
+	if !containsApplicationServerTarget(secondaries, f.cluster) {
+		all = append(all, f.cluster)
+	}
+	all = append(all, secondaries...)
+
+	ordered := make([]*applicationServerTarget, 0, len(all))
+	for _, t := range all {
+		if t.healthy(now) {
+			ordered = append(ordered, t)
+		}
+	}
+	for _, t := range all {
+		if !t.healthy(now) {
+			ordered = append(ordered, t)
+		}
+	}
+	return ordered
+}
+
+func containsApplicationServerTarget(ts []*applicationServerTarget, t *applicationServerTarget) bool {
+	for _, v := range ts {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+// handleUplink sends the application uplinks ups to the first Application Server of the application which accepts
+// them. If the application fans out, the uplinks are also sent to all other healthy Application Servers.
+// Only errors which are retryable cause a failover, since other errors mean that the Application Server is reachable.
+func (f *applicationServerFailover) handleUplink(ctx context.Context, ups []*ttnpb.ApplicationUp) error {
+	if len(ups) == 0 {
+		return nil
+	}
+	appID := ups[0].EndDeviceIds.ApplicationIds.ApplicationId
+	_, fanOut := f.fanOut[appID]
+	req := &ttnpb.NsAsHandleUplinkRequest{
+		ApplicationUps: ups,
+	}
+	var (
+		delivered bool
+		lastErr   error
+	)
+	now := time.Now()
+	for _, t := range f.targets(appID, now) {
+		if delivered && (!fanOut || !t.healthy(now)) {
+			break
+		}
+		logger := log.FromContext(ctx).WithField("application_server_address", t.address)
+		if err := t.handleUplink(ctx, req); err != nil {
+			if !retryableUplinkError(err) {
+				if !delivered {
+					return err
+				}
+				logger.WithError(err).Warn("Failed to send duplicate application uplinks")
+				continue
+			}
+			if t.failed(now, f.failureThreshold, f.retryInterval) {
+				logger.WithError(err).Warn("Application Server unhealthy, fail over")
+			} else {
+				logger.WithError(err).Debug("Failed to send application uplinks")
+			}
+			lastErr = err
+			continue
+		}
+		t.succeeded()
+		delivered = true
+	}
+	if delivered {
+		return nil
+	}
+	return lastErr
+}
+
+// clusterApplicationServerClient returns a client of the Application Server of the cluster.
+func (ns *NetworkServer) clusterApplicationServerClient(ctx context.Context) (ttnpb.NsAsClient, error) {
+	conn, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.NewNsAsClient(conn), nil
+}
+
+// dialApplicationServer dials the secondary Application Server on first use.
+// The connection uses TLS, unless the Application Server is configured to be insecure.
+func (ns *NetworkServer) dialApplicationServer(
+	conf applicationServerDialConfig,
+) (func(context.Context) (ttnpb.NsAsClient, error), func() error) {
+	var (
+		mu   sync.Mutex
+		conn *grpc.ClientConn
+	)
+	client := func(ctx context.Context) (ttnpb.NsAsClient, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if conn != nil {
+			return ttnpb.NewNsAsClient(conn), nil
+		}
+		opts := rpcclient.DefaultDialOptions(ns.Context())
+		if conf.insecure {
+			opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		} else {
+			tlsConfig, err := ns.GetTLSClientConfig(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if conf.rootCA != "" {
+				if err := (tlsconfig.Client{RootCA: conf.rootCA}).ApplyTo(tlsConfig); err != nil {
+					return nil, err
+				}
+			}
+			opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		}
+		var err error
+		conn, err = grpc.DialContext(ns.Context(), conf.address, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return ttnpb.NewNsAsClient(conn), nil
+	}
+	closeConn := func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		if conn == nil {
+			return nil
+		}
+		err := conn.Close()
+		conn = nil
+		return err
+	}
+	return client, closeConn
+}
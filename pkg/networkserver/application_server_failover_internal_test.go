@@ -0,0 +1,281 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var errTestApplicationServer = errors.DefineUnavailable("test_application_server", "test Application Server")
+
+type mockApplicationServer struct {
+	address  string
+	dialConf applicationServerDialConfig
+	err      error
+	calls    *[]string
+	authKey  string
+	closed   bool
+}
+
+func (m *mockApplicationServer) HandleUplink(
+	_ context.Context, _ *ttnpb.NsAsHandleUplinkRequest, opts ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	*m.calls = append(*m.calls, m.address)
+	for _, opt := range opts {
+		if creds, ok := opt.(grpc.PerRPCCredsCallOption); ok {
+			m.authKey = creds.Creds.(rpcmetadata.MD).AuthValue
+		}
+	}
+	if m.err != nil {
+		return nil, m.err
+	}
+	return ttnpb.Empty, nil
+}
+
+func newTestApplicationServerFailover(
+	t *testing.T, conf ApplicationServerFailoverConfig, calls *[]string,
+) (*applicationServerFailover, map[string]*mockApplicationServer) {
+	t.Helper()
+	servers := map[string]*mockApplicationServer{}
+	dial := func(
+		dialConf applicationServerDialConfig,
+	) (func(context.Context) (ttnpb.NsAsClient, error), func() error) {
+		server := &mockApplicationServer{address: dialConf.address, dialConf: dialConf, calls: calls}
+		servers[dialConf.address] = server
+		client := func(context.Context) (ttnpb.NsAsClient, error) {
+			return server, nil
+		}
+		closeConn := func() error {
+			server.closed = true
+			return nil
+		}
+		return client, closeConn
+	}
+	clusterClient, _ := dial(applicationServerDialConfig{address: clusterApplicationServerAddress})
+	clusterAuth := grpc.PerRPCCredentials(rpcmetadata.MD{AuthValue: "cluster-key"})
+	f, err := newApplicationServerFailover(conf, clusterClient, clusterAuth, dial)
+	if err != nil {
+		t.Fatalf("Failed to create Application Server failover: %v", err)
+	}
+	return f, servers
+}
+
+func TestApplicationServerFailover(t *testing.T) {
+	t.Parallel()
+
+	ups := []*ttnpb.ApplicationUp{
+		{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				DeviceId:       "test-dev",
+			},
+		},
+	}
+
+	t.Run("Failover", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+
+		var calls []string
+		f, servers := newTestApplicationServerFailover(t, ApplicationServerFailoverConfig{
+			Secondaries: map[string][]string{
+				"test-app": {"as-1:8884", "as-2:8884"},
+			},
+			Keys: map[string]string{
+				"as-1:8884": "aa01",
+				"as-2:8884": "aa02",
+			},
+			FailureThreshold: 2,
+			RetryInterval:    time.Hour,
+		}, &calls)
+
+		a.So(f.handleUplink(ctx, ups), should.BeNil)
+		a.So(calls, should.Resemble, []string{"cluster"})
+		a.So(servers["cluster"].authKey, should.Equal, "cluster-key")
+
+		servers["cluster"].err = errTestApplicationServer.New()
+		calls = nil
+		a.So(f.handleUplink(ctx, ups), should.BeNil)
+		a.So(calls, should.Resemble, []string{"cluster", "as-1:8884"})
+		// The secondary Application Servers are not called with the cluster key.
+		a.So(servers["as-1:8884"].authKey, should.Equal, "aa01")
+
+		// The cluster Application Server becomes unhealthy after the second failure.
+		calls = nil
+		a.So(f.handleUplink(ctx, ups), should.BeNil)
+		a.So(calls, should.Resemble, []string{"cluster", "as-1:8884"})
+
+		calls = nil
+		a.So(f.handleUplink(ctx, ups), should.BeNil)
+		a.So(calls, should.Resemble, []string{"as-1:8884"})
+
+		// Unhealthy Application Servers are tried as a last resort.
+		servers["as-1:8884"].err = errTestApplicationServer.New()
+		servers["as-2:8884"].err = errTestApplicationServer.New()
+		calls = nil
+		a.So(f.handleUplink(ctx, ups), should.HaveSameErrorDefinitionAs, errTestApplicationServer)
+		a.So(calls, should.Resemble, []string{"as-1:8884", "as-2:8884", "cluster"})
+
+		// Unhealthy Application Servers are tried again after the retry interval.
+		servers["cluster"].err = nil
+		f.cluster.unhealthyUntil = time.Now().Add(-time.Second)
+		calls = nil
+		a.So(f.handleUplink(ctx, ups), should.BeNil)
+		a.So(calls, should.Resemble, []string{"cluster"})
+		a.So(f.cluster.failures, should.Equal, 0)
+
+		// Errors which are not retryable do not cause a failover.
+		servers["cluster"].err = errors.DefinePermissionDenied("test_permission_denied", "test").New()
+		calls = nil
+		a.So(errors.IsPermissionDenied(f.handleUplink(ctx, ups)), should.BeTrue)
+		a.So(calls, should.Resemble, []string{"cluster"})
+	})
+
+	t.Run("FanOut", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+
+		var calls []string
+		f, servers := newTestApplicationServerFailover(t, ApplicationServerFailoverConfig{
+			Secondaries: map[string][]string{
+				"test-app": {"as-1:8884", "as-2:8884"},
+			},
+			Keys: map[string]string{
+				"as-1:8884": "aa01",
+				"as-2:8884": "aa02",
+			},
+			FanOut:           []string{"test-app"},
+			FailureThreshold: 1,
+			RetryInterval:    time.Hour,
+		}, &calls)
+
+		a.So(f.handleUplink(ctx, ups), should.BeNil)
+		a.So(calls, should.Resemble, []string{"cluster", "as-1:8884", "as-2:8884"})
+
+		servers["as-1:8884"].err = errTestApplicationServer.New()
+		calls = nil
+		a.So(f.handleUplink(ctx, ups), should.BeNil)
+		a.So(calls, should.Resemble, []string{"cluster", "as-1:8884", "as-2:8884"})
+
+		// Unhealthy Application Servers do not receive duplicates.
+		calls = nil
+		a.So(f.handleUplink(ctx, ups), should.BeNil)
+		a.So(calls, should.Resemble, []string{"cluster", "as-2:8884"})
+	})
+
+	t.Run("NoSecondaries", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+
+		var calls []string
+		f, servers := newTestApplicationServerFailover(t, ApplicationServerFailoverConfig{
+			FailureThreshold: 1,
+			RetryInterval:    time.Hour,
+		}, &calls)
+		servers["cluster"].err = errTestApplicationServer.New()
+
+		for i := 0; i < 2; i++ {
+			a.So(f.handleUplink(ctx, ups), should.HaveSameErrorDefinitionAs, errTestApplicationServer)
+		}
+		a.So(calls, should.Resemble, []string{"cluster", "cluster"})
+	})
+
+	t.Run("InvalidConfiguration", func(t *testing.T) {
+		t.Parallel()
+		a, _ := test.New(t)
+
+		dial := func(
+			applicationServerDialConfig,
+		) (func(context.Context) (ttnpb.NsAsClient, error), func() error) {
+			return nil, nil
+		}
+		keys := map[string]string{"as-1:8884": "aa01"}
+		for _, conf := range []ApplicationServerFailoverConfig{
+			{
+				Secondaries: map[string][]string{"test-app": {"as-1:8884"}},
+				Keys:        keys,
+			},
+			{
+				Secondaries:      map[string][]string{"test-app": {""}},
+				Keys:             keys,
+				FailureThreshold: 1,
+			},
+			{
+				Secondaries:      map[string][]string{"Invalid App": {"as-1:8884"}},
+				Keys:             keys,
+				FailureThreshold: 1,
+			},
+			{
+				Secondaries:      map[string][]string{"test-app": {"as-1:8884"}},
+				FailureThreshold: 1,
+			},
+			{
+				Secondaries:      map[string][]string{"test-app": {"as-1:8884"}},
+				Keys:             map[string]string{"as-1:8884": "not-hex"},
+				FailureThreshold: 1,
+			},
+		} {
+			_, err := newApplicationServerFailover(conf, nil, nil, dial)
+			a.So(err, should.HaveSameErrorDefinitionAs, errInvalidConfiguration)
+		}
+	})
+
+	t.Run("Connections", func(t *testing.T) {
+		t.Parallel()
+		a, _ := test.New(t)
+
+		var calls []string
+		f, servers := newTestApplicationServerFailover(t, ApplicationServerFailoverConfig{
+			Secondaries: map[string][]string{
+				"test-app":  {"as-1:8884", "as-2:8884"},
+				"other-app": {"as-2:8884", clusterApplicationServerAddress},
+			},
+			Keys: map[string]string{
+				"as-1:8884": "aa01",
+				"as-2:8884": "aa02",
+			},
+			RootCAs: map[string]string{
+				"as-1:8884": "as-1-ca.pem",
+			},
+			Insecure:         []string{"as-2:8884"},
+			FailureThreshold: 1,
+			RetryInterval:    time.Hour,
+		}, &calls)
+
+		a.So(servers["as-1:8884"].dialConf, should.Resemble, applicationServerDialConfig{
+			address: "as-1:8884",
+			rootCA:  "as-1-ca.pem",
+		})
+		a.So(servers["as-2:8884"].dialConf, should.Resemble, applicationServerDialConfig{
+			address:  "as-2:8884",
+			insecure: true,
+		})
+
+		a.So(f.close(), should.BeNil)
+		a.So(servers["as-1:8884"].closed, should.BeTrue)
+		a.So(servers["as-2:8884"].closed, should.BeTrue)
+		a.So(servers[clusterApplicationServerAddress].closed, should.BeFalse)
+	})
+}
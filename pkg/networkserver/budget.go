@@ -0,0 +1,170 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/budget"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/toa"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var evtDownlinkBudgetExhausted = events.Define(
+	"ns.down.budget.exhausted", "downlink budget exhausted",
+	events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	events.WithErrorDataType(),
+)
+
+// checkDownlinkBudget returns a budget exhausted error if any of the downlink budgets
+// of the end device are exhausted at the given time.
+// Errors of the budget store are logged and the downlink is allowed.
+func (ns *NetworkServer) checkDownlinkBudget(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, at time.Time) error {
+	if ns.budgets == nil {
+		return nil
+	}
+	err := ns.budgets.Check(ctx, ids, at)
+	if err != nil && !budget.IsExhausted(err) {
+		log.FromContext(ctx).WithError(err).Warn("Failed to check downlink budget")
+		return nil
+	}
+	return err
+}
+
+// downlinkAirtime returns the time-on-air of the downlink in the slowest of the attempted RX windows.
+func downlinkAirtime(down *ttnpb.DownlinkMessage) (time.Duration, error) {
+	req := down.GetRequest()
+	var airtime time.Duration
+	for _, settings := range []*ttnpb.TxSettings{
+		{DataRate: req.GetRx1DataRate(), Frequency: req.GetRx1Frequency()},
+		{DataRate: req.GetRx2DataRate(), Frequency: req.GetRx2Frequency()},
+	} {
+		if settings.DataRate.GetModulation() == nil {
+			continue
+		}
+		d, err := toa.Compute(len(down.RawPayload), settings)
+		if err != nil {
+			return 0, err
+		}
+		if d > airtime {
+			airtime = d
+		}
+	}
+	return airtime, nil
+}
+
+// reserveDownlinkBudget atomically checks the downlink budgets of the end device and records the downlink in them.
+// reserveDownlinkBudget returns a budget exhausted error if any of the downlink budgets is exhausted, or a function
+// which releases the reservation if the downlink is not transmitted.
+// Errors of the budget store are logged and the downlink is allowed.
+func (ns *NetworkServer) reserveDownlinkBudget(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, down *ttnpb.DownlinkMessage,
+) (func(context.Context), error) {
+	release := func(context.Context) {}
+	if ns.budgets == nil {
+		return release, nil
+	}
+	logger := log.FromContext(ctx)
+	airtime, err := downlinkAirtime(down)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to compute downlink airtime")
+		return release, nil
+	}
+	at := time.Now()
+	if err := ns.budgets.Reserve(ctx, ids, at, airtime); err != nil {
+		if budget.IsExhausted(err) {
+			return nil, err
+		}
+		logger.WithError(err).Warn("Failed to reserve downlink budget")
+		return release, nil
+	}
+	return func(ctx context.Context) {
+		if err := ns.budgets.Release(ctx, ids, at, airtime); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to release downlink budget")
+		}
+	}, nil
+}
+
+// downlinkBudgetResetAt returns the time at which the exhausted downlink budgets of the end device reset,
+// or the zero time if the downlink budgets of the end device are not exhausted at t.
+func (ns *NetworkServer) downlinkBudgetResetAt(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, t time.Time) time.Time {
+	if ns.checkDownlinkBudget(ctx, ids, t) == nil {
+		return time.Time{}
+	}
+	return budget.PeriodStart(t).Add(budget.Period)
+}
+
+// checkDownlinkQueueBudget returns a budget exhausted error if the end device may not
+// queue application downlinks due to exhausted downlink budgets.
+func (ns *NetworkServer) checkDownlinkQueueBudget(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	err := ns.checkDownlinkBudget(ctx, ids, time.Now())
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("Reject downlink queue operation due to exhausted downlink budget")
+		events.Publish(evtDownlinkBudgetExhausted.NewWithIdentifiersAndData(ctx, ids, err))
+	}
+	return err
+}
+
+func downlinkBudgetsProto(reports []*budget.Report) *ttnpb.DownlinkBudgets {
+	pb := &ttnpb.DownlinkBudgets{
+		Budgets: make([]*ttnpb.DownlinkBudget, 0, len(reports)),
+	}
+	for _, r := range reports {
+		pb.Budgets = append(pb.Budgets, r.Proto())
+	}
+	return pb
+}
+
+// GetEndDeviceDownlinkBudgets implements ttnpb.NsServer.
+func (ns *NetworkServer) GetEndDeviceDownlinkBudgets(
+	ctx context.Context, req *ttnpb.GetEndDeviceDownlinkBudgetsRequest,
+) (*ttnpb.DownlinkBudgets, error) {
+	if err := rights.RequireApplication(
+		ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+	); err != nil {
+		return nil, err
+	}
+	if ns.budgets == nil {
+		return &ttnpb.DownlinkBudgets{}, nil
+	}
+	reports, err := ns.budgets.DeviceUsage(ctx, req.EndDeviceIds, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return downlinkBudgetsProto(reports), nil
+}
+
+// GetApplicationDownlinkBudgets implements ttnpb.NsServer.
+func (ns *NetworkServer) GetApplicationDownlinkBudgets(
+	ctx context.Context, req *ttnpb.GetApplicationDownlinkBudgetsRequest,
+) (*ttnpb.DownlinkBudgets, error) {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+	); err != nil {
+		return nil, err
+	}
+	if ns.budgets == nil {
+		return &ttnpb.DownlinkBudgets{}, nil
+	}
+	reports, err := ns.budgets.ApplicationUsage(ctx, req.ApplicationIds, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return downlinkBudgetsProto(reports), nil
+}
@@ -0,0 +1,302 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package budget implements daily downlink airtime and downlink count budgets
+// for end devices, applications and all end devices served by the Network Server.
+package budget
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Scope is the scope of a budget.
+type Scope string
+
+const (
+	// ScopeDevice is the budget of a single end device.
+	ScopeDevice Scope = "device"
+	// ScopeApplication is the budget shared by all end devices of an application.
+	ScopeApplication Scope = "application"
+	// ScopeNetwork is the budget shared by all end devices served by the Network Server.
+	ScopeNetwork Scope = "network"
+)
+
+var scopeProtos = map[Scope]ttnpb.DownlinkBudgetScope{
+	ScopeDevice:      ttnpb.DownlinkBudgetScope_DOWNLINK_BUDGET_SCOPE_END_DEVICE,
+	ScopeApplication: ttnpb.DownlinkBudgetScope_DOWNLINK_BUDGET_SCOPE_APPLICATION,
+	ScopeNetwork:     ttnpb.DownlinkBudgetScope_DOWNLINK_BUDGET_SCOPE_NETWORK,
+}
+
+// NetworkID is the identifier of the network scope.
+const NetworkID = "network"
+
+// Period is the duration of a budget period.
+// Budget periods start at midnight UTC.
+const Period = 24 * time.Hour
+
+// PeriodStart returns the start of the budget period t is in.
+func PeriodStart(t time.Time) time.Time {
+	return t.UTC().Truncate(Period)
+}
+
+// Limits are the limits of a budget. Zero values are unlimited.
+type Limits struct {
+	Airtime   time.Duration `name:"airtime" description:"Maximum downlink airtime per day (0 is unlimited)"`
+	Downlinks uint64        `name:"downlinks" description:"Maximum number of downlinks per day (0 is unlimited)"`
+}
+
+// IsZero returns whether the limits are unlimited.
+func (l Limits) IsZero() bool {
+	return l.Airtime == 0 && l.Downlinks == 0
+}
+
+// Exhausted returns whether the usage exhausts the limits.
+func (l Limits) Exhausted(u Usage) bool {
+	return l.Airtime > 0 && u.Airtime >= l.Airtime ||
+		l.Downlinks > 0 && u.Downlinks >= l.Downlinks
+}
+
+// Usage is the usage of a budget in a period.
+type Usage struct {
+	Airtime   time.Duration
+	Downlinks uint64
+}
+
+// Budget identifies a budget and its limits.
+type Budget struct {
+	Scope  Scope
+	ID     string
+	Limits Limits
+}
+
+// Store stores budget usage.
+type Store interface {
+	// Get returns the usage of the budget identified by scope and id in the period starting at period.
+	Get(ctx context.Context, scope Scope, id string, period time.Time) (Usage, error)
+	// Reserve atomically checks the budgets and adds one downlink with the given airtime to each of them
+	// in the period starting at period. If any of the budgets is exhausted, Reserve adds no usage and returns
+	// the index of the first exhausted budget and its usage. Otherwise, Reserve returns -1.
+	Reserve(ctx context.Context, budgets []Budget, period time.Time, airtime time.Duration) (int, Usage, error)
+	// Release removes one downlink with the given airtime from each of the budgets in the period starting
+	// at period. Release reverts a Reserve call for a downlink which has not been transmitted.
+	Release(ctx context.Context, budgets []Budget, period time.Time, airtime time.Duration) error
+}
+
+// Config is the configuration of the downlink budgets.
+type Config struct {
+	Device      Limits `name:"device" description:"Daily downlink budget per end device"`
+	Application Limits `name:"application" description:"Daily downlink budget per application"`
+	Network     Limits `name:"network" description:"Daily downlink budget of all end devices served by the Network Server"`
+	Store       Store  `name:"-"`
+}
+
+// IsZero returns whether no budgets are configured.
+func (c Config) IsZero() bool {
+	return c.Device.IsZero() && c.Application.IsZero() && c.Network.IsZero()
+}
+
+var errExhausted = errors.DefineResourceExhausted(
+	"budget_exhausted",
+	"{scope} downlink budget of `{id}` exhausted until `{reset_at}`",
+	"airtime", "airtime_limit", "downlinks", "downlinks_limit",
+)
+
+// IsExhausted returns whether err is a budget exhausted error.
+func IsExhausted(err error) bool {
+	return errors.Resemble(err, errExhausted)
+}
+
+// Report is the usage of a budget in the current period.
+type Report struct {
+	Scope   Scope
+	ID      string
+	Usage   Usage
+	Limits  Limits
+	ResetAt time.Time
+}
+
+// Exhausted returns whether the budget is exhausted.
+func (r *Report) Exhausted() bool {
+	return r.Limits.Exhausted(r.Usage)
+}
+
+// Proto returns the report as a ttnpb.DownlinkBudget.
+func (r *Report) Proto() *ttnpb.DownlinkBudget {
+	pb := &ttnpb.DownlinkBudget{
+		Scope:          scopeProtos[r.Scope],
+		Id:             r.ID,
+		Airtime:        durationpb.New(r.Usage.Airtime),
+		Downlinks:      r.Usage.Downlinks,
+		DownlinksLimit: r.Limits.Downlinks,
+		Exhausted:      r.Exhausted(),
+		ResetAt:        timestamppb.New(r.ResetAt),
+	}
+	if r.Limits.Airtime > 0 {
+		pb.AirtimeLimit = durationpb.New(r.Limits.Airtime)
+	}
+	return pb
+}
+
+func durationString(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return d.String()
+}
+
+func (r *Report) err() error {
+	return errExhausted.WithAttributes(
+		"scope", string(r.Scope),
+		"id", r.ID,
+		"reset_at", r.ResetAt.Format(time.RFC3339),
+		"airtime", r.Usage.Airtime.String(),
+		"airtime_limit", durationString(r.Limits.Airtime),
+		"downlinks", r.Usage.Downlinks,
+		"downlinks_limit", r.Limits.Downlinks,
+	)
+}
+
+// Enforcer enforces downlink budgets.
+type Enforcer struct {
+	store  Store
+	limits map[Scope]Limits
+}
+
+// NewEnforcer returns a new Enforcer for the given configuration.
+func NewEnforcer(conf Config) *Enforcer {
+	return &Enforcer{
+		store: conf.Store,
+		limits: map[Scope]Limits{
+			ScopeDevice:      conf.Device,
+			ScopeApplication: conf.Application,
+			ScopeNetwork:     conf.Network,
+		},
+	}
+}
+
+// limited returns the budgets with the limits of their scope, omitting the unlimited budgets.
+func (e *Enforcer) limited(budgets ...Budget) []Budget {
+	limited := budgets[:0]
+	for _, b := range budgets {
+		b.Limits = e.limits[b.Scope]
+		if b.Limits.IsZero() {
+			continue
+		}
+		limited = append(limited, b)
+	}
+	return limited
+}
+
+func (e *Enforcer) deviceBudgets(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) []Budget {
+	return e.limited(
+		Budget{Scope: ScopeDevice, ID: unique.ID(ctx, ids)},
+		Budget{Scope: ScopeApplication, ID: unique.ID(ctx, ids.ApplicationIds)},
+		Budget{Scope: ScopeNetwork, ID: NetworkID},
+	)
+}
+
+func (e *Enforcer) applicationBudgets(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) []Budget {
+	return e.limited(
+		Budget{Scope: ScopeApplication, ID: unique.ID(ctx, ids)},
+		Budget{Scope: ScopeNetwork, ID: NetworkID},
+	)
+}
+
+func (e *Enforcer) reports(ctx context.Context, budgets []Budget, at time.Time) ([]*Report, error) {
+	period := PeriodStart(at)
+	reports := make([]*Report, 0, len(budgets))
+	for _, b := range budgets {
+		usage, err := e.store.Get(ctx, b.Scope, b.ID, period)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, &Report{
+			Scope:   b.Scope,
+			ID:      b.ID,
+			Usage:   usage,
+			Limits:  b.Limits,
+			ResetAt: period.Add(Period),
+		})
+	}
+	return reports, nil
+}
+
+// Check returns an error if any of the budgets that apply to the end device are exhausted at the given time.
+// Check does not reserve any usage, use Reserve to atomically check and record a downlink.
+func (e *Enforcer) Check(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, at time.Time) error {
+	reports, err := e.reports(ctx, e.deviceBudgets(ctx, ids), at)
+	if err != nil {
+		return err
+	}
+	for _, r := range reports {
+		if r.Exhausted() {
+			return r.err()
+		}
+	}
+	return nil
+}
+
+// Reserve atomically checks the budgets that apply to the end device at the given time and records a downlink
+// with the given airtime in them. If any of the budgets is exhausted, no usage is recorded and a budget exhausted
+// error is returned.
+func (e *Enforcer) Reserve(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, at time.Time, airtime time.Duration,
+) error {
+	budgets := e.deviceBudgets(ctx, ids)
+	if len(budgets) == 0 {
+		return nil
+	}
+	period := PeriodStart(at)
+	i, usage, err := e.store.Reserve(ctx, budgets, period, airtime)
+	if err != nil || i < 0 {
+		return err
+	}
+	r := &Report{
+		Scope:   budgets[i].Scope,
+		ID:      budgets[i].ID,
+		Usage:   usage,
+		Limits:  budgets[i].Limits,
+		ResetAt: period.Add(Period),
+	}
+	return r.err()
+}
+
+// Release releases a downlink with the given airtime, which has been reserved at the given time but has not
+// been transmitted, from the budgets that apply to the end device.
+func (e *Enforcer) Release(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, at time.Time, airtime time.Duration,
+) error {
+	budgets := e.deviceBudgets(ctx, ids)
+	if len(budgets) == 0 {
+		return nil
+	}
+	return e.store.Release(ctx, budgets, PeriodStart(at), airtime)
+}
+
+// DeviceUsage returns the usage of the budgets that apply to the end device at the given time.
+func (e *Enforcer) DeviceUsage(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, at time.Time) ([]*Report, error) {
+	return e.reports(ctx, e.deviceBudgets(ctx, ids), at)
+}
+
+// ApplicationUsage returns the usage of the budgets that apply to the application at the given time.
+func (e *Enforcer) ApplicationUsage(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, at time.Time) ([]*Report, error) {
+	return e.reports(ctx, e.applicationBudgets(ctx, ids), at)
+}
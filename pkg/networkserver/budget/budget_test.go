@@ -0,0 +1,162 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package budget_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/budget"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEnforcer(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	e := budget.NewEnforcer(budget.Config{
+		Device: budget.Limits{
+			Downlinks: 2,
+		},
+		Application: budget.Limits{
+			Airtime: time.Second,
+		},
+		Store: budget.NewMemoryStore(),
+	})
+
+	dev1 := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "dev1",
+	}
+	dev2 := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: dev1.ApplicationIds,
+		DeviceId:       "dev2",
+	}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	a.So(e.Check(ctx, dev1, now), should.BeNil)
+	a.So(e.Reserve(ctx, dev1, now, 100*time.Millisecond), should.BeNil)
+	a.So(e.Check(ctx, dev1, now), should.BeNil)
+	a.So(e.Reserve(ctx, dev1, now, 100*time.Millisecond), should.BeNil)
+
+	// Device downlink count exhausted.
+	err := e.Check(ctx, dev1, now)
+	a.So(budget.IsExhausted(err), should.BeTrue)
+	err = e.Reserve(ctx, dev1, now, 100*time.Millisecond)
+	a.So(budget.IsExhausted(err), should.BeTrue)
+	a.So(e.Check(ctx, dev2, now), should.BeNil)
+
+	reports, err := e.DeviceUsage(ctx, dev1, now)
+	a.So(err, should.BeNil)
+	a.So(reports, should.Resemble, []*budget.Report{
+		{
+			Scope:   budget.ScopeDevice,
+			ID:      "test-app.dev1",
+			Usage:   budget.Usage{Airtime: 200 * time.Millisecond, Downlinks: 2},
+			Limits:  budget.Limits{Downlinks: 2},
+			ResetAt: time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Scope:   budget.ScopeApplication,
+			ID:      "test-app",
+			Usage:   budget.Usage{Airtime: 200 * time.Millisecond, Downlinks: 2},
+			Limits:  budget.Limits{Airtime: time.Second},
+			ResetAt: time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC),
+		},
+	})
+
+	// Application airtime exhausted.
+	a.So(e.Reserve(ctx, dev2, now, 800*time.Millisecond), should.BeNil)
+	err = e.Check(ctx, dev2, now)
+	a.So(budget.IsExhausted(err), should.BeTrue)
+
+	// Released downlinks do not count towards the budgets.
+	a.So(e.Release(ctx, dev2, now, 800*time.Millisecond), should.BeNil)
+	a.So(e.Check(ctx, dev2, now), should.BeNil)
+	a.So(e.Reserve(ctx, dev2, now, 800*time.Millisecond), should.BeNil)
+
+	reports, err = e.ApplicationUsage(ctx, dev1.ApplicationIds, now)
+	a.So(err, should.BeNil)
+	if a.So(reports, should.HaveLength, 1) {
+		a.So(reports[0].Exhausted(), should.BeTrue)
+	}
+
+	reports, err = e.DeviceUsage(ctx, dev2, now)
+	a.So(err, should.BeNil)
+	if a.So(reports, should.HaveLength, 2) {
+		a.So(reports[0].Proto(), should.Resemble, &ttnpb.DownlinkBudget{
+			Scope:          ttnpb.DownlinkBudgetScope_DOWNLINK_BUDGET_SCOPE_END_DEVICE,
+			Id:             "test-app.dev2",
+			Airtime:        durationpb.New(800 * time.Millisecond),
+			Downlinks:      1,
+			DownlinksLimit: 2,
+			ResetAt:        timestamppb.New(time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)),
+		})
+	}
+
+	// Budgets reset at midnight UTC.
+	tomorrow := now.Add(12 * time.Hour)
+	a.So(e.Check(ctx, dev1, tomorrow), should.BeNil)
+	a.So(e.Check(ctx, dev2, tomorrow), should.BeNil)
+}
+
+func TestPeriodStart(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	a.So(budget.PeriodStart(time.Date(2026, 10, 19, 1, 30, 0, 0, loc)), should.Equal,
+		time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC))
+	a.So(budget.PeriodStart(time.Date(2026, 10, 19, 23, 59, 59, 0, time.UTC)), should.Equal,
+		time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC))
+}
+
+func TestEnforcerReserveConcurrent(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	e := budget.NewEnforcer(budget.Config{
+		Network: budget.Limits{
+			Downlinks: 10,
+		},
+		Store: budget.NewMemoryStore(),
+	})
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	var reserved atomic.Int64
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if e.Reserve(ctx, &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				DeviceId:       fmt.Sprintf("dev%d", i),
+			}, now, time.Millisecond) == nil {
+				reserved.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+	a.So(reserved.Load(), should.Equal, 10)
+}
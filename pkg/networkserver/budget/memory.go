@@ -0,0 +1,106 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package budget
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryKey struct {
+	scope  Scope
+	id     string
+	period int64
+}
+
+// MemoryStore is an in-memory Store.
+// Usage of past periods is discarded when usage of a newer period is added.
+type MemoryStore struct {
+	mu     sync.Mutex
+	usage  map[memoryKey]Usage
+	latest int64
+}
+
+// NewMemoryStore returns a new MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		usage: make(map[memoryKey]Usage),
+	}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(_ context.Context, scope Scope, id string, period time.Time) (Usage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.usage[memoryKey{scope: scope, id: id, period: period.Unix()}], nil
+}
+
+// discard discards the usage of the periods before period.
+func (s *MemoryStore) discard(period int64) {
+	if period <= s.latest {
+		return
+	}
+	for k := range s.usage {
+		if k.period < period {
+			delete(s.usage, k)
+		}
+	}
+	s.latest = period
+}
+
+// Reserve implements Store.
+func (s *MemoryStore) Reserve(
+	_ context.Context, budgets []Budget, period time.Time, airtime time.Duration,
+) (int, Usage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := period.Unix()
+	s.discard(p)
+	for i, b := range budgets {
+		if u := s.usage[memoryKey{scope: b.Scope, id: b.ID, period: p}]; b.Limits.Exhausted(u) {
+			return i, u, nil
+		}
+	}
+	for _, b := range budgets {
+		k := memoryKey{scope: b.Scope, id: b.ID, period: p}
+		u := s.usage[k]
+		u.Airtime += airtime
+		u.Downlinks++
+		s.usage[k] = u
+	}
+	return -1, Usage{}, nil
+}
+
+// Release implements Store.
+func (s *MemoryStore) Release(_ context.Context, budgets []Budget, period time.Time, airtime time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range budgets {
+		k := memoryKey{scope: b.Scope, id: b.ID, period: period.Unix()}
+		u, ok := s.usage[k]
+		if !ok {
+			continue
+		}
+		if u.Airtime -= airtime; u.Airtime < 0 {
+			u.Airtime = 0
+		}
+		if u.Downlinks > 0 {
+			u.Downlinks--
+		}
+		s.usage[k] = u
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/budget"
+	"go.thethings.network/lorawan-stack/v3/pkg/toa"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDownlinkAirtime(t *testing.T) {
+	t.Parallel()
+
+	loraDataRate := func(sf uint32) *ttnpb.DataRate {
+		return &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Lora{
+				Lora: &ttnpb.LoRaDataRate{
+					SpreadingFactor: sf,
+					Bandwidth:       125000,
+					CodingRate:      band.Cr4_5,
+				},
+			},
+		}
+	}
+	payload := make([]byte, 20)
+	airtime := func(dr *ttnpb.DataRate, freq uint64) time.Duration {
+		return test.Must(toa.Compute(len(payload), &ttnpb.TxSettings{DataRate: dr, Frequency: freq}))
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Request  *ttnpb.TxRequest
+		Expected time.Duration
+	}{
+		{
+			Name: "RX1 only",
+			Request: &ttnpb.TxRequest{
+				Rx1DataRate:  loraDataRate(7),
+				Rx1Frequency: 868100000,
+			},
+			Expected: airtime(loraDataRate(7), 868100000),
+		},
+		{
+			Name: "RX2 only",
+			Request: &ttnpb.TxRequest{
+				Rx2DataRate:  loraDataRate(12),
+				Rx2Frequency: 869525000,
+			},
+			Expected: airtime(loraDataRate(12), 869525000),
+		},
+		{
+			Name: "RX1 and RX2",
+			Request: &ttnpb.TxRequest{
+				Rx1DataRate:  loraDataRate(7),
+				Rx1Frequency: 868100000,
+				Rx2DataRate:  loraDataRate(9),
+				Rx2Frequency: 869525000,
+			},
+			Expected: airtime(loraDataRate(9), 869525000),
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				d, err := downlinkAirtime(&ttnpb.DownlinkMessage{
+					RawPayload: payload,
+					Settings: &ttnpb.DownlinkMessage_Request{
+						Request: tc.Request,
+					},
+				})
+				a.So(err, should.BeNil)
+				a.So(d, should.Equal, tc.Expected)
+			},
+		})
+	}
+}
+
+func TestDownlinkBudgetResetAt(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ns := &NetworkServer{
+		budgets: budget.NewEnforcer(budget.Config{
+			Device: budget.Limits{
+				Downlinks: 1,
+			},
+			Store: budget.NewMemoryStore(),
+		}),
+	}
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	a.So(ns.downlinkBudgetResetAt(ctx, ids, now), should.BeZeroValue)
+	a.So(ns.budgets.Reserve(ctx, ids, now, time.Second), should.BeNil)
+	a.So(ns.downlinkBudgetResetAt(ctx, ids, now), should.Equal, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC))
+	a.So(ns.downlinkBudgetResetAt(ctx, ids, now.Add(12*time.Hour)), should.BeZeroValue)
+}
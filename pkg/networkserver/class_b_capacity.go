@@ -0,0 +1,427 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"strconv"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/packetbroker"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/relayspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// pingSlotReservationLookback is the time before a ping slot from which reservations may overlap the ping slot.
+// It is longer than the airtime of any class B downlink.
+const pingSlotReservationLookback = 4 * time.Second
+
+var (
+	errClassBCapacityDisabled = errors.DefineFailedPrecondition(
+		"class_b_capacity_disabled", "class B capacity planning is disabled",
+	)
+	errInvalidPingSlotFrequency = errors.DefineInvalidArgument(
+		"invalid_ping_slot_frequency", "invalid ping slot frequency `{frequency}`",
+	)
+
+	evtSkipPingSlot = events.Define(
+		"ns.class_b.ping_slot.skip", "skip occupied ping slot",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	)
+	evtStaggerPingSlotFrequency = events.Define(
+		"ns.class_b.ping_slot.stagger", "stagger ping slot frequency",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.MACCommand_PingSlotChannelReq{}),
+	)
+)
+
+// PingSlotReservation is a class B downlink which is scheduled in a ping slot of a gateway.
+type PingSlotReservation struct {
+	DeviceUID string        `json:"device_uid"`
+	StartsAt  time.Time     `json:"starts_at"`
+	Airtime   time.Duration `json:"airtime"`
+}
+
+func (r *PingSlotReservation) overlaps(startsAt time.Time, airtime time.Duration) bool {
+	return startsAt.Before(r.StartsAt.Add(r.Airtime)) && r.StartsAt.Before(startsAt.Add(airtime))
+}
+
+// ClassBDevice is a class B end device of which the gateway is the primary gateway.
+type ClassBDevice struct {
+	DeviceUID             string               `json:"device_uid"`
+	PingSlotPeriodicity   ttnpb.PingSlotPeriod `json:"ping_slot_periodicity"`
+	PingSlotFrequency     uint64               `json:"ping_slot_frequency"`
+	PingSlotDataRateIndex ttnpb.DataRateIndex  `json:"ping_slot_data_rate_index"`
+	UpdatedAt             time.Time            `json:"updated_at"`
+}
+
+// PingSlotOccupancyRegistry stores the ping slot reservations and the class B end devices of gateways.
+type PingSlotOccupancyRegistry interface {
+	// Reserve records the reservation of a ping slot of the gateway.
+	// Reservations expire after the beacon period which follows the beacon period of the reservation.
+	Reserve(ctx context.Context, gatewayID string, r *PingSlotReservation) error
+	// Reservations returns the reservations of the gateway which start in [from, to), ordered by start time.
+	Reservations(ctx context.Context, gatewayID string, from, to time.Time) ([]*PingSlotReservation, error)
+	// SetDevice records the class B end device of the gateway. The class B end devices of a gateway expire after
+	// ttl without updates. A ttl of 0 means that the end devices do not expire.
+	SetDevice(ctx context.Context, gatewayID string, dev *ClassBDevice, ttl time.Duration) error
+	// Devices returns the class B end devices of the gateway.
+	Devices(ctx context.Context, gatewayID string) ([]*ClassBDevice, error)
+}
+
+// classBCapacity tracks the ping slot occupancy of gateways.
+type classBCapacity struct {
+	registry        PingSlotOccupancyRegistry
+	frequencies     []uint64
+	deviceTTL       time.Duration
+	registryTimeout time.Duration
+}
+
+func newClassBCapacity(conf ClassBCapacityConfig) (*classBCapacity, error) {
+	if conf.Registry == nil {
+		return nil, errInvalidConfiguration.WithCause(errors.New("ClassBCapacity.Registry is not specified"))
+	}
+	c := &classBCapacity{
+		registry:        conf.Registry,
+		deviceTTL:       conf.DeviceTTL,
+		registryTimeout: conf.RegistryTimeout,
+	}
+	for _, s := range conf.PingSlotFrequencies {
+		freq, err := strconv.ParseUint(s, 10, 64)
+		if err != nil || freq == 0 {
+			return nil, errInvalidConfiguration.WithCause(errInvalidPingSlotFrequency.WithAttributes("frequency", s))
+		}
+		c.frequencies = append(c.frequencies, freq)
+	}
+	return c, nil
+}
+
+// registryContext returns a context which bounds the registry calls of class B downlinks by the registry timeout.
+func (c *classBCapacity) registryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.registryTimeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, c.registryTimeout)
+}
+
+// activeDevices returns the devices which have been updated within the device TTL.
+func (c *classBCapacity) activeDevices(devices []*ClassBDevice, now time.Time) []*ClassBDevice {
+	if c.deviceTTL == 0 {
+		return devices
+	}
+	active := devices[:0:0]
+	for _, dev := range devices {
+		if now.Sub(dev.UpdatedAt) <= c.deviceTTL {
+			active = append(active, dev)
+		}
+	}
+	return active
+}
+
+// occupancyGatewayID returns the ID of the gateway of which the ping slot occupancy is tracked for the downlink path.
+// The occupancy of Packet Broker, relay, passive roaming and packet source downlink paths is not tracked.
+func occupancyGatewayID(path downlinkPath) string {
+	switch {
+	case proto.Equal(path.GatewayIdentifiers, packetbroker.GatewayIdentifiers),
+		proto.Equal(path.GatewayIdentifiers, relayspec.GatewayIdentifiers),
+		proto.Equal(path.GatewayIdentifiers, passiveRoamingGatewayIdentifiers),
+		path.packetSourceID != "":
+		return ""
+	default:
+		return path.GatewayIdentifiers.GetGatewayId()
+	}
+}
+
+// availablePaths returns the downlink paths of which the gateway has no reservation which overlaps the ping slot.
+// Groups without available downlink paths are removed. Downlink paths of which the occupancy is unknown, because the
+// registry fails or does not respond within the registry timeout, are available.
+func (c *classBCapacity) availablePaths(
+	ctx context.Context, groupedPaths map[uint32][]downlinkPath, startsAt time.Time, airtime time.Duration,
+) map[uint32][]downlinkPath {
+	registryCtx, cancel := c.registryContext(ctx)
+	defer cancel()
+	occupied := make(map[string]bool)
+	isOccupied := func(gatewayID string) bool {
+		if v, ok := occupied[gatewayID]; ok {
+			return v
+		}
+		rs, err := c.registry.Reservations(
+			registryCtx, gatewayID, startsAt.Add(-pingSlotReservationLookback), startsAt.Add(airtime),
+		)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithField("gateway_id", gatewayID).Warn("Failed to get ping slot reservations")
+			occupied[gatewayID] = false
+			return false
+		}
+		for _, r := range rs {
+			if r.overlaps(startsAt, airtime) {
+				occupied[gatewayID] = true
+				return true
+			}
+		}
+		occupied[gatewayID] = false
+		return false
+	}
+	available := make(map[uint32][]downlinkPath, len(groupedPaths))
+	for i, paths := range groupedPaths {
+		for _, path := range paths {
+			if gtwID := occupancyGatewayID(path); gtwID != "" && isOccupied(gtwID) {
+				continue
+			}
+			available[i] = append(available[i], path)
+		}
+	}
+	return available
+}
+
+// reserve records the reservation of the ping slot of the gateway of the first downlink path of down.
+func (c *classBCapacity) reserve(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	groupedPaths map[uint32][]downlinkPath,
+	down *ttnpb.DownlinkMessage,
+	startsAt time.Time,
+) {
+	downlinkPaths := down.GetRequest().GetDownlinkPaths()
+	if len(downlinkPaths) == 0 {
+		return
+	}
+	var gatewayID string
+	for _, paths := range groupedPaths {
+		for _, path := range paths {
+			if path.DownlinkPath == downlinkPaths[0] {
+				gatewayID = occupancyGatewayID(path)
+			}
+		}
+	}
+	if gatewayID == "" {
+		return
+	}
+	airtime, err := downlinkAirtime(down)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to compute class B downlink airtime")
+		return
+	}
+	registryCtx, cancel := c.registryContext(ctx)
+	defer cancel()
+	if err := c.registry.Reserve(registryCtx, gatewayID, &PingSlotReservation{
+		DeviceUID: unique.ID(ctx, ids),
+		StartsAt:  startsAt,
+		Airtime:   airtime,
+	}); err != nil {
+		log.FromContext(ctx).WithError(err).WithField("gateway_id", gatewayID).Warn("Failed to reserve ping slot")
+	}
+}
+
+// primaryGatewayID returns the gateway which received the uplink directly with the best SNR.
+func primaryGatewayID(up *ttnpb.UplinkMessage) string {
+	var (
+		gatewayID string
+		bestSNR   float32
+	)
+	for _, md := range up.RxMetadata {
+		gtwID := md.GetGatewayIds().GetGatewayId()
+		if md.GetRelay() != nil || md.GetPacketBroker() != nil || gtwID == "" {
+			continue
+		}
+		if gatewayID == "" || md.Snr > bestSNR {
+			gatewayID, bestSNR = gtwID, md.Snr
+		}
+	}
+	return gatewayID
+}
+
+// staggerPingSlotFrequency returns the candidate ping slot frequency which is used by the fewest other class B end
+// devices of the gateway. The current frequency is kept unless another candidate is used by fewer end devices.
+func staggerPingSlotFrequency(others []*ClassBDevice, current uint64, candidates []uint64) uint64 {
+	usage := make(map[uint64]int, len(candidates))
+	for _, dev := range others {
+		usage[dev.PingSlotFrequency]++
+	}
+	best := candidates[0]
+	for _, freq := range candidates[1:] {
+		if usage[freq] < usage[best] {
+			best = freq
+		}
+	}
+	for _, freq := range candidates {
+		if freq == current && usage[current] <= usage[best] {
+			return current
+		}
+	}
+	return best
+}
+
+// handleClassBCapacity records the class B end device dev for the primary gateway of the deduplicated data uplink up,
+// and staggers the ping slot frequency of dev across the ping slot frequencies, if class B capacity is enabled.
+func (ns *NetworkServer) handleClassBCapacity(
+	ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, up *ttnpb.UplinkMessage,
+) {
+	c := ns.classBCapacity
+	if c == nil || dev.GetMacState().GetDeviceClass() != ttnpb.Class_CLASS_B || dev.MacState.PingSlotPeriodicity == nil {
+		return
+	}
+	gatewayID := primaryGatewayID(up)
+	if gatewayID == "" {
+		return
+	}
+	logger := log.FromContext(ctx).WithField("gateway_id", gatewayID)
+	now := time.Now()
+	uid := unique.ID(ctx, dev.Ids)
+	if err := c.registry.SetDevice(ctx, gatewayID, &ClassBDevice{
+		DeviceUID:             uid,
+		PingSlotPeriodicity:   dev.MacState.PingSlotPeriodicity.Value,
+		PingSlotFrequency:     mac.DevicePingSlotFrequency(dev, phy, now),
+		PingSlotDataRateIndex: dev.MacState.CurrentParameters.PingSlotDataRateIndexValue.GetValue(),
+		UpdatedAt:             now,
+	}, c.deviceTTL); err != nil {
+		logger.WithError(err).Warn("Failed to record class B end device")
+		return
+	}
+	// NOTE: Ping slot frequencies which hop per beacon period are staggered by the DevAddr already.
+	// Only the ping slot frequency is staggered. The ping slot periodicity is chosen by the end device with
+	// PingSlotInfoReq and cannot be changed by the Network Server. The ping slot data rate determines whether the end
+	// device receives class B downlinks from the gateway at all, so it is not traded for capacity.
+	if len(c.frequencies) == 0 || len(phy.PingSlotFrequencies) > 1 ||
+		dev.MacSettings.GetDesiredPingSlotFrequency() != nil {
+		return
+	}
+	devices, err := c.registry.Devices(ctx, gatewayID)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get class B end devices")
+		return
+	}
+	var others []*ClassBDevice
+	for _, other := range c.activeDevices(devices, now) {
+		if other.DeviceUID != uid {
+			others = append(others, other)
+		}
+	}
+	desired := func(dev *ttnpb.EndDevice) uint64 {
+		if freq := dev.MacState.DesiredParameters.PingSlotFrequency; freq != 0 || len(phy.PingSlotFrequencies) == 0 {
+			return freq
+		}
+		return phy.PingSlotFrequencies[0]
+	}
+	freq := staggerPingSlotFrequency(others, desired(dev), c.frequencies)
+	if freq == desired(dev) {
+		return
+	}
+	var req *ttnpb.MACCommand_PingSlotChannelReq
+	if _, _, err := ns.devices.SetByID(ctx, dev.Ids.ApplicationIds, dev.Ids.DeviceId, []string{
+		"mac_settings.desired_ping_slot_frequency",
+		"mac_state.current_parameters.ping_slot_data_rate_index_value",
+		"mac_state.desired_parameters.ping_slot_data_rate_index_value",
+		"mac_state.desired_parameters.ping_slot_frequency",
+	}, func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if stored == nil || stored.MacState == nil || stored.MacSettings.GetDesiredPingSlotFrequency() != nil {
+			return stored, nil, nil
+		}
+		paths := []string{"mac_state.desired_parameters.ping_slot_frequency"}
+		stored.MacState.DesiredParameters.PingSlotFrequency = freq
+		if stored.MacState.DesiredParameters.PingSlotDataRateIndexValue == nil {
+			stored.MacState.DesiredParameters.PingSlotDataRateIndexValue = stored.MacState.CurrentParameters.PingSlotDataRateIndexValue
+			paths = ttnpb.AddFields(paths, "mac_state.desired_parameters.ping_slot_data_rate_index_value")
+		}
+		req = &ttnpb.MACCommand_PingSlotChannelReq{
+			Frequency:     freq,
+			DataRateIndex: stored.MacState.DesiredParameters.PingSlotDataRateIndexValue.GetValue(),
+		}
+		return stored, paths, nil
+	}); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to stagger ping slot frequency")
+		return
+	}
+	if req != nil {
+		logger.WithField("ping_slot_frequency", freq).Debug("Staggered ping slot frequency")
+		events.Publish(evtStaggerPingSlotFrequency.NewWithIdentifiersAndData(ctx, dev.Ids, req))
+	}
+}
+
+// computeClassBCapacity computes the class B capacity of the gateway from its class B end devices and its ping slot
+// reservations in the beacon periods which start at beaconPeriods.
+func computeClassBCapacity(
+	ids *ttnpb.GatewayIdentifiers,
+	devices []*ClassBDevice,
+	reservations []*PingSlotReservation,
+	beaconPeriods ...time.Time,
+) *ttnpb.GatewayClassBCapacity {
+	capacity := &ttnpb.GatewayClassBCapacity{
+		GatewayIds:         ids,
+		DeviceCount:        uint32(len(devices)),
+		DevicesByFrequency: make(map[uint64]uint32),
+		BeaconPeriods:      make([]*ttnpb.GatewayClassBCapacity_BeaconPeriod, 0, len(beaconPeriods)),
+	}
+	for _, dev := range devices {
+		capacity.PingSlotCount += mac.PingSlotsPerBeaconPeriod(dev.PingSlotPeriodicity)
+		capacity.DevicesByFrequency[dev.PingSlotFrequency]++
+	}
+	for _, startsAt := range beaconPeriods {
+		var (
+			downlinks uint32
+			airtime   time.Duration
+		)
+		for _, r := range reservations {
+			if r.StartsAt.Before(startsAt) || !r.StartsAt.Before(startsAt.Add(mac.BeaconPeriod)) {
+				continue
+			}
+			downlinks++
+			airtime += r.Airtime
+		}
+		capacity.BeaconPeriods = append(capacity.BeaconPeriods, &ttnpb.GatewayClassBCapacity_BeaconPeriod{
+			StartsAt:      timestamppb.New(startsAt),
+			DownlinkCount: downlinks,
+			Airtime:       durationpb.New(airtime),
+			Occupancy:     float32(airtime) / float32(mac.PingSlotWindow),
+		})
+	}
+	return capacity
+}
+
+// GetGatewayClassBCapacity implements ttnpb.NsServer.
+func (ns *NetworkServer) GetGatewayClassBCapacity(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayClassBCapacity, error) {
+	if err := rights.RequireGateway(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	if ns.classBCapacity == nil {
+		return nil, errClassBCapacityDisabled.New()
+	}
+	now := time.Now()
+	devices, err := ns.classBCapacity.registry.Devices(ctx, ids.GatewayId)
+	if err != nil {
+		return nil, err
+	}
+	current := mac.BeaconPeriodStart(now)
+	previous := current.Add(-mac.BeaconPeriod)
+	reservations, err := ns.classBCapacity.registry.Reservations(
+		ctx, ids.GatewayId, previous, current.Add(mac.BeaconPeriod),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return computeClassBCapacity(ids, ns.classBCapacity.activeDevices(devices, now), reservations, previous, current), nil
+}
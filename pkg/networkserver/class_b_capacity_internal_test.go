@@ -0,0 +1,233 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockPingSlotOccupancyRegistry struct {
+	reservations map[string][]*PingSlotReservation
+	err          error
+}
+
+func (r *mockPingSlotOccupancyRegistry) Reserve(_ context.Context, gatewayID string, res *PingSlotReservation) error {
+	r.reservations[gatewayID] = append(r.reservations[gatewayID], res)
+	return nil
+}
+
+func (r *mockPingSlotOccupancyRegistry) Reservations(
+	_ context.Context, gatewayID string, from, to time.Time,
+) ([]*PingSlotReservation, error) {
+	var rs []*PingSlotReservation
+	for _, res := range r.reservations[gatewayID] {
+		if !res.StartsAt.Before(from) && res.StartsAt.Before(to) {
+			rs = append(rs, res)
+		}
+	}
+	return rs, r.err
+}
+
+func (*mockPingSlotOccupancyRegistry) SetDevice(context.Context, string, *ClassBDevice, time.Duration) error {
+	return nil
+}
+
+func (*mockPingSlotOccupancyRegistry) Devices(context.Context, string) ([]*ClassBDevice, error) {
+	return nil, nil
+}
+
+func TestStaggerPingSlotFrequency(t *testing.T) {
+	candidates := []uint64{869100000, 869300000, 869525000}
+	devices := func(freqs ...uint64) []*ClassBDevice {
+		devs := make([]*ClassBDevice, 0, len(freqs))
+		for _, freq := range freqs {
+			devs = append(devs, &ClassBDevice{PingSlotFrequency: freq})
+		}
+		return devs
+	}
+	for _, tc := range []struct {
+		Name     string
+		Others   []*ClassBDevice
+		Current  uint64
+		Expected uint64
+	}{
+		{
+			Name:     "no other devices",
+			Current:  869525000,
+			Expected: 869525000,
+		},
+		{
+			Name:     "current not a candidate",
+			Others:   devices(869100000),
+			Current:  869400000,
+			Expected: 869300000,
+		},
+		{
+			Name:     "current crowded",
+			Others:   devices(869100000, 869300000, 869525000, 869525000),
+			Current:  869525000,
+			Expected: 869100000,
+		},
+		{
+			Name:     "current balanced",
+			Others:   devices(869100000, 869300000, 869525000),
+			Current:  869525000,
+			Expected: 869525000,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				a.So(staggerPingSlotFrequency(tc.Others, tc.Current, candidates), should.Equal, tc.Expected)
+			},
+		})
+	}
+}
+
+func TestPrimaryGatewayID(t *testing.T) {
+	a, _ := test.New(t)
+
+	a.So(primaryGatewayID(&ttnpb.UplinkMessage{
+		RxMetadata: []*ttnpb.RxMetadata{
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"}, Snr: -3},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"}, Snr: 2},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-3"}, Snr: 9, Relay: &ttnpb.RelayMetadata{DeviceId: "relay-1"}},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-4"}, Snr: 9, PacketBroker: &ttnpb.PacketBrokerMetadata{}},
+		},
+	}), should.Equal, "gtw-2")
+	a.So(primaryGatewayID(&ttnpb.UplinkMessage{}), should.BeEmpty)
+}
+
+func TestClassBCapacityAvailablePaths(t *testing.T) {
+	a, ctx := test.New(t)
+
+	startsAt := time.Unix(1700000000, 0).UTC()
+	c := &classBCapacity{
+		registry: &mockPingSlotOccupancyRegistry{
+			reservations: map[string][]*PingSlotReservation{
+				"gtw-1": {{DeviceUID: "test-app.dev-1", StartsAt: startsAt.Add(-time.Second), Airtime: 1100 * time.Millisecond}},
+				"gtw-2": {{DeviceUID: "test-app.dev-2", StartsAt: startsAt.Add(-time.Second), Airtime: time.Second}},
+				"gtw-3": {{DeviceUID: "test-app.dev-3", StartsAt: startsAt.Add(50 * time.Millisecond), Airtime: time.Second}},
+			},
+		},
+	}
+	path := func(gatewayID string) downlinkPath {
+		ids := &ttnpb.GatewayIdentifiers{GatewayId: gatewayID}
+		return downlinkPath{
+			GatewayIdentifiers: ids,
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_Fixed{
+					Fixed: &ttnpb.GatewayAntennaIdentifiers{GatewayIds: ids},
+				},
+			},
+		}
+	}
+	gtw1, gtw2, gtw3, gtw4 := path("gtw-1"), path("gtw-2"), path("gtw-3"), path("gtw-4")
+
+	a.So(c.availablePaths(ctx, map[uint32][]downlinkPath{
+		0: {gtw1, gtw2, gtw3, gtw4},
+	}, startsAt, 100*time.Millisecond), should.Resemble, map[uint32][]downlinkPath{
+		0: {gtw2, gtw4},
+	})
+	a.So(c.availablePaths(ctx, map[uint32][]downlinkPath{
+		0: {gtw1},
+		1: {gtw3},
+	}, startsAt, 100*time.Millisecond), should.BeEmpty)
+
+	c.reserve(ctx, &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "dev-4",
+	}, map[uint32][]downlinkPath{
+		0: {gtw2, gtw4},
+	}, &ttnpb.DownlinkMessage{
+		RawPayload: make([]byte, 20),
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Rx2DataRate: &ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_Lora{
+						Lora: &ttnpb.LoRaDataRate{SpreadingFactor: 9, Bandwidth: 125000, CodingRate: "4/5"},
+					},
+				},
+				Rx2Frequency:  869525000,
+				DownlinkPaths: []*ttnpb.DownlinkPath{gtw4.DownlinkPath},
+			},
+		},
+	}, startsAt)
+	a.So(c.availablePaths(ctx, map[uint32][]downlinkPath{
+		0: {gtw2, gtw4},
+	}, startsAt, 100*time.Millisecond), should.Resemble, map[uint32][]downlinkPath{
+		0: {gtw2},
+	})
+
+	c.registry.(*mockPingSlotOccupancyRegistry).err = errors.New("test")
+	a.So(c.availablePaths(ctx, map[uint32][]downlinkPath{
+		0: {gtw1, gtw2},
+	}, startsAt, 100*time.Millisecond), should.Resemble, map[uint32][]downlinkPath{
+		0: {gtw1, gtw2},
+	})
+}
+
+func TestComputeClassBCapacity(t *testing.T) {
+	a, _ := test.New(t)
+
+	previous := time.Unix(1700000000, 0).UTC()
+	current := previous.Add(mac.BeaconPeriod)
+	capacity := computeClassBCapacity(&ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"}, []*ClassBDevice{
+		{DeviceUID: "test-app.dev-1", PingSlotPeriodicity: ttnpb.PingSlotPeriod_PING_EVERY_1S, PingSlotFrequency: 869525000},
+		{DeviceUID: "test-app.dev-2", PingSlotPeriodicity: ttnpb.PingSlotPeriod_PING_EVERY_128S, PingSlotFrequency: 869525000},
+		{DeviceUID: "test-app.dev-3", PingSlotPeriodicity: ttnpb.PingSlotPeriod_PING_EVERY_32S, PingSlotFrequency: 869100000},
+	}, []*PingSlotReservation{
+		{DeviceUID: "test-app.dev-1", StartsAt: previous.Add(-time.Second), Airtime: time.Second},
+		{DeviceUID: "test-app.dev-1", StartsAt: previous.Add(10 * time.Second), Airtime: 2 * time.Second},
+		{DeviceUID: "test-app.dev-2", StartsAt: current, Airtime: 3 * time.Second},
+		{DeviceUID: "test-app.dev-3", StartsAt: current.Add(mac.BeaconPeriod), Airtime: time.Second},
+	}, previous, current)
+	a.So(capacity, should.Resemble, &ttnpb.GatewayClassBCapacity{
+		GatewayIds:    &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"},
+		DeviceCount:   3,
+		PingSlotCount: 128 + 1 + 4,
+		DevicesByFrequency: map[uint64]uint32{
+			869525000: 2,
+			869100000: 1,
+		},
+		BeaconPeriods: []*ttnpb.GatewayClassBCapacity_BeaconPeriod{
+			{
+				StartsAt:      timestamppb.New(previous),
+				DownlinkCount: 1,
+				Airtime:       durationpb.New(2 * time.Second),
+				Occupancy:     float32(2*time.Second) / float32(mac.PingSlotWindow),
+			},
+			{
+				StartsAt:      timestamppb.New(current),
+				DownlinkCount: 1,
+				Airtime:       durationpb.New(3 * time.Second),
+				Occupancy:     float32(3*time.Second) / float32(mac.PingSlotWindow),
+			},
+		},
+	})
+}
@@ -17,6 +17,7 @@ package networkserver
 import (
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/budget"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -51,6 +52,9 @@ type MACSettingConfig struct {
 	ClassCTimeout              *time.Duration             `name:"class-c-timeout" description:"Deadline for a device in class C mode to respond to requests from the Network Server if not configured in device's MAC settings"`
 	StatusTimePeriodicity      *time.Duration             `name:"status-time-periodicity" description:"The interval after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
 	StatusCountPeriodicity     *uint32                    `name:"status-count-periodicity" description:"Number of uplink messages after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
+	ADRAlgorithm               *string                    `name:"adr-algorithm" description:"ADR algorithm (default, mobile, semtech) Network Server should use if not configured in device's MAC settings. Setting this enables the dynamic ADR mode by default"`
+	DownlinkPathRanking        *string                    `name:"downlink-path-ranking" description:"Downlink path ranking strategy (signal, snr, utilization, preferred, round-robin) Network Server should use if not configured in device's MAC settings"`
+	AutoFCntResync             *bool                      `name:"auto-f-cnt-resync" description:"Resynchronize desynchronized frame counters of ABP and LoRaWAN 1.0.x end devices automatically if not configured in device's MAC settings"`
 }
 
 // Parse parses the configuration and returns ttnpb.MACSettings.
@@ -78,6 +82,27 @@ func (c MACSettingConfig) Parse() (*ttnpb.MACSettings, error) {
 	if c.StatusCountPeriodicity != nil {
 		p.StatusCountPeriodicity = &wrapperspb.UInt32Value{Value: *c.StatusCountPeriodicity}
 	}
+	if c.ADRAlgorithm != nil {
+		if _, err := mac.GetADRAlgorithm(*c.ADRAlgorithm); err != nil {
+			return nil, err
+		}
+		p.Adr = &ttnpb.ADRSettings{
+			Mode: &ttnpb.ADRSettings_Dynamic{
+				Dynamic: &ttnpb.ADRSettings_DynamicMode{
+					Algorithm: *c.ADRAlgorithm,
+				},
+			},
+		}
+	}
+	if c.DownlinkPathRanking != nil {
+		if !isDownlinkPathRankingStrategy(*c.DownlinkPathRanking) {
+			return nil, errDownlinkPathRanking.WithAttributes("name", *c.DownlinkPathRanking)
+		}
+		p.DownlinkPathRanking = *c.DownlinkPathRanking
+	}
+	if c.AutoFCntResync != nil {
+		p.AutoFCntResync = &ttnpb.BoolValue{Value: *c.AutoFCntResync}
+	}
 	if err := p.ValidateFields(); err != nil {
 		return nil, err
 	}
@@ -125,12 +150,168 @@ func (c DownlinkPriorityConfig) Parse() (DownlinkPriorities, error) {
 	return p, nil
 }
 
+// Queue backends of the uplink deduplicator, downlink task queue, application uplink queue
+// and scheduled downlink matcher. The backend does not apply to the end device registry and
+// the other registries of the Network Server, which always use Redis.
+const (
+	// RedisQueueBackend stores the queues in Redis, which allows sharing them between multiple instances.
+	RedisQueueBackend = "redis"
+	// MemoryQueueBackend keeps the queues in memory, which is only suitable for single-node deployments.
+	MemoryQueueBackend = "memory"
+)
+
+var errQueueBackend = errors.DefineInvalidArgument("queue_backend", "invalid queue backend `{value}`")
+
+func validateQueueBackend(backend string) error {
+	switch backend {
+	case "", RedisQueueBackend, MemoryQueueBackend:
+		return nil
+	default:
+		return errQueueBackend.WithAttributes("value", backend)
+	}
+}
+
+// DownlinkPathRankingConfig represents the configuration of the downlink path ranking strategies.
+// The strategy of an end device is selected in its MAC settings.
+type DownlinkPathRankingConfig struct {
+	Utilization       GatewayUtilizationSource `name:"-"`
+	PreferredGateways []string                 `name:"preferred-gateways" description:"IDs of the gateways that are ranked first by the preferred strategy"`
+	RoundRobinMargin  float32                  `name:"round-robin-margin" description:"Signal margin (dB) below the best gateway within which the round-robin strategy rotates gateways"`
+	UtilizationTTL    time.Duration            `name:"utilization-ttl" description:"Time for which the downlink utilization of gateways is cached"`
+}
+
 // InteropConfig represents interoperability client configuration.
 type InteropConfig struct {
 	config.InteropClient `name:",squash"`
 	ID                   *types.EUI64 `name:"id" description:"NSID of this Network Server (EUI)"`
 }
 
+// PassiveRoamingConfig represents the passive roaming configuration.
+// The roaming partners are configured in the interop client configuration.
+type PassiveRoamingConfig struct {
+	BandID           string   `name:"band-id" description:"Band ID of the uplinks forwarded to serving Network Servers"`
+	ForwardingNetIDs []string `name:"forwarding-net-ids" description:"NetIDs of the serving Network Servers to forward uplinks to"`
+	ServingNetIDs    []string `name:"serving-net-ids" description:"NetIDs of the forwarding Network Servers to accept uplinks from"`
+}
+
+// IsZero returns true if passive roaming is not configured.
+func (c PassiveRoamingConfig) IsZero() bool {
+	return len(c.ForwardingNetIDs) == 0 && len(c.ServingNetIDs) == 0
+}
+
+// HandoverRoamingConfig represents the handover roaming configuration.
+// The roaming partners are configured in the interop client configuration.
+type HandoverRoamingConfig struct {
+	Registry        HandoverRegistry `name:"-"`
+	KEKLabel        string           `name:"kek-label" description:"Label of KEK used to encrypt session keys exchanged with roaming partners"`
+	HandoverNetIDs  []string         `name:"handover-net-ids" description:"NetIDs of the serving Network Servers to hand over sessions to"`
+	AcceptNetIDs    []string         `name:"accept-net-ids" description:"NetIDs of the home Network Servers to accept sessions from"`
+	ApplicationID   string           `name:"application-id" description:"Application ID of the end devices of accepted sessions"`
+	FrequencyPlanID string           `name:"frequency-plan-id" description:"Frequency plan ID of the end devices of accepted sessions"`
+}
+
+// IsZero returns true if handover roaming is not configured.
+func (c HandoverRoamingConfig) IsZero() bool {
+	return len(c.HandoverNetIDs) == 0 && len(c.AcceptNetIDs) == 0
+}
+
+func parseNetIDs(ss []string) ([]types.NetID, error) {
+	netIDs := make([]types.NetID, 0, len(ss))
+	for _, s := range ss {
+		var netID types.NetID
+		if err := netID.UnmarshalText([]byte(s)); err != nil {
+			return nil, err
+		}
+		netIDs = append(netIDs, netID)
+	}
+	return netIDs, nil
+}
+
+// DevAddrAllocationConfig represents the configuration of the DevAddr allocation.
+type DevAddrAllocationConfig struct {
+	Allocator DevAddrAllocator `name:"-"`
+}
+
+// MulticastGatewaySelectionConfig represents the configuration of the automatic gateway selection
+// for multicast downlinks.
+type MulticastGatewaySelectionConfig struct {
+	Members      MulticastMemberRegistry `name:"-"`
+	Enable       bool                    `name:"enable" description:"Select gateways for multicast downlinks without gateways from the recent uplinks of the group members"`
+	Coverage     float64                 `name:"coverage" description:"Fraction of the group members to cover (0-1]"`
+	Redundancy   uint32                  `name:"redundancy" description:"Number of selected gateways to cover each group member with"`
+	MaxGateways  uint32                  `name:"max-gateways" description:"Maximum number of gateways to select (0 is unlimited)"`
+	MaxUplinkAge time.Duration           `name:"max-uplink-age" description:"Maximum age of recent uplinks of group members to consider (0 is unlimited)"`
+}
+
+// LinkStatsConfig represents the configuration of the link statistics of end devices.
+type LinkStatsConfig struct {
+	Registry   LinkStatsRegistry `name:"-"`
+	Enable     bool              `name:"enable" description:"Record link samples of data uplinks to compute link statistics of end devices"`
+	WindowSize uint32            `name:"window-size" description:"Number of most recent uplinks to compute link statistics from"`
+	TTL        time.Duration     `name:"ttl" description:"Time after which link samples of end devices without uplinks expire (0 is no expiry)"`
+}
+
+// ApplicationServerFailoverConfig represents the configuration of the secondary Application Servers, to which
+// application uplinks are sent when the Application Server of the cluster is unavailable.
+type ApplicationServerFailoverConfig struct {
+	Secondaries      map[string][]string `name:"secondaries" description:"Secondary Application Server addresses by application ID, in order of preference"`
+	Keys             map[string]string   `name:"keys" description:"Cluster keys (hex) of the secondary Application Servers by address"`
+	RootCAs          map[string]string   `name:"root-cas" description:"Locations of the TLS root CA certificates of the secondary Application Servers by address (optional)"`
+	Insecure         []string            `name:"insecure" description:"Addresses of the secondary Application Servers that are reached without TLS"`
+	FanOut           []string            `name:"fan-out" description:"Application IDs of which uplinks are sent to all healthy Application Servers"`
+	FailureThreshold uint32              `name:"failure-threshold" description:"Number of consecutive failures after which an Application Server is unhealthy"`
+	RetryInterval    time.Duration       `name:"retry-interval" description:"Time after which an unhealthy Application Server is tried again"`
+}
+
+// RelayAssignmentConfig represents the configuration of the automatic assignment of end devices to relays.
+type RelayAssignmentConfig struct {
+	Registry       RelayAssignmentRegistry `name:"-"`
+	Enable         bool                    `name:"enable" description:"Assign end devices with relayed or poor uplinks to relays, and release them when direct coverage improves"`
+	Applications   []string                `name:"applications" description:"Application IDs of which the end devices support relays (empty is all applications)"`
+	Approval       bool                    `name:"approval" description:"Suggest assignments and releases to be approved instead of applying them"`
+	AssignUplinks  uint32                  `name:"assign-uplinks" description:"Number of consecutive relayed or poor uplinks after which an end device is assigned to a relay"`
+	ReleaseUplinks uint32                  `name:"release-uplinks" description:"Number of consecutive direct uplinks with direct coverage after which an end device is released"`
+	PoorLinkMargin float32                 `name:"poor-link-margin" description:"SNR margin in dB above the demodulation floor below which a direct uplink is poor"`
+	ReleaseMargin  float32                 `name:"release-margin" description:"SNR margin in dB above the demodulation floor from which a direct uplink has direct coverage"`
+	TTL            time.Duration           `name:"ttl" description:"Time after which the relay assignment state of end devices and relays without uplinks expires"`
+	BufferSize     uint64                  `name:"buffer-size" description:"Number of uplinks that are buffered for relay assignment, after which uplinks are skipped"`
+	NumConsumers   uint64                  `name:"num-consumers" description:"Number of uplinks that are handled for relay assignment concurrently"`
+}
+
+// ClassBCapacityConfig represents the configuration of the class B ping slot capacity planning.
+type ClassBCapacityConfig struct {
+	Registry            PingSlotOccupancyRegistry `name:"-"`
+	Enable              bool                      `name:"enable" description:"Track the ping slot occupancy of gateways to avoid colliding class B downlinks and report class B capacity"`
+	PingSlotFrequencies []string                  `name:"ping-slot-frequencies" description:"Ping slot frequencies (Hz) to stagger the class B end devices of a gateway across (empty disables staggering)"`
+	DeviceTTL           time.Duration             `name:"device-ttl" description:"Time after which class B end devices without uplinks are no longer counted for their gateway"`
+	RegistryTimeout     time.Duration             `name:"registry-timeout" description:"Timeout of the ping slot occupancy lookups and reservations of class B downlinks, after which the occupancy is unknown"`
+}
+
+// FCntResyncConfig represents the configuration of the frame counter resynchronization of ABP and 1.0.x end devices.
+type FCntResyncConfig struct {
+	Registry     FCntResyncRegistry `name:"-"`
+	Enable       bool               `name:"enable" description:"Detect desynchronized frame counters of ABP and 1.0.x end devices and allow resynchronizing them"`
+	Threshold    uint32             `name:"threshold" description:"Number of consecutive uplinks with a valid MIC and a rejected frame counter after which the frame counter is desynchronized"`
+	Window       time.Duration      `name:"window" description:"Time window in which the rejected uplinks must be received"`
+	TTL          time.Duration      `name:"ttl" description:"Time after which the frame counter resynchronization state of end devices without rejected uplinks or resynchronizations expires"`
+	BufferSize   uint64             `name:"buffer-size" description:"Number of rejected uplinks that are buffered for frame counter resynchronization, after which uplinks are skipped"`
+	NumConsumers uint64             `name:"num-consumers" description:"Number of rejected uplinks that are handled for frame counter resynchronization concurrently"`
+}
+
+// RejoinConfig represents the configuration of the rejoin-request handling and ForceRejoinReq of LoRaWAN 1.1 end devices.
+type RejoinConfig struct {
+	Registry           RejoinRegistry `name:"-"`
+	Enable             bool           `name:"enable" description:"Handle rejoin-requests of LoRaWAN 1.1 end devices and allow forcing end devices to rejoin"`
+	ForceRejoinTimeout time.Duration  `name:"force-rejoin-timeout" description:"Time after which a ForceRejoinReq that did not result in a rejoin expires"`
+}
+
+// PacketSourcesConfig represents the configuration of the ingestion of uplinks from trusted external packet sources.
+type PacketSourcesConfig struct {
+	Keys         map[string]string `name:"keys" description:"Keys of the trusted external packet sources by source ID"`
+	CallbackURLs map[string]string `name:"callback-urls" description:"URLs to post the downlinks through the gateways of the external packet sources to by source ID"`
+	CallbackKeys map[string]string `name:"callback-keys" description:"Bearer tokens of the downlink callbacks of the external packet sources by source ID"`
+}
+
 // PaginationConfig represents the configuration for pagination.
 type PaginationConfig struct {
 	DefaultLimit int64 `name:"default-limit" description:"Default limit for pagination"`
@@ -138,23 +319,62 @@ type PaginationConfig struct {
 
 // Config represents the NetworkServer configuration.
 type Config struct {
-	ApplicationUplinkQueue     ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
-	Devices                    DeviceRegistry               `name:"-"`
-	DownlinkTaskQueue          DownlinkTaskQueueConfig      `name:"downlink-task-queue"`
-	UplinkDeduplicator         UplinkDeduplicator           `name:"-"`
-	ScheduledDownlinkMatcher   ScheduledDownlinkMatcher     `name:"-"`
-	NetID                      types.NetID                  `name:"net-id" description:"NetID of this Network Server"`                                                                                   // nolint: lll
-	ClusterID                  string                       `name:"cluster-id" description:"Cluster ID of this Network Server"`                                                                          // nolint: lll
-	DevAddrPrefixes            []types.DevAddrPrefix        `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`                                                      // nolint: lll
-	DeduplicationWindow        time.Duration                `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`                          // nolint: lll
-	CooldownWindow             time.Duration                `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"` // nolint: lll
-	DownlinkPriorities         DownlinkPriorityConfig       `name:"downlink-priorities" description:"Downlink message priorities"`                                                                       // nolint: lll
-	DefaultMACSettings         MACSettingConfig             `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`           // nolint: lll
-	Interop                    InteropConfig                `name:"interop" description:"Interop client configuration"`                                                                                  // nolint: lll
-	DeviceKEKLabel             string                       `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`                                                     // nolint: lll
-	DownlinkQueueCapacity      int                          `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`                                                       // nolint: lll
-	MACSettingsProfileRegistry MACSettingsProfileRegistry   `name:"-"`
-	Pagination                 PaginationConfig             `name:"pagination" description:"Pagination configuration"`
+	ApplicationUplinkQueue     ApplicationUplinkQueueConfig    `name:"application-uplink-queue"`
+	Devices                    DeviceRegistry                  `name:"-"`
+	DownlinkTaskQueue          DownlinkTaskQueueConfig         `name:"downlink-task-queue"`
+	UplinkDeduplicator         UplinkDeduplicator              `name:"-"`
+	ScheduledDownlinkMatcher   ScheduledDownlinkMatcher        `name:"-"`
+	QueueBackend               string                          `name:"queue-backend" description:"Backend of the uplink deduplicator and task queues (redis, memory). Registries always use Redis"`         // nolint: lll
+	NetID                      types.NetID                     `name:"net-id" description:"NetID of this Network Server"`                                                                                   // nolint: lll
+	ClusterID                  string                          `name:"cluster-id" description:"Cluster ID of this Network Server"`                                                                          // nolint: lll
+	DevAddrPrefixes            []types.DevAddrPrefix           `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`                                                      // nolint: lll
+	DevAddrAllocation          DevAddrAllocationConfig         `name:"dev-addr-allocation" description:"DevAddr allocation configuration"`                                                                  // nolint: lll
+	DeduplicationWindow        time.Duration                   `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`                          // nolint: lll
+	CooldownWindow             time.Duration                   `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"` // nolint: lll
+	DownlinkPriorities         DownlinkPriorityConfig          `name:"downlink-priorities" description:"Downlink message priorities"`                                                                       // nolint: lll
+	DefaultMACSettings         MACSettingConfig                `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`           // nolint: lll
+	DownlinkPathRanking        DownlinkPathRankingConfig       `name:"downlink-path-ranking" description:"Downlink path ranking configuration"`                                                             // nolint: lll
+	Budgets                    budget.Config                   `name:"budgets" description:"Daily downlink airtime and downlink count budgets"`                                                             // nolint: lll
+	Interop                    InteropConfig                   `name:"interop" description:"Interop client configuration"`                                                                                  // nolint: lll
+	PassiveRoaming             PassiveRoamingConfig            `name:"passive-roaming" description:"Passive roaming configuration"`                                                                         // nolint: lll
+	HandoverRoaming            HandoverRoamingConfig           `name:"handover-roaming" description:"Handover roaming configuration"`                                                                       // nolint: lll
+	MulticastGatewaySelection  MulticastGatewaySelectionConfig `name:"multicast-gateway-selection" description:"Automatic gateway selection for multicast downlinks"`                                       // nolint: lll
+	LinkStats                  LinkStatsConfig                 `name:"link-stats" description:"Link statistics configuration"`                                                                              // nolint: lll
+	ApplicationServerFailover  ApplicationServerFailoverConfig `name:"application-server-failover" description:"Application Server failover configuration"`                                                 // nolint: lll
+	RelayAssignment            RelayAssignmentConfig           `name:"relay-assignment" description:"Automatic relay assignment configuration"`                                                             // nolint: lll
+	ClassBCapacity             ClassBCapacityConfig            `name:"class-b-capacity" description:"Class B ping slot capacity planning configuration"`                                                    // nolint: lll
+	FCntResync                 FCntResyncConfig                `name:"f-cnt-resync" description:"Frame counter resynchronization configuration"`                                                            // nolint: lll
+	Rejoin                     RejoinConfig                    `name:"rejoin" description:"Rejoin configuration"`                                                                                           // nolint: lll
+	PacketSources              PacketSourcesConfig             `name:"packet-sources" description:"Trusted external packet sources configuration"`                                                          // nolint: lll
+	DeviceKEKLabel             string                          `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`                                                     // nolint: lll
+	DownlinkQueueCapacity      int                             `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`                                                       // nolint: lll
+	MACSettingsProfileRegistry MACSettingsProfileRegistry      `name:"-"`
+	Pagination                 PaginationConfig                `name:"pagination" description:"Pagination configuration"`
+}
+
+// EffectiveDevAddrPrefixes returns the configured DevAddr prefixes,
+// or the DevAddr prefix of the NetID if no DevAddr prefixes are configured.
+func (c Config) EffectiveDevAddrPrefixes() ([]types.DevAddrPrefix, error) {
+	if len(c.DevAddrPrefixes) > 0 {
+		return c.DevAddrPrefixes, nil
+	}
+	prefix, err := netIDDevAddrPrefix(c.NetID)
+	if err != nil {
+		return nil, err
+	}
+	return []types.DevAddrPrefix{prefix}, nil
+}
+
+// netIDDevAddrPrefix returns the DevAddr prefix of the NetID.
+func netIDDevAddrPrefix(netID types.NetID) (types.DevAddrPrefix, error) {
+	devAddr, err := types.NewDevAddr(netID, nil)
+	if err != nil {
+		return types.DevAddrPrefix{}, err
+	}
+	return types.DevAddrPrefix{
+		DevAddr: devAddr,
+		Length:  uint8(32 - types.NwkAddrBits(netID)),
+	}, nil
 }
 
 // DefaultConfig is the default Network Server configuration.
@@ -169,6 +389,44 @@ var DefaultConfig = Config{
 	DownlinkTaskQueue: DownlinkTaskQueueConfig{
 		NumConsumers: 1,
 	},
+	QueueBackend: RedisQueueBackend,
+	MulticastGatewaySelection: MulticastGatewaySelectionConfig{
+		Coverage:     1,
+		Redundancy:   1,
+		MaxUplinkAge: 24 * time.Hour,
+	},
+	LinkStats: LinkStatsConfig{
+		Enable:     true,
+		WindowSize: 100,
+		TTL:        30 * 24 * time.Hour,
+	},
+	ApplicationServerFailover: ApplicationServerFailoverConfig{
+		FailureThreshold: 3,
+		RetryInterval:    time.Minute,
+	},
+	RelayAssignment: RelayAssignmentConfig{
+		AssignUplinks:  5,
+		ReleaseUplinks: 20,
+		PoorLinkMargin: 2,
+		ReleaseMargin:  10,
+		TTL:            7 * 24 * time.Hour,
+		BufferSize:     4096,
+		NumConsumers:   16,
+	},
+	ClassBCapacity: ClassBCapacityConfig{
+		DeviceTTL:       24 * time.Hour,
+		RegistryTimeout: 200 * time.Millisecond,
+	},
+	FCntResync: FCntResyncConfig{
+		Threshold:    3,
+		Window:       time.Hour,
+		TTL:          30 * 24 * time.Hour,
+		BufferSize:   4096,
+		NumConsumers: 16,
+	},
+	Rejoin: RejoinConfig{
+		ForceRejoinTimeout: 24 * time.Hour,
+	},
 	DeduplicationWindow: 200 * time.Millisecond,
 	CooldownWindow:      time.Second,
 	DownlinkPriorities: DownlinkPriorityConfig{
@@ -184,5 +442,9 @@ var DefaultConfig = Config{
 		StatusTimePeriodicity:  func(v time.Duration) *time.Duration { return &v }(mac.DefaultStatusTimePeriodicity),
 		StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(mac.DefaultStatusCountPeriodicity),
 	},
+	DownlinkPathRanking: DownlinkPathRankingConfig{
+		RoundRobinMargin: 6,
+		UtilizationTTL:   time.Minute,
+	},
 	DownlinkQueueCapacity: 10000,
 }
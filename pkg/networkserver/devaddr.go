@@ -0,0 +1,193 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var errDevAddrAllocationDisabled = errors.DefineFailedPrecondition(
+	"dev_addr_allocation_disabled", "DevAddr allocation is disabled",
+)
+
+// DevAddrAllocator tracks the occupancy of DevAddrs by the sessions of end devices.
+type DevAddrAllocator interface {
+	// LeastUsed returns the least used DevAddr within the prefix. Unused DevAddrs are searched for
+	// starting at from, wrapping around at the end of the prefix.
+	LeastUsed(ctx context.Context, prefix types.DevAddrPrefix, from types.DevAddr) (types.DevAddr, error)
+	// Occupancy returns the number of sessions using each of the DevAddrs.
+	Occupancy(ctx context.Context, devAddrs ...types.DevAddr) ([]uint64, error)
+	// Reconcile sets the DevAddrs used by the sessions of the end device identified by uid to devAddrs.
+	// DevAddrs previously used by the end device which are not in devAddrs are reclaimed.
+	Reconcile(ctx context.Context, uid string, devAddrs ...types.DevAddr) error
+	// Utilization returns the utilization of the DevAddr prefixes.
+	Utilization(ctx context.Context, prefixes ...types.DevAddrPrefix) ([]*ttnpb.DevAddrPrefixUtilization, error)
+}
+
+// makeAllocatingNewDevAddrFunc returns a newDevAddrFunc, which returns the least used DevAddr within
+// the prefix of a random DevAddr derived using newDevAddr.
+func makeAllocatingNewDevAddrFunc(
+	newDevAddr newDevAddrFunc, allocator DevAddrAllocator, prefixes ...types.DevAddrPrefix,
+) newDevAddrFunc {
+	return func(ctx context.Context) types.DevAddr {
+		from := newDevAddr(ctx)
+		for _, prefix := range prefixes {
+			if !from.HasPrefix(prefix) {
+				continue
+			}
+			devAddr, err := allocator.LeastUsed(ctx, prefix, from)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to allocate DevAddr")
+				return from
+			}
+			return devAddr
+		}
+		return from
+	}
+}
+
+// sessionDevAddrs returns the DevAddrs of the current and pending sessions of dev.
+func sessionDevAddrs(dev *ttnpb.EndDevice) (current, pending *types.DevAddr) {
+	if b := dev.GetSession().GetDevAddr(); b != nil {
+		current = types.MustDevAddr(b)
+	}
+	if b := dev.GetPendingSession().GetDevAddr(); b != nil {
+		pending = types.MustDevAddr(b)
+	}
+	return current, pending
+}
+
+func appendDevAddrs(devAddrs []types.DevAddr, addrs ...*types.DevAddr) []types.DevAddr {
+	for _, addr := range addrs {
+		if addr != nil {
+			devAddrs = append(devAddrs, *addr)
+		}
+	}
+	return devAddrs
+}
+
+func equalDevAddrs(a, b *types.DevAddr) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// devAddrAllocatorRegistryWrapper is a DeviceRegistry, which reconciles the DevAddrs
+// used by end devices with the DevAddrAllocator whenever their sessions change.
+type devAddrAllocatorRegistryWrapper struct {
+	DeviceRegistry
+	allocator DevAddrAllocator
+}
+
+func (w devAddrAllocatorRegistryWrapper) SetByID(
+	ctx context.Context,
+	appID *ttnpb.ApplicationIdentifiers,
+	devID string,
+	paths []string,
+	f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.EndDevice, context.Context, error) {
+	var (
+		changed          bool
+		current, pending *types.DevAddr
+	)
+	dev, ctx, err := w.DeviceRegistry.SetByID(ctx, appID, devID,
+		ttnpb.AddFields(paths, "pending_session.dev_addr", "session.dev_addr"),
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			storedCurrent, storedPending := sessionDevAddrs(stored)
+			dev, sets, err := f(ctx, stored)
+			if err != nil {
+				return dev, sets, err
+			}
+			current, pending = storedCurrent, storedPending
+			switch {
+			case dev == nil:
+				current, pending = nil, nil
+			default:
+				updatedCurrent, updatedPending := sessionDevAddrs(dev)
+				if ttnpb.HasAnyField(sets, "session.dev_addr") {
+					current = updatedCurrent
+				}
+				if ttnpb.HasAnyField(sets, "pending_session.dev_addr") {
+					pending = updatedPending
+				}
+			}
+			changed = !equalDevAddrs(current, storedCurrent) || !equalDevAddrs(pending, storedPending)
+			return dev, sets, nil
+		},
+	)
+	if err != nil || !changed {
+		return dev, ctx, err
+	}
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appID,
+		DeviceId:       devID,
+	}
+	if err := w.allocator.Reconcile(ctx, unique.ID(ctx, ids), appendDevAddrs(nil, current, pending)...); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to reconcile DevAddr allocation")
+	}
+	return dev, ctx, nil
+}
+
+func (w devAddrAllocatorRegistryWrapper) BatchDelete(
+	ctx context.Context,
+	appIDs *ttnpb.ApplicationIdentifiers,
+	deviceIDs []string,
+) ([]*ttnpb.EndDeviceIdentifiers, error) {
+	deleted, err := w.DeviceRegistry.BatchDelete(ctx, appIDs, deviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, ids := range deleted {
+		if err := w.allocator.Reconcile(ctx, unique.ID(ctx, ids)); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to reclaim DevAddr allocation")
+		}
+	}
+	return deleted, nil
+}
+
+func wrapEndDeviceRegistryWithDevAddrAllocator(r DeviceRegistry, allocator DevAddrAllocator) DeviceRegistry {
+	return devAddrAllocatorRegistryWrapper{
+		DeviceRegistry: r,
+		allocator:      allocator,
+	}
+}
+
+// GetDevAddrPrefixUtilization implements ttnpb.NsServer.
+func (ns *NetworkServer) GetDevAddrPrefixUtilization(
+	ctx context.Context, _ *emptypb.Empty,
+) (*ttnpb.GetDevAddrPrefixUtilizationResponse, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if ns.devAddrAllocator == nil {
+		return nil, errDevAddrAllocationDisabled.New()
+	}
+	prefixes, err := ns.devAddrAllocator.Utilization(ctx, ns.devAddrPrefixes(ctx)...)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GetDevAddrPrefixUtilizationResponse{
+		Prefixes: prefixes,
+	}, nil
+}
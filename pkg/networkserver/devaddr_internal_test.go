@@ -0,0 +1,263 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockDevAddrAllocator struct {
+	devices map[string][]types.DevAddr
+}
+
+func (m *mockDevAddrAllocator) LeastUsed(
+	ctx context.Context, prefix types.DevAddrPrefix, from types.DevAddr,
+) (types.DevAddr, error) {
+	first := prefix.DevAddr.Mask(prefix.Length).MarshalNumber()
+	size := uint32(1) << (32 - prefix.Length)
+	offset := from.WithPrefix(prefix).MarshalNumber() - first
+	var least types.DevAddr
+	var leastOccupancy uint64
+	for i := uint32(0); i < size; i++ {
+		var devAddr types.DevAddr
+		devAddr.UnmarshalNumber(first + (offset+i)%size)
+		occupancy, _ := m.Occupancy(ctx, devAddr)
+		if i == 0 || occupancy[0] < leastOccupancy {
+			least, leastOccupancy = devAddr, occupancy[0]
+		}
+	}
+	return least, nil
+}
+
+func (m *mockDevAddrAllocator) Occupancy(_ context.Context, devAddrs ...types.DevAddr) ([]uint64, error) {
+	occupancy := make([]uint64, len(devAddrs))
+	for i, devAddr := range devAddrs {
+		for _, used := range m.devices {
+			for _, usedDevAddr := range used {
+				if usedDevAddr.Equal(devAddr) {
+					occupancy[i]++
+				}
+			}
+		}
+	}
+	return occupancy, nil
+}
+
+func (m *mockDevAddrAllocator) Reconcile(_ context.Context, uid string, devAddrs ...types.DevAddr) error {
+	if len(devAddrs) == 0 {
+		delete(m.devices, uid)
+		return nil
+	}
+	m.devices[uid] = devAddrs
+	return nil
+}
+
+func (*mockDevAddrAllocator) Utilization(
+	context.Context, ...types.DevAddrPrefix,
+) ([]*ttnpb.DevAddrPrefixUtilization, error) {
+	return nil, nil
+}
+
+type mockSetByIDRegistry struct {
+	DeviceRegistry
+	stored *ttnpb.EndDevice
+}
+
+func (m *mockSetByIDRegistry) SetByID(
+	ctx context.Context,
+	_ *ttnpb.ApplicationIdentifiers,
+	_ string,
+	_ []string,
+	f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.EndDevice, context.Context, error) {
+	var stored *ttnpb.EndDevice
+	if m.stored != nil {
+		stored = ttnpb.Clone(m.stored)
+	}
+	dev, sets, err := f(ctx, stored)
+	if err != nil {
+		return nil, ctx, err
+	}
+	if dev == nil {
+		m.stored = nil
+		return nil, ctx, nil
+	}
+	if m.stored == nil {
+		m.stored = &ttnpb.EndDevice{}
+	}
+	if err := m.stored.SetFields(dev, sets...); err != nil {
+		return nil, ctx, err
+	}
+	return dev, ctx, nil
+}
+
+func (m *mockSetByIDRegistry) BatchDelete(
+	_ context.Context,
+	_ *ttnpb.ApplicationIdentifiers,
+	_ []string,
+) ([]*ttnpb.EndDeviceIdentifiers, error) {
+	if m.stored == nil {
+		return nil, nil
+	}
+	ids := m.stored.Ids
+	m.stored = nil
+	return []*ttnpb.EndDeviceIdentifiers{ids}, nil
+}
+
+func TestDevAddrAllocatorRegistryWrapper(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	uid := unique.ID(ctx, ids)
+	addr1 := types.DevAddr{0x26, 0x01, 0x02, 0x03}
+	addr2 := types.DevAddr{0x26, 0x04, 0x05, 0x06}
+
+	allocator := &mockDevAddrAllocator{devices: map[string][]types.DevAddr{}}
+	registry := wrapEndDeviceRegistryWithDevAddrAllocator(&mockSetByIDRegistry{}, allocator)
+
+	set := func(paths []string, f func(*ttnpb.EndDevice) *ttnpb.EndDevice) {
+		_, _, err := registry.SetByID(ctx, ids.ApplicationIds, ids.DeviceId, paths,
+			func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				return f(stored), paths, nil
+			},
+		)
+		a.So(err, should.BeNil)
+	}
+
+	// Create with a pending session.
+	set([]string{"ids", "pending_session"}, func(*ttnpb.EndDevice) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			Ids:            ids,
+			PendingSession: &ttnpb.Session{DevAddr: addr1.Bytes()},
+		}
+	})
+	a.So(allocator.devices[uid], should.Resemble, []types.DevAddr{addr1})
+
+	// Unrelated updates do not change the allocation.
+	set([]string{"frequency_plan_id"}, func(stored *ttnpb.EndDevice) *ttnpb.EndDevice {
+		stored.FrequencyPlanId = "EU_863_870"
+		stored.PendingSession = nil
+		return stored
+	})
+	a.So(allocator.devices[uid], should.Resemble, []types.DevAddr{addr1})
+
+	// Rejoin while the first session is active.
+	set([]string{"pending_session", "session"}, func(stored *ttnpb.EndDevice) *ttnpb.EndDevice {
+		stored.Session = stored.PendingSession
+		stored.PendingSession = &ttnpb.Session{DevAddr: addr2.Bytes()}
+		return stored
+	})
+	a.So(allocator.devices[uid], should.Resemble, []types.DevAddr{addr1, addr2})
+
+	// The DevAddr of the previous session is reclaimed when the pending session is activated.
+	set([]string{"pending_session", "session"}, func(stored *ttnpb.EndDevice) *ttnpb.EndDevice {
+		stored.Session = stored.PendingSession
+		stored.PendingSession = nil
+		return stored
+	})
+	a.So(allocator.devices[uid], should.Resemble, []types.DevAddr{addr2})
+
+	occupancy, err := allocator.Occupancy(ctx, addr1, addr2)
+	a.So(err, should.BeNil)
+	a.So(occupancy, should.Resemble, []uint64{0, 1})
+
+	// The DevAddrs are reclaimed when the sessions are reset.
+	set([]string{"pending_session", "session"}, func(stored *ttnpb.EndDevice) *ttnpb.EndDevice {
+		stored.Session = nil
+		stored.PendingSession = nil
+		return stored
+	})
+	_, ok := allocator.devices[uid]
+	a.So(ok, should.BeFalse)
+
+	// The DevAddr of a pending session is reclaimed when the end device keeps using its current session.
+	set([]string{"pending_session", "session"}, func(stored *ttnpb.EndDevice) *ttnpb.EndDevice {
+		stored.Session = &ttnpb.Session{DevAddr: addr1.Bytes()}
+		stored.PendingSession = &ttnpb.Session{DevAddr: addr2.Bytes()}
+		return stored
+	})
+	a.So(allocator.devices[uid], should.Resemble, []types.DevAddr{addr1, addr2})
+	set([]string{"pending_session"}, func(stored *ttnpb.EndDevice) *ttnpb.EndDevice {
+		stored.PendingSession = nil
+		return stored
+	})
+	a.So(allocator.devices[uid], should.Resemble, []types.DevAddr{addr1})
+
+	// The DevAddrs are reclaimed when the device is deleted.
+	set(nil, func(*ttnpb.EndDevice) *ttnpb.EndDevice { return nil })
+	_, ok = allocator.devices[uid]
+	a.So(ok, should.BeFalse)
+
+	// The DevAddrs are reclaimed when the device is deleted in a batch.
+	set([]string{"ids", "session"}, func(*ttnpb.EndDevice) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			Ids:     ids,
+			Session: &ttnpb.Session{DevAddr: addr2.Bytes()},
+		}
+	})
+	a.So(allocator.devices[uid], should.Resemble, []types.DevAddr{addr2})
+	deleted, err := registry.BatchDelete(ctx, ids.ApplicationIds, []string{ids.DeviceId})
+	a.So(err, should.BeNil)
+	a.So(deleted, should.HaveLength, 1)
+	_, ok = allocator.devices[uid]
+	a.So(ok, should.BeFalse)
+}
+
+func TestAllocatingNewDevAddrFunc(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	prefix := types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x01, 0x02, 0x00}, Length: 30}
+	addrs := [4]types.DevAddr{
+		{0x26, 0x01, 0x02, 0x00},
+		{0x26, 0x01, 0x02, 0x01},
+		{0x26, 0x01, 0x02, 0x02},
+		{0x26, 0x01, 0x02, 0x03},
+	}
+	allocator := &mockDevAddrAllocator{devices: map[string][]types.DevAddr{
+		"app.dev1": {addrs[1]},
+		"app.dev2": {addrs[2]},
+	}}
+	newDevAddr := makeAllocatingNewDevAddrFunc(func(context.Context) types.DevAddr {
+		return addrs[1]
+	}, allocator, prefix)
+
+	// The first unused DevAddr following the random DevAddr is allocated.
+	a.So(newDevAddr(ctx), should.Equal, addrs[3])
+
+	// The least used DevAddr is allocated when all DevAddrs of the prefix are used.
+	allocator.devices["app.dev3"] = []types.DevAddr{addrs[0], addrs[3]}
+	allocator.devices["app.dev4"] = []types.DevAddr{addrs[1], addrs[3]}
+	allocator.devices["app.dev5"] = []types.DevAddr{addrs[0]}
+	a.So(newDevAddr(ctx), should.Equal, addrs[2])
+
+	// DevAddrs outside of the prefixes are not allocated by the allocator.
+	outside := types.DevAddr{0x27, 0x00, 0x00, 0x01}
+	newDevAddr = makeAllocatingNewDevAddrFunc(func(context.Context) types.DevAddr {
+		return outside
+	}, allocator, prefix)
+	a.So(newDevAddr(ctx), should.Equal, outside)
+}
@@ -30,6 +30,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/budget"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
@@ -104,6 +105,7 @@ type generateDownlinkState struct {
 	EventBuilders                 events.Builders
 	NeedsDownlinkQueueUpdate      bool
 	EvictDownlinkQueueIfScheduled bool
+	ForceRejoinReqEnqueued        bool
 }
 
 func (s generateDownlinkState) appendApplicationUplinks(ups []*ttnpb.ApplicationUp, scheduled bool) []*ttnpb.ApplicationUp {
@@ -135,7 +137,7 @@ func (ns *NetworkServer) nextDataDownlinkTaskAt(ctx context.Context, dev *ttnpb.
 		log.FromContext(ctx).WithError(err).Warn("Failed to determine device band")
 		return time.Time{}, nil
 	}
-	slot, ok := nextDataDownlinkSlot(ctx, dev, phy, ns.defaultMACSettings, earliestAt)
+	slot, ok := nextDataDownlinkSlot(ctx, dev, phy, ns.defaultMACSettings, earliestAt, ns.dueForceRejoinReq(ctx, dev))
 	if !ok {
 		return time.Time{}, nil
 	}
@@ -152,6 +154,12 @@ func (ns *NetworkServer) nextDataDownlinkTaskAt(ctx context.Context, dev *ttnpb.
 	if taskAt.Before(earliestAt) {
 		taskAt = earliestAt
 	}
+	if _, ok := slot.(*networkInitiatedDownlinkSlot); ok {
+		// NOTE: Network initiated downlinks are not generated while the downlink budgets are exhausted.
+		if resetAt := ns.downlinkBudgetResetAt(ctx, dev.Ids, taskAt); resetAt.After(taskAt) {
+			taskAt = resetAt
+		}
+	}
 	return taskAt, nil
 }
 
@@ -164,6 +172,82 @@ func (ns *NetworkServer) updateDataDownlinkTask(ctx context.Context, dev *ttnpb.
 	return ns.downlinkTasks.Add(ctx, dev.Ids, taskAt, true)
 }
 
+// enqueueMACCommands enqueues the MAC command requests the Network Server needs to send to dev,
+// given maxDownLen and maxUpLen bytes of downlink and uplink MAC command space.
+// The requests which are not answered by the end device, such as forceRejoinReq, are returned instead of enqueued.
+func (ns *NetworkServer) enqueueMACCommands(
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	phy *band.Band,
+	maxDownLen, maxUpLen uint16,
+	transmitAt time.Time,
+	forceRejoinReq *ttnpb.MACCommand_ForceRejoinReq,
+) (mac.EnqueueState, []*ttnpb.MACCommand) {
+	logger := log.FromContext(ctx)
+	var unansweredCmds []*ttnpb.MACCommand
+	enqueuers := []func(context.Context, *ttnpb.EndDevice, uint16, uint16) mac.EnqueueState{
+		mac.EnqueueDutyCycleReq,
+		func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
+			return mac.EnqueueTxParamSetupReq(ctx, dev, maxDownLen, maxUpLen, phy)
+		},
+		mac.EnqueueRxParamSetupReq,
+		func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
+			return mac.EnqueueNewChannelReq(ctx, dev, maxDownLen, maxUpLen, phy)
+		},
+		func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
+			// NOTE: LinkADRReq must be enqueued after NewChannelReq.
+			st, err := mac.EnqueueLinkADRReq(ctx, dev, maxDownLen, maxUpLen, phy)
+			if err != nil {
+				logger.WithError(err).Error("Failed to enqueue LinkADRReq")
+				return mac.EnqueueState{
+					MaxDownLen: maxDownLen,
+					MaxUpLen:   maxUpLen,
+				}
+			}
+			return st
+		},
+		mac.EnqueueRxTimingSetupReq,
+		mac.EnqueueBeaconFreqReq,
+		mac.EnqueuePingSlotChannelReq,
+		func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
+			return mac.EnqueueDLChannelReq(ctx, dev, maxDownLen, maxUpLen, phy)
+		},
+		func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
+			return mac.EnqueueADRParamSetupReq(ctx, dev, maxDownLen, maxUpLen, phy)
+		},
+		func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
+			cmds, st := mac.EnqueueForceRejoinReq(ctx, dev, maxDownLen, maxUpLen, forceRejoinReq)
+			unansweredCmds = append(unansweredCmds, cmds...)
+			return st
+		},
+		mac.EnqueueRejoinParamSetupReq,
+		func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
+			return mac.EnqueueDevStatusReq(ctx, dev, maxDownLen, maxUpLen, ns.defaultMACSettings, transmitAt)
+		},
+		mac.EnqueueRelayConfReq,
+		mac.EnqueueRelayEndDeviceConfReq,
+		func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
+			return mac.EnqueueRelayUpdateUplinkListReq(ctx, dev, maxDownLen, maxUpLen, ns.relayKeyService())
+		},
+		mac.EnqueueRelayCtrlUplinkListReq,
+		mac.EnqueueRelayConfigureFwdLimitReq,
+	}
+
+	res := mac.EnqueueState{
+		MaxDownLen: maxDownLen,
+		MaxUpLen:   maxUpLen,
+		Ok:         true,
+	}
+	for _, f := range enqueuers {
+		st := f(ctx, dev, res.MaxDownLen, res.MaxUpLen)
+		res.MaxDownLen = st.MaxDownLen
+		res.MaxUpLen = st.MaxUpLen
+		res.Ok = res.Ok && st.Ok
+		res.QueuedEvents = append(res.QueuedEvents, st.QueuedEvents...)
+	}
+	return res, unansweredCmds
+}
+
 // generateDataDownlink attempts to generate a downlink.
 // generateDataDownlink returns the generated downlink, application uplinks associated with the generation and error, if any.
 // generateDataDownlink may mutate the device in order to record the downlink generated.
@@ -176,7 +260,8 @@ func (ns *NetworkServer) updateDataDownlinkTask(ctx context.Context, dev *ttnpb.
 // device operating in a region where a fixed channel plan is defined in case
 // dev.MACState.CurrentParameters.Channels is not equal to dev.MACState.DesiredParameters.Channels.
 // Note, that generateDataDownlink assumes transmitAt is the earliest possible time a downlink can be transmitted to the device.
-func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, class ttnpb.Class, transmitAt time.Time, maxDownLen, maxUpLen uint16) (*generatedDownlink, generateDownlinkState, error) {
+// forceRejoinReq is the ForceRejoinReq the Network Server needs to send to the device, if any.
+func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, class ttnpb.Class, transmitAt time.Time, maxDownLen, maxUpLen uint16, forceRejoinReq *ttnpb.MACCommand_ForceRejoinReq) (*generatedDownlink, generateDownlinkState, error) {
 	if dev.MacState == nil {
 		return nil, generateDownlinkState{}, errUnknownMACState.New()
 	}
@@ -199,6 +284,8 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		return nil, generateDownlinkState{}, errInvalidDataRate.New()
 	}
 	maxDownLen, maxUpLen = maxDownLen-8, maxUpLen-8
+	budgetErr := ns.checkDownlinkBudget(ctx, dev.Ids, transmitAt)
+	applicationDownlinksDeferred := false
 	// Subsequent operations on maxDownLen will decrement its size.
 	// As such, we save the space left for the FOpts and the FRMPayload
 	// in order to pre-allocate the space for the two.
@@ -252,60 +339,16 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			}
 		}
 
-		enqueuers := []func(context.Context, *ttnpb.EndDevice, uint16, uint16) mac.EnqueueState{
-			mac.EnqueueDutyCycleReq,
-			func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
-				return mac.EnqueueTxParamSetupReq(ctx, dev, maxDownLen, maxUpLen, phy)
-			},
-			mac.EnqueueRxParamSetupReq,
-			func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
-				return mac.EnqueueNewChannelReq(ctx, dev, maxDownLen, maxUpLen, phy)
-			},
-			func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
-				// NOTE: LinkADRReq must be enqueued after NewChannelReq.
-				st, err := mac.EnqueueLinkADRReq(ctx, dev, maxDownLen, maxUpLen, phy)
-				if err != nil {
-					logger.WithError(err).Error("Failed to enqueue LinkADRReq")
-					return mac.EnqueueState{
-						MaxDownLen: maxDownLen,
-						MaxUpLen:   maxUpLen,
-					}
-				}
-				return st
-			},
-			mac.EnqueueRxTimingSetupReq,
-			mac.EnqueueBeaconFreqReq,
-			mac.EnqueuePingSlotChannelReq,
-			func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
-				return mac.EnqueueDLChannelReq(ctx, dev, maxDownLen, maxUpLen, phy)
-			},
-			func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
-				return mac.EnqueueADRParamSetupReq(ctx, dev, maxDownLen, maxUpLen, phy)
-			},
-			mac.EnqueueForceRejoinReq,
-			mac.EnqueueRejoinParamSetupReq,
-			func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
-				return mac.EnqueueDevStatusReq(ctx, dev, maxDownLen, maxUpLen, ns.defaultMACSettings, transmitAt)
-			},
-			mac.EnqueueRelayConfReq,
-			mac.EnqueueRelayEndDeviceConfReq,
-			func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
-				return mac.EnqueueRelayUpdateUplinkListReq(ctx, dev, maxDownLen, maxUpLen, ns.relayKeyService())
-			},
-			mac.EnqueueRelayCtrlUplinkListReq,
-			mac.EnqueueRelayConfigureFwdLimitReq,
-		}
-
-		for _, f := range enqueuers {
-			st := f(ctx, dev, maxDownLen, maxUpLen)
-			maxDownLen = st.MaxDownLen
-			maxUpLen = st.MaxUpLen
-			fPending = fPending || !st.Ok
-			genState.EventBuilders = append(genState.EventBuilders, st.QueuedEvents...)
-		}
+		st, unansweredCmds := ns.enqueueMACCommands(ctx, dev, phy, maxDownLen, maxUpLen, transmitAt, forceRejoinReq)
+		maxDownLen = st.MaxDownLen
+		maxUpLen = st.MaxUpLen
+		fPending = fPending || !st.Ok
+		genState.EventBuilders = append(genState.EventBuilders, st.QueuedEvents...)
+		genState.ForceRejoinReqEnqueued = containsForceRejoinReq(unansweredCmds...)
 
 		b := make([]byte, 0, maxDownPayloadLen-maxDownLen)
 		cmds = append(cmds, dev.MacState.PendingRequests...)
+		cmds = append(cmds, unansweredCmds...)
 		for _, cmd := range cmds {
 			logger := logger.WithField("cid", cmd.Cid)
 			logger.Debug("Add MAC command to buffer")
@@ -373,6 +416,9 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 
 	cmdsInFOpts := len(cmdBuf) <= fOptsCapacity
 	if cmdsInFOpts && len(relayPayload) == 0 {
+		if up := expireApplicationDownlinks(ctx, dev.Ids, dev.Session, transmitAt); up != nil {
+			genState.baseApplicationUps = append(genState.baseApplicationUps, up)
+		}
 		appDowns := dev.Session.QueuedApplicationDownlinks[:0:0]
 	outer:
 		for i, down := range dev.Session.QueuedApplicationDownlinks {
@@ -465,6 +511,13 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 					// TODO: Check if following downlinks must be dropped (https://github.com/TheThingsNetwork/lorawan-stack/issues/1653).
 				}
 
+			case budgetErr != nil:
+				logger.WithError(budgetErr).Debug("Defer application downlink due to exhausted downlink budget")
+				events.Publish(evtDownlinkBudgetExhausted.NewWithIdentifiersAndData(ctx, dev.Ids, budgetErr))
+				applicationDownlinksDeferred = true
+				appDowns = append(appDowns, dev.Session.QueuedApplicationDownlinks[i:]...)
+				break outer
+
 			default:
 				appDowns = append(appDowns, dev.Session.QueuedApplicationDownlinks[i+1:]...)
 				genState.ApplicationDownlink = down
@@ -490,6 +543,15 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			mType = ttnpb.MType_CONFIRMED_DOWN
 		}
 
+	case budgetErr != nil && len(cmdBuf) > 0 && len(relayPayload) == 0 && !needsDownlink:
+		// NOTE: MAC-only downlinks, which are not required to acknowledge an uplink, are dropped
+		// while the budget is exhausted. The MAC commands are enqueued again in the next downlink.
+		logger.WithError(budgetErr).Debug("Skip MAC-only downlink due to exhausted downlink budget")
+		if !applicationDownlinksDeferred {
+			events.Publish(evtDownlinkBudgetExhausted.NewWithIdentifiersAndData(ctx, dev.Ids, budgetErr))
+		}
+		return nil, genState, budgetErr
+
 	case len(cmdBuf) > 0, len(relayPayload) > 0, needsDownlink:
 		pld.FullFCnt = func() uint32 {
 			for i := len(dev.MacState.RecentDownlinks) - 1; i >= 0; i-- {
@@ -540,6 +602,9 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			pld.FrmPayload = encPayload
 		}
 
+	case applicationDownlinksDeferred:
+		return nil, genState, budgetErr
+
 	default:
 		return nil, genState, errNoDownlink.New()
 	}
@@ -688,6 +753,9 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 type downlinkPath struct {
 	*ttnpb.GatewayIdentifiers
 	*ttnpb.DownlinkPath
+
+	// packetSourceID is the ID of the external packet source of the gateway, if any.
+	packetSourceID string
 }
 
 func buildMetadataComparator(
@@ -720,6 +788,12 @@ func downlinkPathsFromMetadata(
 ) []downlinkPath {
 	mds = append(mds[:0:0], mds...)
 	sort.SliceStable(mds, buildMetadataComparator(settings, mds))
+	return downlinkPathsFromRankedMetadata(mds)
+}
+
+// downlinkPathsFromRankedMetadata returns the downlink paths of the gateway metadata, which is sorted by preference.
+// Paths of gateways which prefer other paths and of gateways which are not directly connected are ranked last.
+func downlinkPathsFromRankedMetadata(mds []*ttnpb.MACState_UplinkMessage_RxMetadata) []downlinkPath {
 	head := make([]downlinkPath, 0, len(mds))
 	body := make([]downlinkPath, 0, len(mds))
 	tail := make([]downlinkPath, 0, len(mds))
@@ -741,6 +815,13 @@ func downlinkPathsFromMetadata(
 		case md.Relay != nil:
 			path.GatewayIdentifiers = relayspec.GatewayIdentifiers
 			tail = append(tail, path)
+		case proto.Equal(md.GatewayIds, passiveRoamingGatewayIdentifiers):
+			path.GatewayIdentifiers = passiveRoamingGatewayIdentifiers
+			tail = append(tail, path)
+		case md.PacketSource != nil:
+			path.GatewayIdentifiers = md.GatewayIds
+			path.packetSourceID = md.PacketSource.SourceId
+			tail = append(tail, path)
 		default:
 			path.GatewayIdentifiers = md.GatewayIds
 			switch md.DownlinkPathConstraint {
@@ -765,6 +846,22 @@ func downlinkPathsFromRecentUplinks(ups ...*ttnpb.MACState_UplinkMessage) []down
 	return nil
 }
 
+// rankedDownlinkPathsFromRecentUplinks is like downlinkPathsFromRecentUplinks, but ranks the gateways with the
+// downlink path ranking strategy of the end device.
+func (ns *NetworkServer) rankedDownlinkPathsFromRecentUplinks(
+	ctx context.Context, dev *ttnpb.EndDevice, ups ...*ttnpb.MACState_UplinkMessage,
+) []downlinkPath {
+	strategy := deviceDownlinkPathRanking(dev, ns.defaultMACSettings)
+	for i := len(ups) - 1; i >= 0; i-- {
+		mds := append(ups[i].RxMetadata[:0:0], ups[i].RxMetadata...)
+		ns.downlinkPathRanking.rank(ctx, strategy, ups[i].Settings, mds)
+		if paths := downlinkPathsFromRankedMetadata(mds); len(paths) > 0 {
+			return paths
+		}
+	}
+	return nil
+}
+
 type scheduledDownlink struct {
 	Message    *ttnpb.DownlinkMessage
 	TransmitAt time.Time
@@ -973,6 +1070,25 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(
 					servedSessionKeyID: req.SessionKeyID,
 					patchServingDevice: ns.relayPatchServingDevice,
 				}
+			case proto.Equal(path.GatewayIdentifiers, passiveRoamingGatewayIdentifiers):
+				logger := logger.WithField("target", "passive_roaming")
+				roamingTarget, err := ns.newPassiveRoamingDownlinkTarget(ctx, path.DownlinkPath)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to get passive roaming downlink target")
+					continue
+				}
+				target = roamingTarget
+			case path.packetSourceID != "":
+				logger := logger.WithFields(log.Fields(
+					"target", "packet_source",
+					"packet_source_id", path.packetSourceID,
+				))
+				sourceTarget, err := ns.newPacketSourceDownlinkTarget(path.packetSourceID, path.GatewayIdentifiers)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to get packet source downlink target")
+					continue
+				}
+				target = sourceTarget
 			default:
 				logger := logger.WithFields(log.Fields(
 					"target", "gateway_server",
@@ -1044,6 +1160,7 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(
 		queuedEvents            = make([]events.Event, 0, totalAttempts+len(req.DownlinkEvents))
 		attemptSuccess          = false
 	)
+outer:
 	for _, attempts := range groupedAttempts {
 		// Each individual transmission (which maps one to one with a group) should have an individual
 		// correlation ID in order to be matchable with a transmission acknowledgement.
@@ -1058,6 +1175,19 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(
 				},
 				CorrelationIds: events.CorrelationIDsFromContext(ctx),
 			}
+			releaseBudget := func(context.Context) {}
+			if req.Payload.MHdr.MType != ttnpb.MType_JOIN_ACCEPT {
+				var err error
+				releaseBudget, err = ns.reserveDownlinkBudget(ctx, req.EndDeviceIdentifiers, down)
+				if err != nil {
+					logger.WithError(err).Debug("Skip downlink due to exhausted downlink budget")
+					queuedEvents = append(queuedEvents, evtDownlinkBudgetExhausted.NewWithIdentifiersAndData(
+						ctx, req.EndDeviceIdentifiers, err,
+					))
+					errs = append(errs, err)
+					break outer
+				}
+			}
 			queuedEvents = append(queuedEvents, attemptEvent.New(ctx, eventIDOpt, events.WithData(down)))
 			registerAttempt(ctx)
 			logger.WithField("path_count", len(req.DownlinkPaths)).Debug("Schedule downlink")
@@ -1067,6 +1197,7 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(
 				CorrelationIds: down.CorrelationIds,
 			}, ns.WithClusterAuth())
 			if err != nil {
+				releaseBudget(ctx)
 				queuedEvents = append(queuedEvents, failEvent.New(ctx, eventIDOpt, events.WithData(err)))
 				errs = append(errs, err)
 				continue
@@ -1479,9 +1610,10 @@ type downlinkAttemptResult struct {
 	QueuedApplicationUplinks   []*ttnpb.ApplicationUp
 	QueuedEvents               []events.Event
 	DownlinkTaskUpdateStrategy downlinkTaskUpdateStrategy
+	ForceRejoinReqSent         bool
 }
 
-func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, fp *frequencyplans.FrequencyPlan, slot *classADownlinkSlot, maxUpLength uint16) downlinkAttemptResult {
+func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, fp *frequencyplans.FrequencyPlan, slot *classADownlinkSlot, maxUpLength uint16, forceRejoinReq *ttnpb.MACCommand_ForceRejoinReq) downlinkAttemptResult {
 	ctx = events.ContextWithCorrelationID(ctx, slot.Uplink.CorrelationIds...)
 	if !dev.MacState.RxWindowsAvailable {
 		log.FromContext(ctx).Error("RX windows not available, skip class A downlink slot")
@@ -1497,7 +1629,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 		}
 	}
 
-	paths := downlinkPathsFromRecentUplinks(dev.MacState.RecentUplinks...)
+	paths := ns.rankedDownlinkPathsFromRecentUplinks(ctx, dev, dev.MacState.RecentUplinks...)
 	if len(paths) == 0 {
 		log.FromContext(ctx).Error("No downlink path available, skip class A downlink slot")
 		return downlinkAttemptResult{
@@ -1560,6 +1692,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 		rxParameters.transmitAt,
 		rxParameters.maxDownLength,
 		maxUpLength,
+		forceRejoinReq,
 	)
 	var sets []string
 	if genState.NeedsDownlinkQueueUpdate {
@@ -1684,10 +1817,11 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 		),
 		QueuedApplicationUplinks: genState.appendApplicationUplinks(nil, true),
 		QueuedEvents:             queuedEvents,
+		ForceRejoinReqSent:       genState.ForceRejoinReqEnqueued,
 	}
 }
 
-func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, fp *frequencyplans.FrequencyPlan, slot *networkInitiatedDownlinkSlot, maxUpLength uint16) downlinkAttemptResult {
+func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, fp *frequencyplans.FrequencyPlan, slot *networkInitiatedDownlinkSlot, maxUpLength uint16, forceRejoinReq *ttnpb.MACCommand_ForceRejoinReq) downlinkAttemptResult {
 	var drIdx ttnpb.DataRateIndex
 	var freq uint64
 	switch slot.Class {
@@ -1732,6 +1866,7 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 	genDown, genState, err := ns.generateDataDownlink(ctx, dev, phy, slot.Class, latestTime(slot.Time, time.Now()),
 		dr.MaxMACPayloadSize(mac.DeviceExpectedDownlinkDwellTime(dev.MacState, fp, phy)),
 		maxUpLength,
+		forceRejoinReq,
 	)
 	var sets []string
 	if genState.NeedsDownlinkQueueUpdate {
@@ -1742,8 +1877,13 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 		if genState.ApplicationDownlink != nil && ttnpb.HasAnyField(sets, "session.queued_application_downlinks") {
 			dev.Session.QueuedApplicationDownlinks = append([]*ttnpb.ApplicationDownlink{genState.ApplicationDownlink}, dev.Session.QueuedApplicationDownlinks...)
 		}
+		taskUpdateStrategy := noDownlinkTask
+		if budget.IsExhausted(err) {
+			// NOTE: The next downlink task is deferred until the downlink budgets reset.
+			taskUpdateStrategy = nextDownlinkTask
+		}
 		return downlinkAttemptResult{
-			DownlinkTaskUpdateStrategy: noDownlinkTask,
+			DownlinkTaskUpdateStrategy: taskUpdateStrategy,
 			SetPaths:                   sets,
 			QueuedApplicationUplinks:   genState.appendApplicationUplinks(nil, false),
 			QueuedEvents:               queuedEvents,
@@ -1796,7 +1936,7 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 			)
 		}
 	} else {
-		paths := downlinkPathsFromRecentUplinks(dev.MacState.RecentUplinks...)
+		paths := ns.rankedDownlinkPathsFromRecentUplinks(ctx, dev, dev.MacState.RecentUplinks...)
 		if len(paths) == 0 {
 			log.FromContext(ctx).Error("No downlink path available, skip class B/C downlink slot")
 			if genState.ApplicationDownlink != nil && ttnpb.HasAnyField(sets, "session.queued_application_downlinks") {
@@ -1823,6 +1963,37 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 		Rx2Frequency:    freq,
 		AbsoluteTime:    absTime,
 	}
+	reservePingSlot := slot.Class == ttnpb.Class_CLASS_B && ns.classBCapacity != nil &&
+		genState.ApplicationDownlink.GetClassBC().GetAbsoluteTime() == nil
+	var airtime time.Duration
+	if reservePingSlot {
+		airtime, err = downlinkAirtime(&ttnpb.DownlinkMessage{
+			RawPayload: genDown.RawPayload,
+			Settings:   &ttnpb.DownlinkMessage_Request{Request: req},
+		})
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to compute class B downlink airtime, skip ping slot occupancy")
+			reservePingSlot = false
+		}
+	}
+	if reservePingSlot {
+		groupedPaths = ns.classBCapacity.availablePaths(ctx, groupedPaths, slot.Time, airtime)
+		if len(groupedPaths) == 0 {
+			log.FromContext(ctx).Debug("Ping slot occupied on all downlink paths, skip class B downlink slot")
+			if genState.NeedsDownlinkQueueUpdate {
+				dev.Session.QueuedApplicationDownlinks = append(
+					[]*ttnpb.ApplicationDownlink{genState.ApplicationDownlink},
+					dev.Session.QueuedApplicationDownlinks...,
+				)
+			}
+			return downlinkAttemptResult{
+				SetPaths:                   sets,
+				QueuedApplicationUplinks:   genState.appendApplicationUplinks(nil, false),
+				QueuedEvents:               append(queuedEvents, evtSkipPingSlot.NewWithIdentifiersAndData(ctx, dev.Ids, nil)),
+				DownlinkTaskUpdateStrategy: retryDownlinkTask,
+			}
+		}
+	}
 	down, scheduleEvents, attemptSuccess, err := ns.scheduleDownlinkByPaths(
 		log.NewContext(ctx, loggerWithTxRequestFields(log.FromContext(ctx), req, false, true)),
 		&scheduleRequest{
@@ -1886,6 +2057,9 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 		}
 	}
 
+	if reservePingSlot {
+		ns.classBCapacity.reserve(ctx, dev.Ids, groupedPaths, down.Message, slot.Time)
+	}
 	recordDataDownlink(dev, genState, genDown.NeedsMACAnswer, down, ns.defaultMACSettings)
 	if genState.ApplicationDownlink != nil || genState.EvictDownlinkQueueIfScheduled {
 		sets = ttnpb.AddFields(sets, "session.queued_application_downlinks")
@@ -1925,6 +2099,7 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 		),
 		QueuedApplicationUplinks: queuedApplicationUplinks,
 		QueuedEvents:             queuedEvents,
+		ForceRejoinReqSent:       genState.ForceRejoinReqEnqueued,
 	}
 }
 
@@ -1954,7 +2129,15 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 		var queuedApplicationUplinks []*ttnpb.ApplicationUp
 		defer func() { ns.submitApplicationUplinks(ctx, queuedApplicationUplinks...) }()
 
+		if s, err := ns.homeHandoverSession(ctx, devID); err != nil {
+			logger.WithError(err).Warn("Failed to get handover roaming session")
+		} else if s != nil {
+			logger.Debug("Downlink slot skipped since the session is handed over")
+			return time.Time{}, nil
+		}
+
 		taskUpdateStrategy := noDownlinkTask
+		forceRejoinSent := false
 		dev, ctx, err := ns.devices.SetByID(ctx, devID.ApplicationIds, devID.DeviceId,
 			[]string{
 				"frequency_plan_id",
@@ -2017,7 +2200,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 					ctx := events.ContextWithCorrelationID(ctx, up.CorrelationIds...)
 					ctx = events.ContextWithCorrelationID(ctx, dev.PendingMacState.QueuedJoinAccept.CorrelationIds...)
 
-					paths := downlinkPathsFromRecentUplinks(up)
+					paths := ns.rankedDownlinkPathsFromRecentUplinks(ctx, dev, up)
 					if len(paths) == 0 {
 						logger.Warn("No downlink path available, skip join-accept downlink slot")
 						dev.PendingMacState.RxWindowsAvailable = false
@@ -2188,6 +2371,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 				logger = logger.WithField("device_class", dev.MacState.DeviceClass)
 
 				ctx = log.NewContext(ctx, logger)
+				forceRejoinReq := ns.dueForceRejoinReq(ctx, dev)
 
 				var maxUpLength uint16 = math.MaxUint16
 				if !dev.Multicast && macspec.ValidateUplinkPayloadSize(dev.MacState.LorawanVersion) {
@@ -2195,16 +2379,17 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 				}
 				var earliestAt time.Time
 				for {
-					v, ok := nextDataDownlinkSlot(ctx, dev, phy, ns.defaultMACSettings, earliestAt)
+					v, ok := nextDataDownlinkSlot(ctx, dev, phy, ns.defaultMACSettings, earliestAt, forceRejoinReq)
 					if !ok {
 						return dev, nil, nil
 					}
 					switch slot := v.(type) {
 					case *classADownlinkSlot:
-						a := ns.attemptClassADataDownlink(ctx, dev, phy, fp, slot, maxUpLength)
+						a := ns.attemptClassADataDownlink(ctx, dev, phy, fp, slot, maxUpLength, forceRejoinReq)
 						queuedEvents = append(queuedEvents, a.QueuedEvents...)
 						queuedApplicationUplinks = append(queuedApplicationUplinks, a.QueuedApplicationUplinks...)
 						taskUpdateStrategy = a.DownlinkTaskUpdateStrategy
+						forceRejoinSent = a.ForceRejoinReqSent
 						return dev, a.SetPaths, nil
 
 					case *networkInitiatedDownlinkSlot:
@@ -2232,10 +2417,11 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 							earliestAt = time.Now().Add(absoluteTimeSchedulingDelay / 2)
 							continue
 						}
-						a := ns.attemptNetworkInitiatedDataDownlink(ctx, dev, phy, fp, slot, maxUpLength)
+						a := ns.attemptNetworkInitiatedDataDownlink(ctx, dev, phy, fp, slot, maxUpLength, forceRejoinReq)
 						queuedEvents = append(queuedEvents, a.QueuedEvents...)
 						queuedApplicationUplinks = append(queuedApplicationUplinks, a.QueuedApplicationUplinks...)
 						taskUpdateStrategy = a.DownlinkTaskUpdateStrategy
+						forceRejoinSent = a.ForceRejoinReqSent
 						return dev, a.SetPaths, nil
 
 					default:
@@ -2249,6 +2435,9 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 			logger.WithError(err).Error("Failed to update device in registry")
 			return time.Time{}, err
 		}
+		if forceRejoinSent {
+			ns.recordForceRejoinTransmission(ctx, dev.Ids)
+		}
 
 		var earliestAt time.Time
 		switch taskUpdateStrategy {
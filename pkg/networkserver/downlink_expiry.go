@@ -0,0 +1,126 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errInvalidDownlinkTTL = errors.DefineInvalidArgument(
+	"invalid_downlink_ttl", "invalid application downlink time to live `{ttl}`",
+)
+
+// applicationDownlinkExpired returns whether down expires before t.
+func applicationDownlinkExpired(down *ttnpb.ApplicationDownlink, t time.Time) bool {
+	return down.GetExpiresAt() != nil && down.ExpiresAt.AsTime().Before(t)
+}
+
+// resolveApplicationDownlinkExpiries replaces the time to live of downs by their absolute expiry relative to now.
+// It returns an error if the time to live of any of the downlinks is not positive.
+func resolveApplicationDownlinkExpiries(now time.Time, downs ...*ttnpb.ApplicationDownlink) error {
+	for _, down := range downs {
+		if down.Ttl == nil {
+			continue
+		}
+		ttl := down.Ttl.AsDuration()
+		if ttl <= 0 {
+			return errInvalidDownlinkTTL.WithAttributes("ttl", ttl)
+		}
+		if expiresAt := now.Add(ttl); down.ExpiresAt == nil || expiresAt.Before(down.ExpiresAt.AsTime()) {
+			down.ExpiresAt = timestamppb.New(expiresAt)
+		}
+		down.Ttl = nil
+	}
+	return nil
+}
+
+// expireApplicationDownlinks drops the application downlinks of session which expire before now.
+//
+// The queue of session is invalidated from the first expired downlink onwards, and the application uplink
+// which is returned reports the remaining downlinks in the invalidated part of the queue. The Application Server
+// assigns new frame counters to these downlinks and queues them again, so that the frame counters of the
+// dropped downlinks are reused. Downlinks of other sessions are kept in the queue.
+// It returns nil if no downlinks expire.
+func expireApplicationDownlinks(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, session *ttnpb.Session, now time.Time,
+) *ttnpb.ApplicationUp {
+	if session == nil {
+		return nil
+	}
+	var (
+		queue       = session.QueuedApplicationDownlinks
+		kept        = queue[:0:0]
+		invalidated = queue[:0:0]
+		expired     *ttnpb.ApplicationDownlink
+	)
+	for _, down := range queue {
+		switch {
+		case !bytes.Equal(down.SessionKeyId, session.Keys.GetSessionKeyId()):
+			kept = append(kept, down)
+
+		case applicationDownlinkExpired(down, now):
+			loggerWithApplicationDownlinkFields(log.FromContext(ctx), down).Debug("Drop expired application downlink")
+			if expired == nil {
+				expired = down
+			}
+
+		case expired == nil:
+			kept = append(kept, down)
+
+		default:
+			invalidated = append(invalidated, down)
+		}
+	}
+	if expired == nil {
+		return nil
+	}
+	session.QueuedApplicationDownlinks = kept
+	lastFCntDown := expired.FCnt
+	if lastFCntDown > 0 {
+		lastFCntDown--
+	}
+	return &ttnpb.ApplicationUp{
+		EndDeviceIds:   ids,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		Up: &ttnpb.ApplicationUp_DownlinkQueueInvalidated{
+			DownlinkQueueInvalidated: &ttnpb.ApplicationInvalidatedDownlinks{
+				Downlinks:    invalidated,
+				LastFCntDown: lastFCntDown,
+				SessionKeyId: session.Keys.GetSessionKeyId(),
+			},
+		},
+	}
+}
+
+// expireQueuedApplicationDownlinks drops the expired application downlinks from the queues of the active and
+// pending session of dev. It returns the application uplinks which invalidate the queues.
+func expireQueuedApplicationDownlinks(
+	ctx context.Context, dev *ttnpb.EndDevice, now time.Time,
+) (ups []*ttnpb.ApplicationUp) {
+	for _, session := range []*ttnpb.Session{dev.Session, dev.PendingSession} {
+		if up := expireApplicationDownlinks(ctx, dev.Ids, session, now); up != nil {
+			ups = append(ups, up)
+		}
+	}
+	return ups
+}
@@ -0,0 +1,159 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestResolveApplicationDownlinkExpiries(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		Name              string
+		ExpiresAt         *timestamppb.Timestamp
+		TTL               *durationpb.Duration
+		ExpectedExpiresAt *timestamppb.Timestamp
+		Error             *errors.Definition
+	}{
+		{
+			Name: "NoExpiry",
+		},
+		{
+			Name:              "TTL",
+			TTL:               durationpb.New(90 * time.Minute),
+			ExpectedExpiresAt: timestamppb.New(now.Add(90 * time.Minute)),
+		},
+		{
+			Name:              "AbsoluteExpiry",
+			ExpiresAt:         timestamppb.New(now.Add(12 * time.Hour)),
+			ExpectedExpiresAt: timestamppb.New(now.Add(12 * time.Hour)),
+		},
+		{
+			Name:              "EarliestExpiry/ExpiresAt",
+			ExpiresAt:         timestamppb.New(now.Add(30 * time.Minute)),
+			TTL:               durationpb.New(time.Hour),
+			ExpectedExpiresAt: timestamppb.New(now.Add(30 * time.Minute)),
+		},
+		{
+			Name:              "EarliestExpiry/TTL",
+			ExpiresAt:         timestamppb.New(now.Add(2 * time.Hour)),
+			TTL:               durationpb.New(time.Hour),
+			ExpectedExpiresAt: timestamppb.New(now.Add(time.Hour)),
+		},
+		{
+			Name:  "ZeroTTL",
+			TTL:   durationpb.New(0),
+			Error: errInvalidDownlinkTTL,
+		},
+		{
+			Name:  "NegativeTTL",
+			TTL:   durationpb.New(-time.Hour),
+			Error: errInvalidDownlinkTTL,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				down := &ttnpb.ApplicationDownlink{ExpiresAt: tc.ExpiresAt, Ttl: tc.TTL}
+				err := resolveApplicationDownlinkExpiries(now, down)
+				if tc.Error != nil {
+					a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+					return
+				}
+				a.So(err, should.BeNil)
+				a.So(down.ExpiresAt, should.Resemble, tc.ExpectedExpiresAt)
+				a.So(down.Ttl, should.BeNil)
+			},
+		})
+	}
+}
+
+func TestExpireApplicationDownlinks(t *testing.T) {
+	a, ctx := test.New(t)
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	sessionKeyID := []byte{0x01}
+	otherSessionKeyID := []byte{0x02}
+	newDownlink := func(fCnt uint32, sessionKeyID []byte, expiresAt time.Time) *ttnpb.ApplicationDownlink {
+		down := &ttnpb.ApplicationDownlink{FCnt: fCnt, SessionKeyId: sessionKeyID}
+		if !expiresAt.IsZero() {
+			down.ExpiresAt = timestamppb.New(expiresAt)
+		}
+		return down
+	}
+
+	valid := newDownlink(1, sessionKeyID, now.Add(time.Hour))
+	unlimited := newDownlink(2, sessionKeyID, time.Time{})
+	session := &ttnpb.Session{
+		Keys:                       &ttnpb.SessionKeys{SessionKeyId: sessionKeyID},
+		QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{valid, unlimited},
+	}
+	a.So(expireApplicationDownlinks(ctx, ids, session, now), should.BeNil)
+	a.So(session.QueuedApplicationDownlinks, should.Resemble, []*ttnpb.ApplicationDownlink{valid, unlimited})
+	a.So(expireApplicationDownlinks(ctx, ids, nil, now), should.BeNil)
+
+	expired := newDownlink(3, sessionKeyID, now.Add(-time.Hour))
+	expiredLater := newDownlink(5, sessionKeyID, now.Add(-time.Minute))
+	following := newDownlink(4, sessionKeyID, now.Add(time.Hour))
+	last := newDownlink(6, sessionKeyID, time.Time{})
+	other := newDownlink(1, otherSessionKeyID, now.Add(-time.Hour))
+	session.QueuedApplicationDownlinks = []*ttnpb.ApplicationDownlink{
+		valid, unlimited, other, expired, following, expiredLater, last,
+	}
+	up := expireApplicationDownlinks(ctx, ids, session, now)
+	a.So(session.QueuedApplicationDownlinks, should.Resemble, []*ttnpb.ApplicationDownlink{valid, unlimited, other})
+	a.So(up, should.Resemble, &ttnpb.ApplicationUp{
+		EndDeviceIds: ids,
+		Up: &ttnpb.ApplicationUp_DownlinkQueueInvalidated{
+			DownlinkQueueInvalidated: &ttnpb.ApplicationInvalidatedDownlinks{
+				Downlinks:    []*ttnpb.ApplicationDownlink{following, last},
+				LastFCntDown: 2,
+				SessionKeyId: sessionKeyID,
+			},
+		},
+	})
+
+	dev := &ttnpb.EndDevice{
+		Ids: ids,
+		Session: &ttnpb.Session{
+			Keys:                       &ttnpb.SessionKeys{SessionKeyId: sessionKeyID},
+			QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{expired},
+		},
+		PendingSession: &ttnpb.Session{
+			Keys:                       &ttnpb.SessionKeys{SessionKeyId: otherSessionKeyID},
+			QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{other},
+		},
+	}
+	ups := expireQueuedApplicationDownlinks(ctx, dev, now)
+	a.So(ups, should.HaveLength, 2)
+	a.So(dev.Session.QueuedApplicationDownlinks, should.BeEmpty)
+	a.So(dev.PendingSession.QueuedApplicationDownlinks, should.BeEmpty)
+}
@@ -198,6 +198,7 @@ func TestGenerateDataDownlink(t *testing.T) {
 		Device                       *ttnpb.EndDevice
 		Payload                      *ttnpb.Message
 		ConfFCnt                     uint32
+		ForceRejoinReq               *ttnpb.MACCommand_ForceRejoinReq
 		ApplicationDownlinkAssertion func(t *testing.T, down *ttnpb.ApplicationDownlink) bool
 		DeviceAssertion              func(*testing.T, *ttnpb.EndDevice) bool
 		Error                        error
@@ -1058,6 +1059,82 @@ func TestGenerateDataDownlink(t *testing.T) {
 				})
 			},
 		},
+		{
+			Name: "1.1/no app downlink/force rejoin/no ack",
+			Device: &ttnpb.EndDevice{
+				Ids: &ttnpb.EndDeviceIdentifiers{
+					ApplicationIds: appID,
+					DeviceId:       devID,
+					DevAddr:        devAddr.Bytes(),
+				},
+				MacState: &ttnpb.MACState{
+					CurrentParameters: &ttnpb.MACParameters{},
+					DesiredParameters: &ttnpb.MACParameters{},
+					LorawanVersion:    ttnpb.MACVersion_MAC_V1_1,
+					RecentUplinks: []*ttnpb.MACState_UplinkMessage{{
+						Payload: &ttnpb.Message{
+							MHdr: &ttnpb.MHDR{
+								MType: ttnpb.MType_UNCONFIRMED_UP,
+							},
+							Payload: &ttnpb.Message_MacPayload{MacPayload: &ttnpb.MACPayload{
+								FHdr: &ttnpb.FHDR{
+									FCtrl: &ttnpb.FCtrl{},
+								},
+							}},
+						},
+					}},
+				},
+				Session: &ttnpb.Session{
+					DevAddr:       devAddr.Bytes(),
+					LastFCntUp:    99,
+					LastNFCntDown: 41,
+					Keys: &ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: nwkSEncKey.Bytes(),
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: sNwkSIntKey.Bytes(),
+						},
+					},
+				},
+				LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_1_REV_B,
+				FrequencyPlanId:   band.EU_863_870,
+			},
+			ForceRejoinReq: &ttnpb.MACCommand_ForceRejoinReq{
+				RejoinType:    ttnpb.RejoinRequestType_KEYS,
+				DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_3,
+				MaxRetries:    2,
+			},
+			Payload: &ttnpb.Message{
+				MHdr: &ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MacPayload{
+					MacPayload: &ttnpb.MACPayload{
+						FHdr: &ttnpb.FHDR{
+							DevAddr: devAddr.Bytes(),
+							FCtrl: &ttnpb.FCtrl{
+								Ack: false,
+								Adr: true,
+							},
+							FCnt: 42,
+							FOpts: MustEncryptDownlink(nwkSEncKey, devAddr, 42,
+								macspec.EncryptionOptions(ttnpb.MACVersion_MAC_V1_1, macspec.DownlinkFrame, 0, true),
+								MakeDownlinkMACBuffer(
+									LoRaWANBands[band.EU_863_870][ttnpb.PHYVersion_RP001_V1_1_REV_B],
+									&ttnpb.MACCommand_ForceRejoinReq{
+										RejoinType:    ttnpb.RejoinRequestType_KEYS,
+										DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_3,
+										MaxRetries:    2,
+									},
+								)...),
+						},
+						FullFCnt: 42,
+					},
+				},
+			},
+		},
 		{
 			Name: "1.1/no app downlink/status(time/zero time)/no ack",
 			Device: &ttnpb.EndDevice{
@@ -1236,7 +1313,7 @@ func TestGenerateDataDownlink(t *testing.T) {
 					return
 				}
 
-				genDown, genState, err := ns.generateDataDownlink(ctx, dev, phy, dev.MacState.DeviceClass, time.Now(), math.MaxUint16, math.MaxUint16)
+				genDown, genState, err := ns.generateDataDownlink(ctx, dev, phy, dev.MacState.DeviceClass, time.Now(), math.MaxUint16, math.MaxUint16, tc.ForceRejoinReq)
 				if tc.Error != nil {
 					a.So(err, should.EqualErrorOrDefinition, tc.Error)
 					a.So(genDown, should.BeNil)
@@ -1254,6 +1331,7 @@ func TestGenerateDataDownlink(t *testing.T) {
 				pld := ttnpb.Clone(tc.Payload)
 				pld.Mic = b[len(b)-4:]
 				a.So(genDown.Payload, should.Resemble, pld)
+				a.So(genState.ForceRejoinReqEnqueued, should.Equal, tc.ForceRejoinReq != nil)
 				if tc.ApplicationDownlinkAssertion != nil {
 					a.So(tc.ApplicationDownlinkAssertion(t, genState.ApplicationDownlink), should.BeTrue)
 				} else {
@@ -0,0 +1,328 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/lora"
+)
+
+const (
+	// SignalDownlinkPathRanking ranks gateways by the adjusted RSSI of the uplink. This is the default strategy.
+	SignalDownlinkPathRanking = "signal"
+	// SNRDownlinkPathRanking ranks gateways by the SNR of the uplink.
+	SNRDownlinkPathRanking = "snr"
+	// UtilizationDownlinkPathRanking ranks gateways by their downlink duty-cycle utilization, least utilized first.
+	UtilizationDownlinkPathRanking = "utilization"
+	// PreferredDownlinkPathRanking ranks the configured preferred gateways before other gateways.
+	PreferredDownlinkPathRanking = "preferred"
+	// RoundRobinDownlinkPathRanking rotates the gateways with a signal close to the best gateway.
+	RoundRobinDownlinkPathRanking = "round-robin"
+)
+
+var errDownlinkPathRanking = errors.DefineInvalidArgument(
+	"downlink_path_ranking", "unknown downlink path ranking strategy `{name}`",
+)
+
+func isDownlinkPathRankingStrategy(name string) bool {
+	switch name {
+	case SignalDownlinkPathRanking,
+		SNRDownlinkPathRanking,
+		UtilizationDownlinkPathRanking,
+		PreferredDownlinkPathRanking,
+		RoundRobinDownlinkPathRanking:
+		return true
+	default:
+		return false
+	}
+}
+
+// GatewayUtilizationSource provides the downlink utilization of gateways.
+type GatewayUtilizationSource interface {
+	// DownlinkUtilization returns the downlink utilization of the gateways by gateway ID.
+	// The utilization is the highest fraction of the duty-cycle limit used in any sub-band of the gateway.
+	// Gateways without statistics are omitted.
+	DownlinkUtilization(ctx context.Context, ids ...*ttnpb.GatewayIdentifiers) (map[string]float32, error)
+}
+
+// downlinkPathRanker sorts the gateway metadata of an uplink in place, most preferred gateway first.
+type downlinkPathRanker func(context.Context, *ttnpb.MACState_UplinkMessage_TxSettings, []*ttnpb.MACState_UplinkMessage_RxMetadata)
+
+type downlinkPathRanking struct {
+	rankers map[string]downlinkPathRanker
+}
+
+func newDownlinkPathRanking(conf DownlinkPathRankingConfig, utilization GatewayUtilizationSource) *downlinkPathRanking {
+	preferred := make(map[string]struct{}, len(conf.PreferredGateways))
+	for _, id := range conf.PreferredGateways {
+		preferred[id] = struct{}{}
+	}
+	var roundRobin atomic.Uint64
+	return &downlinkPathRanking{
+		rankers: map[string]downlinkPathRanker{
+			SignalDownlinkPathRanking: func(
+				_ context.Context,
+				settings *ttnpb.MACState_UplinkMessage_TxSettings,
+				mds []*ttnpb.MACState_UplinkMessage_RxMetadata,
+			) {
+				sortMetadataBySignal(settings, mds)
+			},
+			SNRDownlinkPathRanking: func(
+				_ context.Context,
+				settings *ttnpb.MACState_UplinkMessage_TxSettings,
+				mds []*ttnpb.MACState_UplinkMessage_RxMetadata,
+			) {
+				sortMetadataBySignal(settings, mds)
+				sort.SliceStable(mds, func(i, j int) bool { return mds[i].Snr > mds[j].Snr })
+			},
+			UtilizationDownlinkPathRanking: func(
+				ctx context.Context,
+				settings *ttnpb.MACState_UplinkMessage_TxSettings,
+				mds []*ttnpb.MACState_UplinkMessage_RxMetadata,
+			) {
+				sortMetadataBySignal(settings, mds)
+				if utilization == nil {
+					return
+				}
+				ids := make([]*ttnpb.GatewayIdentifiers, 0, len(mds))
+				for _, md := range mds {
+					if md.GatewayIds != nil && md.PacketBroker == nil && md.Relay == nil {
+						ids = append(ids, md.GatewayIds)
+					}
+				}
+				if len(ids) == 0 {
+					return
+				}
+				utilizations, err := utilization.DownlinkUtilization(ctx, ids...)
+				if err != nil {
+					log.FromContext(ctx).WithError(err).Warn("Failed to get gateway downlink utilization")
+					return
+				}
+				// Gateways without statistics are ranked after gateways with statistics.
+				key := func(md *ttnpb.MACState_UplinkMessage_RxMetadata) float32 {
+					if v, ok := utilizations[md.GatewayIds.GetGatewayId()]; ok {
+						return v
+					}
+					return math.MaxFloat32
+				}
+				sort.SliceStable(mds, func(i, j int) bool { return key(mds[i]) < key(mds[j]) })
+			},
+			PreferredDownlinkPathRanking: func(
+				_ context.Context,
+				settings *ttnpb.MACState_UplinkMessage_TxSettings,
+				mds []*ttnpb.MACState_UplinkMessage_RxMetadata,
+			) {
+				sortMetadataBySignal(settings, mds)
+				isPreferred := func(md *ttnpb.MACState_UplinkMessage_RxMetadata) bool {
+					_, ok := preferred[md.GatewayIds.GetGatewayId()]
+					return ok
+				}
+				sort.SliceStable(mds, func(i, j int) bool { return isPreferred(mds[i]) && !isPreferred(mds[j]) })
+			},
+			RoundRobinDownlinkPathRanking: func(
+				_ context.Context,
+				settings *ttnpb.MACState_UplinkMessage_TxSettings,
+				mds []*ttnpb.MACState_UplinkMessage_RxMetadata,
+			) {
+				sortMetadataBySignal(settings, mds)
+				n := roundRobinCandidates(settings, mds, conf.RoundRobinMargin)
+				if n < 2 {
+					return
+				}
+				k := int(roundRobin.Add(1) % uint64(n))
+				rotated := append(append(mds[k:n:n], mds[:k]...), mds[n:]...)
+				copy(mds, rotated)
+			},
+		},
+	}
+}
+
+// deviceDownlinkPathRanking returns the name of the downlink path ranking strategy to be used for the end device.
+func deviceDownlinkPathRanking(dev *ttnpb.EndDevice, defaults *ttnpb.MACSettings) string {
+	switch {
+	case dev.GetMacSettings().GetDownlinkPathRanking() != "":
+		return dev.MacSettings.DownlinkPathRanking
+
+	case defaults.GetDownlinkPathRanking() != "":
+		return defaults.DownlinkPathRanking
+
+	default:
+		return SignalDownlinkPathRanking
+	}
+}
+
+// validateDownlinkPathRanking validates that the downlink path ranking strategy of the MAC settings, if any, exists.
+func validateDownlinkPathRanking(settings *ttnpb.MACSettings) error {
+	name := settings.GetDownlinkPathRanking()
+	if name == "" || isDownlinkPathRankingStrategy(name) {
+		return nil
+	}
+	return errDownlinkPathRanking.WithAttributes("name", name)
+}
+
+// rank sorts the gateway metadata in place with the named downlink path ranking strategy.
+// Unknown strategies fall back to the signal strategy.
+func (r *downlinkPathRanking) rank(
+	ctx context.Context,
+	strategy string,
+	settings *ttnpb.MACState_UplinkMessage_TxSettings,
+	mds []*ttnpb.MACState_UplinkMessage_RxMetadata,
+) {
+	if r == nil {
+		sortMetadataBySignal(settings, mds)
+		return
+	}
+	ranker, ok := r.rankers[strategy]
+	if !ok {
+		ranker = r.rankers[SignalDownlinkPathRanking]
+	}
+	ranker(ctx, settings, mds)
+}
+
+func metadataSignal(
+	settings *ttnpb.MACState_UplinkMessage_TxSettings, md *ttnpb.MACState_UplinkMessage_RxMetadata,
+) float32 {
+	if _, ok := settings.GetDataRate().GetModulation().(*ttnpb.DataRate_Lora); ok {
+		return lora.AdjustedRSSI(md.ChannelRssi, md.Snr)
+	}
+	return md.ChannelRssi
+}
+
+func sortMetadataBySignal(
+	settings *ttnpb.MACState_UplinkMessage_TxSettings, mds []*ttnpb.MACState_UplinkMessage_RxMetadata,
+) {
+	if settings.GetDataRate() == nil {
+		return
+	}
+	sort.SliceStable(mds, buildMetadataComparator(settings, mds))
+}
+
+// roundRobinCandidates returns the number of leading gateways in mds, sorted by signal, which are within margin of
+// the best gateway.
+func roundRobinCandidates(
+	settings *ttnpb.MACState_UplinkMessage_TxSettings, mds []*ttnpb.MACState_UplinkMessage_RxMetadata, margin float32,
+) int {
+	if len(mds) == 0 || mds[0].ChannelRssi == 0 {
+		return 0
+	}
+	best := metadataSignal(settings, mds[0])
+	for i, md := range mds {
+		if md.ChannelRssi == 0 || md.PacketBroker != nil || md.Relay != nil || metadataSignal(settings, md) < best-margin {
+			return i
+		}
+	}
+	return len(mds)
+}
+
+type gatewayUtilizationEntry struct {
+	utilization float32
+	ok          bool
+	expiresAt   time.Time
+}
+
+// gatewayServerUtilization is a GatewayUtilizationSource which caches the sub-band statistics of the Gateway Server.
+type gatewayServerUtilization struct {
+	ns  *NetworkServer
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]gatewayUtilizationEntry
+}
+
+func newGatewayServerUtilization(ns *NetworkServer, ttl time.Duration) *gatewayServerUtilization {
+	return &gatewayServerUtilization{
+		ns:      ns,
+		ttl:     ttl,
+		entries: make(map[string]gatewayUtilizationEntry),
+	}
+}
+
+// DownlinkUtilization implements GatewayUtilizationSource.
+func (u *gatewayServerUtilization) DownlinkUtilization(
+	ctx context.Context, ids ...*ttnpb.GatewayIdentifiers,
+) (map[string]float32, error) {
+	now := time.Now()
+	res := make(map[string]float32, len(ids))
+	var missing []*ttnpb.GatewayIdentifiers
+	u.mu.Lock()
+	for _, id := range ids {
+		entry, ok := u.entries[id.GetGatewayId()]
+		switch {
+		case !ok || now.After(entry.expiresAt):
+			missing = append(missing, id)
+		case entry.ok:
+			res[id.GetGatewayId()] = entry.utilization
+		}
+	}
+	u.mu.Unlock()
+	if len(missing) == 0 {
+		return res, nil
+	}
+
+	cc, err := u.ns.GetPeerConn(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, nil)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := ttnpb.NewGsClient(cc).BatchGetGatewayConnectionStats(ctx, &ttnpb.BatchGetGatewayConnectionStatsRequest{
+		GatewayIds: missing,
+		FieldMask:  ttnpb.FieldMask("sub_bands"),
+	}, u.ns.WithClusterAuth())
+	if err != nil {
+		return nil, err
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for id, entry := range u.entries {
+		if now.After(entry.expiresAt) {
+			delete(u.entries, id)
+		}
+	}
+	for _, id := range missing {
+		entry := gatewayUtilizationEntry{
+			expiresAt: now.Add(u.ttl),
+		}
+		if st, ok := stats.GetEntries()[id.GetGatewayId()]; ok {
+			entry.utilization, entry.ok = subBandUtilization(st.GetSubBands()), true
+			res[id.GetGatewayId()] = entry.utilization
+		}
+		u.entries[id.GetGatewayId()] = entry
+	}
+	return res, nil
+}
+
+// subBandUtilization returns the highest fraction of the duty-cycle limit used in any of the sub-bands.
+func subBandUtilization(subBands []*ttnpb.GatewayConnectionStats_SubBand) float32 {
+	var res float32
+	for _, sb := range subBands {
+		v := sb.GetDownlinkUtilization()
+		if limit := sb.GetDownlinkUtilizationLimit(); limit > 0 {
+			v /= limit
+		}
+		if v > res {
+			res = v
+		}
+	}
+	return res
+}
@@ -0,0 +1,175 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockGatewayUtilizationSource map[string]float32
+
+func (m mockGatewayUtilizationSource) DownlinkUtilization(
+	_ context.Context, ids ...*ttnpb.GatewayIdentifiers,
+) (map[string]float32, error) {
+	res := make(map[string]float32, len(ids))
+	for _, id := range ids {
+		if v, ok := m[id.GetGatewayId()]; ok {
+			res[id.GetGatewayId()] = v
+		}
+	}
+	return res, nil
+}
+
+func TestDownlinkPathRanking(t *testing.T) {
+	t.Parallel()
+
+	settings := &ttnpb.MACState_UplinkMessage_TxSettings{
+		DataRate: &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Lora{
+				Lora: &ttnpb.LoRaDataRate{SpreadingFactor: 7, Bandwidth: 125000},
+			},
+		},
+	}
+	// Adjusted RSSI: gtw-b -96, gtw-c -96.7, gtw-a -100.7, gtw-d -110.3.
+	mds := []*ttnpb.MACState_UplinkMessage_RxMetadata{
+		{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-a"}, ChannelRssi: -100, Snr: 8},
+		{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-b"}, ChannelRssi: -90, Snr: -6},
+		{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-c"}, ChannelRssi: -94, Snr: 2},
+		{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-d"}, ChannelRssi: -110, Snr: 9},
+	}
+	ranking := newDownlinkPathRanking(DownlinkPathRankingConfig{
+		PreferredGateways: []string{"gtw-d", "gtw-a"},
+		RoundRobinMargin:  6,
+	}, mockGatewayUtilizationSource{
+		"gtw-a": 0.1,
+		"gtw-b": 0.9,
+		"gtw-c": 0.5,
+	})
+	rank := func(dev *ttnpb.EndDevice, defaults *ttnpb.MACSettings) []string {
+		ranked := append(mds[:0:0], mds...)
+		ranking.rank(test.Context(), deviceDownlinkPathRanking(dev, defaults), settings, ranked)
+		ids := make([]string, 0, len(ranked))
+		for _, md := range ranked {
+			ids = append(ids, md.GatewayIds.GetGatewayId())
+		}
+		return ids
+	}
+	withStrategy := func(name string) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			MacSettings: &ttnpb.MACSettings{
+				DownlinkPathRanking: name,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Device   *ttnpb.EndDevice
+		Defaults *ttnpb.MACSettings
+		Expected [][]string
+	}{
+		{
+			Name:     "Signal",
+			Device:   &ttnpb.EndDevice{},
+			Expected: [][]string{{"gtw-b", "gtw-c", "gtw-a", "gtw-d"}},
+		},
+		{
+			Name:     "Unknown",
+			Device:   withStrategy("unknown"),
+			Expected: [][]string{{"gtw-b", "gtw-c", "gtw-a", "gtw-d"}},
+		},
+		{
+			Name:     "SNR",
+			Device:   withStrategy(SNRDownlinkPathRanking),
+			Expected: [][]string{{"gtw-d", "gtw-a", "gtw-c", "gtw-b"}},
+		},
+		{
+			Name:     "Utilization",
+			Device:   withStrategy(UtilizationDownlinkPathRanking),
+			Expected: [][]string{{"gtw-a", "gtw-c", "gtw-b", "gtw-d"}},
+		},
+		{
+			Name:     "Preferred",
+			Device:   withStrategy(PreferredDownlinkPathRanking),
+			Expected: [][]string{{"gtw-a", "gtw-d", "gtw-b", "gtw-c"}},
+		},
+		{
+			Name:   "PreferredByDefault",
+			Device: &ttnpb.EndDevice{},
+			Defaults: &ttnpb.MACSettings{
+				DownlinkPathRanking: PreferredDownlinkPathRanking,
+			},
+			Expected: [][]string{{"gtw-a", "gtw-d", "gtw-b", "gtw-c"}},
+		},
+		{
+			Name:   "SNROverridesDefault",
+			Device: withStrategy(SNRDownlinkPathRanking),
+			Defaults: &ttnpb.MACSettings{
+				DownlinkPathRanking: PreferredDownlinkPathRanking,
+			},
+			Expected: [][]string{{"gtw-d", "gtw-a", "gtw-c", "gtw-b"}},
+		},
+		{
+			Name:   "RoundRobin",
+			Device: withStrategy(RoundRobinDownlinkPathRanking),
+			Expected: [][]string{
+				{"gtw-c", "gtw-a", "gtw-b", "gtw-d"},
+				{"gtw-a", "gtw-b", "gtw-c", "gtw-d"},
+				{"gtw-b", "gtw-c", "gtw-a", "gtw-d"},
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name: tc.Name,
+			Func: func(_ context.Context, _ *testing.T, a *assertions.Assertion) {
+				for _, expected := range tc.Expected {
+					a.So(rank(tc.Device, tc.Defaults), should.Resemble, expected)
+				}
+			},
+		})
+	}
+}
+
+func TestValidateDownlinkPathRanking(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	a.So(validateDownlinkPathRanking(nil), should.BeNil)
+	a.So(validateDownlinkPathRanking(&ttnpb.MACSettings{}), should.BeNil)
+	a.So(validateDownlinkPathRanking(&ttnpb.MACSettings{
+		DownlinkPathRanking: RoundRobinDownlinkPathRanking,
+	}), should.BeNil)
+	a.So(validateDownlinkPathRanking(&ttnpb.MACSettings{
+		DownlinkPathRanking: "unknown",
+	}), should.HaveSameErrorDefinitionAs, errDownlinkPathRanking)
+}
+
+func TestSubBandUtilization(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+	a.So(subBandUtilization(nil), should.Equal, 0)
+	a.So(subBandUtilization([]*ttnpb.GatewayConnectionStats_SubBand{
+		{DownlinkUtilizationLimit: 0.01, DownlinkUtilization: 0.005},
+		{DownlinkUtilizationLimit: 0.1, DownlinkUtilization: 0.02},
+	}), should.AlmostEqual, 0.5, 0.0001)
+}
@@ -0,0 +1,306 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bufio"
+	"context"
+	"io"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errNoExportKEKLabel = errors.DefineInvalidArgument("no_export_kek_label", "no KEK label for export")
+	errImportDuplicate  = errors.DefineInvalidArgument(
+		"import_duplicate", "end device `{device_uid}` is imported more than once",
+	)
+	errImportApplication = errors.DefineInvalidArgument(
+		"import_application", "end device `{device_uid}` is not in application `{application_uid}`",
+	)
+	errImportDecode = errors.DefineInvalidArgument("import_decode", "decode imported end devices")
+)
+
+// endDeviceImportPaths are the paths of the end device fields that are set on import.
+var endDeviceImportPaths = func() []string {
+	paths := []string{
+		"ids.application_ids",
+		"ids.dev_addr",
+		"ids.dev_eui",
+		"ids.device_id",
+		"ids.join_eui",
+	}
+	for _, p := range ttnpb.EndDeviceFieldPathsTopLevel {
+		switch p {
+		case "ids", "created_at", "updated_at":
+		default:
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}()
+
+// rewrapKeyEnvelope unwraps the key envelope and wraps the key with the KEK with the given label.
+func rewrapKeyEnvelope(
+	ctx context.Context, ks crypto.KeyService, ke *ttnpb.KeyEnvelope, kekLabel string,
+) (*ttnpb.KeyEnvelope, error) {
+	if ke == nil {
+		return nil, nil
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, ke, ks)
+	if err != nil {
+		return nil, err
+	}
+	return cryptoutil.WrapAES128Key(ctx, key, kekLabel, ks)
+}
+
+// rewrapEndDeviceKeys wraps the network session keys of the end device with the KEK with the given label.
+// The application session key is wrapped by the Join Server for the Application Server and is left as is.
+func rewrapEndDeviceKeys(ctx context.Context, ks crypto.KeyService, dev *ttnpb.EndDevice, kekLabel string) error {
+	for _, keys := range []*ttnpb.SessionKeys{
+		dev.GetSession().GetKeys(),
+		dev.GetPendingSession().GetKeys(),
+		dev.GetPendingMacState().GetQueuedJoinAccept().GetKeys(),
+	} {
+		if keys == nil {
+			continue
+		}
+		for _, ke := range []**ttnpb.KeyEnvelope{
+			&keys.FNwkSIntKey,
+			&keys.SNwkSIntKey,
+			&keys.NwkSEncKey,
+		} {
+			rewrapped, err := rewrapKeyEnvelope(ctx, ks, *ke, kekLabel)
+			if err != nil {
+				return err
+			}
+			*ke = rewrapped
+		}
+	}
+	return nil
+}
+
+// ExportEndDevices calls f for each end device of the application in the registry, or for each end device in the
+// registry if appID is nil. The exported end devices include the session, MAC state and queued downlinks, and the
+// network session keys are wrapped with the KEK with the given label.
+func ExportEndDevices(
+	ctx context.Context,
+	r DeviceRegistry,
+	ks crypto.KeyService,
+	appID *ttnpb.ApplicationIdentifiers,
+	kekLabel string,
+	f func(*ttnpb.EndDevice) error,
+) error {
+	if kekLabel == "" {
+		return errNoExportKEKLabel.New()
+	}
+	var fErr error
+	err := r.Range(
+		ctx,
+		ttnpb.EndDeviceFieldPathsTopLevel,
+		func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice) bool {
+			if appID != nil && ids.GetApplicationIds().GetApplicationId() != appID.ApplicationId {
+				return true
+			}
+			dev = ttnpb.Clone(dev)
+			if fErr = rewrapEndDeviceKeys(ctx, ks, dev, kekLabel); fErr != nil {
+				return false
+			}
+			fErr = f(dev)
+			return fErr == nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return fErr
+}
+
+// ImportEndDevices stores the end devices in the registry, wrapping the network session keys with the KEK with the
+// given label. Existing end devices are replaced if replace is set, otherwise importing them fails.
+//
+// The import is atomic: all end devices are validated before any is stored, and if storing an end device fails,
+// the end devices that were stored before are restored to their previous state.
+func ImportEndDevices(
+	ctx context.Context,
+	r DeviceRegistry,
+	ks crypto.KeyService,
+	kekLabel string,
+	replace bool,
+	devs ...*ttnpb.EndDevice,
+) error {
+	uids := make(map[string]struct{}, len(devs))
+	imports := make([]*ttnpb.EndDevice, 0, len(devs))
+	for _, dev := range devs {
+		if err := dev.GetIds().ValidateContext(ctx); err != nil {
+			return err
+		}
+		uid := unique.ID(ctx, dev.Ids)
+		if _, ok := uids[uid]; ok {
+			return errImportDuplicate.WithAttributes("device_uid", uid)
+		}
+		uids[uid] = struct{}{}
+		dev = ttnpb.Clone(dev)
+		if err := rewrapEndDeviceKeys(ctx, ks, dev, kekLabel); err != nil {
+			return err
+		}
+		imports = append(imports, dev)
+	}
+
+	type importedEndDevice struct {
+		ids      *ttnpb.EndDeviceIdentifiers
+		previous *ttnpb.EndDevice
+	}
+	imported := make([]importedEndDevice, 0, len(imports))
+	for _, dev := range imports {
+		var previous *ttnpb.EndDevice
+		_, _, err := r.SetByID(
+			ctx,
+			dev.Ids.ApplicationIds,
+			dev.Ids.DeviceId,
+			ttnpb.EndDeviceFieldPathsTopLevel,
+			func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				if stored != nil && !replace {
+					return nil, nil, errDeviceExists.New()
+				}
+				previous = stored
+				return dev, endDeviceImportPaths, nil
+			},
+		)
+		if err != nil {
+			for i := len(imported) - 1; i >= 0; i-- {
+				ids, previous := imported[i].ids, imported[i].previous
+				if _, _, err := r.SetByID(
+					ctx,
+					ids.ApplicationIds,
+					ids.DeviceId,
+					nil,
+					func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+						if previous == nil {
+							return nil, nil, nil
+						}
+						return previous, endDeviceImportPaths, nil
+					},
+				); err != nil {
+					log.FromContext(ctx).WithError(err).WithField(
+						"device_uid", unique.ID(ctx, ids),
+					).Error("Failed to restore end device after failed import")
+				}
+			}
+			return err
+		}
+		imported = append(imported, importedEndDevice{ids: dev.Ids, previous: previous})
+	}
+	return nil
+}
+
+// WriteEndDevices writes the end devices to w as newline-delimited JSON.
+func WriteEndDevices(w io.Writer, devs ...*ttnpb.EndDevice) error {
+	enc := jsonpb.TTN().NewEncoder(w)
+	for _, dev := range devs {
+		if err := enc.Encode(dev); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadEndDevices reads end devices written by WriteEndDevices from r.
+func ReadEndDevices(r io.Reader) ([]*ttnpb.EndDevice, error) {
+	dec := jsonpb.TTN().NewDecoder(bufio.NewReader(r))
+	var devs []*ttnpb.EndDevice
+	for {
+		dev := &ttnpb.EndDevice{}
+		if err := dec.Decode(dev); err != nil {
+			if errors.Is(err, io.EOF) {
+				return devs, nil
+			}
+			return nil, errImportDecode.WithCause(err)
+		}
+		devs = append(devs, dev)
+	}
+}
+
+// ExportEndDevices implements ttnpb.NsServer.
+func (ns *NetworkServer) ExportEndDevices(
+	ctx context.Context, req *ttnpb.ExportEndDevicesRequest,
+) (*ttnpb.ExportEndDevicesResponse, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.ExportEndDevicesResponse{}
+	if err := ExportEndDevices(
+		ctx, ns.devices, ns.KeyService(), req.ApplicationIds, req.KekLabel,
+		func(dev *ttnpb.EndDevice) error {
+			res.EndDevices = append(res.EndDevices, dev)
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ImportEndDevices implements ttnpb.NsServer.
+func (ns *NetworkServer) ImportEndDevices(
+	ctx context.Context, req *ttnpb.ImportEndDevicesRequest,
+) (*ttnpb.ImportEndDevicesResponse, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	for _, dev := range req.EndDevices {
+		if dev.GetIds().GetApplicationIds().GetApplicationId() != req.ApplicationIds.ApplicationId {
+			return nil, errImportApplication.WithAttributes(
+				"device_uid", unique.ID(ctx, dev.GetIds()),
+				"application_uid", unique.ID(ctx, req.ApplicationIds),
+			)
+		}
+	}
+	if err := ImportEndDevices(
+		ctx, ns.devices, ns.KeyService(), ns.deviceKEKLabel, req.Replace, req.EndDevices...,
+	); err != nil {
+		return nil, err
+	}
+	for _, dev := range req.EndDevices {
+		if dev.GetSession() == nil {
+			continue
+		}
+		if err := ns.updateDataDownlinkTask(ctx, dev, time.Time{}); err != nil {
+			log.FromContext(ctx).WithError(err).WithField(
+				"device_uid", unique.ID(ctx, dev.Ids),
+			).Warn("Failed to update downlink task queue after import")
+		}
+	}
+	return &ttnpb.ImportEndDevicesResponse{
+		Imported: uint32(len(req.EndDevices)),
+	}, nil
+}
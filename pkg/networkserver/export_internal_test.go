@@ -0,0 +1,204 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// memDeviceRegistry is a DeviceRegistry which stores end devices in memory and fails to store the end devices in fail.
+type memDeviceRegistry struct {
+	MockDeviceRegistry
+	devices map[string]*ttnpb.EndDevice
+	fail    map[string]bool
+}
+
+func (r *memDeviceRegistry) SetByID(
+	ctx context.Context,
+	appID *ttnpb.ApplicationIdentifiers,
+	devID string,
+	_ []string,
+	f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.EndDevice, context.Context, error) {
+	uid := unique.ID(ctx, &ttnpb.EndDeviceIdentifiers{ApplicationIds: appID, DeviceId: devID})
+	dev, _, err := f(ctx, ttnpb.Clone(r.devices[uid]))
+	if err != nil {
+		return nil, ctx, err
+	}
+	if r.fail[uid] {
+		return nil, ctx, errors.New("fail")
+	}
+	if dev == nil {
+		delete(r.devices, uid)
+		return nil, ctx, nil
+	}
+	r.devices[uid] = ttnpb.Clone(dev)
+	return dev, ctx, nil
+}
+
+func (r *memDeviceRegistry) Range(
+	ctx context.Context, _ []string, f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	uids := make([]string, 0, len(r.devices))
+	for uid := range r.devices {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+	for _, uid := range uids {
+		if !f(ctx, r.devices[uid].Ids, ttnpb.Clone(r.devices[uid])) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *memDeviceRegistry) deviceIDs() []string {
+	ids := make([]string, 0, len(r.devices))
+	for _, dev := range r.devices {
+		ids = append(ids, dev.Ids.DeviceId)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestExportImportEndDevices(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ks := crypto.NewKeyService(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"source": bytes.Repeat([]byte{0x01}, 16),
+		"export": bytes.Repeat([]byte{0x02}, 16),
+		"target": bytes.Repeat([]byte{0x03}, 16),
+	}))
+	key := types.AES128Key{0x42, 0xff, 0x42}
+	wrap := func(label string) *ttnpb.KeyEnvelope {
+		ke, err := cryptoutil.WrapAES128Key(ctx, key, label, ks)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ke
+	}
+	makeDevice := func(appID, devID string, session bool) *ttnpb.EndDevice {
+		dev := &ttnpb.EndDevice{
+			Ids: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: appID},
+				DeviceId:       devID,
+			},
+			FrequencyPlanId: test.EUFrequencyPlanID,
+		}
+		if session {
+			dev.Session = &ttnpb.Session{
+				DevAddr:       types.DevAddr{0x01, 0x02, 0x03, 0x04}.Bytes(),
+				LastFCntUp:    42,
+				LastNFCntDown: 7,
+				Keys: &ttnpb.SessionKeys{
+					FNwkSIntKey: wrap("source"),
+					SNwkSIntKey: wrap("source"),
+					NwkSEncKey:  wrap("source"),
+				},
+				QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+					{FPort: 1, FCnt: 1, FrmPayload: []byte{0x01}},
+				},
+			}
+		}
+		return dev
+	}
+	source := &memDeviceRegistry{devices: map[string]*ttnpb.EndDevice{}}
+	for _, dev := range []*ttnpb.EndDevice{
+		makeDevice("app-1", "dev-1", true),
+		makeDevice("app-1", "dev-2", false),
+		makeDevice("app-2", "dev-3", true),
+	} {
+		source.devices[unique.ID(ctx, dev.Ids)] = dev
+	}
+
+	a.So(ExportEndDevices(
+		ctx, source, ks, nil, "", func(*ttnpb.EndDevice) error { return nil },
+	), should.HaveSameErrorDefinitionAs, errNoExportKEKLabel)
+
+	var buf bytes.Buffer
+	var exported []*ttnpb.EndDevice
+	if !a.So(ExportEndDevices(
+		ctx, source, ks, &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"}, "export",
+		func(dev *ttnpb.EndDevice) error {
+			exported = append(exported, dev)
+			return WriteEndDevices(&buf, dev)
+		},
+	), should.BeNil) || !a.So(exported, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(exported[0].Session.Keys.FNwkSIntKey.KekLabel, should.Equal, "export")
+	a.So(exported[0].Session.Keys.NwkSEncKey.KekLabel, should.Equal, "export")
+	a.So(source.devices["app-1.dev-1"].Session.Keys.FNwkSIntKey.KekLabel, should.Equal, "source")
+
+	devs, err := ReadEndDevices(&buf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(devs, should.Resemble, exported)
+
+	target := &memDeviceRegistry{devices: map[string]*ttnpb.EndDevice{}}
+	a.So(ImportEndDevices(ctx, target, ks, "target", false, devs...), should.BeNil)
+	a.So(target.deviceIDs(), should.Resemble, []string{"dev-1", "dev-2"})
+	imported := target.devices["app-1.dev-1"]
+	a.So(imported.Session.LastFCntUp, should.Equal, 42)
+	a.So(imported.Session.QueuedApplicationDownlinks, should.HaveLength, 1)
+	a.So(imported.Session.Keys.SNwkSIntKey.KekLabel, should.Equal, "target")
+	importedKey, err := cryptoutil.UnwrapAES128Key(ctx, imported.Session.Keys.SNwkSIntKey, ks)
+	a.So(err, should.BeNil)
+	a.So(importedKey, should.Equal, key)
+
+	// Existing end devices are not replaced, and the end devices stored before the failure are removed.
+	target = &memDeviceRegistry{devices: map[string]*ttnpb.EndDevice{
+		"app-1.dev-2": makeDevice("app-1", "dev-2", false),
+	}}
+	a.So(errors.IsAlreadyExists(ImportEndDevices(ctx, target, ks, "target", false, devs...)), should.BeTrue)
+	a.So(target.deviceIDs(), should.Resemble, []string{"dev-2"})
+
+	// Replaced end devices are restored when the import fails.
+	previous := makeDevice("app-1", "dev-1", false)
+	previous.FrequencyPlanId = test.USFrequencyPlanID
+	target = &memDeviceRegistry{
+		devices: map[string]*ttnpb.EndDevice{
+			"app-1.dev-1": previous,
+		},
+		fail: map[string]bool{
+			"app-1.dev-2": true,
+		},
+	}
+	a.So(ImportEndDevices(ctx, target, ks, "target", true, devs...), should.NotBeNil)
+	a.So(target.devices["app-1.dev-1"], should.Resemble, previous)
+	a.So(target.deviceIDs(), should.Resemble, []string{"dev-1"})
+
+	// Duplicate end devices are rejected before any end device is stored.
+	target = &memDeviceRegistry{devices: map[string]*ttnpb.EndDevice{}}
+	a.So(
+		ImportEndDevices(ctx, target, ks, "target", false, devs[0], devs[0]),
+		should.HaveSameErrorDefinitionAs, errImportDuplicate,
+	)
+	a.So(target.devices, should.BeEmpty)
+}
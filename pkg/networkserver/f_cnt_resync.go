@@ -0,0 +1,591 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxFCntResyncAudit is the maximum number of frame counter resynchronizations in the audit of an end device.
+	maxFCntResyncAudit = 16
+	// fCntRolloverCandidates is the number of additional 16-bit frame counter rollovers which are tried to match the
+	// MIC of an uplink which does not match the expected frame counter.
+	fCntRolloverCandidates = 3
+)
+
+var (
+	errNoFCntRejection = errors.DefineNotFound(
+		"no_f_cnt_rejection", "no rejected frame counter",
+	)
+	errFCntResyncSessionChanged = errors.DefineFailedPrecondition(
+		"f_cnt_resync_session_changed", "session changed since the frame counter was rejected",
+	)
+	errFCntResyncDisabled = errors.DefineFailedPrecondition(
+		"f_cnt_resync_disabled", "frame counter resynchronization is disabled",
+	)
+
+	evtDesyncFCnt = events.Define(
+		"ns.up.data.f_cnt.desync", "frame counter desynchronized",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&structpb.Struct{}),
+	)
+	evtResyncFCnt = events.Define(
+		"ns.up.data.f_cnt.resync", "resynchronize frame counter",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&structpb.Struct{}),
+	)
+)
+
+// FCntRejectionReason is the reason for which the frame counter of an uplink is rejected.
+type FCntRejectionReason string
+
+const (
+	// FCntRejectionReset is the rejection of a frame counter below the last frame counter of the session.
+	FCntRejectionReset FCntRejectionReason = "reset"
+	// FCntRejectionGap is the rejection of a frame counter which exceeds the maximum frame counter gap.
+	FCntRejectionGap FCntRejectionReason = "gap"
+)
+
+// FCntRejection is an uplink of a session with a valid MIC, which is rejected because of its frame counter.
+type FCntRejection struct {
+	FCnt       uint32              `json:"f_cnt"`
+	LastFCntUp uint32              `json:"last_f_cnt_up"`
+	Reason     FCntRejectionReason `json:"reason"`
+	ReceivedAt time.Time           `json:"received_at"`
+}
+
+// FCntResyncRecord is the audit record of a frame counter resynchronization.
+type FCntResyncRecord struct {
+	// LastFCntUp is the last frame counter of the session before the resynchronization.
+	LastFCntUp uint32 `json:"last_f_cnt_up"`
+	// FCnt is the last frame counter of the session after the resynchronization.
+	FCnt            uint32              `json:"f_cnt"`
+	Reason          FCntRejectionReason `json:"reason"`
+	RejectedUplinks int                 `json:"rejected_uplinks"`
+	Automatic       bool                `json:"automatic"`
+	ResyncedAt      time.Time           `json:"resynced_at"`
+}
+
+// FCntResyncState is the frame counter resynchronization state of an end device.
+// The automatic resynchronization policy of the end device is configured in its MAC settings.
+type FCntResyncState struct {
+	// Rejections are the consecutive rejected uplinks of the current session, with increasing frame counters.
+	Rejections []*FCntRejection `json:"rejections,omitempty"`
+	// Audit are the most recent frame counter resynchronizations.
+	Audit []*FCntResyncRecord `json:"audit,omitempty"`
+}
+
+// GetRejections returns the rejections of the state, or nil if the state is nil.
+func (s *FCntResyncState) GetRejections() []*FCntRejection {
+	if s == nil {
+		return nil
+	}
+	return s.Rejections
+}
+
+// FCntResyncRegistry stores the frame counter resynchronization state of end devices.
+type FCntResyncRegistry interface {
+	// Get returns the state of the end device, or nil if it has no state.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*FCntResyncState, error)
+	// Update atomically updates the state of the end device using f, which is called with the stored state, or nil if
+	// the end device has no state, and returns the updated state. A nil state returned by f deletes the state.
+	// The state expires after ttl.
+	Update(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		ttl time.Duration,
+		f func(*FCntResyncState) (*FCntResyncState, error),
+	) (*FCntResyncState, error)
+}
+
+type fCntResync struct {
+	FCntResyncConfig
+}
+
+func newFCntResync(conf FCntResyncConfig) (*fCntResync, error) {
+	if conf.Registry == nil {
+		return nil, errInvalidConfiguration.WithCause(errors.New("FCntResync.Registry is not specified"))
+	}
+	if conf.Threshold == 0 {
+		return nil, errInvalidConfiguration.WithCause(errors.New("FCntResync.Threshold must be positive"))
+	}
+	if conf.TTL < conf.Window {
+		return nil, errInvalidConfiguration.WithCause(errors.New("FCntResync.TTL is shorter than FCntResync.Window"))
+	}
+	return &fCntResync{
+		FCntResyncConfig: conf,
+	}, nil
+}
+
+// observe records the rejection in the state, and returns whether the frame counter became desynchronized.
+// Rejections of another session, rejections outside the window and rejections of which the frame counter does not
+// increase are discarded, so that replayed uplinks do not desynchronize the frame counter.
+func (c *fCntResync) observe(state *FCntResyncState, rejection *FCntRejection) bool {
+	wasDesynchronized := len(state.Rejections) >= int(c.Threshold)
+	rejections := state.Rejections[:0:0]
+	for _, r := range state.Rejections {
+		if r.LastFCntUp != rejection.LastFCntUp || rejection.ReceivedAt.Sub(r.ReceivedAt) > c.Window {
+			continue
+		}
+		rejections = append(rejections, r)
+	}
+	if n := len(rejections); n > 0 && rejection.FCnt <= rejections[n-1].FCnt {
+		rejections, wasDesynchronized = rejections[:0], false
+	}
+	rejections = append(rejections, rejection)
+	if n := len(rejections); n > int(c.Threshold) {
+		rejections = rejections[n-int(c.Threshold):]
+	}
+	state.Rejections = rejections
+	return !wasDesynchronized && len(rejections) >= int(c.Threshold)
+}
+
+// fCntCandidates returns the frame counters, other than fCnt, which the 16-bit frame counter of pld may represent
+// after a reset or additional rollovers of the frame counter.
+func fCntCandidates(pld *ttnpb.MACPayload, fCnt uint32, supports32BitFCnt bool) []uint32 {
+	var candidates []uint32
+	if pld.FHdr.FCnt != fCnt {
+		candidates = append(candidates, pld.FHdr.FCnt)
+	}
+	if !supports32BitFCnt {
+		return candidates
+	}
+	for i := uint32(1); i <= fCntRolloverCandidates; i++ {
+		candidate := fCnt + i<<16
+		if candidate < fCnt {
+			break
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates
+}
+
+// deviceAutoFCntResync returns whether the frame counter of the end device is resynchronized automatically when it is
+// desynchronized.
+func deviceAutoFCntResync(dev *ttnpb.EndDevice, defaults *ttnpb.MACSettings) bool {
+	if dev.GetMacSettings().GetAutoFCntResync() != nil {
+		return dev.MacSettings.AutoFCntResync.Value
+	}
+	return defaults.GetAutoFCntResync().GetValue()
+}
+
+// fCntRejectionCandidate is a session of which the MIC of a data uplink may match a frame counter which is rejected.
+type fCntRejectionCandidate struct {
+	ids            *ttnpb.EndDeviceIdentifiers
+	lorawanVersion ttnpb.MACVersion
+	fNwkSIntKey    types.AES128Key
+	lastFCnt       uint32
+	// fCnts are the frame counters of which the MIC is computed.
+	fCnts []uint32
+}
+
+// fCntRejectionUplink is a data uplink of which the frame counter may be rejected for one of the candidates.
+type fCntRejectionUplink struct {
+	up         *ttnpb.UplinkMessage
+	candidates []*fCntRejectionCandidate
+}
+
+// newFCntRejectionCandidate returns the candidate of the data uplink up, of which the MIC does not match the expected
+// frame counter fCnt of the session of match, or nil if frame counter resynchronization does not apply.
+func (ns *NetworkServer) newFCntRejectionCandidate(
+	match *UplinkMatch, fNwkSIntKey types.AES128Key, fCnt uint32, up *ttnpb.UplinkMessage,
+) *fCntRejectionCandidate {
+	if ns.fCntResync == nil || match.IsPending {
+		return nil
+	}
+	supports32BitFCnt := mac.DeviceSupports32BitFCnt(&ttnpb.EndDevice{
+		MacSettings: &ttnpb.MACSettings{
+			Supports_32BitFCnt: match.Supports32BitFCnt,
+		},
+	}, ns.defaultMACSettings)
+	fCnts := fCntCandidates(up.Payload.GetMacPayload(), fCnt, supports32BitFCnt)
+	if len(fCnts) == 0 {
+		return nil
+	}
+	return &fCntRejectionCandidate{
+		ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: match.ApplicationIdentifiers,
+			DeviceId:       match.DeviceID,
+		},
+		lorawanVersion: match.LoRaWANVersion,
+		fNwkSIntKey:    fNwkSIntKey,
+		lastFCnt:       match.LastFCnt,
+		fCnts:          fCnts,
+	}
+}
+
+// enqueueFCntRejectionUplink enqueues the data uplink up for the detection of rejected frame counters of the candidates.
+func (ns *NetworkServer) enqueueFCntRejectionUplink(
+	ctx context.Context, up *ttnpb.UplinkMessage, candidates ...*fCntRejectionCandidate,
+) {
+	if ns.fCntResync == nil || len(candidates) == 0 {
+		return
+	}
+	if err := ns.fCntResyncPool.Publish(ns.FromRequestContext(ctx), &fCntRejectionUplink{
+		up:         up,
+		candidates: candidates,
+	}); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to enqueue uplink for frame counter resynchronization")
+	}
+}
+
+// recordFCntRejection enqueues the data uplink up, of which the MIC matches the frame counter res.FullFCnt of the
+// session of dev, which is rejected. The full MIC of LoRaWAN 1.1 uplinks is verified when the uplink is handled.
+func (ns *NetworkServer) recordFCntRejection(
+	ctx context.Context, dev *ttnpb.EndDevice, res cmacFMatchingResult, up *ttnpb.UplinkMessage,
+) {
+	if ns.fCntResync == nil || res.IsPending {
+		return
+	}
+	ns.enqueueFCntRejectionUplink(ctx, up, &fCntRejectionCandidate{
+		ids:            dev.Ids,
+		lorawanVersion: res.LoRaWANVersion,
+		fNwkSIntKey:    res.FNwkSIntKey,
+		lastFCnt:       res.LastFCnt,
+		fCnts:          []uint32{res.FullFCnt},
+	})
+}
+
+// handleFCntRejectionUplink detects whether the MIC of the uplink matches a rejected frame counter of one of the
+// candidates, and handles the rejection.
+func (ns *NetworkServer) handleFCntRejectionUplink(ctx context.Context, item *fCntRejectionUplink) {
+	// The MIC computations are not counted in the match statistics of the uplink, which are flushed already.
+	ctx = context.WithValue(ctx, matchStatsKey, &matchStats{})
+	for _, c := range item.candidates {
+		for _, fCnt := range c.fCnts {
+			cmacF, ok := matchCmacF(ctx, c.fNwkSIntKey, c.lorawanVersion, fCnt, item.up)
+			if !ok {
+				continue
+			}
+			dev, ctx, err := ns.devices.GetByID(ctx, c.ids.ApplicationIds, c.ids.DeviceId, handleDataUplinkGetPaths[:])
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to get device with rejected frame counter")
+				return
+			}
+			if !ns.verifyFCntRejection(ctx, dev, c, fCnt, cmacF, item.up) {
+				return
+			}
+			reason := FCntRejectionGap
+			if fCnt < c.lastFCnt {
+				reason = FCntRejectionReset
+			}
+			ns.handleFCntRejection(ctx, dev, &FCntRejection{
+				FCnt:       fCnt,
+				LastFCntUp: c.lastFCnt,
+				Reason:     reason,
+				ReceivedAt: *ttnpb.StdTime(item.up.ReceivedAt),
+			})
+			return
+		}
+	}
+}
+
+// verifyFCntRejection returns whether the frame counter fCnt of the data uplink up, of which cmacF matches the session of
+// the candidate c, is rejected for dev. Only ABP end devices are resynchronized if they use LoRaWAN 1.1, of which the
+// full MIC must match.
+func (ns *NetworkServer) verifyFCntRejection(
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	c *fCntRejectionCandidate,
+	fCnt uint32,
+	cmacF [4]byte,
+	up *ttnpb.UplinkMessage,
+) bool {
+	if dev.Session == nil || dev.MacState == nil || dev.Session.LastFCntUp != c.lastFCnt {
+		return false
+	}
+	if macspec.UseLegacyMIC(c.lorawanVersion) {
+		return true
+	}
+	if dev.SupportsJoin {
+		return false
+	}
+	logger := log.FromContext(ctx)
+	sNwkSIntKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.Keys.SNwkSIntKey, ns.KeyService())
+	if err != nil {
+		logger.WithError(err).Warn("Failed to unwrap SNwkSIntKey")
+		return false
+	}
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get frequency plans store")
+		return false
+	}
+	phy, err := internal.DeviceBand(dev, fps)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get device's versioned band")
+		return false
+	}
+	drIdx, _, ok := phy.FindUplinkDataRate(up.Settings.DataRate)
+	if !ok {
+		return false
+	}
+	chIdx, err := searchUplinkChannel(up.Settings.Frequency, dev.MacState)
+	if err != nil {
+		return false
+	}
+	pld := up.Payload.GetMacPayload()
+	var confFCnt uint32
+	if pld.FHdr.FCtrl.Ack {
+		confFCnt = dev.Session.LastConfFCntDown
+	}
+	fullMIC, err := crypto.ComputeUplinkMICFromLegacy(
+		cmacF,
+		sNwkSIntKey,
+		confFCnt,
+		uint8(drIdx),
+		chIdx,
+		types.MustDevAddr(pld.FHdr.DevAddr).OrZero(),
+		fCnt,
+		up.RawPayload[:len(up.RawPayload)-4],
+	)
+	if err != nil {
+		logger.WithError(err).Error("Failed to compute 1.1 MIC")
+		return false
+	}
+	return bytes.Equal(up.Payload.Mic, fullMIC[:])
+}
+
+func fCntRejectionEventData(rejection *FCntRejection, rejectedUplinks int) *structpb.Struct {
+	return &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"f_cnt":            structpb.NewNumberValue(float64(rejection.FCnt)),
+			"last_f_cnt_up":    structpb.NewNumberValue(float64(rejection.LastFCntUp)),
+			"reason":           structpb.NewStringValue(string(rejection.Reason)),
+			"rejected_uplinks": structpb.NewNumberValue(float64(rejectedUplinks)),
+		},
+	}
+}
+
+// handleFCntRejection records the rejection of the frame counter of an uplink of dev, and resynchronizes the frame
+// counter if it is desynchronized and the end device has the automatic resynchronization policy.
+func (ns *NetworkServer) handleFCntRejection(ctx context.Context, dev *ttnpb.EndDevice, rejection *FCntRejection) {
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"f_cnt_rejection_reason", rejection.Reason,
+		"rejected_f_cnt", rejection.FCnt,
+	))
+	logger.Debug("Frame counter of uplink with valid MIC rejected")
+	var desynchronized bool
+	state, err := ns.fCntResync.Registry.Update(ctx, dev.Ids, ns.fCntResync.TTL,
+		func(state *FCntResyncState) (*FCntResyncState, error) {
+			if state == nil {
+				state = &FCntResyncState{}
+			}
+			desynchronized = ns.fCntResync.observe(state, rejection)
+			return state, nil
+		},
+	)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to update frame counter resynchronization state")
+		return
+	}
+	if !desynchronized {
+		return
+	}
+	logger.Info("Frame counter desynchronized")
+	events.Publish(evtDesyncFCnt.NewWithIdentifiersAndData(
+		ctx, dev.Ids, fCntRejectionEventData(rejection, len(state.Rejections)),
+	))
+	if !deviceAutoFCntResync(dev, ns.defaultMACSettings) {
+		return
+	}
+	if _, err := ns.resyncFCnt(ctx, dev.Ids, state.Rejections, true); err != nil {
+		logger.WithError(err).Warn("Failed to resynchronize frame counter")
+	}
+}
+
+// resyncFCnt resynchronizes the frame counter of the session of the end device to the frame counter of the last of the
+// rejections, and records the resynchronization in the audit of the state. The MAC state is reset if the frame counter
+// was reset. Replays of the rejected uplinks remain rejected, as the frame counter must increase after
+// resynchronization.
+func (ns *NetworkServer) resyncFCnt(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, rejections []*FCntRejection, automatic bool,
+) (*FCntResyncState, error) {
+	if len(rejections) == 0 {
+		return nil, errNoFCntRejection.New()
+	}
+	last := rejections[len(rejections)-1]
+	if _, _, err := ns.devices.SetByID(ctx, ids.ApplicationIds, ids.DeviceId, handleDataUplinkGetPaths[:],
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil {
+				return nil, nil, errDeviceNotFound.New()
+			}
+			if stored.Session == nil || stored.Session.LastFCntUp != last.LastFCntUp {
+				return nil, nil, errFCntResyncSessionChanged.New()
+			}
+			stored.Session.LastFCntUp = last.FCnt
+			paths := []string{"session.last_f_cnt_up"}
+			if last.Reason == FCntRejectionReset {
+				fps, err := ns.FrequencyPlansStore(ctx)
+				if err != nil {
+					return nil, nil, err
+				}
+				macState, err := mac.NewState(stored, fps, ns.defaultMACSettings)
+				if err != nil {
+					return nil, nil, err
+				}
+				stored.MacState = macState
+				stored.Session.StartedAt = timestamppb.New(last.ReceivedAt)
+				paths = ttnpb.AddFields(paths, "mac_state", "session.started_at")
+			}
+			return stored, paths, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	record := &FCntResyncRecord{
+		LastFCntUp:      last.LastFCntUp,
+		FCnt:            last.FCnt,
+		Reason:          last.Reason,
+		RejectedUplinks: len(rejections),
+		Automatic:       automatic,
+		ResyncedAt:      time.Now(),
+	}
+	data := fCntRejectionEventData(last, record.RejectedUplinks)
+	data.Fields["automatic"] = structpb.NewBoolValue(automatic)
+	events.Publish(evtResyncFCnt.NewWithIdentifiersAndData(ctx, ids, data))
+	return ns.fCntResync.Registry.Update(ctx, ids, ns.fCntResync.TTL,
+		func(state *FCntResyncState) (*FCntResyncState, error) {
+			if state == nil {
+				state = &FCntResyncState{}
+			}
+			// The rejections of the session before the resynchronization no longer apply.
+			rejections := state.Rejections[:0:0]
+			for _, r := range state.Rejections {
+				if r.LastFCntUp != record.LastFCntUp {
+					rejections = append(rejections, r)
+				}
+			}
+			state.Rejections = rejections
+			state.Audit = append(state.Audit, record)
+			if n := len(state.Audit); n > maxFCntResyncAudit {
+				state.Audit = state.Audit[n-maxFCntResyncAudit:]
+			}
+			return state, nil
+		},
+	)
+}
+
+func (r FCntRejectionReason) proto() ttnpb.FCntRejection_Reason {
+	if r == FCntRejectionGap {
+		return ttnpb.FCntRejection_REASON_GAP
+	}
+	return ttnpb.FCntRejection_REASON_RESET
+}
+
+func (r *FCntRejection) proto() *ttnpb.FCntRejection {
+	return &ttnpb.FCntRejection{
+		FCnt:       r.FCnt,
+		LastFCntUp: r.LastFCntUp,
+		Reason:     r.Reason.proto(),
+		ReceivedAt: timestamppb.New(r.ReceivedAt),
+	}
+}
+
+func (r *FCntResyncRecord) proto() *ttnpb.FCntResyncRecord {
+	return &ttnpb.FCntResyncRecord{
+		LastFCntUp:      r.LastFCntUp,
+		FCnt:            r.FCnt,
+		Reason:          r.Reason.proto(),
+		RejectedUplinks: uint32(r.RejectedUplinks),
+		Automatic:       r.Automatic,
+		ResyncedAt:      timestamppb.New(r.ResyncedAt),
+	}
+}
+
+func (s *FCntResyncState) proto(
+	ids *ttnpb.EndDeviceIdentifiers, threshold uint32, autoResync bool,
+) *ttnpb.EndDeviceFCntResync {
+	pb := &ttnpb.EndDeviceFCntResync{
+		EndDeviceIds: ids,
+		AutoResync:   autoResync,
+	}
+	if s == nil {
+		return pb
+	}
+	pb.Desynchronized = len(s.Rejections) >= int(threshold)
+	for _, r := range s.Rejections {
+		pb.Rejections = append(pb.Rejections, r.proto())
+	}
+	for _, r := range s.Audit {
+		pb.Audit = append(pb.Audit, r.proto())
+	}
+	return pb
+}
+
+// fCntResyncProto returns the state of the end device with its automatic resynchronization policy.
+func (ns *NetworkServer) fCntResyncProto(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, state *FCntResyncState,
+) (*ttnpb.EndDeviceFCntResync, error) {
+	dev, _, err := ns.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{"mac_settings.auto_f_cnt_resync"})
+	if err != nil {
+		return nil, err
+	}
+	return state.proto(ids, ns.fCntResync.Threshold, deviceAutoFCntResync(dev, ns.defaultMACSettings)), nil
+}
+
+// GetEndDeviceFCntResync implements ttnpb.NsServer.
+func (ns *NetworkServer) GetEndDeviceFCntResync(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDeviceFCntResync, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if ns.fCntResync == nil {
+		return nil, errFCntResyncDisabled.New()
+	}
+	state, err := ns.fCntResync.Registry.Get(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return ns.fCntResyncProto(ctx, ids, state)
+}
+
+// ResyncEndDeviceFCnt implements ttnpb.NsServer.
+func (ns *NetworkServer) ResyncEndDeviceFCnt(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDeviceFCntResync, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if ns.fCntResync == nil {
+		return nil, errFCntResyncDisabled.New()
+	}
+	state, err := ns.fCntResync.Registry.Get(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if state, err = ns.resyncFCnt(ctx, ids, state.GetRejections(), false); err != nil {
+		return nil, err
+	}
+	return ns.fCntResyncProto(ctx, ids, state)
+}
@@ -0,0 +1,185 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFCntResyncObserve(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	rejection := func(fCnt, lastFCntUp uint32, d time.Duration) *FCntRejection {
+		return &FCntRejection{
+			FCnt:       fCnt,
+			LastFCntUp: lastFCntUp,
+			Reason:     FCntRejectionReset,
+			ReceivedAt: now.Add(d),
+		}
+	}
+	for _, tc := range []struct {
+		Name                   string
+		Rejections             []*FCntRejection
+		Rejection              *FCntRejection
+		ExpectedRejections     []*FCntRejection
+		ExpectedDesynchronized bool
+	}{
+		{
+			Name:               "first rejection",
+			Rejection:          rejection(1, 4200, 0),
+			ExpectedRejections: []*FCntRejection{rejection(1, 4200, 0)},
+		},
+		{
+			Name:                   "threshold reached",
+			Rejections:             []*FCntRejection{rejection(1, 4200, 0), rejection(2, 4200, time.Minute)},
+			Rejection:              rejection(3, 4200, 2*time.Minute),
+			ExpectedRejections:     []*FCntRejection{rejection(1, 4200, 0), rejection(2, 4200, time.Minute), rejection(3, 4200, 2*time.Minute)},
+			ExpectedDesynchronized: true,
+		},
+		{
+			Name:               "already desynchronized",
+			Rejections:         []*FCntRejection{rejection(1, 4200, 0), rejection(2, 4200, time.Minute), rejection(3, 4200, 2*time.Minute)},
+			Rejection:          rejection(4, 4200, 3*time.Minute),
+			ExpectedRejections: []*FCntRejection{rejection(2, 4200, time.Minute), rejection(3, 4200, 2*time.Minute), rejection(4, 4200, 3*time.Minute)},
+		},
+		{
+			Name:               "replay",
+			Rejections:         []*FCntRejection{rejection(1, 4200, 0), rejection(2, 4200, time.Minute)},
+			Rejection:          rejection(2, 4200, 2*time.Minute),
+			ExpectedRejections: []*FCntRejection{rejection(2, 4200, 2*time.Minute)},
+		},
+		{
+			Name:               "outside window",
+			Rejections:         []*FCntRejection{rejection(1, 4200, -2*time.Hour), rejection(2, 4200, time.Minute)},
+			Rejection:          rejection(3, 4200, 2*time.Minute),
+			ExpectedRejections: []*FCntRejection{rejection(2, 4200, time.Minute), rejection(3, 4200, 2*time.Minute)},
+		},
+		{
+			Name:               "session changed",
+			Rejections:         []*FCntRejection{rejection(1, 4200, 0), rejection(2, 4200, time.Minute)},
+			Rejection:          rejection(3, 4300, 2*time.Minute),
+			ExpectedRejections: []*FCntRejection{rejection(3, 4300, 2*time.Minute)},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				c := &fCntResync{
+					FCntResyncConfig: FCntResyncConfig{
+						Threshold: 3,
+						Window:    time.Hour,
+					},
+				}
+				state := &FCntResyncState{
+					Rejections: tc.Rejections,
+				}
+				a.So(c.observe(state, tc.Rejection), should.Equal, tc.ExpectedDesynchronized)
+				a.So(state.Rejections, should.Resemble, tc.ExpectedRejections)
+			},
+		})
+	}
+}
+
+func TestFCntCandidates(t *testing.T) {
+	a := assertions.New(t)
+	pld := &ttnpb.MACPayload{
+		FHdr: &ttnpb.FHDR{
+			FCnt: 0x2a,
+		},
+	}
+	a.So(fCntCandidates(pld, 0x1002a, true), should.Resemble, []uint32{0x2a, 0x2002a, 0x3002a, 0x4002a})
+	a.So(fCntCandidates(pld, 0x2a, true), should.Resemble, []uint32{0x1002a, 0x2002a, 0x3002a})
+	a.So(fCntCandidates(pld, 0x2a, false), should.BeEmpty)
+	a.So(fCntCandidates(pld, 0xfffe002a, true), should.Resemble, []uint32{0x2a, 0xffff002a})
+}
+
+func TestDeviceAutoFCntResync(t *testing.T) {
+	a := assertions.New(t)
+	enabled := &ttnpb.MACSettings{AutoFCntResync: &ttnpb.BoolValue{Value: true}}
+	disabled := &ttnpb.MACSettings{AutoFCntResync: &ttnpb.BoolValue{Value: false}}
+	a.So(deviceAutoFCntResync(&ttnpb.EndDevice{}, nil), should.BeFalse)
+	a.So(deviceAutoFCntResync(&ttnpb.EndDevice{}, enabled), should.BeTrue)
+	a.So(deviceAutoFCntResync(&ttnpb.EndDevice{MacSettings: disabled}, enabled), should.BeFalse)
+	a.So(deviceAutoFCntResync(&ttnpb.EndDevice{MacSettings: enabled}, disabled), should.BeTrue)
+}
+
+func TestFCntResyncStateProto(t *testing.T) {
+	a := assertions.New(t)
+	now := time.Unix(1700000000, 0).UTC()
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	a.So((*FCntResyncState)(nil).proto(ids, 2, true), should.Resemble, &ttnpb.EndDeviceFCntResync{
+		EndDeviceIds: ids,
+		AutoResync:   true,
+	})
+	state := &FCntResyncState{
+		Rejections: []*FCntRejection{
+			{FCnt: 2, LastFCntUp: 4200, Reason: FCntRejectionReset, ReceivedAt: now},
+			{FCnt: 3, LastFCntUp: 4200, Reason: FCntRejectionReset, ReceivedAt: now.Add(time.Minute)},
+		},
+		Audit: []*FCntResyncRecord{
+			{
+				LastFCntUp:      100,
+				FCnt:            70000,
+				Reason:          FCntRejectionGap,
+				RejectedUplinks: 3,
+				Automatic:       true,
+				ResyncedAt:      now.Add(-time.Hour),
+			},
+		},
+	}
+	expected := &ttnpb.EndDeviceFCntResync{
+		EndDeviceIds:   ids,
+		Desynchronized: true,
+		Rejections: []*ttnpb.FCntRejection{
+			{
+				FCnt:       2,
+				LastFCntUp: 4200,
+				Reason:     ttnpb.FCntRejection_REASON_RESET,
+				ReceivedAt: timestamppb.New(now),
+			},
+			{
+				FCnt:       3,
+				LastFCntUp: 4200,
+				Reason:     ttnpb.FCntRejection_REASON_RESET,
+				ReceivedAt: timestamppb.New(now.Add(time.Minute)),
+			},
+		},
+		Audit: []*ttnpb.FCntResyncRecord{
+			{
+				LastFCntUp:      100,
+				FCnt:            70000,
+				Reason:          ttnpb.FCntRejection_REASON_GAP,
+				RejectedUplinks: 3,
+				Automatic:       true,
+				ResyncedAt:      timestamppb.New(now.Add(-time.Hour)),
+			},
+		},
+	}
+	a.So(state.proto(ids, 2, false), should.Resemble, expected)
+	expected.Desynchronized = false
+	a.So(state.proto(ids, 3, false), should.Resemble, expected)
+}
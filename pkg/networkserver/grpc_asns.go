@@ -61,10 +61,8 @@ const (
 	applicationUplinkLimit             = 100
 )
 
-func (ns *NetworkServer) sendApplicationUplinks(ctx context.Context, cl ttnpb.NsAsClient, ups ...*ttnpb.ApplicationUp) error {
-	if _, err := cl.HandleUplink(ctx, &ttnpb.NsAsHandleUplinkRequest{
-		ApplicationUps: ups,
-	}, ns.WithClusterAuth()); err != nil {
+func (ns *NetworkServer) sendApplicationUplinks(ctx context.Context, ups ...*ttnpb.ApplicationUp) error {
+	if err := ns.applicationServers.handleUplink(ctx, ups); err != nil {
 		log.FromContext(ctx).WithError(err).Warn("Failed to send application uplinks")
 		return err
 	}
@@ -98,13 +96,7 @@ func (ns *NetworkServer) createProcessApplicationUplinkTask(consumerID string) f
 func (ns *NetworkServer) processApplicationUplinkTask(ctx context.Context, consumerID string) error {
 	return ns.applicationUplinks.Pop(ctx, consumerID, applicationUplinkLimit,
 		func(ctx context.Context, ups []*ttnpb.ApplicationUp) error {
-			conn, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
-			if err != nil {
-				log.FromContext(ctx).WithError(err).Warn("Failed to get Application Server peer")
-				return err
-			}
-			cl := ttnpb.NewNsAsClient(conn)
-			if err := ns.sendApplicationUplinks(ctx, cl, ups...); err != nil {
+			if err := ns.sendApplicationUplinks(ctx, ups...); err != nil {
 				log.FromContext(ctx).WithError(err).Warn("Failed to send application uplinks")
 				if !retryableUplinkError(err) {
 					return nil
@@ -170,6 +162,7 @@ func matchApplicationDownlinks(session *ttnpb.Session, macState *ttnpb.MACState,
 		return unmatched, errUnknownMACState.New()
 	}
 
+	now := time.Now()
 	for _, down := range downs {
 		switch {
 		case len(down.FrmPayload) > int(maxDownLen):
@@ -187,7 +180,7 @@ func matchApplicationDownlinks(session *ttnpb.Session, macState *ttnpb.MACState,
 		case multicast && len(down.GetClassBC().GetGateways()) == 0:
 			return unmatched, errNoPath.New()
 
-		case down.GetClassBC().GetAbsoluteTime() != nil && ttnpb.StdTime(down.GetClassBC().GetAbsoluteTime()).Before(time.Now().Add(absoluteTimeSchedulingDelay/2)):
+		case down.GetClassBC().GetAbsoluteTime() != nil && ttnpb.StdTime(down.GetClassBC().GetAbsoluteTime()).Before(now.Add(absoluteTimeSchedulingDelay/2)):
 			return unmatched, errExpiredDownlink.New()
 		}
 		minFCnt = down.FCnt + 1
@@ -276,12 +269,36 @@ func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.Do
 
 	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, req.EndDeviceIds))
 
+	if err := resolveApplicationDownlinkExpiries(time.Now(), req.Downlinks...); err != nil {
+		return nil, err
+	}
+
+	if s, err := ns.homeHandoverSession(ctx, req.EndDeviceIds); err != nil {
+		return nil, err
+	} else if s != nil {
+		// The session is served by the serving Network Server, so the downlinks cannot be queued here.
+		if err := ns.forwardHandoverDownlinks(ctx, s, req.Downlinks...); err != nil {
+			return nil, err
+		}
+		return ttnpb.Empty, nil
+	}
+
 	gets := deviceDownlinkBasePaths[:]
 	if len(req.Downlinks) > 0 {
 		gets = deviceDownlinkFullPaths[:]
 	}
 
+	if len(req.Downlinks) > 0 {
+		if err := ns.checkDownlinkQueueBudget(ctx, req.EndDeviceIds); err != nil {
+			return nil, err
+		}
+		if err := ns.selectMulticastDownlinkGateways(ctx, req.EndDeviceIds, req.Downlinks...); err != nil {
+			return nil, err
+		}
+	}
+
 	log.FromContext(ctx).WithField("downlink_count", len(req.Downlinks)).Debug("Replace downlink queue")
+	var expiredUps []*ttnpb.ApplicationUp
 	dev, ctx, err := ns.devices.SetByID(ctx, req.EndDeviceIds.ApplicationIds, req.EndDeviceIds.DeviceId, gets,
 		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if dev == nil {
@@ -300,6 +317,7 @@ func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.Do
 			if err := matchQueuedApplicationDownlinks(ctx, dev, fps, req.Downlinks...); err != nil {
 				return nil, nil, err
 			}
+			expiredUps = expireQueuedApplicationDownlinks(ctx, dev, time.Now())
 			if len(dev.Session.GetQueuedApplicationDownlinks()) > ns.downlinkQueueCapacity || len(dev.PendingSession.GetQueuedApplicationDownlinks()) > ns.downlinkQueueCapacity {
 				return nil, nil, errDownlinkQueueCapacity.New()
 			}
@@ -319,6 +337,7 @@ func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.Do
 		"pending_session_queue_length", len(dev.PendingSession.GetQueuedApplicationDownlinks()),
 	))
 	log.FromContext(ctx).Debug("Replaced application downlink queue")
+	ns.submitApplicationUplinks(ctx, expiredUps...)
 
 	if len(req.Downlinks) > 0 {
 		if err := ns.updateDataDownlinkTask(ctx, dev, time.Time{}); err != nil {
@@ -340,9 +359,41 @@ func (ns *NetworkServer) DownlinkQueuePush(ctx context.Context, req *ttnpb.Downl
 
 	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, req.EndDeviceIds))
 
-	log.FromContext(ctx).WithField("downlink_count", len(req.Downlinks)).Debug("Push application downlink to queue")
+	if err := resolveApplicationDownlinkExpiries(time.Now(), req.Downlinks...); err != nil {
+		return nil, err
+	}
+
+	if s, err := ns.homeHandoverSession(ctx, req.EndDeviceIds); err != nil {
+		return nil, err
+	} else if s != nil {
+		// The session is served by the serving Network Server, so the downlinks cannot be queued here.
+		if err := ns.forwardHandoverDownlinks(ctx, s, req.Downlinks...); err != nil {
+			return nil, err
+		}
+		return ttnpb.Empty, nil
+	}
+
+	if err := ns.pushApplicationDownlinks(ctx, req.EndDeviceIds, req.Downlinks...); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// pushApplicationDownlinks pushes the application downlinks to the downlink queue of the end device.
+func (ns *NetworkServer) pushApplicationDownlinks(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, downs ...*ttnpb.ApplicationDownlink,
+) error {
+	if err := ns.checkDownlinkQueueBudget(ctx, ids); err != nil {
+		return err
+	}
+	if err := ns.selectMulticastDownlinkGateways(ctx, ids, downs...); err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).WithField("downlink_count", len(downs)).Debug("Push application downlink to queue")
+	var expiredUps []*ttnpb.ApplicationUp
 	dev, ctx, err := ns.devices.SetByID(
-		ctx, req.EndDeviceIds.ApplicationIds, req.EndDeviceIds.DeviceId, deviceDownlinkFullPaths[:],
+		ctx, ids.ApplicationIds, ids.DeviceId, deviceDownlinkFullPaths[:],
 		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if dev == nil {
 				return nil, nil, errDeviceNotFound.New()
@@ -351,9 +402,10 @@ func (ns *NetworkServer) DownlinkQueuePush(ctx context.Context, req *ttnpb.Downl
 			if err != nil {
 				return nil, nil, err
 			}
-			if err := matchQueuedApplicationDownlinks(ctx, dev, fps, req.Downlinks...); err != nil {
+			if err := matchQueuedApplicationDownlinks(ctx, dev, fps, downs...); err != nil {
 				return nil, nil, err
 			}
+			expiredUps = expireQueuedApplicationDownlinks(ctx, dev, time.Now())
 			if len(dev.Session.GetQueuedApplicationDownlinks()) > ns.downlinkQueueCapacity || len(dev.PendingSession.GetQueuedApplicationDownlinks()) > ns.downlinkQueueCapacity {
 				return nil, nil, errDownlinkQueueCapacity.New()
 			}
@@ -365,7 +417,7 @@ func (ns *NetworkServer) DownlinkQueuePush(ctx context.Context, req *ttnpb.Downl
 	)
 	if err != nil {
 		logRegistryRPCError(ctx, err, "Failed to push application downlink to queue")
-		return nil, err
+		return err
 	}
 
 	ctx = log.NewContextWithFields(ctx, log.Fields(
@@ -373,11 +425,12 @@ func (ns *NetworkServer) DownlinkQueuePush(ctx context.Context, req *ttnpb.Downl
 		"pending_session_queue_length", len(dev.PendingSession.GetQueuedApplicationDownlinks()),
 	))
 	log.FromContext(ctx).Debug("Pushed application downlink to queue")
+	ns.submitApplicationUplinks(ctx, expiredUps...)
 
 	if err := ns.updateDataDownlinkTask(ctx, dev, time.Time{}); err != nil {
 		log.FromContext(ctx).WithError(err).Error("Failed to update downlink task queue after downlink queue push")
 	}
-	return ttnpb.Empty, nil
+	return nil
 }
 
 // DownlinkQueueList is called by the Application Server to get the current state of the downlink queue for a device.
@@ -1136,6 +1136,7 @@ var (
 
 	adrSettingsFields = []string{
 		"mac_settings.adr.mode.disabled",
+		"mac_settings.adr.mode.dynamic.algorithm",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode.lora_narrow",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode",
@@ -1208,6 +1209,7 @@ var (
 	}
 
 	dynamicADRSettingsFields = []string{
+		"mac_settings.adr.mode.dynamic.algorithm",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode.lora_narrow",
 		"mac_settings.adr.mode.dynamic.channel_steering.mode",
@@ -1335,6 +1337,22 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 	); err != nil {
 		return nil, err
 	}
+	if err := st.ValidateSetFieldWithCause(
+		func() error {
+			return validateADRAlgorithm(st.Device.GetMacSettings())
+		},
+		"mac_settings.adr.mode.dynamic.algorithm",
+	); err != nil {
+		return nil, err
+	}
+	if err := st.ValidateSetFieldWithCause(
+		func() error {
+			return validateDownlinkPathRanking(st.Device.GetMacSettings())
+		},
+		"mac_settings.downlink_path_ranking",
+	); err != nil {
+		return nil, err
+	}
 	if err := st.ValidateSetFieldWithCause(
 		func() error {
 			if st.Device.PendingMacState == nil {
@@ -28,6 +28,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
@@ -166,6 +167,149 @@ func makeDeferredMACHandler(dev *ttnpb.EndDevice, f macHandler) macHandler {
 	}
 }
 
+// handleUplinkMACCommands handles the MAC commands cmds sent by dev in uplink up with full frame counter fCnt.
+// Handlers, which require the deduplicated uplink, are returned as deferred MAC handlers, unless deduplicated is set.
+// handleUplinkMACCommands returns the queued event builders, deferred MAC handlers and the end device paths set.
+func (ns *NetworkServer) handleUplinkMACCommands(
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	up *ttnpb.UplinkMessage,
+	cmds []*ttnpb.MACCommand,
+	fps *frequencyplans.Store,
+	fCnt uint32,
+	devAddr types.DevAddr,
+	deduplicated bool,
+) (events.Builders, []macHandler, []string) {
+	logger := log.FromContext(ctx)
+	var queuedEventBuilders events.Builders
+	var deferredMACHandlers []macHandler
+	if len(cmds) > 0 && !deduplicated {
+		deferredMACHandlers = make([]macHandler, 0, 2)
+	}
+	var setPaths []string
+	recentMACCommandIdentifiers := make([]ttnpb.MACCommandIdentifier, 0, 1)
+	dev.MacState.QueuedResponses = dev.MacState.QueuedResponses[:0]
+macLoop:
+	for len(cmds) > 0 {
+		var cmd *ttnpb.MACCommand
+		cmd, cmds = cmds[0], cmds[1:]
+		logger := logger.WithField("command", cmd)
+		logger.Debug("Handle MAC command")
+		ctx := log.NewContext(ctx, logger)
+
+		var evs events.Builders
+		var err error
+		switch cmd.Cid {
+		case ttnpb.MACCommandIdentifier_CID_RESET:
+			evs, err = mac.HandleResetInd(ctx, dev, cmd.GetResetInd(), fps, ns.defaultMACSettings)
+		case ttnpb.MACCommandIdentifier_CID_LINK_CHECK:
+			if !deduplicated {
+				deferredMACHandlers = append(deferredMACHandlers, makeDeferredMACHandler(dev, mac.HandleLinkCheckReq))
+				continue macLoop
+			}
+			evs, err = mac.HandleLinkCheckReq(ctx, dev, up)
+		case ttnpb.MACCommandIdentifier_CID_LINK_ADR:
+			pld := cmd.GetLinkAdrAns()
+			dupCount := 0
+			if macspec.AllowDuplicateLinkADRAns(dev.MacState.LorawanVersion) {
+				for _, dup := range cmds {
+					if dup.Cid != ttnpb.MACCommandIdentifier_CID_LINK_ADR {
+						break
+					}
+					if !proto.Equal(dup.GetLinkAdrAns(), pld) {
+						err = errInvalidPayload.New()
+						break
+					}
+					dupCount++
+				}
+			}
+			if err != nil {
+				break
+			}
+			cmds = cmds[dupCount:]
+			evs, err = mac.HandleLinkADRAns(ctx, dev, pld, uint(dupCount), fCnt, fps)
+		case ttnpb.MACCommandIdentifier_CID_DUTY_CYCLE:
+			evs, err = mac.HandleDutyCycleAns(ctx, dev)
+		case ttnpb.MACCommandIdentifier_CID_RX_PARAM_SETUP:
+			evs, err = mac.HandleRxParamSetupAns(ctx, dev, cmd.GetRxParamSetupAns())
+		case ttnpb.MACCommandIdentifier_CID_DEV_STATUS:
+			evs, err = mac.HandleDevStatusAns(ctx, dev, cmd.GetDevStatusAns(), fCnt, *ttnpb.StdTime(up.ReceivedAt))
+			if err == nil {
+				setPaths = ttnpb.AddFields(setPaths,
+					"battery_percentage",
+					"downlink_margin",
+					"last_dev_status_received_at",
+					"power_state",
+				)
+			}
+		case ttnpb.MACCommandIdentifier_CID_NEW_CHANNEL:
+			evs, err = mac.HandleNewChannelAns(ctx, dev, cmd.GetNewChannelAns())
+		case ttnpb.MACCommandIdentifier_CID_RX_TIMING_SETUP:
+			evs, err = mac.HandleRxTimingSetupAns(ctx, dev)
+		case ttnpb.MACCommandIdentifier_CID_TX_PARAM_SETUP:
+			evs, err = mac.HandleTxParamSetupAns(ctx, dev)
+		case ttnpb.MACCommandIdentifier_CID_DL_CHANNEL:
+			evs, err = mac.HandleDLChannelAns(ctx, dev, cmd.GetDlChannelAns())
+		case ttnpb.MACCommandIdentifier_CID_REKEY:
+			evs, err = mac.HandleRekeyInd(ctx, dev, cmd.GetRekeyInd(), devAddr)
+		case ttnpb.MACCommandIdentifier_CID_ADR_PARAM_SETUP:
+			evs, err = mac.HandleADRParamSetupAns(ctx, dev)
+		case ttnpb.MACCommandIdentifier_CID_DEVICE_TIME:
+			if !deduplicated {
+				m := makeDeferredMACHandler(dev, mac.HandleDeviceTimeReq)
+				deferredMACHandlers = append(deferredMACHandlers, m)
+				continue macLoop
+			}
+			evs, err = mac.HandleDeviceTimeReq(ctx, dev, up)
+		case ttnpb.MACCommandIdentifier_CID_REJOIN_PARAM_SETUP:
+			evs, err = mac.HandleRejoinParamSetupAns(ctx, dev, cmd.GetRejoinParamSetupAns())
+		case ttnpb.MACCommandIdentifier_CID_PING_SLOT_INFO:
+			evs, err = mac.HandlePingSlotInfoReq(ctx, dev, cmd.GetPingSlotInfoReq())
+		case ttnpb.MACCommandIdentifier_CID_PING_SLOT_CHANNEL:
+			evs, err = mac.HandlePingSlotChannelAns(ctx, dev, cmd.GetPingSlotChannelAns())
+		case ttnpb.MACCommandIdentifier_CID_BEACON_TIMING:
+			evs, err = mac.HandleBeaconTimingReq(ctx, dev)
+		case ttnpb.MACCommandIdentifier_CID_BEACON_FREQ:
+			evs, err = mac.HandleBeaconFreqAns(ctx, dev, cmd.GetBeaconFreqAns())
+		case ttnpb.MACCommandIdentifier_CID_DEVICE_MODE:
+			evs, err = mac.HandleDeviceModeInd(ctx, dev, cmd.GetDeviceModeInd())
+		case ttnpb.MACCommandIdentifier_CID_RELAY_CONF:
+			evs, err = mac.HandleRelayConfAns(ctx, dev, cmd.GetRelayConfAns())
+		case ttnpb.MACCommandIdentifier_CID_RELAY_END_DEVICE_CONF:
+			evs, err = mac.HandleRelayEndDeviceConfAns(ctx, dev, cmd.GetRelayEndDeviceConfAns())
+		case ttnpb.MACCommandIdentifier_CID_RELAY_UPDATE_UPLINK_LIST:
+			evs, err = mac.HandleRelayUpdateUplinkListAns(ctx, dev, cmd.GetRelayUpdateUplinkListAns())
+		case ttnpb.MACCommandIdentifier_CID_RELAY_CTRL_UPLINK_LIST:
+			evs, err = mac.HandleRelayCtrlUplinkListAns(ctx, dev, cmd.GetRelayCtrlUplinkListAns())
+		case ttnpb.MACCommandIdentifier_CID_RELAY_CONFIGURE_FWD_LIMIT:
+			evs, err = mac.HandleRelayConfigureFwdLimitAns(ctx, dev, cmd.GetRelayConfigureFwdLimitAns())
+		case ttnpb.MACCommandIdentifier_CID_RELAY_NOTIFY_NEW_END_DEVICE:
+			evs, err = mac.HandleRelayNotifyNewEndDeviceReq(ctx, dev, cmd.GetRelayNotifyNewEndDeviceReq())
+		default:
+			_, known := lorawan.DefaultMACCommands[cmd.Cid]
+			logger.WithField("known", known).Debug("Unknown MAC command received")
+			queuedEventBuilders = append(queuedEventBuilders, mac.EvtUnknownMACCommand.BindData(cmd))
+			break macLoop
+		}
+		if err != nil {
+			logger.WithError(err).Debug("Failed to process MAC command")
+			queuedEventBuilders = append(queuedEventBuilders, mac.EvtProcessMACCommandFail.BindData(err))
+			break macLoop
+		}
+		queuedEventBuilders = append(queuedEventBuilders, evs...)
+		recentMACCommandIdentifiers = appendRecentMACCommandIdentifier(recentMACCommandIdentifiers, cmd.Cid)
+	}
+	dev.MacState.RecentMacCommandIdentifiers = recentMACCommandIdentifiers
+	if n := len(dev.MacState.PendingRequests); n > 0 {
+		logger.WithField("unanswered_request_count", n).Debug("MAC command buffer not fully answered")
+		queuedEventBuilders = append(queuedEventBuilders, mac.EvtUnansweredMACCommand.BindData(&ttnpb.MACCommands{
+			Commands: slices.Clone(dev.MacState.PendingRequests),
+		}))
+		dev.MacState.PendingRequests = dev.MacState.PendingRequests[:0]
+	}
+	return queuedEventBuilders, deferredMACHandlers, setPaths
+}
+
 type matchResult struct {
 	cmacFMatchingResult
 
@@ -339,7 +483,13 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 			))
 			switch {
 			case cmacFMatchResult.FullFCnt < dev.Session.LastFCntUp:
-				if pld.FHdr.FCtrl.Ack || dev.Session.LastFCntUp != cmacFMatchResult.LastFCnt || !mac.DeviceResetsFCnt(dev, ns.defaultMACSettings) {
+				if pld.FHdr.FCtrl.Ack || dev.Session.LastFCntUp != cmacFMatchResult.LastFCnt {
+					return nil, false, nil
+				}
+				if !mac.DeviceResetsFCnt(dev, ns.defaultMACSettings) {
+					if !deduplicated {
+						ns.recordFCntRejection(ctx, dev, cmacFMatchResult, up)
+					}
 					return nil, false, nil
 				}
 				ctx = log.NewContextWithField(ctx, "f_cnt_reset", true)
@@ -367,6 +517,9 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 						"f_cnt_gap", fCntGap,
 						"max_f_cnt_gap", phy.MaxFCntGap,
 					)).Debug("FCnt gap exceeds maximum after reset")
+					if !deduplicated && dev.Session.LastFCntUp == cmacFMatchResult.LastFCnt {
+						ns.recordFCntRejection(ctx, dev, cmacFMatchResult, up)
+					}
 					return nil, false, nil
 				}
 
@@ -535,131 +688,10 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 		}
 	}
 
-	var deferredMACHandlers []macHandler
-	if len(cmds) > 0 && !deduplicated {
-		deferredMACHandlers = make([]macHandler, 0, 2)
-	}
-	var setPaths []string
-	recentMACCommandIdentifiers := make([]ttnpb.MACCommandIdentifier, 0, 1)
-	dev.MacState.QueuedResponses = dev.MacState.QueuedResponses[:0]
-macLoop:
-	for len(cmds) > 0 {
-		var cmd *ttnpb.MACCommand
-		cmd, cmds = cmds[0], cmds[1:]
-		logger := logger.WithField("command", cmd)
-		logger.Debug("Handle MAC command")
-		ctx := log.NewContext(ctx, logger)
-
-		var evs events.Builders
-		var err error
-		switch cmd.Cid {
-		case ttnpb.MACCommandIdentifier_CID_RESET:
-			evs, err = mac.HandleResetInd(ctx, dev, cmd.GetResetInd(), fps, ns.defaultMACSettings)
-		case ttnpb.MACCommandIdentifier_CID_LINK_CHECK:
-			if !deduplicated {
-				deferredMACHandlers = append(deferredMACHandlers, makeDeferredMACHandler(dev, mac.HandleLinkCheckReq))
-				continue macLoop
-			}
-			evs, err = mac.HandleLinkCheckReq(ctx, dev, up)
-		case ttnpb.MACCommandIdentifier_CID_LINK_ADR:
-			pld := cmd.GetLinkAdrAns()
-			dupCount := 0
-			if macspec.AllowDuplicateLinkADRAns(dev.MacState.LorawanVersion) {
-				for _, dup := range cmds {
-					if dup.Cid != ttnpb.MACCommandIdentifier_CID_LINK_ADR {
-						break
-					}
-					if !proto.Equal(dup.GetLinkAdrAns(), pld) {
-						err = errInvalidPayload.New()
-						break
-					}
-					dupCount++
-				}
-			}
-			if err != nil {
-				break
-			}
-			cmds = cmds[dupCount:]
-			evs, err = mac.HandleLinkADRAns(ctx, dev, pld, uint(dupCount), cmacFMatchResult.FullFCnt, fps)
-		case ttnpb.MACCommandIdentifier_CID_DUTY_CYCLE:
-			evs, err = mac.HandleDutyCycleAns(ctx, dev)
-		case ttnpb.MACCommandIdentifier_CID_RX_PARAM_SETUP:
-			evs, err = mac.HandleRxParamSetupAns(ctx, dev, cmd.GetRxParamSetupAns())
-		case ttnpb.MACCommandIdentifier_CID_DEV_STATUS:
-			evs, err = mac.HandleDevStatusAns(ctx, dev, cmd.GetDevStatusAns(), cmacFMatchResult.FullFCnt, *ttnpb.StdTime(up.ReceivedAt))
-			if err == nil {
-				setPaths = ttnpb.AddFields(setPaths,
-					"battery_percentage",
-					"downlink_margin",
-					"last_dev_status_received_at",
-					"power_state",
-				)
-			}
-		case ttnpb.MACCommandIdentifier_CID_NEW_CHANNEL:
-			evs, err = mac.HandleNewChannelAns(ctx, dev, cmd.GetNewChannelAns())
-		case ttnpb.MACCommandIdentifier_CID_RX_TIMING_SETUP:
-			evs, err = mac.HandleRxTimingSetupAns(ctx, dev)
-		case ttnpb.MACCommandIdentifier_CID_TX_PARAM_SETUP:
-			evs, err = mac.HandleTxParamSetupAns(ctx, dev)
-		case ttnpb.MACCommandIdentifier_CID_DL_CHANNEL:
-			evs, err = mac.HandleDLChannelAns(ctx, dev, cmd.GetDlChannelAns())
-		case ttnpb.MACCommandIdentifier_CID_REKEY:
-			evs, err = mac.HandleRekeyInd(ctx, dev, cmd.GetRekeyInd(), devAddr)
-		case ttnpb.MACCommandIdentifier_CID_ADR_PARAM_SETUP:
-			evs, err = mac.HandleADRParamSetupAns(ctx, dev)
-		case ttnpb.MACCommandIdentifier_CID_DEVICE_TIME:
-			if !deduplicated {
-				m := makeDeferredMACHandler(dev, mac.HandleDeviceTimeReq)
-				deferredMACHandlers = append(deferredMACHandlers, m)
-				continue macLoop
-			}
-			evs, err = mac.HandleDeviceTimeReq(ctx, dev, up)
-		case ttnpb.MACCommandIdentifier_CID_REJOIN_PARAM_SETUP:
-			evs, err = mac.HandleRejoinParamSetupAns(ctx, dev, cmd.GetRejoinParamSetupAns())
-		case ttnpb.MACCommandIdentifier_CID_PING_SLOT_INFO:
-			evs, err = mac.HandlePingSlotInfoReq(ctx, dev, cmd.GetPingSlotInfoReq())
-		case ttnpb.MACCommandIdentifier_CID_PING_SLOT_CHANNEL:
-			evs, err = mac.HandlePingSlotChannelAns(ctx, dev, cmd.GetPingSlotChannelAns())
-		case ttnpb.MACCommandIdentifier_CID_BEACON_TIMING:
-			evs, err = mac.HandleBeaconTimingReq(ctx, dev)
-		case ttnpb.MACCommandIdentifier_CID_BEACON_FREQ:
-			evs, err = mac.HandleBeaconFreqAns(ctx, dev, cmd.GetBeaconFreqAns())
-		case ttnpb.MACCommandIdentifier_CID_DEVICE_MODE:
-			evs, err = mac.HandleDeviceModeInd(ctx, dev, cmd.GetDeviceModeInd())
-		case ttnpb.MACCommandIdentifier_CID_RELAY_CONF:
-			evs, err = mac.HandleRelayConfAns(ctx, dev, cmd.GetRelayConfAns())
-		case ttnpb.MACCommandIdentifier_CID_RELAY_END_DEVICE_CONF:
-			evs, err = mac.HandleRelayEndDeviceConfAns(ctx, dev, cmd.GetRelayEndDeviceConfAns())
-		case ttnpb.MACCommandIdentifier_CID_RELAY_UPDATE_UPLINK_LIST:
-			evs, err = mac.HandleRelayUpdateUplinkListAns(ctx, dev, cmd.GetRelayUpdateUplinkListAns())
-		case ttnpb.MACCommandIdentifier_CID_RELAY_CTRL_UPLINK_LIST:
-			evs, err = mac.HandleRelayCtrlUplinkListAns(ctx, dev, cmd.GetRelayCtrlUplinkListAns())
-		case ttnpb.MACCommandIdentifier_CID_RELAY_CONFIGURE_FWD_LIMIT:
-			evs, err = mac.HandleRelayConfigureFwdLimitAns(ctx, dev, cmd.GetRelayConfigureFwdLimitAns())
-		case ttnpb.MACCommandIdentifier_CID_RELAY_NOTIFY_NEW_END_DEVICE:
-			evs, err = mac.HandleRelayNotifyNewEndDeviceReq(ctx, dev, cmd.GetRelayNotifyNewEndDeviceReq())
-		default:
-			_, known := lorawan.DefaultMACCommands[cmd.Cid]
-			logger.WithField("known", known).Debug("Unknown MAC command received")
-			queuedEventBuilders = append(queuedEventBuilders, mac.EvtUnknownMACCommand.BindData(cmd))
-			break macLoop
-		}
-		if err != nil {
-			logger.WithError(err).Debug("Failed to process MAC command")
-			queuedEventBuilders = append(queuedEventBuilders, mac.EvtProcessMACCommandFail.BindData(err))
-			break macLoop
-		}
-		queuedEventBuilders = append(queuedEventBuilders, evs...)
-		recentMACCommandIdentifiers = appendRecentMACCommandIdentifier(recentMACCommandIdentifiers, cmd.Cid)
-	}
-	dev.MacState.RecentMacCommandIdentifiers = recentMACCommandIdentifiers
-	if n := len(dev.MacState.PendingRequests); n > 0 {
-		logger.WithField("unanswered_request_count", n).Debug("MAC command buffer not fully answered")
-		queuedEventBuilders = append(queuedEventBuilders, mac.EvtUnansweredMACCommand.BindData(&ttnpb.MACCommands{
-			Commands: slices.Clone(dev.MacState.PendingRequests),
-		}))
-		dev.MacState.PendingRequests = dev.MacState.PendingRequests[:0]
-	}
+	macEventBuilders, deferredMACHandlers, setPaths := ns.handleUplinkMACCommands(
+		ctx, dev, up, cmds, fps, cmacFMatchResult.FullFCnt, devAddr, deduplicated,
+	)
+	queuedEventBuilders = append(queuedEventBuilders, macEventBuilders...)
 
 	if matchType == pendingMatch {
 		if !macspec.UseRekeyInd(dev.MacState.LorawanVersion) {
@@ -784,10 +816,17 @@ func toMACStateRxMetadata(mds []*ttnpb.RxMetadata) []*ttnpb.MACState_UplinkMessa
 		if md.Relay != nil {
 			relayMD = &ttnpb.MACState_UplinkMessage_RxMetadata_RelayMetadata{}
 		}
+		var packetSourceMD *ttnpb.MACState_UplinkMessage_RxMetadata_PacketSourceMetadata
+		if md.PacketSource != nil {
+			packetSourceMD = &ttnpb.MACState_UplinkMessage_RxMetadata_PacketSourceMetadata{
+				SourceId: md.PacketSource.SourceId,
+			}
+		}
 		recentMDs = append(recentMDs, &ttnpb.MACState_UplinkMessage_RxMetadata{
 			GatewayIds:             md.GatewayIds,
 			PacketBroker:           pbMD,
 			Relay:                  relayMD,
+			PacketSource:           packetSourceMD,
 			ChannelRssi:            md.ChannelRssi,
 			Snr:                    md.Snr,
 			DownlinkPathConstraint: md.DownlinkPathConstraint,
@@ -846,6 +885,65 @@ func appendRecentUplink(
 	return recent
 }
 
+// updateUplinkDataRate records the uplink up in the recent uplinks of dev, updates the current
+// data rate of dev to drIdx and, if adr is set, runs the ADR algorithm of dev.
+// updateUplinkDataRate returns the end device paths set and the ADR decision, if any.
+func (ns *NetworkServer) updateUplinkDataRate(
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	up *ttnpb.UplinkMessage,
+	phy *band.Band,
+	drIdx ttnpb.DataRateIndex,
+	adr bool,
+) ([]string, *mac.ADRDecision) {
+	paths := []string{
+		"mac_state.recent_uplinks",
+	}
+	dev.MacState.RecentUplinks = appendRecentUplink(dev.MacState.RecentUplinks, up, recentUplinkCount)
+
+	if drIdx < dev.MacState.CurrentParameters.AdrDataRateIndex {
+		// Device lowers TX power index before lowering data rate index according to the spec.
+		dev.MacState.CurrentParameters.AdrTxPowerIndex = 0
+		paths = ttnpb.AddFields(paths,
+			"mac_state.current_parameters.adr_tx_power_index",
+		)
+	}
+	dev.MacState.CurrentParameters.AdrDataRateIndex = drIdx
+	paths = ttnpb.AddFields(paths,
+		"mac_state.current_parameters.adr_data_rate_index",
+	)
+
+	adaptDataRate, resetDesiredParameters, staticADRSettings := mac.DeviceShouldAdaptDataRate(dev, ns.defaultMACSettings, phy)
+	if resetDesiredParameters || staticADRSettings != nil {
+		paths = ttnpb.AddFields(paths,
+			"mac_state.desired_parameters.adr_data_rate_index",
+			"mac_state.desired_parameters.adr_nb_trans",
+			"mac_state.desired_parameters.adr_tx_power_index",
+		)
+	}
+	if currentParameters, desiredParameters := dev.MacState.CurrentParameters, dev.MacState.DesiredParameters; resetDesiredParameters {
+		desiredParameters.AdrDataRateIndex = currentParameters.AdrDataRateIndex
+		desiredParameters.AdrTxPowerIndex = currentParameters.AdrTxPowerIndex
+		desiredParameters.AdrNbTrans = currentParameters.AdrNbTrans
+	}
+	if desiredParameters := dev.MacState.DesiredParameters; staticADRSettings != nil {
+		desiredParameters.AdrDataRateIndex = staticADRSettings.DataRateIndex
+		desiredParameters.AdrTxPowerIndex = staticADRSettings.TxPowerIndex
+		desiredParameters.AdrNbTrans = staticADRSettings.NbTrans
+	}
+	if !adr || !adaptDataRate {
+		return paths, nil
+	}
+	decision, err := mac.AdaptDataRateWith(
+		ctx, mac.DeviceADRAlgorithm(dev, ns.defaultMACSettings), dev, phy, ns.defaultMACSettings,
+	)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Info("Failed to adapt data rate, avoid ADR")
+		return paths, nil
+	}
+	return paths, decision
+}
+
 var handleDataUplinkGetPaths = [...]string{
 	"frequency_plan_id",
 	"last_dev_status_received_at",
@@ -939,7 +1037,12 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	ctx, flushMatchStats := newContextWithMatchStats(ctx)
 	defer flushMatchStats()
 
-	var matched *matchResult
+	var (
+		matched *matchResult
+		// fCntRejectionCandidates are the sessions of which the MIC may match a rejected frame counter. They are only
+		// tried if no session matches.
+		fCntRejectionCandidates []*fCntRejectionCandidate
+	)
 	if err := ns.devices.RangeByUplinkMatches(ctx, up,
 		func(ctx context.Context, match *UplinkMatch) (bool, error) {
 			defer trace.StartRegion(ctx, "iterate uplink match").End()
@@ -974,6 +1077,9 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			}
 			if !ok {
 				trace.Log(ctx, "ns", "no mic match")
+				if c := ns.newFCntRejectionCandidate(match, fNwkSIntKey, fCnt, up); c != nil {
+					fCntRejectionCandidates = append(fCntRejectionCandidates, c)
+				}
 				return false, nil
 			}
 			trace.Log(ctx, "ns", "mic match")
@@ -1002,8 +1108,19 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		return errDeviceNotFound.WithCause(err)
 	}
 	if !ok {
+		ns.enqueueFCntRejectionUplink(ctx, up, fCntRejectionCandidates...)
+		// Devices of accepted handover roaming sessions may use the DevAddr of the home network, so uplinks are only
+		// forwarded to serving Network Servers if no device matches.
+		if netID, ok := ns.passiveRoamingForwardingNetID(ctx, types.MustDevAddr(pld.FHdr.DevAddr).OrZero()); ok {
+			return ns.forwardPassiveRoamingUplink(ctx, netID, up)
+		}
 		return errDeviceNotFound.New()
 	}
+	if s, err := ns.homeHandoverSession(ctx, matched.Device.Ids); err != nil {
+		return err
+	} else if s != nil {
+		return errDeviceHandedOver.WithAttributes("net_id", s.NetID)
+	}
 
 	pld.FullFCnt = matched.FullFCnt
 	up.DeviceChannelIndex = uint32(matched.ChannelIndex)
@@ -1071,48 +1188,15 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			queuedEvents = append(queuedEvents, matched.QueuedEventBuilders.New(ctx, events.WithIdentifiers(matched.Device.Ids))...)
 
 			stored = matched.Device
-			paths := ttnpb.AddFields(matched.SetPaths,
-				"mac_state.recent_uplinks",
+			paths, decision := ns.updateUplinkDataRate(
+				ctx, stored, up, matched.phy, matched.DataRateIndex, pld.FHdr.FCtrl.Adr,
 			)
-			stored.MacState.RecentUplinks = appendRecentUplink(stored.MacState.RecentUplinks, up, recentUplinkCount)
-
-			if matched.DataRateIndex < stored.MacState.CurrentParameters.AdrDataRateIndex {
-				// Device lowers TX power index before lowering data rate index according to the spec.
-				stored.MacState.CurrentParameters.AdrTxPowerIndex = 0
-				paths = ttnpb.AddFields(paths,
-					"mac_state.current_parameters.adr_tx_power_index",
-				)
-			}
-			stored.MacState.CurrentParameters.AdrDataRateIndex = matched.DataRateIndex
-			paths = ttnpb.AddFields(paths,
-				"mac_state.current_parameters.adr_data_rate_index",
-			)
-
-			adaptDataRate, resetDesiredParameters, staticADRSettings := mac.DeviceShouldAdaptDataRate(stored, ns.defaultMACSettings, matched.phy)
-			if resetDesiredParameters || staticADRSettings != nil {
-				paths = ttnpb.AddFields(paths,
-					"mac_state.desired_parameters.adr_data_rate_index",
-					"mac_state.desired_parameters.adr_nb_trans",
-					"mac_state.desired_parameters.adr_tx_power_index",
-				)
-			}
-			if currentParameters, desiredParameters := stored.MacState.CurrentParameters, stored.MacState.DesiredParameters; resetDesiredParameters {
-				desiredParameters.AdrDataRateIndex = currentParameters.AdrDataRateIndex
-				desiredParameters.AdrTxPowerIndex = currentParameters.AdrTxPowerIndex
-				desiredParameters.AdrNbTrans = currentParameters.AdrNbTrans
-			}
-			if desiredParameters := stored.MacState.DesiredParameters; staticADRSettings != nil {
-				desiredParameters.AdrDataRateIndex = staticADRSettings.DataRateIndex
-				desiredParameters.AdrTxPowerIndex = staticADRSettings.TxPowerIndex
-				desiredParameters.AdrNbTrans = staticADRSettings.NbTrans
-			}
-			if !pld.FHdr.FCtrl.Adr || !adaptDataRate {
-				return stored, paths, nil
-			}
-			if err := mac.AdaptDataRate(ctx, stored, matched.phy, ns.defaultMACSettings); err != nil {
-				log.FromContext(ctx).WithError(err).Info("Failed to adapt data rate, avoid ADR")
+			if decision != nil && decision.Changed() {
+				queuedEvents = append(queuedEvents, mac.EvtAdaptDataRate.NewWithIdentifiersAndData(
+					ctx, stored.Ids, decision.Struct(),
+				))
 			}
-			return stored, paths, nil
+			return stored, ttnpb.AddFields(matched.SetPaths, paths...), nil
 		})
 	if err != nil {
 		// TODO: Retry transaction. (https://github.com/TheThingsNetwork/lorawan-stack/issues/33)
@@ -1122,6 +1206,15 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	matched.Device = stored
 	ctx = matched.Context
 
+	ns.recordLinkSample(ctx, stored.Ids, up, matched.FullFCnt, matched.DataRateIndex, matched.IsRetransmission)
+	if !matched.IsRetransmission {
+		ns.handleRelayAssignment(ctx, stored, up)
+		ns.handleClassBCapacity(ctx, stored, matched.phy, up)
+	}
+	if matched.IsPending {
+		ns.handleRejoinSessionActivation(ctx, stored)
+	}
+
 	skipDownlinkTx := false
 	macPayload := up.Payload.GetMacPayload()
 	if macPayload != nil && macPayload.FPort == dataUploadFPort {
@@ -1319,9 +1412,31 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		return err
 	}
 
+	queuedEvents, err = ns.requestJoinAccept(
+		ctx, up, up.Payload, matched, macState, fp, phy, true, evtProcessJoinRequest, queuedEvents,
+	)
+	return err
+}
+
+// requestJoinAccept requests a join-accept for the join- or rejoin-request up with payload pld of matched from the
+// Join Server, stores macState with the queued join-accept as the pending MAC state of matched and schedules the
+// downlink task of the join-accept. The CFList is only sent if withCFList is true.
+// requestJoinAccept returns queuedEvents with the events queued during the handling appended.
+func (ns *NetworkServer) requestJoinAccept(
+	ctx context.Context,
+	up *ttnpb.UplinkMessage,
+	pld *ttnpb.Message,
+	matched *ttnpb.EndDevice,
+	macState *ttnpb.MACState,
+	fp *frequencyplans.FrequencyPlan,
+	phy *band.Band,
+	withCFList bool,
+	processEvent events.Builder,
+	queuedEvents []events.Event,
+) ([]events.Event, error) {
 	chIdx, err := searchUplinkChannel(up.Settings.Frequency, macState)
 	if err != nil {
-		return err
+		return queuedEvents, err
 	}
 	up.DeviceChannelIndex = uint32(chIdx)
 	ctx = log.NewContextWithField(ctx,
@@ -1346,7 +1461,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		up.Settings.DataRate,
 	)
 	if err != nil {
-		return err
+		return queuedEvents, err
 	}
 	// NOTE: The CFList is an optional part of a JoinAccept message. A JoinAccept containing a CFList has size
 	// 33, while a JoinAccept without a CFList has size 17. Bands which are susceptible to downlink dwell time
@@ -1354,7 +1469,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	// than 19 bytes. For such bands, we need to always omit the CFList.
 	// NOTE: The 5 bytes added to the maximum represent the MHDR (1 byte) and the MIC (4 bytes).
 	var cfList *ttnpb.CFList
-	if maxMACPayloadSize+5 >= lorawan.JoinAcceptWithCFListLength {
+	if withCFList && maxMACPayloadSize+5 >= lorawan.JoinAcceptWithCFListLength {
 		cfList = mac.CFList(phy, macState.DesiredParameters.Channels...)
 	}
 	dlSettings := &ttnpb.DLSettings{
@@ -1364,7 +1479,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	}
 
 	resp, joinEvents, err := ns.sendJoinRequest(ctx, matched.Ids, &ttnpb.JoinRequest{
-		Payload:            up.Payload,
+		Payload:            pld,
 		CfList:             cfList,
 		CorrelationIds:     events.CorrelationIDsFromContext(ctx),
 		DevAddr:            devAddr.Bytes(),
@@ -1378,7 +1493,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 
 	queuedEvents = append(queuedEvents, joinEvents...)
 	if err != nil {
-		return err
+		return queuedEvents, err
 	}
 	registerForwardJoinRequest(ctx, up)
 
@@ -1392,14 +1507,14 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	for _, keyEnvelope := range keyEnvelopes {
 		unwrappedKey, err := cryptoutil.UnwrapAES128Key(ctx, keyEnvelope, ns.KeyService())
 		if err != nil {
-			return err
+			return queuedEvents, err
 		}
 		wrappedEnvelope, err := cryptoutil.WrapAES128Key(ctx, unwrappedKey, ns.deviceKEKLabel, ns.KeyService())
 		if err != nil {
-			return err
+			return queuedEvents, err
 		}
 		if err := keyEnvelope.SetFields(wrappedEnvelope, ttnpb.KeyEnvelopeFieldPathsTopLevel...); err != nil {
-			return err
+			return queuedEvents, err
 		}
 	}
 	macState.QueuedJoinAccept = &ttnpb.MACState_JoinAccept{
@@ -1418,7 +1533,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	ctx = events.ContextWithCorrelationID(ctx, resp.CorrelationIds...)
 
 	if err := ns.deliverRelaySessionKeys(ctx, matched, keys.SessionKeyId); err != nil {
-		return err
+		return queuedEvents, err
 	}
 
 	publishEvents(ctx, queuedEvents...)
@@ -1426,7 +1541,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	up = ttnpb.Clone(up)
 	select {
 	case <-ctx.Done():
-		return ctx.Err()
+		return queuedEvents, ctx.Err()
 	case <-ns.deduplicationDone(ctx, up):
 	}
 	ns.mergeMetadata(ctx, up, initialDeduplicationRound)
@@ -1443,7 +1558,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		},
 		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if stored == nil {
-				logger.Warn("Device deleted during join-request handling, drop")
+				logger.Warn("Device deleted during join handling, drop")
 				return nil, nil, errOutdatedData.New()
 			}
 			stored.PendingMacState = macState
@@ -1454,32 +1569,142 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	if err != nil {
 		// TODO: Retry transaction. (https://github.com/TheThingsNetwork/lorawan-stack/issues/33)
 		logRegistryRPCError(ctx, err, "Failed to update device in registry")
-		return err
+		return queuedEvents, err
 	}
 	matched = stored
 	ctx = storedCtx
 
-	// TODO: Extract this into a utility function shared with mac.HandleRejoinRequest. (https://github.com/TheThingsNetwork/lorawan-stack/issues/8)
 	downAt := ttnpb.StdTime(up.ReceivedAt).Add(-infrastructureDelay/2 + phy.JoinAcceptDelay1 - macState.DesiredParameters.Rx1Delay.Duration()/2 - nsScheduleWindow())
 	if earliestAt := time.Now().Add(nsScheduleWindow()); downAt.Before(earliestAt) {
 		downAt = earliestAt
 	}
 	logger.WithField("start_at", downAt).Debug("Add downlink task")
 	if err := ns.downlinkTasks.Add(ctx, stored.Ids, downAt, true); err != nil {
-		logger.WithError(err).Error("Failed to add downlink task after join-request")
+		logger.WithError(err).Error("Failed to add downlink task after join")
 	}
-	queuedEvents = append(queuedEvents, evtProcessJoinRequest.NewWithIdentifiersAndData(ctx, matched.Ids, up))
+	queuedEvents = append(queuedEvents, processEvent.NewWithIdentifiersAndData(ctx, matched.Ids, up))
 	registerProcessUplink(ctx, up)
-	return nil
+	return queuedEvents, nil
 }
 
-var errRejoinRequest = errors.DefineUnavailable("rejoin_request", "rejoin-request handling is not implemented")
+var errRejoinRequest = errors.DefineUnavailable("rejoin_request", "rejoin-request handling is disabled")
 
-func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) error {
+func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
 	defer trace.StartRegion(ctx, "handle rejoin request").End()
 
-	// TODO: Implement https://github.com/TheThingsNetwork/lorawan-stack/issues/8
-	return errRejoinRequest.New()
+	if ns.rejoin == nil {
+		return errRejoinRequest.New()
+	}
+
+	pld := up.Payload.GetRejoinRequestPayload()
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_eui", types.MustEUI64(pld.DevEui).OrZero(),
+		"rejoin_type", pld.RejoinType,
+		"rejoin_count", pld.RejoinCnt,
+	))
+
+	ok, err := ns.deduplicateUplink(ctx, up, joinRequestCollectionWindow, deduplicationLimit, initialDeduplicationRound)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		trace.Log(ctx, "ns", "message is duplicate")
+		return errDuplicateUplink.New()
+	}
+	trace.Log(ctx, "ns", "message is original")
+
+	matched, matchedCtx, err := ns.rejoinDevice(ctx, pld, []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"mac_settings",
+		"mac_state.current_parameters",
+		"mac_state.desired_parameters",
+		"multicast",
+		"session.dev_addr",
+		"session.keys",
+		"supports_class_b",
+		"supports_class_c",
+		"supports_join",
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to load device of rejoin-request from registry")
+		return errDeviceNotFound.WithCause(err)
+	}
+	ctx = matchedCtx
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"device_uid", unique.ID(ctx, matched.Ids),
+		"join_eui", types.MustEUI64(matched.Ids.JoinEui).OrZero(),
+	))
+
+	queuedEvents := []events.Event{
+		evtReceiveRejoinRequest.NewWithIdentifiersAndData(ctx, matched.Ids, up),
+	}
+	defer func() {
+		if err != nil {
+			queuedEvents = append(queuedEvents, evtDropRejoinRequest.NewWithIdentifiersAndData(ctx, matched.Ids, err))
+		}
+		publishEvents(ctx, queuedEvents...)
+	}()
+
+	if matched.Multicast || !matched.SupportsJoin || !macspec.UseNwkKey(matched.LorawanVersion) {
+		log.FromContext(ctx).Info("End device does not support rejoin-requests, drop")
+		queuedEvents = append(queuedEvents,
+			evtDropRejoinRequest.NewWithIdentifiersAndData(ctx, matched.Ids, errRejoinNotSupported),
+		)
+		return nil
+	}
+
+	state, err := ns.rejoinState(ctx, matched.Ids)
+	if err != nil {
+		return err
+	}
+	if pld.RejoinType != ttnpb.RejoinRequestType_SESSION {
+		if err := ns.verifyRejoinRequest(ctx, matched, state, up); err != nil {
+			return err
+		}
+	}
+
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return err
+	}
+	fp, phy, err := DeviceFrequencyPlanAndBand(matched, fps)
+	if err != nil {
+		return err
+	}
+	ctx = log.NewContextWithField(ctx,
+		"data_rate", up.Settings.DataRate,
+	)
+
+	macState, err := mac.NewState(matched, fps, ns.defaultMACSettings)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to reset device's MAC state")
+		return err
+	}
+	// Type 2 rejoin-requests only rekey the session, so the radio parameters of the end device are retained.
+	withCFList := true
+	if pld.RejoinType == ttnpb.RejoinRequestType_KEYS && matched.MacState != nil {
+		macState.CurrentParameters = ttnpb.Clone(matched.MacState.CurrentParameters)
+		macState.DesiredParameters = ttnpb.Clone(matched.MacState.DesiredParameters)
+		withCFList = false
+	}
+
+	// Type 0 and 2 rejoin-requests do not contain the JoinEUI, which the Join Server needs to find the end device.
+	msg := up.Payload
+	if pld.RejoinType != ttnpb.RejoinRequestType_SESSION {
+		msg = ttnpb.Clone(up.Payload)
+		msg.GetRejoinRequestPayload().JoinEui = matched.Ids.JoinEui
+	}
+
+	queuedEvents, err = ns.requestJoinAccept(
+		ctx, up, msg, matched, macState, fp, phy, withCFList, evtProcessRejoinRequest, queuedEvents,
+	)
+	if err != nil {
+		return err
+	}
+	ns.recordRejoin(ctx, matched.Ids, state, pld, macState.QueuedJoinAccept.GetKeys().GetSessionKeyId(), up)
+	return nil
 }
 
 // HandleUplink is called by the Gateway Server when an uplink message arrives.
@@ -1495,12 +1720,18 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	registerUplinkLatency(ctx, up)
 	up.ReceivedAt = timestamppb.New(time.Now()) // NOTE: This is not equivalent to timestamppb.Now().
 
+	return ttnpb.Empty, ns.handleUplink(ctx, up)
+}
+
+// handleUplink decodes and handles the uplink message up.
+// The caller is responsible for setting up.ReceivedAt and the correlation IDs.
+func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
 	up.Payload = &ttnpb.Message{}
 	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 	if err := up.Payload.ValidateFields(); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 	registerReceiveUplink(ctx, up)
 	defer func() {
@@ -1537,7 +1768,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			"ocw", dr.Lrfhss.GetOperatingChannelWidth(),
 		))
 	default:
-		return nil, errDataRateNotFound.WithAttributes("data_rate", up.Settings.DataRate)
+		return errDataRateNotFound.WithAttributes("data_rate", up.Settings.DataRate)
 	}
 	ctx = log.NewContext(ctx, logger)
 
@@ -1548,14 +1779,14 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	}
 	switch up.Payload.MHdr.MType {
 	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
-		return ttnpb.Empty, ns.handleDataUplink(ctx, up)
+		return ns.handleDataUplink(ctx, up)
 	case ttnpb.MType_JOIN_REQUEST:
-		return ttnpb.Empty, ns.handleJoinRequest(ctx, up)
+		return ns.handleJoinRequest(ctx, up)
 	case ttnpb.MType_REJOIN_REQUEST:
-		return ttnpb.Empty, ns.handleRejoinRequest(ctx, up)
+		return ns.handleRejoinRequest(ctx, up)
 	}
 	logger.Debug("Unmatched MType")
-	return ttnpb.Empty, nil
+	return nil
 }
 
 var errTransmission = errors.Define("transmission", "downlink transmission failed with result `{result}`")
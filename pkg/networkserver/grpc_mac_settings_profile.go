@@ -21,6 +21,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -31,6 +32,16 @@ var (
 	errMACSettingsProfileNotFound      = errors.DefineNotFound("mac_settings_profile_not_found", "MAC settings profile not found")                // nolint: lll
 )
 
+// validateADRAlgorithm validates that the ADR algorithm of the MAC settings, if any, is registered.
+func validateADRAlgorithm(settings *ttnpb.MACSettings) error {
+	name := settings.GetAdr().GetDynamic().GetAlgorithm()
+	if name == "" {
+		return nil
+	}
+	_, err := mac.GetADRAlgorithm(name)
+	return err
+}
+
 func setTotalHeader(ctx context.Context, total int64) {
 	grpc.SetHeader(ctx, metadata.Pairs("x-total-count", strconv.FormatInt(total, 10))) // nolint: errcheck
 }
@@ -50,6 +61,12 @@ func (m *NsMACSettingsProfileRegistry) Create(ctx context.Context, req *ttnpb.Cr
 	); err != nil {
 		return nil, err
 	}
+	if err := validateADRAlgorithm(req.MacSettingsProfile.GetMacSettings()); err != nil {
+		return nil, err
+	}
+	if err := validateDownlinkPathRanking(req.MacSettingsProfile.GetMacSettings()); err != nil {
+		return nil, err
+	}
 	paths := []string{"ids", "mac_settings"}
 	profile, err := m.registry.Set(
 		ctx,
@@ -102,6 +119,12 @@ func (m *NsMACSettingsProfileRegistry) Update(ctx context.Context, req *ttnpb.Up
 	); err != nil {
 		return nil, err
 	}
+	if err := validateADRAlgorithm(req.MacSettingsProfile.GetMacSettings()); err != nil {
+		return nil, err
+	}
+	if err := validateDownlinkPathRanking(req.MacSettingsProfile.GetMacSettings()); err != nil {
+		return nil, err
+	}
 	paths := []string{"ids", "mac_settings"}
 	if req.FieldMask != nil {
 		paths = req.FieldMask.GetPaths()
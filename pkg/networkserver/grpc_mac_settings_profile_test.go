@@ -429,6 +429,50 @@ func TestMACSettingsProfileRegistryCreate(t *testing.T) {
 			ErrorAssertion:   alreadyExistsErrorAssertion,
 			SetCalls:         1,
 		},
+		{
+			Name: "Unknown ADR algorithm",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), &ttnpb.ApplicationIdentifiers{
+							ApplicationId: "test-app-id",
+						}): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			SetFunc: func(
+				ctx context.Context,
+				_ *ttnpb.MACSettingsProfileIdentifiers,
+				_ []string,
+				_ func(context.Context, *ttnpb.MACSettingsProfile) (*ttnpb.MACSettingsProfile, []string, error),
+			) (*ttnpb.MACSettingsProfile, error) {
+				err := errors.New("SetFunc must not be called")
+				test.MustTFromContext(ctx).Error(err)
+				return nil, err
+			},
+			ProfileRequest: &ttnpb.CreateMACSettingsProfileRequest{
+				MacSettingsProfile: &ttnpb.MACSettingsProfile{
+					Ids: registeredProfile.Ids,
+					MacSettings: &ttnpb.MACSettings{
+						Adr: &ttnpb.ADRSettings{
+							Mode: &ttnpb.ADRSettings_Dynamic{
+								Dynamic: &ttnpb.ADRSettings_DynamicMode{
+									Algorithm: "unknown",
+								},
+							},
+						},
+					},
+				},
+			},
+			ProfileAssertion: nilProfileAssertion,
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				t.Helper()
+				return assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+			SetCalls: 0,
+		},
 	} {
 		tc := tc
 		test.RunSubtest(t, test.SubtestConfig{
@@ -0,0 +1,41 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type nsPacketSourceServer struct {
+	ttnpb.UnimplementedNsPacketSourceServer
+
+	packetSources *packetSources
+	handleUplink  func(context.Context, *packetSource, *ttnpb.UplinkMessage) error
+}
+
+// HandleUplink implements ttnpb.NsPacketSourceServer.
+func (s *nsPacketSourceServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (*emptypb.Empty, error) {
+	source, err := s.packetSources.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.handleUplink(ctx, source, up); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,885 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math"
+	"strings"
+
+	ulid "github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errHandoverRoamingDisabled = errors.DefineFailedPrecondition(
+		"handover_roaming_disabled", "handover roaming is disabled",
+	)
+	errHandoverNotAllowed = errors.DefinePermissionDenied(
+		"handover_not_allowed", "handover roaming with NetID `{net_id}` is not allowed",
+	)
+	errHandoverExists   = errors.DefineAlreadyExists("handover_exists", "end device is already handed over")
+	errHandoverNotFound = errors.DefineNotFound("handover_not_found", "end device is not handed over")
+	errDeviceHandedOver = errors.DefineFailedPrecondition(
+		"device_handed_over", "end device is handed over to NetID `{net_id}`",
+	)
+	errNoDevEUI = errors.DefineFailedPrecondition("no_dev_eui", "DevEUI is not set")
+)
+
+var (
+	evtStartHandover = events.Define(
+		"ns.roaming.handover.start", "hand over session to serving Network Server",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.NetworkIdentifiers{}),
+	)
+	evtStopHandover = events.Define(
+		"ns.roaming.handover.stop", "stop handover roaming",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.NetworkIdentifiers{}),
+	)
+	evtAcceptHandover = events.Define(
+		"ns.roaming.handover.accept", "accept session handed over by home Network Server",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.NetworkIdentifiers{}),
+	)
+)
+
+// handoverRoamingGatewayIdentifiers are the proxy gateway identifiers of gateways of serving Network Servers.
+var handoverRoamingGatewayIdentifiers = &ttnpb.GatewayIdentifiers{GatewayId: "handover-roaming"}
+
+// HandoverRole is the role of the Network Server in a handover roaming session.
+type HandoverRole string
+
+const (
+	// HandoverRoleHome is the role of the home Network Server, which handed over the session.
+	HandoverRoleHome HandoverRole = "home"
+	// HandoverRoleServing is the role of the serving Network Server, which accepted the session.
+	HandoverRoleServing HandoverRole = "serving"
+)
+
+// HandoverSession is the handover roaming session of an end device.
+type HandoverSession struct {
+	ApplicationID string       `json:"application_id"`
+	DeviceID      string       `json:"device_id"`
+	DevEUI        types.EUI64  `json:"dev_eui"`
+	Role          HandoverRole `json:"role"`
+	// NetID is the NetID of the roaming partner.
+	NetID types.NetID `json:"net_id"`
+	// NSID is the NSID of the roaming partner, if any.
+	NSID      *types.EUI64 `json:"ns_id,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// EndDeviceIdentifiers returns the identifiers of the end device.
+func (s *HandoverSession) EndDeviceIdentifiers() *ttnpb.EndDeviceIdentifiers {
+	return &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: s.ApplicationID},
+		DeviceId:       s.DeviceID,
+		DevEui:         s.DevEUI.Bytes(),
+	}
+}
+
+func (s *HandoverSession) networkIdentifiers() *ttnpb.NetworkIdentifiers {
+	ids := &ttnpb.NetworkIdentifiers{
+		NetId: s.NetID.Bytes(),
+	}
+	if s.NSID != nil {
+		ids.NsId = s.NSID.Bytes()
+	}
+	return ids
+}
+
+func (s *HandoverSession) header(netID types.NetID, nsID *types.EUI64) interop.NsNsMessageHeader {
+	return interop.NsNsMessageHeader{
+		SenderID:     interop.NetID(netID),
+		SenderNSID:   (*interop.EUI64)(nsID),
+		ReceiverID:   interop.NetID(s.NetID),
+		ReceiverNSID: (*interop.EUI64)(s.NSID),
+	}
+}
+
+// HandoverRegistry stores the handover roaming sessions of end devices.
+// Get methods return nil if the end device has no handover roaming session.
+type HandoverRegistry interface {
+	GetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string) (*HandoverSession, error)
+	GetByEUI(ctx context.Context, devEUI types.EUI64) (*HandoverSession, error)
+	Set(ctx context.Context, s *HandoverSession) error
+	Delete(ctx context.Context, s *HandoverSession) error
+}
+
+// HandoverRoamingClient is the client used to send handover roaming messages to other Network Servers.
+type HandoverRoamingClient interface {
+	HRStartRequest(context.Context, *interop.HRStartReq) (*interop.HRStartAns, error)
+	HRStopRequest(context.Context, *interop.HRStopReq) (*interop.HRStopAns, error)
+	ProfileRequest(context.Context, *interop.ProfileReq) (*interop.ProfileAns, error)
+	XmitDataRequest(context.Context, *interop.XmitDataReq) (*interop.XmitDataAns, error)
+}
+
+// handoverRoaming is the handover roaming state of the Network Server.
+// The Network Server acts as home Network Server (hNS) for the end devices handed over to serving partners and as
+// serving Network Server (sNS) for the end devices handed over by home partners. The home Network Server keeps the
+// end device and its connection to the Application Server, while the serving Network Server serves the session.
+type handoverRoaming struct {
+	client          HandoverRoamingClient
+	registry        HandoverRegistry
+	kekLabel        string
+	handover        []types.NetID
+	accept          []types.NetID
+	applicationIDs  *ttnpb.ApplicationIdentifiers
+	frequencyPlanID string
+}
+
+func newHandoverRoaming(conf HandoverRoamingConfig, client HandoverRoamingClient) (*handoverRoaming, error) {
+	if conf.IsZero() {
+		return nil, nil
+	}
+	if client == nil {
+		return nil, errInvalidConfiguration.WithCause(errors.New("handover roaming requires the interop client"))
+	}
+	if conf.Registry == nil {
+		return nil, errInvalidConfiguration.WithCause(errors.New("HandoverRoaming.Registry is not specified"))
+	}
+	handover, err := parseNetIDs(conf.HandoverNetIDs)
+	if err != nil {
+		return nil, errInvalidConfiguration.WithCause(err)
+	}
+	accept, err := parseNetIDs(conf.AcceptNetIDs)
+	if err != nil {
+		return nil, errInvalidConfiguration.WithCause(err)
+	}
+	r := &handoverRoaming{
+		client:   client,
+		registry: conf.Registry,
+		kekLabel: conf.KEKLabel,
+		handover: handover,
+		accept:   accept,
+	}
+	if len(accept) == 0 {
+		return r, nil
+	}
+	r.applicationIDs = &ttnpb.ApplicationIdentifiers{ApplicationId: conf.ApplicationID}
+	if err := r.applicationIDs.ValidateFields(); err != nil {
+		return nil, errInvalidConfiguration.WithCause(err)
+	}
+	if conf.FrequencyPlanID == "" {
+		return nil, errInvalidConfiguration.WithCause(errors.New("accepting sessions requires a frequency plan ID"))
+	}
+	r.frequencyPlanID = conf.FrequencyPlanID
+	return r, nil
+}
+
+// handsOverTo returns true if sessions may be handed over to the Network Server with the given NetID.
+func (r *handoverRoaming) handsOverTo(netID types.NetID) bool {
+	return r != nil && containsNetID(r.handover, netID)
+}
+
+// accepts returns true if sessions handed over by the Network Server with the given NetID are accepted.
+func (r *handoverRoaming) accepts(netID types.NetID) bool {
+	return r != nil && containsNetID(r.accept, netID)
+}
+
+// serves returns true if the end devices of the application are the end devices of accepted sessions.
+func (r *handoverRoaming) serves(ids *ttnpb.ApplicationIdentifiers) bool {
+	return r != nil && r.applicationIDs != nil && r.applicationIDs.GetApplicationId() == ids.GetApplicationId()
+}
+
+// handoverDeviceID returns the device ID of the end device of an accepted session.
+func handoverDeviceID(devEUI types.EUI64) string {
+	return fmt.Sprintf("roaming-%s", strings.ToLower(devEUI.String()))
+}
+
+// homeHandoverSession returns the handover roaming session of the end device if it is handed over to a serving
+// Network Server.
+func (ns *NetworkServer) homeHandoverSession(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*HandoverSession, error) {
+	if ns.handover == nil || len(ns.handover.handover) == 0 {
+		return nil, nil
+	}
+	s, err := ns.handover.registry.GetByID(ctx, ids.ApplicationIds, ids.DeviceId)
+	if err != nil || s == nil || s.Role != HandoverRoleHome {
+		return nil, err
+	}
+	return s, nil
+}
+
+// partnerHandoverSession returns the handover roaming session of the end device with the given DevEUI, if the session
+// is in the given role and the roaming partner has the given NetID.
+func (ns *NetworkServer) partnerHandoverSession(
+	ctx context.Context, devEUI types.EUI64, role HandoverRole, netID types.NetID,
+) (*HandoverSession, error) {
+	s, err := ns.handover.registry.GetByEUI(ctx, devEUI)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil || s.Role != role || !s.NetID.Equal(netID) {
+		return nil, interop.ErrUnknownDevEUI.New()
+	}
+	return s, nil
+}
+
+// rewrapHandoverKey unwraps the key envelope and wraps the key with the KEK with the given label.
+func (ns *NetworkServer) rewrapHandoverKey(
+	ctx context.Context, ke *ttnpb.KeyEnvelope, kekLabel string,
+) (*ttnpb.KeyEnvelope, error) {
+	return rewrapKeyEnvelope(ctx, ns.KeyService(), ke, kekLabel)
+}
+
+// handoverDeviceProfile returns the device profile of the end device, with the current MAC parameters.
+func (ns *NetworkServer) handoverDeviceProfile(
+	dev *ttnpb.EndDevice, fps *frequencyplans.Store,
+) (*interop.DeviceProfile, error) {
+	fp, err := fps.GetByID(dev.FrequencyPlanId)
+	if err != nil {
+		return nil, err
+	}
+	rfRegion := fp.BandID
+	if region, ok := interop.RFRegionFromBandID(fp.BandID); ok {
+		rfRegion = region
+	}
+	params := dev.GetMacState().GetCurrentParameters()
+	profile := &interop.DeviceProfile{
+		SupportsClassB:    dev.SupportsClassB,
+		SupportsClassC:    dev.SupportsClassC,
+		ClassCTimeout:     uint32(mac.DeviceClassCTimeout(dev, ns.defaultMACSettings) / time.Second),
+		MACVersion:        interop.MACVersion(dev.LorawanVersion),
+		RegParamsRevision: interop.RegParamsRevision(dev.LorawanPhyVersion),
+		SupportsJoin:      dev.SupportsJoin,
+		RXDelay1:          uint32(params.GetRx1Delay()),
+		RXDROffset1:       uint32(params.GetRx1DataRateOffset()),
+		RXDataRate2:       uint32(params.GetRx2DataRateIndex()),
+		RXFreq2:           float64(params.GetRx2Frequency()) / 1e6,
+		RFRegion:          rfRegion,
+		Supports32bitFCnt: mac.DeviceSupports32BitFCnt(dev, ns.defaultMACSettings),
+	}
+	for _, ch := range params.GetChannels() {
+		profile.FactoryPresetFreqs = append(profile.FactoryPresetFreqs, float64(ch.GetUplinkFrequency())/1e6)
+	}
+	return profile, nil
+}
+
+var handoverGetPaths = [...]string{
+	"frequency_plan_id",
+	"ids.dev_eui",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"mac_state",
+	"session",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+}
+
+// StartHandover hands over the session of the end device to the serving Network Server with the given NetID.
+// The session keys are wrapped with the KEK that is shared with the roaming partners.
+// The frame counters are not part of HRStartReq; both Network Servers keep track of the frame counters of the
+// uplinks and downlinks that are forwarded between them.
+func (ns *NetworkServer) StartHandover(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, netID types.NetID, nsID *types.EUI64,
+) (*HandoverSession, error) {
+	r := ns.handover
+	if r == nil {
+		return nil, errHandoverRoamingDisabled.New()
+	}
+	if !r.handsOverTo(netID) {
+		return nil, errHandoverNotAllowed.WithAttributes("net_id", netID)
+	}
+	existing, err := r.registry.GetByID(ctx, ids.ApplicationIds, ids.DeviceId)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errHandoverExists.New()
+	}
+	dev, ctx, err := ns.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, handoverGetPaths[:])
+	if err != nil {
+		return nil, err
+	}
+	devEUI := types.MustEUI64(dev.Ids.DevEui)
+	if devEUI == nil {
+		return nil, errNoDevEUI.New()
+	}
+	session := dev.GetSession()
+	if session.GetKeys().GetFNwkSIntKey() == nil || dev.GetMacState() == nil {
+		return nil, errUnknownSession.New()
+	}
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := ns.handoverDeviceProfile(dev, fps)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &HandoverSession{
+		ApplicationID: ids.ApplicationIds.ApplicationId,
+		DeviceID:      ids.DeviceId,
+		DevEUI:        *devEUI,
+		Role:          HandoverRoleHome,
+		NetID:         netID,
+		NSID:          nsID,
+	}
+	req := &interop.HRStartReq{
+		NsNsMessageHeader: s.header(ns.netID(ctx), ns.nsID(ctx)),
+		DevEUI:            interop.EUI64(*devEUI),
+		DevAddr:           interop.DevAddr(types.MustDevAddr(session.DevAddr).OrZero()),
+		DeviceProfile:     profile,
+	}
+	wrap := func(ke *ttnpb.KeyEnvelope) (*interop.KeyEnvelope, error) {
+		ke, err := ns.rewrapHandoverKey(ctx, ke, r.kekLabel)
+		return (*interop.KeyEnvelope)(ke), err
+	}
+	if macspec.UseNwkKey(dev.LorawanVersion) {
+		if req.FNwkSIntKey, err = wrap(session.Keys.FNwkSIntKey); err != nil {
+			return nil, err
+		}
+		if req.SNwkSIntKey, err = wrap(session.Keys.SNwkSIntKey); err != nil {
+			return nil, err
+		}
+		if req.NwkSEncKey, err = wrap(session.Keys.NwkSEncKey); err != nil {
+			return nil, err
+		}
+	} else if req.NwkSKey, err = wrap(session.Keys.FNwkSIntKey); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.client.HRStartRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	s.CreatedAt = time.Now().UTC()
+	if err := r.registry.Set(ctx, s); err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithField("serving_net_id", netID).Info("Handed over session to serving Network Server")
+	events.Publish(evtStartHandover.NewWithIdentifiersAndData(ctx, ids, s.networkIdentifiers()))
+	return s, nil
+}
+
+// StopHandover stops the handover roaming session of the end device, acting as home Network Server.
+// The session resumes with the frame counters of the forwarded uplinks and downlinks.
+func (ns *NetworkServer) StopHandover(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	r := ns.handover
+	if r == nil {
+		return errHandoverRoamingDisabled.New()
+	}
+	s, err := ns.homeHandoverSession(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return errHandoverNotFound.New()
+	}
+	if _, err := r.client.HRStopRequest(ctx, &interop.HRStopReq{
+		NsNsMessageHeader: s.header(ns.netID(ctx), ns.nsID(ctx)),
+		DevEUI:            interop.EUI64(s.DevEUI),
+	}); err != nil && !errors.Resemble(err, interop.ErrUnknownDevEUI) {
+		return err
+	}
+	if err := r.registry.Delete(ctx, s); err != nil {
+		return err
+	}
+	log.FromContext(ctx).WithField("serving_net_id", s.NetID).Info("Stopped handover roaming")
+	events.Publish(evtStopHandover.NewWithIdentifiersAndData(ctx, ids, s.networkIdentifiers()))
+	return nil
+}
+
+// recordHandoverFrameCounters records the frame counters of an uplink or downlink forwarded between the home and
+// the serving Network Server in the session of the end device, if they are higher than the frame counters of the
+// session. The home Network Server resumes the session with these frame counters when handover roaming stops.
+func (ns *NetworkServer) recordHandoverFrameCounters(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fCntUp, fCntDown *uint32, paths ...string,
+) (*ttnpb.EndDevice, context.Context, error) {
+	return ns.devices.SetByID(ctx, ids.ApplicationIds, ids.DeviceId,
+		ttnpb.AddFields([]string{
+			"mac_state.lorawan_version",
+			"session.last_a_f_cnt_down",
+			"session.last_f_cnt_up",
+			"session.last_n_f_cnt_down",
+		}, paths...),
+		func(_ context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound.New()
+			}
+			if dev.Session == nil {
+				return nil, nil, errUnknownSession.New()
+			}
+			var sets []string
+			if fCntUp != nil && *fCntUp > dev.Session.LastFCntUp {
+				dev.Session.LastFCntUp = *fCntUp
+				sets = append(sets, "session.last_f_cnt_up")
+			}
+			if fCntDown != nil && *fCntDown > dev.Session.LastAFCntDown {
+				dev.Session.LastAFCntDown = *fCntDown
+				sets = append(sets, "session.last_a_f_cnt_down")
+			}
+			if fCntDown != nil && *fCntDown > dev.Session.LastNFCntDown &&
+				macspec.UseSharedFCntDown(dev.GetMacState().GetLorawanVersion()) {
+				dev.Session.LastNFCntDown = *fCntDown
+				sets = append(sets, "session.last_n_f_cnt_down")
+			}
+			return dev, sets, nil
+		},
+	)
+}
+
+// forwardHandoverDownlinks forwards the application downlinks of the end device to the serving Network Server,
+// acting as home Network Server.
+func (ns *NetworkServer) forwardHandoverDownlinks(
+	ctx context.Context, s *HandoverSession, downs ...*ttnpb.ApplicationDownlink,
+) error {
+	devEUI := interop.EUI64(s.DevEUI)
+	for _, down := range downs {
+		fPort, fCnt := down.FPort, down.FCnt
+		if _, err := ns.handover.client.XmitDataRequest(ctx, &interop.XmitDataReq{
+			NsNsMessageHeader: s.header(ns.netID(ctx), ns.nsID(ctx)),
+			FRMPayload:        interop.Buffer(down.FrmPayload),
+			DLMetaData: &interop.DLMetaData{
+				DevEUI:         &devEUI,
+				FPort:          &fPort,
+				FCntDown:       &fCnt,
+				Confirmed:      down.Confirmed,
+				HiPriorityFlag: down.Priority >= ttnpb.TxSchedulePriority_HIGH,
+			},
+		}); err != nil {
+			return err
+		}
+		if _, _, err := ns.recordHandoverFrameCounters(ctx, s.EndDeviceIdentifiers(), nil, &fCnt); err != nil {
+			return err
+		}
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"downlink_count", len(downs),
+		"serving_net_id", s.NetID,
+	)).Debug("Forwarded application downlinks to serving Network Server")
+	return nil
+}
+
+// handleHandoverUplink submits the application uplink received from the serving Network Server to the Application
+// Server, acting as home Network Server.
+func (ns *NetworkServer) handleHandoverUplink(ctx context.Context, in *interop.XmitDataReq) error {
+	md := in.ULMetaData
+	if md.DevEUI == nil || md.FPort == nil || md.FCntUp == nil {
+		return interop.ErrMalformedMessage.New()
+	}
+	s, err := ns.partnerHandoverSession(ctx, types.EUI64(*md.DevEUI), HandoverRoleHome, types.NetID(in.SenderID))
+	if err != nil {
+		return err
+	}
+	ids := s.EndDeviceIdentifiers()
+	dev, ctx, err := ns.recordHandoverFrameCounters(ctx, ids, md.FCntUp, nil,
+		"session.dev_addr",
+		"session.keys.session_key_id",
+	)
+	if err != nil {
+		return err
+	}
+	ids.DevAddr = dev.Session.DevAddr
+
+	ctx = appendUplinkCorrelationID(ctx)
+	receivedAt := timestamppb.New(md.RecvTime)
+	ns.submitApplicationUplinks(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds:   ids,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:     receivedAt,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+			SessionKeyId: dev.Session.Keys.GetSessionKeyId(),
+			FPort:        *md.FPort,
+			FCnt:         *md.FCntUp,
+			FrmPayload:   in.FRMPayload,
+			Confirmed:    md.Confirmed,
+			ReceivedAt:   receivedAt,
+			RxMetadata: []*ttnpb.RxMetadata{{
+				GatewayIds: handoverRoamingGatewayIdentifiers,
+			}},
+		}},
+	})
+	return nil
+}
+
+// handoverProfile returns the device profile of the end device handed over to the serving Network Server,
+// acting as home Network Server.
+func (ns *NetworkServer) handoverProfile(ctx context.Context, in *interop.ProfileReq) (*interop.DeviceProfile, error) {
+	s, err := ns.partnerHandoverSession(ctx, types.EUI64(in.DevEUI), HandoverRoleHome, types.NetID(in.SenderID))
+	if err != nil {
+		return nil, err
+	}
+	dev, ctx, err := ns.devices.GetByID(ctx,
+		&ttnpb.ApplicationIdentifiers{ApplicationId: s.ApplicationID}, s.DeviceID, handoverGetPaths[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ns.handoverDeviceProfile(dev, fps)
+}
+
+var acceptHandoverSetPaths = [...]string{
+	"frequency_plan_id",
+	"ids.application_ids",
+	"ids.dev_eui",
+	"ids.device_id",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"mac_state",
+	"pending_mac_state",
+	"pending_session",
+	"session",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+}
+
+// acceptHandover creates the end device of the session handed over by the home Network Server, acting as serving
+// Network Server. The end device is created in the configured application. The session keys are unwrapped and
+// wrapped with the KEK used to encrypt device keys at rest.
+func (ns *NetworkServer) acceptHandover(ctx context.Context, in *interop.HRStartReq) error {
+	r := ns.handover
+	senderID, devEUI := types.NetID(in.SenderID), types.EUI64(in.DevEUI)
+	existing, err := r.registry.GetByEUI(ctx, devEUI)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errHandoverExists.New()
+	}
+	s := &HandoverSession{
+		ApplicationID: r.applicationIDs.ApplicationId,
+		DeviceID:      handoverDeviceID(devEUI),
+		DevEUI:        devEUI,
+		Role:          HandoverRoleServing,
+		NetID:         senderID,
+		NSID:          (*types.EUI64)(in.SenderNSID),
+	}
+
+	profile := in.DeviceProfile
+	if profile == nil {
+		ans, err := r.client.ProfileRequest(ctx, &interop.ProfileReq{
+			NsNsMessageHeader: s.header(ns.netID(ctx), ns.nsID(ctx)),
+			DevEUI:            in.DevEUI,
+		})
+		if err != nil {
+			return err
+		}
+		profile = ans.DeviceProfile
+	}
+	if profile == nil {
+		return interop.ErrMalformedMessage.New()
+	}
+	macVersion := ttnpb.MACVersion(profile.MACVersion)
+
+	// The session key ID is only used locally, as the application messages are forwarded without it.
+	skID, err := ulid.New(ulid.Now(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	keys := &ttnpb.SessionKeys{
+		SessionKeyId: skID[:],
+	}
+	wrap := func(ke *interop.KeyEnvelope) (*ttnpb.KeyEnvelope, error) {
+		return ns.rewrapHandoverKey(ctx, (*ttnpb.KeyEnvelope)(ke), ns.deviceKEKLabel)
+	}
+	if macspec.UseNwkKey(macVersion) {
+		if keys.FNwkSIntKey, err = wrap(in.FNwkSIntKey); err != nil {
+			return err
+		}
+		if keys.SNwkSIntKey, err = wrap(in.SNwkSIntKey); err != nil {
+			return err
+		}
+		if keys.NwkSEncKey, err = wrap(in.NwkSEncKey); err != nil {
+			return err
+		}
+	} else {
+		if keys.FNwkSIntKey, err = wrap(in.NwkSKey); err != nil {
+			return err
+		}
+		keys.SNwkSIntKey, keys.NwkSEncKey = keys.FNwkSIntKey, keys.FNwkSIntKey
+	}
+	if keys.FNwkSIntKey == nil || keys.SNwkSIntKey == nil || keys.NwkSEncKey == nil {
+		return interop.ErrMalformedMessage.New()
+	}
+
+	// The session starts with the frame counters of the first uplinks and downlinks.
+	session := &ttnpb.Session{
+		DevAddr:   types.DevAddr(in.DevAddr).Bytes(),
+		Keys:      keys,
+		StartedAt: timestamppb.New(time.Now()),
+	}
+	// The serving Network Server only serves the session. Rejoins are handled by the home Network Server.
+	dev := &ttnpb.EndDevice{
+		Ids:               s.EndDeviceIdentifiers(),
+		FrequencyPlanId:   r.frequencyPlanID,
+		LorawanVersion:    macVersion,
+		LorawanPhyVersion: ttnpb.PHYVersion(profile.RegParamsRevision),
+		SupportsClassB:    profile.SupportsClassB,
+		SupportsClassC:    profile.SupportsClassC,
+		MacSettings: &ttnpb.MACSettings{
+			Supports_32BitFCnt: &ttnpb.BoolValue{Value: profile.Supports32bitFCnt},
+		},
+		Session: session,
+	}
+	if profile.SupportsClassC && profile.ClassCTimeout > 0 {
+		dev.MacSettings.ClassCTimeout = durationpb.New(time.Duration(profile.ClassCTimeout) * time.Second)
+	}
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return err
+	}
+	dev.MacState, err = mac.NewState(dev, fps, ns.defaultMACSettings)
+	if err != nil {
+		return err
+	}
+	params := dev.MacState.CurrentParameters
+	params.Rx1Delay = ttnpb.RxDelay(profile.RXDelay1)
+	params.Rx1DataRateOffset = ttnpb.DataRateOffset(profile.RXDROffset1)
+	params.Rx2DataRateIndex = ttnpb.DataRateIndex(profile.RXDataRate2)
+	if profile.RXFreq2 > 0 {
+		params.Rx2Frequency = uint64(math.Round(profile.RXFreq2 * 1e6))
+	}
+
+	if _, ctx, err = ns.devices.SetByID(ctx, r.applicationIDs, s.DeviceID, acceptHandoverSetPaths[:],
+		func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			return dev, acceptHandoverSetPaths[:], nil
+		},
+	); err != nil {
+		return err
+	}
+	s.CreatedAt = time.Now().UTC()
+	if err := r.registry.Set(ctx, s); err != nil {
+		return err
+	}
+	log.FromContext(ctx).WithField("home_net_id", senderID).Info("Accepted session handed over by home Network Server")
+	events.Publish(evtAcceptHandover.NewWithIdentifiersAndData(ctx, dev.Ids, s.networkIdentifiers()))
+	return nil
+}
+
+// stopAcceptedHandover deletes the end device of the session handed over by the home Network Server, acting as
+// serving Network Server.
+func (ns *NetworkServer) stopAcceptedHandover(ctx context.Context, s *HandoverSession) error {
+	ids := s.EndDeviceIdentifiers()
+	if _, _, err := ns.devices.SetByID(ctx, ids.ApplicationIds, ids.DeviceId, nil,
+		func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			return nil, nil, nil
+		},
+	); err != nil {
+		return err
+	}
+	return ns.handover.registry.Delete(ctx, s)
+}
+
+// handleHandoverStop handles the request to stop handover roaming of the roaming partner.
+// As serving Network Server, the end device is deleted. As home Network Server, the session is resumed.
+func (ns *NetworkServer) handleHandoverStop(ctx context.Context, in *interop.HRStopReq) (*interop.HRStopAns, error) {
+	s, err := ns.handover.registry.GetByEUI(ctx, types.EUI64(in.DevEUI))
+	if err != nil {
+		return nil, err
+	}
+	if s == nil || !s.NetID.Equal(types.NetID(in.SenderID)) {
+		return nil, interop.ErrUnknownDevEUI.New()
+	}
+	switch s.Role {
+	case HandoverRoleServing:
+		if err := ns.stopAcceptedHandover(ctx, s); err != nil {
+			return nil, err
+		}
+	case HandoverRoleHome:
+		if err := ns.handover.registry.Delete(ctx, s); err != nil {
+			return nil, err
+		}
+	}
+	events.Publish(evtStopHandover.NewWithIdentifiersAndData(ctx, s.EndDeviceIdentifiers(), s.networkIdentifiers()))
+	return &interop.HRStopAns{}, nil
+}
+
+// forwardHandoverUplinks forwards the application uplinks of the end device of an accepted session to the home
+// Network Server, acting as serving Network Server. Only uplink messages are forwarded; the home Network Server
+// reports the other application messages to its Application Server.
+func (ns *NetworkServer) forwardHandoverUplinks(ctx context.Context, ups ...*ttnpb.ApplicationUp) {
+	logger := log.FromContext(ctx)
+	ns.StartTask(&task.Config{
+		Context: ns.FromRequestContext(ctx),
+		ID:      "handover_roaming_forward_uplinks",
+		Func: func(ctx context.Context) error {
+			for _, up := range ups {
+				msg := up.GetUplinkMessage()
+				if msg == nil {
+					continue
+				}
+				s, err := ns.handover.registry.GetByID(ctx, up.EndDeviceIds.ApplicationIds, up.EndDeviceIds.DeviceId)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to get handover roaming session")
+					return nil
+				}
+				if s == nil || s.Role != HandoverRoleServing {
+					logger.Debug("No handover roaming session found, drop application uplink")
+					continue
+				}
+				devEUI := interop.EUI64(s.DevEUI)
+				fPort, fCnt := msg.FPort, msg.FCnt
+				if _, err := ns.handover.client.XmitDataRequest(ctx, &interop.XmitDataReq{
+					NsNsMessageHeader: s.header(ns.netID(ctx), ns.nsID(ctx)),
+					FRMPayload:        interop.Buffer(msg.FrmPayload),
+					ULMetaData: &interop.ULMetaData{
+						DevEUI:    &devEUI,
+						FPort:     &fPort,
+						FCntUp:    &fCnt,
+						Confirmed: msg.Confirmed,
+						RecvTime:  ttnpb.StdTimeOrZero(msg.ReceivedAt).UTC(),
+						GWCnt:     uint32(len(msg.RxMetadata)),
+					},
+				}); err != nil {
+					logger.WithError(err).Warn("Failed to forward application uplink to home Network Server")
+					continue
+				}
+				logger.Debug("Forwarded application uplink to home Network Server")
+			}
+			return nil
+		},
+		Restart: task.RestartNever,
+		Backoff: task.DefaultBackoffConfig,
+	})
+}
+
+// handleHandoverDownlink pushes the application downlink received from the home Network Server to the downlink queue
+// of the end device, acting as serving Network Server.
+func (ns *NetworkServer) handleHandoverDownlink(ctx context.Context, in *interop.XmitDataReq) error {
+	md := in.DLMetaData
+	if md.DevEUI == nil || md.FPort == nil || md.FCntDown == nil {
+		return interop.ErrMalformedMessage.New()
+	}
+	s, err := ns.partnerHandoverSession(ctx, types.EUI64(*md.DevEUI), HandoverRoleServing, types.NetID(in.SenderID))
+	if err != nil {
+		return err
+	}
+	ids := s.EndDeviceIdentifiers()
+	dev, ctx, err := ns.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{
+		"session.keys.session_key_id",
+	})
+	if err != nil {
+		return err
+	}
+	if dev.Session == nil {
+		return errUnknownSession.New()
+	}
+	priority := ttnpb.TxSchedulePriority_NORMAL
+	if md.HiPriorityFlag {
+		priority = ttnpb.TxSchedulePriority_HIGH
+	}
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("ns:handover:%s", events.NewCorrelationID()))
+	return ns.pushApplicationDownlinks(ctx, ids, &ttnpb.ApplicationDownlink{
+		SessionKeyId:   dev.Session.Keys.GetSessionKeyId(),
+		FPort:          *md.FPort,
+		FCnt:           *md.FCntDown,
+		FrmPayload:     in.FRMPayload,
+		Confirmed:      md.Confirmed,
+		Priority:       priority,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+	})
+}
+
+func containsNetID(netIDs []types.NetID, netID types.NetID) bool {
+	for _, id := range netIDs {
+		if id.Equal(netID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *HandoverSession) proto() *ttnpb.EndDeviceHandover {
+	role := ttnpb.HandoverRoamingRole_HANDOVER_ROAMING_ROLE_HOME
+	if s.Role == HandoverRoleServing {
+		role = ttnpb.HandoverRoamingRole_HANDOVER_ROAMING_ROLE_SERVING
+	}
+	return &ttnpb.EndDeviceHandover{
+		EndDeviceIds: s.EndDeviceIdentifiers(),
+		Role:         role,
+		PartnerIds:   s.networkIdentifiers(),
+		CreatedAt:    timestamppb.New(s.CreatedAt),
+	}
+}
+
+var errNoServingNetID = errors.DefineInvalidArgument("no_serving_net_id", "no serving NetID specified")
+
+// GetEndDeviceHandover implements ttnpb.NsServer.
+func (ns *NetworkServer) GetEndDeviceHandover(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDeviceHandover, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if ns.handover == nil {
+		return nil, errHandoverRoamingDisabled.New()
+	}
+	s, err := ns.handover.registry.GetByID(ctx, ids.ApplicationIds, ids.DeviceId)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, errHandoverNotFound.New()
+	}
+	return s.proto(), nil
+}
+
+// StartEndDeviceHandover implements ttnpb.NsServer.
+func (ns *NetworkServer) StartEndDeviceHandover(
+	ctx context.Context, req *ttnpb.StartEndDeviceHandoverRequest,
+) (*ttnpb.EndDeviceHandover, error) {
+	ids := req.EndDeviceIds
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	netID := types.MustNetID(req.ServingNetworkIds.GetNetId())
+	if netID == nil {
+		return nil, errNoServingNetID.New()
+	}
+	s, err := ns.StartHandover(ctx, ids, *netID, types.MustEUI64(req.ServingNetworkIds.GetNsId()))
+	if err != nil {
+		return nil, err
+	}
+	return s.proto(), nil
+}
+
+// StopEndDeviceHandover implements ttnpb.NsServer.
+func (ns *NetworkServer) StopEndDeviceHandover(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*emptypb.Empty, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if err := ns.StopHandover(ctx, ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
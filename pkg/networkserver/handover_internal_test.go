@@ -0,0 +1,273 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockHandoverRoamingClient struct {
+	HandoverRoamingClient
+}
+
+type mockHandoverRegistry struct {
+	HandoverRegistry
+}
+
+func TestNewHandoverRoaming(t *testing.T) {
+	t.Parallel()
+	client, registry := &mockHandoverRoamingClient{}, &mockHandoverRegistry{}
+	for _, tc := range []struct {
+		Name   string
+		Config HandoverRoamingConfig
+		Client HandoverRoamingClient
+		Nil    bool
+		Error  bool
+	}{
+		{
+			Name: "Disabled",
+			Nil:  true,
+		},
+		{
+			Name:   "NoClient",
+			Config: HandoverRoamingConfig{Registry: registry, HandoverNetIDs: []string{"000013"}},
+			Error:  true,
+		},
+		{
+			Name:   "NoRegistry",
+			Config: HandoverRoamingConfig{HandoverNetIDs: []string{"000013"}},
+			Client: client,
+			Error:  true,
+		},
+		{
+			Name:   "InvalidNetID",
+			Config: HandoverRoamingConfig{Registry: registry, HandoverNetIDs: []string{"invalid"}},
+			Client: client,
+			Error:  true,
+		},
+		{
+			Name: "AcceptWithoutApplication",
+			Config: HandoverRoamingConfig{
+				Registry:        registry,
+				AcceptNetIDs:    []string{"000013"},
+				FrequencyPlanID: test.EUFrequencyPlanID,
+			},
+			Client: client,
+			Error:  true,
+		},
+		{
+			Name: "AcceptWithoutFrequencyPlan",
+			Config: HandoverRoamingConfig{
+				Registry:      registry,
+				AcceptNetIDs:  []string{"000013"},
+				ApplicationID: "roaming",
+			},
+			Client: client,
+			Error:  true,
+		},
+		{
+			Name:   "Home",
+			Config: HandoverRoamingConfig{Registry: registry, HandoverNetIDs: []string{"000013"}},
+			Client: client,
+		},
+		{
+			Name: "Serving",
+			Config: HandoverRoamingConfig{
+				Registry:        registry,
+				AcceptNetIDs:    []string{"000013"},
+				ApplicationID:   "roaming",
+				FrequencyPlanID: test.EUFrequencyPlanID,
+			},
+			Client: client,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(_ context.Context, t *testing.T, a *assertions.Assertion) {
+				t.Helper()
+				r, err := newHandoverRoaming(tc.Config, tc.Client)
+				if tc.Error {
+					a.So(err, should.NotBeNil)
+					return
+				}
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				if tc.Nil {
+					a.So(r, should.BeNil)
+					return
+				}
+				a.So(r, should.NotBeNil)
+			},
+		})
+	}
+}
+
+func TestHandoverRoamingPartners(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	var nilRoaming *handoverRoaming
+	a.So(nilRoaming.handsOverTo(types.NetID{0x00, 0x00, 0x13}), should.BeFalse)
+	a.So(nilRoaming.accepts(types.NetID{0x00, 0x00, 0x13}), should.BeFalse)
+	a.So(nilRoaming.serves(&ttnpb.ApplicationIdentifiers{ApplicationId: "roaming"}), should.BeFalse)
+
+	r, err := newHandoverRoaming(HandoverRoamingConfig{
+		Registry:        &mockHandoverRegistry{},
+		HandoverNetIDs:  []string{"000013"},
+		AcceptNetIDs:    []string{"000014"},
+		ApplicationID:   "roaming",
+		FrequencyPlanID: test.EUFrequencyPlanID,
+	}, &mockHandoverRoamingClient{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(r.handsOverTo(types.NetID{0x00, 0x00, 0x13}), should.BeTrue)
+	a.So(r.handsOverTo(types.NetID{0x00, 0x00, 0x14}), should.BeFalse)
+	a.So(r.accepts(types.NetID{0x00, 0x00, 0x14}), should.BeTrue)
+	a.So(r.accepts(types.NetID{0x00, 0x00, 0x13}), should.BeFalse)
+	a.So(r.serves(&ttnpb.ApplicationIdentifiers{ApplicationId: "roaming"}), should.BeTrue)
+	a.So(r.serves(&ttnpb.ApplicationIdentifiers{ApplicationId: "other"}), should.BeFalse)
+
+	a.So(handoverDeviceID(types.EUI64{0x70, 0xB3, 0xD5, 0x7E, 0xD0, 0x00, 0x00, 0x01}),
+		should.Equal, "roaming-70b3d57ed0000001")
+}
+
+func TestHandoverDeviceProfile(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	ns := &NetworkServer{
+		defaultMACSettings: &ttnpb.MACSettings{},
+	}
+	profile, err := ns.handoverDeviceProfile(&ttnpb.EndDevice{
+		FrequencyPlanId:   test.EUFrequencyPlanID,
+		LorawanVersion:    ttnpb.MACVersion_MAC_V1_0_3,
+		LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_0_3_REV_A,
+		SupportsClassC:    true,
+		SupportsJoin:      true,
+		MacSettings: &ttnpb.MACSettings{
+			Supports_32BitFCnt: &ttnpb.BoolValue{Value: true},
+		},
+		MacState: &ttnpb.MACState{
+			CurrentParameters: &ttnpb.MACParameters{
+				Rx1Delay:          ttnpb.RxDelay_RX_DELAY_5,
+				Rx1DataRateOffset: ttnpb.DataRateOffset_DATA_RATE_OFFSET_1,
+				Rx2DataRateIndex:  ttnpb.DataRateIndex_DATA_RATE_3,
+				Rx2Frequency:      869525000,
+				Channels: []*ttnpb.MACParameters_Channel{
+					{UplinkFrequency: 868100000},
+					{UplinkFrequency: 868300000},
+				},
+			},
+		},
+	}, frequencyplans.NewStore(test.FrequencyPlansFetcher))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(profile, should.Resemble, &interop.DeviceProfile{
+		SupportsClassC:     true,
+		ClassCTimeout:      300,
+		MACVersion:         interop.MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+		RegParamsRevision:  interop.RegParamsRevision(ttnpb.PHYVersion_RP001_V1_0_3_REV_A),
+		SupportsJoin:       true,
+		RXDelay1:           5,
+		RXDROffset1:        1,
+		RXDataRate2:        3,
+		RXFreq2:            869.525,
+		FactoryPresetFreqs: []float64{868.1, 868.3},
+		RFRegion:           "EU868",
+		Supports32bitFCnt:  true,
+	})
+}
+
+func TestRecordHandoverFrameCounters(t *testing.T) {
+	t.Parallel()
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	for _, tc := range []struct {
+		Name           string
+		MACVersion     ttnpb.MACVersion
+		ExpectedNFCnt  uint32
+		ExpectedAFCnt  uint32
+		ExpectedFCntUp uint32
+	}{
+		{
+			Name:           "1.0.3",
+			MACVersion:     ttnpb.MACVersion_MAC_V1_0_3,
+			ExpectedNFCnt:  42,
+			ExpectedAFCnt:  42,
+			ExpectedFCntUp: 100,
+		},
+		{
+			Name:           "1.1",
+			MACVersion:     ttnpb.MACVersion_MAC_V1_1,
+			ExpectedNFCnt:  10,
+			ExpectedAFCnt:  42,
+			ExpectedFCntUp: 100,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, ctx := test.New(t)
+
+			registry := &mockSetByIDRegistry{
+				stored: &ttnpb.EndDevice{
+					Ids: ids,
+					MacState: &ttnpb.MACState{
+						LorawanVersion: tc.MACVersion,
+					},
+					Session: &ttnpb.Session{
+						LastFCntUp:    20,
+						LastNFCntDown: 10,
+						LastAFCntDown: 5,
+					},
+				},
+			}
+			ns := &NetworkServer{devices: registry}
+
+			fCntUp, fCntDown := uint32(100), uint32(42)
+			_, _, err := ns.recordHandoverFrameCounters(ctx, ids, &fCntUp, nil)
+			a.So(err, should.BeNil)
+			_, _, err = ns.recordHandoverFrameCounters(ctx, ids, nil, &fCntDown)
+			a.So(err, should.BeNil)
+
+			// Lower frame counters are ignored.
+			fCntUp, fCntDown = 50, 1
+			_, _, err = ns.recordHandoverFrameCounters(ctx, ids, &fCntUp, &fCntDown)
+			a.So(err, should.BeNil)
+
+			session := registry.stored.Session
+			a.So(session.LastFCntUp, should.Equal, tc.ExpectedFCntUp)
+			a.So(session.LastNFCntDown, should.Equal, tc.ExpectedNFCnt)
+			a.So(session.LastAFCntDown, should.Equal, tc.ExpectedAFCnt)
+		})
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+type interopServer struct {
+	NS *NetworkServer
+}
+
+// PRStartRequest handles the uplink forwarded by a forwarding Network Server, acting as serving Network Server.
+// Passive roaming is stateless, so the answer always has a lifetime of 0.
+func (srv interopServer) PRStartRequest(ctx context.Context, in *interop.PRStartReq) (*interop.PRStartAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	ns := srv.NS
+
+	if !ns.passiveRoaming.serves(types.NetID(in.SenderID)) {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if !types.NetID(in.ReceiverID).Equal(ns.netID(ctx)) {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	up, err := passiveRoamingUplink(in)
+	if err != nil {
+		return nil, err
+	}
+	ctx = appendUplinkCorrelationID(ctx)
+	up.CorrelationIds = events.CorrelationIDsFromContext(ctx)
+
+	if err := ns.handleUplink(ctx, up); err != nil {
+		switch {
+		case errors.Resemble(err, errDuplicateUplink):
+			// The uplink is already handled, possibly through another forwarding Network Server.
+		case errors.Resemble(err, errDeviceNotFound):
+			return nil, interop.ErrUnknownDevAddr.WithCause(err)
+		case errors.Resemble(err, errDecodePayload),
+			errors.Resemble(err, errRawPayloadTooShort):
+			return nil, interop.ErrMalformedMessage.WithCause(err)
+		default:
+			return nil, err
+		}
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	lifetime := uint32(0)
+	return &interop.PRStartAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		Lifetime: &lifetime,
+	}, nil
+}
+
+// PRStopRequest handles the request to stop passive roaming. As passive roaming is stateless, there is no state to
+// remove.
+func (srv interopServer) PRStopRequest(ctx context.Context, in *interop.PRStopReq) (*interop.PRStopAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	ns := srv.NS
+
+	if !ns.passiveRoaming.serves(types.NetID(in.SenderID)) && !ns.passiveRoaming.forwardsTo(types.NetID(in.SenderID)) {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if !types.NetID(in.ReceiverID).Equal(ns.netID(ctx)) {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.PRStopAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// XmitDataRequest transmits the downlink of a serving Network Server, acting as forwarding Network Server.
+// Requests with FRMPayload carry application messages of handover roaming sessions.
+func (srv interopServer) XmitDataRequest(ctx context.Context, in *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	ns := srv.NS
+
+	if len(in.FRMPayload) > 0 {
+		return srv.handoverXmitDataRequest(ctx, in)
+	}
+	if !ns.passiveRoaming.forwardsTo(types.NetID(in.SenderID)) {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if !types.NetID(in.ReceiverID).Equal(ns.netID(ctx)) {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	if len(in.PHYPayload) == 0 || in.DLMetaData == nil {
+		return nil, interop.ErrMalformedMessage.New()
+	}
+	if err := ns.transmitPassiveRoamingDownlink(ctx, in.PHYPayload, in.DLMetaData); err != nil {
+		if errors.Resemble(err, interop.ErrMalformedMessage) {
+			return nil, err
+		}
+		return nil, interop.ErrTransmitFailed.WithCause(err)
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		DLFreq1: in.DLMetaData.DLFreq1,
+		DLFreq2: in.DLMetaData.DLFreq2,
+	}, nil
+}
+
+// handoverXmitDataRequest handles the application message of a handover roaming session. Uplinks are received from
+// the serving Network Server and downlinks are received from the home Network Server.
+func (srv interopServer) handoverXmitDataRequest(
+	ctx context.Context, in *interop.XmitDataReq,
+) (*interop.XmitDataAns, error) {
+	ns := srv.NS
+
+	senderID := types.NetID(in.SenderID)
+	if !ns.handover.handsOverTo(senderID) && !ns.handover.accepts(senderID) {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if !types.NetID(in.ReceiverID).Equal(ns.netID(ctx)) {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	var err error
+	switch {
+	case in.ULMetaData != nil && in.DLMetaData == nil:
+		err = ns.handleHandoverUplink(ctx, in)
+	case in.DLMetaData != nil && in.ULMetaData == nil:
+		err = ns.handleHandoverDownlink(ctx, in)
+	default:
+		err = interop.ErrMalformedMessage.New()
+	}
+	if err != nil {
+		return nil, handoverInteropError(err)
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// HRStartRequest accepts the session handed over by a home Network Server, acting as serving Network Server.
+func (srv interopServer) HRStartRequest(ctx context.Context, in *interop.HRStartReq) (*interop.HRStartAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	ns := srv.NS
+
+	if !ns.handover.accepts(types.NetID(in.SenderID)) {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if !types.NetID(in.ReceiverID).Equal(ns.netID(ctx)) {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	if err := ns.acceptHandover(ctx, in); err != nil {
+		return nil, handoverInteropError(err)
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.HRStartAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		Lifetime: in.Lifetime,
+	}, nil
+}
+
+// HRStopRequest stops the handover roaming session of the roaming partner.
+func (srv interopServer) HRStopRequest(ctx context.Context, in *interop.HRStopReq) (*interop.HRStopAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	ns := srv.NS
+
+	senderID := types.NetID(in.SenderID)
+	if !ns.handover.handsOverTo(senderID) && !ns.handover.accepts(senderID) {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if !types.NetID(in.ReceiverID).Equal(ns.netID(ctx)) {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	ans, err := ns.handleHandoverStop(ctx, in)
+	if err != nil {
+		return nil, handoverInteropError(err)
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	ans.NsNsMessageHeader = header
+	ans.Result = interop.Result{
+		ResultCode: interop.ResultSuccess,
+	}
+	return ans, nil
+}
+
+// ProfileRequest returns the device profile of the end device handed over to a serving Network Server, acting as home
+// Network Server.
+func (srv interopServer) ProfileRequest(ctx context.Context, in *interop.ProfileReq) (*interop.ProfileAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	ns := srv.NS
+
+	if !ns.handover.handsOverTo(types.NetID(in.SenderID)) {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if !types.NetID(in.ReceiverID).Equal(ns.netID(ctx)) {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	profile, err := ns.handoverProfile(ctx, in)
+	if err != nil {
+		return nil, handoverInteropError(err)
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.ProfileAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		DeviceProfile:         profile,
+		RoamingActivationType: interop.RoamingActivationHandover,
+	}, nil
+}
+
+// handoverInteropError returns the interop error of the handover roaming error.
+func handoverInteropError(err error) error {
+	switch {
+	case errors.Resemble(err, errDeviceNotFound):
+		return interop.ErrUnknownDevEUI.WithCause(err)
+	case errors.Resemble(err, errHandoverExists):
+		return interop.ErrRoamingActivation.WithCause(err)
+	default:
+		return err
+	}
+}
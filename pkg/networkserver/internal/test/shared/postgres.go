@@ -0,0 +1,36 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/postgres"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+)
+
+func NewPostgreSQLDeviceRegistry(ctx context.Context) (DeviceRegistry, func()) {
+	tb := test.MustTBFromContext(ctx)
+	db, drop := test.NewPostgreSQL(ctx)
+	reg := &postgres.DeviceRegistry{
+		DB: db,
+	}
+	if err := reg.Init(ctx); err != nil {
+		drop()
+		tb.Fatalf("Failed to initialize PostgreSQL device registry: %s", test.FormatError(err))
+	}
+	return reg, drop
+}
@@ -69,10 +69,14 @@ func FrequencyPlanAndBand(frequencyPlanID string, phyVersion ttnpb.PHYVersion, f
 	}
 	b, ok := LoRaWANBands[fp.BandID][phyVersion]
 	if !ok || b == nil {
-		return nil, nil, errNoBandVersion.WithAttributes(
-			"ver", phyVersion,
-			"id", fp.BandID,
-		)
+		// NOTE: Custom bands are registered in the frequency plans store.
+		b, err = fps.GetBand(fp.BandID, phyVersion)
+		if err != nil {
+			return nil, nil, errNoBandVersion.WithAttributes(
+				"ver", phyVersion,
+				"id", fp.BandID,
+			)
+		}
 	}
 	return fp, b, nil
 }
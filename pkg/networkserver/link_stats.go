@@ -0,0 +1,219 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errLinkStatsDisabled = errors.DefineFailedPrecondition("link_stats_disabled", "link statistics are disabled")
+
+// LinkSample is the link quality sample of a deduplicated data uplink.
+type LinkSample struct {
+	ReceivedAt     time.Time `json:"received_at"`
+	FCnt           uint32    `json:"f_cnt"`
+	Retransmission bool      `json:"retransmission,omitempty"`
+	DataRateIndex  uint32    `json:"data_rate_index"`
+	// SNR is the best SNR of the receiving gateways. It is only set for LoRa uplinks.
+	SNR *float32 `json:"snr,omitempty"`
+	// RSSI is the best channel RSSI of the receiving gateways.
+	RSSI       float32  `json:"rssi"`
+	GatewayIDs []string `json:"gateway_ids,omitempty"`
+}
+
+// LinkStatsRegistry stores the most recent link samples of end devices.
+type LinkStatsRegistry interface {
+	// Add appends the sample to the samples of the end device and keeps at most limit most recent samples.
+	// The samples expire after ttl without new samples. A ttl of 0 means that the samples do not expire.
+	Add(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, sample *LinkSample, limit int, ttl time.Duration) error
+	// Get returns the samples of the end device, oldest first.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*LinkSample, error)
+	// Clear removes the samples of the end device.
+	Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error
+}
+
+// percentiles returns the nearest-rank percentiles of vs. It sorts vs.
+func percentiles(vs []float32) *ttnpb.EndDeviceLinkStats_Percentiles {
+	if len(vs) == 0 {
+		return nil
+	}
+	sort.Slice(vs, func(i, j int) bool { return vs[i] < vs[j] })
+	rank := func(p float64) float32 {
+		i := int(math.Ceil(p*float64(len(vs)))) - 1
+		if i < 0 {
+			i = 0
+		}
+		return vs[i]
+	}
+	return &ttnpb.EndDeviceLinkStats_Percentiles{
+		Min:    vs[0],
+		P10:    rank(0.1),
+		Median: rank(0.5),
+		P90:    rank(0.9),
+		Max:    vs[len(vs)-1],
+	}
+}
+
+// computeLinkStats computes the link statistics from the samples, oldest first.
+// Frame counter gaps between consecutive uplinks are counted as missed uplinks.
+// A frame counter that does not increase, for example after a rejoin, starts a new sequence.
+func computeLinkStats(samples []*LinkSample) *ttnpb.EndDeviceLinkStats {
+	stats := &ttnpb.EndDeviceLinkStats{
+		SampleCount: uint32(len(samples)),
+		DataRates:   make(map[uint32]uint32),
+		GatewayDiversity: &ttnpb.EndDeviceLinkStats_GatewayDiversity{
+			Gateways: make(map[string]uint32),
+		},
+	}
+	if len(samples) == 0 {
+		return stats
+	}
+	stats.FirstUplinkAt = timestamppb.New(samples[0].ReceivedAt)
+	stats.LastUplinkAt = timestamppb.New(samples[len(samples)-1].ReceivedAt)
+	stats.LastFCnt = samples[len(samples)-1].FCnt
+
+	var (
+		snrs, rssis  = make([]float32, 0, len(samples)), make([]float32, 0, len(samples))
+		gatewayCount int
+		prev         *LinkSample
+	)
+	diversity := stats.GatewayDiversity
+	diversity.Min = math.MaxUint32
+	for _, s := range samples {
+		stats.DataRates[s.DataRateIndex]++
+		if s.SNR != nil {
+			snrs = append(snrs, *s.SNR)
+		}
+		rssis = append(rssis, s.RSSI)
+		for _, gtwID := range s.GatewayIDs {
+			diversity.Gateways[gtwID]++
+		}
+		n := uint32(len(s.GatewayIDs))
+		gatewayCount += len(s.GatewayIDs)
+		if n < diversity.Min {
+			diversity.Min = n
+		}
+		if n > diversity.Max {
+			diversity.Max = n
+		}
+
+		if s.Retransmission {
+			stats.RetransmissionCount++
+			continue
+		}
+		stats.UplinkCount++
+		if prev != nil && s.FCnt > prev.FCnt {
+			stats.MissedUplinkCount += s.FCnt - prev.FCnt - 1
+		}
+		prev = s
+	}
+	diversity.Mean = float32(gatewayCount) / float32(len(samples))
+	if total := stats.UplinkCount + stats.MissedUplinkCount; total > 0 {
+		stats.PacketErrorRate = float32(stats.MissedUplinkCount) / float32(total)
+	}
+	stats.Snr, stats.Rssi = percentiles(snrs), percentiles(rssis)
+	return stats
+}
+
+// newLinkSample returns the link sample of the deduplicated data uplink up.
+func newLinkSample(
+	up *ttnpb.UplinkMessage, fCnt uint32, drIdx ttnpb.DataRateIndex, retransmission bool,
+) *LinkSample {
+	sample := &LinkSample{
+		ReceivedAt:     up.GetReceivedAt().AsTime(),
+		FCnt:           fCnt,
+		Retransmission: retransmission,
+		DataRateIndex:  uint32(drIdx),
+	}
+	if up.ReceivedAt == nil {
+		sample.ReceivedAt = time.Now()
+	}
+	isLoRa := up.GetSettings().GetDataRate().GetLora() != nil
+	gatewayIDs := make(map[string]struct{}, len(up.RxMetadata))
+	for i, md := range up.RxMetadata {
+		if i == 0 || md.ChannelRssi > sample.RSSI {
+			sample.RSSI = md.ChannelRssi
+		}
+		if snr := md.Snr; isLoRa && (sample.SNR == nil || snr > *sample.SNR) {
+			sample.SNR = &snr
+		}
+		gtwID := md.GetGatewayIds().GetGatewayId()
+		if _, ok := gatewayIDs[gtwID]; ok || gtwID == "" {
+			continue
+		}
+		gatewayIDs[gtwID] = struct{}{}
+		sample.GatewayIDs = append(sample.GatewayIDs, gtwID)
+	}
+	return sample
+}
+
+// recordLinkSample records the link sample of the deduplicated data uplink up, if link statistics are enabled.
+func (ns *NetworkServer) recordLinkSample(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+	up *ttnpb.UplinkMessage, fCnt uint32, drIdx ttnpb.DataRateIndex, retransmission bool,
+) {
+	if !ns.linkStats.Enable {
+		return
+	}
+	sample := newLinkSample(up, fCnt, drIdx, retransmission)
+	if err := ns.linkStats.Registry.Add(
+		ctx, ids, sample, int(ns.linkStats.WindowSize), ns.linkStats.TTL,
+	); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to record link sample")
+	}
+}
+
+// GetEndDeviceLinkStats implements ttnpb.NsServer.
+func (ns *NetworkServer) GetEndDeviceLinkStats(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDeviceLinkStats, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if !ns.linkStats.Enable {
+		return nil, errLinkStatsDisabled.New()
+	}
+	samples, err := ns.linkStats.Registry.Get(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return computeLinkStats(samples), nil
+}
+
+// ResetEndDeviceLinkStats implements ttnpb.NsServer.
+func (ns *NetworkServer) ResetEndDeviceLinkStats(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*emptypb.Empty, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if !ns.linkStats.Enable {
+		return nil, errLinkStatsDisabled.New()
+	}
+	if err := ns.linkStats.Registry.Clear(ctx, ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,102 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewLinkSample(t *testing.T) {
+	a, _ := test.New(t)
+
+	receivedAt := time.Unix(1700000000, 0).UTC()
+	sample := newLinkSample(&ttnpb.UplinkMessage{
+		ReceivedAt: timestamppb.New(receivedAt),
+		Settings: &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{SpreadingFactor: 7, Bandwidth: 125000},
+				},
+			},
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"}, ChannelRssi: -110, Snr: -3},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"}, ChannelRssi: -80, Snr: 2},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"}, AntennaIndex: 1, ChannelRssi: -90, Snr: 5},
+		},
+	}, 42, ttnpb.DataRateIndex_DATA_RATE_5, true)
+
+	snr := float32(5)
+	a.So(sample, should.Resemble, &LinkSample{
+		ReceivedAt:     receivedAt,
+		FCnt:           42,
+		Retransmission: true,
+		DataRateIndex:  5,
+		SNR:            &snr,
+		RSSI:           -80,
+		GatewayIDs:     []string{"gtw-1", "gtw-2"},
+	})
+}
+
+func TestComputeLinkStats(t *testing.T) {
+	a, _ := test.New(t)
+
+	stats := computeLinkStats(nil)
+	a.So(stats.SampleCount, should.Equal, 0)
+	a.So(stats.PacketErrorRate, should.Equal, 0)
+	a.So(stats.Snr, should.BeNil)
+
+	snr := func(v float32) *float32 { return &v }
+	receivedAt := time.Unix(1700000000, 0).UTC()
+	samples := []*LinkSample{
+		{FCnt: 1, DataRateIndex: 5, SNR: snr(1), RSSI: -100, GatewayIDs: []string{"gtw-1"}},
+		{FCnt: 2, DataRateIndex: 5, SNR: snr(2), RSSI: -90, GatewayIDs: []string{"gtw-1", "gtw-2"}},
+		{FCnt: 2, Retransmission: true, DataRateIndex: 5, SNR: snr(3), RSSI: -80, GatewayIDs: []string{"gtw-2"}},
+		// FCnt 3 and 4 are missed.
+		{FCnt: 5, DataRateIndex: 3, SNR: snr(4), RSSI: -70, GatewayIDs: []string{"gtw-1", "gtw-2", "gtw-3"}},
+		// The frame counter is reset after a rejoin, which is not counted as a gap.
+		{FCnt: 0, DataRateIndex: 3, SNR: snr(5), RSSI: -60, GatewayIDs: []string{"gtw-1"}},
+		{FCnt: 1, DataRateIndex: 3, SNR: snr(6), RSSI: -50, GatewayIDs: []string{"gtw-1"}},
+	}
+	for i, s := range samples {
+		s.ReceivedAt = receivedAt.Add(time.Duration(i) * time.Minute)
+	}
+
+	a.So(computeLinkStats(samples), should.Resemble, &ttnpb.EndDeviceLinkStats{
+		SampleCount:         6,
+		FirstUplinkAt:       timestamppb.New(receivedAt),
+		LastUplinkAt:        timestamppb.New(receivedAt.Add(5 * time.Minute)),
+		LastFCnt:            1,
+		UplinkCount:         5,
+		MissedUplinkCount:   2,
+		RetransmissionCount: 1,
+		PacketErrorRate:     2.0 / 7.0,
+		Snr:                 &ttnpb.EndDeviceLinkStats_Percentiles{Min: 1, P10: 1, Median: 3, P90: 6, Max: 6},
+		Rssi:                &ttnpb.EndDeviceLinkStats_Percentiles{Min: -100, P10: -100, Median: -80, P90: -50, Max: -50},
+		DataRates:           map[uint32]uint32{3: 3, 5: 3},
+		GatewayDiversity: &ttnpb.EndDeviceLinkStats_GatewayDiversity{
+			Gateways: map[string]uint32{"gtw-1": 5, "gtw-2": 3, "gtw-3": 1},
+			Min:      1,
+			Mean:     9.0 / 6.0,
+			Max:      3,
+		},
+	})
+}
@@ -190,6 +190,20 @@ func deviceADRChannelSteering(
 	}
 }
 
+// DeviceADRAlgorithm returns the name of the ADR algorithm to be used for the end device.
+func DeviceADRAlgorithm(dev *ttnpb.EndDevice, defaults *ttnpb.MACSettings) string {
+	switch {
+	case dev.GetMacSettings().GetAdr().GetDynamic().GetAlgorithm() != "":
+		return dev.MacSettings.Adr.GetDynamic().Algorithm
+
+	case defaults.GetAdr().GetDynamic().GetAlgorithm() != "":
+		return defaults.Adr.GetDynamic().Algorithm
+
+	default:
+		return DefaultADRAlgorithm
+	}
+}
+
 func isNarrowDataRateIndex(phy *band.Band, idx ttnpb.DataRateIndex) (lora, ok bool) {
 	dr, ok := phy.DataRates[idx]
 	if !ok {
@@ -202,6 +216,12 @@ func isNarrowDataRateIndex(phy *band.Band, idx ttnpb.DataRateIndex) (lora, ok bo
 	return true, mod.Bandwidth == 125_000
 }
 
+// DemodulationFloor returns the SNR demodulation floor of the LoRa data rate.
+func DemodulationFloor(dr *ttnpb.LoRaDataRate) (float32, bool) {
+	floor, ok := demodulationFloor[dr.GetSpreadingFactor()][dr.GetBandwidth()]
+	return floor, ok
+}
+
 func demodulationFloorStep(phy *band.Band, from, to ttnpb.DataRateIndex) float32 {
 	fromDR, ok := phy.DataRates[from]
 	if !ok {
@@ -298,6 +318,31 @@ func maxSNRFromMetadata(mds ...*ttnpb.MACState_UplinkMessage_RxMetadata) (float3
 	return maxSNR, true
 }
 
+// maxUplinkSNR returns the maximum SNR of all gateways across the uplinks.
+func maxUplinkSNR(ups ...*ttnpb.MACState_UplinkMessage) (float32, bool) {
+	return maxSNRFromMetadata(uplinkMetadata(ups...)...)
+}
+
+// averageUplinkSNR returns the average across the uplinks of the maximum SNR of each uplink.
+func averageUplinkSNR(ups ...*ttnpb.MACState_UplinkMessage) (float32, bool) {
+	var (
+		sum float32
+		n   int
+	)
+	for _, up := range ups {
+		snr, ok := maxSNRFromMetadata(up.RxMetadata...)
+		if !ok {
+			continue
+		}
+		sum += snr
+		n++
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return sum / float32(n), true
+}
+
 func uplinkMetadata(ups ...*ttnpb.MACState_UplinkMessage) []*ttnpb.MACState_UplinkMessage_RxMetadata {
 	mds := make([]*ttnpb.MACState_UplinkMessage_RxMetadata, 0, len(ups))
 	for _, up := range ups {
@@ -547,11 +592,17 @@ func adrTxPowerRange(
 func adrMargin(
 	ctx context.Context, dev *ttnpb.EndDevice, defaults *ttnpb.MACSettings, adrUplinks ...*ttnpb.MACState_UplinkMessage,
 ) (margin float32, optimal bool, ok bool, err error) {
-	maxSNR, ok := maxSNRFromMetadata(uplinkMetadata(adrUplinks...)...)
+	maxSNR, ok := maxUplinkSNR(adrUplinks...)
 	if !ok {
 		log.FromContext(ctx).Debug("Failed to determine max SNR, avoid ADR")
 		return 0, false, false, nil
 	}
+	return adrMarginFromSNR(dev, defaults, maxSNR, adrUplinks...)
+}
+
+func adrMarginFromSNR(
+	dev *ttnpb.EndDevice, defaults *ttnpb.MACSettings, snr float32, adrUplinks ...*ttnpb.MACState_UplinkMessage,
+) (margin float32, optimal bool, ok bool, err error) {
 	// The link margin indicates how much stronger the signal (SNR) is than the
 	// minimum (floor) that we need to demodulate the signal. We subtract a
 	// configurable margin.
@@ -562,7 +613,7 @@ func adrMargin(
 		if !ok {
 			return 0, false, false, internal.ErrInvalidDataRate.New()
 		}
-		margin = maxSNR - df - DeviceADRMargin(dev, defaults)
+		margin = snr - df - DeviceADRMargin(dev, defaults)
 	}
 	// We subtract an extra safety margin if we're afraid that we  don't have enough data
 	// for our decision.
@@ -671,28 +722,57 @@ func adrAdaptNbTrans(
 	desiredParameters.AdrNbTrans = clampNbTrans(dev, defaults, nbTrans, desiredParameters.AdrDataRateIndex)
 }
 
-func adaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings) error {
+// marginADRAlgorithm is the link margin based ADR algorithm of the Network Server.
+type marginADRAlgorithm struct {
+	name string
+	// snr returns the SNR of the ADR uplinks on which the link margin is based.
+	snr func(...*ttnpb.MACState_UplinkMessage) (float32, bool)
+	// maxDataRateStep is the maximum increase of the data rate index per adaptation. Zero means no limit.
+	maxDataRateStep ttnpb.DataRateIndex
+}
+
+// Adapt implements ADRAlgorithm.
+func (a marginADRAlgorithm) Adapt(
+	ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings,
+) (*ADRDecision, error) {
 	macState := dev.MacState
 	adrUplinks := adrUplinks(macState, phy)
 	if len(adrUplinks) == 0 {
-		return nil
+		return nil, nil
 	}
 	minDataRateIndex, maxDataRateIndex, allowedDataRateIndices, ok, err := adrDataRateRange(ctx, dev, phy, defaults)
 	if err != nil || !ok {
-		return err
+		return nil, err
 	}
 	minTxPowerIndex, maxTxPowerIndex, rejectedTxPowerIndices, ok := adrTxPowerRange(ctx, dev, phy, defaults)
 	if !ok {
-		return nil
+		return nil, nil
 	}
-	margin, optimal, ok, err := adrMargin(ctx, dev, defaults, adrUplinks...)
+	snr, ok := a.snr(adrUplinks...)
+	if !ok {
+		log.FromContext(ctx).Debug("Failed to determine SNR, avoid ADR")
+		return nil, nil
+	}
+	margin, optimal, ok, err := adrMarginFromSNR(dev, defaults, snr, adrUplinks...)
 	if err != nil || !ok {
-		return err
+		return nil, err
+	}
+	decision := &ADRDecision{
+		Algorithm: a.name,
+		Uplinks:   len(adrUplinks),
+		SNR:       snr,
+		Margin:    margin,
+		LossRate:  adrLossRate(adrUplinks...),
 	}
 	margin, ok = adrSteerDeviceChannels(
 		ctx, dev, defaults, phy, minDataRateIndex, maxDataRateIndex, allowedDataRateIndices, margin,
 	)
 	if !ok {
+		if step := a.maxDataRateStep; step > 0 {
+			if max := macState.CurrentParameters.AdrDataRateIndex + step; max < maxDataRateIndex {
+				maxDataRateIndex = max
+			}
+		}
 		margin = adrAdaptDataRate(
 			macState, phy, minDataRateIndex, maxDataRateIndex, allowedDataRateIndices, minTxPowerIndex, margin,
 		)
@@ -702,15 +782,14 @@ func adaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	)
 	_ = margin
 	adrAdaptNbTrans(dev, defaults, adrUplinks)
-	return nil
+	return decision, nil
 }
 
 // AdaptDataRate adapts the end device desired ADR parameters based on previous transmissions and device settings.
+// The ADR algorithm is selected by DeviceADRAlgorithm.
 func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings) error {
-	if dev.MacState == nil {
-		return nil
-	}
-	return adaptDataRate(ctx, dev, phy, defaults)
+	_, err := AdaptDataRateWith(ctx, DeviceADRAlgorithm(dev, defaults), dev, phy, defaults)
+	return err
 }
 
 // LossRate calculates the loss rate of the recent uplinks in the provided MAC state.
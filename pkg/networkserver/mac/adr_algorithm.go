@@ -0,0 +1,319 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ADRAlgorithm adapts the desired ADR parameters of end devices.
+type ADRAlgorithm interface {
+	// Adapt adapts the desired data rate index, TX power index and NbTrans of the end device based on its recent
+	// uplinks and returns the inputs of the decision. If the algorithm does not adapt the parameters, Adapt
+	// returns nil.
+	Adapt(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings) (*ADRDecision, error)
+}
+
+// ADRDecision describes the inputs and the outputs of an ADR algorithm run.
+type ADRDecision struct {
+	// Algorithm is the name of the ADR algorithm.
+	Algorithm string
+	// Uplinks is the number of uplinks on which the decision is based.
+	Uplinks int
+	// SNR is the SNR in dB on which the link margin is based.
+	SNR float32
+	// Margin is the link margin in dB.
+	Margin float32
+	// LossRate is the loss rate of the uplinks.
+	LossRate float32
+
+	CurrentDataRateIndex ttnpb.DataRateIndex
+	DesiredDataRateIndex ttnpb.DataRateIndex
+	CurrentTxPowerIndex  uint32
+	DesiredTxPowerIndex  uint32
+	CurrentNbTrans       uint32
+	DesiredNbTrans       uint32
+}
+
+// Changed returns whether the decision desires ADR parameters that differ from the current ones.
+func (d *ADRDecision) Changed() bool {
+	return d.DesiredDataRateIndex != d.CurrentDataRateIndex ||
+		d.DesiredTxPowerIndex != d.CurrentTxPowerIndex ||
+		d.DesiredNbTrans != d.CurrentNbTrans
+}
+
+// Struct returns the decision as a Struct, which is used as the data of the ADR event.
+func (d *ADRDecision) Struct() *structpb.Struct {
+	return &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"algorithm": structpb.NewStringValue(d.Algorithm),
+			"inputs": structpb.NewStructValue(&structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"uplinks":   structpb.NewNumberValue(float64(d.Uplinks)),
+					"snr":       structpb.NewNumberValue(float64(d.SNR)),
+					"margin":    structpb.NewNumberValue(float64(d.Margin)),
+					"loss_rate": structpb.NewNumberValue(float64(d.LossRate)),
+				},
+			}),
+			"current": adrParametersValue(d.CurrentDataRateIndex, d.CurrentTxPowerIndex, d.CurrentNbTrans),
+			"desired": adrParametersValue(d.DesiredDataRateIndex, d.DesiredTxPowerIndex, d.DesiredNbTrans),
+		},
+	}
+}
+
+func adrParametersValue(drIdx ttnpb.DataRateIndex, txPowerIdx, nbTrans uint32) *structpb.Value {
+	return structpb.NewStructValue(&structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"data_rate_index": structpb.NewNumberValue(float64(drIdx)),
+			"tx_power_index":  structpb.NewNumberValue(float64(txPowerIdx)),
+			"nb_trans":        structpb.NewNumberValue(float64(nbTrans)),
+		},
+	})
+}
+
+// EvtAdaptDataRate is the event of an ADR decision which changes the desired ADR parameters.
+var EvtAdaptDataRate = events.Define(
+	"ns.mac.adr", "adapt data rate",
+	events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	events.WithDataType(&structpb.Struct{}),
+	events.WithPropagateToParent(),
+)
+
+// ADR algorithm names.
+const (
+	// DefaultADRAlgorithm is the link margin based algorithm of the Network Server, which uses the maximum SNR.
+	DefaultADRAlgorithm = "default"
+	// SemtechADRAlgorithm is the Semtech reference algorithm.
+	SemtechADRAlgorithm = "semtech"
+	// MobileADRAlgorithm is a conservative variant of the default algorithm for mobile end devices, which uses the
+	// average SNR and increases the data rate index by at most one step at a time.
+	MobileADRAlgorithm = "mobile"
+)
+
+var errADRAlgorithm = errors.DefineInvalidArgument("adr_algorithm", "unknown ADR algorithm `{name}`")
+
+var adrAlgorithms = struct {
+	sync.RWMutex
+	m map[string]ADRAlgorithm
+}{
+	m: map[string]ADRAlgorithm{
+		DefaultADRAlgorithm: marginADRAlgorithm{
+			name: DefaultADRAlgorithm,
+			snr:  maxUplinkSNR,
+		},
+		SemtechADRAlgorithm: semtechADRAlgorithm{},
+		MobileADRAlgorithm: marginADRAlgorithm{
+			name:            MobileADRAlgorithm,
+			snr:             averageUplinkSNR,
+			maxDataRateStep: 1,
+		},
+	},
+}
+
+// RegisterADRAlgorithm registers the ADR algorithm by name, replacing any algorithm with the same name.
+func RegisterADRAlgorithm(name string, algorithm ADRAlgorithm) {
+	adrAlgorithms.Lock()
+	adrAlgorithms.m[name] = algorithm
+	adrAlgorithms.Unlock()
+}
+
+// GetADRAlgorithm returns the ADR algorithm by name.
+func GetADRAlgorithm(name string) (ADRAlgorithm, error) {
+	adrAlgorithms.RLock()
+	algorithm, ok := adrAlgorithms.m[name]
+	adrAlgorithms.RUnlock()
+	if !ok {
+		return nil, errADRAlgorithm.WithAttributes("name", name)
+	}
+	return algorithm, nil
+}
+
+// ADRAlgorithms returns the names of the registered ADR algorithms.
+func ADRAlgorithms() []string {
+	adrAlgorithms.RLock()
+	names := make([]string, 0, len(adrAlgorithms.m))
+	for name := range adrAlgorithms.m {
+		names = append(names, name)
+	}
+	adrAlgorithms.RUnlock()
+	sort.Strings(names)
+	return names
+}
+
+// AdaptDataRateWith adapts the end device desired ADR parameters using the ADR algorithm with the given name.
+// It returns the decision, or nil if the algorithm did not adapt the parameters.
+func AdaptDataRateWith(
+	ctx context.Context, name string, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings,
+) (*ADRDecision, error) {
+	if dev.MacState == nil {
+		return nil, nil
+	}
+	algorithm, err := GetADRAlgorithm(name)
+	if err != nil {
+		return nil, err
+	}
+	decision, err := algorithm.Adapt(ctx, dev, phy, defaults)
+	if err != nil || decision == nil {
+		return nil, err
+	}
+	if decision.Algorithm == "" {
+		decision.Algorithm = name
+	}
+	current, desired := dev.MacState.CurrentParameters, dev.MacState.DesiredParameters
+	decision.CurrentDataRateIndex = current.AdrDataRateIndex
+	decision.CurrentTxPowerIndex = current.AdrTxPowerIndex
+	decision.CurrentNbTrans = current.AdrNbTrans
+	decision.DesiredDataRateIndex = desired.AdrDataRateIndex
+	decision.DesiredTxPowerIndex = desired.AdrTxPowerIndex
+	decision.DesiredNbTrans = desired.AdrNbTrans
+	return decision, nil
+}
+
+// semtechMarginStep is the link margin in dB per step of the Semtech reference algorithm.
+const semtechMarginStep = 3
+
+// semtechADRAlgorithm is the Semtech reference ADR algorithm, as recommended in the Semtech application note
+// "LoRaWAN - simple rate adaptation recommended algorithm".
+//
+// The algorithm waits for a full history of uplinks at the current data rate. Each step of link margin first
+// increases the data rate index and then decreases the TX output power. A negative margin increases the TX
+// output power. NbTrans is adapted one step at a time based on the loss rate.
+type semtechADRAlgorithm struct{}
+
+// Adapt implements ADRAlgorithm.
+func (semtechADRAlgorithm) Adapt(
+	ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings,
+) (*ADRDecision, error) {
+	macState := dev.MacState
+	adrUplinks := adrUplinks(macState, phy)
+	if len(adrUplinks) < OptimalADRUplinkCount {
+		return nil, nil
+	}
+	adrUplinks = adrUplinks[len(adrUplinks)-OptimalADRUplinkCount:]
+	minDataRateIndex, maxDataRateIndex, allowedDataRateIndices, ok, err := adrDataRateRange(ctx, dev, phy, defaults)
+	if err != nil || !ok {
+		return nil, err
+	}
+	minTxPowerIndex, maxTxPowerIndex, rejectedTxPowerIndices, ok := adrTxPowerRange(ctx, dev, phy, defaults)
+	if !ok {
+		return nil, nil
+	}
+	snr, ok := maxUplinkSNR(adrUplinks...)
+	if !ok {
+		log.FromContext(ctx).Debug("Failed to determine max SNR, avoid ADR")
+		return nil, nil
+	}
+	dr := internal.LastUplink(adrUplinks...).Settings.DataRate.GetLora()
+	if dr == nil {
+		return nil, nil
+	}
+	df, ok := demodulationFloor[dr.SpreadingFactor][dr.Bandwidth]
+	if !ok {
+		return nil, internal.ErrInvalidDataRate.New()
+	}
+	decision := &ADRDecision{
+		Algorithm: SemtechADRAlgorithm,
+		Uplinks:   len(adrUplinks),
+		SNR:       snr,
+		Margin:    snr - df - DeviceADRMargin(dev, defaults),
+		LossRate:  adrLossRate(adrUplinks...),
+	}
+
+	currentParameters, desiredParameters := macState.CurrentParameters, macState.DesiredParameters
+	drIdx, txPowerIdx := currentParameters.AdrDataRateIndex, currentParameters.AdrTxPowerIndex
+	if drIdx < minDataRateIndex {
+		drIdx = minDataRateIndex
+	}
+	if txPowerIdx < minTxPowerIndex {
+		txPowerIdx = minTxPowerIndex
+	}
+	if txPowerIdx > maxTxPowerIndex {
+		txPowerIdx = maxTxPowerIndex
+	}
+	nextDataRateIndex := func() (ttnpb.DataRateIndex, bool) {
+		for idx := drIdx + 1; idx <= maxDataRateIndex; idx++ {
+			if _, ok := allowedDataRateIndices[idx]; ok {
+				return idx, true
+			}
+		}
+		return 0, false
+	}
+	nextTxPowerIndex := func(from uint32, up bool) (uint32, bool) {
+		for idx := from; ; {
+			switch {
+			case up && idx < maxTxPowerIndex:
+				idx++
+			case !up && idx > minTxPowerIndex:
+				idx--
+			default:
+				return 0, false
+			}
+			if _, ok := rejectedTxPowerIndices[idx]; !ok {
+				return idx, true
+			}
+		}
+	}
+	steps := int(decision.Margin / semtechMarginStep)
+	for ; steps > 0; steps-- {
+		if idx, ok := nextDataRateIndex(); ok {
+			drIdx = idx
+			continue
+		}
+		idx, ok := nextTxPowerIndex(txPowerIdx, true)
+		if !ok {
+			break
+		}
+		txPowerIdx = idx
+	}
+	for ; steps < 0; steps++ {
+		idx, ok := nextTxPowerIndex(txPowerIdx, false)
+		if !ok {
+			break
+		}
+		txPowerIdx = idx
+	}
+	desiredParameters.AdrDataRateIndex = drIdx
+	desiredParameters.AdrTxPowerIndex = txPowerIdx
+
+	nbTrans := currentParameters.AdrNbTrans
+	if nbTrans == 0 {
+		nbTrans = 1
+	}
+	switch r := decision.LossRate; {
+	case r < 0.05:
+		if nbTrans > 1 {
+			nbTrans--
+		}
+	case r < 0.10:
+	case r < 0.30:
+		if nbTrans < maxNbTrans {
+			nbTrans++
+		}
+	default:
+		nbTrans = maxNbTrans
+	}
+	desiredParameters.AdrNbTrans = clampNbTrans(dev, defaults, nbTrans, drIdx)
+	return decision, nil
+}
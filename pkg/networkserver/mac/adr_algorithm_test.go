@@ -0,0 +1,252 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type noopADRAlgorithm struct{}
+
+func (noopADRAlgorithm) Adapt(
+	context.Context, *ttnpb.EndDevice, *band.Band, *ttnpb.MACSettings,
+) (*ADRDecision, error) {
+	return &ADRDecision{}, nil
+}
+
+func TestADRAlgorithms(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	a.So(ADRAlgorithms(), should.Contain, DefaultADRAlgorithm)
+	a.So(ADRAlgorithms(), should.Contain, SemtechADRAlgorithm)
+	a.So(ADRAlgorithms(), should.Contain, MobileADRAlgorithm)
+
+	_, err := GetADRAlgorithm("unknown")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	RegisterADRAlgorithm("noop", noopADRAlgorithm{})
+	algorithm, err := GetADRAlgorithm("noop")
+	a.So(err, should.BeNil)
+	a.So(algorithm, should.Resemble, noopADRAlgorithm{})
+}
+
+func TestAdaptDataRateWith(t *testing.T) {
+	t.Parallel()
+
+	phy := &band.EU_863_870_RP1_V1_0_2_Rev_B
+	makeDevice := func(uplinks int) *ttnpb.EndDevice {
+		channels := []*ttnpb.MACParameters_Channel{
+			{
+				EnableUplink:     true,
+				MinDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_0,
+				MaxDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5,
+			},
+		}
+		macState := &ttnpb.MACState{
+			CurrentParameters: &ttnpb.MACParameters{
+				AdrDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_0,
+				AdrNbTrans:       1,
+				Channels:         channels,
+			},
+			DesiredParameters: &ttnpb.MACParameters{
+				AdrDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_0,
+				AdrNbTrans:       1,
+				Channels:         channels,
+			},
+		}
+		for i := 0; i < uplinks; i++ {
+			// Alternate between 12 and 8 dB, such that the maximum SNR is 12 dB and the average SNR is 10 dB.
+			snr := float32(12)
+			if i%2 == 1 {
+				snr = 8
+			}
+			macState.RecentUplinks = append(macState.RecentUplinks, &ttnpb.MACState_UplinkMessage{
+				Payload: &ttnpb.Message{
+					MHdr: &ttnpb.MHDR{
+						MType: ttnpb.MType_UNCONFIRMED_UP,
+					},
+					Payload: &ttnpb.Message_MacPayload{
+						MacPayload: &ttnpb.MACPayload{
+							FullFCnt: uint32(i),
+						},
+					},
+				},
+				Settings: &ttnpb.MACState_UplinkMessage_TxSettings{
+					DataRate: &ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_Lora{
+							Lora: &ttnpb.LoRaDataRate{
+								Bandwidth:       125_000,
+								SpreadingFactor: 12,
+								CodingRate:      band.Cr4_5,
+							},
+						},
+					},
+				},
+				RxMetadata: []*ttnpb.MACState_UplinkMessage_RxMetadata{
+					{
+						Snr: snr,
+					},
+				},
+			})
+		}
+		return &ttnpb.EndDevice{
+			MacState: macState,
+		}
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		Algorithm string
+		Uplinks   int
+
+		ExpectedDecision      bool
+		ExpectedSNR           float32
+		ExpectedMargin        float32
+		ExpectedDataRateIndex ttnpb.DataRateIndex
+	}{
+		{
+			Name:                  "Default",
+			Algorithm:             DefaultADRAlgorithm,
+			Uplinks:               20,
+			ExpectedDecision:      true,
+			ExpectedSNR:           12,
+			ExpectedMargin:        17,
+			ExpectedDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5,
+		},
+		{
+			Name:                  "Mobile",
+			Algorithm:             MobileADRAlgorithm,
+			Uplinks:               20,
+			ExpectedDecision:      true,
+			ExpectedSNR:           10,
+			ExpectedMargin:        15,
+			ExpectedDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_1,
+		},
+		{
+			Name:                  "Semtech",
+			Algorithm:             SemtechADRAlgorithm,
+			Uplinks:               20,
+			ExpectedDecision:      true,
+			ExpectedSNR:           12,
+			ExpectedMargin:        17,
+			ExpectedDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5,
+		},
+		{
+			Name:                  "Semtech/IncompleteHistory",
+			Algorithm:             SemtechADRAlgorithm,
+			Uplinks:               19,
+			ExpectedDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_0,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				dev := makeDevice(tc.Uplinks)
+				decision, err := AdaptDataRateWith(ctx, tc.Algorithm, dev, phy, nil)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(dev.MacState.DesiredParameters.AdrDataRateIndex, should.Equal, tc.ExpectedDataRateIndex)
+				if !tc.ExpectedDecision {
+					a.So(decision, should.BeNil)
+					return
+				}
+				if !a.So(decision, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(decision.Algorithm, should.Equal, tc.Algorithm)
+				a.So(decision.Uplinks, should.Equal, tc.Uplinks)
+				a.So(decision.SNR, should.Equal, tc.ExpectedSNR)
+				a.So(decision.Margin, should.Equal, tc.ExpectedMargin)
+				a.So(decision.CurrentDataRateIndex, should.Equal, ttnpb.DataRateIndex_DATA_RATE_0)
+				a.So(decision.DesiredDataRateIndex, should.Equal, tc.ExpectedDataRateIndex)
+				a.So(decision.DesiredNbTrans, should.Equal, 1)
+				a.So(decision.Changed(), should.BeTrue)
+				a.So(decision.Struct().Fields, should.ContainKey, "inputs")
+			},
+		})
+	}
+}
+
+func TestDeviceADRAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	makeSettings := func(algorithm string) *ttnpb.MACSettings {
+		return &ttnpb.MACSettings{
+			Adr: &ttnpb.ADRSettings{
+				Mode: &ttnpb.ADRSettings_Dynamic{
+					Dynamic: &ttnpb.ADRSettings_DynamicMode{
+						Algorithm: algorithm,
+					},
+				},
+			},
+		}
+	}
+	for _, tc := range []struct {
+		Name     string
+		Device   *ttnpb.EndDevice
+		Defaults *ttnpb.MACSettings
+		Expected string
+	}{
+		{
+			Name:     "NoSettings",
+			Device:   &ttnpb.EndDevice{},
+			Expected: DefaultADRAlgorithm,
+		},
+		{
+			Name:     "Defaults",
+			Device:   &ttnpb.EndDevice{},
+			Defaults: makeSettings(MobileADRAlgorithm),
+			Expected: MobileADRAlgorithm,
+		},
+		{
+			Name: "Device",
+			Device: &ttnpb.EndDevice{
+				MacSettings: makeSettings(SemtechADRAlgorithm),
+			},
+			Defaults: makeSettings(MobileADRAlgorithm),
+			Expected: SemtechADRAlgorithm,
+		},
+		{
+			Name: "DeviceWithoutAlgorithm",
+			Device: &ttnpb.EndDevice{
+				MacSettings: makeSettings(""),
+			},
+			Defaults: makeSettings(MobileADRAlgorithm),
+			Expected: MobileADRAlgorithm,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				a.So(DeviceADRAlgorithm(tc.Device, tc.Defaults), should.Equal, tc.Expected)
+			},
+		})
+	}
+}
@@ -17,17 +17,57 @@ package mac
 import (
 	"context"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
-var EvtEnqueueForceRejoinRequest = defineEnqueueMACRequestEvent("force_rejoin", "force rejoin")()
+var EvtEnqueueForceRejoinRequest = defineEnqueueMACRequestEvent(
+	"force_rejoin", "force rejoin",
+	events.WithDataType(&ttnpb.MACCommand_ForceRejoinReq{}),
+)()
 
-func EnqueueForceRejoinReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) EnqueueState {
-	// TODO: Support rejoins. (https://github.com/TheThingsNetwork/lorawan-stack/issues/8)
-	_ = EvtEnqueueForceRejoinRequest
-	return EnqueueState{
-		MaxDownLen: maxDownLen,
-		MaxUpLen:   maxUpLen,
-		Ok:         true,
+// DeviceNeedsForceRejoinReq returns true iff the ForceRejoinReq req needs to be sent to the device.
+func DeviceNeedsForceRejoinReq(dev *ttnpb.EndDevice, req *ttnpb.MACCommand_ForceRejoinReq) bool {
+	return req != nil &&
+		!dev.GetMulticast() &&
+		dev.GetMacState() != nil &&
+		macspec.UseNwkKey(dev.MacState.LorawanVersion)
+}
+
+// EnqueueForceRejoinReq returns the ForceRejoinReq req to send to the device, if it fits in maxDownLen.
+// The end device does not answer ForceRejoinReq, hence it is not added to the pending requests of the device.
+func EnqueueForceRejoinReq(
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	maxDownLen, maxUpLen uint16,
+	req *ttnpb.MACCommand_ForceRejoinReq,
+) ([]*ttnpb.MACCommand, EnqueueState) {
+	if !DeviceNeedsForceRejoinReq(dev, req) {
+		return nil, EnqueueState{
+			MaxDownLen: maxDownLen,
+			MaxUpLen:   maxUpLen,
+			Ok:         true,
+		}
 	}
+	return enqueueMACCommand(ttnpb.MACCommandIdentifier_CID_FORCE_REJOIN, maxDownLen, maxUpLen, func(nDown, nUp uint16) ([]*ttnpb.MACCommand, uint16, events.Builders, bool) {
+		if nDown < 1 {
+			return nil, 0, nil, false
+		}
+		log.FromContext(ctx).WithFields(log.Fields(
+			"rejoin_type", req.RejoinType,
+			"data_rate_index", req.DataRateIndex,
+			"max_retries", req.MaxRetries,
+			"period_exponent", req.PeriodExponent,
+		)).Debug("Enqueued ForceRejoinReq")
+		return []*ttnpb.MACCommand{
+				req.MACCommand(),
+			},
+			0,
+			events.Builders{
+				EvtEnqueueForceRejoinRequest.With(events.WithData(req)),
+			},
+			true
+	})
 }
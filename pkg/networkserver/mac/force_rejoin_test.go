@@ -0,0 +1,97 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mac_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestEnqueueForceRejoinReq(t *testing.T) {
+	req := &ttnpb.MACCommand_ForceRejoinReq{
+		RejoinType:     ttnpb.RejoinRequestType_KEYS,
+		DataRateIndex:  ttnpb.DataRateIndex_DATA_RATE_3,
+		MaxRetries:     2,
+		PeriodExponent: ttnpb.RejoinPeriodExponent_REJOIN_PERIOD_1,
+	}
+	ForEachMACVersion(t, func(makeMACName func(parts ...string) string, macVersion ttnpb.MACVersion) {
+		for _, tc := range []struct {
+			Name       string
+			Request    *ttnpb.MACCommand_ForceRejoinReq
+			MaxDownLen uint16
+			Enqueued   bool
+			Ok         bool
+		}{
+			{
+				Name:       makeMACName("no request"),
+				MaxDownLen: 42,
+				Ok:         true,
+			},
+			{
+				Name:       makeMACName("request"),
+				Request:    req,
+				MaxDownLen: 42,
+				Enqueued:   macspec.UseNwkKey(macVersion),
+				Ok:         true,
+			},
+			{
+				Name:       makeMACName("request/no space"),
+				Request:    req,
+				MaxDownLen: 2,
+				Ok:         !macspec.UseNwkKey(macVersion),
+			},
+		} {
+			tc := tc
+			test.RunSubtest(t, test.SubtestConfig{
+				Name:     tc.Name,
+				Parallel: true,
+				Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+					dev := &ttnpb.EndDevice{
+						MacState: &ttnpb.MACState{
+							LorawanVersion: macVersion,
+						},
+					}
+					a.So(DeviceNeedsForceRejoinReq(dev, tc.Request), should.Equal, tc.Request != nil && macspec.UseNwkKey(macVersion))
+
+					cmds, st := EnqueueForceRejoinReq(ctx, dev, tc.MaxDownLen, 42, tc.Request)
+					a.So(st.Ok, should.Equal, tc.Ok)
+					a.So(st.MaxUpLen, should.Equal, 42)
+					a.So(dev.MacState.PendingRequests, should.BeEmpty)
+					if !tc.Enqueued {
+						a.So(cmds, should.BeEmpty)
+						a.So(st.MaxDownLen, should.Equal, tc.MaxDownLen)
+						return
+					}
+					a.So(cmds, should.Resemble, []*ttnpb.MACCommand{
+						tc.Request.MACCommand(),
+					})
+					a.So(st.MaxDownLen, should.Equal, tc.MaxDownLen-3)
+					a.So(st.QueuedEvents, should.ResembleEventBuilders, events.Builders{
+						EvtEnqueueForceRejoinRequest.With(events.WithData(tc.Request)),
+					})
+				},
+			})
+		}
+	})
+}
@@ -125,6 +125,20 @@ func beaconTimeBefore(t time.Time) time.Duration {
 	return gpstime.ToGPS(t) / BeaconPeriod * BeaconPeriod
 }
 
+// PingSlotWindow is the duration of the ping slots of a beacon period.
+const PingSlotWindow = pingSlotCount * pingSlotLen
+
+// BeaconPeriodStart returns the start of the beacon period which contains t.
+func BeaconPeriodStart(t time.Time) time.Time {
+	return gpstime.Parse(beaconTimeBefore(t)).UTC()
+}
+
+// PingSlotsPerBeaconPeriod returns the number of ping slots an end device with the ping slot periodicity opens
+// in a beacon period.
+func PingSlotsPerBeaconPeriod(periodicity ttnpb.PingSlotPeriod) uint32 {
+	return 1 << (7 - uint32(periodicity))
+}
+
 // NextPingSlotAt returns the exact time instant before or at earliestAt when next ping slot can be open
 // given the data known by Network Server and true, if such time instant exists, otherwise it returns time.Time{} and false.
 func NextPingSlotAt(ctx context.Context, dev *ttnpb.EndDevice, earliestAt time.Time) (time.Time, bool) {
@@ -0,0 +1,268 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errSimulatedUplink = errors.DefineInvalidArgument("simulated_uplink", "invalid simulated uplink `{index}`")
+
+// SimulateMAC implements ttnpb.NsServer.
+func (ns *NetworkServer) SimulateMAC(ctx context.Context, req *ttnpb.SimulateMACRequest) (*ttnpb.MACSimulation, error) {
+	ids := req.EndDeviceIds
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	dev := req.EndDevice
+	if dev != nil {
+		dev = ttnpb.Clone(dev)
+		dev.Ids = ids
+	} else {
+		var err error
+		dev, ctx, err = ns.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, handleDataUplinkGetPaths[:])
+		if err != nil {
+			return nil, err
+		}
+	}
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ns.simulateMAC(ctx, dev, fps, time.Now(), req.Uplinks...)
+}
+
+// simulateMAC runs the uplinks ups of a copy of dev through the MAC command handlers, ADR and MAC command
+// enqueuers of the Network Server. The first uplink is received at now, unless specified otherwise.
+// simulateMAC does not have any side effects.
+func (ns *NetworkServer) simulateMAC(
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	fps *frequencyplans.Store,
+	now time.Time,
+	ups ...*ttnpb.SimulateMACRequest_Uplink,
+) (*ttnpb.MACSimulation, error) {
+	dev = ttnpb.Clone(dev)
+	fp, phy, err := DeviceFrequencyPlanAndBand(dev, fps)
+	if err != nil {
+		return nil, err
+	}
+	if dev.MacState == nil {
+		if dev.MacState, err = mac.NewState(dev, fps, ns.defaultMACSettings); err != nil {
+			return nil, err
+		}
+	}
+	if dev.Session == nil {
+		dev.Session = &ttnpb.Session{}
+	}
+	devAddr := types.MustDevAddr(dev.Session.DevAddr).OrZero()
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("ns:simulate_mac:%s", events.NewCorrelationID()))
+
+	res := &ttnpb.MACSimulation{
+		Steps: make([]*ttnpb.MACSimulation_Step, 0, len(ups)),
+	}
+	receivedAt := now
+	fCnt := dev.Session.LastFCntUp
+	var channelCursor int
+	for i, simUp := range ups {
+		if i > 0 {
+			receivedAt = receivedAt.Add(time.Minute)
+		}
+		if simUp.ReceivedAt != nil {
+			receivedAt = simUp.ReceivedAt.AsTime()
+		}
+		if i > 0 || fCnt > 0 {
+			fCnt++
+		}
+		up, drIdx, chIdx, err := simulatedUplink(dev, phy, simUp, devAddr, fCnt, receivedAt, &channelCursor)
+		if err != nil {
+			return nil, errSimulatedUplink.WithAttributes("index", i).WithCause(err)
+		}
+		evs, _, _ := ns.handleUplinkMACCommands(ctx, dev, up, simUp.MacCommands, fps, fCnt, devAddr, true)
+		dev.MacState.RxWindowsAvailable = true
+		dev.Session.LastFCntUp = fCnt
+		step := &ttnpb.MACSimulation_Step{
+			FCnt:          fCnt,
+			DataRateIndex: drIdx,
+			ChannelIndex:  uint32(chIdx),
+		}
+		_, decision := ns.updateUplinkDataRate(ctx, dev, up, phy, drIdx, up.Payload.GetMacPayload().FHdr.FCtrl.Adr)
+		if decision != nil {
+			if decision.Changed() {
+				evs = append(evs, mac.EvtAdaptDataRate.BindData(decision.Struct()))
+			}
+			step.AdrDecision = decision.Struct()
+		}
+
+		maxDownLen, err := computeMaxMACDownlinkPayloadSize(dev.MacState, phy, fp, uint32(chIdx), up.Settings.DataRate)
+		if err != nil {
+			return nil, errSimulatedUplink.WithAttributes("index", i).WithCause(err)
+		}
+		maxUpLen := maximumUplinkLength(dev.MacState, fp, phy, dev.MacState.RecentUplinks...)
+		cmds, fPending, enqueueEvs := ns.simulateDownlinkMACCommands(ctx, dev, phy, receivedAt, maxDownLen, maxUpLen)
+		evs = append(evs, enqueueEvs...)
+		step.FPending = fPending
+		for _, b := range evs {
+			step.Events = append(step.Events, b.Definition().Name())
+		}
+		step.DownlinkMacCommands = cmds
+		step.MacState = ttnpb.Clone(dev.MacState)
+		res.Steps = append(res.Steps, step)
+	}
+	return res, nil
+}
+
+// simulatedUplink returns the uplink message of the simulated uplink simUp of dev, its data rate index and
+// its device channel index. If simUp does not specify the channel, the first enabled uplink channel at or after
+// channelCursor is used and channelCursor is moved past it.
+func simulatedUplink(
+	dev *ttnpb.EndDevice,
+	phy *band.Band,
+	simUp *ttnpb.SimulateMACRequest_Uplink,
+	devAddr types.DevAddr,
+	fCnt uint32,
+	receivedAt time.Time,
+	channelCursor *int,
+) (*ttnpb.UplinkMessage, ttnpb.DataRateIndex, uint8, error) {
+	drIdx := dev.MacState.CurrentParameters.AdrDataRateIndex
+	if simUp.DataRateIndex != nil {
+		drIdx = simUp.DataRateIndex.Value
+	}
+	dr, ok := phy.DataRates[drIdx]
+	if !ok {
+		return nil, 0, 0, errDataRateIndexNotFound.WithAttributes("index", drIdx)
+	}
+	chs := dev.MacState.CurrentParameters.Channels
+	chIdx := -1
+	if simUp.ChannelIndex != nil {
+		chIdx = int(simUp.ChannelIndex.Value)
+		if chIdx >= len(chs) || !chs[chIdx].GetEnableUplink() {
+			return nil, 0, 0, errInvalidChannelIndex.New()
+		}
+	} else {
+		for j := range chs {
+			k := (*channelCursor + j) % len(chs)
+			if chs[k].GetEnableUplink() {
+				chIdx = k
+				break
+			}
+		}
+		if chIdx < 0 {
+			return nil, 0, 0, errUplinkChannelNotFound.New()
+		}
+		*channelCursor = chIdx + 1
+	}
+
+	gatewayCount := int(simUp.GatewayCount)
+	if gatewayCount == 0 {
+		gatewayCount = 1
+	}
+	mds := make([]*ttnpb.RxMetadata, 0, gatewayCount)
+	for j := 0; j < gatewayCount; j++ {
+		mds = append(mds, &ttnpb.RxMetadata{
+			GatewayIds: &ttnpb.GatewayIdentifiers{
+				GatewayId: fmt.Sprintf("simulated-gateway-%d", j+1),
+			},
+			Snr:         simUp.Snr,
+			Rssi:        simUp.Rssi,
+			ChannelRssi: simUp.Rssi,
+		})
+	}
+	mType := ttnpb.MType_UNCONFIRMED_UP
+	if simUp.Confirmed {
+		mType = ttnpb.MType_CONFIRMED_UP
+	}
+	adr := true
+	if simUp.Adr != nil {
+		adr = simUp.Adr.Value
+	}
+	return &ttnpb.UplinkMessage{
+		Payload: &ttnpb.Message{
+			MHdr: &ttnpb.MHDR{
+				MType: mType,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			Payload: &ttnpb.Message_MacPayload{
+				MacPayload: &ttnpb.MACPayload{
+					FHdr: &ttnpb.FHDR{
+						DevAddr: devAddr.Bytes(),
+						FCtrl: &ttnpb.FCtrl{
+							Adr:       adr,
+							AdrAckReq: simUp.AdrAckReq,
+						},
+						FCnt: fCnt & 0xffff,
+					},
+					FullFCnt: fCnt,
+				},
+			},
+		},
+		Settings: &ttnpb.TxSettings{
+			DataRate:  dr.Rate,
+			Frequency: chs[chIdx].UplinkFrequency,
+		},
+		RxMetadata:         mds,
+		ReceivedAt:         timestamppb.New(receivedAt),
+		DeviceChannelIndex: uint32(chIdx),
+	}, drIdx, uint8(chIdx), nil
+}
+
+// simulateDownlinkMACCommands returns the MAC commands the Network Server would send to dev in a class A downlink
+// with a MACPayload of at most maxDownLen bytes, whether not all MAC commands fit and the queued event builders.
+// The MAC command requests are recorded as pending requests of dev, as if the downlink was sent.
+func (ns *NetworkServer) simulateDownlinkMACCommands(
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	phy *band.Band,
+	transmitAt time.Time,
+	maxDownLen, maxUpLen uint16,
+) ([]*ttnpb.MACCommand, bool, events.Builders) {
+	// NOTE: len(FHDR) + len(FPort) = 7 + 1 = 8
+	if maxDownLen < 8 || maxUpLen < 8 {
+		return nil, true, nil
+	}
+	maxDownLen, maxUpLen = maxDownLen-8, maxUpLen-8
+
+	spec := lorawan.DefaultMACCommands
+	cmds := make([]*ttnpb.MACCommand, 0, len(dev.MacState.QueuedResponses))
+	var fPending bool
+	for _, cmd := range dev.MacState.QueuedResponses {
+		desc, ok := spec[cmd.Cid]
+		if !ok || desc.DownlinkLength >= maxDownLen {
+			fPending = true
+			break
+		}
+		cmds = append(cmds, cmd)
+		maxDownLen -= 1 + desc.DownlinkLength
+	}
+	dev.MacState.QueuedResponses = nil
+	dev.MacState.PendingRequests = dev.MacState.PendingRequests[:0]
+
+	st, _ := ns.enqueueMACCommands(ctx, dev, phy, maxDownLen, maxUpLen, transmitAt, nil)
+	return append(cmds, dev.MacState.PendingRequests...), fPending || !st.Ok, st.QueuedEvents
+}
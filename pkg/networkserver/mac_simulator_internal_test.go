@@ -0,0 +1,96 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSimulateMAC(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ns := &NetworkServer{
+		Component:          &component.Component{},
+		defaultMACSettings: &ttnpb.MACSettings{},
+	}
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	dev := &ttnpb.EndDevice{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			DeviceId:       "test-dev",
+		},
+		FrequencyPlanId:   test.EUFrequencyPlanID,
+		LorawanVersion:    ttnpb.MACVersion_MAC_V1_0_3,
+		LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_0_3_REV_A,
+	}
+	original := ttnpb.Clone(dev)
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	a.So((&ttnpb.SimulateMACRequest{EndDeviceIds: dev.Ids}).ValidateFields(), should.NotBeNil)
+
+	_, err := ns.simulateMAC(ctx, dev, fps, now, &ttnpb.SimulateMACRequest_Uplink{
+		ChannelIndex: &wrapperspb.UInt32Value{Value: 9},
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, errSimulatedUplink)
+
+	res, err := ns.simulateMAC(ctx, dev, fps, now,
+		&ttnpb.SimulateMACRequest_Uplink{
+			Snr:          10,
+			Rssi:         -60,
+			GatewayCount: 2,
+		},
+		&ttnpb.SimulateMACRequest_Uplink{
+			Snr:          10,
+			Rssi:         -60,
+			GatewayCount: 2,
+			MacCommands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_NewChannelAns{FrequencyAck: true, DataRateAck: true}).MACCommand(),
+			},
+		},
+	)
+	if !a.So(err, should.BeNil) || !a.So(res.Steps, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(dev, should.Resemble, original)
+
+	first := res.Steps[0]
+	a.So(first.FCnt, should.Equal, 0)
+	a.So(first.ChannelIndex, should.Equal, 0)
+	a.So(first.DataRateIndex, should.Equal, ttnpb.DataRateIndex_DATA_RATE_0)
+	a.So(first.Events, should.Contain, "ns.mac.new_channel.request")
+	if cmds := first.DownlinkMacCommands; a.So(cmds, should.NotBeEmpty) {
+		a.So(cmds[0].GetNewChannelReq().GetChannelIndex(), should.Equal, 3)
+	}
+
+	second := res.Steps[1]
+	a.So(second.FCnt, should.Equal, 1)
+	a.So(second.ChannelIndex, should.Equal, 1)
+	a.So(second.Events, should.Contain, "ns.mac.new_channel.answer.accept")
+	if cmds := second.DownlinkMacCommands; a.So(cmds, should.NotBeEmpty) {
+		a.So(cmds[0].GetNewChannelReq().GetChannelIndex(), should.Equal, 4)
+	}
+
+	a.So(second.MacState.RecentUplinks, should.HaveLength, 2)
+	a.So(second.MacState.CurrentParameters.Channels, should.HaveLength, 4)
+}
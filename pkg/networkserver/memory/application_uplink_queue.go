@@ -0,0 +1,175 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+type queuedApplicationUp struct {
+	uid       string
+	up        *ttnpb.ApplicationUp
+	notBefore time.Time
+}
+
+// ApplicationUplinkQueue is an in-memory implementation of networkserver.ApplicationUplinkQueue.
+type ApplicationUplinkQueue struct {
+	maxLen        int
+	retryInterval time.Duration
+
+	mu      sync.Mutex
+	uplinks []queuedApplicationUp
+	signal  signal
+}
+
+// NewApplicationUplinkQueue returns a new in-memory application uplink queue.
+// The queue holds at most maxLen uplinks, discarding the oldest uplinks when it is full. A value of 0 means no limit.
+// Uplinks which fail to be processed are processed again after retryInterval.
+func NewApplicationUplinkQueue(maxLen int, retryInterval time.Duration) *ApplicationUplinkQueue {
+	return &ApplicationUplinkQueue{
+		maxLen:        maxLen,
+		retryInterval: retryInterval,
+	}
+}
+
+func (q *ApplicationUplinkQueue) add(ups ...queuedApplicationUp) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.uplinks = append(q.uplinks, ups...)
+	if n := len(q.uplinks) - q.maxLen; q.maxLen > 0 && n > 0 {
+		q.uplinks = append(q.uplinks[:0:0], q.uplinks[n:]...)
+	}
+	q.signal.broadcast()
+}
+
+// Add implements networkserver.ApplicationUplinkQueue.
+func (q *ApplicationUplinkQueue) Add(ctx context.Context, ups ...*ttnpb.ApplicationUp) error {
+	if len(ups) == 0 {
+		return nil
+	}
+	queued := make([]queuedApplicationUp, 0, len(ups))
+	for _, up := range ups {
+		queued = append(queued, queuedApplicationUp{
+			uid: unique.ID(ctx, up.EndDeviceIds),
+			up:  up,
+		})
+	}
+	q.add(queued...)
+	return nil
+}
+
+// take removes and returns up to limit uplinks which may be processed. If there are none, it returns the time
+// until the next uplink may be processed, or a negative duration if there are no uplinks, and a channel which
+// is closed when uplinks are added.
+func (q *ApplicationUplinkQueue) take(limit int) ([]queuedApplicationUp, time.Duration, <-chan struct{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	now := time.Now()
+	var (
+		taken []queuedApplicationUp
+		wait  time.Duration = -1
+	)
+	rest := q.uplinks[:0]
+	for _, up := range q.uplinks {
+		switch d := up.notBefore.Sub(now); {
+		case d <= 0 && (limit <= 0 || len(taken) < limit):
+			taken = append(taken, up)
+		default:
+			if d > 0 && (wait < 0 || d < wait) {
+				wait = d
+			}
+			rest = append(rest, up)
+		}
+	}
+	q.uplinks = rest
+	if len(taken) > 0 {
+		return taken, 0, nil
+	}
+	return nil, wait, q.signal.wait()
+}
+
+// Pop implements networkserver.ApplicationUplinkQueue.
+func (q *ApplicationUplinkQueue) Pop(
+	ctx context.Context, _ string, limit int,
+	f func(context.Context, []*ttnpb.ApplicationUp) error,
+) error {
+	var ups []queuedApplicationUp
+	for len(ups) == 0 {
+		var (
+			wait  time.Duration
+			added <-chan struct{}
+		)
+		ups, wait, added = q.take(limit)
+		if len(ups) > 0 {
+			break
+		}
+		var due <-chan time.Time
+		if wait >= 0 {
+			due = time.After(wait)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-added:
+		case <-due:
+		}
+	}
+
+	type batch struct {
+		ctx     context.Context
+		queued  []queuedApplicationUp
+		uplinks []*ttnpb.ApplicationUp
+	}
+	var keys []string
+	batches := make(map[string]*batch)
+	for _, up := range ups {
+		key := unique.ID(ctx, up.up.GetEndDeviceIds().GetApplicationIds())
+		b, ok := batches[key]
+		if !ok {
+			batchCtx, err := unique.WithContext(ctx, up.uid)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Drop application uplink with invalid identifiers")
+				continue
+			}
+			b = &batch{ctx: batchCtx}
+			batches[key] = b
+			keys = append(keys, key)
+		}
+		b.queued = append(b.queued, up)
+		b.uplinks = append(b.uplinks, up.up)
+	}
+	var failed []queuedApplicationUp
+	for _, key := range keys {
+		b := batches[key]
+		if err := f(b.ctx, b.uplinks); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to process uplink batch")
+			notBefore := time.Now().Add(q.retryInterval)
+			for _, up := range b.queued {
+				up.notBefore = notBefore
+				failed = append(failed, up)
+			}
+		}
+	}
+	if len(failed) > 0 {
+		q.add(failed...)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/memory"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.ApplicationUplinkQueue = &memory.ApplicationUplinkQueue{}
+
+func makeApplicationUp(appID, devID string) *ttnpb.ApplicationUp {
+	return &ttnpb.ApplicationUp{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: appID},
+			DeviceId:       devID,
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{},
+		},
+	}
+}
+
+func TestApplicationUplinkQueue(t *testing.T) {
+	t.Run("Batches", func(t *testing.T) {
+		a, ctx := test.New(t)
+
+		q := memory.NewApplicationUplinkQueue(0, time.Minute)
+		ups := []*ttnpb.ApplicationUp{
+			makeApplicationUp("app1", "dev1"),
+			makeApplicationUp("app2", "dev1"),
+			makeApplicationUp("app1", "dev2"),
+		}
+		a.So(q.Add(ctx, ups...), should.BeNil)
+
+		var batches [][]*ttnpb.ApplicationUp
+		err := q.Pop(ctx, "test", 10, func(_ context.Context, ups []*ttnpb.ApplicationUp) error {
+			batches = append(batches, ups)
+			return nil
+		})
+		a.So(err, should.BeNil)
+		a.So(batches, should.Resemble, [][]*ttnpb.ApplicationUp{
+			{ups[0], ups[2]},
+			{ups[1]},
+		})
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		a, ctx := test.New(t)
+
+		q := memory.NewApplicationUplinkQueue(2, time.Minute)
+		ups := []*ttnpb.ApplicationUp{
+			makeApplicationUp("app1", "dev1"),
+			makeApplicationUp("app1", "dev2"),
+			makeApplicationUp("app1", "dev3"),
+		}
+		a.So(q.Add(ctx, ups...), should.BeNil)
+
+		var popped []*ttnpb.ApplicationUp
+		err := q.Pop(ctx, "test", 10, func(_ context.Context, ups []*ttnpb.ApplicationUp) error {
+			popped = append(popped, ups...)
+			return nil
+		})
+		a.So(err, should.BeNil)
+		a.So(popped, should.Resemble, ups[1:])
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		a, ctx := test.New(t)
+
+		q := memory.NewApplicationUplinkQueue(0, 10*time.Millisecond)
+		up := makeApplicationUp("app1", "dev1")
+		a.So(q.Add(ctx, up), should.BeNil)
+
+		err := q.Pop(ctx, "test", 10, func(context.Context, []*ttnpb.ApplicationUp) error {
+			return errors.New("test")
+		})
+		a.So(err, should.BeNil)
+
+		var popped []*ttnpb.ApplicationUp
+		err = q.Pop(ctx, "test", 10, func(_ context.Context, ups []*ttnpb.ApplicationUp) error {
+			popped = append(popped, ups...)
+			return nil
+		})
+		a.So(err, should.BeNil)
+		a.So(popped, should.Resemble, []*ttnpb.ApplicationUp{up})
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		a, ctx := test.New(t)
+
+		q := memory.NewApplicationUplinkQueue(0, time.Minute)
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		err := q.Pop(ctx, "test", 10, func(context.Context, []*ttnpb.ApplicationUp) error {
+			t.Error("Pop called f on an empty queue")
+			return nil
+		})
+		a.So(errors.IsCanceled(err), should.BeTrue)
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// DownlinkTaskQueue is an in-memory implementation of networkserver.DownlinkTaskQueue.
+type DownlinkTaskQueue struct {
+	mu     sync.Mutex
+	tasks  map[string]time.Time
+	signal signal
+}
+
+// NewDownlinkTaskQueue returns a new in-memory downlink task queue.
+func NewDownlinkTaskQueue() *DownlinkTaskQueue {
+	return &DownlinkTaskQueue{
+		tasks: make(map[string]time.Time),
+	}
+}
+
+func (q *DownlinkTaskQueue) add(uid string, startAt time.Time, replace bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.tasks[uid]; ok && !replace {
+		return
+	}
+	q.tasks[uid] = startAt.UTC()
+	q.signal.broadcast()
+}
+
+// Add adds downlink task for device identified by devID at time startAt.
+// If a task for the device already exists, it is only replaced if replace is set.
+func (q *DownlinkTaskQueue) Add(
+	ctx context.Context, devID *ttnpb.EndDeviceIdentifiers, startAt time.Time, replace bool,
+) error {
+	q.add(unique.ID(ctx, devID), startAt, replace)
+	return nil
+}
+
+// Dispatch blocks until ctx is done. Tasks of the in-memory queue need no dispatching.
+func (*DownlinkTaskQueue) Dispatch(ctx context.Context, _ string) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// next removes and returns the earliest task, if it is due. Otherwise, it returns the time until the earliest task
+// is due, or a negative duration if there are no tasks, and a channel which is closed when a task is added.
+func (q *DownlinkTaskQueue) next() (string, time.Time, time.Duration, <-chan struct{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var (
+		uid     string
+		startAt time.Time
+	)
+	for k, t := range q.tasks {
+		if uid == "" || t.Before(startAt) {
+			uid, startAt = k, t
+		}
+	}
+	if uid == "" {
+		return "", time.Time{}, -1, q.signal.wait()
+	}
+	if d := time.Until(startAt); d > 0 {
+		return "", time.Time{}, d, q.signal.wait()
+	}
+	delete(q.tasks, uid)
+	return uid, startAt, 0, nil
+}
+
+// Pop calls f on the earliest downlink task in the schedule, for which timestamp is in range [0, time.Now()],
+// if such is available, otherwise it blocks until it is.
+func (q *DownlinkTaskQueue) Pop(
+	ctx context.Context,
+	_ string,
+	f func(context.Context, *ttnpb.EndDeviceIdentifiers, time.Time) (time.Time, error),
+) error {
+	for {
+		uid, startAt, wait, added := q.next()
+		if uid == "" {
+			var due <-chan time.Time
+			if wait >= 0 {
+				due = time.After(wait)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-added:
+			case <-due:
+			}
+			continue
+		}
+		ids, err := unique.ToDeviceID(uid)
+		if err != nil {
+			return err
+		}
+		ctx, err := unique.WithContext(ctx, uid)
+		if err != nil {
+			return err
+		}
+		t, err := f(ctx, ids, startAt)
+		if err != nil || t.IsZero() {
+			return err
+		}
+		q.add(uid, t, true)
+		return nil
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memory provides in-memory implementations of interfaces used by networkserver.
+// The implementations are not shared between processes, so they are only suitable for single-node deployments.
+package memory
+
+// signal wakes up the goroutines waiting for a change.
+// It must be guarded by the mutex of the structure it belongs to.
+type signal struct {
+	ch chan struct{}
+}
+
+// wait returns a channel which is closed on the next broadcast.
+func (s *signal) wait() <-chan struct{} {
+	if s.ch == nil {
+		s.ch = make(chan struct{})
+	}
+	return s.ch
+}
+
+// broadcast wakes up all waiting goroutines.
+func (s *signal) broadcast() {
+	if s.ch != nil {
+		close(s.ch)
+		s.ch = nil
+	}
+}
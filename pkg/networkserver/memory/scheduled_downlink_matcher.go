@@ -0,0 +1,105 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// scheduledDownlinkTTL is the duration before metadata for scheduled downlinks expire.
+// TxAcknowledgements received after this TTL will be considered invalid and will not match any downlinks.
+const scheduledDownlinkTTL = time.Minute
+
+const downlinkCorrelationIDPrefix = "ns:transmission:"
+
+var (
+	errMissingDownlinkCorrelationID = errors.DefineNotFound(
+		"missing_downlink_correlation_id", "missing identifier correlation ID on downlink message",
+	)
+	errScheduledDownlinkNotFound = errors.DefineNotFound("scheduled_downlink_not_found", "scheduled downlink not found")
+)
+
+// downlinkCorrelationID returns the ID of the ns:transmission:XXXXXXX correlation ID.
+func downlinkCorrelationID(cids []string) (string, bool) {
+	for _, cid := range cids {
+		if id := strings.TrimPrefix(cid, downlinkCorrelationIDPrefix); id != cid && id != "" {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+type scheduledDownlinkEntry struct {
+	down      *ttnpb.DownlinkMessage
+	expiresAt time.Time
+}
+
+// ScheduledDownlinkMatcher is an in-memory implementation of networkserver.ScheduledDownlinkMatcher.
+type ScheduledDownlinkMatcher struct {
+	mu        sync.Mutex
+	downlinks map[string]scheduledDownlinkEntry
+}
+
+// NewScheduledDownlinkMatcher returns a new in-memory scheduled downlink matcher.
+func NewScheduledDownlinkMatcher() *ScheduledDownlinkMatcher {
+	return &ScheduledDownlinkMatcher{
+		downlinks: make(map[string]scheduledDownlinkEntry),
+	}
+}
+
+// Add implements networkserver.ScheduledDownlinkMatcher.
+func (m *ScheduledDownlinkMatcher) Add(_ context.Context, down *ttnpb.DownlinkMessage) error {
+	id, ok := downlinkCorrelationID(down.GetCorrelationIds())
+	if !ok {
+		return errMissingDownlinkCorrelationID.New()
+	}
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for k, e := range m.downlinks {
+		if !now.Before(e.expiresAt) {
+			delete(m.downlinks, k)
+		}
+	}
+	m.downlinks[id] = scheduledDownlinkEntry{
+		down:      ttnpb.Clone(down),
+		expiresAt: now.Add(scheduledDownlinkTTL),
+	}
+	return nil
+}
+
+// Match implements networkserver.ScheduledDownlinkMatcher.
+func (m *ScheduledDownlinkMatcher) Match(_ context.Context, ack *ttnpb.TxAcknowledgment) (*ttnpb.DownlinkMessage, error) {
+	id, ok := downlinkCorrelationID(ack.GetDownlinkMessage().GetCorrelationIds())
+	if !ok {
+		return nil, errMissingDownlinkCorrelationID.New()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.downlinks[id]
+	if !ok || !time.Now().Before(e.expiresAt) {
+		return nil, errScheduledDownlinkNotFound.New()
+	}
+	delete(m.downlinks, id)
+	return e.down, nil
+}
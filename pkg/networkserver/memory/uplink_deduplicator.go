@@ -0,0 +1,130 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+)
+
+type uplinkKey struct {
+	payload   string
+	frequency uint64
+	dataRate  string
+	round     uint64
+}
+
+type uplinkEntry struct {
+	expiresAt time.Time
+	mds       []*ttnpb.RxMetadata
+}
+
+// UplinkDeduplicator is an in-memory implementation of networkserver.UplinkDeduplicator.
+type UplinkDeduplicator struct {
+	mu       sync.Mutex
+	entries  map[uplinkKey]*uplinkEntry
+	prunedAt time.Time
+}
+
+// NewUplinkDeduplicator returns a new in-memory uplink deduplicator.
+func NewUplinkDeduplicator() *UplinkDeduplicator {
+	return &UplinkDeduplicator{
+		entries: make(map[uplinkKey]*uplinkEntry),
+	}
+}
+
+func makeUplinkKey(up *ttnpb.UplinkMessage, round uint64) (uplinkKey, error) {
+	drBytes, err := proto.Marshal(up.Settings.DataRate)
+	if err != nil {
+		return uplinkKey{}, err
+	}
+	// NOTE: Data rate and frequency are included in the key to support retransmissions.
+	return uplinkKey{
+		payload:   string(up.RawPayload),
+		frequency: up.Settings.Frequency,
+		dataRate:  string(drBytes),
+		round:     round,
+	}, nil
+}
+
+// prune removes the expired entries. It is called at most once per second.
+func (d *UplinkDeduplicator) prune(now time.Time) {
+	if now.Sub(d.prunedAt) < time.Second {
+		return
+	}
+	for k, e := range d.entries {
+		if !now.Before(e.expiresAt) {
+			delete(d.entries, k)
+		}
+	}
+	d.prunedAt = now
+}
+
+// DeduplicateUplink deduplicates up for window.
+func (d *UplinkDeduplicator) DeduplicateUplink(
+	_ context.Context, up *ttnpb.UplinkMessage, window time.Duration, limit int, round uint64,
+) (bool, error) {
+	k, err := makeUplinkKey(up, round)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.prune(now)
+	e, ok := d.entries[k]
+	first := !ok || !now.Before(e.expiresAt)
+	if first {
+		e = &uplinkEntry{
+			expiresAt: now.Add(window),
+		}
+		d.entries[k] = e
+	}
+	for _, md := range up.RxMetadata {
+		e.mds = append(e.mds, ttnpb.Clone(md))
+	}
+	if limit > 0 && len(e.mds) > limit {
+		e.mds = append(e.mds[:0:0], e.mds[len(e.mds)-limit:]...)
+	}
+	return first, nil
+}
+
+// AccumulatedMetadata returns accumulated metadata for up.
+func (d *UplinkDeduplicator) AccumulatedMetadata(
+	_ context.Context, up *ttnpb.UplinkMessage, round uint64,
+) ([]*ttnpb.RxMetadata, error) {
+	k, err := makeUplinkKey(up, round)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e, ok := d.entries[k]
+	if !ok || !now.Before(e.expiresAt) {
+		return nil, nil
+	}
+	mds := make([]*ttnpb.RxMetadata, 0, len(e.mds))
+	for _, md := range e.mds {
+		mds = append(mds, ttnpb.Clone(md))
+	}
+	return mds, nil
+}
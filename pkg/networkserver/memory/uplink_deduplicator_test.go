@@ -0,0 +1,97 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/memory"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.UplinkDeduplicator = &memory.UplinkDeduplicator{}
+
+func TestUplinkDeduplicator(t *testing.T) {
+	a, ctx := test.New(t)
+
+	d := memory.NewUplinkDeduplicator()
+
+	makeUplink := func(gatewayID string) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+			Settings: &ttnpb.TxSettings{
+				DataRate: &ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_Lora{
+						Lora: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 7,
+							Bandwidth:       125000,
+						},
+					},
+				},
+				Frequency: 868100000,
+			},
+			RxMetadata: []*ttnpb.RxMetadata{{
+				GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: gatewayID},
+			}},
+		}
+	}
+
+	first, err := d.DeduplicateUplink(ctx, makeUplink("gtw1"), time.Minute, 2, 1)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeTrue)
+
+	first, err = d.DeduplicateUplink(ctx, makeUplink("gtw2"), time.Minute, 2, 1)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeFalse)
+
+	first, err = d.DeduplicateUplink(ctx, makeUplink("gtw3"), time.Minute, 2, 1)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeFalse)
+
+	mds, err := d.AccumulatedMetadata(ctx, makeUplink("gtw1"), 1)
+	a.So(err, should.BeNil)
+	if a.So(mds, should.HaveLength, 2) {
+		a.So(mds[0].GatewayIds.GatewayId, should.Equal, "gtw2")
+		a.So(mds[1].GatewayIds.GatewayId, should.Equal, "gtw3")
+	}
+
+	// Another round is deduplicated separately.
+	first, err = d.DeduplicateUplink(ctx, makeUplink("gtw1"), time.Minute, 2, 2)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeTrue)
+
+	// Retransmissions on another frequency are not duplicates.
+	up := makeUplink("gtw1")
+	up.Settings.Frequency = 868300000
+	first, err = d.DeduplicateUplink(ctx, up, time.Minute, 2, 1)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeTrue)
+
+	// Entries expire after the window.
+	first, err = d.DeduplicateUplink(ctx, makeUplink("gtw1"), time.Nanosecond, 2, 3)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeTrue)
+	<-time.After(time.Millisecond)
+	mds, err = d.AccumulatedMetadata(ctx, makeUplink("gtw1"), 3)
+	a.So(err, should.BeNil)
+	a.So(mds, should.BeEmpty)
+	first, err = d.DeduplicateUplink(ctx, makeUplink("gtw2"), time.Minute, 2, 3)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeTrue)
+}
@@ -0,0 +1,320 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	errNotMulticast = errors.DefineFailedPrecondition(
+		"not_multicast", "end device `{device_uid}` is not a multicast end device",
+	)
+	errMulticastGatewaySelectionDisabled = errors.DefineFailedPrecondition(
+		"multicast_gateway_selection_disabled", "multicast gateway selection is disabled",
+	)
+)
+
+// MulticastMemberRegistry stores the end devices that are members of the multicast groups of multicast end devices.
+// Members are in the same application as the multicast end device.
+type MulticastMemberRegistry interface {
+	// Get returns the device IDs of the members of the multicast end device.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]string, error)
+	// Set sets the device IDs of the members of the multicast end device.
+	// If deviceIDs is empty, the members are removed.
+	Set(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, deviceIDs []string) error
+}
+
+// multicastGatewayCandidate is a gateway antenna that received uplinks of group members.
+type multicastGatewayCandidate struct {
+	key          string
+	ids          *ttnpb.GatewayIdentifiers
+	antennaIndex uint32
+	// snr is the best SNR of the uplinks of each member received by the gateway antenna, by member index.
+	snr map[int]float32
+}
+
+// multicastGatewaySelection is a set of gateways selected for multicast downlinks.
+type multicastGatewaySelection struct {
+	Gateways []*ttnpb.ClassBCGatewayIdentifiers
+	// Members is the number of group members.
+	Members int
+	// Covered is the number of group members that are covered by the redundancy goal.
+	Covered int
+}
+
+// multicastGatewayCandidates returns the gateway antennas which are able to transmit downlinks that
+// received the recent uplinks of the members, sorted by key.
+func multicastGatewayCandidates(
+	members [][]*ttnpb.MACState_UplinkMessage, maxUplinkAge time.Duration, now time.Time,
+) []*multicastGatewayCandidate {
+	candidates := make(map[string]*multicastGatewayCandidate)
+	for i, ups := range members {
+		for _, up := range ups {
+			if maxUplinkAge > 0 && now.Sub(ttnpb.StdTimeOrZero(up.ReceivedAt)) > maxUplinkAge {
+				continue
+			}
+			for _, md := range up.RxMetadata {
+				if md.GatewayIds == nil || md.PacketBroker != nil || md.Relay != nil || md.PacketSource != nil ||
+					md.DownlinkPathConstraint == ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER {
+					continue
+				}
+				var antennaIndex uint32
+				if len(md.UplinkToken) > 0 {
+					token := &ttnpb.UplinkToken{}
+					if err := proto.Unmarshal(md.UplinkToken, token); err == nil {
+						antennaIndex = token.GetIds().GetAntennaIndex()
+					}
+				}
+				key := fmt.Sprintf("%s/%d", md.GatewayIds.GatewayId, antennaIndex)
+				c, ok := candidates[key]
+				if !ok {
+					c = &multicastGatewayCandidate{
+						key:          key,
+						ids:          md.GatewayIds,
+						antennaIndex: antennaIndex,
+						snr:          make(map[int]float32),
+					}
+					candidates[key] = c
+				}
+				if snr, ok := c.snr[i]; !ok || md.Snr > snr {
+					c.snr[i] = md.Snr
+				}
+			}
+		}
+	}
+	sorted := make([]*multicastGatewayCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		sorted = append(sorted, c)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].key < sorted[j].key })
+	return sorted
+}
+
+// selectMulticastGateways greedily selects a minimal set of gateways, which covers the coverage goal of the members
+// with the redundancy goal. A member is covered if it is covered by the redundancy goal, or by all gateways that
+// received its recent uplinks if there are fewer. Each selected gateway is in its own group, so that the downlink is
+// transmitted by all selected gateways.
+func selectMulticastGateways(
+	conf MulticastGatewaySelectionConfig, members [][]*ttnpb.MACState_UplinkMessage, now time.Time,
+) *multicastGatewaySelection {
+	candidates := multicastGatewayCandidates(members, conf.MaxUplinkAge, now)
+	redundancy := int(conf.Redundancy)
+	if redundancy < 1 {
+		redundancy = 1
+	}
+	coverage := conf.Coverage
+	if coverage <= 0 || coverage > 1 {
+		coverage = 1
+	}
+
+	need := make([]int, len(members))
+	for _, c := range candidates {
+		for i := range c.snr {
+			need[i]++
+		}
+	}
+	for i := range need {
+		if need[i] > redundancy {
+			need[i] = redundancy
+		}
+	}
+	have := make([]int, len(members))
+	covered := func() int {
+		n := 0
+		for i := range have {
+			if need[i] > 0 && have[i] >= need[i] {
+				n++
+			}
+		}
+		return n
+	}
+
+	selection := &multicastGatewaySelection{
+		Members: len(members),
+	}
+	target := int(math.Ceil(coverage * float64(len(members))))
+	selected := make([]bool, len(candidates))
+	for selection.Covered < target && (conf.MaxGateways == 0 || len(selection.Gateways) < int(conf.MaxGateways)) {
+		best, bestGain, bestSNR := -1, 0, float32(0)
+		for j, c := range candidates {
+			if selected[j] {
+				continue
+			}
+			gain, snr := 0, float32(0)
+			for i, s := range c.snr {
+				if have[i] < need[i] {
+					gain++
+					snr += s
+				}
+			}
+			if gain > bestGain || gain == bestGain && gain > 0 && snr > bestSNR {
+				best, bestGain, bestSNR = j, gain, snr
+			}
+		}
+		if best < 0 {
+			break
+		}
+		selected[best] = true
+		c := candidates[best]
+		for i := range c.snr {
+			have[i]++
+		}
+		selection.Gateways = append(selection.Gateways, &ttnpb.ClassBCGatewayIdentifiers{
+			GatewayIds:   c.ids,
+			AntennaIndex: c.antennaIndex,
+			GroupIndex:   uint32(len(selection.Gateways)),
+		})
+		selection.Covered = covered()
+	}
+	return selection
+}
+
+// selectMulticastGroupGateways selects the gateways for multicast downlinks of the multicast end device from the recent
+// uplinks of its group members. It returns nil if the multicast end device has no group members.
+func (ns *NetworkServer) selectMulticastGroupGateways(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*multicastGatewaySelection, error) {
+	conf := ns.multicastGateways
+	deviceIDs, err := conf.Members.Get(ctx, ids)
+	if err != nil || len(deviceIDs) == 0 {
+		return nil, err
+	}
+	devs, err := ns.devices.BatchGetByID(ctx, ids.ApplicationIds, deviceIDs, []string{"mac_state.recent_uplinks"})
+	if err != nil {
+		return nil, err
+	}
+	members := make([][]*ttnpb.MACState_UplinkMessage, 0, len(devs))
+	for _, dev := range devs {
+		if dev == nil {
+			continue
+		}
+		members = append(members, dev.GetMacState().GetRecentUplinks())
+	}
+	return selectMulticastGateways(conf, members, time.Now()), nil
+}
+
+// selectMulticastDownlinkGateways sets the gateways of the multicast downlinks without gateways to the gateways
+// selected from the recent uplinks of the group members of the multicast end device.
+func (ns *NetworkServer) selectMulticastDownlinkGateways(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, downs ...*ttnpb.ApplicationDownlink,
+) error {
+	if !ns.multicastGateways.Enable {
+		return nil
+	}
+	var pending []*ttnpb.ApplicationDownlink
+	for _, down := range downs {
+		if len(down.GetClassBC().GetGateways()) == 0 {
+			pending = append(pending, down)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	selection, err := ns.selectMulticastGroupGateways(ctx, ids)
+	if err != nil || selection == nil || len(selection.Gateways) == 0 {
+		return err
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"covered_member_count", selection.Covered,
+		"gateway_count", len(selection.Gateways),
+		"member_count", selection.Members,
+	)).Debug("Selected gateways for multicast downlinks")
+	for _, down := range pending {
+		if down.ClassBC == nil {
+			down.ClassBC = &ttnpb.ApplicationDownlink_ClassBC{}
+		}
+		down.ClassBC.Gateways = selection.Gateways
+	}
+	return nil
+}
+
+// GetMulticastGroupMembers implements ttnpb.NsServer.
+func (ns *NetworkServer) GetMulticastGroupMembers(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.MulticastGroupMembers, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if !ns.multicastGateways.Enable {
+		return nil, errMulticastGatewaySelectionDisabled.New()
+	}
+	deviceIDs, err := ns.multicastGateways.Members.Get(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.MulticastGroupMembers{
+		EndDeviceIds:    ids,
+		MemberDeviceIds: deviceIDs,
+	}, nil
+}
+
+// SetMulticastGroupMembers implements ttnpb.NsServer.
+func (ns *NetworkServer) SetMulticastGroupMembers(
+	ctx context.Context, req *ttnpb.MulticastGroupMembers,
+) (*emptypb.Empty, error) {
+	ids := req.EndDeviceIds
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if !ns.multicastGateways.Enable {
+		return nil, errMulticastGatewaySelectionDisabled.New()
+	}
+	dev, ctx, err := ns.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{"multicast"})
+	if err != nil {
+		return nil, err
+	}
+	if !dev.Multicast {
+		return nil, errNotMulticast.WithAttributes("device_uid", unique.ID(ctx, ids))
+	}
+	if err := ns.multicastGateways.Members.Set(ctx, ids, req.MemberDeviceIds); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// GetMulticastGroupGateways implements ttnpb.NsServer.
+func (ns *NetworkServer) GetMulticastGroupGateways(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.MulticastGroupGateways, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if !ns.multicastGateways.Enable {
+		return nil, errMulticastGatewaySelectionDisabled.New()
+	}
+	selection, err := ns.selectMulticastGroupGateways(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.MulticastGroupGateways{}
+	if selection != nil {
+		res.Gateways = selection.Gateways
+		res.MemberCount, res.CoveredMemberCount = uint32(selection.Members), uint32(selection.Covered)
+	}
+	return res, nil
+}
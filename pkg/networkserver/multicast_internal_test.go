@@ -0,0 +1,166 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSelectMulticastGateways(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	gtwA := &ttnpb.GatewayIdentifiers{GatewayId: "gtw-a"}
+	gtwB := &ttnpb.GatewayIdentifiers{GatewayId: "gtw-b"}
+	gtwC := &ttnpb.GatewayIdentifiers{GatewayId: "gtw-c"}
+	gtwD := &ttnpb.GatewayIdentifiers{GatewayId: "gtw-d"}
+	tokenC, err := proto.Marshal(&ttnpb.UplinkToken{
+		Ids: &ttnpb.GatewayAntennaIdentifiers{GatewayIds: gtwC, AntennaIndex: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	uplink := func(age time.Duration, mds ...*ttnpb.MACState_UplinkMessage_RxMetadata) *ttnpb.MACState_UplinkMessage {
+		return &ttnpb.MACState_UplinkMessage{
+			ReceivedAt: timestamppb.New(now.Add(-age)),
+			RxMetadata: mds,
+		}
+	}
+	members := [][]*ttnpb.MACState_UplinkMessage{
+		{
+			uplink(time.Minute,
+				&ttnpb.MACState_UplinkMessage_RxMetadata{GatewayIds: gtwA, Snr: 5},
+				&ttnpb.MACState_UplinkMessage_RxMetadata{GatewayIds: gtwB, Snr: 1},
+			),
+		},
+		{
+			uplink(time.Minute, &ttnpb.MACState_UplinkMessage_RxMetadata{GatewayIds: gtwB, Snr: 2}),
+		},
+		{
+			uplink(time.Hour, &ttnpb.MACState_UplinkMessage_RxMetadata{GatewayIds: gtwC, Snr: 3, UplinkToken: tokenC}),
+		},
+		nil,
+		{
+			uplink(48*time.Hour, &ttnpb.MACState_UplinkMessage_RxMetadata{GatewayIds: gtwA, Snr: 10}),
+			uplink(time.Minute, &ttnpb.MACState_UplinkMessage_RxMetadata{
+				GatewayIds:             gtwD,
+				Snr:                    10,
+				DownlinkPathConstraint: ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER,
+			}),
+			uplink(time.Minute, &ttnpb.MACState_UplinkMessage_RxMetadata{
+				PacketBroker: &ttnpb.MACState_UplinkMessage_RxMetadata_PacketBrokerMetadata{},
+				Snr:          10,
+			}),
+		},
+	}
+	gateway := func(ids *ttnpb.GatewayIdentifiers, antennaIndex, groupIndex uint32) *ttnpb.ClassBCGatewayIdentifiers {
+		return &ttnpb.ClassBCGatewayIdentifiers{
+			GatewayIds:   ids,
+			AntennaIndex: antennaIndex,
+			GroupIndex:   groupIndex,
+		}
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Config   MulticastGatewaySelectionConfig
+		Expected *multicastGatewaySelection
+	}{
+		{
+			Name: "FullCoverage",
+			Config: MulticastGatewaySelectionConfig{
+				Coverage:     1,
+				Redundancy:   1,
+				MaxUplinkAge: 24 * time.Hour,
+			},
+			Expected: &multicastGatewaySelection{
+				Gateways: []*ttnpb.ClassBCGatewayIdentifiers{
+					gateway(gtwB, 0, 0),
+					gateway(gtwC, 1, 1),
+				},
+				Members: 5,
+				Covered: 3,
+			},
+		},
+		{
+			Name: "Redundancy",
+			Config: MulticastGatewaySelectionConfig{
+				Coverage:     1,
+				Redundancy:   2,
+				MaxUplinkAge: 24 * time.Hour,
+			},
+			Expected: &multicastGatewaySelection{
+				Gateways: []*ttnpb.ClassBCGatewayIdentifiers{
+					gateway(gtwB, 0, 0),
+					gateway(gtwA, 0, 1),
+					gateway(gtwC, 1, 2),
+				},
+				Members: 5,
+				Covered: 3,
+			},
+		},
+		{
+			Name: "PartialCoverage",
+			Config: MulticastGatewaySelectionConfig{
+				Coverage:     0.4,
+				Redundancy:   1,
+				MaxUplinkAge: 24 * time.Hour,
+			},
+			Expected: &multicastGatewaySelection{
+				Gateways: []*ttnpb.ClassBCGatewayIdentifiers{
+					gateway(gtwB, 0, 0),
+				},
+				Members: 5,
+				Covered: 2,
+			},
+		},
+		{
+			Name: "MaxGateways",
+			Config: MulticastGatewaySelectionConfig{
+				Coverage:     1,
+				Redundancy:   2,
+				MaxGateways:  2,
+				MaxUplinkAge: 24 * time.Hour,
+			},
+			Expected: &multicastGatewaySelection{
+				Gateways: []*ttnpb.ClassBCGatewayIdentifiers{
+					gateway(gtwB, 0, 0),
+					gateway(gtwA, 0, 1),
+				},
+				Members: 5,
+				Covered: 2,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(_ context.Context, _ *testing.T, a *assertions.Assertion) {
+				a.So(selectMulticastGateways(tc.Config, members, now), should.Resemble, tc.Expected)
+			},
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/budget"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
@@ -36,6 +37,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/workerpool"
 	"google.golang.org/grpc"
 )
@@ -163,13 +165,15 @@ type NetworkServer struct {
 	relayConfiguration ttnpb.NsRelayConfigurationServiceServer
 	macSettingsProfile ttnpb.NsMACSettingsProfileRegistryServer
 
-	netID           netIDFunc
-	nsID            nsIDFunc
-	clusterID       string
-	newDevAddr      newDevAddrFunc
-	devAddrPrefixes devAddrPrefixesFunc
+	netID            netIDFunc
+	nsID             nsIDFunc
+	clusterID        string
+	newDevAddr       newDevAddrFunc
+	devAddrAllocator DevAddrAllocator
+	devAddrPrefixes  devAddrPrefixesFunc
 
 	applicationUplinks ApplicationUplinkQueue
+	applicationServers *applicationServerFailover
 
 	downlinkTasks      DownlinkTaskQueue
 	downlinkPriorities DownlinkPriorities
@@ -177,9 +181,27 @@ type NetworkServer struct {
 	deduplicationWindow windowDurationFunc
 	collectionWindow    windowDurationFunc
 
-	defaultMACSettings *ttnpb.MACSettings
+	defaultMACSettings  *ttnpb.MACSettings
+	downlinkPathRanking *downlinkPathRanking
+	budgets             *budget.Enforcer
 
-	interopClient InteropClient
+	interopClient  InteropClient
+	passiveRoaming *passiveRoaming
+	handover       *handoverRoaming
+
+	multicastGateways MulticastGatewaySelectionConfig
+
+	linkStats LinkStatsConfig
+
+	relayAssignment *relayAssignment
+
+	classBCapacity *classBCapacity
+
+	fCntResync *fCntResync
+
+	rejoin *rejoin
+
+	packetSources *packetSources
 
 	uplinkDeduplicator UplinkDeduplicator
 
@@ -189,6 +211,8 @@ type NetworkServer struct {
 	scheduledDownlinkMatcher ScheduledDownlinkMatcher
 
 	uplinkSubmissionPool workerpool.WorkerPool[[]*ttnpb.ApplicationUp]
+	relayAssignmentPool  workerpool.WorkerPool[*relayAssignmentUplink]
+	fCntResyncPool       workerpool.WorkerPool[*fCntRejectionUplink]
 }
 
 // Option configures the NetworkServer.
@@ -209,6 +233,8 @@ const (
 	applicationUplinkDispatchTaskName = "dispatch_application_uplink"
 	downlinkDispatchTaskName          = "dispatch_downlink"
 
+	closeApplicationServerConnectionsTaskName = "close_application_server_connections"
+
 	maxInt = int(^uint(0) >> 1)
 )
 
@@ -243,18 +269,9 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		panic(errInvalidConfiguration.WithCause(errors.New("MACSettingsProfileRegistry is not specified")))
 	}
 
-	devAddrPrefixes := conf.DevAddrPrefixes
-	if len(devAddrPrefixes) == 0 {
-		devAddr, err := types.NewDevAddr(conf.NetID, nil)
-		if err != nil {
-			return nil, err
-		}
-		devAddrPrefixes = []types.DevAddrPrefix{
-			{
-				DevAddr: devAddr,
-				Length:  uint8(32 - types.NwkAddrBits(conf.NetID)),
-			},
-		}
+	devAddrPrefixes, err := conf.EffectiveDevAddrPrefixes()
+	if err != nil {
+		return nil, err
 	}
 	downlinkPriorities, err := conf.DownlinkPriorities.Parse()
 	if err != nil {
@@ -272,10 +289,83 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		}
 	}
 
+	roamingCl, _ := interopCl.(PassiveRoamingClient)
+	passiveRoaming, err := newPassiveRoaming(conf.PassiveRoaming, roamingCl)
+	if err != nil {
+		return nil, err
+	}
+	handoverCl, _ := interopCl.(HandoverRoamingClient)
+	handover, err := newHandoverRoaming(conf.HandoverRoaming, handoverCl)
+	if err != nil {
+		return nil, err
+	}
+
 	defaultMACSettings, err := conf.DefaultMACSettings.Parse()
 	if err != nil {
 		return nil, err
 	}
+	if err := validateQueueBackend(conf.QueueBackend); err != nil {
+		return nil, err
+	}
+	devices := conf.Devices
+	newDevAddr := makeNewDevAddrFunc(devAddrPrefixes...)
+	if allocator := conf.DevAddrAllocation.Allocator; allocator != nil {
+		devices = wrapEndDeviceRegistryWithDevAddrAllocator(devices, allocator)
+		newDevAddr = makeAllocatingNewDevAddrFunc(newDevAddr, allocator, devAddrPrefixes...)
+	}
+
+	if conf.MulticastGatewaySelection.Enable && conf.MulticastGatewaySelection.Members == nil {
+		return nil, errInvalidConfiguration.WithCause(errors.New("MulticastGatewaySelection.Members is not specified"))
+	}
+
+	if conf.LinkStats.Enable && conf.LinkStats.Registry == nil {
+		return nil, errInvalidConfiguration.WithCause(errors.New("LinkStats.Registry is not specified"))
+	}
+
+	var relayAssignment *relayAssignment
+	if conf.RelayAssignment.Enable {
+		relayAssignment, err = newRelayAssignment(conf.RelayAssignment)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var classBCapacity *classBCapacity
+	if conf.ClassBCapacity.Enable {
+		classBCapacity, err = newClassBCapacity(conf.ClassBCapacity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var fCntResync *fCntResync
+	if conf.FCntResync.Enable {
+		fCntResync, err = newFCntResync(conf.FCntResync)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rejoin *rejoin
+	if conf.Rejoin.Enable {
+		rejoin, err = newRejoin(conf.Rejoin)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	packetSources, err := newPacketSources(conf.PacketSources)
+	if err != nil {
+		return nil, err
+	}
+
+	var budgets *budget.Enforcer
+	if !conf.Budgets.IsZero() {
+		if conf.Budgets.Store == nil {
+			return nil, errInvalidConfiguration.WithCause(errors.New("Budgets.Store is not specified"))
+		}
+		budgets = budget.NewEnforcer(conf.Budgets)
+	}
 
 	ns := &NetworkServer{
 		Component:                c,
@@ -283,24 +373,46 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		netID:                    makeNetIDFunc(conf.NetID),
 		nsID:                     makeNSIDFunc(conf.Interop.ID),
 		clusterID:                conf.ClusterID,
-		newDevAddr:               makeNewDevAddrFunc(devAddrPrefixes...),
+		newDevAddr:               newDevAddr,
+		devAddrAllocator:         conf.DevAddrAllocation.Allocator,
 		devAddrPrefixes:          makeDevAddrPrefixesFunc(devAddrPrefixes...),
 		applicationUplinks:       conf.ApplicationUplinkQueue.Queue,
 		deduplicationWindow:      makeWindowDurationFunc(conf.DeduplicationWindow),
 		collectionWindow:         makeWindowDurationFunc(conf.DeduplicationWindow + conf.CooldownWindow),
-		devices:                  wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
-		batchDevices:             &nsEndDeviceBatchRegistry{devices: conf.Devices},
+		devices:                  wrapEndDeviceRegistryWithReplacedFields(devices, replacedEndDeviceFields...),
+		batchDevices:             &nsEndDeviceBatchRegistry{devices: devices},
 		relayConfiguration:       &nsRelayConfigurationService{devices: conf.Devices, frequencyPlans: c.FrequencyPlansStore},
 		macSettingsProfile:       &NsMACSettingsProfileRegistry{registry: conf.MACSettingsProfileRegistry},
 		downlinkTasks:            conf.DownlinkTaskQueue.Queue,
 		downlinkPriorities:       downlinkPriorities,
 		defaultMACSettings:       defaultMACSettings,
+		budgets:                  budgets,
 		interopClient:            interopCl,
+		passiveRoaming:           passiveRoaming,
+		handover:                 handover,
+		multicastGateways:        conf.MulticastGatewaySelection,
+		linkStats:                conf.LinkStats,
+		relayAssignment:          relayAssignment,
+		classBCapacity:           classBCapacity,
+		fCntResync:               fCntResync,
+		rejoin:                   rejoin,
+		packetSources:            packetSources,
 		uplinkDeduplicator:       conf.UplinkDeduplicator,
 		deviceKEKLabel:           conf.DeviceKEKLabel,
 		downlinkQueueCapacity:    conf.DownlinkQueueCapacity,
 		scheduledDownlinkMatcher: conf.ScheduledDownlinkMatcher,
 	}
+	ns.applicationServers, err = newApplicationServerFailover(
+		conf.ApplicationServerFailover, ns.clusterApplicationServerClient, ns.WithClusterAuth(), ns.dialApplicationServer,
+	)
+	if err != nil {
+		return nil, err
+	}
+	utilization := conf.DownlinkPathRanking.Utilization
+	if utilization == nil {
+		utilization = newGatewayServerUtilization(ns, conf.DownlinkPathRanking.UtilizationTTL)
+	}
+	ns.downlinkPathRanking = newDownlinkPathRanking(conf.DownlinkPathRanking, utilization)
 	ns.uplinkSubmissionPool = workerpool.NewWorkerPool(workerpool.Config[[]*ttnpb.ApplicationUp]{
 		Component:  c,
 		Context:    ctx,
@@ -309,6 +421,26 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		QueueSize:  int(conf.ApplicationUplinkQueue.FastBufferSize),
 		MaxWorkers: int(conf.ApplicationUplinkQueue.FastNumConsumers),
 	})
+	if ns.relayAssignment != nil {
+		ns.relayAssignmentPool = workerpool.NewWorkerPool(workerpool.Config[*relayAssignmentUplink]{
+			Component:  c,
+			Context:    ctx,
+			Name:       "relay_assignment",
+			Handler:    ns.handleRelayAssignmentUplink,
+			QueueSize:  int(conf.RelayAssignment.BufferSize),
+			MaxWorkers: int(conf.RelayAssignment.NumConsumers),
+		})
+	}
+	if ns.fCntResync != nil {
+		ns.fCntResyncPool = workerpool.NewWorkerPool(workerpool.Config[*fCntRejectionUplink]{
+			Component:  c,
+			Context:    ctx,
+			Name:       "f_cnt_resync",
+			Handler:    ns.handleFCntRejectionUplink,
+			QueueSize:  int(conf.FCntResync.BufferSize),
+			MaxWorkers: int(conf.FCntResync.NumConsumers),
+		})
+	}
 	ctx = ns.Context()
 
 	if len(opts) == 0 {
@@ -333,6 +465,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			"/ttn.lorawan.v3.Ns",
 			"/ttn.lorawan.v3.RelayConfigurationService",
 			"/ttn.lorawan.v3.NsMACSettingsProfileRegistry",
+			"/ttn.lorawan.v3.NsPacketSource",
 		} {
 			c.GRPC.RegisterUnaryHook(filter, hook.name, hook.middleware)
 		}
@@ -386,7 +519,21 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			Backoff: processTaskBackoff,
 		})
 	}
+	if len(conf.ApplicationServerFailover.Secondaries) > 0 {
+		ns.RegisterTask(&task.Config{
+			Context: ctx,
+			ID:      closeApplicationServerConnectionsTaskName,
+			Func: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ns.applicationServers.close()
+			},
+			Restart: task.RestartNever,
+			Backoff: task.DefaultBackoffConfig,
+		})
+	}
 	c.RegisterGRPC(ns)
+	c.RegisterInterop(ns)
+	c.RegisterWeb(ns)
 	return ns, nil
 }
 
@@ -404,6 +551,12 @@ func (ns *NetworkServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterNsServer(s, ns)
 	ttnpb.RegisterNsRelayConfigurationServiceServer(s, ns.relayConfiguration)
 	ttnpb.RegisterNsMACSettingsProfileRegistryServer(s, ns.macSettingsProfile)
+	if ns.packetSources != nil {
+		ttnpb.RegisterNsPacketSourceServer(s, &nsPacketSourceServer{
+			packetSources: ns.packetSources,
+			handleUplink:  ns.handlePacketSourceUplink,
+		})
+	}
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -415,6 +568,20 @@ func (ns *NetworkServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Client
 	ttnpb.RegisterNsMACSettingsProfileRegistryHandler(ns.Context(), s, conn) // nolint:errcheck
 }
 
+// RegisterRoutes registers the HTTP routes of the Network Server.
+func (ns *NetworkServer) RegisterRoutes(s *web.Server) {
+	if ns.packetSources != nil {
+		ns.registerPacketSourceRoutes(s)
+	}
+}
+
+// RegisterInterop registers the NS-NS interop services used for passive and handover roaming.
+func (ns *NetworkServer) RegisterInterop(srv *interop.Server) {
+	if ns.passiveRoaming != nil || ns.handover != nil {
+		srv.RegisterNS(interopServer{NS: ns})
+	}
+}
+
 // Roles returns the roles that the Network Server fulfills.
 func (ns *NetworkServer) Roles() []ttnpb.ClusterRole {
 	return []ttnpb.ClusterRole{ttnpb.ClusterRole_NETWORK_SERVER}
@@ -270,7 +270,7 @@ func makeOTAAFlowTest(conf OTAAFlowTestConfig) func(context.Context, TestEnviron
 			FOpts:         MakeUplinkMACBuffer(phy, append(upCmders, conf.UplinkMACCommanders...)...),
 			FCtrl:         &ttnpb.FCtrl{Adr: true},
 			FPort:         0x42,
-			EventBuilders: append(upEvBuilders, conf.UplinkEventBuilders...),
+			EventBuilders: append(append(upEvBuilders, conf.UplinkEventBuilders...), mac.EvtAdaptDataRate),
 		})
 		if !a.So(ok, should.BeTrue) {
 			t.Error("Data uplink assertion failed")
@@ -0,0 +1,327 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errPacketSourceUnauthenticated = errors.DefineUnauthenticated(
+		"packet_source_unauthenticated", "unauthenticated packet source",
+	)
+	errUnknownPacketSource = errors.DefineNotFound(
+		"unknown_packet_source", "packet source `{source_id}` not found",
+	)
+	errPacketSourceUplinkDecode = errors.DefineInvalidArgument(
+		"packet_source_uplink_decode", "decode packet source uplink",
+	)
+	errPacketSourceCallback = errors.DefineUnavailable(
+		"packet_source_callback", "callback of packet source `{source_id}` failed with status `{status}`",
+	)
+)
+
+// maxPacketSourceUplinkSize is the maximum size of the body of uplink requests of external packet sources.
+const maxPacketSourceUplinkSize = 1 << 16
+
+// packetSource is a trusted external packet source.
+type packetSource struct {
+	id          string
+	key         []byte
+	callbackURL string
+	callbackKey string
+}
+
+// packetSources are the trusted external packet sources of which the Network Server ingests uplinks.
+// Downlinks through the gateways of a packet source are posted to the callback URL of the packet source, authorized
+// by the callback key of the packet source if configured.
+type packetSources struct {
+	sources map[string]*packetSource
+}
+
+func newPacketSources(conf PacketSourcesConfig) (*packetSources, error) {
+	if len(conf.Keys) == 0 {
+		return nil, nil
+	}
+	s := &packetSources{
+		sources: make(map[string]*packetSource, len(conf.Keys)),
+	}
+	for id, key := range conf.Keys {
+		if id == "" || key == "" {
+			return nil, errInvalidConfiguration.WithCause(errors.New("packet source ID and key must not be empty"))
+		}
+		s.sources[id] = &packetSource{
+			id:  id,
+			key: []byte(key),
+		}
+	}
+	for id, callbackURL := range conf.CallbackURLs {
+		source, ok := s.sources[id]
+		if !ok {
+			return nil, errInvalidConfiguration.WithCause(errUnknownPacketSource.WithAttributes("source_id", id))
+		}
+		if _, err := url.ParseRequestURI(callbackURL); err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+		source.callbackURL = callbackURL
+	}
+	for id, callbackKey := range conf.CallbackKeys {
+		source, ok := s.sources[id]
+		if !ok {
+			return nil, errInvalidConfiguration.WithCause(errUnknownPacketSource.WithAttributes("source_id", id))
+		}
+		source.callbackKey = callbackKey
+	}
+	return s, nil
+}
+
+// authenticate returns the packet source of which the key is in the authorization of the request context.
+func (s *packetSources) authenticate(ctx context.Context) (*packetSource, error) {
+	md := rpcmetadata.FromIncomingContext(ctx)
+	if !strings.EqualFold(md.AuthType, "bearer") || md.AuthValue == "" {
+		return nil, errPacketSourceUnauthenticated.New()
+	}
+	var match *packetSource
+	for _, source := range s.sources {
+		if subtle.ConstantTimeCompare(source.key, []byte(md.AuthValue)) == 1 {
+			match = source
+		}
+	}
+	if match == nil {
+		return nil, errPacketSourceUnauthenticated.New()
+	}
+	return match, nil
+}
+
+// packetSourceUplink returns the uplink message of the uplink in received from the packet source.
+// The gateway identifiers and uplink tokens of the gateways of the packet source are kept, and the metadata is marked
+// with the packet source so that downlinks can be posted back to the packet source. Gateways without uplink token do
+// not allow downlink.
+func packetSourceUplink(source *packetSource, in *ttnpb.UplinkMessage) (*ttnpb.UplinkMessage, error) {
+	switch {
+	case len(in.GetRawPayload()) == 0:
+		return nil, errInvalidFieldValue.WithAttributes("field", "raw_payload")
+	case in.GetSettings().GetDataRate() == nil:
+		return nil, errInvalidFieldValue.WithAttributes("field", "settings.data_rate")
+	case in.GetSettings().GetFrequency() == 0:
+		return nil, errInvalidFieldValue.WithAttributes("field", "settings.frequency")
+	case len(in.GetRxMetadata()) == 0:
+		return nil, errInvalidFieldValue.WithAttributes("field", "rx_metadata")
+	}
+	rxMetadata := make([]*ttnpb.RxMetadata, 0, len(in.RxMetadata))
+	for _, md := range in.RxMetadata {
+		if md.GetGatewayIds() == nil {
+			return nil, errInvalidFieldValue.WithAttributes("field", "rx_metadata.gateway_ids")
+		}
+		rxMD := &ttnpb.RxMetadata{
+			GatewayIds:             md.GatewayIds,
+			PacketSource:           &ttnpb.PacketSourceMetadata{SourceId: source.id},
+			UplinkToken:            md.UplinkToken,
+			DownlinkPathConstraint: md.DownlinkPathConstraint,
+			Timestamp:              md.Timestamp,
+			Time:                   md.Time,
+			Rssi:                   md.Rssi,
+			ChannelRssi:            md.ChannelRssi,
+			Snr:                    md.Snr,
+			Location:               md.Location,
+			ReceivedAt:             md.ReceivedAt,
+		}
+		if rxMD.ChannelRssi == 0 {
+			rxMD.ChannelRssi = rxMD.Rssi
+		}
+		if len(md.UplinkToken) == 0 || source.callbackURL == "" {
+			rxMD.DownlinkPathConstraint = ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER
+		}
+		rxMetadata = append(rxMetadata, rxMD)
+	}
+	return &ttnpb.UplinkMessage{
+		RawPayload: in.RawPayload,
+		Settings: &ttnpb.TxSettings{
+			DataRate:  in.Settings.DataRate,
+			Frequency: in.Settings.Frequency,
+			Timestamp: in.Settings.Timestamp,
+			Time:      in.Settings.Time,
+		},
+		RxMetadata:     rxMetadata,
+		CorrelationIds: in.CorrelationIds,
+	}, nil
+}
+
+// handlePacketSourceUplink handles the uplink in received from the authenticated external packet source.
+// The uplink is deduplicated with the uplinks received from the Gateway Server and other packet sources.
+func (ns *NetworkServer) handlePacketSourceUplink(
+	ctx context.Context, source *packetSource, in *ttnpb.UplinkMessage,
+) error {
+	ctx = log.NewContextWithField(ctx, "packet_source_id", source.id)
+	up, err := packetSourceUplink(source, in)
+	if err != nil {
+		return err
+	}
+	ctx = events.ContextWithCorrelationID(ctx, up.CorrelationIds...)
+	ctx = appendUplinkCorrelationID(ctx)
+	up.CorrelationIds = events.CorrelationIDsFromContext(ctx)
+	up.ReceivedAt = timestamppb.New(time.Now()) // NOTE: This is not equivalent to timestamppb.Now().
+
+	if err := ns.handleUplink(ctx, up); err != nil && !errors.Resemble(err, errDuplicateUplink) {
+		return err
+	}
+	return nil
+}
+
+// packetSourceDownlinkTarget transmits downlink through a gateway of an external packet source.
+type packetSourceDownlinkTarget struct {
+	source     *packetSource
+	gatewayIDs *ttnpb.GatewayIdentifiers
+	httpClient func(context.Context) (*http.Client, error)
+}
+
+var _ downlinkTarget = (*packetSourceDownlinkTarget)(nil)
+
+func (ns *NetworkServer) newPacketSourceDownlinkTarget(
+	sourceID string, gatewayIDs *ttnpb.GatewayIdentifiers,
+) (*packetSourceDownlinkTarget, error) {
+	var source *packetSource
+	if ns.packetSources != nil {
+		source = ns.packetSources.sources[sourceID]
+	}
+	if source == nil || source.callbackURL == "" {
+		return nil, errUnknownPacketSource.WithAttributes("source_id", sourceID)
+	}
+	return &packetSourceDownlinkTarget{
+		source:     source,
+		gatewayIDs: gatewayIDs,
+		httpClient: func(ctx context.Context) (*http.Client, error) {
+			return ns.HTTPClient(ctx)
+		},
+	}, nil
+}
+
+// Equal implements downlinkTarget.
+func (t *packetSourceDownlinkTarget) Equal(target downlinkTarget) bool {
+	other, ok := target.(*packetSourceDownlinkTarget)
+	if !ok {
+		return false
+	}
+	return other.source.id == t.source.id && proto.Equal(other.gatewayIDs, t.gatewayIDs)
+}
+
+// Schedule implements downlinkTarget.
+// The downlink message is posted to the callback URL of the packet source, with the uplink tokens of the gateway of
+// the packet source as downlink paths.
+func (t *packetSourceDownlinkTarget) Schedule(
+	ctx context.Context, msg *ttnpb.DownlinkMessage, _ ...grpc.CallOption,
+) (*ttnpb.ScheduleDownlinkResponse, error) {
+	if msg.GetRequest() == nil {
+		panic("downlink without request")
+	}
+	b, err := jsonpb.TTN().Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, t.source.callbackURL, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if t.source.callbackKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+t.source.callbackKey)
+	}
+	client, err := t.httpClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body) //nolint:errcheck
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, errPacketSourceCallback.WithAttributes(
+			"source_id", t.source.id,
+			"status", res.StatusCode,
+		)
+	}
+	return &ttnpb.ScheduleDownlinkResponse{
+		Delay: durationpb.New(peeringScheduleDelay),
+		DownlinkPath: &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_Fixed{
+				Fixed: &ttnpb.GatewayAntennaIdentifiers{
+					GatewayIds: t.gatewayIDs,
+				},
+			},
+		},
+	}, nil
+}
+
+func (ns *NetworkServer) registerPacketSourceRoutes(s *web.Server) {
+	router := s.Prefix(ttnpb.HTTPAPIPrefix + "/ns/packet-sources").Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("networkserver/packet_sources")),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+		ratelimit.HTTPMiddleware(ns.RateLimiter(), "http:ns:packet_sources"),
+	)
+	router.HandleFunc("/uplink", ns.handlePacketSourceUplinkRequest).Methods(http.MethodPost)
+}
+
+func (ns *NetworkServer) handlePacketSourceUplinkRequest(w http.ResponseWriter, r *http.Request) {
+	source, err := ns.packetSources.authenticate(r.Context())
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	b, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPacketSourceUplinkSize))
+	if err != nil {
+		webhandlers.Error(w, r, errPacketSourceUplinkDecode.WithCause(err))
+		return
+	}
+	up := &ttnpb.UplinkMessage{}
+	if err := jsonpb.TTN().Unmarshal(b, up); err != nil {
+		webhandlers.Error(w, r, errPacketSourceUplinkDecode.WithCause(err))
+		return
+	}
+	if err := up.ValidateFields(); err != nil {
+		webhandlers.Error(w, r, errPacketSourceUplinkDecode.WithCause(err))
+		return
+	}
+	if err := ns.handlePacketSourceUplink(r.Context(), source, up); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
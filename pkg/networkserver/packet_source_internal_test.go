@@ -0,0 +1,355 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPacketSourcesAuthenticate(t *testing.T) {
+	a, ctx := test.New(t)
+
+	sources, err := newPacketSources(PacketSourcesConfig{})
+	a.So(err, should.BeNil)
+	a.So(sources, should.BeNil)
+
+	_, err = newPacketSources(PacketSourcesConfig{
+		Keys:         map[string]string{"lns-1": "secret-1"},
+		CallbackURLs: map[string]string{"lns-2": "https://lns-2.example.com/downlink"},
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	sources, err = newPacketSources(PacketSourcesConfig{
+		Keys: map[string]string{
+			"lns-1": "secret-1",
+			"lns-2": "secret-2",
+		},
+		CallbackURLs: map[string]string{"lns-1": "https://lns-1.example.com/downlink"},
+		CallbackKeys: map[string]string{"lns-1": "callback-secret-1"},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(sources.sources["lns-1"].callbackURL, should.Equal, "https://lns-1.example.com/downlink")
+	a.So(sources.sources["lns-1"].callbackKey, should.Equal, "callback-secret-1")
+	a.So(sources.sources["lns-2"].callbackURL, should.BeEmpty)
+
+	for _, tc := range []struct {
+		Authorization string
+		SourceID      string
+	}{
+		{},
+		{Authorization: "Bearer secret-3"},
+		{Authorization: "Basic secret-1"},
+		{Authorization: "Bearer secret-1", SourceID: "lns-1"},
+		{Authorization: "bearer secret-2", SourceID: "lns-2"},
+	} {
+		ctx := ctx
+		if tc.Authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tc.Authorization))
+		}
+		source, err := sources.authenticate(ctx)
+		if tc.SourceID == "" {
+			a.So(errors.IsUnauthenticated(err), should.BeTrue)
+			continue
+		}
+		if a.So(err, should.BeNil) {
+			a.So(source.id, should.Equal, tc.SourceID)
+		}
+	}
+}
+
+func TestPacketSourceUplink(t *testing.T) {
+	a := assertions.New(t)
+
+	source := &packetSource{
+		id:          "lns-1",
+		key:         []byte("secret-1"),
+		callbackURL: "https://lns-1.example.com/downlink",
+	}
+	_, err := packetSourceUplink(source, &ttnpb.UplinkMessage{RawPayload: []byte{0x40}})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	settings := &ttnpb.TxSettings{
+		DataRate: &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Lora{
+				Lora: &ttnpb.LoRaDataRate{Bandwidth: 125000, SpreadingFactor: 7},
+			},
+		},
+		Frequency: 868100000,
+	}
+	_, err = packetSourceUplink(source, &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		Settings:   settings,
+		RxMetadata: []*ttnpb.RxMetadata{{UplinkToken: []byte("token-1")}},
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	up, err := packetSourceUplink(source, &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		Settings:   settings,
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIds:   &ttnpb.GatewayIdentifiers{GatewayId: "lns-gtw-1"},
+				UplinkToken:  []byte("token-1"),
+				Rssi:         -42,
+				Snr:          7.5,
+				PacketBroker: &ttnpb.PacketBrokerMetadata{},
+			},
+			{
+				GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "lns-gtw-2"},
+				Rssi:       -90,
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if !a.So(up.RxMetadata, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	for _, md := range up.RxMetadata {
+		a.So(md.PacketSource, should.Resemble, &ttnpb.PacketSourceMetadata{SourceId: "lns-1"})
+		a.So(md.PacketBroker, should.BeNil)
+		a.So(md.ChannelRssi, should.Equal, md.Rssi)
+	}
+	a.So(up.RxMetadata[0].GatewayIds, should.Resemble, &ttnpb.GatewayIdentifiers{GatewayId: "lns-gtw-1"})
+	a.So(up.RxMetadata[1].GatewayIds, should.Resemble, &ttnpb.GatewayIdentifiers{GatewayId: "lns-gtw-2"})
+	a.So(up.RxMetadata[0].UplinkToken, should.Resemble, []byte("token-1"))
+	a.So(up.RxMetadata[0].DownlinkPathConstraint, should.Equal, ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NONE)
+	a.So(up.RxMetadata[1].DownlinkPathConstraint, should.Equal, ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER)
+
+	paths := downlinkPathsFromRankedMetadata(toMACStateRxMetadata([]*ttnpb.RxMetadata{
+		up.RxMetadata[0],
+		{
+			GatewayIds:  &ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"},
+			UplinkToken: []byte("gs-token"),
+		},
+	}))
+	if a.So(paths, should.HaveLength, 2) {
+		a.So(paths[0].GatewayIdentifiers.GetGatewayId(), should.Equal, "test-gtw")
+		a.So(paths[0].packetSourceID, should.BeEmpty)
+		a.So(paths[1].GatewayIdentifiers.GetGatewayId(), should.Equal, "lns-gtw-1")
+		a.So(paths[1].packetSourceID, should.Equal, "lns-1")
+		a.So(paths[1].GetUplinkToken(), should.Resemble, []byte("token-1"))
+		a.So(occupancyGatewayID(paths[1]), should.BeEmpty)
+	}
+}
+
+func TestPacketSourceDownlinkTarget(t *testing.T) {
+	a, ctx := test.New(t)
+
+	type request struct {
+		Authorization string
+		Message       *ttnpb.DownlinkMessage
+	}
+	reqCh := make(chan request, 1)
+	status := http.StatusAccepted
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		msg := &ttnpb.DownlinkMessage{}
+		if err := jsonpb.TTN().Unmarshal(b, msg); err != nil {
+			t.Errorf("Failed to decode downlink: %v", err)
+		}
+		reqCh <- request{
+			Authorization: r.Header.Get("Authorization"),
+			Message:       msg,
+		}
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	source := &packetSource{
+		id:          "lns-1",
+		key:         []byte("secret-1"),
+		callbackURL: srv.URL,
+		callbackKey: "callback-secret-1",
+	}
+	gatewayIDs := &ttnpb.GatewayIdentifiers{GatewayId: "lns-gtw-1"}
+	target := &packetSourceDownlinkTarget{
+		source:     source,
+		gatewayIDs: gatewayIDs,
+		httpClient: func(context.Context) (*http.Client, error) {
+			return srv.Client(), nil
+		},
+	}
+	a.So(target.Equal(&packetSourceDownlinkTarget{
+		source:     &packetSource{id: "lns-1"},
+		gatewayIDs: &ttnpb.GatewayIdentifiers{GatewayId: "lns-gtw-1"},
+	}), should.BeTrue)
+	a.So(target.Equal(&packetSourceDownlinkTarget{
+		source:     &packetSource{id: "lns-1"},
+		gatewayIDs: &ttnpb.GatewayIdentifiers{GatewayId: "lns-gtw-2"},
+	}), should.BeFalse)
+	a.So(target.Equal(&packetSourceDownlinkTarget{
+		source:     &packetSource{id: "lns-2"},
+		gatewayIDs: gatewayIDs,
+	}), should.BeFalse)
+
+	uplinkToken := []byte("token-1")
+	msg := &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x60, 0x01, 0x02, 0x03, 0x04},
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class: ttnpb.Class_CLASS_A,
+				DownlinkPaths: []*ttnpb.DownlinkPath{
+					{
+						Path: &ttnpb.DownlinkPath_UplinkToken{
+							UplinkToken: uplinkToken,
+						},
+					},
+				},
+				Rx1Delay:     ttnpb.RxDelay_RX_DELAY_1,
+				Rx1Frequency: 868100000,
+			},
+		},
+	}
+	res, err := target.Schedule(ctx, msg)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.DownlinkPath.GetFixed().GetGatewayIds(), should.Resemble, gatewayIDs)
+
+	req := <-reqCh
+	a.So(req.Authorization, should.Equal, "Bearer callback-secret-1")
+	a.So(req.Message.RawPayload, should.Resemble, msg.RawPayload)
+	a.So(req.Message.GetRequest().GetDownlinkPaths(), should.HaveLength, 1)
+	a.So(req.Message.GetRequest().GetDownlinkPaths()[0].GetUplinkToken(), should.Resemble, []byte("token-1"))
+
+	source.callbackKey = ""
+	_, err = target.Schedule(ctx, msg)
+	a.So(err, should.BeNil)
+	req = <-reqCh
+	a.So(req.Authorization, should.BeEmpty)
+
+	status = http.StatusServiceUnavailable
+	_, err = target.Schedule(ctx, msg)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	<-reqCh
+}
+
+func TestPacketSourceUplinkRequest(t *testing.T) {
+	a, ctx := test.New(t)
+
+	sources, err := newPacketSources(PacketSourcesConfig{
+		Keys: map[string]string{"lns-1": "secret-1"},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	ns := &NetworkServer{packetSources: sources}
+	handler := webmiddleware.Metadata("Authorization")(http.HandlerFunc(ns.handlePacketSourceUplinkRequest))
+
+	for _, tc := range []struct {
+		Name          string
+		Authorization string
+		Body          io.Reader
+		Read          bool
+		Status        int
+	}{
+		{
+			Name:   "Unauthenticated",
+			Body:   strings.NewReader("{}"),
+			Status: http.StatusUnauthorized,
+		},
+		{
+			Name:          "InvalidKey",
+			Authorization: "Bearer secret-2",
+			Body:          strings.NewReader("{}"),
+			Status:        http.StatusUnauthorized,
+		},
+		{
+			Name:          "TooLarge",
+			Authorization: "Bearer secret-1",
+			Body:          strings.NewReader(`{"raw_payload":"` + strings.Repeat("A", maxPacketSourceUplinkSize) + `"}`),
+			Read:          true,
+			Status:        http.StatusBadRequest,
+		},
+		{
+			Name:          "Invalid",
+			Authorization: "Bearer secret-1",
+			Body:          strings.NewReader(`{"raw_payload":"QA=="}`),
+			Read:          true,
+			Status:        http.StatusBadRequest,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			body := &readRecorder{Reader: tc.Body}
+			r := httptest.NewRequest(http.MethodPost, "/api/v3/ns/packet-sources/uplink", body).WithContext(ctx)
+			if tc.Authorization != "" {
+				r.Header.Set("Authorization", tc.Authorization)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			a.So(w.Code, should.Equal, tc.Status)
+			a.So(body.read, should.Equal, tc.Read)
+		})
+	}
+}
+
+type readRecorder struct {
+	io.Reader
+	read bool
+}
+
+func (r *readRecorder) Read(p []byte) (int, error) {
+	r.read = true
+	return r.Reader.Read(p)
+}
+
+func TestPacketSourceServerHandleUplink(t *testing.T) {
+	a, ctx := test.New(t)
+
+	sources, err := newPacketSources(PacketSourcesConfig{
+		Keys: map[string]string{"lns-1": "secret-1"},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var handled *packetSource
+	srv := &nsPacketSourceServer{
+		packetSources: sources,
+		handleUplink: func(_ context.Context, source *packetSource, _ *ttnpb.UplinkMessage) error {
+			handled = source
+			return nil
+		},
+	}
+
+	_, err = srv.HandleUplink(ctx, &ttnpb.UplinkMessage{})
+	a.So(errors.IsUnauthenticated(err), should.BeTrue)
+	a.So(handled, should.BeNil)
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer secret-1"))
+	_, err = srv.HandleUplink(ctx, &ttnpb.UplinkMessage{})
+	a.So(err, should.BeNil)
+	if a.So(handled, should.NotBeNil) {
+		a.So(handled.id, should.Equal, "lns-1")
+	}
+}
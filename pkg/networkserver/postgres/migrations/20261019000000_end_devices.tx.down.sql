@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ns_relay_served_devices;
+DROP TABLE IF EXISTS ns_end_devices;
@@ -0,0 +1,29 @@
+CREATE TABLE ns_end_devices (
+  uid                     TEXT PRIMARY KEY,
+  join_eui                BYTEA,
+  dev_eui                 BYTEA,
+  dev_addr                BYTEA,
+  last_f_cnt_up           BIGINT NOT NULL DEFAULT 0,
+  lorawan_version         INTEGER NOT NULL DEFAULT 0,
+  f_nwk_s_int_key         BYTEA,
+  resets_f_cnt            BOOLEAN,
+  supports_32_bit_f_cnt   BOOLEAN,
+  pending_dev_addr        BYTEA,
+  pending_lorawan_version INTEGER NOT NULL DEFAULT 0,
+  pending_f_nwk_s_int_key BYTEA,
+  pending_at              TIMESTAMPTZ,
+  end_device              BYTEA NOT NULL
+);
+--bun:split
+CREATE UNIQUE INDEX ns_end_devices_eui_index ON ns_end_devices (join_eui, dev_eui);
+--bun:split
+CREATE INDEX ns_end_devices_dev_addr_index ON ns_end_devices (dev_addr);
+--bun:split
+CREATE INDEX ns_end_devices_pending_dev_addr_index ON ns_end_devices (pending_dev_addr);
+--bun:split
+CREATE TABLE ns_relay_served_devices (
+  served_uid  TEXT PRIMARY KEY,
+  serving_uid TEXT NOT NULL
+);
+--bun:split
+CREATE INDEX ns_relay_served_devices_serving_uid_index ON ns_relay_served_devices (serving_uid);
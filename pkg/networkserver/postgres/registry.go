@@ -0,0 +1,709 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postgres implements the Network Server device registry in PostgreSQL.
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"runtime/trace"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/internal/registry"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/postgres/migrations"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/store"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errInvalidFieldmask   = errors.DefineInvalidArgument("invalid_fieldmask", "invalid fieldmask")
+	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
+	errReadOnlyField      = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errInvalidDevice      = errors.DefineInvalidArgument("invalid_device", "device is invalid")
+	errDatabaseCorruption = errors.DefineCorruption("database_corruption", "database is corrupted")
+	errNoUplinkMatch      = errors.DefineNotFound("no_uplink_match", "no device matches uplink")
+
+	errRelayServed = errors.DefineAlreadyExists("relay_served", "`{served}` is already served by `{serving}`")
+)
+
+// rangeBatchSize is the number of devices which are read at once by Range.
+const rangeBatchSize = 1024
+
+// DeviceRegistry is an implementation of networkserver.DeviceRegistry.
+type DeviceRegistry struct {
+	DB *sql.DB
+}
+
+// Init initializes the DeviceRegistry.
+func (r *DeviceRegistry) Init(ctx context.Context) error {
+	return store.Migrate(ctx, r.DB, migrations.Migrations, "ns_device_registry_migrations")
+}
+
+func unmarshalDevice(b []byte) (*ttnpb.EndDevice, error) {
+	dev := &ttnpb.EndDevice{}
+	if err := proto.Unmarshal(b, dev); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return dev, nil
+}
+
+func marshalKey(ke *ttnpb.KeyEnvelope) ([]byte, error) {
+	if ke == nil {
+		return nil, nil
+	}
+	b, err := proto.Marshal(ke)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		b = []byte{}
+	}
+	return b, nil
+}
+
+func unmarshalKey(b []byte) (*ttnpb.KeyEnvelope, error) {
+	if b == nil {
+		return nil, nil
+	}
+	ke := &ttnpb.KeyEnvelope{}
+	if err := proto.Unmarshal(b, ke); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return ke, nil
+}
+
+func nullBool(v *ttnpb.BoolValue) sql.NullBool {
+	if v == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: v.Value, Valid: true}
+}
+
+func boolValue(v sql.NullBool) *ttnpb.BoolValue {
+	if !v.Valid {
+		return nil
+	}
+	return &ttnpb.BoolValue{Value: v.Bool}
+}
+
+func euiBytes(ids *ttnpb.EndDeviceIdentifiers) (joinEUI, devEUI []byte) {
+	if ids.JoinEui == nil || ids.DevEui == nil {
+		return nil, nil
+	}
+	j, d := types.MustEUI64(ids.JoinEui).OrZero(), types.MustEUI64(ids.DevEui).OrZero()
+	return j[:], d[:]
+}
+
+func devAddrBytes(ses *ttnpb.Session) []byte {
+	if ses == nil {
+		return nil
+	}
+	addr := types.MustDevAddr(ses.DevAddr).OrZero()
+	return addr[:]
+}
+
+// relayServedUIDs returns the UIDs of the devices which are served by the relay dev.
+func relayServedUIDs(ctx context.Context, dev *ttnpb.EndDevice) map[string]struct{} {
+	m := make(map[string]struct{})
+	for _, rules := range [][]*ttnpb.RelayUplinkForwardingRule{
+		dev.GetMacSettings().GetRelay().GetServing().GetUplinkForwardingRules(),
+		dev.GetMacSettings().GetDesiredRelay().GetServing().GetUplinkForwardingRules(),
+		dev.GetMacState().GetCurrentParameters().GetRelay().GetServing().GetUplinkForwardingRules(),
+		dev.GetMacState().GetDesiredParameters().GetRelay().GetServing().GetUplinkForwardingRules(),
+		dev.GetPendingMacState().GetCurrentParameters().GetRelay().GetServing().GetUplinkForwardingRules(),
+		dev.GetPendingMacState().GetDesiredParameters().GetRelay().GetServing().GetUplinkForwardingRules(),
+	} {
+		for _, rule := range rules {
+			if rule.GetDeviceId() == "" {
+				continue
+			}
+			m[unique.ID(ctx, &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: dev.Ids.ApplicationIds,
+				DeviceId:       rule.DeviceId,
+			})] = struct{}{}
+		}
+	}
+	return m
+}
+
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func getDevice(ctx context.Context, q queryer, query string, args ...any) (*ttnpb.EndDevice, error) {
+	var b []byte
+	if err := q.QueryRowContext(ctx, query, args...).Scan(&b); err != nil {
+		return nil, store.WrapDriverError(err)
+	}
+	return unmarshalDevice(b)
+}
+
+// GetByID gets device by appID, devID.
+func (r *DeviceRegistry) GetByID(
+	ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string,
+) (*ttnpb.EndDevice, context.Context, error) {
+	defer trace.StartRegion(ctx, "get end device by id").End()
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appID,
+		DeviceId:       devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, ctx, err
+	}
+	pb, err := getDevice(ctx, r.DB, `SELECT end_device FROM ns_end_devices WHERE uid = $1`, unique.ID(ctx, ids))
+	if err != nil {
+		return nil, ctx, err
+	}
+	pb, err = ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+// BatchGetByID gets devices by appID, deviceIDs.
+func (r *DeviceRegistry) BatchGetByID(
+	ctx context.Context, appID *ttnpb.ApplicationIdentifiers, deviceIDs []string, paths []string,
+) ([]*ttnpb.EndDevice, error) {
+	defer trace.StartRegion(ctx, "batch get end device by id").End()
+
+	protos := make([]*ttnpb.EndDevice, len(deviceIDs))
+	for i, devID := range deviceIDs {
+		ids := &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appID,
+			DeviceId:       devID,
+		}
+		if err := ids.ValidateContext(ctx); err != nil {
+			return nil, err
+		}
+		dev, err := getDevice(ctx, r.DB, `SELECT end_device FROM ns_end_devices WHERE uid = $1`, unique.ID(ctx, ids))
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		dev, err = ttnpb.FilterGetEndDevice(dev, paths...)
+		if err != nil {
+			return nil, err
+		}
+		protos[i] = dev
+	}
+	return protos, nil
+}
+
+// GetByEUI gets device by joinEUI, devEUI.
+func (r *DeviceRegistry) GetByEUI(
+	ctx context.Context, joinEUI, devEUI types.EUI64, paths []string,
+) (*ttnpb.EndDevice, context.Context, error) {
+	defer trace.StartRegion(ctx, "get end device by eui").End()
+
+	pb, err := getDevice(ctx, r.DB,
+		`SELECT end_device FROM ns_end_devices WHERE join_eui = $1 AND dev_eui = $2`, joinEUI[:], devEUI[:],
+	)
+	if err != nil {
+		return nil, ctx, err
+	}
+	pb, err = ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+type uplinkMatchRow struct {
+	uid               string
+	lastFCnt          int64
+	lorawanVersion    int32
+	fNwkSIntKey       []byte
+	resetsFCnt        sql.NullBool
+	supports32BitFCnt sql.NullBool
+}
+
+// RangeByUplinkMatches ranges over devices matching the uplink.
+// Devices with a current session are ranged first, starting with the devices of which the last frame counter is
+// closest to and not greater than the frame counter of the uplink. Devices with a pending session are ranged last,
+// most recently joined first.
+func (r *DeviceRegistry) RangeByUplinkMatches(
+	ctx context.Context, up *ttnpb.UplinkMessage, f func(context.Context, *networkserver.UplinkMatch) (bool, error),
+) error {
+	defer trace.StartRegion(ctx, "range end devices by uplink matches").End()
+
+	pld := up.Payload.GetMacPayload()
+	ackFlag := pld.FHdr.FCtrl.Ack
+	lsb := uint16(pld.FHdr.FCnt)
+	devAddr := types.MustDevAddr(pld.FHdr.DevAddr).OrZero()
+
+	queryRows := func(query string) ([]uplinkMatchRow, error) {
+		rows, err := r.DB.QueryContext(ctx, query, devAddr[:])
+		if err != nil {
+			return nil, store.WrapDriverError(err)
+		}
+		defer rows.Close()
+		var ms []uplinkMatchRow
+		for rows.Next() {
+			var m uplinkMatchRow
+			if err := rows.Scan(
+				&m.uid, &m.lastFCnt, &m.lorawanVersion, &m.fNwkSIntKey, &m.resetsFCnt, &m.supports32BitFCnt,
+			); err != nil {
+				return nil, store.WrapDriverError(err)
+			}
+			ms = append(ms, m)
+		}
+		return ms, store.WrapDriverError(rows.Err())
+	}
+
+	current, err := queryRows(`SELECT uid, last_f_cnt_up, lorawan_version, f_nwk_s_int_key, resets_f_cnt, supports_32_bit_f_cnt
+		FROM ns_end_devices WHERE dev_addr = $1 ORDER BY last_f_cnt_up & 65535 DESC, uid DESC`)
+	if err != nil {
+		return err
+	}
+	// NOTE: Order the sessions of which the last frame counter is not greater than the uplink frame counter first,
+	// which is consistent with the order in the Redis device registry.
+	head := make([]uplinkMatchRow, 0, len(current))
+	tail := make([]uplinkMatchRow, 0, len(current))
+	for _, m := range current {
+		if uint16(m.lastFCnt) <= lsb {
+			head = append(head, m)
+		} else {
+			tail = append(tail, m)
+		}
+	}
+	current = append(head, tail...)
+
+	var pending []uplinkMatchRow
+	if !ackFlag {
+		pending, err = queryRows(`SELECT uid, 0, pending_lorawan_version, pending_f_nwk_s_int_key, NULL::BOOLEAN, NULL::BOOLEAN
+			FROM ns_end_devices WHERE pending_dev_addr = $1 ORDER BY pending_at DESC, uid DESC`)
+		if err != nil {
+			return err
+		}
+	}
+
+	match := func(m uplinkMatchRow, isPending bool) (bool, error) {
+		ids, err := unique.ToDeviceID(m.uid)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Error("Failed to parse UID as device identifiers")
+			return false, errDatabaseCorruption.WithCause(err)
+		}
+		fNwkSIntKey, err := unmarshalKey(m.fNwkSIntKey)
+		if err != nil {
+			return false, err
+		}
+		return f(ctx, &networkserver.UplinkMatch{
+			ApplicationIdentifiers: ids.ApplicationIds,
+			DeviceID:               ids.DeviceId,
+			LoRaWANVersion:         ttnpb.MACVersion(m.lorawanVersion),
+			FNwkSIntKey:            fNwkSIntKey,
+			LastFCnt:               uint32(m.lastFCnt),
+			ResetsFCnt:             boolValue(m.resetsFCnt),
+			Supports32BitFCnt:      boolValue(m.supports32BitFCnt),
+			IsPending:              isPending,
+		})
+	}
+	for _, m := range current {
+		if uint16(m.lastFCnt) > lsb && m.supports32BitFCnt.Valid && !m.supports32BitFCnt.Bool &&
+			(ackFlag || !m.resetsFCnt.Valid || !m.resetsFCnt.Bool) {
+			continue
+		}
+		stop, err := match(m, false)
+		if err != nil || stop {
+			return err
+		}
+	}
+	for _, m := range pending {
+		stop, err := match(m, true)
+		if err != nil || stop {
+			return err
+		}
+	}
+	return errNoUplinkMatch.New()
+}
+
+func setRelayServedDevices(
+	ctx context.Context, tx *sql.Tx, uid string, stored, updated map[string]struct{},
+) error {
+	for servedUID := range stored {
+		if _, ok := updated[servedUID]; ok {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM ns_relay_served_devices WHERE served_uid = $1 AND serving_uid = $2`, servedUID, uid,
+		); err != nil {
+			return store.WrapDriverError(err)
+		}
+	}
+	for servedUID := range updated {
+		if _, ok := stored[servedUID]; ok {
+			continue
+		}
+		var servingUID string
+		err := tx.QueryRowContext(ctx,
+			`SELECT serving_uid FROM ns_relay_served_devices WHERE served_uid = $1`, servedUID,
+		).Scan(&servingUID)
+		switch {
+		case err == nil:
+			return errRelayServed.WithAttributes(
+				"served", servedUID,
+				"serving", servingUID,
+			)
+		case !errors.Is(err, sql.ErrNoRows):
+			return store.WrapDriverError(err)
+		}
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO ns_relay_served_devices (served_uid, serving_uid) VALUES ($1, $2)`, servedUID, uid,
+		); err != nil {
+			return store.WrapDriverError(err)
+		}
+	}
+	return nil
+}
+
+func deleteDevice(ctx context.Context, tx *sql.Tx, uid string) (*ttnpb.EndDevice, error) {
+	var b []byte
+	if err := tx.QueryRowContext(ctx,
+		`DELETE FROM ns_end_devices WHERE uid = $1 RETURNING end_device`, uid,
+	).Scan(&b); err != nil {
+		return nil, store.WrapDriverError(err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM ns_relay_served_devices WHERE serving_uid = $1`, uid); err != nil {
+		return nil, store.WrapDriverError(err)
+	}
+	return unmarshalDevice(b)
+}
+
+func upsertDevice(ctx context.Context, tx *sql.Tx, uid string, dev *ttnpb.EndDevice, pendingAt sql.NullTime) error {
+	b, err := proto.Marshal(dev)
+	if err != nil {
+		return err
+	}
+	fNwkSIntKey, err := marshalKey(dev.GetSession().GetKeys().GetFNwkSIntKey())
+	if err != nil {
+		return err
+	}
+	pendingFNwkSIntKey, err := marshalKey(dev.GetPendingSession().GetKeys().GetFNwkSIntKey())
+	if err != nil {
+		return err
+	}
+	joinEUI, devEUI := euiBytes(dev.Ids)
+	_, err = tx.ExecContext(ctx, `INSERT INTO ns_end_devices (
+			uid, join_eui, dev_eui,
+			dev_addr, last_f_cnt_up, lorawan_version, f_nwk_s_int_key, resets_f_cnt, supports_32_bit_f_cnt,
+			pending_dev_addr, pending_lorawan_version, pending_f_nwk_s_int_key, pending_at,
+			end_device
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (uid) DO UPDATE SET
+			join_eui = EXCLUDED.join_eui,
+			dev_eui = EXCLUDED.dev_eui,
+			dev_addr = EXCLUDED.dev_addr,
+			last_f_cnt_up = EXCLUDED.last_f_cnt_up,
+			lorawan_version = EXCLUDED.lorawan_version,
+			f_nwk_s_int_key = EXCLUDED.f_nwk_s_int_key,
+			resets_f_cnt = EXCLUDED.resets_f_cnt,
+			supports_32_bit_f_cnt = EXCLUDED.supports_32_bit_f_cnt,
+			pending_dev_addr = EXCLUDED.pending_dev_addr,
+			pending_lorawan_version = EXCLUDED.pending_lorawan_version,
+			pending_f_nwk_s_int_key = EXCLUDED.pending_f_nwk_s_int_key,
+			pending_at = EXCLUDED.pending_at,
+			end_device = EXCLUDED.end_device`,
+		uid, joinEUI, devEUI,
+		devAddrBytes(dev.Session),
+		int64(dev.GetSession().GetLastFCntUp()),
+		int32(dev.GetMacState().GetLorawanVersion()),
+		fNwkSIntKey,
+		nullBool(dev.GetMacSettings().GetResetsFCnt()),
+		nullBool(dev.GetMacSettings().GetSupports_32BitFCnt()),
+		devAddrBytes(dev.PendingSession),
+		int32(dev.GetPendingMacState().GetLorawanVersion()),
+		pendingFNwkSIntKey,
+		pendingAt,
+		b,
+	)
+	return store.WrapDriverError(err)
+}
+
+// SetByID sets device by appID, devID.
+func (r *DeviceRegistry) SetByID(
+	ctx context.Context,
+	appID *ttnpb.ApplicationIdentifiers,
+	devID string,
+	gets []string,
+	f func(ctx context.Context, pb *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+) (*ttnpb.EndDevice, context.Context, error) {
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appID,
+		DeviceId:       devID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, ctx, err
+	}
+	uid := unique.ID(ctx, ids)
+
+	defer trace.StartRegion(ctx, "set end device by id").End()
+
+	var pb *ttnpb.EndDevice
+	if err := store.Transact(ctx, r.DB, func(tx *sql.Tx) error {
+		if err := store.LockKey(ctx, tx, store.NetworkServerDevicesLockNamespace, uid); err != nil {
+			return err
+		}
+		var (
+			storedBytes     []byte
+			storedPendingAt sql.NullTime
+			stored          *ttnpb.EndDevice
+		)
+		err := tx.QueryRowContext(ctx,
+			`SELECT end_device, pending_at FROM ns_end_devices WHERE uid = $1 FOR UPDATE`, uid,
+		).Scan(&storedBytes, &storedPendingAt)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return store.WrapDriverError(err)
+		default:
+			if stored, err = unmarshalDevice(storedBytes); err != nil {
+				return err
+			}
+		}
+
+		if stored != nil {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			if err != nil {
+				return err
+			}
+		}
+
+		var sets []string
+		pb, sets, err = f(ctx, pb)
+		if err != nil {
+			return err
+		}
+		if err := ttnpb.ProhibitFields(sets,
+			"created_at",
+			"updated_at",
+		); err != nil {
+			return errInvalidFieldmask.WithCause(err)
+		}
+
+		if stored == nil && pb == nil {
+			return nil
+		}
+		if pb != nil && len(sets) == 0 {
+			pb, err = ttnpb.FilterGetEndDevice(stored, gets...)
+			return err
+		}
+		if pb == nil && len(sets) == 0 {
+			trace.Log(ctx, "ns:postgres", "delete end device")
+			_, err := deleteDevice(ctx, tx, uid)
+			return err
+		}
+
+		if stored == nil {
+			trace.Log(ctx, "ns:postgres", "create end device")
+			if err := ttnpb.RequireFields(sets,
+				"ids.application_ids",
+				"ids.device_id",
+			); err != nil {
+				return errInvalidFieldmask.WithCause(err)
+			}
+			if pb.Ids.ApplicationIds.ApplicationId != appID.ApplicationId || pb.Ids.DeviceId != devID {
+				return errInvalidIdentifiers.New()
+			}
+			if pb.Ids.JoinEui != nil && pb.Ids.DevEui != nil {
+				joinEUI, devEUI := euiBytes(pb.Ids)
+				var storedUID string
+				err := tx.QueryRowContext(ctx,
+					`SELECT uid FROM ns_end_devices WHERE join_eui = $1 AND dev_eui = $2`, joinEUI, devEUI,
+				).Scan(&storedUID)
+				switch {
+				case err == nil:
+					return registry.UniqueEUIViolationErr(
+						ctx, types.MustEUI64(pb.Ids.JoinEui).OrZero(), types.MustEUI64(pb.Ids.DevEui).OrZero(), storedUID,
+					)
+				case !errors.Is(err, sql.ErrNoRows):
+					return store.WrapDriverError(err)
+				}
+			}
+		} else {
+			if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") &&
+				pb.Ids.ApplicationIds.ApplicationId != stored.Ids.ApplicationIds.ApplicationId {
+				return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.device_id") && pb.Ids.DeviceId != stored.Ids.DeviceId {
+				return errReadOnlyField.WithAttributes("field", "ids.device_id")
+			}
+			if ttnpb.HasAnyField(sets, "ids.join_eui") && !bytes.Equal(pb.Ids.JoinEui, stored.Ids.JoinEui) {
+				return errReadOnlyField.WithAttributes("field", "ids.join_eui")
+			}
+			if ttnpb.HasAnyField(sets, "ids.dev_eui") && !bytes.Equal(pb.Ids.DevEui, stored.Ids.DevEui) {
+				return errReadOnlyField.WithAttributes("field", "ids.dev_eui")
+			}
+		}
+
+		updated := &ttnpb.EndDevice{}
+		if stored != nil {
+			updated = proto.Clone(stored).(*ttnpb.EndDevice)
+		}
+		updated, err = ttnpb.ApplyEndDeviceFieldMask(updated, pb, sets...)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		updated.UpdatedAt = timestamppb.New(now) // NOTE: This is not equivalent to timestamppb.Now().
+		if stored == nil {
+			updated.CreatedAt = updated.UpdatedAt
+		}
+
+		if updated.Session != nil && updated.MacState == nil ||
+			updated.PendingSession != nil && updated.PendingMacState == nil {
+			return errInvalidDevice.New()
+		}
+		if err := updated.ValidateFields(); err != nil {
+			return err
+		}
+
+		// NOTE: The time at which the pending session was set orders the devices with a pending session on uplink
+		// matching, so it is only updated when the pending session is set to a different DevAddr.
+		var pendingAt sql.NullTime
+		if updated.PendingSession != nil {
+			pendingAt = sql.NullTime{Time: now, Valid: true}
+			if storedPendingAt.Valid &&
+				bytes.Equal(devAddrBytes(updated.PendingSession), devAddrBytes(stored.GetPendingSession())) {
+				pendingAt = storedPendingAt
+			}
+		}
+		if err := setRelayServedDevices(
+			ctx, tx, uid, relayServedUIDs(ctx, stored), relayServedUIDs(ctx, updated),
+		); err != nil {
+			return err
+		}
+		if err := upsertDevice(ctx, tx, uid, updated, pendingAt); err != nil {
+			return err
+		}
+		pb, err = ttnpb.FilterGetEndDevice(updated, gets...)
+		return err
+	}); err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
+// Range ranges over devices in DeviceRegistry.
+func (r *DeviceRegistry) Range(
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	var after string
+	for {
+		devs, lastUID, err := r.rangeBatch(ctx, after)
+		if err != nil {
+			return err
+		}
+		for _, dev := range devs {
+			dev, err := ttnpb.FilterGetEndDevice(dev, paths...)
+			if err != nil {
+				return err
+			}
+			if !f(ctx, dev.Ids, dev) {
+				return nil
+			}
+		}
+		if len(devs) < rangeBatchSize {
+			return nil
+		}
+		after = lastUID
+	}
+}
+
+func (r *DeviceRegistry) rangeBatch(ctx context.Context, after string) ([]*ttnpb.EndDevice, string, error) {
+	rows, err := r.DB.QueryContext(ctx,
+		`SELECT uid, end_device FROM ns_end_devices WHERE uid > $1 ORDER BY uid LIMIT $2`, after, rangeBatchSize,
+	)
+	if err != nil {
+		return nil, "", store.WrapDriverError(err)
+	}
+	defer rows.Close()
+	var (
+		devs    []*ttnpb.EndDevice
+		lastUID string
+	)
+	for rows.Next() {
+		var b []byte
+		if err := rows.Scan(&lastUID, &b); err != nil {
+			return nil, "", store.WrapDriverError(err)
+		}
+		dev, err := unmarshalDevice(b)
+		if err != nil {
+			return nil, "", err
+		}
+		devs = append(devs, dev)
+	}
+	return devs, lastUID, store.WrapDriverError(rows.Err())
+}
+
+// BatchDelete implements DeviceRegistry.
+// This function deletes all the devices in a single transaction.
+func (r *DeviceRegistry) BatchDelete(
+	ctx context.Context,
+	appIDs *ttnpb.ApplicationIdentifiers,
+	deviceIDs []string,
+) ([]*ttnpb.EndDeviceIdentifiers, error) {
+	uids := make([]string, 0, len(deviceIDs))
+	for _, devID := range deviceIDs {
+		uids = append(uids, unique.ID(ctx, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appIDs,
+			DeviceId:       devID,
+		}))
+	}
+	// NOTE: Lock the devices in a consistent order to avoid deadlocks between concurrent batch deletions.
+	sort.Strings(uids)
+
+	defer trace.StartRegion(ctx, "batch delete end devices").End()
+
+	var ret []*ttnpb.EndDeviceIdentifiers
+	if err := store.Transact(ctx, r.DB, func(tx *sql.Tx) error {
+		ret = make([]*ttnpb.EndDeviceIdentifiers, 0, len(uids))
+		for _, uid := range uids {
+			if err := store.LockKey(ctx, tx, store.NetworkServerDevicesLockNamespace, uid); err != nil {
+				return err
+			}
+			dev, err := deleteDevice(ctx, tx, uid)
+			if errors.IsNotFound(err) {
+				continue
+			}
+			if errors.IsDataLoss(err) {
+				log.FromContext(ctx).WithError(err).Warn("Failed to decode stored end device")
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			ret = append(ret, dev.Ids)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
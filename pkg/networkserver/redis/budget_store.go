@@ -0,0 +1,143 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/budget"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+)
+
+const (
+	budgetAirtimeField   = "airtime"
+	budgetDownlinksField = "downlinks"
+)
+
+// BudgetStore is an implementation of budget.Store.
+// The usage of each budget period is stored in a hash, which expires a period after the period ends.
+type BudgetStore struct {
+	Redis *ttnredis.Client
+}
+
+// NewBudgetStore returns a new budget store.
+func NewBudgetStore(cl *ttnredis.Client) *BudgetStore {
+	return &BudgetStore{
+		Redis: cl,
+	}
+}
+
+func (s *BudgetStore) key(scope budget.Scope, id string, period time.Time) string {
+	return s.Redis.Key(string(scope), id, strconv.FormatInt(period.Unix(), 10))
+}
+
+func parseBudgetUsage(vs map[string]string) (budget.Usage, error) {
+	var u budget.Usage
+	if v, ok := vs[budgetAirtimeField]; ok {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return budget.Usage{}, errDatabaseCorruption.WithCause(err)
+		}
+		u.Airtime = time.Duration(n)
+	}
+	if v, ok := vs[budgetDownlinksField]; ok {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return budget.Usage{}, errDatabaseCorruption.WithCause(err)
+		}
+		u.Downlinks = n
+	}
+	return u, nil
+}
+
+// Get implements budget.Store.
+func (s *BudgetStore) Get(ctx context.Context, scope budget.Scope, id string, period time.Time) (budget.Usage, error) {
+	vs, err := s.Redis.HGetAll(ctx, s.key(scope, id, period)).Result()
+	if err != nil {
+		return budget.Usage{}, ttnredis.ConvertError(err)
+	}
+	return parseBudgetUsage(vs)
+}
+
+// reserveBudgetScript checks the budgets in KEYS and adds one downlink with airtime ARGV[1] to each of them
+// if none of them is exhausted. The keys expire at the Unix time ARGV[2]. The airtime and downlinks limits
+// of the budget in KEYS[i] are ARGV[2*i+1] and ARGV[2*i+2], where 0 is unlimited.
+// The script returns 0 if the downlink is added, otherwise the index (starting at 1) of the first exhausted
+// budget with its airtime and downlinks.
+var reserveBudgetScript = redis.NewScript(`for i, k in ipairs(KEYS) do
+	local airtime_limit = tonumber(ARGV[2*i+1])
+	local downlinks_limit = tonumber(ARGV[2*i+2])
+	local vs = redis.call('hmget', k, '` + budgetAirtimeField + `', '` + budgetDownlinksField + `')
+	local airtime = tonumber(vs[1]) or 0
+	local downlinks = tonumber(vs[2]) or 0
+	if (airtime_limit > 0 and airtime >= airtime_limit) or (downlinks_limit > 0 and downlinks >= downlinks_limit) then
+		return { i, airtime, downlinks }
+	end
+end
+for _, k in ipairs(KEYS) do
+	redis.call('hincrby', k, '` + budgetAirtimeField + `', ARGV[1])
+	redis.call('hincrby', k, '` + budgetDownlinksField + `', 1)
+	redis.call('expireat', k, ARGV[2])
+end
+return { 0 }`)
+
+// Reserve implements budget.Store.
+func (s *BudgetStore) Reserve(
+	ctx context.Context, budgets []budget.Budget, period time.Time, airtime time.Duration,
+) (int, budget.Usage, error) {
+	keys := make([]string, 0, len(budgets))
+	args := make([]any, 0, 2+2*len(budgets))
+	args = append(args, int64(airtime), period.Add(2*budget.Period).Unix())
+	for _, b := range budgets {
+		keys = append(keys, s.key(b.Scope, b.ID, period))
+		args = append(args, int64(b.Limits.Airtime), b.Limits.Downlinks)
+	}
+	vs, err := reserveBudgetScript.Run(ctx, s.Redis, keys, args...).Int64Slice()
+	if err != nil {
+		return 0, budget.Usage{}, ttnredis.ConvertError(err)
+	}
+	switch {
+	case len(vs) == 1 && vs[0] == 0:
+		return -1, budget.Usage{}, nil
+	case len(vs) == 3 && vs[0] > 0 && int(vs[0]) <= len(budgets):
+		return int(vs[0]) - 1, budget.Usage{
+			Airtime:   time.Duration(vs[1]),
+			Downlinks: uint64(vs[2]),
+		}, nil
+	default:
+		return 0, budget.Usage{}, errDatabaseCorruption.New()
+	}
+}
+
+// Release implements budget.Store.
+func (s *BudgetStore) Release(
+	ctx context.Context, budgets []budget.Budget, period time.Time, airtime time.Duration,
+) error {
+	if _, err := s.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		for _, b := range budgets {
+			k := s.key(b.Scope, b.ID, period)
+			p.HIncrBy(ctx, k, budgetAirtimeField, -int64(airtime))
+			p.HIncrBy(ctx, k, budgetDownlinksField, -1)
+			p.ExpireAt(ctx, k, period.Add(2*budget.Period))
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
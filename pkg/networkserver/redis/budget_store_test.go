@@ -0,0 +1,89 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/budget"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestBudgetStore(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	s := redis.NewBudgetStore(cl)
+	today := budget.PeriodStart(time.Now())
+	tomorrow := today.Add(budget.Period)
+
+	u, err := s.Get(ctx, budget.ScopeDevice, "test-app.test-dev", today)
+	a.So(err, should.BeNil)
+	a.So(u, should.Resemble, budget.Usage{})
+
+	budgets := []budget.Budget{
+		{
+			Scope:  budget.ScopeDevice,
+			ID:     "test-app.test-dev",
+			Limits: budget.Limits{Downlinks: 2},
+		},
+		{
+			Scope:  budget.ScopeNetwork,
+			ID:     budget.NetworkID,
+			Limits: budget.Limits{Airtime: time.Second},
+		},
+	}
+	i, _, err := s.Reserve(ctx, budgets, today, 50*time.Millisecond)
+	a.So(err, should.BeNil)
+	a.So(i, should.Equal, -1)
+
+	i, _, err = s.Reserve(ctx, budgets, today, 70*time.Millisecond)
+	a.So(err, should.BeNil)
+	a.So(i, should.Equal, -1)
+
+	u, err = s.Get(ctx, budget.ScopeDevice, "test-app.test-dev", today)
+	a.So(err, should.BeNil)
+	a.So(u, should.Resemble, budget.Usage{Airtime: 120 * time.Millisecond, Downlinks: 2})
+
+	// The device budget is exhausted, so no usage is added to any of the budgets.
+	i, u, err = s.Reserve(ctx, budgets, today, 70*time.Millisecond)
+	a.So(err, should.BeNil)
+	a.So(i, should.Equal, 0)
+	a.So(u, should.Resemble, budget.Usage{Airtime: 120 * time.Millisecond, Downlinks: 2})
+
+	u, err = s.Get(ctx, budget.ScopeNetwork, budget.NetworkID, today)
+	a.So(err, should.BeNil)
+	a.So(u, should.Resemble, budget.Usage{Airtime: 120 * time.Millisecond, Downlinks: 2})
+
+	a.So(s.Release(ctx, budgets, today, 70*time.Millisecond), should.BeNil)
+
+	u, err = s.Get(ctx, budget.ScopeDevice, "test-app.test-dev", today)
+	a.So(err, should.BeNil)
+	a.So(u, should.Resemble, budget.Usage{Airtime: 50 * time.Millisecond, Downlinks: 1})
+
+	u, err = s.Get(ctx, budget.ScopeApplication, "test-app", today)
+	a.So(err, should.BeNil)
+	a.So(u, should.Resemble, budget.Usage{})
+
+	u, err = s.Get(ctx, budget.ScopeDevice, "test-app.test-dev", tomorrow)
+	a.So(err, should.BeNil)
+	a.So(u, should.Resemble, budget.Usage{})
+}
@@ -0,0 +1,278 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// devAddrBatchSize is the number of DevAddrs retrieved from the index at once.
+const devAddrBatchSize = 1024
+
+// DevAddrAllocator is an implementation of networkserver.DevAddrAllocator.
+//
+// The DevAddrs used by each end device, the end devices using each DevAddr and
+// an index of all DevAddrs in use, scored by their numeric value, are stored.
+type DevAddrAllocator struct {
+	Redis *ttnredis.Client
+}
+
+// NewDevAddrAllocator returns a new DevAddr allocator.
+func NewDevAddrAllocator(cl *ttnredis.Client) *DevAddrAllocator {
+	return &DevAddrAllocator{
+		Redis: cl,
+	}
+}
+
+func (a *DevAddrAllocator) uidKey(uid string) string {
+	return UIDKey(a.Redis, uid)
+}
+
+func (a *DevAddrAllocator) addrKey(devAddr string) string {
+	return a.Redis.Key("addr", devAddr)
+}
+
+func (a *DevAddrAllocator) indexKey() string {
+	return a.Redis.Key("index")
+}
+
+// releaseDevAddrScript removes the end device from the DevAddr and removes the DevAddr
+// from the index if it is not used anymore.
+var releaseDevAddrScript = redis.NewScript(`redis.call('srem', KEYS[1], ARGV[1])
+redis.call('srem', KEYS[2], ARGV[2])
+if redis.call('scard', KEYS[1]) == 0 then
+	redis.call('zrem', KEYS[3], ARGV[2])
+end
+return redis.status_reply('OK')`)
+
+// rangeIndex calls f with the batches of DevAddrs in the index with a numeric value between min and max,
+// in ascending order, until f returns false.
+func (a *DevAddrAllocator) rangeIndex(ctx context.Context, min, max uint64, f func([]redis.Z) (bool, error)) error {
+	if min > max {
+		return nil
+	}
+	from := strconv.FormatUint(min, 10)
+	to := strconv.FormatUint(max, 10)
+	for {
+		zs, err := a.Redis.ZRangeByScoreWithScores(ctx, a.indexKey(), &redis.ZRangeBy{
+			Min:   from,
+			Max:   to,
+			Count: devAddrBatchSize,
+		}).Result()
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		if len(zs) == 0 {
+			return nil
+		}
+		if ok, err := f(zs); err != nil || !ok {
+			return err
+		}
+		if len(zs) < devAddrBatchSize {
+			return nil
+		}
+		from = "(" + strconv.FormatUint(uint64(zs[len(zs)-1].Score), 10)
+	}
+}
+
+// occupancy returns the number of sessions using each of the DevAddrs in the batch of the index.
+func (a *DevAddrAllocator) occupancy(ctx context.Context, zs []redis.Z) ([]uint64, error) {
+	cmds := make([]*redis.IntCmd, len(zs))
+	if _, err := a.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i, z := range zs {
+			cmds[i] = p.SCard(ctx, a.addrKey(z.Member.(string)))
+		}
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	occupancy := make([]uint64, len(zs))
+	for i, cmd := range cmds {
+		occupancy[i] = uint64(cmd.Val())
+	}
+	return occupancy, nil
+}
+
+// firstUnused returns the first DevAddr with a numeric value between min and max which is not in the index.
+func (a *DevAddrAllocator) firstUnused(ctx context.Context, min, max uint64) (uint64, bool, error) {
+	next := min
+	found := false
+	if err := a.rangeIndex(ctx, min, max, func(zs []redis.Z) (bool, error) {
+		for _, z := range zs {
+			n := uint64(z.Score)
+			if n > next {
+				found = true
+				return false, nil
+			}
+			next = n + 1
+		}
+		return true, nil
+	}); err != nil {
+		return 0, false, err
+	}
+	return next, found || next <= max, nil
+}
+
+// LeastUsed implements networkserver.DevAddrAllocator.
+//
+// The index is scanned for a gap starting at from. Only if all DevAddrs of the prefix are used,
+// the occupancy of each DevAddr in the prefix is retrieved to find the least used one.
+func (a *DevAddrAllocator) LeastUsed(
+	ctx context.Context, prefix types.DevAddrPrefix, from types.DevAddr,
+) (types.DevAddr, error) {
+	first := uint64(prefix.DevAddr.Mask(prefix.Length).MarshalNumber())
+	last := first + uint64(1)<<(32-prefix.Length) - 1
+	start := uint64(from.WithPrefix(prefix).MarshalNumber())
+	ranges := [][2]uint64{{start, last}}
+	if start > first {
+		ranges = append(ranges, [2]uint64{first, start - 1})
+	}
+	var devAddr types.DevAddr
+	for _, r := range ranges {
+		n, ok, err := a.firstUnused(ctx, r[0], r[1])
+		if err != nil {
+			return types.DevAddr{}, err
+		}
+		if ok {
+			devAddr.UnmarshalNumber(uint32(n))
+			return devAddr, nil
+		}
+	}
+	var least uint64
+	found := false
+	for _, r := range ranges {
+		if err := a.rangeIndex(ctx, r[0], r[1], func(zs []redis.Z) (bool, error) {
+			occupancy, err := a.occupancy(ctx, zs)
+			if err != nil {
+				return false, err
+			}
+			for i, n := range occupancy {
+				if !found || n < least {
+					least, found = n, true
+					devAddr.UnmarshalNumber(uint32(zs[i].Score))
+				}
+			}
+			return true, nil
+		}); err != nil {
+			return types.DevAddr{}, err
+		}
+	}
+	return devAddr, nil
+}
+
+// Occupancy implements networkserver.DevAddrAllocator.
+func (a *DevAddrAllocator) Occupancy(ctx context.Context, devAddrs ...types.DevAddr) ([]uint64, error) {
+	cmds := make([]*redis.IntCmd, len(devAddrs))
+	if _, err := a.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i, devAddr := range devAddrs {
+			cmds[i] = p.SCard(ctx, a.addrKey(devAddr.String()))
+		}
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	occupancy := make([]uint64, len(devAddrs))
+	for i, cmd := range cmds {
+		occupancy[i] = uint64(cmd.Val())
+	}
+	return occupancy, nil
+}
+
+// Reconcile implements networkserver.DevAddrAllocator.
+func (a *DevAddrAllocator) Reconcile(ctx context.Context, uid string, devAddrs ...types.DevAddr) error {
+	uk := a.uidKey(uid)
+	stored, err := a.Redis.SMembers(ctx, uk).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	keep := make(map[string]types.DevAddr, len(devAddrs))
+	for _, devAddr := range devAddrs {
+		keep[devAddr.String()] = devAddr
+	}
+	for _, s := range stored {
+		if _, ok := keep[s]; ok {
+			delete(keep, s)
+			continue
+		}
+		if err := releaseDevAddrScript.Run(ctx, a.Redis, []string{a.addrKey(s), uk, a.indexKey()}, uid, s).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+	}
+	if len(keep) == 0 {
+		return nil
+	}
+	if _, err := a.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		for s, devAddr := range keep {
+			p.SAdd(ctx, a.addrKey(s), uid)
+			p.SAdd(ctx, uk, s)
+			p.ZAdd(ctx, a.indexKey(), redis.Z{
+				Score:  float64(devAddr.MarshalNumber()),
+				Member: s,
+			})
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Utilization implements networkserver.DevAddrAllocator.
+func (a *DevAddrAllocator) Utilization(
+	ctx context.Context, prefixes ...types.DevAddrPrefix,
+) ([]*ttnpb.DevAddrPrefixUtilization, error) {
+	res := make([]*ttnpb.DevAddrPrefixUtilization, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		size := uint64(1) << (32 - prefix.Length)
+		first := uint64(prefix.DevAddr.Mask(prefix.Length).MarshalNumber())
+		u := &ttnpb.DevAddrPrefixUtilization{
+			Prefix: &ttnpb.DevAddrPrefix{
+				DevAddr: prefix.DevAddr.Mask(prefix.Length).Bytes(),
+				Length:  uint32(prefix.Length),
+			},
+			Addresses: size,
+		}
+		if err := a.rangeIndex(ctx, first, first+size-1, func(zs []redis.Z) (bool, error) {
+			occupancy, err := a.occupancy(ctx, zs)
+			if err != nil {
+				return false, err
+			}
+			for _, n := range occupancy {
+				if n == 0 {
+					continue
+				}
+				u.UsedAddresses++
+				if n > 1 {
+					u.SharedAddresses++
+				}
+				u.Sessions += n
+				if n > u.MaxSessionsPerAddress {
+					u.MaxSessionsPerAddress = n
+				}
+			}
+			return true, nil
+		}); err != nil {
+			return nil, err
+		}
+		res = append(res, u)
+	}
+	return res, nil
+}
@@ -0,0 +1,119 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDevAddrAllocator(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	alloc := redis.NewDevAddrAllocator(cl)
+
+	addr1 := types.DevAddr{0x26, 0x00, 0x00, 0x01}
+	addr2 := types.DevAddr{0x26, 0x00, 0x00, 0x02}
+	addr3 := types.DevAddr{0x27, 0x00, 0x00, 0x01}
+	prefix26 := types.DevAddrPrefix{DevAddr: types.DevAddr{0x26}, Length: 8}
+	prefix27 := types.DevAddrPrefix{DevAddr: types.DevAddr{0x27}, Length: 8}
+
+	occupancy, err := alloc.Occupancy(ctx, addr1, addr2, addr3)
+	a.So(err, should.BeNil)
+	a.So(occupancy, should.Resemble, []uint64{0, 0, 0})
+
+	a.So(alloc.Reconcile(ctx, "app.dev1", addr1), should.BeNil)
+	a.So(alloc.Reconcile(ctx, "app.dev2", addr1, addr2), should.BeNil)
+	a.So(alloc.Reconcile(ctx, "app.dev3", addr3), should.BeNil)
+
+	occupancy, err = alloc.Occupancy(ctx, addr1, addr2, addr3)
+	a.So(err, should.BeNil)
+	a.So(occupancy, should.Resemble, []uint64{2, 1, 1})
+
+	utilization, err := alloc.Utilization(ctx, prefix26, prefix27)
+	a.So(err, should.BeNil)
+	a.So(utilization, should.Resemble, []*ttnpb.DevAddrPrefixUtilization{
+		{
+			Prefix:                &ttnpb.DevAddrPrefix{DevAddr: prefix26.DevAddr.Bytes(), Length: 8},
+			Addresses:             1 << 24,
+			UsedAddresses:         2,
+			SharedAddresses:       1,
+			Sessions:              3,
+			MaxSessionsPerAddress: 2,
+		},
+		{
+			Prefix:                &ttnpb.DevAddrPrefix{DevAddr: prefix27.DevAddr.Bytes(), Length: 8},
+			Addresses:             1 << 24,
+			UsedAddresses:         1,
+			Sessions:              1,
+			MaxSessionsPerAddress: 1,
+		},
+	})
+
+	// Reclaim the DevAddr of the previous session of dev2 and delete dev3.
+	a.So(alloc.Reconcile(ctx, "app.dev2", addr2), should.BeNil)
+	a.So(alloc.Reconcile(ctx, "app.dev3"), should.BeNil)
+
+	occupancy, err = alloc.Occupancy(ctx, addr1, addr2, addr3)
+	a.So(err, should.BeNil)
+	a.So(occupancy, should.Resemble, []uint64{1, 1, 0})
+
+	utilization, err = alloc.Utilization(ctx, prefix26, prefix27)
+	a.So(err, should.BeNil)
+	a.So(utilization, should.Resemble, []*ttnpb.DevAddrPrefixUtilization{
+		{
+			Prefix:                &ttnpb.DevAddrPrefix{DevAddr: prefix26.DevAddr.Bytes(), Length: 8},
+			Addresses:             1 << 24,
+			UsedAddresses:         2,
+			Sessions:              2,
+			MaxSessionsPerAddress: 1,
+		},
+		{
+			Prefix:    &ttnpb.DevAddrPrefix{DevAddr: prefix27.DevAddr.Bytes(), Length: 8},
+			Addresses: 1 << 24,
+		},
+	})
+
+	// Unused DevAddrs are allocated first, searching from the given DevAddr.
+	devAddr, err := alloc.LeastUsed(ctx, prefix26, addr1)
+	a.So(err, should.BeNil)
+	a.So(devAddr, should.Equal, types.DevAddr{0x26, 0x00, 0x00, 0x03})
+	devAddr, err = alloc.LeastUsed(ctx, prefix26, types.DevAddr{0x26, 0xff, 0xff, 0xff})
+	a.So(err, should.BeNil)
+	a.So(devAddr, should.Equal, types.DevAddr{0x26, 0xff, 0xff, 0xff})
+
+	// The least used DevAddr is allocated when all DevAddrs of the prefix are used.
+	prefix30 := types.DevAddrPrefix{DevAddr: addr1.Mask(30), Length: 30}
+	addr0 := types.DevAddr{0x26, 0x00, 0x00, 0x00}
+	addrLast := types.DevAddr{0x26, 0x00, 0x00, 0x03}
+	devAddr, err = alloc.LeastUsed(ctx, prefix30, addrLast)
+	a.So(err, should.BeNil)
+	a.So(devAddr, should.Equal, addrLast)
+	a.So(alloc.Reconcile(ctx, "app.dev4", addr0, addrLast), should.BeNil)
+	a.So(alloc.Reconcile(ctx, "app.dev5", addr0, addr1), should.BeNil)
+	a.So(alloc.Reconcile(ctx, "app.dev6", addrLast), should.BeNil)
+	devAddr, err = alloc.LeastUsed(ctx, prefix30, addrLast)
+	a.So(err, should.BeNil)
+	a.So(devAddr, should.Equal, addr2)
+}
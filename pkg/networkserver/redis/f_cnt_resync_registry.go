@@ -0,0 +1,114 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// FCntResyncRegistry is an implementation of networkserver.FCntResyncRegistry.
+//
+// The frame counter resynchronization state is stored as JSON by end device UID, and expires after the TTL
+// given on update. The state of deleted end devices therefore expires as well.
+type FCntResyncRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// NewFCntResyncRegistry returns a new frame counter resynchronization registry.
+func NewFCntResyncRegistry(cl *ttnredis.Client) *FCntResyncRegistry {
+	return &FCntResyncRegistry{
+		Redis: cl,
+	}
+}
+
+func (r *FCntResyncRegistry) stateKey(uid string) string {
+	return ttnredis.Key(UIDKey(r.Redis, uid), "f-cnt-resync")
+}
+
+func (r *FCntResyncRegistry) get(
+	ctx context.Context, cl redis.Cmdable, k string,
+) (*networkserver.FCntResyncState, error) {
+	b, err := cl.Get(ctx, k).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	state := &networkserver.FCntResyncState{}
+	if err := json.Unmarshal(b, state); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return state, nil
+}
+
+// Get implements networkserver.FCntResyncRegistry.
+func (r *FCntResyncRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*networkserver.FCntResyncState, error) {
+	state, err := r.get(ctx, r.Redis, r.stateKey(unique.ID(ctx, ids)))
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return state, nil
+}
+
+// Update implements networkserver.FCntResyncRegistry.
+func (r *FCntResyncRegistry) Update(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	ttl time.Duration,
+	f func(*networkserver.FCntResyncState) (*networkserver.FCntResyncState, error),
+) (*networkserver.FCntResyncState, error) {
+	k := r.stateKey(unique.ID(ctx, ids))
+	var updated *networkserver.FCntResyncState
+	if err := r.Redis.Watch(ctx, func(tx *redis.Tx) error {
+		state, err := r.get(ctx, tx, k)
+		if err != nil {
+			return err
+		}
+		updated, err = f(state)
+		if err != nil {
+			return err
+		}
+		var b []byte
+		if updated != nil {
+			if b, err = json.Marshal(updated); err != nil {
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			if updated == nil {
+				p.Del(ctx, k)
+				return nil
+			}
+			p.Set(ctx, k, b, ttl)
+			return nil
+		})
+		return err
+	}, k); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return updated, nil
+}
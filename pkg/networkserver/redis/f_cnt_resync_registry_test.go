@@ -0,0 +1,101 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.FCntResyncRegistry = &redis.FCntResyncRegistry{}
+
+func TestFCntResyncRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := redis.NewFCntResyncRegistry(cl)
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+
+	state, err := reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(state, should.BeNil)
+
+	receivedAt := time.Unix(1700000000, 0).UTC()
+	expected := &networkserver.FCntResyncState{
+		Rejections: []*networkserver.FCntRejection{
+			{FCnt: 2, LastFCntUp: 4200, Reason: networkserver.FCntRejectionReset, ReceivedAt: receivedAt},
+		},
+		Audit: []*networkserver.FCntResyncRecord{
+			{
+				LastFCntUp:      100,
+				FCnt:            70000,
+				Reason:          networkserver.FCntRejectionGap,
+				RejectedUplinks: 3,
+				Automatic:       true,
+				ResyncedAt:      receivedAt.Add(-time.Hour),
+			},
+		},
+	}
+	state, err = reg.Update(ctx, ids, time.Hour,
+		func(stored *networkserver.FCntResyncState) (*networkserver.FCntResyncState, error) {
+			a.So(stored, should.BeNil)
+			return expected, nil
+		},
+	)
+	a.So(err, should.BeNil)
+	a.So(state, should.Resemble, expected)
+	state, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(state, should.Resemble, expected)
+	ttl, err := cl.TTL(ctx, cl.Key("uid", unique.ID(ctx, ids), "f-cnt-resync")).Result()
+	a.So(err, should.BeNil)
+	a.So(ttl, should.BeBetweenOrEqual, time.Hour-time.Minute, time.Hour)
+
+	_, err = reg.Update(ctx, ids, time.Hour,
+		func(*networkserver.FCntResyncState) (*networkserver.FCntResyncState, error) {
+			return nil, errors.New("test")
+		},
+	)
+	a.So(err, should.NotBeNil)
+	state, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(state, should.Resemble, expected)
+
+	state, err = reg.Update(ctx, ids, time.Hour,
+		func(stored *networkserver.FCntResyncState) (*networkserver.FCntResyncState, error) {
+			a.So(stored, should.Resemble, expected)
+			return nil, nil
+		},
+	)
+	a.So(err, should.BeNil)
+	a.So(state, should.BeNil)
+	state, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(state, should.BeNil)
+}
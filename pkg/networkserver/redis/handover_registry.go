@@ -0,0 +1,113 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// HandoverRegistry is an implementation of networkserver.HandoverRegistry.
+//
+// The handover roaming sessions are stored by end device UID, and indexed by DevEUI.
+type HandoverRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// NewHandoverRegistry returns a new handover roaming session registry.
+func NewHandoverRegistry(cl *ttnredis.Client) *HandoverRegistry {
+	return &HandoverRegistry{
+		Redis: cl,
+	}
+}
+
+func (r *HandoverRegistry) uidKey(uid string) string {
+	return UIDKey(r.Redis, uid)
+}
+
+func (r *HandoverRegistry) euiKey(devEUI types.EUI64) string {
+	return r.Redis.Key("eui", devEUI.String())
+}
+
+func (r *HandoverRegistry) get(ctx context.Context, uid string) (*networkserver.HandoverSession, error) {
+	b, err := r.Redis.Get(ctx, r.uidKey(uid)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	s := &networkserver.HandoverSession{}
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return s, nil
+}
+
+// GetByID implements networkserver.HandoverRegistry.
+func (r *HandoverRegistry) GetByID(
+	ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string,
+) (*networkserver.HandoverSession, error) {
+	return r.get(ctx, unique.ID(ctx, &ttnpb.EndDeviceIdentifiers{ApplicationIds: appID, DeviceId: devID}))
+}
+
+// GetByEUI implements networkserver.HandoverRegistry.
+func (r *HandoverRegistry) GetByEUI(ctx context.Context, devEUI types.EUI64) (*networkserver.HandoverSession, error) {
+	uid, err := r.Redis.Get(ctx, r.euiKey(devEUI)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	return r.get(ctx, uid)
+}
+
+// Set implements networkserver.HandoverRegistry.
+func (r *HandoverRegistry) Set(ctx context.Context, s *networkserver.HandoverSession) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	uid := unique.ID(ctx, s.EndDeviceIdentifiers())
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.Set(ctx, r.uidKey(uid), b, 0)
+		p.Set(ctx, r.euiKey(s.DevEUI), uid, 0)
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Delete implements networkserver.HandoverRegistry.
+func (r *HandoverRegistry) Delete(ctx context.Context, s *networkserver.HandoverSession) error {
+	uid := unique.ID(ctx, s.EndDeviceIdentifiers())
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.Del(ctx, r.uidKey(uid), r.euiKey(s.DevEUI))
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestHandoverRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := redis.NewHandoverRegistry(cl)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	devEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	nsID := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+
+	s, err := reg.GetByID(ctx, appIDs, "test-dev")
+	a.So(err, should.BeNil)
+	a.So(s, should.BeNil)
+	s, err = reg.GetByEUI(ctx, devEUI)
+	a.So(err, should.BeNil)
+	a.So(s, should.BeNil)
+
+	stored := &networkserver.HandoverSession{
+		ApplicationID: "test-app",
+		DeviceID:      "test-dev",
+		DevEUI:        devEUI,
+		Role:          networkserver.HandoverRoleHome,
+		NetID:         types.NetID{0x00, 0x00, 0x13},
+		NSID:          &nsID,
+		CreatedAt:     time.Unix(1700000000, 0).UTC(),
+	}
+	a.So(reg.Set(ctx, stored), should.BeNil)
+
+	s, err = reg.GetByID(ctx, appIDs, "test-dev")
+	a.So(err, should.BeNil)
+	a.So(s, should.Resemble, stored)
+	s, err = reg.GetByEUI(ctx, devEUI)
+	a.So(err, should.BeNil)
+	a.So(s, should.Resemble, stored)
+
+	a.So(reg.Delete(ctx, stored), should.BeNil)
+
+	s, err = reg.GetByID(ctx, appIDs, "test-dev")
+	a.So(err, should.BeNil)
+	a.So(s, should.BeNil)
+	s, err = reg.GetByEUI(ctx, devEUI)
+	a.So(err, should.BeNil)
+	a.So(s, should.BeNil)
+}
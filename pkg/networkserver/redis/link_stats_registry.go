@@ -0,0 +1,100 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// LinkStatsRegistry is an implementation of networkserver.LinkStatsRegistry.
+//
+// The link samples of each end device are stored as JSON in a list, oldest first.
+type LinkStatsRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// NewLinkStatsRegistry returns a new link statistics registry.
+func NewLinkStatsRegistry(cl *ttnredis.Client) *LinkStatsRegistry {
+	return &LinkStatsRegistry{
+		Redis: cl,
+	}
+}
+
+func (r *LinkStatsRegistry) samplesKey(uid string) string {
+	return ttnredis.Key(UIDKey(r.Redis, uid), "samples")
+}
+
+// Add implements networkserver.LinkStatsRegistry.
+func (r *LinkStatsRegistry) Add(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	sample *networkserver.LinkSample,
+	limit int,
+	ttl time.Duration,
+) error {
+	b, err := json.Marshal(sample)
+	if err != nil {
+		return err
+	}
+	k := r.samplesKey(unique.ID(ctx, ids))
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.RPush(ctx, k, b)
+		if limit > 0 {
+			p.LTrim(ctx, k, int64(-limit), -1)
+		}
+		if ttl > 0 {
+			p.PExpire(ctx, k, ttl)
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Get implements networkserver.LinkStatsRegistry.
+func (r *LinkStatsRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) ([]*networkserver.LinkSample, error) {
+	vs, err := r.Redis.LRange(ctx, r.samplesKey(unique.ID(ctx, ids)), 0, -1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	samples := make([]*networkserver.LinkSample, 0, len(vs))
+	for _, v := range vs {
+		sample := &networkserver.LinkSample{}
+		if err := json.Unmarshal([]byte(v), sample); err != nil {
+			return nil, err
+		}
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
+
+// Clear implements networkserver.LinkStatsRegistry.
+func (r *LinkStatsRegistry) Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	if err := r.Redis.Del(ctx, r.samplesKey(unique.ID(ctx, ids))).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.LinkStatsRegistry = &redis.LinkStatsRegistry{}
+
+func TestLinkStatsRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := redis.NewLinkStatsRegistry(cl)
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+
+	samples, err := reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(samples, should.BeEmpty)
+
+	snr := float32(7.5)
+	receivedAt := time.Unix(1700000000, 0).UTC()
+	for i := uint32(1); i <= 3; i++ {
+		a.So(reg.Add(ctx, ids, &networkserver.LinkSample{
+			ReceivedAt:    receivedAt.Add(time.Duration(i) * time.Minute),
+			FCnt:          i,
+			DataRateIndex: 5,
+			SNR:           &snr,
+			RSSI:          -42,
+			GatewayIDs:    []string{"gtw-1"},
+		}, 2, time.Hour), should.BeNil)
+	}
+
+	samples, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	if a.So(samples, should.HaveLength, 2) {
+		a.So(samples[0].FCnt, should.Equal, 2)
+		a.So(samples[1], should.Resemble, &networkserver.LinkSample{
+			ReceivedAt:    receivedAt.Add(3 * time.Minute),
+			FCnt:          3,
+			DataRateIndex: 5,
+			SNR:           &snr,
+			RSSI:          -42,
+			GatewayIDs:    []string{"gtw-1"},
+		})
+	}
+
+	a.So(reg.Clear(ctx, ids), should.BeNil)
+	samples, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(samples, should.BeEmpty)
+}
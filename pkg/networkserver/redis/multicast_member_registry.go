@@ -0,0 +1,72 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"sort"
+
+	"github.com/redis/go-redis/v9"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// MulticastMemberRegistry is an implementation of networkserver.MulticastMemberRegistry.
+//
+// The device IDs of the members of each multicast end device are stored in a set.
+type MulticastMemberRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// NewMulticastMemberRegistry returns a new multicast group member registry.
+func NewMulticastMemberRegistry(cl *ttnredis.Client) *MulticastMemberRegistry {
+	return &MulticastMemberRegistry{
+		Redis: cl,
+	}
+}
+
+func (r *MulticastMemberRegistry) membersKey(uid string) string {
+	return ttnredis.Key(UIDKey(r.Redis, uid), "members")
+}
+
+// Get implements networkserver.MulticastMemberRegistry.
+func (r *MulticastMemberRegistry) Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]string, error) {
+	deviceIDs, err := r.Redis.SMembers(ctx, r.membersKey(unique.ID(ctx, ids))).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	sort.Strings(deviceIDs)
+	return deviceIDs, nil
+}
+
+// Set implements networkserver.MulticastMemberRegistry.
+func (r *MulticastMemberRegistry) Set(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, deviceIDs []string) error {
+	k := r.membersKey(unique.ID(ctx, ids))
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.Del(ctx, k)
+		if len(deviceIDs) > 0 {
+			members := make([]any, len(deviceIDs))
+			for i, devID := range deviceIDs {
+				members[i] = devID
+			}
+			p.SAdd(ctx, k, members...)
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
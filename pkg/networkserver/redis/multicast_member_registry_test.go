@@ -0,0 +1,57 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMulticastMemberRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := redis.NewMulticastMemberRegistry(cl)
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-mc",
+	}
+
+	members, err := reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(members, should.BeEmpty)
+
+	a.So(reg.Set(ctx, ids, []string{"dev-2", "dev-1"}), should.BeNil)
+	members, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(members, should.Resemble, []string{"dev-1", "dev-2"})
+
+	a.So(reg.Set(ctx, ids, []string{"dev-3"}), should.BeNil)
+	members, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(members, should.Resemble, []string{"dev-3"})
+
+	a.So(reg.Set(ctx, ids, nil), should.BeNil)
+	members, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(members, should.BeEmpty)
+}
@@ -0,0 +1,142 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+)
+
+// PingSlotOccupancyRegistry is an implementation of networkserver.PingSlotOccupancyRegistry.
+//
+// The ping slot reservations of a gateway are stored as JSON in a sorted set scored by start time in microseconds.
+// Reservations are retained for two beacon periods.
+// The class B end devices of a gateway are stored as JSON in a hash by end device UID.
+type PingSlotOccupancyRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// NewPingSlotOccupancyRegistry returns a new ping slot occupancy registry.
+func NewPingSlotOccupancyRegistry(cl *ttnredis.Client) *PingSlotOccupancyRegistry {
+	return &PingSlotOccupancyRegistry{
+		Redis: cl,
+	}
+}
+
+const pingSlotReservationRetention = 2 * mac.BeaconPeriod
+
+func (r *PingSlotOccupancyRegistry) reservationsKey(gatewayID string) string {
+	return r.Redis.Key("reservations", gatewayID)
+}
+
+func (r *PingSlotOccupancyRegistry) devicesKey(gatewayID string) string {
+	return r.Redis.Key("devices", gatewayID)
+}
+
+func pingSlotScore(t time.Time) string {
+	return strconv.FormatInt(t.UnixMicro(), 10)
+}
+
+// Reserve implements networkserver.PingSlotOccupancyRegistry.
+func (r *PingSlotOccupancyRegistry) Reserve(
+	ctx context.Context, gatewayID string, reservation *networkserver.PingSlotReservation,
+) error {
+	b, err := json.Marshal(reservation)
+	if err != nil {
+		return err
+	}
+	k := r.reservationsKey(gatewayID)
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.ZAdd(ctx, k, redis.Z{
+			Score:  float64(reservation.StartsAt.UnixMicro()),
+			Member: b,
+		})
+		p.ZRemRangeByScore(ctx, k, "-inf", "("+pingSlotScore(time.Now().Add(-pingSlotReservationRetention)))
+		p.PExpire(ctx, k, time.Until(reservation.StartsAt)+pingSlotReservationRetention)
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Reservations implements networkserver.PingSlotOccupancyRegistry.
+func (r *PingSlotOccupancyRegistry) Reservations(
+	ctx context.Context, gatewayID string, from, to time.Time,
+) ([]*networkserver.PingSlotReservation, error) {
+	vs, err := r.Redis.ZRangeByScore(ctx, r.reservationsKey(gatewayID), &redis.ZRangeBy{
+		Min: pingSlotScore(from),
+		Max: "(" + pingSlotScore(to),
+	}).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	reservations := make([]*networkserver.PingSlotReservation, 0, len(vs))
+	for _, v := range vs {
+		reservation := &networkserver.PingSlotReservation{}
+		if err := json.Unmarshal([]byte(v), reservation); err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		reservations = append(reservations, reservation)
+	}
+	return reservations, nil
+}
+
+// SetDevice implements networkserver.PingSlotOccupancyRegistry.
+func (r *PingSlotOccupancyRegistry) SetDevice(
+	ctx context.Context, gatewayID string, dev *networkserver.ClassBDevice, ttl time.Duration,
+) error {
+	b, err := json.Marshal(dev)
+	if err != nil {
+		return err
+	}
+	k := r.devicesKey(gatewayID)
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HSet(ctx, k, dev.DeviceUID, b)
+		if ttl > 0 {
+			p.PExpire(ctx, k, ttl)
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Devices implements networkserver.PingSlotOccupancyRegistry.
+func (r *PingSlotOccupancyRegistry) Devices(
+	ctx context.Context, gatewayID string,
+) ([]*networkserver.ClassBDevice, error) {
+	vs, err := r.Redis.HGetAll(ctx, r.devicesKey(gatewayID)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	devices := make([]*networkserver.ClassBDevice, 0, len(vs))
+	for _, v := range vs {
+		dev := &networkserver.ClassBDevice{}
+		if err := json.Unmarshal([]byte(v), dev); err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		devices = append(devices, dev)
+	}
+	return devices, nil
+}
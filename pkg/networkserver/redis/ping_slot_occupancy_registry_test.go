@@ -0,0 +1,81 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.PingSlotOccupancyRegistry = &redis.PingSlotOccupancyRegistry{}
+
+func TestPingSlotOccupancyRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := redis.NewPingSlotOccupancyRegistry(cl)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	reservations, err := reg.Reservations(ctx, "test-gtw", now, now.Add(time.Minute))
+	a.So(err, should.BeNil)
+	a.So(reservations, should.BeEmpty)
+
+	expected := []*networkserver.PingSlotReservation{
+		{DeviceUID: "test-app.test-dev-1", StartsAt: now.Add(time.Second), Airtime: 50 * time.Millisecond},
+		{DeviceUID: "test-app.test-dev-2", StartsAt: now.Add(2 * time.Second), Airtime: 50 * time.Millisecond},
+		{DeviceUID: "test-app.test-dev-1", StartsAt: now.Add(3 * time.Second), Airtime: 50 * time.Millisecond},
+	}
+	for _, r := range []*networkserver.PingSlotReservation{expected[2], expected[0], expected[1]} {
+		a.So(reg.Reserve(ctx, "test-gtw", r), should.BeNil)
+	}
+	reservations, err = reg.Reservations(ctx, "test-gtw", now, now.Add(time.Minute))
+	a.So(err, should.BeNil)
+	a.So(reservations, should.Resemble, expected)
+
+	reservations, err = reg.Reservations(ctx, "test-gtw", now.Add(2*time.Second), now.Add(3*time.Second))
+	a.So(err, should.BeNil)
+	a.So(reservations, should.Resemble, expected[1:2])
+
+	reservations, err = reg.Reservations(ctx, "other-gtw", now, now.Add(time.Minute))
+	a.So(err, should.BeNil)
+	a.So(reservations, should.BeEmpty)
+
+	devices, err := reg.Devices(ctx, "test-gtw")
+	a.So(err, should.BeNil)
+	a.So(devices, should.BeEmpty)
+
+	dev := &networkserver.ClassBDevice{
+		DeviceUID:             "test-app.test-dev-1",
+		PingSlotPeriodicity:   ttnpb.PingSlotPeriod_PING_EVERY_4S,
+		PingSlotFrequency:     869525000,
+		PingSlotDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_3,
+		UpdatedAt:             now,
+	}
+	a.So(reg.SetDevice(ctx, "test-gtw", dev, time.Hour), should.BeNil)
+	dev.PingSlotFrequency = 869100000
+	a.So(reg.SetDevice(ctx, "test-gtw", dev, time.Hour), should.BeNil)
+	devices, err = reg.Devices(ctx, "test-gtw")
+	a.So(err, should.BeNil)
+	a.So(devices, should.Resemble, []*networkserver.ClassBDevice{dev})
+}
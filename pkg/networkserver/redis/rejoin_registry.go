@@ -0,0 +1,130 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// RejoinRegistry is an implementation of networkserver.RejoinRegistry.
+//
+// The rejoin state is stored as JSON by end device UID. The end device UID is indexed by DevEUI.
+type RejoinRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// NewRejoinRegistry returns a new rejoin registry.
+func NewRejoinRegistry(cl *ttnredis.Client) *RejoinRegistry {
+	return &RejoinRegistry{
+		Redis: cl,
+	}
+}
+
+func (r *RejoinRegistry) stateKey(uid string) string {
+	return ttnredis.Key(UIDKey(r.Redis, uid), "rejoin")
+}
+
+func (r *RejoinRegistry) devEUIKey(devEUI types.EUI64) string {
+	return r.Redis.Key("dev-eui", devEUI.String())
+}
+
+// Get implements networkserver.RejoinRegistry.
+func (r *RejoinRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*networkserver.RejoinState, error) {
+	b, err := r.Redis.Get(ctx, r.stateKey(unique.ID(ctx, ids))).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	state := &networkserver.RejoinState{}
+	if err := json.Unmarshal(b, state); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return state, nil
+}
+
+// Set implements networkserver.RejoinRegistry.
+func (r *RejoinRegistry) Set(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, state *networkserver.RejoinState,
+) error {
+	uid := unique.ID(ctx, ids)
+	k := r.stateKey(uid)
+	devEUI := types.MustEUI64(ids.DevEui).OrZero()
+	if state == nil {
+		if err := r.Redis.Del(ctx, k).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		if devEUI.IsZero() {
+			return nil
+		}
+		// Only remove the index if it still refers to this end device.
+		indexed, err := r.Redis.Get(ctx, r.devEUIKey(devEUI)).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				return nil
+			}
+			return ttnredis.ConvertError(err)
+		}
+		if indexed != uid {
+			return nil
+		}
+		if err := r.Redis.Del(ctx, r.devEUIKey(devEUI)).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		return nil
+	}
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.Set(ctx, k, b, 0)
+		if !devEUI.IsZero() {
+			p.Set(ctx, r.devEUIKey(devEUI), uid, 0)
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// GetByDevEUI implements networkserver.RejoinRegistry.
+func (r *RejoinRegistry) GetByDevEUI(ctx context.Context, devEUI types.EUI64) (*ttnpb.EndDeviceIdentifiers, error) {
+	uid, err := r.Redis.Get(ctx, r.devEUIKey(devEUI)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	ids, err := unique.ToDeviceID(uid)
+	if err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,86 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.RejoinRegistry = &redis.RejoinRegistry{}
+
+func TestRejoinRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := redis.NewRejoinRegistry(cl)
+	devEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+		DevEui:         devEUI.Bytes(),
+	}
+
+	state, err := reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(state, should.BeNil)
+	indexed, err := reg.GetByDevEUI(ctx, devEUI)
+	a.So(err, should.BeNil)
+	a.So(indexed, should.BeNil)
+
+	issuedAt := time.Unix(1700000000, 0).UTC()
+	rejoinCount := uint32(3)
+	expected := &networkserver.RejoinState{
+		ForceRejoin: &networkserver.ForceRejoin{
+			ForceRejoinParameters: networkserver.ForceRejoinParameters{
+				RejoinType:     ttnpb.RejoinRequestType_KEYS,
+				DataRateIndex:  ttnpb.DataRateIndex_DATA_RATE_2,
+				MaxRetries:     3,
+				PeriodExponent: ttnpb.RejoinPeriodExponent_REJOIN_PERIOD_1,
+			},
+			IssuedAt: issuedAt,
+		},
+		SessionKeyID:     []byte{0x01, 0x02},
+		LastRejoinCount0: &rejoinCount,
+	}
+	a.So(reg.Set(ctx, ids, expected), should.BeNil)
+	state, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(state, should.Resemble, expected)
+	indexed, err = reg.GetByDevEUI(ctx, devEUI)
+	a.So(err, should.BeNil)
+	a.So(indexed, should.Resemble, &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: ids.ApplicationIds,
+		DeviceId:       ids.DeviceId,
+	})
+
+	a.So(reg.Set(ctx, ids, nil), should.BeNil)
+	state, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(state, should.BeNil)
+	indexed, err = reg.GetByDevEUI(ctx, devEUI)
+	a.So(err, should.BeNil)
+	a.So(indexed, should.BeNil)
+}
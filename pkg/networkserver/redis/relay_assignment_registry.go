@@ -0,0 +1,130 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// RelayAssignmentRegistry is an implementation of networkserver.RelayAssignmentRegistry.
+//
+// The relay assignment state is stored as JSON by end device UID.
+// The coverage of the relays of an application is stored as JSON in a hash by relay device ID.
+type RelayAssignmentRegistry struct {
+	Redis *ttnredis.Client
+}
+
+// NewRelayAssignmentRegistry returns a new relay assignment registry.
+func NewRelayAssignmentRegistry(cl *ttnredis.Client) *RelayAssignmentRegistry {
+	return &RelayAssignmentRegistry{
+		Redis: cl,
+	}
+}
+
+func (r *RelayAssignmentRegistry) stateKey(uid string) string {
+	return ttnredis.Key(UIDKey(r.Redis, uid), "relay-assignment")
+}
+
+func (r *RelayAssignmentRegistry) coverageKey(appUID string) string {
+	return r.Redis.Key("relay-coverage", appUID)
+}
+
+// Get implements networkserver.RelayAssignmentRegistry.
+func (r *RelayAssignmentRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*networkserver.RelayAssignmentState, error) {
+	b, err := r.Redis.Get(ctx, r.stateKey(unique.ID(ctx, ids))).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	state := &networkserver.RelayAssignmentState{}
+	if err := json.Unmarshal(b, state); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return state, nil
+}
+
+// Set implements networkserver.RelayAssignmentRegistry.
+func (r *RelayAssignmentRegistry) Set(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, state *networkserver.RelayAssignmentState, ttl time.Duration,
+) error {
+	k := r.stateKey(unique.ID(ctx, ids))
+	if state == nil {
+		if err := r.Redis.Del(ctx, k).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		return nil
+	}
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err := r.Redis.Set(ctx, k, b, ttl).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// SetRelayCoverage implements networkserver.RelayAssignmentRegistry.
+func (r *RelayAssignmentRegistry) SetRelayCoverage(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, coverage *networkserver.RelayCoverage, ttl time.Duration,
+) error {
+	b, err := json.Marshal(coverage)
+	if err != nil {
+		return err
+	}
+	k := r.coverageKey(unique.ID(ctx, ids.ApplicationIds))
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HSet(ctx, k, ids.DeviceId, b)
+		if ttl > 0 {
+			p.PExpire(ctx, k, ttl)
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// RelayCoverage implements networkserver.RelayAssignmentRegistry.
+func (r *RelayAssignmentRegistry) RelayCoverage(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (map[string]*networkserver.RelayCoverage, error) {
+	vs, err := r.Redis.HGetAll(ctx, r.coverageKey(unique.ID(ctx, ids))).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	coverage := make(map[string]*networkserver.RelayCoverage, len(vs))
+	for devID, v := range vs {
+		c := &networkserver.RelayCoverage{}
+		if err := json.Unmarshal([]byte(v), c); err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		coverage[devID] = c
+	}
+	return coverage, nil
+}
@@ -0,0 +1,87 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ networkserver.RelayAssignmentRegistry = &redis.RelayAssignmentRegistry{}
+
+func TestRelayAssignmentRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := redis.NewRelayAssignmentRegistry(cl)
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appIDs,
+		DeviceId:       "test-dev",
+	}
+
+	state, err := reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(state, should.BeNil)
+
+	suggestedAt := time.Unix(1700000000, 0).UTC()
+	expected := &networkserver.RelayAssignmentState{
+		PoorUplinks: 2,
+		Suggestion: &networkserver.RelayAssignmentSuggestion{
+			Action:          networkserver.RelayAssignmentAssign,
+			ServingDeviceID: "test-relay",
+			Reason:          networkserver.RelayAssignmentReasonPoorLink,
+			SuggestedAt:     suggestedAt,
+		},
+	}
+	a.So(reg.Set(ctx, ids, expected, time.Hour), should.BeNil)
+	state, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(state, should.Resemble, expected)
+
+	a.So(reg.Set(ctx, ids, nil, 0), should.BeNil)
+	state, err = reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(state, should.BeNil)
+
+	coverage, err := reg.RelayCoverage(ctx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(coverage, should.BeEmpty)
+
+	for _, devID := range []string{"test-relay-1", "test-relay-2"} {
+		a.So(reg.SetRelayCoverage(ctx, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appIDs,
+			DeviceId:       devID,
+		}, &networkserver.RelayCoverage{
+			GatewayIDs: []string{"gtw-1", devID},
+			UpdatedAt:  suggestedAt,
+		}, time.Hour), should.BeNil)
+	}
+	coverage, err = reg.RelayCoverage(ctx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(coverage, should.Resemble, map[string]*networkserver.RelayCoverage{
+		"test-relay-1": {GatewayIDs: []string{"gtw-1", "test-relay-1"}, UpdatedAt: suggestedAt},
+		"test-relay-2": {GatewayIDs: []string{"gtw-1", "test-relay-2"}, UpdatedAt: suggestedAt},
+	})
+}
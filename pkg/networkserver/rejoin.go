@@ -0,0 +1,684 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errRejoinDisabled = errors.DefineFailedPrecondition(
+		"rejoin_disabled", "rejoin handling is disabled",
+	)
+	errRejoinNotSupported = errors.DefineFailedPrecondition(
+		"rejoin_not_supported", "end device does not support rejoin-requests",
+	)
+	errNoRejoinSession = errors.DefineFailedPrecondition(
+		"no_rejoin_session", "no session to rejoin",
+	)
+	errRejoinNetIDMismatch = errors.DefineInvalidArgument(
+		"rejoin_net_id_mismatch", "NetID `{net_id}` of rejoin-request does not match",
+	)
+	errRejoinMICMismatch = errors.DefineInvalidArgument(
+		"rejoin_mic_mismatch", "MIC of rejoin-request does not match",
+	)
+	errRejoinCountReplay = errors.DefineInvalidArgument(
+		"rejoin_count_replay", "RJcount0 `{rejoin_count}` has already been used in the session",
+	)
+	errInvalidForceRejoin = errors.DefineInvalidArgument(
+		"invalid_force_rejoin", "invalid force rejoin request",
+	)
+	errInvalidRejoinPolicy = errors.DefineInvalidArgument(
+		"invalid_rejoin_policy", "invalid rejoin policy",
+	)
+	errNoForceRejoin = errors.DefineNotFound(
+		"no_force_rejoin", "no pending force rejoin request",
+	)
+
+	evtReceiveRejoinRequest = events.Define(
+		"ns.up.rejoin.receive", "receive rejoin-request",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+	)
+	evtDropRejoinRequest = events.Define(
+		"ns.up.rejoin.drop", "drop rejoin-request",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+	evtProcessRejoinRequest = events.Define(
+		"ns.up.rejoin.process", "successfully processed rejoin-request",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.UplinkMessage{}),
+		events.WithPropagateToParent(),
+	)
+	evtForceRejoin = events.Define(
+		"ns.rejoin.force", "force rejoin",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&structpb.Struct{}),
+	)
+	evtCompleteRejoin = events.Define(
+		"ns.rejoin.complete", "complete rejoin",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&structpb.Struct{}),
+	)
+)
+
+// ForceRejoinParameters are the parameters of a ForceRejoinReq.
+type ForceRejoinParameters struct {
+	// RejoinType is the type of the rejoin-request the end device must transmit. Only types 0 and 2 can be forced.
+	RejoinType    ttnpb.RejoinRequestType `json:"rejoin_type"`
+	DataRateIndex ttnpb.DataRateIndex     `json:"data_rate_index"`
+	// MaxRetries is the number of times the end device retransmits the rejoin-request.
+	MaxRetries uint32 `json:"max_retries"`
+	// PeriodExponent configures the delay between the retransmissions of the rejoin-request.
+	PeriodExponent ttnpb.RejoinPeriodExponent `json:"period_exponent"`
+}
+
+func (p ForceRejoinParameters) request() *ttnpb.MACCommand_ForceRejoinReq {
+	return &ttnpb.MACCommand_ForceRejoinReq{
+		RejoinType:     p.RejoinType,
+		DataRateIndex:  p.DataRateIndex,
+		MaxRetries:     p.MaxRetries,
+		PeriodExponent: p.PeriodExponent,
+	}
+}
+
+// validate returns an error if the parameters are not valid for an end device using phy.
+func (p ForceRejoinParameters) validate(phy *band.Band) error {
+	if err := p.request().ValidateFields(); err != nil {
+		return err
+	}
+	if p.RejoinType == ttnpb.RejoinRequestType_SESSION {
+		return errors.New("type 1 rejoin-requests cannot be forced")
+	}
+	if _, ok := phy.DataRates[p.DataRateIndex]; !ok {
+		return errors.New("data rate index is not defined in the band")
+	}
+	return nil
+}
+
+func (p ForceRejoinParameters) eventData() *structpb.Struct {
+	return &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"rejoin_type":     structpb.NewStringValue(p.RejoinType.String()),
+			"data_rate_index": structpb.NewNumberValue(float64(p.DataRateIndex)),
+			"max_retries":     structpb.NewNumberValue(float64(p.MaxRetries)),
+			"period_exponent": structpb.NewStringValue(p.PeriodExponent.String()),
+		},
+	}
+}
+
+// ForceRejoin is a ForceRejoinReq issued to an end device, which is sent in the downlinks to the end device until
+// the end device rejoins or the request expires.
+type ForceRejoin struct {
+	ForceRejoinParameters
+	// Periodic is true if the request is issued by the periodic rejoin policy of the end device.
+	Periodic bool      `json:"periodic,omitempty"`
+	IssuedAt time.Time `json:"issued_at"`
+	// Transmissions is the number of downlinks in which the request is sent.
+	Transmissions uint32     `json:"transmissions,omitempty"`
+	SentAt        *time.Time `json:"sent_at,omitempty"`
+}
+
+// RejoinPolicy is the periodic rejoin policy of an end device. A ForceRejoinReq is issued to the end device when the
+// interval elapsed since the last rejoin of the end device, or since the policy was set.
+type RejoinPolicy struct {
+	ForceRejoinParameters
+	Interval time.Duration `json:"interval"`
+	SetAt    time.Time     `json:"set_at"`
+}
+
+// RejoinRecord is a rejoin of an end device.
+type RejoinRecord struct {
+	RejoinType   ttnpb.RejoinRequestType `json:"rejoin_type"`
+	SessionKeyID []byte                  `json:"session_key_id"`
+	// Forced is true if the end device rejoined while a ForceRejoinReq was pending.
+	Forced   bool `json:"forced,omitempty"`
+	Periodic bool `json:"periodic,omitempty"`
+	// ReceivedAt is the time at which the rejoin-request is received.
+	ReceivedAt time.Time `json:"received_at"`
+	// CompletedAt is the time at which the end device activated the session of the rejoin.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// RejoinState is the rejoin state of a LoRaWAN 1.1 end device.
+type RejoinState struct {
+	ForceRejoin *ForceRejoin  `json:"force_rejoin,omitempty"`
+	Policy      *RejoinPolicy `json:"policy,omitempty"`
+	// SessionKeyID is the session key ID of the session of LastRejoinCount0.
+	SessionKeyID     []byte  `json:"session_key_id,omitempty"`
+	LastRejoinCount0 *uint32 `json:"last_rejoin_count_0,omitempty"`
+	// PendingRejoin is the rejoin of which the session is not activated yet.
+	PendingRejoin *RejoinRecord `json:"pending_rejoin,omitempty"`
+	LastRejoin    *RejoinRecord `json:"last_rejoin,omitempty"`
+}
+
+// dueForceRejoin returns the ForceRejoinReq to send to the end device at now, if any.
+// Requests issued more than timeout ago have expired.
+func (s *RejoinState) dueForceRejoin(now time.Time, timeout time.Duration) *ForceRejoin {
+	if s.ForceRejoin != nil {
+		if now.Sub(s.ForceRejoin.IssuedAt) > timeout {
+			return nil
+		}
+		return s.ForceRejoin
+	}
+	if s.Policy == nil || s.PendingRejoin != nil {
+		return nil
+	}
+	lastAt := s.Policy.SetAt
+	if s.LastRejoin != nil && s.LastRejoin.CompletedAt != nil && s.LastRejoin.CompletedAt.After(lastAt) {
+		lastAt = *s.LastRejoin.CompletedAt
+	}
+	if now.Before(lastAt.Add(s.Policy.Interval)) {
+		return nil
+	}
+	return &ForceRejoin{
+		ForceRejoinParameters: s.Policy.ForceRejoinParameters,
+		Periodic:              true,
+		IssuedAt:              now,
+	}
+}
+
+// RejoinRegistry stores the rejoin state of LoRaWAN 1.1 end devices.
+type RejoinRegistry interface {
+	// Get returns the state of the end device, or nil if it has no state.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*RejoinState, error)
+	// Set sets the state of the end device, and indexes the end device by the DevEUI in ids.
+	// A nil state deletes the state.
+	Set(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, state *RejoinState) error
+	// GetByDevEUI returns the identifiers of the end device with a state indexed by devEUI, or nil if there is none.
+	GetByDevEUI(ctx context.Context, devEUI types.EUI64) (*ttnpb.EndDeviceIdentifiers, error)
+}
+
+type rejoin struct {
+	RejoinConfig
+}
+
+func newRejoin(conf RejoinConfig) (*rejoin, error) {
+	if conf.Registry == nil {
+		return nil, errInvalidConfiguration.WithCause(errors.New("Rejoin.Registry is not specified"))
+	}
+	if conf.ForceRejoinTimeout <= 0 {
+		return nil, errInvalidConfiguration.WithCause(errors.New("Rejoin.ForceRejoinTimeout must be positive"))
+	}
+	return &rejoin{
+		RejoinConfig: conf,
+	}, nil
+}
+
+func (ns *NetworkServer) rejoinState(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*RejoinState, error) {
+	state, err := ns.rejoin.Registry.Get(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		state = &RejoinState{}
+	}
+	return state, nil
+}
+
+// rejoinDevice returns the end device of the rejoin-request pld with the given paths.
+// Type 0 and 2 rejoin-requests do not contain the JoinEUI, so the end device is looked up by the DevEUI index of the
+// rejoin states instead.
+func (ns *NetworkServer) rejoinDevice(
+	ctx context.Context, pld *ttnpb.RejoinRequestPayload, paths []string,
+) (*ttnpb.EndDevice, context.Context, error) {
+	devEUI := types.MustEUI64(pld.DevEui).OrZero()
+	if pld.RejoinType == ttnpb.RejoinRequestType_SESSION {
+		return ns.devices.GetByEUI(ctx, types.MustEUI64(pld.JoinEui).OrZero(), devEUI, paths)
+	}
+	ids, err := ns.rejoin.Registry.GetByDevEUI(ctx, devEUI)
+	if err != nil {
+		return nil, ctx, err
+	}
+	if ids == nil {
+		return nil, ctx, errDeviceNotFound.New()
+	}
+	return ns.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, paths)
+}
+
+// verifyRejoinRequest verifies the NetID, the MIC and the RJcount0 of the type 0 or 2 rejoin-request up of the current
+// session of dev, and records RJcount0 in state.
+func (ns *NetworkServer) verifyRejoinRequest(
+	ctx context.Context, dev *ttnpb.EndDevice, state *RejoinState, up *ttnpb.UplinkMessage,
+) error {
+	pld := up.Payload.GetRejoinRequestPayload()
+	if netID := types.MustNetID(pld.NetId).OrZero(); !netID.Equal(ns.netID(ctx)) {
+		return errRejoinNetIDMismatch.WithAttributes("net_id", netID)
+	}
+	if dev.GetSession().GetKeys().GetSNwkSIntKey() == nil {
+		return errNoRejoinSession.New()
+	}
+	key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.Keys.SNwkSIntKey, ns.KeyService())
+	if err != nil {
+		return err
+	}
+	n := len(up.RawPayload) - 4
+	mic, err := crypto.ComputeRejoinRequestMIC(key, up.RawPayload[:n])
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(mic[:], up.RawPayload[n:]) {
+		return errRejoinMICMismatch.New()
+	}
+	if bytes.Equal(state.SessionKeyID, dev.Session.Keys.SessionKeyId) &&
+		state.LastRejoinCount0 != nil && pld.RejoinCnt <= *state.LastRejoinCount0 {
+		return errRejoinCountReplay.WithAttributes("rejoin_count", pld.RejoinCnt)
+	}
+	rejoinCount := pld.RejoinCnt
+	state.SessionKeyID, state.LastRejoinCount0 = dev.Session.Keys.SessionKeyId, &rejoinCount
+	return nil
+}
+
+// recordRejoin records the rejoin of the end device with the session key ID of the requested join-accept in state,
+// and stores state.
+func (ns *NetworkServer) recordRejoin(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	state *RejoinState,
+	pld *ttnpb.RejoinRequestPayload,
+	sessionKeyID []byte,
+	up *ttnpb.UplinkMessage,
+) {
+	record := &RejoinRecord{
+		RejoinType:   pld.RejoinType,
+		SessionKeyID: sessionKeyID,
+		ReceivedAt:   *ttnpb.StdTime(up.ReceivedAt),
+	}
+	if f := state.ForceRejoin; f != nil {
+		record.Forced, record.Periodic = true, f.Periodic
+	}
+	state.ForceRejoin = nil
+	state.PendingRejoin = record
+	if err := ns.rejoin.Registry.Set(ctx, ids, state); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to set rejoin state")
+	}
+}
+
+// handleRejoinSessionActivation records the activation of the session of the LoRaWAN 1.1 end device dev, and
+// publishes the completion of the rejoin if the session is the session of a rejoin.
+// The end device is indexed by DevEUI on every session activation, so that it can rejoin with type 0 and 2
+// rejoin-requests.
+func (ns *NetworkServer) handleRejoinSessionActivation(ctx context.Context, dev *ttnpb.EndDevice) {
+	if ns.rejoin == nil ||
+		!dev.SupportsJoin ||
+		!macspec.UseNwkKey(dev.LorawanVersion) ||
+		dev.GetSession().GetKeys() == nil {
+		return
+	}
+	logger := log.FromContext(ctx)
+	state, err := ns.rejoinState(ctx, dev.Ids)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get rejoin state")
+		return
+	}
+	if pending := state.PendingRejoin; pending != nil && bytes.Equal(pending.SessionKeyID, dev.Session.Keys.SessionKeyId) {
+		now := time.Now()
+		pending.CompletedAt = &now
+		state.LastRejoin = pending
+		logger.WithField("rejoin_type", pending.RejoinType).Info("Rejoin completed")
+		events.Publish(evtCompleteRejoin.NewWithIdentifiersAndData(ctx, dev.Ids, &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"rejoin_type": structpb.NewStringValue(pending.RejoinType.String()),
+				"forced":      structpb.NewBoolValue(pending.Forced),
+				"periodic":    structpb.NewBoolValue(pending.Periodic),
+			},
+		}))
+	}
+	state.PendingRejoin = nil
+	if err := ns.rejoin.Registry.Set(ctx, dev.Ids, state); err != nil {
+		logger.WithError(err).Warn("Failed to set rejoin state")
+	}
+}
+
+// dueForceRejoinReq returns the ForceRejoinReq the Network Server needs to send to dev, if any.
+func (ns *NetworkServer) dueForceRejoinReq(ctx context.Context, dev *ttnpb.EndDevice) *ttnpb.MACCommand_ForceRejoinReq {
+	if ns.rejoin == nil ||
+		dev.GetMulticast() ||
+		dev.GetMacState() == nil ||
+		!macspec.UseNwkKey(dev.MacState.LorawanVersion) {
+		return nil
+	}
+	state, err := ns.rejoin.Registry.Get(ctx, dev.Ids)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get rejoin state")
+		return nil
+	}
+	if state == nil {
+		return nil
+	}
+	f := state.dueForceRejoin(time.Now(), ns.rejoin.ForceRejoinTimeout)
+	if f == nil {
+		return nil
+	}
+	return f.request()
+}
+
+// recordForceRejoinTransmission records the transmission of the ForceRejoinReq to the end device.
+// The ForceRejoinReq of the periodic rejoin policy of the end device is issued on its first transmission.
+func (ns *NetworkServer) recordForceRejoinTransmission(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) {
+	logger := log.FromContext(ctx)
+	state, err := ns.rejoinState(ctx, ids)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get rejoin state")
+		return
+	}
+	now := time.Now()
+	f := state.dueForceRejoin(now, ns.rejoin.ForceRejoinTimeout)
+	if f == nil {
+		return
+	}
+	if state.ForceRejoin == nil {
+		state.ForceRejoin = f
+		data := f.eventData()
+		data.Fields["periodic"] = structpb.NewBoolValue(true)
+		events.Publish(evtForceRejoin.NewWithIdentifiersAndData(ctx, ids, data))
+	}
+	f.Transmissions++
+	f.SentAt = &now
+	if err := ns.rejoin.Registry.Set(ctx, ids, state); err != nil {
+		logger.WithError(err).Warn("Failed to set rejoin state")
+	}
+}
+
+// containsForceRejoinReq returns true iff cmds contains a ForceRejoinReq.
+func containsForceRejoinReq(cmds ...*ttnpb.MACCommand) bool {
+	for _, cmd := range cmds {
+		if cmd.Cid == ttnpb.MACCommandIdentifier_CID_FORCE_REJOIN {
+			return true
+		}
+	}
+	return false
+}
+
+// rejoinDeviceBand returns the band of the end device, if the end device supports rejoin-requests.
+func (ns *NetworkServer) rejoinDeviceBand(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDevice, *band.Band, error) {
+	dev, ctx, err := ns.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"multicast",
+		"supports_join",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if dev.Multicast || !dev.SupportsJoin || !macspec.UseNwkKey(dev.LorawanVersion) {
+		return nil, nil, errRejoinNotSupported.New()
+	}
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	phy, err := DeviceBand(dev, fps)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dev, phy, nil
+}
+
+// issueForceRejoin issues a ForceRejoinReq with the given parameters to the end device.
+func (ns *NetworkServer) issueForceRejoin(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, params ForceRejoinParameters,
+) (*RejoinState, error) {
+	dev, phy, err := ns.rejoinDeviceBand(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := params.validate(phy); err != nil {
+		return nil, errInvalidForceRejoin.WithCause(err)
+	}
+	state, err := ns.rejoinState(ctx, dev.Ids)
+	if err != nil {
+		return nil, err
+	}
+	state.ForceRejoin = &ForceRejoin{
+		ForceRejoinParameters: params,
+		IssuedAt:              time.Now(),
+	}
+	if err := ns.rejoin.Registry.Set(ctx, dev.Ids, state); err != nil {
+		return nil, err
+	}
+	events.Publish(evtForceRejoin.NewWithIdentifiersAndData(ctx, dev.Ids, params.eventData()))
+	return state, nil
+}
+
+func forceRejoinParameters(req *ttnpb.MACCommand_ForceRejoinReq) ForceRejoinParameters {
+	return ForceRejoinParameters{
+		RejoinType:     req.RejoinType,
+		DataRateIndex:  req.DataRateIndex,
+		MaxRetries:     req.MaxRetries,
+		PeriodExponent: req.PeriodExponent,
+	}
+}
+
+func (f *ForceRejoin) proto() *ttnpb.EndDeviceRejoin_ForceRejoin {
+	if f == nil {
+		return nil
+	}
+	return &ttnpb.EndDeviceRejoin_ForceRejoin{
+		ForceRejoinReq: f.request(),
+		Periodic:       f.Periodic,
+		IssuedAt:       timestamppb.New(f.IssuedAt),
+		Transmissions:  f.Transmissions,
+		SentAt:         ttnpb.ProtoTime(f.SentAt),
+	}
+}
+
+func (p *RejoinPolicy) proto() *ttnpb.EndDeviceRejoin_Policy {
+	if p == nil {
+		return nil
+	}
+	return &ttnpb.EndDeviceRejoin_Policy{
+		ForceRejoinReq: p.request(),
+		Interval:       durationpb.New(p.Interval),
+		SetAt:          timestamppb.New(p.SetAt),
+	}
+}
+
+func (r *RejoinRecord) proto() *ttnpb.EndDeviceRejoin_Rejoin {
+	if r == nil {
+		return nil
+	}
+	return &ttnpb.EndDeviceRejoin_Rejoin{
+		RejoinType:  r.RejoinType,
+		Forced:      r.Forced,
+		Periodic:    r.Periodic,
+		ReceivedAt:  timestamppb.New(r.ReceivedAt),
+		CompletedAt: ttnpb.ProtoTime(r.CompletedAt),
+	}
+}
+
+func (s *RejoinState) proto(ids *ttnpb.EndDeviceIdentifiers) *ttnpb.EndDeviceRejoin {
+	return &ttnpb.EndDeviceRejoin{
+		EndDeviceIds:  ids,
+		ForceRejoin:   s.ForceRejoin.proto(),
+		Policy:        s.Policy.proto(),
+		PendingRejoin: s.PendingRejoin.proto(),
+		LastRejoin:    s.LastRejoin.proto(),
+	}
+}
+
+// GetEndDeviceRejoin implements ttnpb.NsServer.
+func (ns *NetworkServer) GetEndDeviceRejoin(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDeviceRejoin, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if ns.rejoin == nil {
+		return nil, errRejoinDisabled.New()
+	}
+	state, err := ns.rejoinState(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return state.proto(ids), nil
+}
+
+// ForceEndDeviceRejoin implements ttnpb.NsServer.
+func (ns *NetworkServer) ForceEndDeviceRejoin(
+	ctx context.Context, req *ttnpb.ForceEndDeviceRejoinRequest,
+) (*ttnpb.EndDeviceRejoin, error) {
+	if err := rights.RequireApplication(
+		ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if ns.rejoin == nil {
+		return nil, errRejoinDisabled.New()
+	}
+	state, err := ns.issueForceRejoin(ctx, req.EndDeviceIds, forceRejoinParameters(req.ForceRejoinReq))
+	if err != nil {
+		return nil, err
+	}
+	return state.proto(req.EndDeviceIds), nil
+}
+
+// CancelEndDeviceForceRejoin implements ttnpb.NsServer.
+func (ns *NetworkServer) CancelEndDeviceForceRejoin(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDeviceRejoin, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if ns.rejoin == nil {
+		return nil, errRejoinDisabled.New()
+	}
+	dev, _, err := ns.rejoinDeviceBand(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	state, err := ns.rejoinState(ctx, dev.Ids)
+	if err != nil {
+		return nil, err
+	}
+	if state.ForceRejoin == nil {
+		return nil, errNoForceRejoin.New()
+	}
+	state.ForceRejoin = nil
+	if err := ns.rejoin.Registry.Set(ctx, dev.Ids, state); err != nil {
+		return nil, err
+	}
+	return state.proto(ids), nil
+}
+
+// ForceEndDevicesRejoin implements ttnpb.NsServer.
+func (ns *NetworkServer) ForceEndDevicesRejoin(
+	ctx context.Context, req *ttnpb.ForceEndDevicesRejoinRequest,
+) (*ttnpb.ForceEndDevicesRejoinResponse, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if ns.rejoin == nil {
+		return nil, errRejoinDisabled.New()
+	}
+	params := forceRejoinParameters(req.ForceRejoinReq)
+	res := &ttnpb.ForceEndDevicesRejoinResponse{
+		Results: make([]*ttnpb.ForceEndDevicesRejoinResponse_Result, 0, len(req.DeviceIds)),
+	}
+	for _, devID := range req.DeviceIds {
+		result := &ttnpb.ForceEndDevicesRejoinResponse_Result{DeviceId: devID}
+		if _, err := ns.issueForceRejoin(ctx, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: req.ApplicationIds,
+			DeviceId:       devID,
+		}, params); err != nil {
+			if ttnErr, ok := errors.From(err); ok {
+				result.Error = ttnpb.ErrorDetailsToProto(ttnErr)
+			}
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+// SetEndDeviceRejoinPolicy implements ttnpb.NsServer.
+func (ns *NetworkServer) SetEndDeviceRejoinPolicy(
+	ctx context.Context, req *ttnpb.SetEndDeviceRejoinPolicyRequest,
+) (*ttnpb.EndDeviceRejoin, error) {
+	if err := rights.RequireApplication(
+		ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if ns.rejoin == nil {
+		return nil, errRejoinDisabled.New()
+	}
+	dev, phy, err := ns.rejoinDeviceBand(ctx, req.EndDeviceIds)
+	if err != nil {
+		return nil, err
+	}
+	params := forceRejoinParameters(req.ForceRejoinReq)
+	if err := params.validate(phy); err != nil {
+		return nil, errInvalidRejoinPolicy.WithCause(err)
+	}
+	state, err := ns.rejoinState(ctx, dev.Ids)
+	if err != nil {
+		return nil, err
+	}
+	state.Policy = &RejoinPolicy{
+		ForceRejoinParameters: params,
+		Interval:              req.Interval.AsDuration(),
+		SetAt:                 time.Now(),
+	}
+	if err := ns.rejoin.Registry.Set(ctx, dev.Ids, state); err != nil {
+		return nil, err
+	}
+	return state.proto(req.EndDeviceIds), nil
+}
+
+// DeleteEndDeviceRejoinPolicy implements ttnpb.NsServer.
+func (ns *NetworkServer) DeleteEndDeviceRejoinPolicy(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDeviceRejoin, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if ns.rejoin == nil {
+		return nil, errRejoinDisabled.New()
+	}
+	dev, _, err := ns.rejoinDeviceBand(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	state, err := ns.rejoinState(ctx, dev.Ids)
+	if err != nil {
+		return nil, err
+	}
+	state.Policy = nil
+	if err := ns.rejoin.Registry.Set(ctx, dev.Ids, state); err != nil {
+		return nil, err
+	}
+	return state.proto(ids), nil
+}
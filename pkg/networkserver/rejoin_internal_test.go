@@ -0,0 +1,203 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDueForceRejoin(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	params := ForceRejoinParameters{
+		RejoinType:    ttnpb.RejoinRequestType_KEYS,
+		DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_2,
+		MaxRetries:    2,
+	}
+	issued := &ForceRejoin{
+		ForceRejoinParameters: params,
+		IssuedAt:              now.Add(-time.Hour),
+	}
+	policy := &RejoinPolicy{
+		ForceRejoinParameters: params,
+		Interval:              24 * time.Hour,
+		SetAt:                 now.Add(-48 * time.Hour),
+	}
+	completedAt := now.Add(-time.Hour)
+	for _, tc := range []struct {
+		Name     string
+		State    *RejoinState
+		Expected *ForceRejoin
+	}{
+		{
+			Name:  "no state",
+			State: &RejoinState{},
+		},
+		{
+			Name: "issued",
+			State: &RejoinState{
+				ForceRejoin: issued,
+			},
+			Expected: issued,
+		},
+		{
+			Name: "expired",
+			State: &RejoinState{
+				ForceRejoin: &ForceRejoin{
+					ForceRejoinParameters: params,
+					IssuedAt:              now.Add(-25 * time.Hour),
+				},
+			},
+		},
+		{
+			Name: "policy due",
+			State: &RejoinState{
+				Policy: policy,
+			},
+			Expected: &ForceRejoin{
+				ForceRejoinParameters: params,
+				Periodic:              true,
+				IssuedAt:              now,
+			},
+		},
+		{
+			Name: "policy not due after rejoin",
+			State: &RejoinState{
+				Policy: policy,
+				LastRejoin: &RejoinRecord{
+					RejoinType:  ttnpb.RejoinRequestType_KEYS,
+					ReceivedAt:  completedAt,
+					CompletedAt: &completedAt,
+				},
+			},
+		},
+		{
+			Name: "policy with pending rejoin",
+			State: &RejoinState{
+				Policy: policy,
+				PendingRejoin: &RejoinRecord{
+					RejoinType: ttnpb.RejoinRequestType_KEYS,
+					ReceivedAt: now.Add(-time.Minute),
+				},
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				a.So(tc.State.dueForceRejoin(now, 24*time.Hour), should.Resemble, tc.Expected)
+			},
+		})
+	}
+}
+
+func TestForceRejoinParametersValidate(t *testing.T) {
+	a := assertions.New(t)
+	phy := &band.EU_863_870_RP1_V1_0_2_Rev_B
+	a.So(ForceRejoinParameters{
+		RejoinType:    ttnpb.RejoinRequestType_CONTEXT,
+		DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5,
+		MaxRetries:    7,
+	}.validate(phy), should.BeNil)
+	a.So(ForceRejoinParameters{
+		RejoinType: ttnpb.RejoinRequestType_SESSION,
+	}.validate(phy), should.NotBeNil)
+	a.So(ForceRejoinParameters{
+		RejoinType:    ttnpb.RejoinRequestType_KEYS,
+		DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_15,
+	}.validate(phy), should.NotBeNil)
+	a.So(ForceRejoinParameters{
+		RejoinType: ttnpb.RejoinRequestType_KEYS,
+		MaxRetries: 8,
+	}.validate(phy), should.NotBeNil)
+}
+
+func TestRejoinStateProto(t *testing.T) {
+	a := assertions.New(t)
+	now := time.Unix(1700000000, 0).UTC()
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	params := ForceRejoinParameters{
+		RejoinType:    ttnpb.RejoinRequestType_KEYS,
+		DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_2,
+		MaxRetries:    2,
+	}
+	sentAt := now.Add(-time.Minute)
+	completedAt := now.Add(-time.Hour)
+	a.So((&RejoinState{}).proto(ids), should.Resemble, &ttnpb.EndDeviceRejoin{EndDeviceIds: ids})
+	a.So((&RejoinState{
+		ForceRejoin: &ForceRejoin{
+			ForceRejoinParameters: params,
+			IssuedAt:              now.Add(-time.Hour),
+			Transmissions:         1,
+			SentAt:                &sentAt,
+		},
+		Policy: &RejoinPolicy{
+			ForceRejoinParameters: params,
+			Interval:              24 * time.Hour,
+			SetAt:                 now.Add(-48 * time.Hour),
+		},
+		SessionKeyID: []byte{0x01},
+		LastRejoin: &RejoinRecord{
+			RejoinType:   ttnpb.RejoinRequestType_KEYS,
+			SessionKeyID: []byte{0x02},
+			Forced:       true,
+			Periodic:     true,
+			ReceivedAt:   now.Add(-2 * time.Hour),
+			CompletedAt:  &completedAt,
+		},
+	}).proto(ids), should.Resemble, &ttnpb.EndDeviceRejoin{
+		EndDeviceIds: ids,
+		ForceRejoin: &ttnpb.EndDeviceRejoin_ForceRejoin{
+			ForceRejoinReq: &ttnpb.MACCommand_ForceRejoinReq{
+				RejoinType:    ttnpb.RejoinRequestType_KEYS,
+				DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_2,
+				MaxRetries:    2,
+			},
+			IssuedAt:      timestamppb.New(now.Add(-time.Hour)),
+			Transmissions: 1,
+			SentAt:        timestamppb.New(sentAt),
+		},
+		Policy: &ttnpb.EndDeviceRejoin_Policy{
+			ForceRejoinReq: &ttnpb.MACCommand_ForceRejoinReq{
+				RejoinType:    ttnpb.RejoinRequestType_KEYS,
+				DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_2,
+				MaxRetries:    2,
+			},
+			Interval: durationpb.New(24 * time.Hour),
+			SetAt:    timestamppb.New(now.Add(-48 * time.Hour)),
+		},
+		LastRejoin: &ttnpb.EndDeviceRejoin_Rejoin{
+			RejoinType:  ttnpb.RejoinRequestType_KEYS,
+			Forced:      true,
+			Periodic:    true,
+			ReceivedAt:  timestamppb.New(now.Add(-2 * time.Hour)),
+			CompletedAt: timestamppb.New(completedAt),
+		},
+	})
+}
@@ -0,0 +1,839 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxRelayUplinkForwardingRules is the maximum number of uplink forwarding rules of a relay.
+const maxRelayUplinkForwardingRules = 16
+
+var (
+	errRelayUplinkForwardingRulesExhausted = errors.DefineResourceExhausted(
+		"relay_uplink_forwarding_rules_exhausted", "relay `{device_id}` has no free uplink forwarding rule",
+	)
+	errRelayAssignmentServing = errors.DefineFailedPrecondition(
+		"relay_assignment_serving", "end device is a relay and cannot be assigned to a relay",
+	)
+	errNoRelayAssignmentSuggestion = errors.DefineNotFound(
+		"no_relay_assignment_suggestion", "no relay assignment suggestion",
+	)
+	errRelayAssignmentDisabled = errors.DefineFailedPrecondition(
+		"relay_assignment_disabled", "relay assignment is disabled",
+	)
+	errRelayAssignmentAction = errors.DefineInvalidArgument(
+		"relay_assignment_action", "invalid relay assignment action `{action}`",
+	)
+
+	evtSuggestRelayAssignment = events.Define(
+		"ns.relay.assign.suggest", "suggest assignment to relay",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.EndDeviceIdentifiers{}),
+	)
+	evtAssignRelay = events.Define(
+		"ns.relay.assign", "assign to relay",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.EndDeviceIdentifiers{}),
+	)
+	evtSuggestRelayRelease = events.Define(
+		"ns.relay.release.suggest", "suggest release from relay",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.EndDeviceIdentifiers{}),
+	)
+	evtReleaseRelay = events.Define(
+		"ns.relay.release", "release from relay",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.EndDeviceIdentifiers{}),
+	)
+)
+
+// RelayAssignmentAction is the action of a relay assignment suggestion.
+type RelayAssignmentAction string
+
+const (
+	// RelayAssignmentAssign assigns the end device to the relay.
+	RelayAssignmentAssign RelayAssignmentAction = "assign"
+	// RelayAssignmentRelease releases the end device from the relay.
+	RelayAssignmentRelease RelayAssignmentAction = "release"
+)
+
+// Reasons of relay assignment suggestions.
+const (
+	RelayAssignmentReasonRelayed        = "relayed"
+	RelayAssignmentReasonPoorLink       = "poor_link"
+	RelayAssignmentReasonDirectCoverage = "direct_coverage"
+)
+
+// RelayAssignmentSuggestion is an assignment to or a release from a relay.
+type RelayAssignmentSuggestion struct {
+	Action          RelayAssignmentAction `json:"action"`
+	ServingDeviceID string                `json:"serving_device_id"`
+	Reason          string                `json:"reason"`
+	SuggestedAt     time.Time             `json:"suggested_at"`
+}
+
+// RelayAssignmentState is the relay assignment state of an end device.
+type RelayAssignmentState struct {
+	// RelayedUplinks is the number of consecutive uplinks which were only received through relays.
+	RelayedUplinks uint32 `json:"relayed_uplinks,omitempty"`
+	// PoorUplinks is the number of consecutive direct uplinks with a poor link.
+	PoorUplinks uint32 `json:"poor_uplinks,omitempty"`
+	// CoveredUplinks is the number of consecutive direct uplinks with direct coverage.
+	CoveredUplinks uint32 `json:"covered_uplinks,omitempty"`
+	// ServingDeviceID is the relay to which the end device has been assigned automatically.
+	// Only automatic assignments are released automatically.
+	ServingDeviceID string `json:"serving_device_id,omitempty"`
+	// Suggestion is the assignment or release which awaits approval.
+	Suggestion *RelayAssignmentSuggestion `json:"suggestion,omitempty"`
+}
+
+// GetSuggestion returns the suggestion of the state, or nil if the state is nil.
+func (s *RelayAssignmentState) GetSuggestion() *RelayAssignmentSuggestion {
+	if s == nil {
+		return nil
+	}
+	return s.Suggestion
+}
+
+// RelayCoverage is the coverage of a relay by the gateways which received its most recent uplink.
+type RelayCoverage struct {
+	GatewayIDs []string  `json:"gateway_ids"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// RelayAssignmentRegistry stores the relay assignment state of end devices and the coverage of relays.
+type RelayAssignmentRegistry interface {
+	// Get returns the relay assignment state of the end device, or nil if there is none.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*RelayAssignmentState, error)
+	// Set stores the relay assignment state of the end device, which expires after ttl.
+	// A nil state deletes the state. A ttl of 0 means that the state does not expire.
+	Set(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, state *RelayAssignmentState, ttl time.Duration) error
+	// SetRelayCoverage stores the coverage of the relay. The coverage of the relays of an application expires
+	// after ttl without updates. A ttl of 0 means that the coverage does not expire.
+	SetRelayCoverage(
+		ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, coverage *RelayCoverage, ttl time.Duration,
+	) error
+	// RelayCoverage returns the coverage of the relays of the application by relay device ID.
+	RelayCoverage(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (map[string]*RelayCoverage, error)
+}
+
+// relayAssignment assigns end devices to relays and releases them.
+type relayAssignment struct {
+	RelayAssignmentConfig
+	applications map[string]struct{}
+}
+
+func newRelayAssignment(conf RelayAssignmentConfig) (*relayAssignment, error) {
+	switch {
+	case conf.Registry == nil:
+		return nil, errInvalidConfiguration.WithCause(errors.New("RelayAssignment.Registry is not specified"))
+	case conf.AssignUplinks == 0:
+		return nil, errInvalidConfiguration.WithCause(errors.New("RelayAssignment.AssignUplinks is zero"))
+	case conf.ReleaseUplinks == 0:
+		return nil, errInvalidConfiguration.WithCause(errors.New("RelayAssignment.ReleaseUplinks is zero"))
+	case conf.ReleaseMargin <= conf.PoorLinkMargin:
+		return nil, errInvalidConfiguration.WithCause(
+			errors.New("RelayAssignment.ReleaseMargin is not greater than RelayAssignment.PoorLinkMargin"),
+		)
+	}
+	ra := &relayAssignment{
+		RelayAssignmentConfig: conf,
+		applications:          make(map[string]struct{}, len(conf.Applications)),
+	}
+	for _, appID := range conf.Applications {
+		if err := (&ttnpb.ApplicationIdentifiers{ApplicationId: appID}).ValidateFields(); err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+		ra.applications[appID] = struct{}{}
+	}
+	return ra, nil
+}
+
+func (ra *relayAssignment) enabled(ids *ttnpb.ApplicationIdentifiers) bool {
+	if len(ra.applications) == 0 {
+		return true
+	}
+	_, ok := ra.applications[ids.GetApplicationId()]
+	return ok
+}
+
+// relayUplinkObservation is the observation of a data uplink for relay assignment.
+type relayUplinkObservation struct {
+	// RelayIDs are the device IDs of the relays which forwarded the uplink, sorted.
+	RelayIDs []string
+	// GatewayIDs are the gateways which received the uplink directly.
+	GatewayIDs []string
+	// Margin is the best SNR margin above the demodulation floor of the direct receptions.
+	// It is nil if the uplink is not received directly or is not LoRa modulated.
+	Margin *float32
+}
+
+func observeRelayUplink(up *ttnpb.UplinkMessage) relayUplinkObservation {
+	var (
+		obs                relayUplinkObservation
+		seenRelays, seenGW = make(map[string]struct{}), make(map[string]struct{})
+	)
+	floor, isLoRa := mac.DemodulationFloor(up.GetSettings().GetDataRate().GetLora())
+	for _, md := range up.RxMetadata {
+		if relay := md.GetRelay(); relay != nil {
+			if _, ok := seenRelays[relay.DeviceId]; !ok && relay.DeviceId != "" {
+				seenRelays[relay.DeviceId] = struct{}{}
+				obs.RelayIDs = append(obs.RelayIDs, relay.DeviceId)
+			}
+			continue
+		}
+		if isLoRa {
+			if margin := md.Snr - floor; obs.Margin == nil || margin > *obs.Margin {
+				obs.Margin = &margin
+			}
+		}
+		gtwID := md.GetGatewayIds().GetGatewayId()
+		if _, ok := seenGW[gtwID]; !ok && gtwID != "" {
+			seenGW[gtwID] = struct{}{}
+			obs.GatewayIDs = append(obs.GatewayIDs, gtwID)
+		}
+	}
+	sort.Strings(obs.RelayIDs)
+	return obs
+}
+
+// next updates the state with the observation of an uplink of an end device which is served by the relay
+// servingDeviceID, if any, and returns the suggested assignment or release.
+// Assignments because of poor links do not have a relay, which must be selected by the caller.
+func (ra *relayAssignment) next(
+	state *RelayAssignmentState, servingDeviceID string, obs relayUplinkObservation, now time.Time,
+) *RelayAssignmentSuggestion {
+	if state.ServingDeviceID != servingDeviceID {
+		// The relay of the end device has been changed by other means.
+		state.ServingDeviceID = ""
+	}
+	suggest := func(action RelayAssignmentAction, servingDeviceID, reason string) *RelayAssignmentSuggestion {
+		state.RelayedUplinks, state.PoorUplinks, state.CoveredUplinks = 0, 0, 0
+		return &RelayAssignmentSuggestion{
+			Action:          action,
+			ServingDeviceID: servingDeviceID,
+			Reason:          reason,
+			SuggestedAt:     now,
+		}
+	}
+	if len(obs.GatewayIDs) == 0 && len(obs.RelayIDs) > 0 {
+		state.PoorUplinks, state.CoveredUplinks = 0, 0
+		for _, relayID := range obs.RelayIDs {
+			if relayID == servingDeviceID {
+				state.RelayedUplinks = 0
+				return nil
+			}
+		}
+		if state.RelayedUplinks++; state.RelayedUplinks < ra.AssignUplinks {
+			return nil
+		}
+		return suggest(RelayAssignmentAssign, obs.RelayIDs[0], RelayAssignmentReasonRelayed)
+	}
+	state.RelayedUplinks = 0
+	switch {
+	case obs.Margin == nil:
+	case *obs.Margin < ra.PoorLinkMargin:
+		state.CoveredUplinks = 0
+		if servingDeviceID != "" {
+			state.PoorUplinks = 0
+			return nil
+		}
+		if state.PoorUplinks++; state.PoorUplinks < ra.AssignUplinks {
+			return nil
+		}
+		return suggest(RelayAssignmentAssign, "", RelayAssignmentReasonPoorLink)
+	case *obs.Margin >= ra.ReleaseMargin:
+		state.PoorUplinks = 0
+		if state.ServingDeviceID == "" {
+			state.CoveredUplinks = 0
+			return nil
+		}
+		if state.CoveredUplinks++; state.CoveredUplinks < ra.ReleaseUplinks {
+			return nil
+		}
+		return suggest(RelayAssignmentRelease, state.ServingDeviceID, RelayAssignmentReasonDirectCoverage)
+	default:
+		state.PoorUplinks, state.CoveredUplinks = 0, 0
+	}
+	return nil
+}
+
+// selectRelay returns the relay which shares the most gateways with gatewayIDs, or an empty string if no relay
+// shares a gateway. Coverage which is older than maxAge is ignored. A maxAge of 0 means that coverage does not expire.
+// Ties are broken by the device ID of the relay.
+func selectRelay(
+	coverage map[string]*RelayCoverage, gatewayIDs []string, exclude string, now time.Time, maxAge time.Duration,
+) string {
+	gateways := make(map[string]struct{}, len(gatewayIDs))
+	for _, gtwID := range gatewayIDs {
+		gateways[gtwID] = struct{}{}
+	}
+	var (
+		best       string
+		bestShared int
+	)
+	for relayID, c := range coverage {
+		if relayID == exclude || maxAge > 0 && now.Sub(c.UpdatedAt) > maxAge {
+			continue
+		}
+		var shared int
+		for _, gtwID := range c.GatewayIDs {
+			if _, ok := gateways[gtwID]; ok {
+				shared++
+			}
+		}
+		if shared == 0 {
+			continue
+		}
+		if shared > bestShared || shared == bestShared && relayID < best {
+			best, bestShared = relayID, shared
+		}
+	}
+	return best
+}
+
+// relayAssignmentUplink is a deduplicated data uplink of an end device which is handled for relay assignment.
+type relayAssignmentUplink struct {
+	ids *ttnpb.EndDeviceIdentifiers
+	// serving is true if the end device is a relay.
+	serving bool
+	// servingDeviceID is the relay which serves the end device, if any.
+	servingDeviceID string
+	obs             relayUplinkObservation
+	receivedAt      time.Time
+}
+
+// handleRelayAssignment enqueues the deduplicated data uplink up of dev for relay assignment, if enabled.
+// The uplink is handled asynchronously by handleRelayAssignmentUplink. If the queue is full, the uplink is skipped.
+func (ns *NetworkServer) handleRelayAssignment(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) {
+	ra := ns.relayAssignment
+	if ra == nil || !ra.enabled(dev.Ids.ApplicationIds) {
+		return
+	}
+	item := &relayAssignmentUplink{
+		ids:             dev.Ids,
+		serving:         dev.MacSettings.GetDesiredRelay().GetServing() != nil,
+		servingDeviceID: dev.MacSettings.GetDesiredRelay().GetServed().GetServingDeviceId(),
+		obs:             observeRelayUplink(up),
+		receivedAt:      time.Now(),
+	}
+	if item.serving && len(item.obs.GatewayIDs) == 0 {
+		return
+	}
+	if err := ns.relayAssignmentPool.Publish(ns.FromRequestContext(ctx), item); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to enqueue uplink for relay assignment")
+	}
+}
+
+// handleRelayAssignmentUplink handles the uplink for relay assignment.
+// The coverage of relays is recorded, and end devices are assigned to relays or released from them.
+func (ns *NetworkServer) handleRelayAssignmentUplink(ctx context.Context, up *relayAssignmentUplink) {
+	ra := ns.relayAssignment
+	logger := log.FromContext(ctx)
+	if up.serving {
+		if err := ra.Registry.SetRelayCoverage(ctx, up.ids, &RelayCoverage{
+			GatewayIDs: up.obs.GatewayIDs,
+			UpdatedAt:  up.receivedAt,
+		}, ra.TTL); err != nil {
+			logger.WithError(err).Warn("Failed to record relay coverage")
+		}
+		return
+	}
+
+	state, err := ra.Registry.Get(ctx, up.ids)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get relay assignment state")
+		return
+	}
+	if state == nil {
+		state = &RelayAssignmentState{}
+	}
+	suggestion := ra.next(state, up.servingDeviceID, up.obs, up.receivedAt)
+	if suggestion != nil && suggestion.ServingDeviceID == "" {
+		coverage, err := ra.Registry.RelayCoverage(ctx, up.ids.ApplicationIds)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get relay coverage")
+			return
+		}
+		suggestion.ServingDeviceID = selectRelay(coverage, up.obs.GatewayIDs, up.ids.DeviceId, up.receivedAt, ra.TTL)
+		if suggestion.ServingDeviceID == "" {
+			logger.Debug("No relay covers end device with poor link")
+			suggestion = nil
+		}
+	}
+	switch {
+	case suggestion == nil:
+	case ra.Approval:
+		if pending := state.Suggestion; pending != nil &&
+			pending.Action == suggestion.Action && pending.ServingDeviceID == suggestion.ServingDeviceID {
+			break
+		}
+		state.Suggestion = suggestion
+		evt := evtSuggestRelayAssignment
+		if suggestion.Action == RelayAssignmentRelease {
+			evt = evtSuggestRelayRelease
+		}
+		events.Publish(evt.NewWithIdentifiersAndData(ctx, up.ids, relayIdentifiers(up.ids, suggestion.ServingDeviceID)))
+	default:
+		if err := ns.applyRelayAssignment(ctx, up.ids, state, suggestion); err != nil {
+			logger.WithError(err).WithFields(log.Fields(
+				"relay_assignment_action", suggestion.Action,
+				"serving_device_id", suggestion.ServingDeviceID,
+			)).Warn("Failed to apply relay assignment")
+		}
+	}
+	if err := ra.Registry.Set(ctx, up.ids, state, ra.TTL); err != nil {
+		logger.WithError(err).Warn("Failed to store relay assignment state")
+	}
+}
+
+func relayIdentifiers(ids *ttnpb.EndDeviceIdentifiers, servingDeviceID string) *ttnpb.EndDeviceIdentifiers {
+	return &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: ids.ApplicationIds,
+		DeviceId:       servingDeviceID,
+	}
+}
+
+// applyRelayAssignment applies the suggestion to the end device and updates the state accordingly.
+func (ns *NetworkServer) applyRelayAssignment(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, state *RelayAssignmentState, s *RelayAssignmentSuggestion,
+) error {
+	evt := evtAssignRelay
+	switch s.Action {
+	case RelayAssignmentAssign:
+		if err := ns.assignRelay(ctx, ids, s.ServingDeviceID); err != nil {
+			return err
+		}
+		state.ServingDeviceID = s.ServingDeviceID
+	case RelayAssignmentRelease:
+		if err := ns.releaseRelay(ctx, ids, s.ServingDeviceID); err != nil {
+			return err
+		}
+		state.ServingDeviceID = ""
+		evt = evtReleaseRelay
+	default:
+		return errRelayAssignmentAction.WithAttributes("action", s.Action)
+	}
+	state.Suggestion = nil
+	log.FromContext(ctx).WithFields(log.Fields(
+		"relay_assignment_action", s.Action,
+		"relay_assignment_reason", s.Reason,
+		"serving_device_id", s.ServingDeviceID,
+	)).Info("Applied relay assignment")
+	events.Publish(evt.NewWithIdentifiersAndData(ctx, ids, relayIdentifiers(ids, s.ServingDeviceID)))
+	return nil
+}
+
+var relayAssignmentServedPaths = []string{
+	"frequency_plan_id",
+	"lorawan_phy_version",
+	"mac_settings.desired_relay",
+	"mac_state.desired_parameters.relay",
+	"pending_mac_state.desired_parameters.relay",
+	"pending_session.keys.session_key_id",
+	"session.keys.session_key_id",
+}
+
+var relayAssignmentServingPaths = []string{
+	"mac_settings.desired_relay",
+	"mac_state.desired_parameters.relay",
+	"pending_mac_state.desired_parameters.relay",
+}
+
+// relayAssignmentRollback is the relay configuration of a served end device before it was assigned to a relay.
+type relayAssignmentRollback struct {
+	settings               *ttnpb.RelaySettings
+	macStateParameters     *ttnpb.RelayParameters
+	pendingStateParameters *ttnpb.RelayParameters
+}
+
+func newRelayAssignmentRollback(dev *ttnpb.EndDevice) *relayAssignmentRollback {
+	return &relayAssignmentRollback{
+		settings:               proto.Clone(dev.MacSettings.GetDesiredRelay()).(*ttnpb.RelaySettings),
+		macStateParameters:     proto.Clone(dev.MacState.GetDesiredParameters().GetRelay()).(*ttnpb.RelayParameters),
+		pendingStateParameters: proto.Clone(dev.PendingMacState.GetDesiredParameters().GetRelay()).(*ttnpb.RelayParameters),
+	}
+}
+
+// assignRelay assigns the end device to the relay servingDeviceID.
+// End devices which are not served by a relay yet are served in dynamic mode, such that they keep sending uplinks
+// directly while they receive downlinks. End devices which are served by another relay keep their served settings,
+// and their uplink forwarding rule is removed from the other relay.
+// The uplink forwarding rule is set in the relay after the end device is updated. If that fails, the update of the
+// end device is rolled back.
+func (ns *NetworkServer) assignRelay(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, servingDeviceID string) error {
+	var (
+		previous     string
+		sessionKeyID []byte
+		rollback     *relayAssignmentRollback
+	)
+	_, ctx, err := ns.devices.SetByID(ctx, ids.ApplicationIds, ids.DeviceId, relayAssignmentServedPaths,
+		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound.New()
+			}
+			if dev.MacSettings.GetDesiredRelay().GetServing() != nil {
+				return nil, nil, errRelayAssignmentServing.New()
+			}
+			rollback = newRelayAssignmentRollback(dev)
+			sessionKeyID = deviceSessionKeyID(dev)
+			var paths []string
+			if served := dev.MacSettings.GetDesiredRelay().GetServed(); served != nil {
+				previous = served.ServingDeviceId
+				served.ServingDeviceId = servingDeviceID
+				paths = []string{"mac_settings.desired_relay.mode.served.serving_device_id"}
+				for path, parameters := range map[string]*ttnpb.ServedRelayParameters{
+					"mac_state.desired_parameters.relay.mode.served.serving_device_id":         dev.MacState.GetDesiredParameters().GetRelay().GetServed(),        // nolint: lll
+					"pending_mac_state.desired_parameters.relay.mode.served.serving_device_id": dev.PendingMacState.GetDesiredParameters().GetRelay().GetServed(), // nolint: lll
+				} {
+					if parameters == nil {
+						continue
+					}
+					parameters.ServingDeviceId = servingDeviceID
+					paths = ttnpb.AddFields(paths, path)
+				}
+				return dev, paths, nil
+			}
+			fps, err := ns.FrequencyPlansStore(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+			phy, err := internal.DeviceBand(dev, fps)
+			if err != nil {
+				return nil, nil, err
+			}
+			settings := &ttnpb.RelaySettings{
+				Mode: &ttnpb.RelaySettings_Served{
+					Served: &ttnpb.ServedRelaySettings{
+						Mode: &ttnpb.ServedRelaySettings_Dynamic{
+							Dynamic: &ttnpb.RelayEndDeviceDynamicMode{},
+						},
+						ServingDeviceId: servingDeviceID,
+					},
+				},
+			}
+			dev.MacSettings = &ttnpb.MACSettings{DesiredRelay: settings}
+			paths = []string{"mac_settings.desired_relay"}
+			for path, desiredParameters := range map[string]*ttnpb.MACParameters{
+				"mac_state.desired_parameters.relay":         dev.MacState.GetDesiredParameters(),
+				"pending_mac_state.desired_parameters.relay": dev.PendingMacState.GetDesiredParameters(),
+			} {
+				if desiredParameters == nil {
+					continue
+				}
+				desiredParameters.Relay = mac.RelayParametersFromRelaySettings(settings, phy)
+				paths = ttnpb.AddFields(paths, path)
+			}
+			return dev, paths, nil
+		},
+	)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to assign end device to relay")
+		return err
+	}
+	if err := ns.setRelayUplinkForwardingRule(
+		ctx, ids.ApplicationIds, servingDeviceID, ids.DeviceId, sessionKeyID,
+	); err != nil {
+		logRegistryRPCError(ctx, err, "Failed to set uplink forwarding rule in relay")
+		if rollbackErr := ns.rollbackRelayAssignment(ctx, ids, servingDeviceID, rollback); rollbackErr != nil {
+			logRegistryRPCError(ctx, rollbackErr, "Failed to roll back relay assignment")
+		}
+		return err
+	}
+	if previous != "" && previous != servingDeviceID {
+		if err := ns.removeRelayUplinkForwardingRule(ctx, ids.ApplicationIds, previous, ids.DeviceId); err != nil {
+			logRegistryRPCError(ctx, err, "Failed to remove uplink forwarding rule from previous relay")
+		}
+	}
+	return nil
+}
+
+// rollbackRelayAssignment restores the relay configuration of the end device before it was assigned to the relay
+// servingDeviceID. If the end device is no longer served by servingDeviceID, it is unchanged.
+func (ns *NetworkServer) rollbackRelayAssignment(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, servingDeviceID string, rollback *relayAssignmentRollback,
+) error {
+	_, _, err := ns.devices.SetByID(ctx, ids.ApplicationIds, ids.DeviceId, relayAssignmentServingPaths,
+		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound.New()
+			}
+			if dev.MacSettings.GetDesiredRelay().GetServed().GetServingDeviceId() != servingDeviceID {
+				return dev, nil, nil
+			}
+			if dev.MacSettings == nil {
+				dev.MacSettings = &ttnpb.MACSettings{}
+			}
+			dev.MacSettings.DesiredRelay = rollback.settings
+			paths := []string{"mac_settings.desired_relay"}
+			for path, v := range map[string]struct {
+				desiredParameters *ttnpb.MACParameters
+				relay             *ttnpb.RelayParameters
+			}{
+				"mac_state.desired_parameters.relay": {
+					dev.MacState.GetDesiredParameters(), rollback.macStateParameters,
+				},
+				"pending_mac_state.desired_parameters.relay": {
+					dev.PendingMacState.GetDesiredParameters(), rollback.pendingStateParameters,
+				},
+			} {
+				if v.desiredParameters == nil {
+					continue
+				}
+				v.desiredParameters.Relay = v.relay
+				paths = ttnpb.AddFields(paths, path)
+			}
+			return dev, paths, nil
+		},
+	)
+	return err
+}
+
+// releaseRelay releases the end device from the relay servingDeviceID.
+// The end device is no longer served by a relay. If the end device is not served by servingDeviceID, it is unchanged.
+func (ns *NetworkServer) releaseRelay(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, servingDeviceID string) error {
+	_, ctx, err := ns.devices.SetByID(ctx, ids.ApplicationIds, ids.DeviceId, relayAssignmentServingPaths,
+		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound.New()
+			}
+			if dev.MacSettings.GetDesiredRelay().GetServed().GetServingDeviceId() != servingDeviceID {
+				return dev, nil, nil
+			}
+			dev.MacSettings.DesiredRelay = nil
+			paths := []string{"mac_settings.desired_relay"}
+			for path, desiredParameters := range map[string]*ttnpb.MACParameters{
+				"mac_state.desired_parameters.relay":         dev.MacState.GetDesiredParameters(),
+				"pending_mac_state.desired_parameters.relay": dev.PendingMacState.GetDesiredParameters(),
+			} {
+				if desiredParameters == nil {
+					continue
+				}
+				desiredParameters.Relay = nil
+				paths = ttnpb.AddFields(paths, path)
+			}
+			return dev, paths, nil
+		},
+	)
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to release end device from relay")
+		return err
+	}
+	return ns.removeRelayUplinkForwardingRule(ctx, ids.ApplicationIds, servingDeviceID, ids.DeviceId)
+}
+
+// updateRelayUplinkForwardingRules updates the uplink forwarding rules of the relay servingDeviceID using f.
+func (ns *NetworkServer) updateRelayUplinkForwardingRules(
+	ctx context.Context,
+	appIDs *ttnpb.ApplicationIdentifiers,
+	servingDeviceID string,
+	f func([]*ttnpb.RelayUplinkForwardingRule) ([]*ttnpb.RelayUplinkForwardingRule, bool, error),
+) error {
+	_, _, err := ns.devices.SetByID(ctx, appIDs, servingDeviceID, relayAssignmentServingPaths,
+		func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound.New()
+			}
+			if dev.MacSettings.GetDesiredRelay() == nil {
+				return nil, nil, errRelayNotFound.New()
+			}
+			servingSettings := dev.MacSettings.DesiredRelay.GetServing()
+			if servingSettings == nil {
+				return nil, nil, errRelayNotServing.New()
+			}
+			rules, changed, err := f(servingSettings.UplinkForwardingRules)
+			if err != nil || !changed {
+				return dev, nil, err
+			}
+			servingSettings.UplinkForwardingRules = rules
+			paths := []string{"mac_settings.desired_relay.mode.serving.uplink_forwarding_rules"}
+			for path, serving := range map[string]*ttnpb.ServingRelayParameters{
+				"mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules":         dev.MacState.GetDesiredParameters().GetRelay().GetServing(),        // nolint: lll
+				"pending_mac_state.desired_parameters.relay.mode.serving.uplink_forwarding_rules": dev.PendingMacState.GetDesiredParameters().GetRelay().GetServing(), // nolint: lll
+			} {
+				if serving == nil {
+					continue
+				}
+				serving.UplinkForwardingRules = rules
+				paths = ttnpb.AddFields(paths, path)
+			}
+			return dev, paths, nil
+		},
+	)
+	return err
+}
+
+// setRelayUplinkForwardingRule sets the uplink forwarding rule of the served end device in the relay.
+// The rule of the served end device is updated if it exists, and otherwise stored in the first free rule.
+func (ns *NetworkServer) setRelayUplinkForwardingRule(
+	ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, servingDeviceID, servedDeviceID string, sessionKeyID []byte,
+) error {
+	return ns.updateRelayUplinkForwardingRules(ctx, appIDs, servingDeviceID,
+		func(rules []*ttnpb.RelayUplinkForwardingRule) ([]*ttnpb.RelayUplinkForwardingRule, bool, error) {
+			free := -1
+			for i, rule := range rules {
+				switch {
+				case rule.GetDeviceId() == servedDeviceID:
+					rule.SessionKeyId = sessionKeyID
+					return rules, true, nil
+				case free < 0 && proto.Equal(rule, emptyRelayUplinkForwardingRule):
+					free = i
+				}
+			}
+			rule := &ttnpb.RelayUplinkForwardingRule{
+				DeviceId:     servedDeviceID,
+				SessionKeyId: sessionKeyID,
+			}
+			switch {
+			case free >= 0:
+				rules[free] = rule
+			case len(rules) < maxRelayUplinkForwardingRules:
+				rules = append(rules, rule)
+			default:
+				return nil, false, errRelayUplinkForwardingRulesExhausted.WithAttributes("device_id", servingDeviceID)
+			}
+			return rules, true, nil
+		},
+	)
+}
+
+// removeRelayUplinkForwardingRule removes the uplink forwarding rule of the served end device from the relay.
+func (ns *NetworkServer) removeRelayUplinkForwardingRule(
+	ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, servingDeviceID, servedDeviceID string,
+) error {
+	return ns.updateRelayUplinkForwardingRules(ctx, appIDs, servingDeviceID,
+		func(rules []*ttnpb.RelayUplinkForwardingRule) ([]*ttnpb.RelayUplinkForwardingRule, bool, error) {
+			var changed bool
+			for i, rule := range rules {
+				if rule.GetDeviceId() == servedDeviceID {
+					rules[i], changed = emptyRelayUplinkForwardingRule, true
+				}
+			}
+			return rules, changed, nil
+		},
+	)
+}
+
+func (s *RelayAssignmentSuggestion) proto() *ttnpb.RelayAssignmentSuggestion {
+	if s == nil {
+		return nil
+	}
+	action := ttnpb.RelayAssignmentAction_RELAY_ASSIGNMENT_ACTION_ASSIGN
+	if s.Action == RelayAssignmentRelease {
+		action = ttnpb.RelayAssignmentAction_RELAY_ASSIGNMENT_ACTION_RELEASE
+	}
+	return &ttnpb.RelayAssignmentSuggestion{
+		Action:          action,
+		ServingDeviceId: s.ServingDeviceID,
+		Reason:          s.Reason,
+		SuggestedAt:     timestamppb.New(s.SuggestedAt),
+	}
+}
+
+func (s *RelayAssignmentState) proto(ids *ttnpb.EndDeviceIdentifiers) *ttnpb.EndDeviceRelayAssignment {
+	pb := &ttnpb.EndDeviceRelayAssignment{EndDeviceIds: ids}
+	if s == nil {
+		return pb
+	}
+	pb.RelayedUplinks = s.RelayedUplinks
+	pb.PoorUplinks = s.PoorUplinks
+	pb.CoveredUplinks = s.CoveredUplinks
+	pb.ServingDeviceId = s.ServingDeviceID
+	pb.Suggestion = s.Suggestion.proto()
+	return pb
+}
+
+// GetEndDeviceRelayAssignment implements ttnpb.NsServer.
+func (ns *NetworkServer) GetEndDeviceRelayAssignment(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDeviceRelayAssignment, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if ns.relayAssignment == nil {
+		return nil, errRelayAssignmentDisabled.New()
+	}
+	state, err := ns.relayAssignment.Registry.Get(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return state.proto(ids), nil
+}
+
+// pendingRelayAssignment returns the state of the end device, which must have a pending suggestion.
+func (ns *NetworkServer) pendingRelayAssignment(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*RelayAssignmentState, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if ns.relayAssignment == nil {
+		return nil, errRelayAssignmentDisabled.New()
+	}
+	state, err := ns.relayAssignment.Registry.Get(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if state.GetSuggestion() == nil {
+		return nil, errNoRelayAssignmentSuggestion.New()
+	}
+	return state, nil
+}
+
+// ApproveEndDeviceRelayAssignment implements ttnpb.NsServer.
+func (ns *NetworkServer) ApproveEndDeviceRelayAssignment(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDeviceRelayAssignment, error) {
+	state, err := ns.pendingRelayAssignment(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := ns.applyRelayAssignment(ctx, ids, state, state.Suggestion); err != nil {
+		return nil, err
+	}
+	if err := ns.relayAssignment.Registry.Set(ctx, ids, state, ns.relayAssignment.TTL); err != nil {
+		return nil, err
+	}
+	return state.proto(ids), nil
+}
+
+// RejectEndDeviceRelayAssignment implements ttnpb.NsServer.
+func (ns *NetworkServer) RejectEndDeviceRelayAssignment(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*emptypb.Empty, error) {
+	state, err := ns.pendingRelayAssignment(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	state.Suggestion = nil
+	if err := ns.relayAssignment.Registry.Set(ctx, ids, state, ns.relayAssignment.TTL); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
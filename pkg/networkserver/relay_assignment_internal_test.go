@@ -0,0 +1,288 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestObserveRelayUplink(t *testing.T) {
+	a, _ := test.New(t)
+
+	obs := observeRelayUplink(&ttnpb.UplinkMessage{
+		Settings: &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{SpreadingFactor: 7, Bandwidth: 125000},
+				},
+			},
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"}, Snr: -3},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"}, Snr: 2},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"}, AntennaIndex: 1, Snr: -1},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-3"}, Snr: 9, Relay: &ttnpb.RelayMetadata{DeviceId: "relay-2"}},
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-3"}, Snr: 9, Relay: &ttnpb.RelayMetadata{DeviceId: "relay-1"}},
+		},
+	})
+	margin := float32(9.5)
+	a.So(obs, should.Resemble, relayUplinkObservation{
+		RelayIDs:   []string{"relay-1", "relay-2"},
+		GatewayIDs: []string{"gtw-1", "gtw-2"},
+		Margin:     &margin,
+	})
+}
+
+func TestRelayAssignmentNext(t *testing.T) {
+	ra := &relayAssignment{
+		RelayAssignmentConfig: RelayAssignmentConfig{
+			AssignUplinks:  2,
+			ReleaseUplinks: 2,
+			PoorLinkMargin: 2,
+			ReleaseMargin:  10,
+		},
+	}
+	now := time.Unix(1700000000, 0).UTC()
+	margin := func(v float32) *float32 { return &v }
+	relayed := relayUplinkObservation{RelayIDs: []string{"relay-1"}}
+	poor := relayUplinkObservation{GatewayIDs: []string{"gtw-1"}, Margin: margin(1)}
+	fair := relayUplinkObservation{GatewayIDs: []string{"gtw-1"}, Margin: margin(5)}
+	covered := relayUplinkObservation{GatewayIDs: []string{"gtw-1"}, Margin: margin(12)}
+
+	for _, tc := range []struct {
+		Name            string
+		State           *RelayAssignmentState
+		ServingDeviceID string
+		Observations    []relayUplinkObservation
+		Expected        *RelayAssignmentSuggestion
+		ExpectedState   *RelayAssignmentState
+	}{
+		{
+			Name:          "relayed below threshold",
+			State:         &RelayAssignmentState{},
+			Observations:  []relayUplinkObservation{relayed},
+			ExpectedState: &RelayAssignmentState{RelayedUplinks: 1},
+		},
+		{
+			Name:         "relayed",
+			State:        &RelayAssignmentState{},
+			Observations: []relayUplinkObservation{relayed, relayed},
+			Expected: &RelayAssignmentSuggestion{
+				Action:          RelayAssignmentAssign,
+				ServingDeviceID: "relay-1",
+				Reason:          RelayAssignmentReasonRelayed,
+				SuggestedAt:     now,
+			},
+			ExpectedState: &RelayAssignmentState{},
+		},
+		{
+			Name:            "relayed by serving relay",
+			State:           &RelayAssignmentState{RelayedUplinks: 1},
+			ServingDeviceID: "relay-1",
+			Observations:    []relayUplinkObservation{relayed, relayed},
+			ExpectedState:   &RelayAssignmentState{},
+		},
+		{
+			Name:         "poor link",
+			State:        &RelayAssignmentState{},
+			Observations: []relayUplinkObservation{poor, poor},
+			Expected: &RelayAssignmentSuggestion{
+				Action:      RelayAssignmentAssign,
+				Reason:      RelayAssignmentReasonPoorLink,
+				SuggestedAt: now,
+			},
+			ExpectedState: &RelayAssignmentState{},
+		},
+		{
+			Name:          "poor link interrupted",
+			State:         &RelayAssignmentState{},
+			Observations:  []relayUplinkObservation{poor, fair, poor},
+			ExpectedState: &RelayAssignmentState{PoorUplinks: 1},
+		},
+		{
+			Name:            "poor link served",
+			State:           &RelayAssignmentState{},
+			ServingDeviceID: "relay-1",
+			Observations:    []relayUplinkObservation{poor, poor},
+			ExpectedState:   &RelayAssignmentState{},
+		},
+		{
+			Name:            "direct coverage",
+			State:           &RelayAssignmentState{ServingDeviceID: "relay-1"},
+			ServingDeviceID: "relay-1",
+			Observations:    []relayUplinkObservation{covered, covered},
+			Expected: &RelayAssignmentSuggestion{
+				Action:          RelayAssignmentRelease,
+				ServingDeviceID: "relay-1",
+				Reason:          RelayAssignmentReasonDirectCoverage,
+				SuggestedAt:     now,
+			},
+			ExpectedState: &RelayAssignmentState{ServingDeviceID: "relay-1"},
+		},
+		{
+			Name:            "direct coverage of manual assignment",
+			State:           &RelayAssignmentState{},
+			ServingDeviceID: "relay-1",
+			Observations:    []relayUplinkObservation{covered, covered},
+			ExpectedState:   &RelayAssignmentState{},
+		},
+		{
+			Name:            "direct coverage after relay change",
+			State:           &RelayAssignmentState{ServingDeviceID: "relay-1", CoveredUplinks: 1},
+			ServingDeviceID: "relay-2",
+			Observations:    []relayUplinkObservation{covered},
+			ExpectedState:   &RelayAssignmentState{},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				var suggestion *RelayAssignmentSuggestion
+				for _, obs := range tc.Observations {
+					suggestion = ra.next(tc.State, tc.ServingDeviceID, obs, now)
+				}
+				a.So(suggestion, should.Resemble, tc.Expected)
+				a.So(tc.State, should.Resemble, tc.ExpectedState)
+			},
+		})
+	}
+}
+
+func TestSelectRelay(t *testing.T) {
+	a, _ := test.New(t)
+
+	now := time.Unix(1700000000, 0).UTC()
+	coverage := map[string]*RelayCoverage{
+		"relay-1": {GatewayIDs: []string{"gtw-1"}, UpdatedAt: now},
+		"relay-2": {GatewayIDs: []string{"gtw-1", "gtw-2"}, UpdatedAt: now},
+		"relay-3": {GatewayIDs: []string{"gtw-1", "gtw-2"}, UpdatedAt: now},
+		"relay-4": {GatewayIDs: []string{"gtw-1", "gtw-2", "gtw-3"}, UpdatedAt: now.Add(-2 * time.Hour)},
+	}
+	a.So(selectRelay(coverage, []string{"gtw-1", "gtw-2", "gtw-3"}, "", now, time.Hour), should.Equal, "relay-2")
+	a.So(selectRelay(coverage, []string{"gtw-1", "gtw-2", "gtw-3"}, "", now, 0), should.Equal, "relay-4")
+	a.So(selectRelay(coverage, []string{"gtw-1", "gtw-2"}, "relay-2", now, time.Hour), should.Equal, "relay-3")
+	a.So(selectRelay(coverage, []string{"gtw-5"}, "", now, time.Hour), should.BeEmpty)
+}
+
+func TestRelayAssignmentStateProto(t *testing.T) {
+	a, _ := test.New(t)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	now := time.Unix(1700000000, 0).UTC()
+	a.So((*RelayAssignmentState)(nil).proto(ids), should.Resemble, &ttnpb.EndDeviceRelayAssignment{EndDeviceIds: ids})
+	a.So((&RelayAssignmentState{
+		CoveredUplinks:  3,
+		ServingDeviceID: "relay-1",
+		Suggestion: &RelayAssignmentSuggestion{
+			Action:          RelayAssignmentRelease,
+			ServingDeviceID: "relay-1",
+			Reason:          RelayAssignmentReasonDirectCoverage,
+			SuggestedAt:     now,
+		},
+	}).proto(ids), should.Resemble, &ttnpb.EndDeviceRelayAssignment{
+		EndDeviceIds:    ids,
+		CoveredUplinks:  3,
+		ServingDeviceId: "relay-1",
+		Suggestion: &ttnpb.RelayAssignmentSuggestion{
+			Action:          ttnpb.RelayAssignmentAction_RELAY_ASSIGNMENT_ACTION_RELEASE,
+			ServingDeviceId: "relay-1",
+			Reason:          RelayAssignmentReasonDirectCoverage,
+			SuggestedAt:     timestamppb.New(now),
+		},
+	})
+}
+
+func TestAssignRelayRollback(t *testing.T) {
+	a, ctx := test.New(t)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	servedSettings := func(servingDeviceID string) *ttnpb.RelaySettings {
+		return &ttnpb.RelaySettings{
+			Mode: &ttnpb.RelaySettings_Served{
+				Served: &ttnpb.ServedRelaySettings{
+					Mode: &ttnpb.ServedRelaySettings_Always{
+						Always: &ttnpb.RelayEndDeviceAlwaysMode{},
+					},
+					ServingDeviceId: servingDeviceID,
+				},
+			},
+		}
+	}
+	fullRules := make([]*ttnpb.RelayUplinkForwardingRule, maxRelayUplinkForwardingRules)
+	for i := range fullRules {
+		fullRules[i] = &ttnpb.RelayUplinkForwardingRule{DeviceId: fmt.Sprintf("other-dev-%d", i)}
+	}
+	devices := map[string]*ttnpb.EndDevice{
+		"served-dev": {
+			Ids:         &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "served-dev"},
+			MacSettings: &ttnpb.MACSettings{DesiredRelay: servedSettings("relay-1")},
+		},
+		"relay-2": {
+			Ids: &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "relay-2"},
+			MacSettings: &ttnpb.MACSettings{
+				DesiredRelay: &ttnpb.RelaySettings{
+					Mode: &ttnpb.RelaySettings_Serving{
+						Serving: &ttnpb.ServingRelaySettings{
+							UplinkForwardingRules: fullRules,
+						},
+					},
+				},
+			},
+		},
+	}
+	var transactions int
+	ns := &NetworkServer{
+		devices: MockDeviceRegistry{
+			SetByIDFunc: func(
+				ctx context.Context,
+				_ *ttnpb.ApplicationIdentifiers,
+				devID string,
+				_ []string,
+				f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error),
+			) (*ttnpb.EndDevice, context.Context, error) {
+				transactions++
+				defer func() { transactions-- }()
+				if !a.So(transactions, should.Equal, 1) {
+					t.Fatal("Nested device registry transaction")
+				}
+				dev, _, err := f(ctx, ttnpb.Clone(devices[devID]))
+				if err != nil {
+					return nil, ctx, err
+				}
+				devices[devID] = dev
+				return dev, ctx, nil
+			},
+		},
+	}
+
+	err := ns.assignRelay(ctx, devices["served-dev"].Ids, "relay-2")
+	a.So(err, should.HaveSameErrorDefinitionAs, errRelayUplinkForwardingRulesExhausted)
+	a.So(devices["served-dev"].MacSettings.DesiredRelay, should.Resemble, servedSettings("relay-1"))
+	a.So(devices["relay-2"].MacSettings.DesiredRelay.GetServing().UplinkForwardingRules, should.Resemble, fullRules)
+}
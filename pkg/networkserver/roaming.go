@@ -0,0 +1,565 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errPassiveRoamingDisabled = errors.DefineFailedPrecondition(
+		"passive_roaming_disabled", "passive roaming is disabled",
+	)
+	errPassiveRoamingUplinkToken = errors.DefineInvalidArgument(
+		"passive_roaming_uplink_token", "invalid passive roaming uplink token",
+	)
+	errPassiveRoamingRFRegion = errors.DefineInvalidArgument(
+		"passive_roaming_rf_region", "unknown RF region `{rf_region}`",
+	)
+	errPassiveRoamingClass = errors.DefineInvalidArgument(
+		"passive_roaming_class", "class `{class}` is not supported in passive roaming",
+	)
+)
+
+// passiveRoamingGatewayIdentifiers are the proxy gateway identifiers of gateways of forwarding Network Servers.
+var passiveRoamingGatewayIdentifiers = &ttnpb.GatewayIdentifiers{GatewayId: "passive-roaming"}
+
+// PassiveRoamingClient is the client used to send passive roaming messages to other Network Servers.
+type PassiveRoamingClient interface {
+	PRStartRequest(context.Context, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequest(context.Context, *interop.XmitDataReq) (*interop.XmitDataAns, error)
+}
+
+type passiveRoamingPartner struct {
+	netID  types.NetID
+	prefix types.DevAddrPrefix
+}
+
+// passiveRoaming is the passive roaming state of the Network Server.
+// The Network Server acts as forwarding Network Server (fNS) for the forwarding partners and as serving Network Server
+// (sNS) for the serving partners. Passive roaming is stateless: each uplink message is forwarded individually.
+type passiveRoaming struct {
+	client     PassiveRoamingClient
+	band       *band.Band
+	rfRegion   string
+	forwarding []passiveRoamingPartner
+	serving    []types.NetID
+}
+
+func newPassiveRoaming(conf PassiveRoamingConfig, client PassiveRoamingClient) (*passiveRoaming, error) {
+	if conf.IsZero() {
+		return nil, nil
+	}
+	if client == nil {
+		return nil, errInvalidConfiguration.WithCause(errors.New("passive roaming requires the interop client"))
+	}
+	forwarding, err := parseNetIDs(conf.ForwardingNetIDs)
+	if err != nil {
+		return nil, errInvalidConfiguration.WithCause(err)
+	}
+	serving, err := parseNetIDs(conf.ServingNetIDs)
+	if err != nil {
+		return nil, errInvalidConfiguration.WithCause(err)
+	}
+	r := &passiveRoaming{
+		client:  client,
+		serving: serving,
+	}
+	if len(forwarding) == 0 {
+		return r, nil
+	}
+	if conf.BandID == "" {
+		return nil, errInvalidConfiguration.WithCause(errors.New("forwarding uplinks requires a band ID"))
+	}
+	phy, err := band.GetLatest(conf.BandID)
+	if err != nil {
+		return nil, errInvalidConfiguration.WithCause(err)
+	}
+	r.band = &phy
+	r.rfRegion = conf.BandID
+	if rfRegion, ok := interop.RFRegionFromBandID(conf.BandID); ok {
+		r.rfRegion = rfRegion
+	}
+	for _, netID := range forwarding {
+		prefix, err := netIDDevAddrPrefix(netID)
+		if err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+		r.forwarding = append(r.forwarding, passiveRoamingPartner{
+			netID:  netID,
+			prefix: prefix,
+		})
+	}
+	return r, nil
+}
+
+// forwardsTo returns true if uplinks are forwarded to the Network Server with the given NetID.
+func (r *passiveRoaming) forwardsTo(netID types.NetID) bool {
+	if r == nil {
+		return false
+	}
+	for _, partner := range r.forwarding {
+		if partner.netID.Equal(netID) {
+			return true
+		}
+	}
+	return false
+}
+
+// serves returns true if uplinks forwarded by the Network Server with the given NetID are served.
+func (r *passiveRoaming) serves(netID types.NetID) bool {
+	return r != nil && containsNetID(r.serving, netID)
+}
+
+// passiveRoamingUplinkToken is the uplink token of uplink messages received from a forwarding Network Server.
+type passiveRoamingUplinkToken struct {
+	NetID      types.NetID
+	NSID       *types.EUI64 `json:",omitempty"`
+	FNSULToken []byte       `json:",omitempty"`
+	ULToken    []byte       `json:",omitempty"`
+}
+
+func encodePassiveRoamingUplinkToken(token *passiveRoamingUplinkToken) ([]byte, error) {
+	return json.Marshal(token)
+}
+
+func parsePassiveRoamingUplinkToken(b []byte) (*passiveRoamingUplinkToken, error) {
+	token := &passiveRoamingUplinkToken{}
+	if err := json.Unmarshal(b, token); err != nil {
+		return nil, errPassiveRoamingUplinkToken.WithCause(err)
+	}
+	return token, nil
+}
+
+// passiveRoamingForwardingNetID returns the NetID of the serving Network Server to forward uplinks
+// with the given DevAddr to, if any.
+func (ns *NetworkServer) passiveRoamingForwardingNetID(ctx context.Context, devAddr types.DevAddr) (types.NetID, bool) {
+	if ns.passiveRoaming == nil || len(ns.passiveRoaming.forwarding) == 0 {
+		return types.NetID{}, false
+	}
+	for _, prefix := range ns.devAddrPrefixes(ctx) {
+		if devAddr.HasPrefix(prefix) {
+			return types.NetID{}, false
+		}
+	}
+	for _, partner := range ns.passiveRoaming.forwarding {
+		if devAddr.HasPrefix(partner.prefix) {
+			return partner.netID, true
+		}
+	}
+	return types.NetID{}, false
+}
+
+// forwardPassiveRoamingUplink forwards the data uplink up to the serving Network Server with the given NetID.
+// forwardPassiveRoamingUplink waits for the deduplication window to close, so that the metadata of all gateways
+// that received the uplink is forwarded at once.
+func (ns *NetworkServer) forwardPassiveRoamingUplink(
+	ctx context.Context, netID types.NetID, up *ttnpb.UplinkMessage,
+) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ns.deduplicationDone(ctx, up):
+	}
+	up = ttnpb.Clone(up)
+	ns.mergeMetadata(ctx, up, initialDeduplicationRound)
+
+	req, err := ns.passiveRoaming.startRequest(ns.netID(ctx), netID, up)
+	if err != nil {
+		return err
+	}
+	logger := log.FromContext(ctx).WithField("serving_net_id", netID)
+	ns.StartTask(&task.Config{
+		Context: ns.FromRequestContext(ctx),
+		ID:      "passive_roaming_forward_uplink",
+		Func: func(ctx context.Context) error {
+			ans, err := ns.passiveRoaming.client.PRStartRequest(ctx, req)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to forward uplink to serving Network Server")
+				return nil
+			}
+			logger.Debug("Forwarded uplink to serving Network Server")
+			if ans.DLMetaData == nil || len(ans.PHYPayload) == 0 {
+				return nil
+			}
+			if err := ns.transmitPassiveRoamingDownlink(ctx, ans.PHYPayload, ans.DLMetaData); err != nil {
+				logger.WithError(err).Warn("Failed to transmit downlink of serving Network Server")
+			}
+			return nil
+		},
+		Restart: task.RestartNever,
+		Backoff: task.DefaultBackoffConfig,
+	})
+	return nil
+}
+
+// startRequest returns the PRStartReq of the uplink message up.
+func (r *passiveRoaming) startRequest(senderID, receiverID types.NetID, up *ttnpb.UplinkMessage) (*interop.PRStartReq, error) {
+	drIdx, _, ok := r.band.FindUplinkDataRate(up.Settings.DataRate)
+	if !ok {
+		return nil, errDataRateNotFound.WithAttributes("data_rate", up.Settings.DataRate)
+	}
+	gwInfo := make([]interop.GWInfoElement, 0, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		if md.PacketBroker != nil || md.Relay != nil || md.PacketSource != nil ||
+			proto.Equal(md.GatewayIds, passiveRoamingGatewayIdentifiers) {
+			continue
+		}
+		rssi, snr := int32(math.Round(float64(md.Rssi))), md.Snr
+		info := interop.GWInfoElement{
+			ID:       interop.Buffer(md.GatewayIds.GetEui()),
+			RFRegion: r.rfRegion,
+			RSSI:     &rssi,
+			SNR:      &snr,
+			ULToken:  interop.Buffer(md.UplinkToken),
+			DLAllowed: len(md.UplinkToken) > 0 &&
+				md.DownlinkPathConstraint != ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER,
+		}
+		if loc := md.Location; loc != nil {
+			lat, lon := loc.Latitude, loc.Longitude
+			info.Lat, info.Lon = &lat, &lon
+		}
+		gwInfo = append(gwInfo, info)
+	}
+	devAddr := interop.DevAddr(types.MustDevAddr(up.Payload.GetMacPayload().GetFHdr().GetDevAddr()).OrZero())
+	dataRate := uint32(drIdx)
+	ulFreq := float64(up.Settings.Frequency) / 1e6
+	return &interop.PRStartReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID:   interop.NetID(senderID),
+			ReceiverID: interop.NetID(receiverID),
+		},
+		PHYPayload: interop.Buffer(up.RawPayload),
+		ULMetaData: interop.ULMetaData{
+			DevAddr:   &devAddr,
+			Confirmed: up.Payload.GetMHdr().GetMType() == ttnpb.MType_CONFIRMED_UP,
+			DataRate:  &dataRate,
+			ULFreq:    &ulFreq,
+			RecvTime:  ttnpb.StdTimeOrZero(up.ReceivedAt).UTC(),
+			RFRegion:  r.rfRegion,
+			GWCnt:     uint32(len(gwInfo)),
+			GWInfo:    gwInfo,
+		},
+	}, nil
+}
+
+// txRequest returns the TxRequest of the downlink metadata md.
+func (r *passiveRoaming) txRequest(md *interop.DLMetaData) (*ttnpb.TxRequest, error) {
+	req := &ttnpb.TxRequest{
+		Rx1Delay: ttnpb.RxDelay(md.RXDelay1),
+		Priority: ttnpb.TxSchedulePriority_NORMAL,
+	}
+	if md.HiPriorityFlag {
+		req.Priority = ttnpb.TxSchedulePriority_HIGH
+	}
+	switch md.ClassMode {
+	case "", "A":
+		req.Class = ttnpb.Class_CLASS_A
+	case "C":
+		req.Class = ttnpb.Class_CLASS_C
+	default:
+		return nil, interop.ErrMalformedMessage.WithCause(errPassiveRoamingClass.WithAttributes("class", md.ClassMode))
+	}
+	dataRate := func(idx uint32) (*ttnpb.DataRate, error) {
+		dr, ok := r.band.DataRates[ttnpb.DataRateIndex(idx)]
+		if !ok {
+			return nil, interop.ErrMalformedMessage.WithCause(errDataRateNotFound.WithAttributes("data_rate", idx))
+		}
+		return dr.Rate, nil
+	}
+	if md.DLFreq1 != nil && md.DataRate1 != nil {
+		dr, err := dataRate(*md.DataRate1)
+		if err != nil {
+			return nil, err
+		}
+		req.Rx1Frequency, req.Rx1DataRate = uint64(math.Round(*md.DLFreq1*1e6)), dr
+	}
+	if md.DLFreq2 != nil && md.DataRate2 != nil {
+		dr, err := dataRate(*md.DataRate2)
+		if err != nil {
+			return nil, err
+		}
+		req.Rx2Frequency, req.Rx2DataRate = uint64(math.Round(*md.DLFreq2*1e6)), dr
+	}
+	if req.Rx1Frequency == 0 && req.Rx2Frequency == 0 {
+		return nil, interop.ErrMalformedMessage.WithCause(errNoPath.New())
+	}
+	return req, nil
+}
+
+// transmitPassiveRoamingDownlink transmits the downlink of a serving Network Server through the gateways
+// referenced in md. The gateways are attempted in order until scheduling succeeds.
+func (ns *NetworkServer) transmitPassiveRoamingDownlink(
+	ctx context.Context, phyPayload []byte, md *interop.DLMetaData,
+) error {
+	req, err := ns.passiveRoaming.txRequest(md)
+	if err != nil {
+		return err
+	}
+	logger := log.FromContext(ctx)
+	var errs []error
+	for _, info := range md.GWInfo {
+		if !info.DLAllowed || len(info.ULToken) == 0 {
+			continue
+		}
+		token := &ttnpb.UplinkToken{}
+		if err := proto.Unmarshal(info.ULToken, token); err != nil {
+			logger.WithError(err).Warn("Failed to parse uplink token of passive roaming downlink")
+			continue
+		}
+		gtwIDs := token.GetIds().GetGatewayIds()
+		peer, err := ns.GetPeer(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, gtwIDs)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get Gateway Server peer")
+			continue
+		}
+		req := ttnpb.Clone(req)
+		req.DownlinkPaths = []*ttnpb.DownlinkPath{
+			{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: info.ULToken,
+				},
+			},
+		}
+		target := &gatewayServerDownlinkTarget{peer: peer}
+		if _, err := target.Schedule(ctx, &ttnpb.DownlinkMessage{
+			RawPayload: phyPayload,
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: req,
+			},
+			CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		}, ns.WithClusterAuth()); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		return nil
+	}
+	if len(errs) == 0 {
+		return errNoPath.New()
+	}
+	return errSchedule.WithCause(errs[len(errs)-1])
+}
+
+// passiveRoamingDownlinkTarget transmits downlink through a forwarding Network Server.
+type passiveRoamingDownlinkTarget struct {
+	netID    types.NetID
+	nsID     *types.EUI64
+	senderID types.NetID
+
+	client         PassiveRoamingClient
+	frequencyPlans func(context.Context) (*frequencyplans.Store, error)
+}
+
+var _ downlinkTarget = (*passiveRoamingDownlinkTarget)(nil)
+
+func (ns *NetworkServer) newPassiveRoamingDownlinkTarget(
+	ctx context.Context, path *ttnpb.DownlinkPath,
+) (*passiveRoamingDownlinkTarget, error) {
+	if ns.passiveRoaming == nil {
+		return nil, errPassiveRoamingDisabled.New()
+	}
+	token, err := parsePassiveRoamingUplinkToken(path.GetUplinkToken())
+	if err != nil {
+		return nil, err
+	}
+	return &passiveRoamingDownlinkTarget{
+		netID:          token.NetID,
+		nsID:           token.NSID,
+		senderID:       ns.netID(ctx),
+		client:         ns.passiveRoaming.client,
+		frequencyPlans: ns.FrequencyPlansStore,
+	}, nil
+}
+
+// Equal implements downlinkTarget.
+func (t *passiveRoamingDownlinkTarget) Equal(target downlinkTarget) bool {
+	other, ok := target.(*passiveRoamingDownlinkTarget)
+	if !ok {
+		return false
+	}
+	return other.netID.Equal(t.netID)
+}
+
+// Schedule implements downlinkTarget.
+func (t *passiveRoamingDownlinkTarget) Schedule(
+	ctx context.Context, msg *ttnpb.DownlinkMessage, _ ...grpc.CallOption,
+) (*ttnpb.ScheduleDownlinkResponse, error) {
+	req := msg.GetRequest()
+	if req == nil {
+		panic("downlink without request")
+	}
+	fps, err := t.frequencyPlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fp, err := fps.GetByID(req.FrequencyPlanId)
+	if err != nil {
+		return nil, err
+	}
+	phy, err := band.GetLatest(fp.BandID)
+	if err != nil {
+		return nil, err
+	}
+	md := &interop.DLMetaData{
+		RXDelay1:       uint32(req.Rx1Delay),
+		HiPriorityFlag: req.Priority >= ttnpb.TxSchedulePriority_HIGH,
+	}
+	switch req.Class {
+	case ttnpb.Class_CLASS_A:
+		md.ClassMode = "A"
+	case ttnpb.Class_CLASS_C:
+		md.ClassMode = "C"
+	default:
+		return nil, errPassiveRoamingClass.WithAttributes("class", req.Class)
+	}
+	if req.Rx1Frequency != 0 && req.Rx1DataRate != nil {
+		idx, _, ok := phy.FindDownlinkDataRate(req.Rx1DataRate)
+		if !ok {
+			return nil, errDataRateNotFound.WithAttributes("data_rate", req.Rx1DataRate)
+		}
+		freq, dataRate := float64(req.Rx1Frequency)/1e6, uint32(idx)
+		md.DLFreq1, md.DataRate1 = &freq, &dataRate
+	}
+	if req.Rx2Frequency != 0 && req.Rx2DataRate != nil {
+		idx, _, ok := phy.FindDownlinkDataRate(req.Rx2DataRate)
+		if !ok {
+			return nil, errDataRateNotFound.WithAttributes("data_rate", req.Rx2DataRate)
+		}
+		freq, dataRate := float64(req.Rx2Frequency)/1e6, uint32(idx)
+		md.DLFreq2, md.DataRate2 = &freq, &dataRate
+	}
+	for _, path := range req.DownlinkPaths {
+		token, err := parsePassiveRoamingUplinkToken(path.GetUplinkToken())
+		if err != nil {
+			return nil, err
+		}
+		md.FNSULToken = interop.Buffer(token.FNSULToken)
+		md.GWInfo = append(md.GWInfo, interop.GWInfoElement{
+			ULToken:   interop.Buffer(token.ULToken),
+			DLAllowed: true,
+		})
+	}
+	if _, err := t.client.XmitDataRequest(ctx, &interop.XmitDataReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID:     interop.NetID(t.senderID),
+			ReceiverID:   interop.NetID(t.netID),
+			ReceiverNSID: (*interop.EUI64)(t.nsID),
+		},
+		PHYPayload: interop.Buffer(msg.RawPayload),
+		DLMetaData: md,
+	}); err != nil {
+		return nil, err
+	}
+	return &ttnpb.ScheduleDownlinkResponse{
+		Delay: durationpb.New(peeringScheduleDelay),
+		DownlinkPath: &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_Fixed{
+				Fixed: &ttnpb.GatewayAntennaIdentifiers{
+					GatewayIds: passiveRoamingGatewayIdentifiers,
+				},
+			},
+		},
+	}, nil
+}
+
+// passiveRoamingUplink returns the uplink message of the PRStartReq forwarded by the forwarding Network Server.
+func passiveRoamingUplink(req *interop.PRStartReq) (*ttnpb.UplinkMessage, error) {
+	md := req.ULMetaData
+	bandID, ok := interop.BandIDFromRFRegion(md.RFRegion)
+	if !ok {
+		return nil, interop.ErrMalformedMessage.WithCause(
+			errPassiveRoamingRFRegion.WithAttributes("rf_region", md.RFRegion),
+		)
+	}
+	phy, err := band.GetLatest(bandID)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	if md.DataRate == nil {
+		return nil, interop.ErrMalformedMessage.WithCause(errInvalidDataRate.New())
+	}
+	if md.ULFreq == nil {
+		return nil, interop.ErrMalformedMessage.WithCause(errInvalidFieldValue.WithAttributes("field", "ULFreq"))
+	}
+	dr, ok := phy.DataRates[ttnpb.DataRateIndex(*md.DataRate)]
+	if !ok {
+		return nil, interop.ErrMalformedMessage.WithCause(
+			errDataRateNotFound.WithAttributes("data_rate", *md.DataRate),
+		)
+	}
+	rxMetadata := make([]*ttnpb.RxMetadata, 0, len(md.GWInfo))
+	for _, info := range md.GWInfo {
+		uplinkToken, err := encodePassiveRoamingUplinkToken(&passiveRoamingUplinkToken{
+			NetID:      types.NetID(req.SenderID),
+			NSID:       (*types.EUI64)(req.SenderNSID),
+			FNSULToken: md.FNSULToken,
+			ULToken:    info.ULToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rxMD := &ttnpb.RxMetadata{
+			GatewayIds:  passiveRoamingGatewayIdentifiers,
+			UplinkToken: uplinkToken,
+		}
+		if !info.DLAllowed {
+			rxMD.DownlinkPathConstraint = ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER
+		}
+		if info.RSSI != nil {
+			rxMD.Rssi = float32(*info.RSSI)
+			rxMD.ChannelRssi = rxMD.Rssi
+		}
+		if info.SNR != nil {
+			rxMD.Snr = *info.SNR
+		}
+		if info.Lat != nil && info.Lon != nil {
+			rxMD.Location = &ttnpb.Location{
+				Latitude:  *info.Lat,
+				Longitude: *info.Lon,
+			}
+		}
+		rxMetadata = append(rxMetadata, rxMD)
+	}
+	receivedAt := md.RecvTime
+	if receivedAt.IsZero() {
+		receivedAt = time.Now()
+	}
+	return &ttnpb.UplinkMessage{
+		RawPayload: req.PHYPayload,
+		Settings: &ttnpb.TxSettings{
+			DataRate:  dr.Rate,
+			Frequency: uint64(math.Round(*md.ULFreq * 1e6)),
+		},
+		RxMetadata: rxMetadata,
+		ReceivedAt: timestamppb.New(receivedAt),
+	}, nil
+}
@@ -0,0 +1,346 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockPassiveRoamingClient struct {
+	prStartReqs  []*interop.PRStartReq
+	xmitDataReqs []*interop.XmitDataReq
+}
+
+func (m *mockPassiveRoamingClient) PRStartRequest(
+	_ context.Context, req *interop.PRStartReq,
+) (*interop.PRStartAns, error) {
+	m.prStartReqs = append(m.prStartReqs, req)
+	return &interop.PRStartAns{Result: interop.Result{ResultCode: interop.ResultSuccess}}, nil
+}
+
+func (m *mockPassiveRoamingClient) XmitDataRequest(
+	_ context.Context, req *interop.XmitDataReq,
+) (*interop.XmitDataAns, error) {
+	m.xmitDataReqs = append(m.xmitDataReqs, req)
+	return &interop.XmitDataAns{Result: interop.Result{ResultCode: interop.ResultSuccess}}, nil
+}
+
+func TestNewPassiveRoaming(t *testing.T) {
+	t.Parallel()
+	client := &mockPassiveRoamingClient{}
+	for _, tc := range []struct {
+		Name   string
+		Config PassiveRoamingConfig
+		Client PassiveRoamingClient
+		Nil    bool
+		Error  bool
+	}{
+		{
+			Name: "Disabled",
+			Nil:  true,
+		},
+		{
+			Name:   "NoClient",
+			Config: PassiveRoamingConfig{ServingNetIDs: []string{"000013"}},
+			Error:  true,
+		},
+		{
+			Name:   "InvalidNetID",
+			Config: PassiveRoamingConfig{ServingNetIDs: []string{"invalid"}},
+			Client: client,
+			Error:  true,
+		},
+		{
+			Name:   "ForwardingWithoutBand",
+			Config: PassiveRoamingConfig{ForwardingNetIDs: []string{"000013"}},
+			Client: client,
+			Error:  true,
+		},
+		{
+			Name:   "Serving",
+			Config: PassiveRoamingConfig{ServingNetIDs: []string{"000013"}},
+			Client: client,
+		},
+		{
+			Name: "Forwarding",
+			Config: PassiveRoamingConfig{
+				BandID:           band.EU_863_870,
+				ForwardingNetIDs: []string{"000013"},
+			},
+			Client: client,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(_ context.Context, t *testing.T, a *assertions.Assertion) {
+				t.Helper()
+				r, err := newPassiveRoaming(tc.Config, tc.Client)
+				if tc.Error {
+					a.So(err, should.NotBeNil)
+					return
+				}
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				if tc.Nil {
+					a.So(r, should.BeNil)
+					return
+				}
+				a.So(r, should.NotBeNil)
+			},
+		})
+	}
+}
+
+func TestPassiveRoamingForwardingNetID(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	r, err := newPassiveRoaming(PassiveRoamingConfig{
+		BandID:           band.EU_863_870,
+		ForwardingNetIDs: []string{"000013"},
+	}, &mockPassiveRoamingClient{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	ns := &NetworkServer{
+		devAddrPrefixes: makeDevAddrPrefixesFunc(types.DevAddrPrefix{
+			DevAddr: types.DevAddr{0x00, 0x00, 0x00, 0x00},
+			Length:  7,
+		}),
+		passiveRoaming: r,
+	}
+
+	netID, ok := ns.passiveRoamingForwardingNetID(ctx, types.DevAddr{0x27, 0x00, 0x00, 0x42})
+	a.So(ok, should.BeTrue)
+	a.So(netID, should.Equal, types.NetID{0x00, 0x00, 0x13})
+
+	_, ok = ns.passiveRoamingForwardingNetID(ctx, types.DevAddr{0x01, 0x00, 0x00, 0x42})
+	a.So(ok, should.BeFalse)
+
+	_, ok = ns.passiveRoamingForwardingNetID(ctx, types.DevAddr{0x48, 0x00, 0x00, 0x42})
+	a.So(ok, should.BeFalse)
+}
+
+func TestPassiveRoamingUplink(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	r, err := newPassiveRoaming(PassiveRoamingConfig{
+		BandID:           band.EU_863_870,
+		ForwardingNetIDs: []string{"000013"},
+	}, &mockPassiveRoamingClient{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	receivedAt := time.Unix(1700000000, 0).UTC()
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x42, 0x00, 0x00, 0x27, 0x00, 0x01, 0x00},
+		Payload: &ttnpb.Message{
+			MHdr: &ttnpb.MHDR{MType: ttnpb.MType_CONFIRMED_UP},
+			Payload: &ttnpb.Message_MacPayload{
+				MacPayload: &ttnpb.MACPayload{
+					FHdr: &ttnpb.FHDR{DevAddr: types.DevAddr{0x27, 0x00, 0x00, 0x42}.Bytes()},
+				},
+			},
+		},
+		Settings: &ttnpb.TxSettings{
+			DataRate:  r.band.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+			Frequency: 868100000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIds:  &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1", Eui: types.EUI64{0x01}.Bytes()},
+				Rssi:        -42.4,
+				Snr:         7.5,
+				UplinkToken: []byte("token-1"),
+			},
+			{
+				GatewayIds:             &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"},
+				UplinkToken:            []byte("token-2"),
+				DownlinkPathConstraint: ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER,
+			},
+			{
+				GatewayIds:   &ttnpb.GatewayIdentifiers{GatewayId: "packetbroker"},
+				PacketBroker: &ttnpb.PacketBrokerMetadata{},
+			},
+		},
+		ReceivedAt: timestamppb.New(receivedAt),
+	}
+
+	req, err := r.startRequest(types.NetID{0x00, 0x00, 0x42}, types.NetID{0x00, 0x00, 0x13}, up)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(req.SenderID, should.Equal, interop.NetID{0x00, 0x00, 0x42})
+	a.So(req.ReceiverID, should.Equal, interop.NetID{0x00, 0x00, 0x13})
+	a.So(req.ULMetaData.RFRegion, should.Equal, "EU868")
+	a.So(*req.ULMetaData.DataRate, should.Equal, 5)
+	a.So(*req.ULMetaData.ULFreq, should.Equal, 868.1)
+	a.So(req.ULMetaData.Confirmed, should.BeTrue)
+	a.So(req.ULMetaData.RecvTime, should.Equal, receivedAt)
+	if !a.So(req.ULMetaData.GWInfo, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(*req.ULMetaData.GWInfo[0].RSSI, should.Equal, -42)
+	a.So(req.ULMetaData.GWInfo[0].DLAllowed, should.BeTrue)
+	a.So(req.ULMetaData.GWInfo[1].DLAllowed, should.BeFalse)
+
+	// The serving Network Server converts the request back to an uplink message.
+	req.ULMetaData.FNSULToken = interop.Buffer("fns-token")
+	servedUp, err := passiveRoamingUplink(req)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(servedUp.RawPayload, should.Resemble, up.RawPayload)
+	a.So(servedUp.Settings.Frequency, should.Equal, 868100000)
+	a.So(servedUp.Settings.DataRate, should.Resemble, up.Settings.DataRate)
+	a.So(servedUp.ReceivedAt.AsTime(), should.Equal, receivedAt)
+	if !a.So(servedUp.RxMetadata, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(servedUp.RxMetadata[0].GatewayIds, should.Resemble, passiveRoamingGatewayIdentifiers)
+	a.So(servedUp.RxMetadata[1].DownlinkPathConstraint, should.Equal,
+		ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER)
+	token, err := parsePassiveRoamingUplinkToken(servedUp.RxMetadata[0].UplinkToken)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(token, should.Resemble, &passiveRoamingUplinkToken{
+		NetID:      types.NetID{0x00, 0x00, 0x42},
+		FNSULToken: []byte("fns-token"),
+		ULToken:    []byte("token-1"),
+	})
+
+	req.ULMetaData.RFRegion = "Unknown"
+	_, err = passiveRoamingUplink(req)
+	a.So(err, should.NotBeNil)
+}
+
+func TestPassiveRoamingDownlinkTarget(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	client := &mockPassiveRoamingClient{}
+	nsID := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	uplinkToken, err := encodePassiveRoamingUplinkToken(&passiveRoamingUplinkToken{
+		NetID:      types.NetID{0x00, 0x00, 0x13},
+		NSID:       &nsID,
+		FNSULToken: []byte("fns-token"),
+		ULToken:    []byte("token-1"),
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	target := &passiveRoamingDownlinkTarget{
+		netID:    types.NetID{0x00, 0x00, 0x13},
+		nsID:     &nsID,
+		senderID: types.NetID{0x00, 0x00, 0x42},
+		client:   client,
+		frequencyPlans: func(context.Context) (*frequencyplans.Store, error) {
+			return frequencyplans.NewStore(test.FrequencyPlansFetcher), nil
+		},
+	}
+	a.So(target.Equal(&passiveRoamingDownlinkTarget{netID: types.NetID{0x00, 0x00, 0x13}}), should.BeTrue)
+	a.So(target.Equal(&passiveRoamingDownlinkTarget{netID: types.NetID{0x00, 0x00, 0x14}}), should.BeFalse)
+
+	phy, err := band.GetLatest(band.EU_863_870)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	res, err := target.Schedule(ctx, &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x60, 0x42},
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class: ttnpb.Class_CLASS_A,
+				DownlinkPaths: []*ttnpb.DownlinkPath{
+					{
+						Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: uplinkToken},
+					},
+				},
+				Rx1Delay:        ttnpb.RxDelay_RX_DELAY_1,
+				Rx1DataRate:     phy.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+				Rx1Frequency:    868100000,
+				Rx2DataRate:     phy.DataRates[ttnpb.DataRateIndex_DATA_RATE_0].Rate,
+				Rx2Frequency:    869525000,
+				Priority:        ttnpb.TxSchedulePriority_HIGHEST,
+				FrequencyPlanId: test.EUFrequencyPlanID,
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.Delay.AsDuration(), should.Equal, peeringScheduleDelay)
+	if !a.So(client.xmitDataReqs, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	req := client.xmitDataReqs[0]
+	a.So(req.SenderID, should.Equal, interop.NetID{0x00, 0x00, 0x42})
+	a.So(req.ReceiverID, should.Equal, interop.NetID{0x00, 0x00, 0x13})
+	a.So(*req.ReceiverNSID, should.Equal, interop.EUI64(nsID))
+	a.So(req.PHYPayload, should.Resemble, interop.Buffer{0x60, 0x42})
+
+	md := req.DLMetaData
+	a.So(md.ClassMode, should.Equal, "A")
+	a.So(md.RXDelay1, should.Equal, 1)
+	a.So(md.HiPriorityFlag, should.BeTrue)
+	a.So(*md.DLFreq1, should.Equal, 868.1)
+	a.So(*md.DataRate1, should.Equal, 5)
+	a.So(*md.DLFreq2, should.Equal, 869.525)
+	a.So(*md.DataRate2, should.Equal, 0)
+	a.So(md.FNSULToken, should.Resemble, interop.Buffer("fns-token"))
+	a.So(md.GWInfo, should.Resemble, []interop.GWInfoElement{
+		{ULToken: interop.Buffer("token-1"), DLAllowed: true},
+	})
+
+	// The forwarding Network Server converts the downlink metadata back to a transmission request.
+	r, err := newPassiveRoaming(PassiveRoamingConfig{
+		BandID:           band.EU_863_870,
+		ForwardingNetIDs: []string{"000042"},
+	}, client)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	txReq, err := r.txRequest(md)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(txReq.Class, should.Equal, ttnpb.Class_CLASS_A)
+	a.So(txReq.Rx1Delay, should.Equal, ttnpb.RxDelay_RX_DELAY_1)
+	a.So(txReq.Rx1Frequency, should.Equal, 868100000)
+	a.So(txReq.Rx1DataRate, should.Resemble, phy.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate)
+	a.So(txReq.Rx2Frequency, should.Equal, 869525000)
+	a.So(txReq.Priority, should.Equal, ttnpb.TxSchedulePriority_HIGH)
+
+	md.ClassMode = "B"
+	_, err = r.txRequest(md)
+	a.So(err, should.NotBeNil)
+}
@@ -224,7 +224,8 @@ func deviceHasPathForDownlink(ctx context.Context, dev *ttnpb.EndDevice, down *t
 
 // nextDataDownlinkSlot returns the next downlinkSlot before or at earliestAt when next data downlink can be transmitted to the device
 // given the data known by Network Server and true, if such downlinkSlot and downlink exist, otherwise it returns nil and false.
-func nextDataDownlinkSlot(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings, earliestAt time.Time) (downlinkSlot, bool) {
+// forceRejoinReq is the ForceRejoinReq the Network Server needs to send to the device, if any.
+func nextDataDownlinkSlot(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings, earliestAt time.Time, forceRejoinReq *ttnpb.MACCommand_ForceRejoinReq) (downlinkSlot, bool) {
 	if dev.GetMacState() == nil {
 		return nil, false
 	}
@@ -277,6 +278,9 @@ func nextDataDownlinkSlot(ctx context.Context, dev *ttnpb.EndDevice, phy *band.B
 		case mac.DeviceNeedsDutyCycleReq(dev):
 			logger.Debug("Device needs DutyCycleReq, choose class A downlink slot")
 			return classA, true
+		case mac.DeviceNeedsForceRejoinReq(dev, forceRejoinReq):
+			logger.Debug("Device needs ForceRejoinReq, choose class A downlink slot")
+			return classA, true
 		case mac.DeviceNeedsLinkADRReq(ctx, dev, phy):
 			logger.Debug("Device needs LinkADRReq, choose class A downlink slot")
 			return classA, true
@@ -428,6 +432,10 @@ func (ns *NetworkServer) submitApplicationUplinks(ctx context.Context, ups ...*t
 		"device_uid", unique.ID(ctx, ups[0].EndDeviceIds),
 		"uplink_count", n,
 	))
+	if ns.handover.serves(ups[0].EndDeviceIds.ApplicationIds) {
+		ns.forwardHandoverUplinks(ctx, ups...)
+		return
+	}
 	if err := ns.uplinkSubmissionPool.Publish(ctx, ups); err != nil {
 		log.FromContext(ctx).WithError(err).Warn("Failed to enqueue application uplinks in submission pool")
 		ns.enqueueApplicationUplinks(ctx, ups...)
@@ -436,13 +444,7 @@ func (ns *NetworkServer) submitApplicationUplinks(ctx context.Context, ups ...*t
 }
 
 func (ns *NetworkServer) handleUplinkSubmission(ctx context.Context, ups []*ttnpb.ApplicationUp) {
-	conn, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Warn("Failed to get Application Server peer")
-		ns.enqueueApplicationUplinks(ctx, ups...)
-		return
-	}
-	if err := ns.sendApplicationUplinks(ctx, ttnpb.NewNsAsClient(conn), ups...); err != nil {
+	if err := ns.sendApplicationUplinks(ctx, ups...); err != nil {
 		log.FromContext(ctx).WithError(err).Warn("Failed to send application uplinks to Application Server")
 		if !retryableUplinkError(err) {
 			return
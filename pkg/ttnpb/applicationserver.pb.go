@@ -96,6 +96,55 @@ func (AsConfiguration_PubSub_Providers_Status) EnumDescriptor() ([]byte, []int)
 	return file_ttn_lorawan_v3_applicationserver_proto_rawDescGZIP(), []int{4, 0, 0, 0}
 }
 
+// The source of the historical upstream messages.
+type ReplayApplicationUpsRequest_Source int32
+
+const (
+	// The last decoded and normalized upstream messages stored per end device.
+	ReplayApplicationUpsRequest_SOURCE_STORAGE ReplayApplicationUpsRequest_Source = 0
+	// The upstream messages in the events store.
+	ReplayApplicationUpsRequest_SOURCE_EVENTS ReplayApplicationUpsRequest_Source = 1
+)
+
+// Enum value maps for ReplayApplicationUpsRequest_Source.
+var (
+	ReplayApplicationUpsRequest_Source_name = map[int32]string{
+		0: "SOURCE_STORAGE",
+		1: "SOURCE_EVENTS",
+	}
+	ReplayApplicationUpsRequest_Source_value = map[string]int32{
+		"SOURCE_STORAGE": 0,
+		"SOURCE_EVENTS":  1,
+	}
+)
+
+func (x ReplayApplicationUpsRequest_Source) Enum() *ReplayApplicationUpsRequest_Source {
+	p := new(ReplayApplicationUpsRequest_Source)
+	*p = x
+	return p
+}
+
+func (x ReplayApplicationUpsRequest_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReplayApplicationUpsRequest_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_applicationserver_proto_enumTypes[1].Descriptor()
+}
+
+func (ReplayApplicationUpsRequest_Source) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_applicationserver_proto_enumTypes[1]
+}
+
+func (x ReplayApplicationUpsRequest_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReplayApplicationUpsRequest_Source.Descriptor instead.
+func (ReplayApplicationUpsRequest_Source) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_proto_rawDescGZIP(), []int{18, 0}
+}
+
 type ApplicationLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -933,6 +982,411 @@ func (x *DecodeDownlinkResponse) GetDownlink() *ApplicationDownlink {
 	return nil
 }
 
+type GetApplicationEndDeviceStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// The FPort of the uplink messages to return.
+	// If zero, the uplink messages of all FPorts are returned.
+	FPort uint32 `protobuf:"varint,2,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// The maximum number of uplink messages to return per FPort.
+	// If zero, all stored uplink messages are returned.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetApplicationEndDeviceStateRequest) Reset() {
+	*x = GetApplicationEndDeviceStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApplicationEndDeviceStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationEndDeviceStateRequest) ProtoMessage() {}
+
+func (x *GetApplicationEndDeviceStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationEndDeviceStateRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationEndDeviceStateRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetApplicationEndDeviceStateRequest) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *GetApplicationEndDeviceStateRequest) GetFPort() uint32 {
+	if x != nil {
+		return x.FPort
+	}
+	return 0
+}
+
+func (x *GetApplicationEndDeviceStateRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type BatchGetApplicationEndDeviceStatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// The IDs of the end devices to return the state of.
+	// If empty, the states of the most recently updated end devices of the application are returned.
+	DeviceIds []string `protobuf:"bytes,2,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	// The FPort of the uplink messages to return.
+	// If zero, the uplink messages of all FPorts are returned.
+	FPort uint32 `protobuf:"varint,3,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	// The maximum number of uplink messages to return per FPort.
+	// If zero, all stored uplink messages are returned.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *BatchGetApplicationEndDeviceStatesRequest) Reset() {
+	*x = BatchGetApplicationEndDeviceStatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetApplicationEndDeviceStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetApplicationEndDeviceStatesRequest) ProtoMessage() {}
+
+func (x *BatchGetApplicationEndDeviceStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetApplicationEndDeviceStatesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetApplicationEndDeviceStatesRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchGetApplicationEndDeviceStatesRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *BatchGetApplicationEndDeviceStatesRequest) GetDeviceIds() []string {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+func (x *BatchGetApplicationEndDeviceStatesRequest) GetFPort() uint32 {
+	if x != nil {
+		return x.FPort
+	}
+	return 0
+}
+
+func (x *BatchGetApplicationEndDeviceStatesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ApplicationEndDeviceState is the state of an end device in the Application Server.
+type ApplicationEndDeviceState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// The last uplink messages with a decoded payload by FPort, newest first.
+	Uplinks map[uint32]*ApplicationEndDeviceState_Uplinks `protobuf:"bytes,2,rep,name=uplinks,proto3" json:"uplinks,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The last uplink message with a normalized payload.
+	LastNormalized *ApplicationUp `protobuf:"bytes,3,opt,name=last_normalized,json=lastNormalized,proto3" json:"last_normalized,omitempty"`
+}
+
+func (x *ApplicationEndDeviceState) Reset() {
+	*x = ApplicationEndDeviceState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationEndDeviceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationEndDeviceState) ProtoMessage() {}
+
+func (x *ApplicationEndDeviceState) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationEndDeviceState.ProtoReflect.Descriptor instead.
+func (*ApplicationEndDeviceState) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ApplicationEndDeviceState) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *ApplicationEndDeviceState) GetUplinks() map[uint32]*ApplicationEndDeviceState_Uplinks {
+	if x != nil {
+		return x.Uplinks
+	}
+	return nil
+}
+
+func (x *ApplicationEndDeviceState) GetLastNormalized() *ApplicationUp {
+	if x != nil {
+		return x.LastNormalized
+	}
+	return nil
+}
+
+type ApplicationEndDeviceStates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	States []*ApplicationEndDeviceState `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+}
+
+func (x *ApplicationEndDeviceStates) Reset() {
+	*x = ApplicationEndDeviceStates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationEndDeviceStates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationEndDeviceStates) ProtoMessage() {}
+
+func (x *ApplicationEndDeviceStates) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationEndDeviceStates.ProtoReflect.Descriptor instead.
+func (*ApplicationEndDeviceStates) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ApplicationEndDeviceStates) GetStates() []*ApplicationEndDeviceState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type ReplayApplicationUpsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// The IDs of the end devices of which the upstream messages are replayed.
+	// If empty, the upstream messages of all end devices of the application are replayed.
+	DeviceIds []string                           `protobuf:"bytes,2,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	Source    ReplayApplicationUpsRequest_Source `protobuf:"varint,3,opt,name=source,proto3,enum=ttn.lorawan.v3.ReplayApplicationUpsRequest_Source" json:"source,omitempty"`
+	// The integration to which the upstream messages are replayed.
+	Target *ApplicationUpReplayTarget `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// The upstream messages received in the time range from start_time to end_time are replayed.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ReplayApplicationUpsRequest) Reset() {
+	*x = ReplayApplicationUpsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayApplicationUpsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayApplicationUpsRequest) ProtoMessage() {}
+
+func (x *ReplayApplicationUpsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayApplicationUpsRequest.ProtoReflect.Descriptor instead.
+func (*ReplayApplicationUpsRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReplayApplicationUpsRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *ReplayApplicationUpsRequest) GetDeviceIds() []string {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+func (x *ReplayApplicationUpsRequest) GetSource() ReplayApplicationUpsRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return ReplayApplicationUpsRequest_SOURCE_STORAGE
+}
+
+func (x *ReplayApplicationUpsRequest) GetTarget() *ApplicationUpReplayTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ReplayApplicationUpsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ReplayApplicationUpsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ReplayApplicationUpsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the started replay.
+	ReplayId string `protobuf:"bytes,1,opt,name=replay_id,json=replayId,proto3" json:"replay_id,omitempty"`
+	// The number of upstream messages that are replayed.
+	Messages uint32 `protobuf:"varint,2,opt,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *ReplayApplicationUpsResponse) Reset() {
+	*x = ReplayApplicationUpsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayApplicationUpsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayApplicationUpsResponse) ProtoMessage() {}
+
+func (x *ReplayApplicationUpsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayApplicationUpsResponse.ProtoReflect.Descriptor instead.
+func (*ReplayApplicationUpsResponse) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReplayApplicationUpsResponse) GetReplayId() string {
+	if x != nil {
+		return x.ReplayId
+	}
+	return ""
+}
+
+func (x *ReplayApplicationUpsResponse) GetMessages() uint32 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
 type AsConfiguration_PubSub struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -944,7 +1398,7 @@ type AsConfiguration_PubSub struct {
 func (x *AsConfiguration_PubSub) Reset() {
 	*x = AsConfiguration_PubSub{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[14]
+		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -957,7 +1411,7 @@ func (x *AsConfiguration_PubSub) String() string {
 func (*AsConfiguration_PubSub) ProtoMessage() {}
 
 func (x *AsConfiguration_PubSub) ProtoReflect() protoreflect.Message {
-	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[14]
+	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -992,7 +1446,7 @@ type AsConfiguration_Webhooks struct {
 func (x *AsConfiguration_Webhooks) Reset() {
 	*x = AsConfiguration_Webhooks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[15]
+		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1005,7 +1459,7 @@ func (x *AsConfiguration_Webhooks) String() string {
 func (*AsConfiguration_Webhooks) ProtoMessage() {}
 
 func (x *AsConfiguration_Webhooks) ProtoReflect() protoreflect.Message {
-	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[15]
+	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1501,7 @@ type AsConfiguration_PubSub_Providers struct {
 func (x *AsConfiguration_PubSub_Providers) Reset() {
 	*x = AsConfiguration_PubSub_Providers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[16]
+		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1060,7 +1514,7 @@ func (x *AsConfiguration_PubSub_Providers) String() string {
 func (*AsConfiguration_PubSub_Providers) ProtoMessage() {}
 
 func (x *AsConfiguration_PubSub_Providers) ProtoReflect() protoreflect.Message {
-	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[16]
+	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,6 +1544,53 @@ func (x *AsConfiguration_PubSub_Providers) GetNats() AsConfiguration_PubSub_Prov
 	return AsConfiguration_PubSub_Providers_ENABLED
 }
 
+type ApplicationEndDeviceState_Uplinks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uplinks []*ApplicationUp `protobuf:"bytes,1,rep,name=uplinks,proto3" json:"uplinks,omitempty"`
+}
+
+func (x *ApplicationEndDeviceState_Uplinks) Reset() {
+	*x = ApplicationEndDeviceState_Uplinks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationEndDeviceState_Uplinks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationEndDeviceState_Uplinks) ProtoMessage() {}
+
+func (x *ApplicationEndDeviceState_Uplinks) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationEndDeviceState_Uplinks.ProtoReflect.Descriptor instead.
+func (*ApplicationEndDeviceState_Uplinks) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *ApplicationEndDeviceState_Uplinks) GetUplinks() []*ApplicationUp {
+	if x != nil {
+		return x.Uplinks
+	}
+	return nil
+}
+
 var File_ttn_lorawan_v3_applicationserver_proto protoreflect.FileDescriptor
 
 var file_ttn_lorawan_v3_applicationserver_proto_rawDesc = []byte{
@@ -1337,246 +1838,396 @@ var file_ttn_lorawan_v3_applicationserver_proto_rawDesc = []byte{
 	0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
 	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x32, 0xf5,
-	0x05, 0x0a, 0x02, 0x41, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e,
-	0x6b, 0x12, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
-	0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74,
-	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x3e, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x98, 0x01,
-	0x0a, 0x07, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
-	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x08, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xbc,
+	0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c,
+	0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x06,
+	0x66, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x2a, 0x03, 0x18, 0xff, 0x01, 0x52, 0x05, 0x66, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x2a, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x97, 0x02,
+	0x0a, 0x29, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
 	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x3a, 0x01, 0x2a,
-	0x1a, 0x36, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x7c, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
-	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x2a, 0x26,
-	0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x92, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x69,
-	0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2f, 0xfa, 0x42, 0x2c, 0x92,
+	0x01, 0x29, 0x10, 0xe8, 0x07, 0x22, 0x24, 0x72, 0x22, 0x18, 0x24, 0x32, 0x1e, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x3f, 0x3a, 0x5b, 0x2d, 0x5d, 0x3f, 0x5b, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x7b, 0x32, 0x2c, 0x7d, 0x24, 0x52, 0x09, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x66, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18, 0xff, 0x01,
+	0x52, 0x05, 0x66, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18, 0xe8, 0x07,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb4, 0x03, 0x0a, 0x19, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x50, 0x0a, 0x07, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x75, 0x70, 0x6c, 0x69,
+	0x6e, 0x6b, 0x73, 0x12, 0x46, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x1a, 0x42, 0x0a, 0x07, 0x55,
+	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
 	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a,
-	0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
-	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x52, 0x07, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x1a,
+	0x6d, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x47, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x55, 0x70, 0x6c, 0x69,
+	0x6e, 0x6b, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5f,
+	0x0a, 0x1a, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0xa2, 0x04, 0x0a, 0x1b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x59, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2f,
+	0xfa, 0x42, 0x2c, 0x92, 0x01, 0x29, 0x10, 0xe8, 0x07, 0x22, 0x24, 0x72, 0x22, 0x18, 0x24, 0x32,
+	0x1e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x3f, 0x3a, 0x5b, 0x2d, 0x5d,
+	0x3f, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x7b, 0x32, 0x2c, 0x7d, 0x24, 0x52,
+	0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x54, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x4b, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x43, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x53, 0x10, 0x01, 0x22, 0x57, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x32, 0xf5, 0x05,
+	0x0a, 0x02, 0x41, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x3e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x98, 0x01, 0x0a,
+	0x07, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x1a,
+	0x36, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x7c, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x2a, 0x26, 0x2f,
 	0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
 	0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
-	0x33, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74, 0x74,
-	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12,
-	0x11, 0x2f, 0x61, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x1a, 0x22, 0x92, 0x41, 0x1f, 0x12, 0x1d, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20,
-	0x61, 0x6e, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x32, 0x97, 0x02, 0x0a, 0x04, 0x4e, 0x73, 0x41, 0x73, 0x12,
-	0x4f, 0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12,
-	0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
-	0x2e, 0x4e, 0x73, 0x41, 0x73, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0xbd, 0x01, 0x92, 0x41, 0xb9, 0x01, 0x12, 0xb6, 0x01, 0x54, 0x68, 0x65, 0x20, 0x4e, 0x73,
-	0x41, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73,
-	0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73,
-	0x20, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
-	0x63, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e,
-	0x67, 0x73, 0x20, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x20, 0x54, 0x68,
-	0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x2d, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x65, 0x61, 0x6e,
-	0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e,
-	0x32, 0x83, 0x0e, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x41, 0x73, 0x12, 0x54, 0x0a, 0x09, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a,
-	0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
-	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x30, 0x01,
-	0x12, 0xcb, 0x01, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x92, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x24,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x61,
+	0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11,
+	0x2f, 0x61, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x22, 0x92, 0x41, 0x1f, 0x12, 0x1d, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x61,
+	0x6e, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x32, 0x97, 0x02, 0x0a, 0x04, 0x4e, 0x73, 0x41, 0x73, 0x12, 0x4f,
+	0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x27,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x4e, 0x73, 0x41, 0x73, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0xbd, 0x01, 0x92, 0x41, 0xb9, 0x01, 0x12, 0xb6, 0x01, 0x54, 0x68, 0x65, 0x20, 0x4e, 0x73, 0x41,
+	0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73, 0x65,
+	0x64, 0x20, 0x62, 0x79, 0x20, 0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x20,
+	0x53, 0x74, 0x61, 0x63, 0x6b, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e, 0x67,
+	0x73, 0x20, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x20, 0x54, 0x68, 0x69,
+	0x73, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x2d, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x65, 0x61, 0x6e, 0x74,
+	0x20, 0x66, 0x6f, 0x72, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x32,
+	0xec, 0x13, 0x0a, 0x05, 0x41, 0x70, 0x70, 0x41, 0x73, 0x12, 0x54, 0x0a, 0x09, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x1d,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x30, 0x01, 0x12,
+	0xcb, 0x01, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x78, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x72, 0x3a, 0x01, 0x2a, 0x22, 0x6d,
+	0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x12, 0xd1, 0x01,
+	0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
 	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
 	0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x78, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x72, 0x3a, 0x01, 0x2a, 0x22,
-	0x6d, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x7b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x75, 0x3a, 0x01, 0x2a, 0x22,
+	0x70, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
 	0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64,
 	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x12, 0xd1,
-	0x01, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x7b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x75, 0x3a, 0x01, 0x2a,
-	0x22, 0x70, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x12, 0xb3, 0x01, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x24, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x73, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x12, 0x4a, 0x2f, 0x61, 0x73,
+	0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0xa3, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d,
+	0x51, 0x54, 0x54, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x51, 0x54, 0x54, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x71, 0x74, 0x74, 0x2d, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0xc3, 0x01,
+	0x0a, 0x0e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x12, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x7a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x74, 0x3a,
+	0x01, 0x2a, 0x22, 0x6f, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e,
+	0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x70, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x12, 0xdb, 0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x74, 0x3a, 0x01, 0x2a,
+	0x22, 0x6f, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
 	0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
 	0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
 	0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f,
 	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x12, 0xb3, 0x01, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
-	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x24,
-	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x73, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x12, 0x4a, 0x2f, 0x61,
-	0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0xa3, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x4d, 0x51, 0x54, 0x54, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
-	0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x22, 0x2e, 0x74, 0x74, 0x6e,
-	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x51, 0x54, 0x54,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x3e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x12, 0x36, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x71, 0x74, 0x74, 0x2d, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0xc3,
-	0x01, 0x0a, 0x0e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e,
-	0x6b, 0x12, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
-	0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x7a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x74,
-	0x3a, 0x01, 0x2a, 0x22, 0x6f, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0xd3, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x6c, 0x69,
+	0x6e, 0x6b, 0x12, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x55,
+	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x72, 0x3a, 0x01, 0x2a, 0x22, 0x6d, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x70,
+	0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0xdb, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x74, 0x3a, 0x01, 0x2a, 0x22, 0x6f, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2f, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0xe6, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x33, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x71, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x6b, 0x12, 0x69, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63,
 	0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65,
 	0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x70, 0x2f, 0x73, 0x69, 0x6d, 0x75,
-	0x6c, 0x61, 0x74, 0x65, 0x12, 0xdb, 0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0xc9,
+	0x01, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x39, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x61, 0x73, 0x2f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0xab, 0x01, 0x0a, 0x09, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x70, 0x73, 0x12, 0x2b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x3a, 0x01, 0x2a, 0x22, 0x38,
+	0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x55, 0x70, 0x73, 0x12, 0x26, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x30, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2a, 0x2a, 0x28, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x1a, 0x4e,
+	0x92, 0x41, 0x4b, 0x12, 0x49, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x20, 0x61, 0x6e, 0x64,
+	0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x72, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x32, 0x9f,
+	0x05, 0x0a, 0x13, 0x41, 0x73, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0xb2, 0x01, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x23,
 	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x74, 0x3a, 0x01,
-	0x2a, 0x22, 0x6f, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64,
-	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2f, 0x65, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x12, 0xd3, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x6c,
-	0x69, 0x6e, 0x6b, 0x12, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
-	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
-	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x72, 0x3a, 0x01, 0x2a, 0x22, 0x6d, 0x2f, 0x61, 0x73, 0x2f, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64,
-	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x75,
-	0x70, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0xdb, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x2e, 0x74, 0x74,
-	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x63,
-	0x6f, 0x64, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
-	0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x74, 0x3a, 0x01, 0x2a, 0x22, 0x6f, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x6b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x65, 0x12, 0x63, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x86, 0x02, 0x0a, 0x03,
+	0x53, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x22, 0xbe, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0xb7, 0x01, 0x3a, 0x01, 0x2a,
+	0x5a, 0x4d, 0x3a, 0x01, 0x2a, 0x22, 0x48, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
 	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x63, 0x65, 0x2e, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
-	0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x2f,
-	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0x4e, 0x92, 0x41, 0x4b, 0x12, 0x49, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x72, 0x20,
-	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x74, 0x6f, 0x20,
-	0x61, 0x6e, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x32, 0x9f, 0x05, 0x0a, 0x13, 0x41, 0x73, 0x45, 0x6e, 0x64,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0xb2,
-	0x01, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
-	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x74,
-	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x65, 0x12, 0x63,
-	0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
-	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
-	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0x86, 0x02, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x74, 0x74,
-	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74,
-	0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
-	0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xbe, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0xb7, 0x01, 0x3a, 0x01, 0x2a, 0x5a, 0x4d, 0x3a, 0x01, 0x2a, 0x22, 0x48, 0x2f,
-	0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x69, 0x64, 0x73, 0x2e,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x63, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a,
+	0x63, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x69, 0x64,
-	0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x95, 0x01, 0x0a,
-	0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x2a, 0x45, 0x2f,
-	0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
-	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x69, 0x64, 0x7d, 0x1a, 0x32, 0x92, 0x41, 0x2f, 0x12, 0x2d, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x20, 0x6f, 0x6e,
-	0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x32, 0xf3, 0x01, 0x0a, 0x18, 0x41, 0x73, 0x45,
-	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x97, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
-	0x33, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x64,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x2a, 0x3f,
-	0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x1a,
-	0x3d, 0x92, 0x41, 0x3a, 0x12, 0x38, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x31,
-	0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73,
-	0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x95, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x4d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x47, 0x2a, 0x45, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x1a, 0x32, 0x92, 0x41,
+	0x2f, 0x12, 0x2d, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x32, 0xf3, 0x01, 0x0a, 0x18, 0x41, 0x73, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x97, 0x01,
+	0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x47,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x2a, 0x3f, 0x2f, 0x61, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x3d, 0x92, 0x41, 0x3a, 0x12, 0x38, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x20, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20,
+	0x65, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x20, 0x6f, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65,
+	0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1591,125 +2242,156 @@ func file_ttn_lorawan_v3_applicationserver_proto_rawDescGZIP() []byte {
 	return file_ttn_lorawan_v3_applicationserver_proto_rawDescData
 }
 
-var file_ttn_lorawan_v3_applicationserver_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ttn_lorawan_v3_applicationserver_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_ttn_lorawan_v3_applicationserver_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ttn_lorawan_v3_applicationserver_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_ttn_lorawan_v3_applicationserver_proto_goTypes = []interface{}{
-	(AsConfiguration_PubSub_Providers_Status)(0), // 0: ttn.lorawan.v3.AsConfiguration.PubSub.Providers.Status
-	(*ApplicationLink)(nil),                      // 1: ttn.lorawan.v3.ApplicationLink
-	(*GetApplicationLinkRequest)(nil),            // 2: ttn.lorawan.v3.GetApplicationLinkRequest
-	(*SetApplicationLinkRequest)(nil),            // 3: ttn.lorawan.v3.SetApplicationLinkRequest
-	(*ApplicationLinkStats)(nil),                 // 4: ttn.lorawan.v3.ApplicationLinkStats
-	(*AsConfiguration)(nil),                      // 5: ttn.lorawan.v3.AsConfiguration
-	(*GetAsConfigurationRequest)(nil),            // 6: ttn.lorawan.v3.GetAsConfigurationRequest
-	(*GetAsConfigurationResponse)(nil),           // 7: ttn.lorawan.v3.GetAsConfigurationResponse
-	(*NsAsHandleUplinkRequest)(nil),              // 8: ttn.lorawan.v3.NsAsHandleUplinkRequest
-	(*EncodeDownlinkRequest)(nil),                // 9: ttn.lorawan.v3.EncodeDownlinkRequest
-	(*EncodeDownlinkResponse)(nil),               // 10: ttn.lorawan.v3.EncodeDownlinkResponse
-	(*DecodeUplinkRequest)(nil),                  // 11: ttn.lorawan.v3.DecodeUplinkRequest
-	(*DecodeUplinkResponse)(nil),                 // 12: ttn.lorawan.v3.DecodeUplinkResponse
-	(*DecodeDownlinkRequest)(nil),                // 13: ttn.lorawan.v3.DecodeDownlinkRequest
-	(*DecodeDownlinkResponse)(nil),               // 14: ttn.lorawan.v3.DecodeDownlinkResponse
-	(*AsConfiguration_PubSub)(nil),               // 15: ttn.lorawan.v3.AsConfiguration.PubSub
-	(*AsConfiguration_Webhooks)(nil),             // 16: ttn.lorawan.v3.AsConfiguration.Webhooks
-	(*AsConfiguration_PubSub_Providers)(nil),     // 17: ttn.lorawan.v3.AsConfiguration.PubSub.Providers
-	(*MessagePayloadFormatters)(nil),             // 18: ttn.lorawan.v3.MessagePayloadFormatters
-	(*wrapperspb.BoolValue)(nil),                 // 19: google.protobuf.BoolValue
-	(*ApplicationIdentifiers)(nil),               // 20: ttn.lorawan.v3.ApplicationIdentifiers
-	(*fieldmaskpb.FieldMask)(nil),                // 21: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),                // 22: google.protobuf.Timestamp
-	(*ApplicationUp)(nil),                        // 23: ttn.lorawan.v3.ApplicationUp
-	(*EndDeviceIdentifiers)(nil),                 // 24: ttn.lorawan.v3.EndDeviceIdentifiers
-	(*EndDeviceVersionIdentifiers)(nil),          // 25: ttn.lorawan.v3.EndDeviceVersionIdentifiers
-	(*ApplicationDownlink)(nil),                  // 26: ttn.lorawan.v3.ApplicationDownlink
-	(PayloadFormatter)(0),                        // 27: ttn.lorawan.v3.PayloadFormatter
-	(*ApplicationUplink)(nil),                    // 28: ttn.lorawan.v3.ApplicationUplink
-	(*durationpb.Duration)(nil),                  // 29: google.protobuf.Duration
-	(*DownlinkQueueRequest)(nil),                 // 30: ttn.lorawan.v3.DownlinkQueueRequest
-	(*GetEndDeviceRequest)(nil),                  // 31: ttn.lorawan.v3.GetEndDeviceRequest
-	(*SetEndDeviceRequest)(nil),                  // 32: ttn.lorawan.v3.SetEndDeviceRequest
-	(*BatchDeleteEndDevicesRequest)(nil),         // 33: ttn.lorawan.v3.BatchDeleteEndDevicesRequest
-	(*emptypb.Empty)(nil),                        // 34: google.protobuf.Empty
-	(*ApplicationDownlinks)(nil),                 // 35: ttn.lorawan.v3.ApplicationDownlinks
-	(*MQTTConnectionInfo)(nil),                   // 36: ttn.lorawan.v3.MQTTConnectionInfo
-	(*EndDevice)(nil),                            // 37: ttn.lorawan.v3.EndDevice
+	(AsConfiguration_PubSub_Providers_Status)(0),      // 0: ttn.lorawan.v3.AsConfiguration.PubSub.Providers.Status
+	(ReplayApplicationUpsRequest_Source)(0),           // 1: ttn.lorawan.v3.ReplayApplicationUpsRequest.Source
+	(*ApplicationLink)(nil),                           // 2: ttn.lorawan.v3.ApplicationLink
+	(*GetApplicationLinkRequest)(nil),                 // 3: ttn.lorawan.v3.GetApplicationLinkRequest
+	(*SetApplicationLinkRequest)(nil),                 // 4: ttn.lorawan.v3.SetApplicationLinkRequest
+	(*ApplicationLinkStats)(nil),                      // 5: ttn.lorawan.v3.ApplicationLinkStats
+	(*AsConfiguration)(nil),                           // 6: ttn.lorawan.v3.AsConfiguration
+	(*GetAsConfigurationRequest)(nil),                 // 7: ttn.lorawan.v3.GetAsConfigurationRequest
+	(*GetAsConfigurationResponse)(nil),                // 8: ttn.lorawan.v3.GetAsConfigurationResponse
+	(*NsAsHandleUplinkRequest)(nil),                   // 9: ttn.lorawan.v3.NsAsHandleUplinkRequest
+	(*EncodeDownlinkRequest)(nil),                     // 10: ttn.lorawan.v3.EncodeDownlinkRequest
+	(*EncodeDownlinkResponse)(nil),                    // 11: ttn.lorawan.v3.EncodeDownlinkResponse
+	(*DecodeUplinkRequest)(nil),                       // 12: ttn.lorawan.v3.DecodeUplinkRequest
+	(*DecodeUplinkResponse)(nil),                      // 13: ttn.lorawan.v3.DecodeUplinkResponse
+	(*DecodeDownlinkRequest)(nil),                     // 14: ttn.lorawan.v3.DecodeDownlinkRequest
+	(*DecodeDownlinkResponse)(nil),                    // 15: ttn.lorawan.v3.DecodeDownlinkResponse
+	(*GetApplicationEndDeviceStateRequest)(nil),       // 16: ttn.lorawan.v3.GetApplicationEndDeviceStateRequest
+	(*BatchGetApplicationEndDeviceStatesRequest)(nil), // 17: ttn.lorawan.v3.BatchGetApplicationEndDeviceStatesRequest
+	(*ApplicationEndDeviceState)(nil),                 // 18: ttn.lorawan.v3.ApplicationEndDeviceState
+	(*ApplicationEndDeviceStates)(nil),                // 19: ttn.lorawan.v3.ApplicationEndDeviceStates
+	(*ReplayApplicationUpsRequest)(nil),               // 20: ttn.lorawan.v3.ReplayApplicationUpsRequest
+	(*ReplayApplicationUpsResponse)(nil),              // 21: ttn.lorawan.v3.ReplayApplicationUpsResponse
+	(*AsConfiguration_PubSub)(nil),                    // 22: ttn.lorawan.v3.AsConfiguration.PubSub
+	(*AsConfiguration_Webhooks)(nil),                  // 23: ttn.lorawan.v3.AsConfiguration.Webhooks
+	(*AsConfiguration_PubSub_Providers)(nil),          // 24: ttn.lorawan.v3.AsConfiguration.PubSub.Providers
+	(*ApplicationEndDeviceState_Uplinks)(nil),         // 25: ttn.lorawan.v3.ApplicationEndDeviceState.Uplinks
+	nil,                                  // 26: ttn.lorawan.v3.ApplicationEndDeviceState.UplinksEntry
+	(*MessagePayloadFormatters)(nil),     // 27: ttn.lorawan.v3.MessagePayloadFormatters
+	(*wrapperspb.BoolValue)(nil),         // 28: google.protobuf.BoolValue
+	(*ApplicationIdentifiers)(nil),       // 29: ttn.lorawan.v3.ApplicationIdentifiers
+	(*fieldmaskpb.FieldMask)(nil),        // 30: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),        // 31: google.protobuf.Timestamp
+	(*ApplicationUp)(nil),                // 32: ttn.lorawan.v3.ApplicationUp
+	(*EndDeviceIdentifiers)(nil),         // 33: ttn.lorawan.v3.EndDeviceIdentifiers
+	(*EndDeviceVersionIdentifiers)(nil),  // 34: ttn.lorawan.v3.EndDeviceVersionIdentifiers
+	(*ApplicationDownlink)(nil),          // 35: ttn.lorawan.v3.ApplicationDownlink
+	(PayloadFormatter)(0),                // 36: ttn.lorawan.v3.PayloadFormatter
+	(*ApplicationUplink)(nil),            // 37: ttn.lorawan.v3.ApplicationUplink
+	(*ApplicationUpReplayTarget)(nil),    // 38: ttn.lorawan.v3.ApplicationUpReplayTarget
+	(*durationpb.Duration)(nil),          // 39: google.protobuf.Duration
+	(*DownlinkQueueRequest)(nil),         // 40: ttn.lorawan.v3.DownlinkQueueRequest
+	(*GetEndDeviceRequest)(nil),          // 41: ttn.lorawan.v3.GetEndDeviceRequest
+	(*SetEndDeviceRequest)(nil),          // 42: ttn.lorawan.v3.SetEndDeviceRequest
+	(*BatchDeleteEndDevicesRequest)(nil), // 43: ttn.lorawan.v3.BatchDeleteEndDevicesRequest
+	(*emptypb.Empty)(nil),                // 44: google.protobuf.Empty
+	(*ApplicationDownlinks)(nil),         // 45: ttn.lorawan.v3.ApplicationDownlinks
+	(*MQTTConnectionInfo)(nil),           // 46: ttn.lorawan.v3.MQTTConnectionInfo
+	(*EndDevice)(nil),                    // 47: ttn.lorawan.v3.EndDevice
 }
 var file_ttn_lorawan_v3_applicationserver_proto_depIdxs = []int32{
-	18, // 0: ttn.lorawan.v3.ApplicationLink.default_formatters:type_name -> ttn.lorawan.v3.MessagePayloadFormatters
-	19, // 1: ttn.lorawan.v3.ApplicationLink.skip_payload_crypto:type_name -> google.protobuf.BoolValue
-	20, // 2: ttn.lorawan.v3.GetApplicationLinkRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
-	21, // 3: ttn.lorawan.v3.GetApplicationLinkRequest.field_mask:type_name -> google.protobuf.FieldMask
-	20, // 4: ttn.lorawan.v3.SetApplicationLinkRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
-	1,  // 5: ttn.lorawan.v3.SetApplicationLinkRequest.link:type_name -> ttn.lorawan.v3.ApplicationLink
-	21, // 6: ttn.lorawan.v3.SetApplicationLinkRequest.field_mask:type_name -> google.protobuf.FieldMask
-	22, // 7: ttn.lorawan.v3.ApplicationLinkStats.linked_at:type_name -> google.protobuf.Timestamp
-	22, // 8: ttn.lorawan.v3.ApplicationLinkStats.last_up_received_at:type_name -> google.protobuf.Timestamp
-	22, // 9: ttn.lorawan.v3.ApplicationLinkStats.last_downlink_forwarded_at:type_name -> google.protobuf.Timestamp
-	15, // 10: ttn.lorawan.v3.AsConfiguration.pubsub:type_name -> ttn.lorawan.v3.AsConfiguration.PubSub
-	16, // 11: ttn.lorawan.v3.AsConfiguration.webhooks:type_name -> ttn.lorawan.v3.AsConfiguration.Webhooks
-	5,  // 12: ttn.lorawan.v3.GetAsConfigurationResponse.configuration:type_name -> ttn.lorawan.v3.AsConfiguration
-	23, // 13: ttn.lorawan.v3.NsAsHandleUplinkRequest.application_ups:type_name -> ttn.lorawan.v3.ApplicationUp
-	24, // 14: ttn.lorawan.v3.EncodeDownlinkRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
-	25, // 15: ttn.lorawan.v3.EncodeDownlinkRequest.version_ids:type_name -> ttn.lorawan.v3.EndDeviceVersionIdentifiers
-	26, // 16: ttn.lorawan.v3.EncodeDownlinkRequest.downlink:type_name -> ttn.lorawan.v3.ApplicationDownlink
-	27, // 17: ttn.lorawan.v3.EncodeDownlinkRequest.formatter:type_name -> ttn.lorawan.v3.PayloadFormatter
-	26, // 18: ttn.lorawan.v3.EncodeDownlinkResponse.downlink:type_name -> ttn.lorawan.v3.ApplicationDownlink
-	24, // 19: ttn.lorawan.v3.DecodeUplinkRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
-	25, // 20: ttn.lorawan.v3.DecodeUplinkRequest.version_ids:type_name -> ttn.lorawan.v3.EndDeviceVersionIdentifiers
-	28, // 21: ttn.lorawan.v3.DecodeUplinkRequest.uplink:type_name -> ttn.lorawan.v3.ApplicationUplink
-	27, // 22: ttn.lorawan.v3.DecodeUplinkRequest.formatter:type_name -> ttn.lorawan.v3.PayloadFormatter
-	28, // 23: ttn.lorawan.v3.DecodeUplinkResponse.uplink:type_name -> ttn.lorawan.v3.ApplicationUplink
-	24, // 24: ttn.lorawan.v3.DecodeDownlinkRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
-	25, // 25: ttn.lorawan.v3.DecodeDownlinkRequest.version_ids:type_name -> ttn.lorawan.v3.EndDeviceVersionIdentifiers
-	26, // 26: ttn.lorawan.v3.DecodeDownlinkRequest.downlink:type_name -> ttn.lorawan.v3.ApplicationDownlink
-	27, // 27: ttn.lorawan.v3.DecodeDownlinkRequest.formatter:type_name -> ttn.lorawan.v3.PayloadFormatter
-	26, // 28: ttn.lorawan.v3.DecodeDownlinkResponse.downlink:type_name -> ttn.lorawan.v3.ApplicationDownlink
-	17, // 29: ttn.lorawan.v3.AsConfiguration.PubSub.providers:type_name -> ttn.lorawan.v3.AsConfiguration.PubSub.Providers
-	29, // 30: ttn.lorawan.v3.AsConfiguration.Webhooks.unhealthy_retry_interval:type_name -> google.protobuf.Duration
-	0,  // 31: ttn.lorawan.v3.AsConfiguration.PubSub.Providers.mqtt:type_name -> ttn.lorawan.v3.AsConfiguration.PubSub.Providers.Status
-	0,  // 32: ttn.lorawan.v3.AsConfiguration.PubSub.Providers.nats:type_name -> ttn.lorawan.v3.AsConfiguration.PubSub.Providers.Status
-	2,  // 33: ttn.lorawan.v3.As.GetLink:input_type -> ttn.lorawan.v3.GetApplicationLinkRequest
-	3,  // 34: ttn.lorawan.v3.As.SetLink:input_type -> ttn.lorawan.v3.SetApplicationLinkRequest
-	20, // 35: ttn.lorawan.v3.As.DeleteLink:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
-	20, // 36: ttn.lorawan.v3.As.GetLinkStats:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
-	6,  // 37: ttn.lorawan.v3.As.GetConfiguration:input_type -> ttn.lorawan.v3.GetAsConfigurationRequest
-	8,  // 38: ttn.lorawan.v3.NsAs.HandleUplink:input_type -> ttn.lorawan.v3.NsAsHandleUplinkRequest
-	20, // 39: ttn.lorawan.v3.AppAs.Subscribe:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
-	30, // 40: ttn.lorawan.v3.AppAs.DownlinkQueuePush:input_type -> ttn.lorawan.v3.DownlinkQueueRequest
-	30, // 41: ttn.lorawan.v3.AppAs.DownlinkQueueReplace:input_type -> ttn.lorawan.v3.DownlinkQueueRequest
-	24, // 42: ttn.lorawan.v3.AppAs.DownlinkQueueList:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
-	20, // 43: ttn.lorawan.v3.AppAs.GetMQTTConnectionInfo:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
-	23, // 44: ttn.lorawan.v3.AppAs.SimulateUplink:input_type -> ttn.lorawan.v3.ApplicationUp
-	9,  // 45: ttn.lorawan.v3.AppAs.EncodeDownlink:input_type -> ttn.lorawan.v3.EncodeDownlinkRequest
-	11, // 46: ttn.lorawan.v3.AppAs.DecodeUplink:input_type -> ttn.lorawan.v3.DecodeUplinkRequest
-	13, // 47: ttn.lorawan.v3.AppAs.DecodeDownlink:input_type -> ttn.lorawan.v3.DecodeDownlinkRequest
-	31, // 48: ttn.lorawan.v3.AsEndDeviceRegistry.Get:input_type -> ttn.lorawan.v3.GetEndDeviceRequest
-	32, // 49: ttn.lorawan.v3.AsEndDeviceRegistry.Set:input_type -> ttn.lorawan.v3.SetEndDeviceRequest
-	24, // 50: ttn.lorawan.v3.AsEndDeviceRegistry.Delete:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
-	33, // 51: ttn.lorawan.v3.AsEndDeviceBatchRegistry.Delete:input_type -> ttn.lorawan.v3.BatchDeleteEndDevicesRequest
-	1,  // 52: ttn.lorawan.v3.As.GetLink:output_type -> ttn.lorawan.v3.ApplicationLink
-	1,  // 53: ttn.lorawan.v3.As.SetLink:output_type -> ttn.lorawan.v3.ApplicationLink
-	34, // 54: ttn.lorawan.v3.As.DeleteLink:output_type -> google.protobuf.Empty
-	4,  // 55: ttn.lorawan.v3.As.GetLinkStats:output_type -> ttn.lorawan.v3.ApplicationLinkStats
-	7,  // 56: ttn.lorawan.v3.As.GetConfiguration:output_type -> ttn.lorawan.v3.GetAsConfigurationResponse
-	34, // 57: ttn.lorawan.v3.NsAs.HandleUplink:output_type -> google.protobuf.Empty
-	23, // 58: ttn.lorawan.v3.AppAs.Subscribe:output_type -> ttn.lorawan.v3.ApplicationUp
-	34, // 59: ttn.lorawan.v3.AppAs.DownlinkQueuePush:output_type -> google.protobuf.Empty
-	34, // 60: ttn.lorawan.v3.AppAs.DownlinkQueueReplace:output_type -> google.protobuf.Empty
-	35, // 61: ttn.lorawan.v3.AppAs.DownlinkQueueList:output_type -> ttn.lorawan.v3.ApplicationDownlinks
-	36, // 62: ttn.lorawan.v3.AppAs.GetMQTTConnectionInfo:output_type -> ttn.lorawan.v3.MQTTConnectionInfo
-	34, // 63: ttn.lorawan.v3.AppAs.SimulateUplink:output_type -> google.protobuf.Empty
-	10, // 64: ttn.lorawan.v3.AppAs.EncodeDownlink:output_type -> ttn.lorawan.v3.EncodeDownlinkResponse
-	12, // 65: ttn.lorawan.v3.AppAs.DecodeUplink:output_type -> ttn.lorawan.v3.DecodeUplinkResponse
-	14, // 66: ttn.lorawan.v3.AppAs.DecodeDownlink:output_type -> ttn.lorawan.v3.DecodeDownlinkResponse
-	37, // 67: ttn.lorawan.v3.AsEndDeviceRegistry.Get:output_type -> ttn.lorawan.v3.EndDevice
-	37, // 68: ttn.lorawan.v3.AsEndDeviceRegistry.Set:output_type -> ttn.lorawan.v3.EndDevice
-	34, // 69: ttn.lorawan.v3.AsEndDeviceRegistry.Delete:output_type -> google.protobuf.Empty
-	34, // 70: ttn.lorawan.v3.AsEndDeviceBatchRegistry.Delete:output_type -> google.protobuf.Empty
-	52, // [52:71] is the sub-list for method output_type
-	33, // [33:52] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	27, // 0: ttn.lorawan.v3.ApplicationLink.default_formatters:type_name -> ttn.lorawan.v3.MessagePayloadFormatters
+	28, // 1: ttn.lorawan.v3.ApplicationLink.skip_payload_crypto:type_name -> google.protobuf.BoolValue
+	29, // 2: ttn.lorawan.v3.GetApplicationLinkRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	30, // 3: ttn.lorawan.v3.GetApplicationLinkRequest.field_mask:type_name -> google.protobuf.FieldMask
+	29, // 4: ttn.lorawan.v3.SetApplicationLinkRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	2,  // 5: ttn.lorawan.v3.SetApplicationLinkRequest.link:type_name -> ttn.lorawan.v3.ApplicationLink
+	30, // 6: ttn.lorawan.v3.SetApplicationLinkRequest.field_mask:type_name -> google.protobuf.FieldMask
+	31, // 7: ttn.lorawan.v3.ApplicationLinkStats.linked_at:type_name -> google.protobuf.Timestamp
+	31, // 8: ttn.lorawan.v3.ApplicationLinkStats.last_up_received_at:type_name -> google.protobuf.Timestamp
+	31, // 9: ttn.lorawan.v3.ApplicationLinkStats.last_downlink_forwarded_at:type_name -> google.protobuf.Timestamp
+	22, // 10: ttn.lorawan.v3.AsConfiguration.pubsub:type_name -> ttn.lorawan.v3.AsConfiguration.PubSub
+	23, // 11: ttn.lorawan.v3.AsConfiguration.webhooks:type_name -> ttn.lorawan.v3.AsConfiguration.Webhooks
+	6,  // 12: ttn.lorawan.v3.GetAsConfigurationResponse.configuration:type_name -> ttn.lorawan.v3.AsConfiguration
+	32, // 13: ttn.lorawan.v3.NsAsHandleUplinkRequest.application_ups:type_name -> ttn.lorawan.v3.ApplicationUp
+	33, // 14: ttn.lorawan.v3.EncodeDownlinkRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	34, // 15: ttn.lorawan.v3.EncodeDownlinkRequest.version_ids:type_name -> ttn.lorawan.v3.EndDeviceVersionIdentifiers
+	35, // 16: ttn.lorawan.v3.EncodeDownlinkRequest.downlink:type_name -> ttn.lorawan.v3.ApplicationDownlink
+	36, // 17: ttn.lorawan.v3.EncodeDownlinkRequest.formatter:type_name -> ttn.lorawan.v3.PayloadFormatter
+	35, // 18: ttn.lorawan.v3.EncodeDownlinkResponse.downlink:type_name -> ttn.lorawan.v3.ApplicationDownlink
+	33, // 19: ttn.lorawan.v3.DecodeUplinkRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	34, // 20: ttn.lorawan.v3.DecodeUplinkRequest.version_ids:type_name -> ttn.lorawan.v3.EndDeviceVersionIdentifiers
+	37, // 21: ttn.lorawan.v3.DecodeUplinkRequest.uplink:type_name -> ttn.lorawan.v3.ApplicationUplink
+	36, // 22: ttn.lorawan.v3.DecodeUplinkRequest.formatter:type_name -> ttn.lorawan.v3.PayloadFormatter
+	37, // 23: ttn.lorawan.v3.DecodeUplinkResponse.uplink:type_name -> ttn.lorawan.v3.ApplicationUplink
+	33, // 24: ttn.lorawan.v3.DecodeDownlinkRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	34, // 25: ttn.lorawan.v3.DecodeDownlinkRequest.version_ids:type_name -> ttn.lorawan.v3.EndDeviceVersionIdentifiers
+	35, // 26: ttn.lorawan.v3.DecodeDownlinkRequest.downlink:type_name -> ttn.lorawan.v3.ApplicationDownlink
+	36, // 27: ttn.lorawan.v3.DecodeDownlinkRequest.formatter:type_name -> ttn.lorawan.v3.PayloadFormatter
+	35, // 28: ttn.lorawan.v3.DecodeDownlinkResponse.downlink:type_name -> ttn.lorawan.v3.ApplicationDownlink
+	33, // 29: ttn.lorawan.v3.GetApplicationEndDeviceStateRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	29, // 30: ttn.lorawan.v3.BatchGetApplicationEndDeviceStatesRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	33, // 31: ttn.lorawan.v3.ApplicationEndDeviceState.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	26, // 32: ttn.lorawan.v3.ApplicationEndDeviceState.uplinks:type_name -> ttn.lorawan.v3.ApplicationEndDeviceState.UplinksEntry
+	32, // 33: ttn.lorawan.v3.ApplicationEndDeviceState.last_normalized:type_name -> ttn.lorawan.v3.ApplicationUp
+	18, // 34: ttn.lorawan.v3.ApplicationEndDeviceStates.states:type_name -> ttn.lorawan.v3.ApplicationEndDeviceState
+	29, // 35: ttn.lorawan.v3.ReplayApplicationUpsRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	1,  // 36: ttn.lorawan.v3.ReplayApplicationUpsRequest.source:type_name -> ttn.lorawan.v3.ReplayApplicationUpsRequest.Source
+	38, // 37: ttn.lorawan.v3.ReplayApplicationUpsRequest.target:type_name -> ttn.lorawan.v3.ApplicationUpReplayTarget
+	31, // 38: ttn.lorawan.v3.ReplayApplicationUpsRequest.start_time:type_name -> google.protobuf.Timestamp
+	31, // 39: ttn.lorawan.v3.ReplayApplicationUpsRequest.end_time:type_name -> google.protobuf.Timestamp
+	24, // 40: ttn.lorawan.v3.AsConfiguration.PubSub.providers:type_name -> ttn.lorawan.v3.AsConfiguration.PubSub.Providers
+	39, // 41: ttn.lorawan.v3.AsConfiguration.Webhooks.unhealthy_retry_interval:type_name -> google.protobuf.Duration
+	0,  // 42: ttn.lorawan.v3.AsConfiguration.PubSub.Providers.mqtt:type_name -> ttn.lorawan.v3.AsConfiguration.PubSub.Providers.Status
+	0,  // 43: ttn.lorawan.v3.AsConfiguration.PubSub.Providers.nats:type_name -> ttn.lorawan.v3.AsConfiguration.PubSub.Providers.Status
+	32, // 44: ttn.lorawan.v3.ApplicationEndDeviceState.Uplinks.uplinks:type_name -> ttn.lorawan.v3.ApplicationUp
+	25, // 45: ttn.lorawan.v3.ApplicationEndDeviceState.UplinksEntry.value:type_name -> ttn.lorawan.v3.ApplicationEndDeviceState.Uplinks
+	3,  // 46: ttn.lorawan.v3.As.GetLink:input_type -> ttn.lorawan.v3.GetApplicationLinkRequest
+	4,  // 47: ttn.lorawan.v3.As.SetLink:input_type -> ttn.lorawan.v3.SetApplicationLinkRequest
+	29, // 48: ttn.lorawan.v3.As.DeleteLink:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
+	29, // 49: ttn.lorawan.v3.As.GetLinkStats:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
+	7,  // 50: ttn.lorawan.v3.As.GetConfiguration:input_type -> ttn.lorawan.v3.GetAsConfigurationRequest
+	9,  // 51: ttn.lorawan.v3.NsAs.HandleUplink:input_type -> ttn.lorawan.v3.NsAsHandleUplinkRequest
+	29, // 52: ttn.lorawan.v3.AppAs.Subscribe:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
+	40, // 53: ttn.lorawan.v3.AppAs.DownlinkQueuePush:input_type -> ttn.lorawan.v3.DownlinkQueueRequest
+	40, // 54: ttn.lorawan.v3.AppAs.DownlinkQueueReplace:input_type -> ttn.lorawan.v3.DownlinkQueueRequest
+	33, // 55: ttn.lorawan.v3.AppAs.DownlinkQueueList:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	29, // 56: ttn.lorawan.v3.AppAs.GetMQTTConnectionInfo:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
+	32, // 57: ttn.lorawan.v3.AppAs.SimulateUplink:input_type -> ttn.lorawan.v3.ApplicationUp
+	10, // 58: ttn.lorawan.v3.AppAs.EncodeDownlink:input_type -> ttn.lorawan.v3.EncodeDownlinkRequest
+	12, // 59: ttn.lorawan.v3.AppAs.DecodeUplink:input_type -> ttn.lorawan.v3.DecodeUplinkRequest
+	14, // 60: ttn.lorawan.v3.AppAs.DecodeDownlink:input_type -> ttn.lorawan.v3.DecodeDownlinkRequest
+	16, // 61: ttn.lorawan.v3.AppAs.GetEndDeviceState:input_type -> ttn.lorawan.v3.GetApplicationEndDeviceStateRequest
+	17, // 62: ttn.lorawan.v3.AppAs.BatchGetEndDeviceStates:input_type -> ttn.lorawan.v3.BatchGetApplicationEndDeviceStatesRequest
+	20, // 63: ttn.lorawan.v3.AppAs.ReplayUps:input_type -> ttn.lorawan.v3.ReplayApplicationUpsRequest
+	29, // 64: ttn.lorawan.v3.AppAs.CancelReplayUps:input_type -> ttn.lorawan.v3.ApplicationIdentifiers
+	41, // 65: ttn.lorawan.v3.AsEndDeviceRegistry.Get:input_type -> ttn.lorawan.v3.GetEndDeviceRequest
+	42, // 66: ttn.lorawan.v3.AsEndDeviceRegistry.Set:input_type -> ttn.lorawan.v3.SetEndDeviceRequest
+	33, // 67: ttn.lorawan.v3.AsEndDeviceRegistry.Delete:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	43, // 68: ttn.lorawan.v3.AsEndDeviceBatchRegistry.Delete:input_type -> ttn.lorawan.v3.BatchDeleteEndDevicesRequest
+	2,  // 69: ttn.lorawan.v3.As.GetLink:output_type -> ttn.lorawan.v3.ApplicationLink
+	2,  // 70: ttn.lorawan.v3.As.SetLink:output_type -> ttn.lorawan.v3.ApplicationLink
+	44, // 71: ttn.lorawan.v3.As.DeleteLink:output_type -> google.protobuf.Empty
+	5,  // 72: ttn.lorawan.v3.As.GetLinkStats:output_type -> ttn.lorawan.v3.ApplicationLinkStats
+	8,  // 73: ttn.lorawan.v3.As.GetConfiguration:output_type -> ttn.lorawan.v3.GetAsConfigurationResponse
+	44, // 74: ttn.lorawan.v3.NsAs.HandleUplink:output_type -> google.protobuf.Empty
+	32, // 75: ttn.lorawan.v3.AppAs.Subscribe:output_type -> ttn.lorawan.v3.ApplicationUp
+	44, // 76: ttn.lorawan.v3.AppAs.DownlinkQueuePush:output_type -> google.protobuf.Empty
+	44, // 77: ttn.lorawan.v3.AppAs.DownlinkQueueReplace:output_type -> google.protobuf.Empty
+	45, // 78: ttn.lorawan.v3.AppAs.DownlinkQueueList:output_type -> ttn.lorawan.v3.ApplicationDownlinks
+	46, // 79: ttn.lorawan.v3.AppAs.GetMQTTConnectionInfo:output_type -> ttn.lorawan.v3.MQTTConnectionInfo
+	44, // 80: ttn.lorawan.v3.AppAs.SimulateUplink:output_type -> google.protobuf.Empty
+	11, // 81: ttn.lorawan.v3.AppAs.EncodeDownlink:output_type -> ttn.lorawan.v3.EncodeDownlinkResponse
+	13, // 82: ttn.lorawan.v3.AppAs.DecodeUplink:output_type -> ttn.lorawan.v3.DecodeUplinkResponse
+	15, // 83: ttn.lorawan.v3.AppAs.DecodeDownlink:output_type -> ttn.lorawan.v3.DecodeDownlinkResponse
+	18, // 84: ttn.lorawan.v3.AppAs.GetEndDeviceState:output_type -> ttn.lorawan.v3.ApplicationEndDeviceState
+	19, // 85: ttn.lorawan.v3.AppAs.BatchGetEndDeviceStates:output_type -> ttn.lorawan.v3.ApplicationEndDeviceStates
+	21, // 86: ttn.lorawan.v3.AppAs.ReplayUps:output_type -> ttn.lorawan.v3.ReplayApplicationUpsResponse
+	44, // 87: ttn.lorawan.v3.AppAs.CancelReplayUps:output_type -> google.protobuf.Empty
+	47, // 88: ttn.lorawan.v3.AsEndDeviceRegistry.Get:output_type -> ttn.lorawan.v3.EndDevice
+	47, // 89: ttn.lorawan.v3.AsEndDeviceRegistry.Set:output_type -> ttn.lorawan.v3.EndDevice
+	44, // 90: ttn.lorawan.v3.AsEndDeviceRegistry.Delete:output_type -> google.protobuf.Empty
+	44, // 91: ttn.lorawan.v3.AsEndDeviceBatchRegistry.Delete:output_type -> google.protobuf.Empty
+	69, // [69:92] is the sub-list for method output_type
+	46, // [46:69] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_ttn_lorawan_v3_applicationserver_proto_init() }
@@ -1891,7 +2573,7 @@ func file_ttn_lorawan_v3_applicationserver_proto_init() {
 			}
 		}
 		file_ttn_lorawan_v3_applicationserver_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AsConfiguration_PubSub); i {
+			switch v := v.(*GetApplicationEndDeviceStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1903,7 +2585,7 @@ func file_ttn_lorawan_v3_applicationserver_proto_init() {
 			}
 		}
 		file_ttn_lorawan_v3_applicationserver_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AsConfiguration_Webhooks); i {
+			switch v := v.(*BatchGetApplicationEndDeviceStatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1915,6 +2597,78 @@ func file_ttn_lorawan_v3_applicationserver_proto_init() {
 			}
 		}
 		file_ttn_lorawan_v3_applicationserver_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplicationEndDeviceState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_applicationserver_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplicationEndDeviceStates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_applicationserver_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayApplicationUpsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_applicationserver_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayApplicationUpsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_applicationserver_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsConfiguration_PubSub); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_applicationserver_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AsConfiguration_Webhooks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_applicationserver_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AsConfiguration_PubSub_Providers); i {
 			case 0:
 				return &v.state
@@ -1926,14 +2680,26 @@ func file_ttn_lorawan_v3_applicationserver_proto_init() {
 				return nil
 			}
 		}
+		file_ttn_lorawan_v3_applicationserver_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplicationEndDeviceState_Uplinks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ttn_lorawan_v3_applicationserver_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   17,
+			NumEnums:      2,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   5,
 		},
@@ -109,11 +109,13 @@ var EncodeDownlinkRequestFieldPathsNested = []string{
 	"downlink.correlation_ids",
 	"downlink.decoded_payload",
 	"downlink.decoded_payload_warnings",
+	"downlink.expires_at",
 	"downlink.f_cnt",
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
 	"downlink.session_key_id",
+	"downlink.ttl",
 	"end_device_ids",
 	"end_device_ids.application_ids",
 	"end_device_ids.application_ids.application_id",
@@ -150,11 +152,13 @@ var EncodeDownlinkResponseFieldPathsNested = []string{
 	"downlink.correlation_ids",
 	"downlink.decoded_payload",
 	"downlink.decoded_payload_warnings",
+	"downlink.expires_at",
 	"downlink.f_cnt",
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
 	"downlink.session_key_id",
+	"downlink.ttl",
 }
 
 var EncodeDownlinkResponseFieldPathsTopLevel = []string{
@@ -313,11 +317,13 @@ var DecodeDownlinkRequestFieldPathsNested = []string{
 	"downlink.correlation_ids",
 	"downlink.decoded_payload",
 	"downlink.decoded_payload_warnings",
+	"downlink.expires_at",
 	"downlink.f_cnt",
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
 	"downlink.session_key_id",
+	"downlink.ttl",
 	"end_device_ids",
 	"end_device_ids.application_ids",
 	"end_device_ids.application_ids.application_id",
@@ -354,16 +360,358 @@ var DecodeDownlinkResponseFieldPathsNested = []string{
 	"downlink.correlation_ids",
 	"downlink.decoded_payload",
 	"downlink.decoded_payload_warnings",
+	"downlink.expires_at",
 	"downlink.f_cnt",
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
 	"downlink.session_key_id",
+	"downlink.ttl",
 }
 
 var DecodeDownlinkResponseFieldPathsTopLevel = []string{
 	"downlink",
 }
+var GetApplicationEndDeviceStateRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"f_port",
+	"limit",
+}
+
+var GetApplicationEndDeviceStateRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"f_port",
+	"limit",
+}
+var BatchGetApplicationEndDeviceStatesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"device_ids",
+	"f_port",
+	"limit",
+}
+
+var BatchGetApplicationEndDeviceStatesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"device_ids",
+	"f_port",
+	"limit",
+}
+var ApplicationEndDeviceStateFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"last_normalized",
+	"last_normalized.correlation_ids",
+	"last_normalized.end_device_ids",
+	"last_normalized.end_device_ids.application_ids",
+	"last_normalized.end_device_ids.application_ids.application_id",
+	"last_normalized.end_device_ids.dev_addr",
+	"last_normalized.end_device_ids.dev_eui",
+	"last_normalized.end_device_ids.device_id",
+	"last_normalized.end_device_ids.join_eui",
+	"last_normalized.received_at",
+	"last_normalized.replay",
+	"last_normalized.replay.replay_id",
+	"last_normalized.replay.target",
+	"last_normalized.replay.target.integration",
+	"last_normalized.replay.target.integration.mqtt",
+	"last_normalized.replay.target.integration.pub_sub",
+	"last_normalized.replay.target.integration.pub_sub.pub_sub_id",
+	"last_normalized.replay.target.integration.webhook",
+	"last_normalized.replay.target.integration.webhook.webhook_id",
+	"last_normalized.simulated",
+	"last_normalized.up",
+	"last_normalized.up.downlink_ack",
+	"last_normalized.up.downlink_ack.class_b_c",
+	"last_normalized.up.downlink_ack.class_b_c.absolute_time",
+	"last_normalized.up.downlink_ack.class_b_c.gateways",
+	"last_normalized.up.downlink_ack.confirmed",
+	"last_normalized.up.downlink_ack.confirmed_retry",
+	"last_normalized.up.downlink_ack.confirmed_retry.attempt",
+	"last_normalized.up.downlink_ack.confirmed_retry.max_attempts",
+	"last_normalized.up.downlink_ack.correlation_ids",
+	"last_normalized.up.downlink_ack.decoded_payload",
+	"last_normalized.up.downlink_ack.decoded_payload_warnings",
+	"last_normalized.up.downlink_ack.expires_at",
+	"last_normalized.up.downlink_ack.f_cnt",
+	"last_normalized.up.downlink_ack.f_port",
+	"last_normalized.up.downlink_ack.frm_payload",
+	"last_normalized.up.downlink_ack.priority",
+	"last_normalized.up.downlink_ack.session_key_id",
+	"last_normalized.up.downlink_ack.ttl",
+	"last_normalized.up.downlink_failed",
+	"last_normalized.up.downlink_failed.downlink",
+	"last_normalized.up.downlink_failed.downlink.class_b_c",
+	"last_normalized.up.downlink_failed.downlink.class_b_c.absolute_time",
+	"last_normalized.up.downlink_failed.downlink.class_b_c.gateways",
+	"last_normalized.up.downlink_failed.downlink.confirmed",
+	"last_normalized.up.downlink_failed.downlink.confirmed_retry",
+	"last_normalized.up.downlink_failed.downlink.confirmed_retry.attempt",
+	"last_normalized.up.downlink_failed.downlink.confirmed_retry.max_attempts",
+	"last_normalized.up.downlink_failed.downlink.correlation_ids",
+	"last_normalized.up.downlink_failed.downlink.decoded_payload",
+	"last_normalized.up.downlink_failed.downlink.decoded_payload_warnings",
+	"last_normalized.up.downlink_failed.downlink.expires_at",
+	"last_normalized.up.downlink_failed.downlink.f_cnt",
+	"last_normalized.up.downlink_failed.downlink.f_port",
+	"last_normalized.up.downlink_failed.downlink.frm_payload",
+	"last_normalized.up.downlink_failed.downlink.priority",
+	"last_normalized.up.downlink_failed.downlink.session_key_id",
+	"last_normalized.up.downlink_failed.downlink.ttl",
+	"last_normalized.up.downlink_failed.error",
+	"last_normalized.up.downlink_failed.error.attributes",
+	"last_normalized.up.downlink_failed.error.cause",
+	"last_normalized.up.downlink_failed.error.cause.attributes",
+	"last_normalized.up.downlink_failed.error.cause.correlation_id",
+	"last_normalized.up.downlink_failed.error.cause.message_format",
+	"last_normalized.up.downlink_failed.error.cause.name",
+	"last_normalized.up.downlink_failed.error.cause.namespace",
+	"last_normalized.up.downlink_failed.error.code",
+	"last_normalized.up.downlink_failed.error.correlation_id",
+	"last_normalized.up.downlink_failed.error.details",
+	"last_normalized.up.downlink_failed.error.message_format",
+	"last_normalized.up.downlink_failed.error.name",
+	"last_normalized.up.downlink_failed.error.namespace",
+	"last_normalized.up.downlink_nack",
+	"last_normalized.up.downlink_nack.class_b_c",
+	"last_normalized.up.downlink_nack.class_b_c.absolute_time",
+	"last_normalized.up.downlink_nack.class_b_c.gateways",
+	"last_normalized.up.downlink_nack.confirmed",
+	"last_normalized.up.downlink_nack.confirmed_retry",
+	"last_normalized.up.downlink_nack.confirmed_retry.attempt",
+	"last_normalized.up.downlink_nack.confirmed_retry.max_attempts",
+	"last_normalized.up.downlink_nack.correlation_ids",
+	"last_normalized.up.downlink_nack.decoded_payload",
+	"last_normalized.up.downlink_nack.decoded_payload_warnings",
+	"last_normalized.up.downlink_nack.expires_at",
+	"last_normalized.up.downlink_nack.f_cnt",
+	"last_normalized.up.downlink_nack.f_port",
+	"last_normalized.up.downlink_nack.frm_payload",
+	"last_normalized.up.downlink_nack.priority",
+	"last_normalized.up.downlink_nack.session_key_id",
+	"last_normalized.up.downlink_nack.ttl",
+	"last_normalized.up.downlink_queue_invalidated",
+	"last_normalized.up.downlink_queue_invalidated.downlinks",
+	"last_normalized.up.downlink_queue_invalidated.last_f_cnt_down",
+	"last_normalized.up.downlink_queue_invalidated.session_key_id",
+	"last_normalized.up.downlink_queued",
+	"last_normalized.up.downlink_queued.class_b_c",
+	"last_normalized.up.downlink_queued.class_b_c.absolute_time",
+	"last_normalized.up.downlink_queued.class_b_c.gateways",
+	"last_normalized.up.downlink_queued.confirmed",
+	"last_normalized.up.downlink_queued.confirmed_retry",
+	"last_normalized.up.downlink_queued.confirmed_retry.attempt",
+	"last_normalized.up.downlink_queued.confirmed_retry.max_attempts",
+	"last_normalized.up.downlink_queued.correlation_ids",
+	"last_normalized.up.downlink_queued.decoded_payload",
+	"last_normalized.up.downlink_queued.decoded_payload_warnings",
+	"last_normalized.up.downlink_queued.expires_at",
+	"last_normalized.up.downlink_queued.f_cnt",
+	"last_normalized.up.downlink_queued.f_port",
+	"last_normalized.up.downlink_queued.frm_payload",
+	"last_normalized.up.downlink_queued.priority",
+	"last_normalized.up.downlink_queued.session_key_id",
+	"last_normalized.up.downlink_queued.ttl",
+	"last_normalized.up.downlink_sent",
+	"last_normalized.up.downlink_sent.class_b_c",
+	"last_normalized.up.downlink_sent.class_b_c.absolute_time",
+	"last_normalized.up.downlink_sent.class_b_c.gateways",
+	"last_normalized.up.downlink_sent.confirmed",
+	"last_normalized.up.downlink_sent.confirmed_retry",
+	"last_normalized.up.downlink_sent.confirmed_retry.attempt",
+	"last_normalized.up.downlink_sent.confirmed_retry.max_attempts",
+	"last_normalized.up.downlink_sent.correlation_ids",
+	"last_normalized.up.downlink_sent.decoded_payload",
+	"last_normalized.up.downlink_sent.decoded_payload_warnings",
+	"last_normalized.up.downlink_sent.expires_at",
+	"last_normalized.up.downlink_sent.f_cnt",
+	"last_normalized.up.downlink_sent.f_port",
+	"last_normalized.up.downlink_sent.frm_payload",
+	"last_normalized.up.downlink_sent.priority",
+	"last_normalized.up.downlink_sent.session_key_id",
+	"last_normalized.up.downlink_sent.ttl",
+	"last_normalized.up.join_accept",
+	"last_normalized.up.join_accept.app_s_key",
+	"last_normalized.up.join_accept.app_s_key.encrypted_key",
+	"last_normalized.up.join_accept.app_s_key.kek_label",
+	"last_normalized.up.join_accept.app_s_key.key",
+	"last_normalized.up.join_accept.invalidated_downlinks",
+	"last_normalized.up.join_accept.pending_session",
+	"last_normalized.up.join_accept.received_at",
+	"last_normalized.up.join_accept.session_key_id",
+	"last_normalized.up.location_solved",
+	"last_normalized.up.location_solved.attributes",
+	"last_normalized.up.location_solved.location",
+	"last_normalized.up.location_solved.location.accuracy",
+	"last_normalized.up.location_solved.location.altitude",
+	"last_normalized.up.location_solved.location.latitude",
+	"last_normalized.up.location_solved.location.longitude",
+	"last_normalized.up.location_solved.location.source",
+	"last_normalized.up.location_solved.service",
+	"last_normalized.up.service_data",
+	"last_normalized.up.service_data.data",
+	"last_normalized.up.service_data.service",
+	"last_normalized.up.uplink_message",
+	"last_normalized.up.uplink_message.app_s_key",
+	"last_normalized.up.uplink_message.app_s_key.encrypted_key",
+	"last_normalized.up.uplink_message.app_s_key.kek_label",
+	"last_normalized.up.uplink_message.app_s_key.key",
+	"last_normalized.up.uplink_message.confirmed",
+	"last_normalized.up.uplink_message.consumed_airtime",
+	"last_normalized.up.uplink_message.decoded_payload",
+	"last_normalized.up.uplink_message.decoded_payload_warnings",
+	"last_normalized.up.uplink_message.f_cnt",
+	"last_normalized.up.uplink_message.f_port",
+	"last_normalized.up.uplink_message.frm_payload",
+	"last_normalized.up.uplink_message.last_a_f_cnt_down",
+	"last_normalized.up.uplink_message.locations",
+	"last_normalized.up.uplink_message.network_ids",
+	"last_normalized.up.uplink_message.network_ids.cluster_address",
+	"last_normalized.up.uplink_message.network_ids.cluster_id",
+	"last_normalized.up.uplink_message.network_ids.net_id",
+	"last_normalized.up.uplink_message.network_ids.ns_id",
+	"last_normalized.up.uplink_message.network_ids.tenant_address",
+	"last_normalized.up.uplink_message.network_ids.tenant_id",
+	"last_normalized.up.uplink_message.normalized_payload",
+	"last_normalized.up.uplink_message.normalized_payload_warnings",
+	"last_normalized.up.uplink_message.packet_error_rate",
+	"last_normalized.up.uplink_message.received_at",
+	"last_normalized.up.uplink_message.rx_metadata",
+	"last_normalized.up.uplink_message.session_key_id",
+	"last_normalized.up.uplink_message.settings",
+	"last_normalized.up.uplink_message.settings.concentrator_timestamp",
+	"last_normalized.up.uplink_message.settings.data_rate",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation.fsk",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation.fsk.bit_rate",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation.lora",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation.lora.bandwidth",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation.lora.coding_rate",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation.lora.spreading_factor",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation.lrfhss",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation.lrfhss.coding_rate",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation.lrfhss.modulation_type",
+	"last_normalized.up.uplink_message.settings.data_rate.modulation.lrfhss.operating_channel_width",
+	"last_normalized.up.uplink_message.settings.downlink",
+	"last_normalized.up.uplink_message.settings.downlink.antenna_index",
+	"last_normalized.up.uplink_message.settings.downlink.invert_polarization",
+	"last_normalized.up.uplink_message.settings.downlink.tx_power",
+	"last_normalized.up.uplink_message.settings.enable_crc",
+	"last_normalized.up.uplink_message.settings.frequency",
+	"last_normalized.up.uplink_message.settings.time",
+	"last_normalized.up.uplink_message.settings.timestamp",
+	"last_normalized.up.uplink_message.version_ids",
+	"last_normalized.up.uplink_message.version_ids.band_id",
+	"last_normalized.up.uplink_message.version_ids.brand_id",
+	"last_normalized.up.uplink_message.version_ids.firmware_version",
+	"last_normalized.up.uplink_message.version_ids.hardware_version",
+	"last_normalized.up.uplink_message.version_ids.model_id",
+	"last_normalized.up.uplink_normalized",
+	"last_normalized.up.uplink_normalized.confirmed",
+	"last_normalized.up.uplink_normalized.consumed_airtime",
+	"last_normalized.up.uplink_normalized.f_cnt",
+	"last_normalized.up.uplink_normalized.f_port",
+	"last_normalized.up.uplink_normalized.frm_payload",
+	"last_normalized.up.uplink_normalized.locations",
+	"last_normalized.up.uplink_normalized.network_ids",
+	"last_normalized.up.uplink_normalized.network_ids.cluster_address",
+	"last_normalized.up.uplink_normalized.network_ids.cluster_id",
+	"last_normalized.up.uplink_normalized.network_ids.net_id",
+	"last_normalized.up.uplink_normalized.network_ids.ns_id",
+	"last_normalized.up.uplink_normalized.network_ids.tenant_address",
+	"last_normalized.up.uplink_normalized.network_ids.tenant_id",
+	"last_normalized.up.uplink_normalized.normalized_payload",
+	"last_normalized.up.uplink_normalized.normalized_payload_warnings",
+	"last_normalized.up.uplink_normalized.received_at",
+	"last_normalized.up.uplink_normalized.rx_metadata",
+	"last_normalized.up.uplink_normalized.session_key_id",
+	"last_normalized.up.uplink_normalized.settings",
+	"last_normalized.up.uplink_normalized.settings.concentrator_timestamp",
+	"last_normalized.up.uplink_normalized.settings.data_rate",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation.fsk",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation.fsk.bit_rate",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation.lora",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation.lora.bandwidth",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation.lora.coding_rate",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation.lora.spreading_factor",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation.lrfhss",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation.lrfhss.coding_rate",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation.lrfhss.modulation_type",
+	"last_normalized.up.uplink_normalized.settings.data_rate.modulation.lrfhss.operating_channel_width",
+	"last_normalized.up.uplink_normalized.settings.downlink",
+	"last_normalized.up.uplink_normalized.settings.downlink.antenna_index",
+	"last_normalized.up.uplink_normalized.settings.downlink.invert_polarization",
+	"last_normalized.up.uplink_normalized.settings.downlink.tx_power",
+	"last_normalized.up.uplink_normalized.settings.enable_crc",
+	"last_normalized.up.uplink_normalized.settings.frequency",
+	"last_normalized.up.uplink_normalized.settings.time",
+	"last_normalized.up.uplink_normalized.settings.timestamp",
+	"last_normalized.up.uplink_normalized.version_ids",
+	"last_normalized.up.uplink_normalized.version_ids.band_id",
+	"last_normalized.up.uplink_normalized.version_ids.brand_id",
+	"last_normalized.up.uplink_normalized.version_ids.firmware_version",
+	"last_normalized.up.uplink_normalized.version_ids.hardware_version",
+	"last_normalized.up.uplink_normalized.version_ids.model_id",
+	"uplinks",
+}
+
+var ApplicationEndDeviceStateFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"last_normalized",
+	"uplinks",
+}
+var ApplicationEndDeviceStatesFieldPathsNested = []string{
+	"states",
+}
+
+var ApplicationEndDeviceStatesFieldPathsTopLevel = []string{
+	"states",
+}
+var ReplayApplicationUpsRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"device_ids",
+	"end_time",
+	"source",
+	"start_time",
+	"target",
+	"target.integration",
+	"target.integration.mqtt",
+	"target.integration.pub_sub",
+	"target.integration.pub_sub.pub_sub_id",
+	"target.integration.webhook",
+	"target.integration.webhook.webhook_id",
+}
+
+var ReplayApplicationUpsRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"device_ids",
+	"end_time",
+	"source",
+	"start_time",
+	"target",
+}
+var ReplayApplicationUpsResponseFieldPathsNested = []string{
+	"messages",
+	"replay_id",
+}
+
+var ReplayApplicationUpsResponseFieldPathsTopLevel = []string{
+	"messages",
+	"replay_id",
+}
 var AsConfiguration_PubSubFieldPathsNested = []string{
 	"providers",
 	"providers.mqtt",
@@ -391,3 +739,10 @@ var AsConfiguration_PubSub_ProvidersFieldPathsTopLevel = []string{
 	"mqtt",
 	"nats",
 }
+var ApplicationEndDeviceState_UplinksFieldPathsNested = []string{
+	"uplinks",
+}
+
+var ApplicationEndDeviceState_UplinksFieldPathsTopLevel = []string{
+	"uplinks",
+}
@@ -779,6 +779,345 @@ func (dst *DecodeDownlinkResponse) SetFields(src *DecodeDownlinkResponse, paths
 	return nil
 }
 
+func (dst *GetApplicationEndDeviceStateRequest) SetFields(src *GetApplicationEndDeviceStateRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *BatchGetApplicationEndDeviceStatesRequest) SetFields(src *BatchGetApplicationEndDeviceStatesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIds = src.DeviceIds
+			} else {
+				dst.DeviceIds = nil
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationEndDeviceState) SetFields(src *ApplicationEndDeviceState, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Uplinks = src.Uplinks
+			} else {
+				dst.Uplinks = nil
+			}
+		case "last_normalized":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationUp
+				if (src == nil || src.LastNormalized == nil) && dst.LastNormalized == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastNormalized
+				}
+				if dst.LastNormalized != nil {
+					newDst = dst.LastNormalized
+				} else {
+					newDst = &ApplicationUp{}
+					dst.LastNormalized = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastNormalized = src.LastNormalized
+				} else {
+					dst.LastNormalized = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationEndDeviceStates) SetFields(src *ApplicationEndDeviceStates, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "states":
+			if len(subs) > 0 {
+				return fmt.Errorf("'states' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.States = src.States
+			} else {
+				dst.States = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ReplayApplicationUpsRequest) SetFields(src *ReplayApplicationUpsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIds = src.DeviceIds
+			} else {
+				dst.DeviceIds = nil
+			}
+		case "source":
+			if len(subs) > 0 {
+				return fmt.Errorf("'source' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Source = src.Source
+			} else {
+				dst.Source = 0
+			}
+		case "target":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationUpReplayTarget
+				if (src == nil || src.Target == nil) && dst.Target == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Target
+				}
+				if dst.Target != nil {
+					newDst = dst.Target
+				} else {
+					newDst = &ApplicationUpReplayTarget{}
+					dst.Target = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Target = src.Target
+				} else {
+					dst.Target = nil
+				}
+			}
+		case "start_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'start_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartTime = src.StartTime
+			} else {
+				dst.StartTime = nil
+			}
+		case "end_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndTime = src.EndTime
+			} else {
+				dst.EndTime = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ReplayApplicationUpsResponse) SetFields(src *ReplayApplicationUpsResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "replay_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'replay_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReplayId = src.ReplayId
+			} else {
+				var zero string
+				dst.ReplayId = zero
+			}
+		case "messages":
+			if len(subs) > 0 {
+				return fmt.Errorf("'messages' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Messages = src.Messages
+			} else {
+				var zero uint32
+				dst.Messages = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *AsConfiguration_PubSub) SetFields(src *AsConfiguration_PubSub, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -873,3 +1212,23 @@ func (dst *AsConfiguration_PubSub_Providers) SetFields(src *AsConfiguration_PubS
 	}
 	return nil
 }
+
+func (dst *ApplicationEndDeviceState_Uplinks) SetFields(src *ApplicationEndDeviceState_Uplinks, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Uplinks = src.Uplinks
+			} else {
+				dst.Uplinks = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -1586,6 +1586,761 @@ var _ interface {
 	ErrorName() string
 } = DecodeDownlinkResponseValidationError{}
 
+// ValidateFields checks the field values on
+// GetApplicationEndDeviceStateRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GetApplicationEndDeviceStateRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetApplicationEndDeviceStateRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return GetApplicationEndDeviceStateRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetApplicationEndDeviceStateRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "f_port":
+
+			if m.GetFPort() > 255 {
+				return GetApplicationEndDeviceStateRequestValidationError{
+					field:  "f_port",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return GetApplicationEndDeviceStateRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		default:
+			return GetApplicationEndDeviceStateRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetApplicationEndDeviceStateRequestValidationError is the validation error
+// returned by GetApplicationEndDeviceStateRequest.ValidateFields if the
+// designated constraints aren't met.
+type GetApplicationEndDeviceStateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApplicationEndDeviceStateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApplicationEndDeviceStateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApplicationEndDeviceStateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApplicationEndDeviceStateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApplicationEndDeviceStateRequestValidationError) ErrorName() string {
+	return "GetApplicationEndDeviceStateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApplicationEndDeviceStateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApplicationEndDeviceStateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApplicationEndDeviceStateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApplicationEndDeviceStateRequestValidationError{}
+
+// ValidateFields checks the field values on
+// BatchGetApplicationEndDeviceStatesRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *BatchGetApplicationEndDeviceStatesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = BatchGetApplicationEndDeviceStatesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return BatchGetApplicationEndDeviceStatesRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchGetApplicationEndDeviceStatesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_ids":
+
+			if len(m.GetDeviceIds()) > 1000 {
+				return BatchGetApplicationEndDeviceStatesRequestValidationError{
+					field:  "device_ids",
+					reason: "value must contain no more than 1000 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetDeviceIds() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 36 {
+					return BatchGetApplicationEndDeviceStatesRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_BatchGetApplicationEndDeviceStatesRequest_DeviceIds_Pattern.MatchString(item) {
+					return BatchGetApplicationEndDeviceStatesRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		case "f_port":
+
+			if m.GetFPort() > 255 {
+				return BatchGetApplicationEndDeviceStatesRequestValidationError{
+					field:  "f_port",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return BatchGetApplicationEndDeviceStatesRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		default:
+			return BatchGetApplicationEndDeviceStatesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BatchGetApplicationEndDeviceStatesRequestValidationError is the validation
+// error returned by BatchGetApplicationEndDeviceStatesRequest.ValidateFields
+// if the designated constraints aren't met.
+type BatchGetApplicationEndDeviceStatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetApplicationEndDeviceStatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetApplicationEndDeviceStatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetApplicationEndDeviceStatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetApplicationEndDeviceStatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetApplicationEndDeviceStatesRequestValidationError) ErrorName() string {
+	return "BatchGetApplicationEndDeviceStatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetApplicationEndDeviceStatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetApplicationEndDeviceStatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetApplicationEndDeviceStatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetApplicationEndDeviceStatesRequestValidationError{}
+
+var _BatchGetApplicationEndDeviceStatesRequest_DeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ApplicationEndDeviceState with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationEndDeviceState) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationEndDeviceStateFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationEndDeviceStateValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplinks":
+
+			for key, val := range m.GetUplinks() {
+				_ = val
+
+				// no validation rules for Uplinks[key]
+
+				if v, ok := interface{}(val).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationEndDeviceStateValidationError{
+							field:  fmt.Sprintf("uplinks[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "last_normalized":
+
+			if v, ok := interface{}(m.GetLastNormalized()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationEndDeviceStateValidationError{
+						field:  "last_normalized",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationEndDeviceStateValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationEndDeviceStateValidationError is the validation error returned by
+// ApplicationEndDeviceState.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationEndDeviceStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationEndDeviceStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationEndDeviceStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationEndDeviceStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationEndDeviceStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationEndDeviceStateValidationError) ErrorName() string {
+	return "ApplicationEndDeviceStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationEndDeviceStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationEndDeviceState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationEndDeviceStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationEndDeviceStateValidationError{}
+
+// ValidateFields checks the field values on ApplicationEndDeviceStates with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationEndDeviceStates) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationEndDeviceStatesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "states":
+
+			for idx, item := range m.GetStates() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationEndDeviceStatesValidationError{
+							field:  fmt.Sprintf("states[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationEndDeviceStatesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationEndDeviceStatesValidationError is the validation error returned
+// by ApplicationEndDeviceStates.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationEndDeviceStatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationEndDeviceStatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationEndDeviceStatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationEndDeviceStatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationEndDeviceStatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationEndDeviceStatesValidationError) ErrorName() string {
+	return "ApplicationEndDeviceStatesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationEndDeviceStatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationEndDeviceStates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationEndDeviceStatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationEndDeviceStatesValidationError{}
+
+// ValidateFields checks the field values on ReplayApplicationUpsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ReplayApplicationUpsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ReplayApplicationUpsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return ReplayApplicationUpsRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ReplayApplicationUpsRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_ids":
+
+			if len(m.GetDeviceIds()) > 1000 {
+				return ReplayApplicationUpsRequestValidationError{
+					field:  "device_ids",
+					reason: "value must contain no more than 1000 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetDeviceIds() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 36 {
+					return ReplayApplicationUpsRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_ReplayApplicationUpsRequest_DeviceIds_Pattern.MatchString(item) {
+					return ReplayApplicationUpsRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		case "source":
+
+			if _, ok := ReplayApplicationUpsRequest_Source_name[int32(m.GetSource())]; !ok {
+				return ReplayApplicationUpsRequestValidationError{
+					field:  "source",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "target":
+
+			if m.GetTarget() == nil {
+				return ReplayApplicationUpsRequestValidationError{
+					field:  "target",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetTarget()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ReplayApplicationUpsRequestValidationError{
+						field:  "target",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "start_time":
+
+			if m.GetStartTime() == nil {
+				return ReplayApplicationUpsRequestValidationError{
+					field:  "start_time",
+					reason: "value is required",
+				}
+			}
+
+		case "end_time":
+
+			if m.GetEndTime() == nil {
+				return ReplayApplicationUpsRequestValidationError{
+					field:  "end_time",
+					reason: "value is required",
+				}
+			}
+
+		default:
+			return ReplayApplicationUpsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ReplayApplicationUpsRequestValidationError is the validation error returned
+// by ReplayApplicationUpsRequest.ValidateFields if the designated constraints
+// aren't met.
+type ReplayApplicationUpsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayApplicationUpsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayApplicationUpsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayApplicationUpsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayApplicationUpsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayApplicationUpsRequestValidationError) ErrorName() string {
+	return "ReplayApplicationUpsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayApplicationUpsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayApplicationUpsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayApplicationUpsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayApplicationUpsRequestValidationError{}
+
+var _ReplayApplicationUpsRequest_DeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ReplayApplicationUpsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ReplayApplicationUpsResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ReplayApplicationUpsResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "replay_id":
+			// no validation rules for ReplayId
+		case "messages":
+			// no validation rules for Messages
+		default:
+			return ReplayApplicationUpsResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ReplayApplicationUpsResponseValidationError is the validation error returned
+// by ReplayApplicationUpsResponse.ValidateFields if the designated
+// constraints aren't met.
+type ReplayApplicationUpsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayApplicationUpsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayApplicationUpsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayApplicationUpsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayApplicationUpsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayApplicationUpsResponseValidationError) ErrorName() string {
+	return "ReplayApplicationUpsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayApplicationUpsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayApplicationUpsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayApplicationUpsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayApplicationUpsResponseValidationError{}
+
 // ValidateFields checks the field values on AsConfiguration_PubSub with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1860,3 +2615,102 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AsConfiguration_PubSub_ProvidersValidationError{}
+
+// ValidateFields checks the field values on ApplicationEndDeviceState_Uplinks
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationEndDeviceState_Uplinks) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationEndDeviceState_UplinksFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "uplinks":
+
+			for idx, item := range m.GetUplinks() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationEndDeviceState_UplinksValidationError{
+							field:  fmt.Sprintf("uplinks[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationEndDeviceState_UplinksValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationEndDeviceState_UplinksValidationError is the validation error
+// returned by ApplicationEndDeviceState_Uplinks.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationEndDeviceState_UplinksValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationEndDeviceState_UplinksValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationEndDeviceState_UplinksValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationEndDeviceState_UplinksValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationEndDeviceState_UplinksValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationEndDeviceState_UplinksValidationError) ErrorName() string {
+	return "ApplicationEndDeviceState_UplinksValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationEndDeviceState_UplinksValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationEndDeviceState_Uplinks.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationEndDeviceState_UplinksValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationEndDeviceState_UplinksValidationError{}
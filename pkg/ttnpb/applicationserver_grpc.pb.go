@@ -384,15 +384,19 @@ var NsAs_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	AppAs_Subscribe_FullMethodName             = "/ttn.lorawan.v3.AppAs/Subscribe"
-	AppAs_DownlinkQueuePush_FullMethodName     = "/ttn.lorawan.v3.AppAs/DownlinkQueuePush"
-	AppAs_DownlinkQueueReplace_FullMethodName  = "/ttn.lorawan.v3.AppAs/DownlinkQueueReplace"
-	AppAs_DownlinkQueueList_FullMethodName     = "/ttn.lorawan.v3.AppAs/DownlinkQueueList"
-	AppAs_GetMQTTConnectionInfo_FullMethodName = "/ttn.lorawan.v3.AppAs/GetMQTTConnectionInfo"
-	AppAs_SimulateUplink_FullMethodName        = "/ttn.lorawan.v3.AppAs/SimulateUplink"
-	AppAs_EncodeDownlink_FullMethodName        = "/ttn.lorawan.v3.AppAs/EncodeDownlink"
-	AppAs_DecodeUplink_FullMethodName          = "/ttn.lorawan.v3.AppAs/DecodeUplink"
-	AppAs_DecodeDownlink_FullMethodName        = "/ttn.lorawan.v3.AppAs/DecodeDownlink"
+	AppAs_Subscribe_FullMethodName               = "/ttn.lorawan.v3.AppAs/Subscribe"
+	AppAs_DownlinkQueuePush_FullMethodName       = "/ttn.lorawan.v3.AppAs/DownlinkQueuePush"
+	AppAs_DownlinkQueueReplace_FullMethodName    = "/ttn.lorawan.v3.AppAs/DownlinkQueueReplace"
+	AppAs_DownlinkQueueList_FullMethodName       = "/ttn.lorawan.v3.AppAs/DownlinkQueueList"
+	AppAs_GetMQTTConnectionInfo_FullMethodName   = "/ttn.lorawan.v3.AppAs/GetMQTTConnectionInfo"
+	AppAs_SimulateUplink_FullMethodName          = "/ttn.lorawan.v3.AppAs/SimulateUplink"
+	AppAs_EncodeDownlink_FullMethodName          = "/ttn.lorawan.v3.AppAs/EncodeDownlink"
+	AppAs_DecodeUplink_FullMethodName            = "/ttn.lorawan.v3.AppAs/DecodeUplink"
+	AppAs_DecodeDownlink_FullMethodName          = "/ttn.lorawan.v3.AppAs/DecodeDownlink"
+	AppAs_GetEndDeviceState_FullMethodName       = "/ttn.lorawan.v3.AppAs/GetEndDeviceState"
+	AppAs_BatchGetEndDeviceStates_FullMethodName = "/ttn.lorawan.v3.AppAs/BatchGetEndDeviceStates"
+	AppAs_ReplayUps_FullMethodName               = "/ttn.lorawan.v3.AppAs/ReplayUps"
+	AppAs_CancelReplayUps_FullMethodName         = "/ttn.lorawan.v3.AppAs/CancelReplayUps"
 )
 
 // AppAsClient is the client API for AppAs service.
@@ -415,6 +419,16 @@ type AppAsClient interface {
 	EncodeDownlink(ctx context.Context, in *EncodeDownlinkRequest, opts ...grpc.CallOption) (*EncodeDownlinkResponse, error)
 	DecodeUplink(ctx context.Context, in *DecodeUplinkRequest, opts ...grpc.CallOption) (*DecodeUplinkResponse, error)
 	DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequest, opts ...grpc.CallOption) (*DecodeDownlinkResponse, error)
+	// Get the last decoded uplink messages and the last normalized uplink message of the end device.
+	GetEndDeviceState(ctx context.Context, in *GetApplicationEndDeviceStateRequest, opts ...grpc.CallOption) (*ApplicationEndDeviceState, error)
+	// Get the last decoded uplink messages and the last normalized uplink messages of end devices of the application.
+	// End devices without state are omitted.
+	BatchGetEndDeviceStates(ctx context.Context, in *BatchGetApplicationEndDeviceStatesRequest, opts ...grpc.CallOption) (*ApplicationEndDeviceStates, error)
+	// Replay the historical upstream messages of the application to a single integration, at a limited rate.
+	// Only one replay per application can be active at a time.
+	ReplayUps(ctx context.Context, in *ReplayApplicationUpsRequest, opts ...grpc.CallOption) (*ReplayApplicationUpsResponse, error)
+	// Cancel the active replay of the application.
+	CancelReplayUps(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type appAsClient struct {
@@ -529,6 +543,42 @@ func (c *appAsClient) DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequ
 	return out, nil
 }
 
+func (c *appAsClient) GetEndDeviceState(ctx context.Context, in *GetApplicationEndDeviceStateRequest, opts ...grpc.CallOption) (*ApplicationEndDeviceState, error) {
+	out := new(ApplicationEndDeviceState)
+	err := c.cc.Invoke(ctx, AppAs_GetEndDeviceState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) BatchGetEndDeviceStates(ctx context.Context, in *BatchGetApplicationEndDeviceStatesRequest, opts ...grpc.CallOption) (*ApplicationEndDeviceStates, error) {
+	out := new(ApplicationEndDeviceStates)
+	err := c.cc.Invoke(ctx, AppAs_BatchGetEndDeviceStates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) ReplayUps(ctx context.Context, in *ReplayApplicationUpsRequest, opts ...grpc.CallOption) (*ReplayApplicationUpsResponse, error) {
+	out := new(ReplayApplicationUpsResponse)
+	err := c.cc.Invoke(ctx, AppAs_ReplayUps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) CancelReplayUps(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AppAs_CancelReplayUps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AppAsServer is the server API for AppAs service.
 // All implementations must embed UnimplementedAppAsServer
 // for forward compatibility
@@ -549,6 +599,16 @@ type AppAsServer interface {
 	EncodeDownlink(context.Context, *EncodeDownlinkRequest) (*EncodeDownlinkResponse, error)
 	DecodeUplink(context.Context, *DecodeUplinkRequest) (*DecodeUplinkResponse, error)
 	DecodeDownlink(context.Context, *DecodeDownlinkRequest) (*DecodeDownlinkResponse, error)
+	// Get the last decoded uplink messages and the last normalized uplink message of the end device.
+	GetEndDeviceState(context.Context, *GetApplicationEndDeviceStateRequest) (*ApplicationEndDeviceState, error)
+	// Get the last decoded uplink messages and the last normalized uplink messages of end devices of the application.
+	// End devices without state are omitted.
+	BatchGetEndDeviceStates(context.Context, *BatchGetApplicationEndDeviceStatesRequest) (*ApplicationEndDeviceStates, error)
+	// Replay the historical upstream messages of the application to a single integration, at a limited rate.
+	// Only one replay per application can be active at a time.
+	ReplayUps(context.Context, *ReplayApplicationUpsRequest) (*ReplayApplicationUpsResponse, error)
+	// Cancel the active replay of the application.
+	CancelReplayUps(context.Context, *ApplicationIdentifiers) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAppAsServer()
 }
 
@@ -583,6 +643,18 @@ func (UnimplementedAppAsServer) DecodeUplink(context.Context, *DecodeUplinkReque
 func (UnimplementedAppAsServer) DecodeDownlink(context.Context, *DecodeDownlinkRequest) (*DecodeDownlinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecodeDownlink not implemented")
 }
+func (UnimplementedAppAsServer) GetEndDeviceState(context.Context, *GetApplicationEndDeviceStateRequest) (*ApplicationEndDeviceState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndDeviceState not implemented")
+}
+func (UnimplementedAppAsServer) BatchGetEndDeviceStates(context.Context, *BatchGetApplicationEndDeviceStatesRequest) (*ApplicationEndDeviceStates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetEndDeviceStates not implemented")
+}
+func (UnimplementedAppAsServer) ReplayUps(context.Context, *ReplayApplicationUpsRequest) (*ReplayApplicationUpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayUps not implemented")
+}
+func (UnimplementedAppAsServer) CancelReplayUps(context.Context, *ApplicationIdentifiers) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReplayUps not implemented")
+}
 func (UnimplementedAppAsServer) mustEmbedUnimplementedAppAsServer() {}
 
 // UnsafeAppAsServer may be embedded to opt out of forward compatibility for this service.
@@ -761,6 +833,78 @@ func _AppAs_DecodeDownlink_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AppAs_GetEndDeviceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationEndDeviceStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).GetEndDeviceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppAs_GetEndDeviceState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).GetEndDeviceState(ctx, req.(*GetApplicationEndDeviceStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_BatchGetEndDeviceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetApplicationEndDeviceStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).BatchGetEndDeviceStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppAs_BatchGetEndDeviceStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).BatchGetEndDeviceStates(ctx, req.(*BatchGetApplicationEndDeviceStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_ReplayUps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayApplicationUpsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).ReplayUps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppAs_ReplayUps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).ReplayUps(ctx, req.(*ReplayApplicationUpsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_CancelReplayUps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).CancelReplayUps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppAs_CancelReplayUps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).CancelReplayUps(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AppAs_ServiceDesc is the grpc.ServiceDesc for AppAs service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -800,6 +944,22 @@ var AppAs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DecodeDownlink",
 			Handler:    _AppAs_DecodeDownlink_Handler,
 		},
+		{
+			MethodName: "GetEndDeviceState",
+			Handler:    _AppAs_GetEndDeviceState_Handler,
+		},
+		{
+			MethodName: "BatchGetEndDeviceStates",
+			Handler:    _AppAs_BatchGetEndDeviceStates_Handler,
+		},
+		{
+			MethodName: "ReplayUps",
+			Handler:    _AppAs_ReplayUps_Handler,
+		},
+		{
+			MethodName: "CancelReplayUps",
+			Handler:    _AppAs_CancelReplayUps_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
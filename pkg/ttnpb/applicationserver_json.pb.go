@@ -944,3 +944,273 @@ func (x *DecodeDownlinkResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState
 func (x *DecodeDownlinkResponse) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the GetApplicationEndDeviceStateRequest message to JSON.
+func (x *GetApplicationEndDeviceStateRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.FPort != 0 || s.HasField("f_port") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_port")
+		s.WriteUint32(x.FPort)
+	}
+	if x.Limit != 0 || s.HasField("limit") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("limit")
+		s.WriteUint32(x.Limit)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetApplicationEndDeviceStateRequest to JSON.
+func (x *GetApplicationEndDeviceStateRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetApplicationEndDeviceStateRequest message from JSON.
+func (x *GetApplicationEndDeviceStateRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "f_port", "fPort":
+			s.AddField("f_port")
+			x.FPort = s.ReadUint32()
+		case "limit":
+			s.AddField("limit")
+			x.Limit = s.ReadUint32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetApplicationEndDeviceStateRequest from JSON.
+func (x *GetApplicationEndDeviceStateRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ApplicationEndDeviceState_Uplinks message to JSON.
+func (x *ApplicationEndDeviceState_Uplinks) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Uplinks) > 0 || s.HasField("uplinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uplinks")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Uplinks {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("uplinks"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ApplicationEndDeviceState_Uplinks to JSON.
+func (x *ApplicationEndDeviceState_Uplinks) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationEndDeviceState_Uplinks message from JSON.
+func (x *ApplicationEndDeviceState_Uplinks) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "uplinks":
+			s.AddField("uplinks")
+			if s.ReadNil() {
+				x.Uplinks = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Uplinks = append(x.Uplinks, nil)
+					return
+				}
+				v := &ApplicationUp{}
+				v.UnmarshalProtoJSON(s.WithField("uplinks", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Uplinks = append(x.Uplinks, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ApplicationEndDeviceState_Uplinks from JSON.
+func (x *ApplicationEndDeviceState_Uplinks) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ApplicationEndDeviceState message to JSON.
+func (x *ApplicationEndDeviceState) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.Uplinks != nil || s.HasField("uplinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uplinks")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.Uplinks {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectUint32Field(k)
+			v.MarshalProtoJSON(s.WithField("uplinks"))
+		}
+		s.WriteObjectEnd()
+	}
+	if x.LastNormalized != nil || s.HasField("last_normalized") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("last_normalized")
+		x.LastNormalized.MarshalProtoJSON(s.WithField("last_normalized"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ApplicationEndDeviceState to JSON.
+func (x *ApplicationEndDeviceState) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationEndDeviceState message from JSON.
+func (x *ApplicationEndDeviceState) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "uplinks":
+			s.AddField("uplinks")
+			if s.ReadNil() {
+				x.Uplinks = nil
+				return
+			}
+			x.Uplinks = make(map[uint32]*ApplicationEndDeviceState_Uplinks)
+			s.ReadUint32Map(func(key uint32) {
+				var v ApplicationEndDeviceState_Uplinks
+				v.UnmarshalProtoJSON(s)
+				x.Uplinks[key] = &v
+			})
+		case "last_normalized", "lastNormalized":
+			if s.ReadNil() {
+				x.LastNormalized = nil
+				return
+			}
+			x.LastNormalized = &ApplicationUp{}
+			x.LastNormalized.UnmarshalProtoJSON(s.WithField("last_normalized", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ApplicationEndDeviceState from JSON.
+func (x *ApplicationEndDeviceState) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ApplicationEndDeviceStates message to JSON.
+func (x *ApplicationEndDeviceStates) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.States) > 0 || s.HasField("states") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("states")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.States {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("states"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ApplicationEndDeviceStates to JSON.
+func (x *ApplicationEndDeviceStates) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationEndDeviceStates message from JSON.
+func (x *ApplicationEndDeviceStates) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "states":
+			s.AddField("states")
+			if s.ReadNil() {
+				x.States = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.States = append(x.States, nil)
+					return
+				}
+				v := &ApplicationEndDeviceState{}
+				v.UnmarshalProtoJSON(s.WithField("states", false))
+				if s.Err() != nil {
+					return
+				}
+				x.States = append(x.States, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ApplicationEndDeviceStates from JSON.
+func (x *ApplicationEndDeviceStates) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
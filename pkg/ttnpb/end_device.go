@@ -617,6 +617,8 @@ func (v *ADRSettings_DynamicMode) FieldIsZero(p string) bool {
 	switch p {
 	case "margin":
 		return v.Margin == nil
+	case "algorithm":
+		return v.Algorithm == ""
 	case "channel_steering":
 		return v.ChannelSteering == nil
 	case "channel_steering.mode":
@@ -761,6 +763,8 @@ func (v *ADRSettings) FieldIsZero(p string) bool {
 		return v.GetStatic().FieldIsZero("nb_trans")
 	case "mode.dynamic":
 		return v.GetDynamic() == nil
+	case "mode.dynamic.algorithm":
+		return v.GetDynamic().FieldIsZero("algorithm")
 	case "mode.dynamic.channel_steering":
 		return v.GetDynamic().FieldIsZero("channel_steering")
 	case "mode.dynamic.channel_steering.mode":
@@ -911,6 +915,8 @@ func (v *MACSettings) FieldIsZero(p string) bool {
 		return v.Adr.FieldIsZero("mode.static.nb_trans")
 	case "adr.mode.dynamic":
 		return v.Adr.FieldIsZero("mode.dynamic")
+	case "adr.mode.dynamic.algorithm":
+		return v.Adr.FieldIsZero("mode.dynamic.algorithm")
 	case "adr.mode.dynamic.channel_steering":
 		return v.Adr.FieldIsZero("mode.dynamic.channel_steering")
 	case "adr.mode.dynamic.channel_steering.mode":
@@ -1303,6 +1309,12 @@ func (v *MACSettings) FieldIsZero(p string) bool {
 		return v.DownlinkDwellTime == nil
 	case "downlink_dwell_time.value":
 		return v.DownlinkDwellTime.FieldIsZero("value")
+	case "downlink_path_ranking":
+		return v.DownlinkPathRanking == ""
+	case "auto_f_cnt_resync":
+		return v.AutoFCntResync == nil
+	case "auto_f_cnt_resync.value":
+		return v.AutoFCntResync.FieldIsZero("value")
 	}
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
@@ -1845,6 +1857,8 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.PendingApplicationDownlink.FieldIsZero("confirmed_retry.attempt")
 	case "pending_application_downlink.confirmed_retry.max_attempts":
 		return v.PendingApplicationDownlink.FieldIsZero("confirmed_retry.max_attempts")
+	case "pending_application_downlink.expires_at":
+		return v.PendingApplicationDownlink.FieldIsZero("expires_at")
 	case "pending_application_downlink.decoded_payload":
 		return v.PendingApplicationDownlink.FieldIsZero("decoded_payload")
 	case "pending_application_downlink.decoded_payload_warnings":
@@ -1857,6 +1871,8 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.PendingApplicationDownlink.FieldIsZero("frm_payload")
 	case "pending_application_downlink.priority":
 		return v.PendingApplicationDownlink.FieldIsZero("priority")
+	case "pending_application_downlink.ttl":
+		return v.PendingApplicationDownlink.FieldIsZero("ttl")
 	case "pending_application_downlink.session_key_id":
 		return v.PendingApplicationDownlink.FieldIsZero("session_key_id")
 	case "pending_join_request":
@@ -2267,6 +2283,8 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.MacSettings.FieldIsZero("adr.mode.static.nb_trans")
 	case "mac_settings.adr.mode.dynamic":
 		return v.MacSettings.FieldIsZero("adr.mode.dynamic")
+	case "mac_settings.adr.mode.dynamic.algorithm":
+		return v.MacSettings.FieldIsZero("adr.mode.dynamic.algorithm")
 	case "mac_settings.adr.mode.dynamic.channel_steering":
 		return v.MacSettings.FieldIsZero("adr.mode.dynamic.channel_steering")
 	case "mac_settings.adr.mode.dynamic.channel_steering.mode":
@@ -2651,6 +2669,12 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.MacSettings.FieldIsZero("uplink_dwell_time")
 	case "mac_settings.uplink_dwell_time.value":
 		return v.MacSettings.FieldIsZero("uplink_dwell_time.value")
+	case "mac_settings.downlink_path_ranking":
+		return v.MacSettings.FieldIsZero("downlink_path_ranking")
+	case "mac_settings.auto_f_cnt_resync":
+		return v.MacSettings.FieldIsZero("auto_f_cnt_resync")
+	case "mac_settings.auto_f_cnt_resync.value":
+		return v.MacSettings.FieldIsZero("auto_f_cnt_resync.value")
 	case "mac_settings.downlink_dwell_time":
 		return v.MacSettings.FieldIsZero("downlink_dwell_time")
 	case "mac_settings.downlink_dwell_time.value":
@@ -371,6 +371,7 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_mac_settings.adr.mode",
 	"default_mac_settings.adr.mode.disabled",
 	"default_mac_settings.adr.mode.dynamic",
+	"default_mac_settings.adr.mode.dynamic.algorithm",
 	"default_mac_settings.adr.mode.dynamic.channel_steering",
 	"default_mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"default_mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -438,6 +439,8 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_mac_settings.adr.mode.static.nb_trans",
 	"default_mac_settings.adr.mode.static.tx_power_index",
 	"default_mac_settings.adr_margin",
+	"default_mac_settings.auto_f_cnt_resync",
+	"default_mac_settings.auto_f_cnt_resync.value",
 	"default_mac_settings.beacon_frequency",
 	"default_mac_settings.beacon_frequency.value",
 	"default_mac_settings.class_b_c_downlink_interval",
@@ -503,6 +506,7 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_mac_settings.desired_rx2_frequency.value",
 	"default_mac_settings.downlink_dwell_time",
 	"default_mac_settings.downlink_dwell_time.value",
+	"default_mac_settings.downlink_path_ranking",
 	"default_mac_settings.factory_preset_frequencies",
 	"default_mac_settings.max_duty_cycle",
 	"default_mac_settings.max_duty_cycle.value",
@@ -605,6 +609,7 @@ var ADRSettingsFieldPathsNested = []string{
 	"mode",
 	"mode.disabled",
 	"mode.dynamic",
+	"mode.dynamic.algorithm",
 	"mode.dynamic.channel_steering",
 	"mode.dynamic.channel_steering.mode",
 	"mode.dynamic.channel_steering.mode.disabled",
@@ -681,6 +686,7 @@ var MACSettingsFieldPathsNested = []string{
 	"adr.mode",
 	"adr.mode.disabled",
 	"adr.mode.dynamic",
+	"adr.mode.dynamic.algorithm",
 	"adr.mode.dynamic.channel_steering",
 	"adr.mode.dynamic.channel_steering.mode",
 	"adr.mode.dynamic.channel_steering.mode.disabled",
@@ -748,6 +754,8 @@ var MACSettingsFieldPathsNested = []string{
 	"adr.mode.static.nb_trans",
 	"adr.mode.static.tx_power_index",
 	"adr_margin",
+	"auto_f_cnt_resync",
+	"auto_f_cnt_resync.value",
 	"beacon_frequency",
 	"beacon_frequency.value",
 	"class_b_c_downlink_interval",
@@ -813,6 +821,7 @@ var MACSettingsFieldPathsNested = []string{
 	"desired_rx2_frequency.value",
 	"downlink_dwell_time",
 	"downlink_dwell_time.value",
+	"downlink_path_ranking",
 	"factory_preset_frequencies",
 	"max_duty_cycle",
 	"max_duty_cycle.value",
@@ -883,6 +892,7 @@ var MACSettingsFieldPathsNested = []string{
 var MACSettingsFieldPathsTopLevel = []string{
 	"adr",
 	"adr_margin",
+	"auto_f_cnt_resync",
 	"beacon_frequency",
 	"class_b_c_downlink_interval",
 	"class_b_timeout",
@@ -900,6 +910,7 @@ var MACSettingsFieldPathsTopLevel = []string{
 	"desired_rx2_data_rate_index",
 	"desired_rx2_frequency",
 	"downlink_dwell_time",
+	"downlink_path_ranking",
 	"factory_preset_frequencies",
 	"max_duty_cycle",
 	"ping_slot_data_rate_index",
@@ -928,6 +939,7 @@ var MACSettingsProfileFieldPathsNested = []string{
 	"mac_settings.adr.mode",
 	"mac_settings.adr.mode.disabled",
 	"mac_settings.adr.mode.dynamic",
+	"mac_settings.adr.mode.dynamic.algorithm",
 	"mac_settings.adr.mode.dynamic.channel_steering",
 	"mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -995,6 +1007,8 @@ var MACSettingsProfileFieldPathsNested = []string{
 	"mac_settings.adr.mode.static.nb_trans",
 	"mac_settings.adr.mode.static.tx_power_index",
 	"mac_settings.adr_margin",
+	"mac_settings.auto_f_cnt_resync",
+	"mac_settings.auto_f_cnt_resync.value",
 	"mac_settings.beacon_frequency",
 	"mac_settings.beacon_frequency.value",
 	"mac_settings.class_b_c_downlink_interval",
@@ -1060,6 +1074,7 @@ var MACSettingsProfileFieldPathsNested = []string{
 	"mac_settings.desired_rx2_frequency.value",
 	"mac_settings.downlink_dwell_time",
 	"mac_settings.downlink_dwell_time.value",
+	"mac_settings.downlink_path_ranking",
 	"mac_settings.factory_preset_frequencies",
 	"mac_settings.max_duty_cycle",
 	"mac_settings.max_duty_cycle.value",
@@ -1278,11 +1293,13 @@ var MACStateFieldPathsNested = []string{
 	"pending_application_downlink.correlation_ids",
 	"pending_application_downlink.decoded_payload",
 	"pending_application_downlink.decoded_payload_warnings",
+	"pending_application_downlink.expires_at",
 	"pending_application_downlink.f_cnt",
 	"pending_application_downlink.f_port",
 	"pending_application_downlink.frm_payload",
 	"pending_application_downlink.priority",
 	"pending_application_downlink.session_key_id",
+	"pending_application_downlink.ttl",
 	"pending_join_request",
 	"pending_join_request.cf_list",
 	"pending_join_request.cf_list.ch_masks",
@@ -1424,6 +1441,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.adr.mode",
 	"mac_settings.adr.mode.disabled",
 	"mac_settings.adr.mode.dynamic",
+	"mac_settings.adr.mode.dynamic.algorithm",
 	"mac_settings.adr.mode.dynamic.channel_steering",
 	"mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -1491,6 +1509,8 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.adr.mode.static.nb_trans",
 	"mac_settings.adr.mode.static.tx_power_index",
 	"mac_settings.adr_margin",
+	"mac_settings.auto_f_cnt_resync",
+	"mac_settings.auto_f_cnt_resync.value",
 	"mac_settings.beacon_frequency",
 	"mac_settings.beacon_frequency.value",
 	"mac_settings.class_b_c_downlink_interval",
@@ -1556,6 +1576,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.desired_rx2_frequency.value",
 	"mac_settings.downlink_dwell_time",
 	"mac_settings.downlink_dwell_time.value",
+	"mac_settings.downlink_path_ranking",
 	"mac_settings.factory_preset_frequencies",
 	"mac_settings.max_duty_cycle",
 	"mac_settings.max_duty_cycle.value",
@@ -1768,11 +1789,13 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_application_downlink.correlation_ids",
 	"mac_state.pending_application_downlink.decoded_payload",
 	"mac_state.pending_application_downlink.decoded_payload_warnings",
+	"mac_state.pending_application_downlink.expires_at",
 	"mac_state.pending_application_downlink.f_cnt",
 	"mac_state.pending_application_downlink.f_port",
 	"mac_state.pending_application_downlink.frm_payload",
 	"mac_state.pending_application_downlink.priority",
 	"mac_state.pending_application_downlink.session_key_id",
+	"mac_state.pending_application_downlink.ttl",
 	"mac_state.pending_join_request",
 	"mac_state.pending_join_request.cf_list",
 	"mac_state.pending_join_request.cf_list.ch_masks",
@@ -1984,11 +2007,13 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.pending_application_downlink.correlation_ids",
 	"pending_mac_state.pending_application_downlink.decoded_payload",
 	"pending_mac_state.pending_application_downlink.decoded_payload_warnings",
+	"pending_mac_state.pending_application_downlink.expires_at",
 	"pending_mac_state.pending_application_downlink.f_cnt",
 	"pending_mac_state.pending_application_downlink.f_port",
 	"pending_mac_state.pending_application_downlink.frm_payload",
 	"pending_mac_state.pending_application_downlink.priority",
 	"pending_mac_state.pending_application_downlink.session_key_id",
+	"pending_mac_state.pending_application_downlink.ttl",
 	"pending_mac_state.pending_join_request",
 	"pending_mac_state.pending_join_request.cf_list",
 	"pending_mac_state.pending_join_request.cf_list.ch_masks",
@@ -2252,6 +2277,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode",
 	"end_device.mac_settings.adr.mode.disabled",
 	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -2319,6 +2345,8 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode.static.nb_trans",
 	"end_device.mac_settings.adr.mode.static.tx_power_index",
 	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.auto_f_cnt_resync",
+	"end_device.mac_settings.auto_f_cnt_resync.value",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.beacon_frequency.value",
 	"end_device.mac_settings.class_b_c_downlink_interval",
@@ -2384,6 +2412,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_rx2_frequency.value",
 	"end_device.mac_settings.downlink_dwell_time",
 	"end_device.mac_settings.downlink_dwell_time.value",
+	"end_device.mac_settings.downlink_path_ranking",
 	"end_device.mac_settings.factory_preset_frequencies",
 	"end_device.mac_settings.max_duty_cycle",
 	"end_device.mac_settings.max_duty_cycle.value",
@@ -2596,11 +2625,13 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
 	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device.mac_state.pending_application_downlink.ttl",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
 	"end_device.mac_state.pending_join_request.cf_list.ch_masks",
@@ -2812,11 +2843,13 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
+	"end_device.pending_mac_state.pending_application_downlink.ttl",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
 	"end_device.pending_mac_state.pending_join_request.cf_list.ch_masks",
@@ -3012,6 +3045,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode",
 	"end_device.mac_settings.adr.mode.disabled",
 	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -3079,6 +3113,8 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode.static.nb_trans",
 	"end_device.mac_settings.adr.mode.static.tx_power_index",
 	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.auto_f_cnt_resync",
+	"end_device.mac_settings.auto_f_cnt_resync.value",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.beacon_frequency.value",
 	"end_device.mac_settings.class_b_c_downlink_interval",
@@ -3144,6 +3180,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_rx2_frequency.value",
 	"end_device.mac_settings.downlink_dwell_time",
 	"end_device.mac_settings.downlink_dwell_time.value",
+	"end_device.mac_settings.downlink_path_ranking",
 	"end_device.mac_settings.factory_preset_frequencies",
 	"end_device.mac_settings.max_duty_cycle",
 	"end_device.mac_settings.max_duty_cycle.value",
@@ -3356,11 +3393,13 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
 	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device.mac_state.pending_application_downlink.ttl",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
 	"end_device.mac_state.pending_join_request.cf_list.ch_masks",
@@ -3572,11 +3611,13 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
+	"end_device.pending_mac_state.pending_application_downlink.ttl",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
 	"end_device.pending_mac_state.pending_join_request.cf_list.ch_masks",
@@ -3823,6 +3864,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode",
 	"end_device.mac_settings.adr.mode.disabled",
 	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -3890,6 +3932,8 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode.static.nb_trans",
 	"end_device.mac_settings.adr.mode.static.tx_power_index",
 	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.auto_f_cnt_resync",
+	"end_device.mac_settings.auto_f_cnt_resync.value",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.beacon_frequency.value",
 	"end_device.mac_settings.class_b_c_downlink_interval",
@@ -3955,6 +3999,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_rx2_frequency.value",
 	"end_device.mac_settings.downlink_dwell_time",
 	"end_device.mac_settings.downlink_dwell_time.value",
+	"end_device.mac_settings.downlink_path_ranking",
 	"end_device.mac_settings.factory_preset_frequencies",
 	"end_device.mac_settings.max_duty_cycle",
 	"end_device.mac_settings.max_duty_cycle.value",
@@ -4167,11 +4212,13 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
 	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device.mac_state.pending_application_downlink.ttl",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
 	"end_device.mac_state.pending_join_request.cf_list.ch_masks",
@@ -4383,11 +4430,13 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
+	"end_device.pending_mac_state.pending_application_downlink.ttl",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
 	"end_device.pending_mac_state.pending_join_request.cf_list.ch_masks",
@@ -4600,6 +4649,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode",
 	"end_device.mac_settings.adr.mode.disabled",
 	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -4667,6 +4717,8 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode.static.nb_trans",
 	"end_device.mac_settings.adr.mode.static.tx_power_index",
 	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.auto_f_cnt_resync",
+	"end_device.mac_settings.auto_f_cnt_resync.value",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.beacon_frequency.value",
 	"end_device.mac_settings.class_b_c_downlink_interval",
@@ -4732,6 +4784,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_settings.desired_rx2_frequency.value",
 	"end_device.mac_settings.downlink_dwell_time",
 	"end_device.mac_settings.downlink_dwell_time.value",
+	"end_device.mac_settings.downlink_path_ranking",
 	"end_device.mac_settings.factory_preset_frequencies",
 	"end_device.mac_settings.max_duty_cycle",
 	"end_device.mac_settings.max_duty_cycle.value",
@@ -4944,11 +4997,13 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
 	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device.mac_state.pending_application_downlink.ttl",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
 	"end_device.mac_state.pending_join_request.cf_list.ch_masks",
@@ -5160,11 +5215,13 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
+	"end_device.pending_mac_state.pending_application_downlink.ttl",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
 	"end_device.pending_mac_state.pending_join_request.cf_list.ch_masks",
@@ -5401,6 +5458,7 @@ var ADRSettings_StaticModeFieldPathsTopLevel = []string{
 	"tx_power_index",
 }
 var ADRSettings_DynamicModeFieldPathsNested = []string{
+	"algorithm",
 	"channel_steering",
 	"channel_steering.mode",
 	"channel_steering.mode.disabled",
@@ -5466,6 +5524,7 @@ var ADRSettings_DynamicModeFieldPathsNested = []string{
 }
 
 var ADRSettings_DynamicModeFieldPathsTopLevel = []string{
+	"algorithm",
 	"channel_steering",
 	"margin",
 	"max_data_rate_index",
@@ -5757,6 +5816,8 @@ var MACState_UplinkMessage_RxMetadataFieldPathsNested = []string{
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
 	"packet_broker",
+	"packet_source",
+	"packet_source.source_id",
 	"relay",
 	"snr",
 	"uplink_token",
@@ -5767,6 +5828,7 @@ var MACState_UplinkMessage_RxMetadataFieldPathsTopLevel = []string{
 	"downlink_path_constraint",
 	"gateway_ids",
 	"packet_broker",
+	"packet_source",
 	"relay",
 	"snr",
 	"uplink_token",
@@ -5775,6 +5837,13 @@ var MACState_UplinkMessage_RxMetadata_PacketBrokerMetadataFieldPathsNested []str
 var MACState_UplinkMessage_RxMetadata_PacketBrokerMetadataFieldPathsTopLevel []string
 var MACState_UplinkMessage_RxMetadata_RelayMetadataFieldPathsNested []string
 var MACState_UplinkMessage_RxMetadata_RelayMetadataFieldPathsTopLevel []string
+var MACState_UplinkMessage_RxMetadata_PacketSourceMetadataFieldPathsNested = []string{
+	"source_id",
+}
+
+var MACState_UplinkMessage_RxMetadata_PacketSourceMetadataFieldPathsTopLevel = []string{
+	"source_id",
+}
 var MACState_DownlinkMessage_MessageFieldPathsNested = []string{
 	"m_hdr",
 	"m_hdr.m_type",
@@ -2503,6 +2503,41 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 					dst.DesiredRelay = nil
 				}
 			}
+		case "downlink_path_ranking":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_path_ranking' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkPathRanking = src.DownlinkPathRanking
+			} else {
+				var zero string
+				dst.DownlinkPathRanking = zero
+			}
+		case "auto_f_cnt_resync":
+			if len(subs) > 0 {
+				var newDst, newSrc *BoolValue
+				if (src == nil || src.AutoFCntResync == nil) && dst.AutoFCntResync == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.AutoFCntResync
+				}
+				if dst.AutoFCntResync != nil {
+					newDst = dst.AutoFCntResync
+				} else {
+					newDst = &BoolValue{}
+					dst.AutoFCntResync = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.AutoFCntResync = src.AutoFCntResync
+				} else {
+					dst.AutoFCntResync = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -4573,6 +4608,16 @@ func (dst *ADRSettings_DynamicMode) SetFields(src *ADRSettings_DynamicMode, path
 					dst.Overrides = nil
 				}
 			}
+		case "algorithm":
+			if len(subs) > 0 {
+				return fmt.Errorf("'algorithm' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Algorithm = src.Algorithm
+			} else {
+				var zero string
+				dst.Algorithm = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -5657,6 +5702,31 @@ func (dst *MACState_UplinkMessage_RxMetadata) SetFields(src *MACState_UplinkMess
 					dst.Relay = nil
 				}
 			}
+		case "packet_source":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACState_UplinkMessage_RxMetadata_PacketSourceMetadata
+				if (src == nil || src.PacketSource == nil) && dst.PacketSource == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.PacketSource
+				}
+				if dst.PacketSource != nil {
+					newDst = dst.PacketSource
+				} else {
+					newDst = &MACState_UplinkMessage_RxMetadata_PacketSourceMetadata{}
+					dst.PacketSource = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.PacketSource = src.PacketSource
+				} else {
+					dst.PacketSource = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -5679,6 +5749,27 @@ func (dst *MACState_UplinkMessage_RxMetadata_RelayMetadata) SetFields(src *MACSt
 	return nil
 }
 
+func (dst *MACState_UplinkMessage_RxMetadata_PacketSourceMetadata) SetFields(src *MACState_UplinkMessage_RxMetadata_PacketSourceMetadata, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "source_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'source_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SourceId = src.SourceId
+			} else {
+				var zero string
+				dst.SourceId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *MACState_DownlinkMessage_Message) SetFields(src *MACState_DownlinkMessage_Message, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
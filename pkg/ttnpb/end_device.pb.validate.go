@@ -2491,6 +2491,27 @@ func (m *MACSettings) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "downlink_path_ranking":
+
+			if utf8.RuneCountInString(m.GetDownlinkPathRanking()) > 64 {
+				return MACSettingsValidationError{
+					field:  "downlink_path_ranking",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "auto_f_cnt_resync":
+
+			if v, ok := interface{}(m.GetAutoFCntResync()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "auto_f_cnt_resync",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACSettingsValidationError{
 				field:  name,
@@ -6008,6 +6029,15 @@ func (m *ADRSettings_DynamicMode) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "algorithm":
+
+			if utf8.RuneCountInString(m.GetAlgorithm()) > 64 {
+				return ADRSettings_DynamicModeValidationError{
+					field:  "algorithm",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
 		default:
 			return ADRSettings_DynamicModeValidationError{
 				field:  name,
@@ -7762,6 +7792,18 @@ func (m *MACState_UplinkMessage_RxMetadata) ValidateFields(paths ...string) erro
 				}
 			}
 
+		case "packet_source":
+
+			if v, ok := interface{}(m.GetPacketSource()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACState_UplinkMessage_RxMetadataValidationError{
+						field:  "packet_source",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACState_UplinkMessage_RxMetadataValidationError{
 				field:  name,
@@ -7979,6 +8021,100 @@ var _ interface {
 	ErrorName() string
 } = MACState_UplinkMessage_RxMetadata_RelayMetadataValidationError{}
 
+// ValidateFields checks the field values on
+// MACState_UplinkMessage_RxMetadata_PacketSourceMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACState_UplinkMessage_RxMetadata_PacketSourceMetadata) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACState_UplinkMessage_RxMetadata_PacketSourceMetadataFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "source_id":
+			// no validation rules for SourceId
+		default:
+			return MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError is the
+// validation error returned by
+// MACState_UplinkMessage_RxMetadata_PacketSourceMetadata.ValidateFields if
+// the designated constraints aren't met.
+type MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError) Key() bool {
+	return e.key
+}
+
+// ErrorName returns error name.
+func (e MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError) ErrorName() string {
+	return "MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACState_UplinkMessage_RxMetadata_PacketSourceMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACState_UplinkMessage_RxMetadata_PacketSourceMetadataValidationError{}
+
 // ValidateFields checks the field values on MACState_DownlinkMessage_Message
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
@@ -2155,6 +2155,7 @@ func AddSelectFlagsForADRSettings_DynamicMode(flags *pflag.FlagSet, prefix strin
 	AddSelectFlagsForADRSettings_DynamicMode_ChannelSteeringSettings(flags, flagsplugin.Prefix("channel-steering", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("overrides", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("overrides", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForADRSettings_DynamicMode_Overrides(flags, flagsplugin.Prefix("overrides", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("algorithm", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("algorithm", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forADRSettings_DynamicMode message from select flags.
@@ -2224,6 +2225,11 @@ func PathsFromSelectFlagsForADRSettings_DynamicMode(flags *pflag.FlagSet, prefix
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("algorithm", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("algorithm", prefix))
+	}
 	return paths, nil
 }
 
@@ -2240,6 +2246,7 @@ func AddSetFlagsForADRSettings_DynamicMode(flags *pflag.FlagSet, prefix string,
 	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("max-nb-trans", prefix), "", flagsplugin.WithHidden(hidden)))
 	AddSetFlagsForADRSettings_DynamicMode_ChannelSteeringSettings(flags, flagsplugin.Prefix("channel-steering", prefix), hidden)
 	AddSetFlagsForADRSettings_DynamicMode_Overrides(flags, flagsplugin.Prefix("overrides", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("algorithm", prefix), "", flagsplugin.WithHidden(hidden)))
 }
 
 // SetFromFlags sets the ADRSettings_DynamicMode message from flags.
@@ -2314,6 +2321,12 @@ func (m *ADRSettings_DynamicMode) SetFromFlags(flags *pflag.FlagSet, prefix stri
 			paths = append(paths, setPaths...)
 		}
 	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("algorithm", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Algorithm = val
+		paths = append(paths, flagsplugin.Prefix("algorithm", prefix))
+	}
 	return paths, nil
 }
 
@@ -2504,6 +2517,9 @@ func AddSelectFlagsForMACSettings(flags *pflag.FlagSet, prefix string, hidden bo
 	AddSelectFlagsForRelaySettings(flags, flagsplugin.Prefix("relay", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("desired-relay", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("desired-relay", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForRelaySettings(flags, flagsplugin.Prefix("desired-relay", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("downlink-path-ranking", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("downlink-path-ranking", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("auto-f-cnt-resync", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("auto-f-cnt-resync", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForBoolValue(flags, flagsplugin.Prefix("auto-f-cnt-resync", prefix), true)
 }
 
 // SelectFromFlags outputs the fieldmask paths forMACSettings message from select flags.
@@ -2833,6 +2849,21 @@ func PathsFromSelectFlagsForMACSettings(flags *pflag.FlagSet, prefix string) (pa
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("downlink_path_ranking", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("downlink_path_ranking", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("auto_f_cnt_resync", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("auto_f_cnt_resync", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForBoolValue(flags, flagsplugin.Prefix("auto_f_cnt_resync", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	return paths, nil
 }
 
@@ -2900,6 +2931,9 @@ func AddSetFlagsForMACSettings(flags *pflag.FlagSet, prefix string, hidden bool)
 	flagsplugin.AddAlias(flags, flagsplugin.Prefix("schedule-downlinks.value", prefix), flagsplugin.Prefix("schedule-downlinks", prefix), flagsplugin.WithHidden(hidden))
 	AddSetFlagsForRelaySettings(flags, flagsplugin.Prefix("relay", prefix), hidden)
 	AddSetFlagsForRelaySettings(flags, flagsplugin.Prefix("desired-relay", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("downlink-path-ranking", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForBoolValue(flags, flagsplugin.Prefix("auto-f-cnt-resync", prefix), true)
+	flagsplugin.AddAlias(flags, flagsplugin.Prefix("auto-f-cnt-resync.value", prefix), flagsplugin.Prefix("auto-f-cnt-resync", prefix), flagsplugin.WithHidden(hidden))
 }
 
 // SetFromFlags sets the MACSettings message from flags.
@@ -3236,6 +3270,22 @@ func (m *MACSettings) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths [
 			paths = append(paths, setPaths...)
 		}
 	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("downlink_path_ranking", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.DownlinkPathRanking = val
+		paths = append(paths, flagsplugin.Prefix("downlink_path_ranking", prefix))
+	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("auto_f_cnt_resync", prefix)); changed {
+		if m.AutoFCntResync == nil {
+			m.AutoFCntResync = &BoolValue{}
+		}
+		if setPaths, err := m.AutoFCntResync.SetFromFlags(flags, flagsplugin.Prefix("auto_f_cnt_resync", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
 	return paths, nil
 }
 
@@ -1601,6 +1601,11 @@ func (x *ADRSettings_DynamicMode) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		// NOTE: ADRSettings_DynamicMode_Overrides does not seem to implement MarshalProtoJSON.
 		golang.MarshalMessage(s, x.Overrides)
 	}
+	if x.Algorithm != "" || s.HasField("algorithm") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("algorithm")
+		s.WriteString(x.Algorithm)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -1709,6 +1714,9 @@ func (x *ADRSettings_DynamicMode) UnmarshalProtoJSON(s *jsonplugin.UnmarshalStat
 			var v ADRSettings_DynamicMode_Overrides
 			golang.UnmarshalMessage(s, &v)
 			x.Overrides = &v
+		case "algorithm":
+			s.AddField("algorithm")
+			x.Algorithm = s.ReadString()
 		}
 	})
 }
@@ -2011,6 +2019,16 @@ func (x *MACSettings) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("desired_relay")
 		x.DesiredRelay.MarshalProtoJSON(s.WithField("desired_relay"))
 	}
+	if x.DownlinkPathRanking != "" || s.HasField("downlink_path_ranking") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("downlink_path_ranking")
+		s.WriteString(x.DownlinkPathRanking)
+	}
+	if x.AutoFCntResync != nil || s.HasField("auto_f_cnt_resync") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("auto_f_cnt_resync")
+		x.AutoFCntResync.MarshalProtoJSON(s.WithField("auto_f_cnt_resync"))
+	}
 	s.WriteObjectEnd()
 }
 
@@ -2330,6 +2348,17 @@ func (x *MACSettings) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 			}
 			x.DesiredRelay = &RelaySettings{}
 			x.DesiredRelay.UnmarshalProtoJSON(s.WithField("desired_relay", true))
+		case "downlink_path_ranking", "downlinkPathRanking":
+			s.AddField("downlink_path_ranking")
+			x.DownlinkPathRanking = s.ReadString()
+		case "auto_f_cnt_resync", "autoFCntResync":
+			s.AddField("auto_f_cnt_resync")
+			if s.ReadNil() {
+				x.AutoFCntResync = nil
+				return
+			}
+			x.AutoFCntResync = &BoolValue{}
+			x.AutoFCntResync.UnmarshalProtoJSON(s.WithField("auto_f_cnt_resync", false))
 		}
 	})
 }
@@ -2606,6 +2635,12 @@ func (x *MACState_UplinkMessage_RxMetadata) MarshalProtoJSON(s *jsonplugin.Marsh
 		// NOTE: MACState_UplinkMessage_RxMetadata_RelayMetadata does not seem to implement MarshalProtoJSON.
 		golang.MarshalMessage(s, x.Relay)
 	}
+	if x.PacketSource != nil || s.HasField("packet_source") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("packet_source")
+		// NOTE: MACState_UplinkMessage_RxMetadata_PacketSourceMetadata does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.PacketSource)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -2662,6 +2697,16 @@ func (x *MACState_UplinkMessage_RxMetadata) UnmarshalProtoJSON(s *jsonplugin.Unm
 			var v MACState_UplinkMessage_RxMetadata_RelayMetadata
 			golang.UnmarshalMessage(s, &v)
 			x.Relay = &v
+		case "packet_source", "packetSource":
+			s.AddField("packet_source")
+			if s.ReadNil() {
+				x.PacketSource = nil
+				return
+			}
+			// NOTE: MACState_UplinkMessage_RxMetadata_PacketSourceMetadata does not seem to implement UnmarshalProtoJSON.
+			var v MACState_UplinkMessage_RxMetadata_PacketSourceMetadata
+			golang.UnmarshalMessage(s, &v)
+			x.PacketSource = &v
 		}
 	})
 }
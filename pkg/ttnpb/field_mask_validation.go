@@ -111,6 +111,7 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_settings.adr.mode",
 	"mac_settings.adr.mode.disabled",
 	"mac_settings.adr.mode.dynamic",
+	"mac_settings.adr.mode.dynamic.algorithm",
 	"mac_settings.adr.mode.dynamic.channel_steering",
 	"mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -308,6 +309,9 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_settings.uplink_dwell_time.value",
 	"mac_settings.downlink_dwell_time",
 	"mac_settings.downlink_dwell_time.value",
+	"mac_settings.downlink_path_ranking",
+	"mac_settings.auto_f_cnt_resync",
+	"mac_settings.auto_f_cnt_resync.value",
 	"mac_state",
 	"mac_state.current_parameters",
 	"mac_state.current_parameters.adr_ack_delay_exponent",
@@ -444,11 +448,13 @@ var nsEndDeviceReadFieldPaths = [...]string{
 	"mac_state.pending_application_downlink.class_b_c.gateways",
 	"mac_state.pending_application_downlink.confirmed",
 	"mac_state.pending_application_downlink.correlation_ids",
+	"mac_state.pending_application_downlink.expires_at",
 	"mac_state.pending_application_downlink.f_cnt",
 	"mac_state.pending_application_downlink.f_port",
 	"mac_state.pending_application_downlink.frm_payload",
 	"mac_state.pending_application_downlink.priority",
 	"mac_state.pending_application_downlink.session_key_id",
+	"mac_state.pending_application_downlink.ttl",
 	"mac_state.pending_relay_downlink",
 	"mac_state.pending_relay_downlink.raw_payload",
 	"mac_state.pending_requests",
@@ -1022,6 +1028,7 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_settings.adr.mode",
 			"mac_settings.adr.mode.disabled",
 			"mac_settings.adr.mode.dynamic",
+			"mac_settings.adr.mode.dynamic.algorithm",
 			"mac_settings.adr.mode.dynamic.channel_steering",
 			"mac_settings.adr.mode.dynamic.channel_steering.mode",
 			"mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -1219,6 +1226,11 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_settings.uplink_dwell_time.value",
 			"mac_settings.downlink_dwell_time",
 			"mac_settings.downlink_dwell_time.value",
+			"mac_settings.downlink_path_ranking",
+			"mac_settings.auto_f_cnt_resync",
+			"mac_settings.auto_f_cnt_resync.value",
+	"mac_settings.auto_f_cnt_resync",
+	"mac_settings.auto_f_cnt_resync.value",
 			"mac_state",
 			"mac_state.current_parameters",
 			"mac_state.current_parameters.adr_ack_delay_exponent",
@@ -1355,11 +1367,13 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 			"mac_state.pending_application_downlink.class_b_c.gateways",
 			"mac_state.pending_application_downlink.confirmed",
 			"mac_state.pending_application_downlink.correlation_ids",
+			"mac_state.pending_application_downlink.expires_at",
 			"mac_state.pending_application_downlink.f_cnt",
 			"mac_state.pending_application_downlink.f_port",
 			"mac_state.pending_application_downlink.frm_payload",
 			"mac_state.pending_application_downlink.priority",
 			"mac_state.pending_application_downlink.session_key_id",
+			"mac_state.pending_application_downlink.ttl",
 			"mac_state.pending_relay_downlink",
 			"mac_state.pending_relay_downlink.raw_payload",
 			"mac_state.pending_requests",
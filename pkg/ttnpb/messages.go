@@ -101,6 +101,10 @@ func (v *ApplicationDownlink) FieldIsZero(p string) bool {
 		return v.ConfirmedRetry.FieldIsZero("attempt")
 	case "confirmed_retry.max_attempts":
 		return v.ConfirmedRetry.FieldIsZero("max_attempts")
+	case "expires_at":
+		return v.ExpiresAt == nil
+	case "ttl":
+		return v.Ttl == nil
 	}
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
@@ -733,11 +733,13 @@ var ApplicationDownlinkFieldPathsNested = []string{
 	"correlation_ids",
 	"decoded_payload",
 	"decoded_payload_warnings",
+	"expires_at",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
 	"priority",
 	"session_key_id",
+	"ttl",
 }
 
 var ApplicationDownlinkFieldPathsTopLevel = []string{
@@ -747,11 +749,13 @@ var ApplicationDownlinkFieldPathsTopLevel = []string{
 	"correlation_ids",
 	"decoded_payload",
 	"decoded_payload_warnings",
+	"expires_at",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
 	"priority",
 	"session_key_id",
+	"ttl",
 }
 var ApplicationDownlinksFieldPathsNested = []string{
 	"downlinks",
@@ -772,11 +776,13 @@ var ApplicationDownlinkFailedFieldPathsNested = []string{
 	"downlink.correlation_ids",
 	"downlink.decoded_payload",
 	"downlink.decoded_payload_warnings",
+	"downlink.expires_at",
 	"downlink.f_cnt",
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
 	"downlink.session_key_id",
+	"downlink.ttl",
 	"error",
 	"error.attributes",
 	"error.cause",
@@ -844,6 +850,15 @@ var ApplicationUpFieldPathsNested = []string{
 	"end_device_ids.device_id",
 	"end_device_ids.join_eui",
 	"received_at",
+	"replay",
+	"replay.replay_id",
+	"replay.target",
+	"replay.target.integration",
+	"replay.target.integration.mqtt",
+	"replay.target.integration.pub_sub",
+	"replay.target.integration.pub_sub.pub_sub_id",
+	"replay.target.integration.webhook",
+	"replay.target.integration.webhook.webhook_id",
 	"simulated",
 	"up",
 	"up.downlink_ack",
@@ -857,11 +872,13 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_ack.correlation_ids",
 	"up.downlink_ack.decoded_payload",
 	"up.downlink_ack.decoded_payload_warnings",
+	"up.downlink_ack.expires_at",
 	"up.downlink_ack.f_cnt",
 	"up.downlink_ack.f_port",
 	"up.downlink_ack.frm_payload",
 	"up.downlink_ack.priority",
 	"up.downlink_ack.session_key_id",
+	"up.downlink_ack.ttl",
 	"up.downlink_failed",
 	"up.downlink_failed.downlink",
 	"up.downlink_failed.downlink.class_b_c",
@@ -874,11 +891,13 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_failed.downlink.correlation_ids",
 	"up.downlink_failed.downlink.decoded_payload",
 	"up.downlink_failed.downlink.decoded_payload_warnings",
+	"up.downlink_failed.downlink.expires_at",
 	"up.downlink_failed.downlink.f_cnt",
 	"up.downlink_failed.downlink.f_port",
 	"up.downlink_failed.downlink.frm_payload",
 	"up.downlink_failed.downlink.priority",
 	"up.downlink_failed.downlink.session_key_id",
+	"up.downlink_failed.downlink.ttl",
 	"up.downlink_failed.error",
 	"up.downlink_failed.error.attributes",
 	"up.downlink_failed.error.cause",
@@ -904,11 +923,13 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_nack.correlation_ids",
 	"up.downlink_nack.decoded_payload",
 	"up.downlink_nack.decoded_payload_warnings",
+	"up.downlink_nack.expires_at",
 	"up.downlink_nack.f_cnt",
 	"up.downlink_nack.f_port",
 	"up.downlink_nack.frm_payload",
 	"up.downlink_nack.priority",
 	"up.downlink_nack.session_key_id",
+	"up.downlink_nack.ttl",
 	"up.downlink_queue_invalidated",
 	"up.downlink_queue_invalidated.downlinks",
 	"up.downlink_queue_invalidated.last_f_cnt_down",
@@ -924,11 +945,13 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_queued.correlation_ids",
 	"up.downlink_queued.decoded_payload",
 	"up.downlink_queued.decoded_payload_warnings",
+	"up.downlink_queued.expires_at",
 	"up.downlink_queued.f_cnt",
 	"up.downlink_queued.f_port",
 	"up.downlink_queued.frm_payload",
 	"up.downlink_queued.priority",
 	"up.downlink_queued.session_key_id",
+	"up.downlink_queued.ttl",
 	"up.downlink_sent",
 	"up.downlink_sent.class_b_c",
 	"up.downlink_sent.class_b_c.absolute_time",
@@ -940,11 +963,13 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_sent.correlation_ids",
 	"up.downlink_sent.decoded_payload",
 	"up.downlink_sent.decoded_payload_warnings",
+	"up.downlink_sent.expires_at",
 	"up.downlink_sent.f_cnt",
 	"up.downlink_sent.f_port",
 	"up.downlink_sent.frm_payload",
 	"up.downlink_sent.priority",
 	"up.downlink_sent.session_key_id",
+	"up.downlink_sent.ttl",
 	"up.join_accept",
 	"up.join_accept.app_s_key",
 	"up.join_accept.app_s_key.encrypted_key",
@@ -1074,9 +1099,37 @@ var ApplicationUpFieldPathsTopLevel = []string{
 	"correlation_ids",
 	"end_device_ids",
 	"received_at",
+	"replay",
 	"simulated",
 	"up",
 }
+var ApplicationUpReplayTargetFieldPathsNested = []string{
+	"integration",
+	"integration.mqtt",
+	"integration.pub_sub",
+	"integration.pub_sub.pub_sub_id",
+	"integration.webhook",
+	"integration.webhook.webhook_id",
+}
+
+var ApplicationUpReplayTargetFieldPathsTopLevel = []string{
+	"integration",
+}
+var ApplicationUpReplayFieldPathsNested = []string{
+	"replay_id",
+	"target",
+	"target.integration",
+	"target.integration.mqtt",
+	"target.integration.pub_sub",
+	"target.integration.pub_sub.pub_sub_id",
+	"target.integration.webhook",
+	"target.integration.webhook.webhook_id",
+}
+
+var ApplicationUpReplayFieldPathsTopLevel = []string{
+	"replay_id",
+	"target",
+}
 var MessagePayloadFormattersFieldPathsNested = []string{
 	"down_formatter",
 	"down_formatter_parameter",
@@ -1123,3 +1176,19 @@ var ApplicationDownlink_ConfirmedRetryFieldPathsTopLevel = []string{
 	"attempt",
 	"max_attempts",
 }
+var ApplicationUpReplayTarget_WebhookFieldPathsNested = []string{
+	"webhook_id",
+}
+
+var ApplicationUpReplayTarget_WebhookFieldPathsTopLevel = []string{
+	"webhook_id",
+}
+var ApplicationUpReplayTarget_PubSubFieldPathsNested = []string{
+	"pub_sub_id",
+}
+
+var ApplicationUpReplayTarget_PubSubFieldPathsTopLevel = []string{
+	"pub_sub_id",
+}
+var ApplicationUpReplayTarget_MQTTFieldPathsNested []string
+var ApplicationUpReplayTarget_MQTTFieldPathsTopLevel []string
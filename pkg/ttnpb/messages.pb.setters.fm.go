@@ -1175,6 +1175,24 @@ func (dst *ApplicationDownlink) SetFields(src *ApplicationDownlink, paths ...str
 					dst.ConfirmedRetry = nil
 				}
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "ttl":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ttl' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Ttl = src.Ttl
+			} else {
+				dst.Ttl = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1456,6 +1474,31 @@ func (dst *ApplicationUp) SetFields(src *ApplicationUp, paths ...string) error {
 				var zero bool
 				dst.Simulated = zero
 			}
+		case "replay":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationUpReplay
+				if (src == nil || src.Replay == nil) && dst.Replay == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Replay
+				}
+				if dst.Replay != nil {
+					newDst = dst.Replay
+				} else {
+					newDst = &ApplicationUpReplay{}
+					dst.Replay = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Replay = src.Replay
+				} else {
+					dst.Replay = nil
+				}
+			}
 
 		case "up":
 			if len(subs) == 0 && src == nil {
@@ -1881,6 +1924,192 @@ func (dst *ApplicationUp) SetFields(src *ApplicationUp, paths ...string) error {
 	return nil
 }
 
+func (dst *ApplicationUpReplayTarget) SetFields(src *ApplicationUpReplayTarget, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+
+		case "integration":
+			if len(subs) == 0 && src == nil {
+				dst.Integration = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Integration = src.Integration
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "webhook":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Integration.(*ApplicationUpReplayTarget_Webhook_)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Integration == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'webhook', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Integration.(*ApplicationUpReplayTarget_Webhook_)
+					if dstValid := dstTypeOk || dst.Integration == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'webhook', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationUpReplayTarget_Webhook
+						if srcTypeOk {
+							newSrc = src.Integration.(*ApplicationUpReplayTarget_Webhook_).Webhook
+						}
+						if dstTypeOk {
+							newDst = dst.Integration.(*ApplicationUpReplayTarget_Webhook_).Webhook
+						} else if srcTypeOk {
+							newDst = &ApplicationUpReplayTarget_Webhook{}
+							dst.Integration = &ApplicationUpReplayTarget_Webhook_{Webhook: newDst}
+						} else {
+							dst.Integration = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Integration = src.Integration
+						} else {
+							dst.Integration = nil
+						}
+					}
+				case "pub_sub":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Integration.(*ApplicationUpReplayTarget_PubSub_)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Integration == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'pub_sub', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Integration.(*ApplicationUpReplayTarget_PubSub_)
+					if dstValid := dstTypeOk || dst.Integration == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'pub_sub', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationUpReplayTarget_PubSub
+						if srcTypeOk {
+							newSrc = src.Integration.(*ApplicationUpReplayTarget_PubSub_).PubSub
+						}
+						if dstTypeOk {
+							newDst = dst.Integration.(*ApplicationUpReplayTarget_PubSub_).PubSub
+						} else if srcTypeOk {
+							newDst = &ApplicationUpReplayTarget_PubSub{}
+							dst.Integration = &ApplicationUpReplayTarget_PubSub_{PubSub: newDst}
+						} else {
+							dst.Integration = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Integration = src.Integration
+						} else {
+							dst.Integration = nil
+						}
+					}
+				case "mqtt":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Integration.(*ApplicationUpReplayTarget_Mqtt)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Integration == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'mqtt', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Integration.(*ApplicationUpReplayTarget_Mqtt)
+					if dstValid := dstTypeOk || dst.Integration == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'mqtt', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationUpReplayTarget_MQTT
+						if srcTypeOk {
+							newSrc = src.Integration.(*ApplicationUpReplayTarget_Mqtt).Mqtt
+						}
+						if dstTypeOk {
+							newDst = dst.Integration.(*ApplicationUpReplayTarget_Mqtt).Mqtt
+						} else if srcTypeOk {
+							newDst = &ApplicationUpReplayTarget_MQTT{}
+							dst.Integration = &ApplicationUpReplayTarget_Mqtt{Mqtt: newDst}
+						} else {
+							dst.Integration = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Integration = src.Integration
+						} else {
+							dst.Integration = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationUpReplay) SetFields(src *ApplicationUpReplay, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "replay_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'replay_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReplayId = src.ReplayId
+			} else {
+				var zero string
+				dst.ReplayId = zero
+			}
+		case "target":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationUpReplayTarget
+				if (src == nil || src.Target == nil) && dst.Target == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Target
+				}
+				if dst.Target != nil {
+					newDst = dst.Target
+				} else {
+					newDst = &ApplicationUpReplayTarget{}
+					dst.Target = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Target = src.Target
+				} else {
+					dst.Target = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *MessagePayloadFormatters) SetFields(src *MessagePayloadFormatters, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -2033,3 +2262,52 @@ func (dst *ApplicationDownlink_ConfirmedRetry) SetFields(src *ApplicationDownlin
 	}
 	return nil
 }
+
+func (dst *ApplicationUpReplayTarget_Webhook) SetFields(src *ApplicationUpReplayTarget_Webhook, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "webhook_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'webhook_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WebhookId = src.WebhookId
+			} else {
+				var zero string
+				dst.WebhookId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationUpReplayTarget_PubSub) SetFields(src *ApplicationUpReplayTarget_PubSub, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "pub_sub_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'pub_sub_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PubSubId = src.PubSubId
+			} else {
+				var zero string
+				dst.PubSubId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationUpReplayTarget_MQTT) SetFields(src *ApplicationUpReplayTarget_MQTT, paths ...string) error {
+	if len(paths) != 0 {
+		return fmt.Errorf("message ApplicationUpReplayTarget_MQTT has no fields, but paths %s were specified", paths)
+	}
+	return nil
+}
@@ -1607,6 +1607,30 @@ func (m *ApplicationDownlink) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "ttl":
+
+			if v, ok := interface{}(m.GetTtl()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkValidationError{
+						field:  "ttl",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return ApplicationDownlinkValidationError{
 				field:  name,
@@ -2292,6 +2316,18 @@ func (m *ApplicationUp) ValidateFields(paths ...string) error {
 
 		case "simulated":
 			// no validation rules for Simulated
+		case "replay":
+
+			if v, ok := interface{}(m.GetReplay()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationUpValidationError{
+						field:  "replay",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		case "up":
 			if m.Up == nil {
 				return ApplicationUpValidationError{
@@ -2549,6 +2585,255 @@ var _ interface {
 	ErrorName() string
 } = ApplicationUpValidationError{}
 
+// ValidateFields checks the field values on ApplicationUpReplayTarget with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationUpReplayTarget) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationUpReplayTargetFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "integration":
+			if m.Integration == nil {
+				return ApplicationUpReplayTargetValidationError{
+					field:  "integration",
+					reason: "value is required",
+				}
+			}
+			if len(subs) == 0 {
+				subs = []string{
+					"webhook", "pub_sub", "mqtt",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "webhook":
+					w, ok := m.Integration.(*ApplicationUpReplayTarget_Webhook_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetWebhook()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationUpReplayTargetValidationError{
+								field:  "webhook",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "pub_sub":
+					w, ok := m.Integration.(*ApplicationUpReplayTarget_PubSub_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetPubSub()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationUpReplayTargetValidationError{
+								field:  "pub_sub",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "mqtt":
+					w, ok := m.Integration.(*ApplicationUpReplayTarget_Mqtt)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetMqtt()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationUpReplayTargetValidationError{
+								field:  "mqtt",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		default:
+			return ApplicationUpReplayTargetValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationUpReplayTargetValidationError is the validation error returned by
+// ApplicationUpReplayTarget.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationUpReplayTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationUpReplayTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationUpReplayTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationUpReplayTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationUpReplayTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationUpReplayTargetValidationError) ErrorName() string {
+	return "ApplicationUpReplayTargetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationUpReplayTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationUpReplayTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationUpReplayTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationUpReplayTargetValidationError{}
+
+// ValidateFields checks the field values on ApplicationUpReplay with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationUpReplay) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationUpReplayFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "replay_id":
+			// no validation rules for ReplayId
+		case "target":
+
+			if m.GetTarget() == nil {
+				return ApplicationUpReplayValidationError{
+					field:  "target",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetTarget()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationUpReplayValidationError{
+						field:  "target",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationUpReplayValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationUpReplayValidationError is the validation error returned by
+// ApplicationUpReplay.ValidateFields if the designated constraints aren't met.
+type ApplicationUpReplayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationUpReplayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationUpReplayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationUpReplayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationUpReplayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationUpReplayValidationError) ErrorName() string {
+	return "ApplicationUpReplayValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationUpReplayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationUpReplay.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationUpReplayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationUpReplayValidationError{}
+
 // ValidateFields checks the field values on MessagePayloadFormatters with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -2998,3 +3283,270 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ApplicationDownlink_ConfirmedRetryValidationError{}
+
+// ValidateFields checks the field values on ApplicationUpReplayTarget_Webhook
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationUpReplayTarget_Webhook) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationUpReplayTarget_WebhookFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "webhook_id":
+
+			if utf8.RuneCountInString(m.GetWebhookId()) > 36 {
+				return ApplicationUpReplayTarget_WebhookValidationError{
+					field:  "webhook_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_ApplicationUpReplayTarget_Webhook_WebhookId_Pattern.MatchString(m.GetWebhookId()) {
+				return ApplicationUpReplayTarget_WebhookValidationError{
+					field:  "webhook_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return ApplicationUpReplayTarget_WebhookValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationUpReplayTarget_WebhookValidationError is the validation error
+// returned by ApplicationUpReplayTarget_Webhook.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationUpReplayTarget_WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationUpReplayTarget_WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationUpReplayTarget_WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationUpReplayTarget_WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationUpReplayTarget_WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationUpReplayTarget_WebhookValidationError) ErrorName() string {
+	return "ApplicationUpReplayTarget_WebhookValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationUpReplayTarget_WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationUpReplayTarget_Webhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationUpReplayTarget_WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationUpReplayTarget_WebhookValidationError{}
+
+var _ApplicationUpReplayTarget_Webhook_WebhookId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ApplicationUpReplayTarget_PubSub
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationUpReplayTarget_PubSub) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationUpReplayTarget_PubSubFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "pub_sub_id":
+
+			if utf8.RuneCountInString(m.GetPubSubId()) > 36 {
+				return ApplicationUpReplayTarget_PubSubValidationError{
+					field:  "pub_sub_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_ApplicationUpReplayTarget_PubSub_PubSubId_Pattern.MatchString(m.GetPubSubId()) {
+				return ApplicationUpReplayTarget_PubSubValidationError{
+					field:  "pub_sub_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return ApplicationUpReplayTarget_PubSubValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationUpReplayTarget_PubSubValidationError is the validation error
+// returned by ApplicationUpReplayTarget_PubSub.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationUpReplayTarget_PubSubValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationUpReplayTarget_PubSubValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationUpReplayTarget_PubSubValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationUpReplayTarget_PubSubValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationUpReplayTarget_PubSubValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationUpReplayTarget_PubSubValidationError) ErrorName() string {
+	return "ApplicationUpReplayTarget_PubSubValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationUpReplayTarget_PubSubValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationUpReplayTarget_PubSub.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationUpReplayTarget_PubSubValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationUpReplayTarget_PubSubValidationError{}
+
+var _ApplicationUpReplayTarget_PubSub_PubSubId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ApplicationUpReplayTarget_MQTT
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationUpReplayTarget_MQTT) ValidateFields(paths ...string) error {
+	if len(paths) > 0 {
+		return fmt.Errorf("message ApplicationUpReplayTarget_MQTT has no fields, but paths %s were specified", paths)
+	}
+	return nil
+}
+
+// ApplicationUpReplayTarget_MQTTValidationError is the validation error
+// returned by ApplicationUpReplayTarget_MQTT.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationUpReplayTarget_MQTTValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationUpReplayTarget_MQTTValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationUpReplayTarget_MQTTValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationUpReplayTarget_MQTTValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationUpReplayTarget_MQTTValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationUpReplayTarget_MQTTValidationError) ErrorName() string {
+	return "ApplicationUpReplayTarget_MQTTValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationUpReplayTarget_MQTTValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationUpReplayTarget_MQTT.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationUpReplayTarget_MQTTValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationUpReplayTarget_MQTTValidationError{}
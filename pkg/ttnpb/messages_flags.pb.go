@@ -585,6 +585,8 @@ func AddSelectFlagsForApplicationDownlink(flags *pflag.FlagSet, prefix string, h
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("correlation-ids", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("correlation-ids", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("confirmed-retry", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("confirmed-retry", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationDownlink_ConfirmedRetry(flags, flagsplugin.Prefix("confirmed-retry", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("expires-at", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("expires-at", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("ttl", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("ttl", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forApplicationDownlink message from select flags.
@@ -654,6 +656,16 @@ func PathsFromSelectFlagsForApplicationDownlink(flags *pflag.FlagSet, prefix str
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("expires_at", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("expires_at", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("ttl", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("ttl", prefix))
+	}
 	return paths, nil
 }
 
@@ -670,6 +682,8 @@ func AddSetFlagsForApplicationDownlink(flags *pflag.FlagSet, prefix string, hidd
 	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("priority", prefix), flagsplugin.EnumValueDesc(TxSchedulePriority_value), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("correlation-ids", prefix), "", flagsplugin.WithHidden(hidden)))
 	AddSetFlagsForApplicationDownlink_ConfirmedRetry(flags, flagsplugin.Prefix("confirmed-retry", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewTimestampFlag(flagsplugin.Prefix("expires-at", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewDurationFlag(flagsplugin.Prefix("ttl", prefix), "", flagsplugin.WithHidden(hidden)))
 }
 
 // SetFromFlags sets the ApplicationDownlink message from flags.
@@ -747,6 +761,18 @@ func (m *ApplicationDownlink) SetFromFlags(flags *pflag.FlagSet, prefix string)
 			paths = append(paths, setPaths...)
 		}
 	}
+	if val, changed, err := flagsplugin.GetTimestamp(flags, flagsplugin.Prefix("expires_at", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.ExpiresAt = golang.SetTimestamp(val)
+		paths = append(paths, flagsplugin.Prefix("expires_at", prefix))
+	}
+	if val, changed, err := flagsplugin.GetDuration(flags, flagsplugin.Prefix("ttl", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Ttl = golang.SetDuration(val)
+		paths = append(paths, flagsplugin.Prefix("ttl", prefix))
+	}
 	return paths, nil
 }
 
@@ -856,6 +882,8 @@ func AddSelectFlagsForApplicationUp(flags *pflag.FlagSet, prefix string, hidden
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("up.service-data", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("up.service-data", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationServiceData(flags, flagsplugin.Prefix("up.service-data", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("simulated", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("simulated", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("replay", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("replay", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationUpReplay(flags, flagsplugin.Prefix("replay", prefix), hidden)
 }
 
 // SelectFromFlags outputs the fieldmask paths forApplicationUp message from select flags.
@@ -995,6 +1023,216 @@ func PathsFromSelectFlagsForApplicationUp(flags *pflag.FlagSet, prefix string) (
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("simulated", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("replay", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("replay", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationUpReplay(flags, flagsplugin.Prefix("replay", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
+	return paths, nil
+}
+
+// AddSelectFlagsForApplicationUpReplayTarget_Webhook adds flags to select fields in ApplicationUpReplayTarget_Webhook.
+func AddSelectFlagsForApplicationUpReplayTarget_Webhook(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("webhook-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("webhook-id", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationUpReplayTarget_Webhook message from select flags.
+func PathsFromSelectFlagsForApplicationUpReplayTarget_Webhook(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("webhook_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("webhook_id", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationUpReplayTarget_Webhook adds flags to select fields in ApplicationUpReplayTarget_Webhook.
+func AddSetFlagsForApplicationUpReplayTarget_Webhook(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("webhook-id", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ApplicationUpReplayTarget_Webhook message from flags.
+func (m *ApplicationUpReplayTarget_Webhook) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("webhook_id", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.WebhookId = val
+		paths = append(paths, flagsplugin.Prefix("webhook_id", prefix))
+	}
+	return paths, nil
+}
+
+// AddSelectFlagsForApplicationUpReplayTarget_PubSub adds flags to select fields in ApplicationUpReplayTarget_PubSub.
+func AddSelectFlagsForApplicationUpReplayTarget_PubSub(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("pub-sub-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("pub-sub-id", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationUpReplayTarget_PubSub message from select flags.
+func PathsFromSelectFlagsForApplicationUpReplayTarget_PubSub(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("pub_sub_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("pub_sub_id", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationUpReplayTarget_PubSub adds flags to select fields in ApplicationUpReplayTarget_PubSub.
+func AddSetFlagsForApplicationUpReplayTarget_PubSub(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("pub-sub-id", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ApplicationUpReplayTarget_PubSub message from flags.
+func (m *ApplicationUpReplayTarget_PubSub) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("pub_sub_id", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.PubSubId = val
+		paths = append(paths, flagsplugin.Prefix("pub_sub_id", prefix))
+	}
+	return paths, nil
+}
+
+// AddSelectFlagsForApplicationUpReplayTarget_MQTT adds flags to select fields in ApplicationUpReplayTarget_MQTT.
+func AddSelectFlagsForApplicationUpReplayTarget_MQTT(flags *pflag.FlagSet, prefix string, hidden bool) {
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationUpReplayTarget_MQTT message from select flags.
+func PathsFromSelectFlagsForApplicationUpReplayTarget_MQTT(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationUpReplayTarget_MQTT adds flags to select fields in ApplicationUpReplayTarget_MQTT.
+func AddSetFlagsForApplicationUpReplayTarget_MQTT(flags *pflag.FlagSet, prefix string, hidden bool) {
+}
+
+// SetFromFlags sets the ApplicationUpReplayTarget_MQTT message from flags.
+func (m *ApplicationUpReplayTarget_MQTT) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	return paths, nil
+}
+
+// AddSelectFlagsForApplicationUpReplayTarget adds flags to select fields in ApplicationUpReplayTarget.
+func AddSelectFlagsForApplicationUpReplayTarget(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("integration.webhook", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("integration.webhook", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationUpReplayTarget_Webhook(flags, flagsplugin.Prefix("integration.webhook", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("integration.pub-sub", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("integration.pub-sub", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationUpReplayTarget_PubSub(flags, flagsplugin.Prefix("integration.pub-sub", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("integration.mqtt", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("integration.mqtt", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationUpReplayTarget_MQTT(flags, flagsplugin.Prefix("integration.mqtt", prefix), hidden)
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationUpReplayTarget message from select flags.
+func PathsFromSelectFlagsForApplicationUpReplayTarget(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("integration.webhook", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("integration.webhook", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationUpReplayTarget_Webhook(flags, flagsplugin.Prefix("integration.webhook", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("integration.pub_sub", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("integration.pub_sub", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationUpReplayTarget_PubSub(flags, flagsplugin.Prefix("integration.pub_sub", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("integration.mqtt", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("integration.mqtt", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationUpReplayTarget_MQTT(flags, flagsplugin.Prefix("integration.mqtt", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationUpReplayTarget adds flags to select fields in ApplicationUpReplayTarget.
+func AddSetFlagsForApplicationUpReplayTarget(flags *pflag.FlagSet, prefix string, hidden bool) {
+	AddSetFlagsForApplicationUpReplayTarget_Webhook(flags, flagsplugin.Prefix("integration.webhook", prefix), hidden)
+	AddSetFlagsForApplicationUpReplayTarget_PubSub(flags, flagsplugin.Prefix("integration.pub-sub", prefix), hidden)
+	AddSetFlagsForApplicationUpReplayTarget_MQTT(flags, flagsplugin.Prefix("integration.mqtt", prefix), hidden)
+}
+
+// SetFromFlags sets the ApplicationUpReplayTarget message from flags.
+func (m *ApplicationUpReplayTarget) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("integration.webhook", prefix)); changed {
+		ov := &ApplicationUpReplayTarget_Webhook_{}
+		if ov.Webhook == nil {
+			ov.Webhook = &ApplicationUpReplayTarget_Webhook{}
+		}
+		if setPaths, err := ov.Webhook.SetFromFlags(flags, flagsplugin.Prefix("integration.webhook", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+		m.Integration = ov
+	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("integration.pub_sub", prefix)); changed {
+		ov := &ApplicationUpReplayTarget_PubSub_{}
+		if ov.PubSub == nil {
+			ov.PubSub = &ApplicationUpReplayTarget_PubSub{}
+		}
+		if setPaths, err := ov.PubSub.SetFromFlags(flags, flagsplugin.Prefix("integration.pub_sub", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+		m.Integration = ov
+	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("integration.mqtt", prefix)); changed {
+		ov := &ApplicationUpReplayTarget_Mqtt{}
+		if ov.Mqtt == nil {
+			ov.Mqtt = &ApplicationUpReplayTarget_MQTT{}
+		}
+		if setPaths, err := ov.Mqtt.SetFromFlags(flags, flagsplugin.Prefix("integration.mqtt", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+		m.Integration = ov
+	}
+	return paths, nil
+}
+
+// AddSelectFlagsForApplicationUpReplay adds flags to select fields in ApplicationUpReplay.
+func AddSelectFlagsForApplicationUpReplay(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("replay-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("replay-id", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("target", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("target", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationUpReplayTarget(flags, flagsplugin.Prefix("target", prefix), hidden)
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationUpReplay message from select flags.
+func PathsFromSelectFlagsForApplicationUpReplay(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("replay_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("replay_id", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("target", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("target", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationUpReplayTarget(flags, flagsplugin.Prefix("target", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	return paths, nil
 }
 
@@ -1471,6 +1471,24 @@ func (x *ApplicationDownlink) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		// NOTE: ApplicationDownlink_ConfirmedRetry does not seem to implement MarshalProtoJSON.
 		golang.MarshalMessage(s, x.ConfirmedRetry)
 	}
+	if x.ExpiresAt != nil || s.HasField("expires_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("expires_at")
+		if x.ExpiresAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.ExpiresAt)
+		}
+	}
+	if x.Ttl != nil || s.HasField("ttl") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ttl")
+		if x.Ttl == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Ttl)
+		}
+	}
 	s.WriteObjectEnd()
 }
 
@@ -1548,6 +1566,28 @@ func (x *ApplicationDownlink) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 			var v ApplicationDownlink_ConfirmedRetry
 			golang.UnmarshalMessage(s, &v)
 			x.ConfirmedRetry = &v
+		case "expires_at", "expiresAt":
+			s.AddField("expires_at")
+			if s.ReadNil() {
+				x.ExpiresAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.ExpiresAt = v
+		case "ttl":
+			s.AddField("ttl")
+			if s.ReadNil() {
+				x.Ttl = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Ttl = v
 		}
 	})
 }
@@ -1924,6 +1964,12 @@ func (x *ApplicationUp) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("simulated")
 		s.WriteBool(x.Simulated)
 	}
+	if x.Replay != nil || s.HasField("replay") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("replay")
+		// NOTE: ApplicationUpReplay does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Replay)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -2071,6 +2117,16 @@ func (x *ApplicationUp) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 		case "simulated":
 			s.AddField("simulated")
 			x.Simulated = s.ReadBool()
+		case "replay":
+			s.AddField("replay")
+			if s.ReadNil() {
+				x.Replay = nil
+				return
+			}
+			// NOTE: ApplicationUpReplay does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationUpReplay
+			golang.UnmarshalMessage(s, &v)
+			x.Replay = &v
 		}
 	})
 }
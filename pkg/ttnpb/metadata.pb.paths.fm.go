@@ -35,6 +35,8 @@ var RxMetadataFieldPathsNested = []string{
 	"packet_broker.home_network_tenant_id",
 	"packet_broker.hops",
 	"packet_broker.message_id",
+	"packet_source",
+	"packet_source.source_id",
 	"received_at",
 	"relay",
 	"relay.device_id",
@@ -64,6 +66,7 @@ var RxMetadataFieldPathsTopLevel = []string{
 	"hopping_width",
 	"location",
 	"packet_broker",
+	"packet_source",
 	"received_at",
 	"relay",
 	"rssi",
@@ -129,6 +132,13 @@ var PacketBrokerRouteHopFieldPathsTopLevel = []string{
 	"sender_address",
 	"sender_name",
 }
+var PacketSourceMetadataFieldPathsNested = []string{
+	"source_id",
+}
+
+var PacketSourceMetadataFieldPathsTopLevel = []string{
+	"source_id",
+}
 var RelayMetadataFieldPathsNested = []string{
 	"device_id",
 	"wor_channel",
@@ -299,6 +299,31 @@ func (dst *RxMetadata) SetFields(src *RxMetadata, paths ...string) error {
 			} else {
 				dst.Advanced = nil
 			}
+		case "packet_source":
+			if len(subs) > 0 {
+				var newDst, newSrc *PacketSourceMetadata
+				if (src == nil || src.PacketSource == nil) && dst.PacketSource == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.PacketSource
+				}
+				if dst.PacketSource != nil {
+					newDst = dst.PacketSource
+				} else {
+					newDst = &PacketSourceMetadata{}
+					dst.PacketSource = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.PacketSource = src.PacketSource
+				} else {
+					dst.PacketSource = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -533,6 +558,27 @@ func (dst *PacketBrokerRouteHop) SetFields(src *PacketBrokerRouteHop, paths ...s
 	return nil
 }
 
+func (dst *PacketSourceMetadata) SetFields(src *PacketSourceMetadata, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "source_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'source_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SourceId = src.SourceId
+			} else {
+				var zero string
+				dst.SourceId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *RelayMetadata) SetFields(src *RelayMetadata, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -206,6 +206,18 @@ func (m *RxMetadata) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "packet_source":
+
+			if v, ok := interface{}(m.GetPacketSource()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RxMetadataValidationError{
+						field:  "packet_source",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return RxMetadataValidationError{
 				field:  name,
@@ -640,6 +652,96 @@ var _ interface {
 	ErrorName() string
 } = PacketBrokerRouteHopValidationError{}
 
+// ValidateFields checks the field values on PacketSourceMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PacketSourceMetadata) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PacketSourceMetadataFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "source_id":
+
+			if l := utf8.RuneCountInString(m.GetSourceId()); l < 1 || l > 100 {
+				return PacketSourceMetadataValidationError{
+					field:  "source_id",
+					reason: "value length must be between 1 and 100 runes, inclusive",
+				}
+			}
+
+		default:
+			return PacketSourceMetadataValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PacketSourceMetadataValidationError is the validation error returned by
+// PacketSourceMetadata.ValidateFields if the designated constraints aren't met.
+type PacketSourceMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PacketSourceMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PacketSourceMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PacketSourceMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PacketSourceMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PacketSourceMetadataValidationError) ErrorName() string {
+	return "PacketSourceMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PacketSourceMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPacketSourceMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PacketSourceMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PacketSourceMetadataValidationError{}
+
 // ValidateFields checks the field values on RelayMetadata with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
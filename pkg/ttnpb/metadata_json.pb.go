@@ -214,6 +214,12 @@ func (x *RxMetadata) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			golang.MarshalStruct(s, x.Advanced)
 		}
 	}
+	if x.PacketSource != nil || s.HasField("packet_source") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("packet_source")
+		// NOTE: PacketSourceMetadata does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.PacketSource)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -359,6 +365,16 @@ func (x *RxMetadata) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.Advanced = v
+		case "packet_source", "packetSource":
+			s.AddField("packet_source")
+			if s.ReadNil() {
+				x.PacketSource = nil
+				return
+			}
+			// NOTE: PacketSourceMetadata does not seem to implement UnmarshalProtoJSON.
+			var v PacketSourceMetadata
+			golang.UnmarshalMessage(s, &v)
+			x.PacketSource = &v
 		}
 	})
 }
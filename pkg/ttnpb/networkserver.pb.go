@@ -28,7 +28,11 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -40,6 +44,205 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Role of the Network Server in a handover roaming session.
+type HandoverRoamingRole int32
+
+const (
+	// The Network Server handed over the session to a serving Network Server.
+	HandoverRoamingRole_HANDOVER_ROAMING_ROLE_HOME HandoverRoamingRole = 0
+	// The Network Server serves the session handed over by a home Network Server.
+	HandoverRoamingRole_HANDOVER_ROAMING_ROLE_SERVING HandoverRoamingRole = 1
+)
+
+// Enum value maps for HandoverRoamingRole.
+var (
+	HandoverRoamingRole_name = map[int32]string{
+		0: "HANDOVER_ROAMING_ROLE_HOME",
+		1: "HANDOVER_ROAMING_ROLE_SERVING",
+	}
+	HandoverRoamingRole_value = map[string]int32{
+		"HANDOVER_ROAMING_ROLE_HOME":    0,
+		"HANDOVER_ROAMING_ROLE_SERVING": 1,
+	}
+)
+
+func (x HandoverRoamingRole) Enum() *HandoverRoamingRole {
+	p := new(HandoverRoamingRole)
+	*p = x
+	return p
+}
+
+func (x HandoverRoamingRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HandoverRoamingRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_networkserver_proto_enumTypes[0].Descriptor()
+}
+
+func (HandoverRoamingRole) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_networkserver_proto_enumTypes[0]
+}
+
+func (x HandoverRoamingRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HandoverRoamingRole.Descriptor instead.
+func (HandoverRoamingRole) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{0}
+}
+
+// Scope of a daily downlink budget of the Network Server.
+type DownlinkBudgetScope int32
+
+const (
+	// The budget of a single end device.
+	DownlinkBudgetScope_DOWNLINK_BUDGET_SCOPE_END_DEVICE DownlinkBudgetScope = 0
+	// The budget shared by all end devices of an application.
+	DownlinkBudgetScope_DOWNLINK_BUDGET_SCOPE_APPLICATION DownlinkBudgetScope = 1
+	// The budget shared by all end devices served by the Network Server.
+	DownlinkBudgetScope_DOWNLINK_BUDGET_SCOPE_NETWORK DownlinkBudgetScope = 2
+)
+
+// Enum value maps for DownlinkBudgetScope.
+var (
+	DownlinkBudgetScope_name = map[int32]string{
+		0: "DOWNLINK_BUDGET_SCOPE_END_DEVICE",
+		1: "DOWNLINK_BUDGET_SCOPE_APPLICATION",
+		2: "DOWNLINK_BUDGET_SCOPE_NETWORK",
+	}
+	DownlinkBudgetScope_value = map[string]int32{
+		"DOWNLINK_BUDGET_SCOPE_END_DEVICE":  0,
+		"DOWNLINK_BUDGET_SCOPE_APPLICATION": 1,
+		"DOWNLINK_BUDGET_SCOPE_NETWORK":     2,
+	}
+)
+
+func (x DownlinkBudgetScope) Enum() *DownlinkBudgetScope {
+	p := new(DownlinkBudgetScope)
+	*p = x
+	return p
+}
+
+func (x DownlinkBudgetScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DownlinkBudgetScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_networkserver_proto_enumTypes[1].Descriptor()
+}
+
+func (DownlinkBudgetScope) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_networkserver_proto_enumTypes[1]
+}
+
+func (x DownlinkBudgetScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DownlinkBudgetScope.Descriptor instead.
+func (DownlinkBudgetScope) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{1}
+}
+
+// Action of a relay assignment suggestion.
+type RelayAssignmentAction int32
+
+const (
+	// Assign the end device to the relay.
+	RelayAssignmentAction_RELAY_ASSIGNMENT_ACTION_ASSIGN RelayAssignmentAction = 0
+	// Release the end device from the relay.
+	RelayAssignmentAction_RELAY_ASSIGNMENT_ACTION_RELEASE RelayAssignmentAction = 1
+)
+
+// Enum value maps for RelayAssignmentAction.
+var (
+	RelayAssignmentAction_name = map[int32]string{
+		0: "RELAY_ASSIGNMENT_ACTION_ASSIGN",
+		1: "RELAY_ASSIGNMENT_ACTION_RELEASE",
+	}
+	RelayAssignmentAction_value = map[string]int32{
+		"RELAY_ASSIGNMENT_ACTION_ASSIGN":  0,
+		"RELAY_ASSIGNMENT_ACTION_RELEASE": 1,
+	}
+)
+
+func (x RelayAssignmentAction) Enum() *RelayAssignmentAction {
+	p := new(RelayAssignmentAction)
+	*p = x
+	return p
+}
+
+func (x RelayAssignmentAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelayAssignmentAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_networkserver_proto_enumTypes[2].Descriptor()
+}
+
+func (RelayAssignmentAction) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_networkserver_proto_enumTypes[2]
+}
+
+func (x RelayAssignmentAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelayAssignmentAction.Descriptor instead.
+func (RelayAssignmentAction) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{2}
+}
+
+type FCntRejection_Reason int32
+
+const (
+	// The frame counter is below the last frame counter of the session.
+	FCntRejection_REASON_RESET FCntRejection_Reason = 0
+	// The frame counter exceeds the maximum frame counter gap.
+	FCntRejection_REASON_GAP FCntRejection_Reason = 1
+)
+
+// Enum value maps for FCntRejection_Reason.
+var (
+	FCntRejection_Reason_name = map[int32]string{
+		0: "REASON_RESET",
+		1: "REASON_GAP",
+	}
+	FCntRejection_Reason_value = map[string]int32{
+		"REASON_RESET": 0,
+		"REASON_GAP":   1,
+	}
+)
+
+func (x FCntRejection_Reason) Enum() *FCntRejection_Reason {
+	p := new(FCntRejection_Reason)
+	*p = x
+	return p
+}
+
+func (x FCntRejection_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FCntRejection_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_ttn_lorawan_v3_networkserver_proto_enumTypes[3].Descriptor()
+}
+
+func (FCntRejection_Reason) Type() protoreflect.EnumType {
+	return &file_ttn_lorawan_v3_networkserver_proto_enumTypes[3]
+}
+
+func (x FCntRejection_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FCntRejection_Reason.Descriptor instead.
+func (FCntRejection_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{23, 0}
+}
+
 // Response of GenerateDevAddr.
 type GenerateDevAddrResponse struct {
 	state         protoimpl.MessageState
@@ -238,366 +441,4615 @@ func (x *GetDeviceAdressPrefixesResponse) GetDevAddrPrefixes() [][]byte {
 	return nil
 }
 
-var File_ttn_lorawan_v3_networkserver_proto protoreflect.FileDescriptor
+// Utilization of a DevAddr prefix of the Network Server.
+type DevAddrPrefixUtilization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_ttn_lorawan_v3_networkserver_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
-	0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
-	0x6e, 0x2e, 0x76, 0x33, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x21, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x20, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
-	0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77,
-	0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72,
-	0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77,
-	0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x01,
-	0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x41, 0x64, 0x64,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xc8, 0x01, 0x0a, 0x08, 0x64, 0x65,
-	0x76, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xac, 0x01, 0x92,
-	0x41, 0x19, 0x4a, 0x0a, 0x22, 0x32, 0x36, 0x30, 0x30, 0x41, 0x42, 0x43, 0x44, 0x22, 0x9a, 0x02,
-	0x01, 0x07, 0xa2, 0x02, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xfa, 0x42, 0x06, 0x7a, 0x04,
-	0x68, 0x04, 0x70, 0x01, 0xea, 0xaa, 0x19, 0x82, 0x01, 0x0a, 0x3f, 0x67, 0x6f, 0x2e, 0x74, 0x68,
-	0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
-	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68,
-	0x61, 0x6c, 0x48, 0x45, 0x58, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x67, 0x6f, 0x2e, 0x74,
-	0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76,
-	0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x6d, 0x61,
-	0x72, 0x73, 0x68, 0x61, 0x6c, 0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x07, 0x64, 0x65, 0x76,
-	0x41, 0x64, 0x64, 0x72, 0x22, 0xb3, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x4d, 0x41, 0x43, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x11, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
-	0x63, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x0f, 0x66, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x79, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x13, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x5f, 0x70, 0x68, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x50, 0x48, 0x59, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x11, 0x6c,
-	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x50, 0x68, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x3a, 0x08, 0xf2, 0xaa, 0x19, 0x04, 0x08, 0x00, 0x10, 0x01, 0x22, 0xd7, 0x01, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x4e, 0x65, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0xc2, 0x01, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0xaa, 0x01, 0x92, 0x41, 0x17, 0x4a, 0x08, 0x22, 0x30, 0x30, 0x30, 0x30, 0x31, 0x33, 0x22,
-	0x9a, 0x02, 0x01, 0x07, 0xa2, 0x02, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xfa, 0x42, 0x06,
-	0x7a, 0x04, 0x68, 0x03, 0x70, 0x01, 0xea, 0xaa, 0x19, 0x82, 0x01, 0x0a, 0x3f, 0x67, 0x6f, 0x2e,
-	0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f,
-	0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72,
-	0x73, 0x68, 0x61, 0x6c, 0x48, 0x45, 0x58, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x67, 0x6f,
-	0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b,
-	0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e,
-	0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x33, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x05, 0x6e,
-	0x65, 0x74, 0x49, 0x64, 0x22, 0x8e, 0x02, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xea, 0x01, 0x0a, 0x11, 0x64, 0x65, 0x76,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0c, 0x42, 0xbd, 0x01, 0x92, 0x41, 0x11, 0x4a, 0x0f, 0x5b, 0x22, 0x32, 0x36,
-	0x30, 0x30, 0x41, 0x42, 0x30, 0x30, 0x2f, 0x32, 0x34, 0x22, 0x5d, 0xfa, 0x42, 0x09, 0x92, 0x01,
-	0x06, 0x22, 0x04, 0x7a, 0x02, 0x68, 0x05, 0xea, 0xaa, 0x19, 0x98, 0x01, 0x0a, 0x49, 0x67, 0x6f,
-	0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b,
-	0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61,
-	0x72, 0x73, 0x68, 0x61, 0x6c, 0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74,
-	0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68,
-	0x61, 0x6c, 0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x53,
-	0x6c, 0x69, 0x63, 0x65, 0x52, 0x0f, 0x64, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x65, 0x73, 0x32, 0xac, 0x04, 0x0a, 0x02, 0x4e, 0x73, 0x12, 0x68, 0x0a, 0x0f,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x6e, 0x73, 0x2f, 0x64, 0x65,
-	0x76, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x12, 0xae, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x41, 0x43, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
-	0x33, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x41, 0x43, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
-	0x4d, 0x41, 0x43, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x44, 0x12, 0x42, 0x2f, 0x6e, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x5f, 0x6d, 0x61, 0x63, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x66,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x7b, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x5f, 0x70, 0x68, 0x79, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x12, 0x58, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4e, 0x65,
-	0x74, 0x49, 0x44, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x74, 0x74,
-	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74,
-	0x4e, 0x65, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x6e, 0x73, 0x2f, 0x6e, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x12, 0x82, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
-	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x41, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
-	0x15, 0x2f, 0x6e, 0x73, 0x2f, 0x64, 0x65, 0x76, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x1a, 0x2c, 0x92, 0x41, 0x29, 0x12, 0x27, 0x4d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x20, 0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x53,
-	0x74, 0x61, 0x63, 0x6b, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x32, 0xae, 0x03, 0x0a, 0x04, 0x41, 0x73, 0x4e, 0x73, 0x12, 0x54, 0x0a,
-	0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
-	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
-	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5f, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x74,
-	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x73, 0x1a, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
-	0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x1a, 0x9b, 0x01, 0x92, 0x41, 0x97, 0x01, 0x12, 0x94,
-	0x01, 0x54, 0x68, 0x65, 0x20, 0x41, 0x73, 0x4e, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20, 0x61, 0x6e,
-	0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x73, 0x20,
-	0x6e, 0x6f, 0x74, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20,
-	0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x2e, 0x32, 0xc1, 0x02, 0x0a, 0x04, 0x47, 0x73, 0x4e, 0x73, 0x12, 0x45,
-	0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1d,
-	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
-	0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x59, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54,
-	0x78, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
-	0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x78, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
-	0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x96, 0x01, 0x92, 0x41, 0x92, 0x01, 0x12, 0x8f, 0x01, 0x54, 0x68, 0x65, 0x20, 0x47, 0x73,
-	0x4e, 0x53, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x73, 0x20, 0x61, 0x20, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x20, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x69,
-	0x73, 0x20, 0x61, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64,
-	0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64,
-	0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x65,
-	0x6e, 0x64, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x32, 0xf9, 0x06, 0x0a, 0x13, 0x4e, 0x73,
-	0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x79, 0x12, 0xb2, 0x01, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
-	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
-	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
-	0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x65, 0x12, 0x63, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64,
-	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x86, 0x02, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x23,
-	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
-	0x53, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
-	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xbe,
-	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0xb7, 0x01, 0x3a, 0x01, 0x2a, 0x5a, 0x4d, 0x3a, 0x01, 0x2a,
-	0x22, 0x48, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x69,
-	0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x63, 0x2f, 0x6e, 0x73, 0x2f,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e,
-	0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12,
-	0xce, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
-	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41,
-	0x6e, 0x64, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
-	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x22, 0x6e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x68, 0x3a, 0x01, 0x2a, 0x32, 0x63, 0x2f, 0x6e, 0x73,
-	0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65,
-	0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0x95, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x74,
-	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x47, 0x2a, 0x45, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x1a, 0x3b, 0x92, 0x41, 0x38, 0x12, 0x36, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x20, 0x6f, 0x6e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x20,
-	0x53, 0x74, 0x61, 0x63, 0x6b, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x32, 0xfc, 0x01, 0x0a, 0x18, 0x4e, 0x73, 0x45, 0x6e, 0x64, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x79, 0x12, 0x97, 0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2c, 0x2e,
-	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x2a, 0x3f, 0x2f, 0x6e, 0x73,
-	0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x46, 0x92, 0x41,
-	0x43, 0x12, 0x41, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65,
-	0x73, 0x20, 0x6f, 0x66, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x20, 0x6f, 0x6e, 0x20, 0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x53,
-	0x74, 0x61, 0x63, 0x6b, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68,
-	0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72,
-	0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Prefix *DevAddrPrefix `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Number of addresses in the prefix.
+	Addresses uint64 `protobuf:"varint,2,opt,name=addresses,proto3" json:"addresses,omitempty"`
+	// Number of addresses in the prefix used by at least one end device.
+	UsedAddresses uint64 `protobuf:"varint,3,opt,name=used_addresses,json=usedAddresses,proto3" json:"used_addresses,omitempty"`
+	// Number of addresses in the prefix used by more than one end device.
+	SharedAddresses uint64 `protobuf:"varint,4,opt,name=shared_addresses,json=sharedAddresses,proto3" json:"shared_addresses,omitempty"`
+	// Number of sessions using an address in the prefix.
+	Sessions uint64 `protobuf:"varint,5,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	// Highest number of sessions using the same address in the prefix.
+	MaxSessionsPerAddress uint64 `protobuf:"varint,6,opt,name=max_sessions_per_address,json=maxSessionsPerAddress,proto3" json:"max_sessions_per_address,omitempty"`
 }
 
-var (
-	file_ttn_lorawan_v3_networkserver_proto_rawDescOnce sync.Once
-	file_ttn_lorawan_v3_networkserver_proto_rawDescData = file_ttn_lorawan_v3_networkserver_proto_rawDesc
-)
+func (x *DevAddrPrefixUtilization) Reset() {
+	*x = DevAddrPrefixUtilization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP() []byte {
-	file_ttn_lorawan_v3_networkserver_proto_rawDescOnce.Do(func() {
-		file_ttn_lorawan_v3_networkserver_proto_rawDescData = protoimpl.X.CompressGZIP(file_ttn_lorawan_v3_networkserver_proto_rawDescData)
-	})
-	return file_ttn_lorawan_v3_networkserver_proto_rawDescData
+func (x *DevAddrPrefixUtilization) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_ttn_lorawan_v3_networkserver_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_ttn_lorawan_v3_networkserver_proto_goTypes = []interface{}{
-	(*GenerateDevAddrResponse)(nil),         // 0: ttn.lorawan.v3.GenerateDevAddrResponse
-	(*GetDefaultMACSettingsRequest)(nil),    // 1: ttn.lorawan.v3.GetDefaultMACSettingsRequest
-	(*GetNetIDResponse)(nil),                // 2: ttn.lorawan.v3.GetNetIDResponse
-	(*GetDeviceAdressPrefixesResponse)(nil), // 3: ttn.lorawan.v3.GetDeviceAdressPrefixesResponse
-	(PHYVersion)(0),                         // 4: ttn.lorawan.v3.PHYVersion
-	(*emptypb.Empty)(nil),                   // 5: google.protobuf.Empty
-	(*DownlinkQueueRequest)(nil),            // 6: ttn.lorawan.v3.DownlinkQueueRequest
-	(*EndDeviceIdentifiers)(nil),            // 7: ttn.lorawan.v3.EndDeviceIdentifiers
-	(*UplinkMessage)(nil),                   // 8: ttn.lorawan.v3.UplinkMessage
-	(*GatewayTxAcknowledgment)(nil),         // 9: ttn.lorawan.v3.GatewayTxAcknowledgment
-	(*GetEndDeviceRequest)(nil),             // 10: ttn.lorawan.v3.GetEndDeviceRequest
-	(*SetEndDeviceRequest)(nil),             // 11: ttn.lorawan.v3.SetEndDeviceRequest
-	(*ResetAndGetEndDeviceRequest)(nil),     // 12: ttn.lorawan.v3.ResetAndGetEndDeviceRequest
-	(*BatchDeleteEndDevicesRequest)(nil),    // 13: ttn.lorawan.v3.BatchDeleteEndDevicesRequest
-	(*MACSettings)(nil),                     // 14: ttn.lorawan.v3.MACSettings
-	(*ApplicationDownlinks)(nil),            // 15: ttn.lorawan.v3.ApplicationDownlinks
-	(*EndDevice)(nil),                       // 16: ttn.lorawan.v3.EndDevice
+func (*DevAddrPrefixUtilization) ProtoMessage() {}
+
+func (x *DevAddrPrefixUtilization) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_ttn_lorawan_v3_networkserver_proto_depIdxs = []int32{
-	4,  // 0: ttn.lorawan.v3.GetDefaultMACSettingsRequest.lorawan_phy_version:type_name -> ttn.lorawan.v3.PHYVersion
-	5,  // 1: ttn.lorawan.v3.Ns.GenerateDevAddr:input_type -> google.protobuf.Empty
-	1,  // 2: ttn.lorawan.v3.Ns.GetDefaultMACSettings:input_type -> ttn.lorawan.v3.GetDefaultMACSettingsRequest
-	5,  // 3: ttn.lorawan.v3.Ns.GetNetID:input_type -> google.protobuf.Empty
-	5,  // 4: ttn.lorawan.v3.Ns.GetDeviceAddressPrefixes:input_type -> google.protobuf.Empty
-	6,  // 5: ttn.lorawan.v3.AsNs.DownlinkQueueReplace:input_type -> ttn.lorawan.v3.DownlinkQueueRequest
-	6,  // 6: ttn.lorawan.v3.AsNs.DownlinkQueuePush:input_type -> ttn.lorawan.v3.DownlinkQueueRequest
-	7,  // 7: ttn.lorawan.v3.AsNs.DownlinkQueueList:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
-	8,  // 8: ttn.lorawan.v3.GsNs.HandleUplink:input_type -> ttn.lorawan.v3.UplinkMessage
-	9,  // 9: ttn.lorawan.v3.GsNs.ReportTxAcknowledgment:input_type -> ttn.lorawan.v3.GatewayTxAcknowledgment
-	10, // 10: ttn.lorawan.v3.NsEndDeviceRegistry.Get:input_type -> ttn.lorawan.v3.GetEndDeviceRequest
-	11, // 11: ttn.lorawan.v3.NsEndDeviceRegistry.Set:input_type -> ttn.lorawan.v3.SetEndDeviceRequest
-	12, // 12: ttn.lorawan.v3.NsEndDeviceRegistry.ResetFactoryDefaults:input_type -> ttn.lorawan.v3.ResetAndGetEndDeviceRequest
-	7,  // 13: ttn.lorawan.v3.NsEndDeviceRegistry.Delete:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
-	13, // 14: ttn.lorawan.v3.NsEndDeviceBatchRegistry.Delete:input_type -> ttn.lorawan.v3.BatchDeleteEndDevicesRequest
-	0,  // 15: ttn.lorawan.v3.Ns.GenerateDevAddr:output_type -> ttn.lorawan.v3.GenerateDevAddrResponse
-	14, // 16: ttn.lorawan.v3.Ns.GetDefaultMACSettings:output_type -> ttn.lorawan.v3.MACSettings
-	2,  // 17: ttn.lorawan.v3.Ns.GetNetID:output_type -> ttn.lorawan.v3.GetNetIDResponse
-	3,  // 18: ttn.lorawan.v3.Ns.GetDeviceAddressPrefixes:output_type -> ttn.lorawan.v3.GetDeviceAdressPrefixesResponse
-	5,  // 19: ttn.lorawan.v3.AsNs.DownlinkQueueReplace:output_type -> google.protobuf.Empty
-	5,  // 20: ttn.lorawan.v3.AsNs.DownlinkQueuePush:output_type -> google.protobuf.Empty
-	15, // 21: ttn.lorawan.v3.AsNs.DownlinkQueueList:output_type -> ttn.lorawan.v3.ApplicationDownlinks
-	5,  // 22: ttn.lorawan.v3.GsNs.HandleUplink:output_type -> google.protobuf.Empty
-	5,  // 23: ttn.lorawan.v3.GsNs.ReportTxAcknowledgment:output_type -> google.protobuf.Empty
-	16, // 24: ttn.lorawan.v3.NsEndDeviceRegistry.Get:output_type -> ttn.lorawan.v3.EndDevice
-	16, // 25: ttn.lorawan.v3.NsEndDeviceRegistry.Set:output_type -> ttn.lorawan.v3.EndDevice
-	16, // 26: ttn.lorawan.v3.NsEndDeviceRegistry.ResetFactoryDefaults:output_type -> ttn.lorawan.v3.EndDevice
-	5,  // 27: ttn.lorawan.v3.NsEndDeviceRegistry.Delete:output_type -> google.protobuf.Empty
-	5,  // 28: ttn.lorawan.v3.NsEndDeviceBatchRegistry.Delete:output_type -> google.protobuf.Empty
-	15, // [15:29] is the sub-list for method output_type
-	1,  // [1:15] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+
+// Deprecated: Use DevAddrPrefixUtilization.ProtoReflect.Descriptor instead.
+func (*DevAddrPrefixUtilization) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{4}
 }
 
-func init() { file_ttn_lorawan_v3_networkserver_proto_init() }
-func file_ttn_lorawan_v3_networkserver_proto_init() {
-	if File_ttn_lorawan_v3_networkserver_proto != nil {
-		return
+func (x *DevAddrPrefixUtilization) GetPrefix() *DevAddrPrefix {
+	if x != nil {
+		return x.Prefix
 	}
-	file_ttn_lorawan_v3_end_device_proto_init()
-	file_ttn_lorawan_v3_identifiers_proto_init()
-	file_ttn_lorawan_v3_lorawan_proto_init()
-	file_ttn_lorawan_v3_messages_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_ttn_lorawan_v3_networkserver_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateDevAddrResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ttn_lorawan_v3_networkserver_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDefaultMACSettingsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ttn_lorawan_v3_networkserver_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNetIDResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *DevAddrPrefixUtilization) GetAddresses() uint64 {
+	if x != nil {
+		return x.Addresses
+	}
+	return 0
+}
+
+func (x *DevAddrPrefixUtilization) GetUsedAddresses() uint64 {
+	if x != nil {
+		return x.UsedAddresses
+	}
+	return 0
+}
+
+func (x *DevAddrPrefixUtilization) GetSharedAddresses() uint64 {
+	if x != nil {
+		return x.SharedAddresses
+	}
+	return 0
+}
+
+func (x *DevAddrPrefixUtilization) GetSessions() uint64 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *DevAddrPrefixUtilization) GetMaxSessionsPerAddress() uint64 {
+	if x != nil {
+		return x.MaxSessionsPerAddress
+	}
+	return 0
+}
+
+type GetDevAddrPrefixUtilizationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prefixes []*DevAddrPrefixUtilization `protobuf:"bytes,1,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *GetDevAddrPrefixUtilizationResponse) Reset() {
+	*x = GetDevAddrPrefixUtilizationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDevAddrPrefixUtilizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDevAddrPrefixUtilizationResponse) ProtoMessage() {}
+
+func (x *GetDevAddrPrefixUtilizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_ttn_lorawan_v3_networkserver_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDeviceAdressPrefixesResponse); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDevAddrPrefixUtilizationResponse.ProtoReflect.Descriptor instead.
+func (*GetDevAddrPrefixUtilizationResponse) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDevAddrPrefixUtilizationResponse) GetPrefixes() []*DevAddrPrefixUtilization {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+// Handover roaming session of an end device.
+type EndDeviceHandover struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	Role         HandoverRoamingRole   `protobuf:"varint,2,opt,name=role,proto3,enum=ttn.lorawan.v3.HandoverRoamingRole" json:"role,omitempty"`
+	// Identifiers of the roaming partner.
+	PartnerIds *NetworkIdentifiers    `protobuf:"bytes,3,opt,name=partner_ids,json=partnerIds,proto3" json:"partner_ids,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *EndDeviceHandover) Reset() {
+	*x = EndDeviceHandover{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceHandover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceHandover) ProtoMessage() {}
+
+func (x *EndDeviceHandover) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceHandover.ProtoReflect.Descriptor instead.
+func (*EndDeviceHandover) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EndDeviceHandover) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *EndDeviceHandover) GetRole() HandoverRoamingRole {
+	if x != nil {
+		return x.Role
+	}
+	return HandoverRoamingRole_HANDOVER_ROAMING_ROLE_HOME
+}
+
+func (x *EndDeviceHandover) GetPartnerIds() *NetworkIdentifiers {
+	if x != nil {
+		return x.PartnerIds
+	}
+	return nil
+}
+
+func (x *EndDeviceHandover) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type StartEndDeviceHandoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// Identifiers of the serving Network Server. The NetID is required.
+	ServingNetworkIds *NetworkIdentifiers `protobuf:"bytes,2,opt,name=serving_network_ids,json=servingNetworkIds,proto3" json:"serving_network_ids,omitempty"`
+}
+
+func (x *StartEndDeviceHandoverRequest) Reset() {
+	*x = StartEndDeviceHandoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartEndDeviceHandoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEndDeviceHandoverRequest) ProtoMessage() {}
+
+func (x *StartEndDeviceHandoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEndDeviceHandoverRequest.ProtoReflect.Descriptor instead.
+func (*StartEndDeviceHandoverRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StartEndDeviceHandoverRequest) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *StartEndDeviceHandoverRequest) GetServingNetworkIds() *NetworkIdentifiers {
+	if x != nil {
+		return x.ServingNetworkIds
+	}
+	return nil
+}
+
+// Members of the multicast group of a multicast end device.
+type MulticastGroupMembers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifiers of the multicast end device.
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// Device IDs of the members, which are in the application of the multicast end device.
+	MemberDeviceIds []string `protobuf:"bytes,2,rep,name=member_device_ids,json=memberDeviceIds,proto3" json:"member_device_ids,omitempty"`
+}
+
+func (x *MulticastGroupMembers) Reset() {
+	*x = MulticastGroupMembers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MulticastGroupMembers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MulticastGroupMembers) ProtoMessage() {}
+
+func (x *MulticastGroupMembers) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MulticastGroupMembers.ProtoReflect.Descriptor instead.
+func (*MulticastGroupMembers) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MulticastGroupMembers) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *MulticastGroupMembers) GetMemberDeviceIds() []string {
+	if x != nil {
+		return x.MemberDeviceIds
+	}
+	return nil
+}
+
+// Gateways selected for the multicast downlinks of a multicast end device.
+type MulticastGroupGateways struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gateways []*ClassBCGatewayIdentifiers `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	// Number of members of the multicast group.
+	MemberCount uint32 `protobuf:"varint,2,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	// Number of members of the multicast group that are covered by the selected gateways.
+	CoveredMemberCount uint32 `protobuf:"varint,3,opt,name=covered_member_count,json=coveredMemberCount,proto3" json:"covered_member_count,omitempty"`
+}
+
+func (x *MulticastGroupGateways) Reset() {
+	*x = MulticastGroupGateways{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MulticastGroupGateways) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MulticastGroupGateways) ProtoMessage() {}
+
+func (x *MulticastGroupGateways) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MulticastGroupGateways.ProtoReflect.Descriptor instead.
+func (*MulticastGroupGateways) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MulticastGroupGateways) GetGateways() []*ClassBCGatewayIdentifiers {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+func (x *MulticastGroupGateways) GetMemberCount() uint32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *MulticastGroupGateways) GetCoveredMemberCount() uint32 {
+	if x != nil {
+		return x.CoveredMemberCount
+	}
+	return 0
+}
+
+// Usage of a daily downlink budget of the Network Server in the current budget period.
+type DownlinkBudget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope DownlinkBudgetScope `protobuf:"varint,1,opt,name=scope,proto3,enum=ttn.lorawan.v3.DownlinkBudgetScope" json:"scope,omitempty"`
+	// Unique identifier of the end device or application, or `network` for the network scope.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Downlink airtime used in the current budget period.
+	Airtime *durationpb.Duration `protobuf:"bytes,3,opt,name=airtime,proto3" json:"airtime,omitempty"`
+	// Maximum downlink airtime per budget period. Unset if the airtime is not limited.
+	AirtimeLimit *durationpb.Duration `protobuf:"bytes,4,opt,name=airtime_limit,json=airtimeLimit,proto3" json:"airtime_limit,omitempty"`
+	// Number of downlinks in the current budget period.
+	Downlinks uint64 `protobuf:"varint,5,opt,name=downlinks,proto3" json:"downlinks,omitempty"`
+	// Maximum number of downlinks per budget period. Zero if the number of downlinks is not limited.
+	DownlinksLimit uint64 `protobuf:"varint,6,opt,name=downlinks_limit,json=downlinksLimit,proto3" json:"downlinks_limit,omitempty"`
+	// Whether the budget is exhausted until the end of the current budget period.
+	Exhausted bool `protobuf:"varint,7,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	// Time at which the current budget period ends.
+	ResetAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
+}
+
+func (x *DownlinkBudget) Reset() {
+	*x = DownlinkBudget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownlinkBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownlinkBudget) ProtoMessage() {}
+
+func (x *DownlinkBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownlinkBudget.ProtoReflect.Descriptor instead.
+func (*DownlinkBudget) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DownlinkBudget) GetScope() DownlinkBudgetScope {
+	if x != nil {
+		return x.Scope
+	}
+	return DownlinkBudgetScope_DOWNLINK_BUDGET_SCOPE_END_DEVICE
+}
+
+func (x *DownlinkBudget) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DownlinkBudget) GetAirtime() *durationpb.Duration {
+	if x != nil {
+		return x.Airtime
+	}
+	return nil
+}
+
+func (x *DownlinkBudget) GetAirtimeLimit() *durationpb.Duration {
+	if x != nil {
+		return x.AirtimeLimit
+	}
+	return nil
+}
+
+func (x *DownlinkBudget) GetDownlinks() uint64 {
+	if x != nil {
+		return x.Downlinks
+	}
+	return 0
+}
+
+func (x *DownlinkBudget) GetDownlinksLimit() uint64 {
+	if x != nil {
+		return x.DownlinksLimit
+	}
+	return 0
+}
+
+func (x *DownlinkBudget) GetExhausted() bool {
+	if x != nil {
+		return x.Exhausted
+	}
+	return false
+}
+
+func (x *DownlinkBudget) GetResetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetAt
+	}
+	return nil
+}
+
+type DownlinkBudgets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Budgets []*DownlinkBudget `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
+}
+
+func (x *DownlinkBudgets) Reset() {
+	*x = DownlinkBudgets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownlinkBudgets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownlinkBudgets) ProtoMessage() {}
+
+func (x *DownlinkBudgets) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownlinkBudgets.ProtoReflect.Descriptor instead.
+func (*DownlinkBudgets) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DownlinkBudgets) GetBudgets() []*DownlinkBudget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type GetEndDeviceDownlinkBudgetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+}
+
+func (x *GetEndDeviceDownlinkBudgetsRequest) Reset() {
+	*x = GetEndDeviceDownlinkBudgetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEndDeviceDownlinkBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEndDeviceDownlinkBudgetsRequest) ProtoMessage() {}
+
+func (x *GetEndDeviceDownlinkBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEndDeviceDownlinkBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*GetEndDeviceDownlinkBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEndDeviceDownlinkBudgetsRequest) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+type GetApplicationDownlinkBudgetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+}
+
+func (x *GetApplicationDownlinkBudgetsRequest) Reset() {
+	*x = GetApplicationDownlinkBudgetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApplicationDownlinkBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApplicationDownlinkBudgetsRequest) ProtoMessage() {}
+
+func (x *GetApplicationDownlinkBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApplicationDownlinkBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*GetApplicationDownlinkBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetApplicationDownlinkBudgetsRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+// Rolling link statistics of an end device, computed from its most recent data uplinks.
+type EndDeviceLinkStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of link samples the statistics are computed from.
+	SampleCount   uint32                 `protobuf:"varint,1,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	FirstUplinkAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=first_uplink_at,json=firstUplinkAt,proto3" json:"first_uplink_at,omitempty"`
+	LastUplinkAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_uplink_at,json=lastUplinkAt,proto3" json:"last_uplink_at,omitempty"`
+	LastFCnt      uint32                 `protobuf:"varint,4,opt,name=last_f_cnt,json=lastFCnt,proto3" json:"last_f_cnt,omitempty"`
+	// Number of uplinks, excluding retransmissions.
+	UplinkCount uint32 `protobuf:"varint,5,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Number of uplinks that were lost, derived from gaps in the frame counters.
+	MissedUplinkCount   uint32 `protobuf:"varint,6,opt,name=missed_uplink_count,json=missedUplinkCount,proto3" json:"missed_uplink_count,omitempty"`
+	RetransmissionCount uint32 `protobuf:"varint,7,opt,name=retransmission_count,json=retransmissionCount,proto3" json:"retransmission_count,omitempty"`
+	// Fraction of uplinks that were lost.
+	PacketErrorRate float32 `protobuf:"fixed32,8,opt,name=packet_error_rate,json=packetErrorRate,proto3" json:"packet_error_rate,omitempty"`
+	// Percentiles of the best SNR of the LoRa uplinks.
+	Snr *EndDeviceLinkStats_Percentiles `protobuf:"bytes,9,opt,name=snr,proto3" json:"snr,omitempty"`
+	// Percentiles of the best channel RSSI of the uplinks.
+	Rssi *EndDeviceLinkStats_Percentiles `protobuf:"bytes,10,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	// Number of uplinks by data rate index.
+	DataRates        map[uint32]uint32                    `protobuf:"bytes,11,rep,name=data_rates,json=dataRates,proto3" json:"data_rates,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	GatewayDiversity *EndDeviceLinkStats_GatewayDiversity `protobuf:"bytes,12,opt,name=gateway_diversity,json=gatewayDiversity,proto3" json:"gateway_diversity,omitempty"`
+}
+
+func (x *EndDeviceLinkStats) Reset() {
+	*x = EndDeviceLinkStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceLinkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceLinkStats) ProtoMessage() {}
+
+func (x *EndDeviceLinkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceLinkStats.ProtoReflect.Descriptor instead.
+func (*EndDeviceLinkStats) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EndDeviceLinkStats) GetSampleCount() uint32 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats) GetFirstUplinkAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstUplinkAt
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStats) GetLastUplinkAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUplinkAt
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStats) GetLastFCnt() uint32 {
+	if x != nil {
+		return x.LastFCnt
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats) GetUplinkCount() uint32 {
+	if x != nil {
+		return x.UplinkCount
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats) GetMissedUplinkCount() uint32 {
+	if x != nil {
+		return x.MissedUplinkCount
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats) GetRetransmissionCount() uint32 {
+	if x != nil {
+		return x.RetransmissionCount
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats) GetPacketErrorRate() float32 {
+	if x != nil {
+		return x.PacketErrorRate
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats) GetSnr() *EndDeviceLinkStats_Percentiles {
+	if x != nil {
+		return x.Snr
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStats) GetRssi() *EndDeviceLinkStats_Percentiles {
+	if x != nil {
+		return x.Rssi
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStats) GetDataRates() map[uint32]uint32 {
+	if x != nil {
+		return x.DataRates
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStats) GetGatewayDiversity() *EndDeviceLinkStats_GatewayDiversity {
+	if x != nil {
+		return x.GatewayDiversity
+	}
+	return nil
+}
+
+type ExportEndDevicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// Label of the KEK with which the network session keys of the exported end devices are wrapped.
+	KekLabel string `protobuf:"bytes,2,opt,name=kek_label,json=kekLabel,proto3" json:"kek_label,omitempty"`
+}
+
+func (x *ExportEndDevicesRequest) Reset() {
+	*x = ExportEndDevicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportEndDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEndDevicesRequest) ProtoMessage() {}
+
+func (x *ExportEndDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEndDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ExportEndDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportEndDevicesRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *ExportEndDevicesRequest) GetKekLabel() string {
+	if x != nil {
+		return x.KekLabel
+	}
+	return ""
+}
+
+type ExportEndDevicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The end devices with their session, MAC state and queued downlinks.
+	EndDevices []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
+}
+
+func (x *ExportEndDevicesResponse) Reset() {
+	*x = ExportEndDevicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportEndDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEndDevicesResponse) ProtoMessage() {}
+
+func (x *ExportEndDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEndDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ExportEndDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportEndDevicesResponse) GetEndDevices() []*EndDevice {
+	if x != nil {
+		return x.EndDevices
+	}
+	return nil
+}
+
+type ImportEndDevicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// The end devices to import, as exported by ExportEndDevices.
+	EndDevices []*EndDevice `protobuf:"bytes,2,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
+	// Whether to replace existing end devices. If false, importing an existing end device fails.
+	Replace bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+}
+
+func (x *ImportEndDevicesRequest) Reset() {
+	*x = ImportEndDevicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportEndDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEndDevicesRequest) ProtoMessage() {}
+
+func (x *ImportEndDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEndDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ImportEndDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportEndDevicesRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *ImportEndDevicesRequest) GetEndDevices() []*EndDevice {
+	if x != nil {
+		return x.EndDevices
+	}
+	return nil
+}
+
+func (x *ImportEndDevicesRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type ImportEndDevicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of imported end devices.
+	Imported uint32 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+}
+
+func (x *ImportEndDevicesResponse) Reset() {
+	*x = ImportEndDevicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportEndDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEndDevicesResponse) ProtoMessage() {}
+
+func (x *ImportEndDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEndDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ImportEndDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportEndDevicesResponse) GetImported() uint32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+type SimulateMACRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// End device template to simulate. If not set, the stored end device is used.
+	EndDevice *EndDevice                   `protobuf:"bytes,2,opt,name=end_device,json=endDevice,proto3" json:"end_device,omitempty"`
+	Uplinks   []*SimulateMACRequest_Uplink `protobuf:"bytes,3,rep,name=uplinks,proto3" json:"uplinks,omitempty"`
+}
+
+func (x *SimulateMACRequest) Reset() {
+	*x = SimulateMACRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateMACRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateMACRequest) ProtoMessage() {}
+
+func (x *SimulateMACRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateMACRequest.ProtoReflect.Descriptor instead.
+func (*SimulateMACRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SimulateMACRequest) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *SimulateMACRequest) GetEndDevice() *EndDevice {
+	if x != nil {
+		return x.EndDevice
+	}
+	return nil
+}
+
+func (x *SimulateMACRequest) GetUplinks() []*SimulateMACRequest_Uplink {
+	if x != nil {
+		return x.Uplinks
+	}
+	return nil
+}
+
+// Outcome of a MAC simulation.
+type MACSimulation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Steps []*MACSimulation_Step `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
+}
+
+func (x *MACSimulation) Reset() {
+	*x = MACSimulation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MACSimulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MACSimulation) ProtoMessage() {}
+
+func (x *MACSimulation) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MACSimulation.ProtoReflect.Descriptor instead.
+func (*MACSimulation) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MACSimulation) GetSteps() []*MACSimulation_Step {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// Assignment of an end device to a relay, or release of an end device from a relay.
+type RelayAssignmentSuggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action RelayAssignmentAction `protobuf:"varint,1,opt,name=action,proto3,enum=ttn.lorawan.v3.RelayAssignmentAction" json:"action,omitempty"`
+	// Device ID of the relay.
+	ServingDeviceId string `protobuf:"bytes,2,opt,name=serving_device_id,json=servingDeviceId,proto3" json:"serving_device_id,omitempty"`
+	// Reason of the suggestion: relayed, poor_link or direct_coverage.
+	Reason      string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	SuggestedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=suggested_at,json=suggestedAt,proto3" json:"suggested_at,omitempty"`
+}
+
+func (x *RelayAssignmentSuggestion) Reset() {
+	*x = RelayAssignmentSuggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayAssignmentSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayAssignmentSuggestion) ProtoMessage() {}
+
+func (x *RelayAssignmentSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayAssignmentSuggestion.ProtoReflect.Descriptor instead.
+func (*RelayAssignmentSuggestion) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RelayAssignmentSuggestion) GetAction() RelayAssignmentAction {
+	if x != nil {
+		return x.Action
+	}
+	return RelayAssignmentAction_RELAY_ASSIGNMENT_ACTION_ASSIGN
+}
+
+func (x *RelayAssignmentSuggestion) GetServingDeviceId() string {
+	if x != nil {
+		return x.ServingDeviceId
+	}
+	return ""
+}
+
+func (x *RelayAssignmentSuggestion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RelayAssignmentSuggestion) GetSuggestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuggestedAt
+	}
+	return nil
+}
+
+// Relay assignment state of an end device.
+type EndDeviceRelayAssignment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// Number of consecutive uplinks which were only received through relays.
+	RelayedUplinks uint32 `protobuf:"varint,2,opt,name=relayed_uplinks,json=relayedUplinks,proto3" json:"relayed_uplinks,omitempty"`
+	// Number of consecutive direct uplinks with a poor link.
+	PoorUplinks uint32 `protobuf:"varint,3,opt,name=poor_uplinks,json=poorUplinks,proto3" json:"poor_uplinks,omitempty"`
+	// Number of consecutive direct uplinks with direct coverage.
+	CoveredUplinks uint32 `protobuf:"varint,4,opt,name=covered_uplinks,json=coveredUplinks,proto3" json:"covered_uplinks,omitempty"`
+	// Device ID of the relay to which the end device has been assigned automatically.
+	// Only automatic assignments are released automatically.
+	ServingDeviceId string `protobuf:"bytes,5,opt,name=serving_device_id,json=servingDeviceId,proto3" json:"serving_device_id,omitempty"`
+	// Assignment or release which awaits approval.
+	Suggestion *RelayAssignmentSuggestion `protobuf:"bytes,6,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+}
+
+func (x *EndDeviceRelayAssignment) Reset() {
+	*x = EndDeviceRelayAssignment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceRelayAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceRelayAssignment) ProtoMessage() {}
+
+func (x *EndDeviceRelayAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceRelayAssignment.ProtoReflect.Descriptor instead.
+func (*EndDeviceRelayAssignment) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EndDeviceRelayAssignment) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *EndDeviceRelayAssignment) GetRelayedUplinks() uint32 {
+	if x != nil {
+		return x.RelayedUplinks
+	}
+	return 0
+}
+
+func (x *EndDeviceRelayAssignment) GetPoorUplinks() uint32 {
+	if x != nil {
+		return x.PoorUplinks
+	}
+	return 0
+}
+
+func (x *EndDeviceRelayAssignment) GetCoveredUplinks() uint32 {
+	if x != nil {
+		return x.CoveredUplinks
+	}
+	return 0
+}
+
+func (x *EndDeviceRelayAssignment) GetServingDeviceId() string {
+	if x != nil {
+		return x.ServingDeviceId
+	}
+	return ""
+}
+
+func (x *EndDeviceRelayAssignment) GetSuggestion() *RelayAssignmentSuggestion {
+	if x != nil {
+		return x.Suggestion
+	}
+	return nil
+}
+
+// Uplink with a valid MIC of which the frame counter is rejected.
+type FCntRejection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FCnt uint32 `protobuf:"varint,1,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// Last frame counter of the session when the uplink was rejected.
+	LastFCntUp uint32                 `protobuf:"varint,2,opt,name=last_f_cnt_up,json=lastFCntUp,proto3" json:"last_f_cnt_up,omitempty"`
+	Reason     FCntRejection_Reason   `protobuf:"varint,3,opt,name=reason,proto3,enum=ttn.lorawan.v3.FCntRejection_Reason" json:"reason,omitempty"`
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+}
+
+func (x *FCntRejection) Reset() {
+	*x = FCntRejection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCntRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCntRejection) ProtoMessage() {}
+
+func (x *FCntRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCntRejection.ProtoReflect.Descriptor instead.
+func (*FCntRejection) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FCntRejection) GetFCnt() uint32 {
+	if x != nil {
+		return x.FCnt
+	}
+	return 0
+}
+
+func (x *FCntRejection) GetLastFCntUp() uint32 {
+	if x != nil {
+		return x.LastFCntUp
+	}
+	return 0
+}
+
+func (x *FCntRejection) GetReason() FCntRejection_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return FCntRejection_REASON_RESET
+}
+
+func (x *FCntRejection) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+// Frame counter resynchronization of an end device.
+type FCntResyncRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Last frame counter of the session before the resynchronization.
+	LastFCntUp uint32 `protobuf:"varint,1,opt,name=last_f_cnt_up,json=lastFCntUp,proto3" json:"last_f_cnt_up,omitempty"`
+	// Last frame counter of the session after the resynchronization.
+	FCnt   uint32               `protobuf:"varint,2,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	Reason FCntRejection_Reason `protobuf:"varint,3,opt,name=reason,proto3,enum=ttn.lorawan.v3.FCntRejection_Reason" json:"reason,omitempty"`
+	// Number of rejected uplinks which desynchronized the frame counter.
+	RejectedUplinks uint32 `protobuf:"varint,4,opt,name=rejected_uplinks,json=rejectedUplinks,proto3" json:"rejected_uplinks,omitempty"`
+	// Whether the frame counter is resynchronized automatically.
+	Automatic  bool                   `protobuf:"varint,5,opt,name=automatic,proto3" json:"automatic,omitempty"`
+	ResyncedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=resynced_at,json=resyncedAt,proto3" json:"resynced_at,omitempty"`
+}
+
+func (x *FCntResyncRecord) Reset() {
+	*x = FCntResyncRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCntResyncRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCntResyncRecord) ProtoMessage() {}
+
+func (x *FCntResyncRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCntResyncRecord.ProtoReflect.Descriptor instead.
+func (*FCntResyncRecord) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *FCntResyncRecord) GetLastFCntUp() uint32 {
+	if x != nil {
+		return x.LastFCntUp
+	}
+	return 0
+}
+
+func (x *FCntResyncRecord) GetFCnt() uint32 {
+	if x != nil {
+		return x.FCnt
+	}
+	return 0
+}
+
+func (x *FCntResyncRecord) GetReason() FCntRejection_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return FCntRejection_REASON_RESET
+}
+
+func (x *FCntResyncRecord) GetRejectedUplinks() uint32 {
+	if x != nil {
+		return x.RejectedUplinks
+	}
+	return 0
+}
+
+func (x *FCntResyncRecord) GetAutomatic() bool {
+	if x != nil {
+		return x.Automatic
+	}
+	return false
+}
+
+func (x *FCntResyncRecord) GetResyncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResyncedAt
+	}
+	return nil
+}
+
+// Frame counter resynchronization state of an end device.
+type EndDeviceFCntResync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// Whether the frame counter is desynchronized.
+	Desynchronized bool `protobuf:"varint,2,opt,name=desynchronized,proto3" json:"desynchronized,omitempty"`
+	// Whether the frame counter is resynchronized automatically when it is desynchronized.
+	// This is configured with mac_settings.auto_f_cnt_resync of the end device.
+	AutoResync bool `protobuf:"varint,3,opt,name=auto_resync,json=autoResync,proto3" json:"auto_resync,omitempty"`
+	// Consecutive rejected uplinks of the current session, with increasing frame counters.
+	Rejections []*FCntRejection `protobuf:"bytes,4,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	// Most recent frame counter resynchronizations.
+	Audit []*FCntResyncRecord `protobuf:"bytes,5,rep,name=audit,proto3" json:"audit,omitempty"`
+}
+
+func (x *EndDeviceFCntResync) Reset() {
+	*x = EndDeviceFCntResync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceFCntResync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceFCntResync) ProtoMessage() {}
+
+func (x *EndDeviceFCntResync) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceFCntResync.ProtoReflect.Descriptor instead.
+func (*EndDeviceFCntResync) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EndDeviceFCntResync) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *EndDeviceFCntResync) GetDesynchronized() bool {
+	if x != nil {
+		return x.Desynchronized
+	}
+	return false
+}
+
+func (x *EndDeviceFCntResync) GetAutoResync() bool {
+	if x != nil {
+		return x.AutoResync
+	}
+	return false
+}
+
+func (x *EndDeviceFCntResync) GetRejections() []*FCntRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+func (x *EndDeviceFCntResync) GetAudit() []*FCntResyncRecord {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+// Class B capacity of a gateway.
+type GatewayClassBCapacity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// Number of class B end devices of which the gateway is the primary gateway.
+	DeviceCount uint32 `protobuf:"varint,2,opt,name=device_count,json=deviceCount,proto3" json:"device_count,omitempty"`
+	// Number of ping slots which the class B end devices open per beacon period.
+	PingSlotCount uint32 `protobuf:"varint,3,opt,name=ping_slot_count,json=pingSlotCount,proto3" json:"ping_slot_count,omitempty"`
+	// Number of class B end devices by ping slot frequency (Hz).
+	DevicesByFrequency map[uint64]uint32 `protobuf:"bytes,4,rep,name=devices_by_frequency,json=devicesByFrequency,proto3" json:"devices_by_frequency,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Occupancy of the previous and the current beacon period.
+	BeaconPeriods []*GatewayClassBCapacity_BeaconPeriod `protobuf:"bytes,5,rep,name=beacon_periods,json=beaconPeriods,proto3" json:"beacon_periods,omitempty"`
+}
+
+func (x *GatewayClassBCapacity) Reset() {
+	*x = GatewayClassBCapacity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayClassBCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayClassBCapacity) ProtoMessage() {}
+
+func (x *GatewayClassBCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayClassBCapacity.ProtoReflect.Descriptor instead.
+func (*GatewayClassBCapacity) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GatewayClassBCapacity) GetGatewayIds() *GatewayIdentifiers {
+	if x != nil {
+		return x.GatewayIds
+	}
+	return nil
+}
+
+func (x *GatewayClassBCapacity) GetDeviceCount() uint32 {
+	if x != nil {
+		return x.DeviceCount
+	}
+	return 0
+}
+
+func (x *GatewayClassBCapacity) GetPingSlotCount() uint32 {
+	if x != nil {
+		return x.PingSlotCount
+	}
+	return 0
+}
+
+func (x *GatewayClassBCapacity) GetDevicesByFrequency() map[uint64]uint32 {
+	if x != nil {
+		return x.DevicesByFrequency
+	}
+	return nil
+}
+
+func (x *GatewayClassBCapacity) GetBeaconPeriods() []*GatewayClassBCapacity_BeaconPeriod {
+	if x != nil {
+		return x.BeaconPeriods
+	}
+	return nil
+}
+
+// Rejoin state of a LoRaWAN 1.1 end device.
+type EndDeviceRejoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers        `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	ForceRejoin  *EndDeviceRejoin_ForceRejoin `protobuf:"bytes,2,opt,name=force_rejoin,json=forceRejoin,proto3" json:"force_rejoin,omitempty"`
+	Policy       *EndDeviceRejoin_Policy      `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	// Rejoin of which the session is not activated yet.
+	PendingRejoin *EndDeviceRejoin_Rejoin `protobuf:"bytes,4,opt,name=pending_rejoin,json=pendingRejoin,proto3" json:"pending_rejoin,omitempty"`
+	LastRejoin    *EndDeviceRejoin_Rejoin `protobuf:"bytes,5,opt,name=last_rejoin,json=lastRejoin,proto3" json:"last_rejoin,omitempty"`
+}
+
+func (x *EndDeviceRejoin) Reset() {
+	*x = EndDeviceRejoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceRejoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceRejoin) ProtoMessage() {}
+
+func (x *EndDeviceRejoin) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceRejoin.ProtoReflect.Descriptor instead.
+func (*EndDeviceRejoin) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EndDeviceRejoin) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *EndDeviceRejoin) GetForceRejoin() *EndDeviceRejoin_ForceRejoin {
+	if x != nil {
+		return x.ForceRejoin
+	}
+	return nil
+}
+
+func (x *EndDeviceRejoin) GetPolicy() *EndDeviceRejoin_Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *EndDeviceRejoin) GetPendingRejoin() *EndDeviceRejoin_Rejoin {
+	if x != nil {
+		return x.PendingRejoin
+	}
+	return nil
+}
+
+func (x *EndDeviceRejoin) GetLastRejoin() *EndDeviceRejoin_Rejoin {
+	if x != nil {
+		return x.LastRejoin
+	}
+	return nil
+}
+
+type ForceEndDeviceRejoinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// Only type 0 and type 2 rejoin-requests can be forced.
+	ForceRejoinReq *MACCommand_ForceRejoinReq `protobuf:"bytes,2,opt,name=force_rejoin_req,json=forceRejoinReq,proto3" json:"force_rejoin_req,omitempty"`
+}
+
+func (x *ForceEndDeviceRejoinRequest) Reset() {
+	*x = ForceEndDeviceRejoinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceEndDeviceRejoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceEndDeviceRejoinRequest) ProtoMessage() {}
+
+func (x *ForceEndDeviceRejoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceEndDeviceRejoinRequest.ProtoReflect.Descriptor instead.
+func (*ForceEndDeviceRejoinRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ForceEndDeviceRejoinRequest) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *ForceEndDeviceRejoinRequest) GetForceRejoinReq() *MACCommand_ForceRejoinReq {
+	if x != nil {
+		return x.ForceRejoinReq
+	}
+	return nil
+}
+
+type SetEndDeviceRejoinPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
+	// Only type 0 and type 2 rejoin-requests can be forced.
+	ForceRejoinReq *MACCommand_ForceRejoinReq `protobuf:"bytes,2,opt,name=force_rejoin_req,json=forceRejoinReq,proto3" json:"force_rejoin_req,omitempty"`
+	Interval       *durationpb.Duration       `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *SetEndDeviceRejoinPolicyRequest) Reset() {
+	*x = SetEndDeviceRejoinPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEndDeviceRejoinPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEndDeviceRejoinPolicyRequest) ProtoMessage() {}
+
+func (x *SetEndDeviceRejoinPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEndDeviceRejoinPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetEndDeviceRejoinPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetEndDeviceRejoinPolicyRequest) GetEndDeviceIds() *EndDeviceIdentifiers {
+	if x != nil {
+		return x.EndDeviceIds
+	}
+	return nil
+}
+
+func (x *SetEndDeviceRejoinPolicyRequest) GetForceRejoinReq() *MACCommand_ForceRejoinReq {
+	if x != nil {
+		return x.ForceRejoinReq
+	}
+	return nil
+}
+
+func (x *SetEndDeviceRejoinPolicyRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type ForceEndDevicesRejoinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	DeviceIds      []string                `protobuf:"bytes,2,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	// Only type 0 and type 2 rejoin-requests can be forced.
+	ForceRejoinReq *MACCommand_ForceRejoinReq `protobuf:"bytes,3,opt,name=force_rejoin_req,json=forceRejoinReq,proto3" json:"force_rejoin_req,omitempty"`
+}
+
+func (x *ForceEndDevicesRejoinRequest) Reset() {
+	*x = ForceEndDevicesRejoinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceEndDevicesRejoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceEndDevicesRejoinRequest) ProtoMessage() {}
+
+func (x *ForceEndDevicesRejoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceEndDevicesRejoinRequest.ProtoReflect.Descriptor instead.
+func (*ForceEndDevicesRejoinRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ForceEndDevicesRejoinRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if x != nil {
+		return x.ApplicationIds
+	}
+	return nil
+}
+
+func (x *ForceEndDevicesRejoinRequest) GetDeviceIds() []string {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+func (x *ForceEndDevicesRejoinRequest) GetForceRejoinReq() *MACCommand_ForceRejoinReq {
+	if x != nil {
+		return x.ForceRejoinReq
+	}
+	return nil
+}
+
+type ForceEndDevicesRejoinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ForceEndDevicesRejoinResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ForceEndDevicesRejoinResponse) Reset() {
+	*x = ForceEndDevicesRejoinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceEndDevicesRejoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceEndDevicesRejoinResponse) ProtoMessage() {}
+
+func (x *ForceEndDevicesRejoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceEndDevicesRejoinResponse.ProtoReflect.Descriptor instead.
+func (*ForceEndDevicesRejoinResponse) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ForceEndDevicesRejoinResponse) GetResults() []*ForceEndDevicesRejoinResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Percentiles of a signal quality measurement.
+type EndDeviceLinkStats_Percentiles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min    float32 `protobuf:"fixed32,1,opt,name=min,proto3" json:"min,omitempty"`
+	P10    float32 `protobuf:"fixed32,2,opt,name=p10,proto3" json:"p10,omitempty"`
+	Median float32 `protobuf:"fixed32,3,opt,name=median,proto3" json:"median,omitempty"`
+	P90    float32 `protobuf:"fixed32,4,opt,name=p90,proto3" json:"p90,omitempty"`
+	Max    float32 `protobuf:"fixed32,5,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *EndDeviceLinkStats_Percentiles) Reset() {
+	*x = EndDeviceLinkStats_Percentiles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceLinkStats_Percentiles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceLinkStats_Percentiles) ProtoMessage() {}
+
+func (x *EndDeviceLinkStats_Percentiles) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceLinkStats_Percentiles.ProtoReflect.Descriptor instead.
+func (*EndDeviceLinkStats_Percentiles) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *EndDeviceLinkStats_Percentiles) GetMin() float32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats_Percentiles) GetP10() float32 {
+	if x != nil {
+		return x.P10
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats_Percentiles) GetMedian() float32 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats_Percentiles) GetP90() float32 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats_Percentiles) GetMax() float32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// Number of gateways that received the uplinks.
+type EndDeviceLinkStats_GatewayDiversity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of uplinks received by each gateway, by gateway ID.
+	Gateways map[string]uint32 `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Minimum number of gateways that received an uplink.
+	Min uint32 `protobuf:"varint,2,opt,name=min,proto3" json:"min,omitempty"`
+	// Mean number of gateways that received an uplink.
+	Mean float32 `protobuf:"fixed32,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	// Maximum number of gateways that received an uplink.
+	Max uint32 `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *EndDeviceLinkStats_GatewayDiversity) Reset() {
+	*x = EndDeviceLinkStats_GatewayDiversity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceLinkStats_GatewayDiversity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceLinkStats_GatewayDiversity) ProtoMessage() {}
+
+func (x *EndDeviceLinkStats_GatewayDiversity) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceLinkStats_GatewayDiversity.ProtoReflect.Descriptor instead.
+func (*EndDeviceLinkStats_GatewayDiversity) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{14, 1}
+}
+
+func (x *EndDeviceLinkStats_GatewayDiversity) GetGateways() map[string]uint32 {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+func (x *EndDeviceLinkStats_GatewayDiversity) GetMin() uint32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats_GatewayDiversity) GetMean() float32 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *EndDeviceLinkStats_GatewayDiversity) GetMax() uint32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// Synthetic data uplink of a MAC simulation.
+type SimulateMACRequest_Uplink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time at which the uplink is received.
+	// If not set, the uplink is received one minute after the previous uplink, or now for the first uplink.
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	// Data rate index of the uplink. If not set, the current ADR data rate index is used.
+	DataRateIndex *DataRateIndexValue `protobuf:"bytes,2,opt,name=data_rate_index,json=dataRateIndex,proto3" json:"data_rate_index,omitempty"`
+	// Device channel index of the uplink. If not set, the enabled uplink channels are used in a round-robin fashion.
+	ChannelIndex *wrapperspb.UInt32Value `protobuf:"bytes,3,opt,name=channel_index,json=channelIndex,proto3" json:"channel_index,omitempty"`
+	Snr          float32                 `protobuf:"fixed32,4,opt,name=snr,proto3" json:"snr,omitempty"`
+	Rssi         float32                 `protobuf:"fixed32,5,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	// Number of gateways which receive the uplink. If zero, one gateway receives the uplink.
+	GatewayCount uint32 `protobuf:"varint,6,opt,name=gateway_count,json=gatewayCount,proto3" json:"gateway_count,omitempty"`
+	// ADR bit of the uplink. If not set, the ADR bit is set.
+	Adr       *wrapperspb.BoolValue `protobuf:"bytes,7,opt,name=adr,proto3" json:"adr,omitempty"`
+	AdrAckReq bool                  `protobuf:"varint,8,opt,name=adr_ack_req,json=adrAckReq,proto3" json:"adr_ack_req,omitempty"`
+	Confirmed bool                  `protobuf:"varint,9,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// MAC commands sent by the end device in the uplink.
+	MacCommands []*MACCommand `protobuf:"bytes,10,rep,name=mac_commands,json=macCommands,proto3" json:"mac_commands,omitempty"`
+}
+
+func (x *SimulateMACRequest_Uplink) Reset() {
+	*x = SimulateMACRequest_Uplink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateMACRequest_Uplink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateMACRequest_Uplink) ProtoMessage() {}
+
+func (x *SimulateMACRequest_Uplink) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateMACRequest_Uplink.ProtoReflect.Descriptor instead.
+func (*SimulateMACRequest_Uplink) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *SimulateMACRequest_Uplink) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *SimulateMACRequest_Uplink) GetDataRateIndex() *DataRateIndexValue {
+	if x != nil {
+		return x.DataRateIndex
+	}
+	return nil
+}
+
+func (x *SimulateMACRequest_Uplink) GetChannelIndex() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.ChannelIndex
+	}
+	return nil
+}
+
+func (x *SimulateMACRequest_Uplink) GetSnr() float32 {
+	if x != nil {
+		return x.Snr
+	}
+	return 0
+}
+
+func (x *SimulateMACRequest_Uplink) GetRssi() float32 {
+	if x != nil {
+		return x.Rssi
+	}
+	return 0
+}
+
+func (x *SimulateMACRequest_Uplink) GetGatewayCount() uint32 {
+	if x != nil {
+		return x.GatewayCount
+	}
+	return 0
+}
+
+func (x *SimulateMACRequest_Uplink) GetAdr() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Adr
+	}
+	return nil
+}
+
+func (x *SimulateMACRequest_Uplink) GetAdrAckReq() bool {
+	if x != nil {
+		return x.AdrAckReq
+	}
+	return false
+}
+
+func (x *SimulateMACRequest_Uplink) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *SimulateMACRequest_Uplink) GetMacCommands() []*MACCommand {
+	if x != nil {
+		return x.MacCommands
+	}
+	return nil
+}
+
+// Outcome of a simulated uplink.
+type MACSimulation_Step struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FCnt          uint32        `protobuf:"varint,1,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	DataRateIndex DataRateIndex `protobuf:"varint,2,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	ChannelIndex  uint32        `protobuf:"varint,3,opt,name=channel_index,json=channelIndex,proto3" json:"channel_index,omitempty"`
+	// Names of the events the Network Server would publish.
+	Events []string `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	// Decision of the ADR algorithm, if it ran.
+	AdrDecision *structpb.Struct `protobuf:"bytes,5,opt,name=adr_decision,json=adrDecision,proto3" json:"adr_decision,omitempty"`
+	// MAC commands the Network Server would send in the next downlink.
+	DownlinkMacCommands []*MACCommand `protobuf:"bytes,6,rep,name=downlink_mac_commands,json=downlinkMacCommands,proto3" json:"downlink_mac_commands,omitempty"`
+	// Whether MAC commands did not fit in the next downlink.
+	FPending bool `protobuf:"varint,7,opt,name=f_pending,json=fPending,proto3" json:"f_pending,omitempty"`
+	// MAC state after the uplink and the downlink.
+	MacState *MACState `protobuf:"bytes,8,opt,name=mac_state,json=macState,proto3" json:"mac_state,omitempty"`
+}
+
+func (x *MACSimulation_Step) Reset() {
+	*x = MACSimulation_Step{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MACSimulation_Step) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MACSimulation_Step) ProtoMessage() {}
+
+func (x *MACSimulation_Step) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MACSimulation_Step.ProtoReflect.Descriptor instead.
+func (*MACSimulation_Step) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *MACSimulation_Step) GetFCnt() uint32 {
+	if x != nil {
+		return x.FCnt
+	}
+	return 0
+}
+
+func (x *MACSimulation_Step) GetDataRateIndex() DataRateIndex {
+	if x != nil {
+		return x.DataRateIndex
+	}
+	return DataRateIndex_DATA_RATE_0
+}
+
+func (x *MACSimulation_Step) GetChannelIndex() uint32 {
+	if x != nil {
+		return x.ChannelIndex
+	}
+	return 0
+}
+
+func (x *MACSimulation_Step) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *MACSimulation_Step) GetAdrDecision() *structpb.Struct {
+	if x != nil {
+		return x.AdrDecision
+	}
+	return nil
+}
+
+func (x *MACSimulation_Step) GetDownlinkMacCommands() []*MACCommand {
+	if x != nil {
+		return x.DownlinkMacCommands
+	}
+	return nil
+}
+
+func (x *MACSimulation_Step) GetFPending() bool {
+	if x != nil {
+		return x.FPending
+	}
+	return false
+}
+
+func (x *MACSimulation_Step) GetMacState() *MACState {
+	if x != nil {
+		return x.MacState
+	}
+	return nil
+}
+
+// Occupancy of the ping slots of the gateway in a beacon period.
+type GatewayClassBCapacity_BeaconPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	// Number of class B downlinks which are scheduled in the ping slots of the beacon period.
+	DownlinkCount uint32 `protobuf:"varint,2,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	// Total airtime of the class B downlinks.
+	Airtime *durationpb.Duration `protobuf:"bytes,3,opt,name=airtime,proto3" json:"airtime,omitempty"`
+	// Fraction of the ping slot window which is occupied by class B downlinks.
+	Occupancy float32 `protobuf:"fixed32,4,opt,name=occupancy,proto3" json:"occupancy,omitempty"`
+}
+
+func (x *GatewayClassBCapacity_BeaconPeriod) Reset() {
+	*x = GatewayClassBCapacity_BeaconPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayClassBCapacity_BeaconPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayClassBCapacity_BeaconPeriod) ProtoMessage() {}
+
+func (x *GatewayClassBCapacity_BeaconPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayClassBCapacity_BeaconPeriod.ProtoReflect.Descriptor instead.
+func (*GatewayClassBCapacity_BeaconPeriod) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *GatewayClassBCapacity_BeaconPeriod) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *GatewayClassBCapacity_BeaconPeriod) GetDownlinkCount() uint32 {
+	if x != nil {
+		return x.DownlinkCount
+	}
+	return 0
+}
+
+func (x *GatewayClassBCapacity_BeaconPeriod) GetAirtime() *durationpb.Duration {
+	if x != nil {
+		return x.Airtime
+	}
+	return nil
+}
+
+func (x *GatewayClassBCapacity_BeaconPeriod) GetOccupancy() float32 {
+	if x != nil {
+		return x.Occupancy
+	}
+	return 0
+}
+
+// ForceRejoinReq issued to the end device, which is sent in the downlinks to the end device until the end device
+// rejoins or the request expires.
+type EndDeviceRejoin_ForceRejoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForceRejoinReq *MACCommand_ForceRejoinReq `protobuf:"bytes,1,opt,name=force_rejoin_req,json=forceRejoinReq,proto3" json:"force_rejoin_req,omitempty"`
+	// Whether the request is issued by the periodic rejoin policy of the end device.
+	Periodic bool                   `protobuf:"varint,2,opt,name=periodic,proto3" json:"periodic,omitempty"`
+	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// Number of downlinks in which the request is sent.
+	Transmissions uint32                 `protobuf:"varint,4,opt,name=transmissions,proto3" json:"transmissions,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+}
+
+func (x *EndDeviceRejoin_ForceRejoin) Reset() {
+	*x = EndDeviceRejoin_ForceRejoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceRejoin_ForceRejoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceRejoin_ForceRejoin) ProtoMessage() {}
+
+func (x *EndDeviceRejoin_ForceRejoin) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceRejoin_ForceRejoin.ProtoReflect.Descriptor instead.
+func (*EndDeviceRejoin_ForceRejoin) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *EndDeviceRejoin_ForceRejoin) GetForceRejoinReq() *MACCommand_ForceRejoinReq {
+	if x != nil {
+		return x.ForceRejoinReq
+	}
+	return nil
+}
+
+func (x *EndDeviceRejoin_ForceRejoin) GetPeriodic() bool {
+	if x != nil {
+		return x.Periodic
+	}
+	return false
+}
+
+func (x *EndDeviceRejoin_ForceRejoin) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *EndDeviceRejoin_ForceRejoin) GetTransmissions() uint32 {
+	if x != nil {
+		return x.Transmissions
+	}
+	return 0
+}
+
+func (x *EndDeviceRejoin_ForceRejoin) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+// Periodic rejoin policy of the end device. A ForceRejoinReq is issued to the end device when the interval elapsed
+// since the last rejoin of the end device, or since the policy was set.
+type EndDeviceRejoin_Policy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForceRejoinReq *MACCommand_ForceRejoinReq `protobuf:"bytes,1,opt,name=force_rejoin_req,json=forceRejoinReq,proto3" json:"force_rejoin_req,omitempty"`
+	Interval       *durationpb.Duration       `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	SetAt          *timestamppb.Timestamp     `protobuf:"bytes,3,opt,name=set_at,json=setAt,proto3" json:"set_at,omitempty"`
+}
+
+func (x *EndDeviceRejoin_Policy) Reset() {
+	*x = EndDeviceRejoin_Policy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceRejoin_Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceRejoin_Policy) ProtoMessage() {}
+
+func (x *EndDeviceRejoin_Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceRejoin_Policy.ProtoReflect.Descriptor instead.
+func (*EndDeviceRejoin_Policy) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{27, 1}
+}
+
+func (x *EndDeviceRejoin_Policy) GetForceRejoinReq() *MACCommand_ForceRejoinReq {
+	if x != nil {
+		return x.ForceRejoinReq
+	}
+	return nil
+}
+
+func (x *EndDeviceRejoin_Policy) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *EndDeviceRejoin_Policy) GetSetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SetAt
+	}
+	return nil
+}
+
+// Rejoin of the end device.
+type EndDeviceRejoin_Rejoin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RejoinType RejoinRequestType `protobuf:"varint,1,opt,name=rejoin_type,json=rejoinType,proto3,enum=ttn.lorawan.v3.RejoinRequestType" json:"rejoin_type,omitempty"`
+	// Whether the end device rejoined while a ForceRejoinReq was pending.
+	Forced bool `protobuf:"varint,2,opt,name=forced,proto3" json:"forced,omitempty"`
+	// Whether the ForceRejoinReq was issued by the periodic rejoin policy.
+	Periodic   bool                   `protobuf:"varint,3,opt,name=periodic,proto3" json:"periodic,omitempty"`
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	// Time at which the end device activated the session of the rejoin.
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *EndDeviceRejoin_Rejoin) Reset() {
+	*x = EndDeviceRejoin_Rejoin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndDeviceRejoin_Rejoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndDeviceRejoin_Rejoin) ProtoMessage() {}
+
+func (x *EndDeviceRejoin_Rejoin) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndDeviceRejoin_Rejoin.ProtoReflect.Descriptor instead.
+func (*EndDeviceRejoin_Rejoin) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{27, 2}
+}
+
+func (x *EndDeviceRejoin_Rejoin) GetRejoinType() RejoinRequestType {
+	if x != nil {
+		return x.RejoinType
+	}
+	return RejoinRequestType_CONTEXT
+}
+
+func (x *EndDeviceRejoin_Rejoin) GetForced() bool {
+	if x != nil {
+		return x.Forced
+	}
+	return false
+}
+
+func (x *EndDeviceRejoin_Rejoin) GetPeriodic() bool {
+	if x != nil {
+		return x.Periodic
+	}
+	return false
+}
+
+func (x *EndDeviceRejoin_Rejoin) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *EndDeviceRejoin_Rejoin) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// Result of issuing the ForceRejoinReq to an end device.
+type ForceEndDevicesRejoinResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeviceId string `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// Error of issuing the ForceRejoinReq, if any.
+	Error *ErrorDetails `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ForceEndDevicesRejoinResponse_Result) Reset() {
+	*x = ForceEndDevicesRejoinResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceEndDevicesRejoinResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceEndDevicesRejoinResponse_Result) ProtoMessage() {}
+
+func (x *ForceEndDevicesRejoinResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_networkserver_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceEndDevicesRejoinResponse_Result.ProtoReflect.Descriptor instead.
+func (*ForceEndDevicesRejoinResponse_Result) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *ForceEndDevicesRejoinResponse_Result) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *ForceEndDevicesRejoinResponse_Result) GetError() *ErrorDetails {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_ttn_lorawan_v3_networkserver_proto protoreflect.FileDescriptor
+
+var file_ttn_lorawan_v3_networkserver_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61,
+	0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21,
+	0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2f, 0x76, 0x33, 0x2f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f,
+	0x76, 0x33, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1d, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0xc8, 0x01, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xac, 0x01, 0x92, 0x41, 0x19, 0x4a, 0x0a, 0x22,
+	0x32, 0x36, 0x30, 0x30, 0x41, 0x42, 0x43, 0x44, 0x22, 0x9a, 0x02, 0x01, 0x07, 0xa2, 0x02, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xfa, 0x42, 0x06, 0x7a, 0x04, 0x68, 0x04, 0x70, 0x01, 0xea,
+	0xaa, 0x19, 0x82, 0x01, 0x0a, 0x3f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e,
+	0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x48, 0x45, 0x58,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69,
+	0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c,
+	0x34, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x07, 0x64, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x22,
+	0xb3, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x41,
+	0x43, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x11, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x6c,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x18, 0x40, 0x52, 0x0f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x50,
+	0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x13, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x5f, 0x70, 0x68, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x50, 0x48, 0x59, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x11, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x50, 0x68, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0xf2, 0xaa, 0x19,
+	0x04, 0x08, 0x00, 0x10, 0x01, 0x22, 0xd7, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xc2, 0x01, 0x0a, 0x06, 0x6e,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0xaa, 0x01, 0x92, 0x41,
+	0x17, 0x4a, 0x08, 0x22, 0x30, 0x30, 0x30, 0x30, 0x31, 0x33, 0x22, 0x9a, 0x02, 0x01, 0x07, 0xa2,
+	0x02, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xfa, 0x42, 0x06, 0x7a, 0x04, 0x68, 0x03, 0x70,
+	0x01, 0xea, 0xaa, 0x19, 0x82, 0x01, 0x0a, 0x3f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68,
+	0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x48,
+	0x45, 0x58, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68,
+	0x61, 0x6c, 0x33, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x22,
+	0x8e, 0x02, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0xea, 0x01, 0x0a, 0x11, 0x64, 0x65, 0x76, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x42,
+	0xbd, 0x01, 0x92, 0x41, 0x11, 0x4a, 0x0f, 0x5b, 0x22, 0x32, 0x36, 0x30, 0x30, 0x41, 0x42, 0x30,
+	0x30, 0x2f, 0x32, 0x34, 0x22, 0x5d, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x7a, 0x02,
+	0x68, 0x05, 0xea, 0xaa, 0x19, 0x98, 0x01, 0x0a, 0x49, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c,
+	0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x6c, 0x69,
+	0x63, 0x65, 0x12, 0x4b, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x44, 0x65, 0x76,
+	0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52,
+	0x0f, 0x64, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73,
+	0x22, 0x96, 0x02, 0x0a, 0x18, 0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44,
+	0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x6b, 0x0a, 0x23, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x55, 0x74, 0x69,
+	0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x44, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0xac, 0x02, 0x0a, 0x11, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x54, 0x0a, 0x0e,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x37, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x0b, 0x70,
+	0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a,
+	0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x1d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x5c, 0x0a,
+	0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x15,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x65,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x58, 0x0a, 0x11, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2c, 0xfa, 0x42, 0x29, 0x92, 0x01, 0x26, 0x22, 0x24,
+	0x72, 0x22, 0x18, 0x24, 0x32, 0x1e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28,
+	0x3f, 0x3a, 0x5b, 0x2d, 0x5d, 0x3f, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x7b,
+	0x32, 0x2c, 0x7d, 0x24, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63,
+	0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73,
+	0x12, 0x45, 0x0a, 0x08, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x42, 0x43, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x08, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xec, 0x02, 0x0a,
+	0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12,
+	0x39, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x69,
+	0x72, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x69, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x3e, 0x0a, 0x0d, 0x61, 0x69, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x61, 0x69, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x68, 0x61, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x74, 0x41, 0x74, 0x22, 0x4b, 0x0a, 0x0f, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12, 0x38,
+	0x0a, 0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52,
+	0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x22, 0x7a, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54,
+	0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a,
+	0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0xdd, 0x08, 0x0a, 0x12, 0x45, 0x6e, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x55, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x66, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x46, 0x43, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x75, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x55, 0x70,
+	0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x03, 0x73, 0x6e, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x03, 0x73, 0x6e, 0x72, 0x12, 0x42, 0x0a, 0x04, 0x72, 0x73,
+	0x73, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x04, 0x72, 0x73, 0x73, 0x69, 0x12, 0x50,
+	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x61, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x52, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x60, 0x0a, 0x11, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x64, 0x69, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x69, 0x76, 0x65, 0x72, 0x73, 0x69, 0x74, 0x79,
+	0x52, 0x10, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x69, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x74, 0x79, 0x1a, 0x6d, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x31, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x03, 0x70, 0x31, 0x30, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x70, 0x39, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x70, 0x39, 0x30, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x6d, 0x61,
+	0x78, 0x1a, 0xe6, 0x01, 0x0a, 0x10, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x69, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x74, 0x79, 0x12, 0x5d, 0x0a, 0x08, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x44, 0x69, 0x76, 0x65, 0x72, 0x73, 0x69, 0x74, 0x79, 0x2e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x1a, 0x3b, 0x0a,
+	0x0d, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9d, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12,
+	0x27, 0x0a, 0x09, 0x6b, 0x65, 0x6b, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x10, 0x52, 0x08,
+	0x6b, 0x65, 0x6b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x56, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x22, 0xca, 0x01, 0x0a, 0x17, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0x36, 0x0a,
+	0x18, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x22, 0xcc, 0x05, 0x0a, 0x12, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x4d, 0x41, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0e,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x07,
+	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x41, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x92, 0x01, 0x05,
+	0x08, 0x01, 0x10, 0xe8, 0x07, 0x52, 0x07, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x1a, 0xd3,
+	0x03, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x52, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x41, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6e, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x03, 0x73, 0x6e, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x73, 0x73, 0x69, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x73, 0x73, 0x69, 0x12, 0x2c, 0x0a, 0x0d, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x64, 0x52, 0x0c, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x61, 0x64, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x61, 0x64, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x64, 0x72, 0x5f, 0x61,
+	0x63, 0x6b, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x64,
+	0x72, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x6d, 0x61, 0x63, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x41, 0x43,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x0b, 0x6d, 0x61, 0x63, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x22, 0xcb, 0x03, 0x0a, 0x0d, 0x4d, 0x41, 0x43, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x41, 0x43, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73,
+	0x1a, 0xff, 0x02, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x13, 0x0a, 0x05, 0x66, 0x5f, 0x63,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x66, 0x43, 0x6e, 0x74, 0x12, 0x45,
+	0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x52, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x61, 0x64, 0x72, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x0b, 0x61, 0x64, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4e,
+	0x0a, 0x15, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x61, 0x63, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d,
+	0x41, 0x43, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x13, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x4d, 0x61, 0x63, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x66, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a, 0x09, 0x6d,
+	0x61, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x4d, 0x41, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x25, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2a, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x6e, 0x67, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xd2, 0x02, 0x0a, 0x18, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x4a, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x0c, 0x65,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x55, 0x70, 0x6c,
+	0x69, 0x6e, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x6f, 0x72, 0x5f, 0x75, 0x70, 0x6c,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x6f, 0x6f, 0x72,
+	0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x65, 0x64, 0x5f, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0a,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xee, 0x01, 0x0a, 0x0d, 0x46, 0x43, 0x6e, 0x74,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x66, 0x5f, 0x63,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x66, 0x43, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x43, 0x6e, 0x74, 0x55,
+	0x70, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x46, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2a, 0x0a, 0x06,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x47, 0x41, 0x50, 0x10, 0x01, 0x22, 0x8e, 0x02, 0x0a, 0x10, 0x46, 0x43, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x75, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x43, 0x6e, 0x74, 0x55, 0x70,
+	0x12, 0x13, 0x0a, 0x05, 0x66, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x66, 0x43, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x12, 0x3b, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa1, 0x02, 0x0a, 0x13, 0x45, 0x6e,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x79, 0x6e,
+	0x63, 0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52,
+	0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x64, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f,
+	0x6e, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65,
+	0x73, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f,
+	0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x3d, 0x0a, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x43, 0x6e, 0x74,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x22, 0xfe, 0x04,
+	0x0a, 0x15, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x42, 0x43,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x26, 0x0a, 0x0f, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x6c,
+	0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x6f, 0x0a, 0x14, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x42, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x42, 0x79, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x42, 0x79, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x59, 0x0a, 0x0e, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x42, 0x43,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x1a, 0xc1, 0x01, 0x0a, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x69, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x61, 0x69, 0x72, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x63, 0x63,
+	0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6f, 0x63,
+	0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x1a, 0x45, 0x0a, 0x17, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x42, 0x79, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe3,
+	0x08, 0x0a, 0x0f, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f,
+	0x69, 0x6e, 0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x52, 0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x4e,
+	0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69,
+	0x6e, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x3e,
+	0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4d,
+	0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x0d,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x47, 0x0a,
+	0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a,
+	0x6f, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x1a, 0x92, 0x02, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x53, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x4d, 0x41, 0x43, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x52, 0x0e, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x1a, 0xc7, 0x01, 0x0a, 0x06,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x53, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x4d, 0x41, 0x43, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x52, 0x0e, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x73, 0x65, 0x74, 0x41, 0x74, 0x1a, 0xfc, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e,
+	0x12, 0x42, 0x0a, 0x0b, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x69, 0x63, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x1b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x6e,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x65, 0x6e,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x73, 0x12, 0x5d, 0x0a, 0x10, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x41, 0x43, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x22, 0x99, 0x02, 0x0a, 0x1f, 0x53, 0x65,
+	0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x54, 0x0a,
+	0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x73, 0x12, 0x5d, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6a,
+	0x6f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d,
+	0x41, 0x43, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x41, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x08, 0x01, 0x2a, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xa9, 0x02, 0x0a, 0x1c, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x73, 0x12, 0x4f, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x30, 0xfa, 0x42, 0x2d, 0x92, 0x01, 0x2a, 0x08, 0x01, 0x10,
+	0x64, 0x22, 0x24, 0x72, 0x22, 0x18, 0x24, 0x32, 0x1e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5d, 0x28, 0x3f, 0x3a, 0x5b, 0x2d, 0x5d, 0x3f, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5d, 0x29, 0x7b, 0x32, 0x2c, 0x7d, 0x24, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x5d, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x6a, 0x6f,
+	0x69, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x41,
+	0x43, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x22, 0xca, 0x01, 0x0a, 0x1d, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x1a, 0x59, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x58,
+	0x0a, 0x13, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x6f, 0x61, 0x6d, 0x69, 0x6e,
+	0x67, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45,
+	0x52, 0x5f, 0x52, 0x4f, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x48,
+	0x4f, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45,
+	0x52, 0x5f, 0x52, 0x4f, 0x41, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x2a, 0x85, 0x01, 0x0a, 0x13, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x24, 0x0a, 0x20, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x42, 0x55, 0x44,
+	0x47, 0x45, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x45,
+	0x56, 0x49, 0x43, 0x45, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f,
+	0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x21, 0x0a,
+	0x1d, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54,
+	0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x02,
+	0x2a, 0x60, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x4c,
+	0x41, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x00, 0x12, 0x23, 0x0a,
+	0x1f, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45,
+	0x10, 0x01, 0x32, 0xc1, 0x2c, 0x0a, 0x02, 0x4e, 0x73, 0x12, 0x68, 0x0a, 0x0f, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x76, 0x41, 0x64, 0x64, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x6e, 0x73, 0x2f, 0x64, 0x65, 0x76, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0xae, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x4d, 0x41, 0x43, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x41, 0x43, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x41, 0x43,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44,
+	0x12, 0x42, 0x2f, 0x6e, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x61,
+	0x63, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x66, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x7b,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x5f, 0x70, 0x68, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x7d, 0x12, 0x58, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x49, 0x44,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x6e, 0x73, 0x2f, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x82,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x6e,
+	0x73, 0x2f, 0x64, 0x65, 0x76, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x65, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x76, 0x41, 0x64,
+	0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x33, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x65, 0x76, 0x41, 0x64, 0x64, 0x72, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x55, 0x74, 0x69,
+	0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x6e, 0x73, 0x2f, 0x64, 0x65,
+	0x76, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x2f,
+	0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xb7, 0x01, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x48, 0x61, 0x6e, 0x64,
+	0x6f, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x21, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x56, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x50, 0x12, 0x4e, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x68, 0x61, 0x6e,
+	0x64, 0x6f, 0x76, 0x65, 0x72, 0x12, 0xe3, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72,
+	0x12, 0x2d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76,
+	0x65, 0x72, 0x22, 0x77, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x71, 0x3a, 0x01, 0x2a, 0x22, 0x6c, 0x2f,
+	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x12, 0xad, 0x01, 0x0a, 0x15,
+	0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x48, 0x61, 0x6e,
+	0x64, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x56, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x50, 0x2a, 0x4e, 0x2f, 0x6e, 0x73,
+	0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x12, 0xc8, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x25,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x5f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x59, 0x12, 0x57, 0x2f,
+	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0xdc, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x80, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x7a, 0x3a, 0x01, 0x2a, 0x1a, 0x75,
+	0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0xcb, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x73, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x26, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x63, 0x61, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x73, 0x22, 0x60, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5a, 0x12, 0x58, 0x2f, 0x6e, 0x73, 0x2f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x73, 0x12, 0xe7, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x73, 0x12, 0x32, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x22, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x6d,
+	0x12, 0x6b, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12, 0xb9, 0x01,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12,
+	0x34, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39,
+	0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0x58, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x52, 0x12, 0x50, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0xb1, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x52, 0x2a, 0x50, 0x2f, 0x6e, 0x73, 0x2f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0xcd, 0x01, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x61,
+	0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x73, 0x1a, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x61,
+	0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x58, 0x12, 0x56, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x79,
+	0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0xd9, 0x01, 0x0a, 0x1f,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x66, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x60, 0x22, 0x5e, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72,
+	0x65, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0xc5, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x79,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x5f,
+	0x22, 0x5d, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0xbf, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x46, 0x43, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x1a, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x43, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x54, 0x12, 0x52, 0x2f,
+	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x79, 0x6e,
+	0x63, 0x12, 0xbc, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x43, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a,
+	0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x43, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x79, 0x6e, 0x63, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x54, 0x22, 0x52, 0x2f, 0x6e,
+	0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x66, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x79, 0x6e, 0x63,
+	0x12, 0x99, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x42, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x22, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x73, 0x1a, 0x25, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x42,
+	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c,
+	0x12, 0x2a, 0x2f, 0x6e, 0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x2f, 0x7b,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x62, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0xb1, 0x01, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a,
+	0x6f, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x4e, 0x12, 0x4c, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e,
+	0x12, 0xe1, 0x01, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x2b, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x22, 0x7b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x75, 0x3a,
+	0x01, 0x2a, 0x22, 0x70, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e,
+	0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x2f, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x12, 0xbf, 0x01, 0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x6a,
+	0x6f, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x54, 0x2a, 0x52, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e,
+	0x2f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0xc7, 0x01, 0x0a, 0x15, 0x46, 0x6f, 0x72, 0x63, 0x65,
+	0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e,
+	0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x3a, 0x01, 0x2a, 0x22, 0x46, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x2f, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x12, 0xea, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x22,
+	0x7c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x76, 0x3a, 0x01, 0x2a, 0x1a, 0x71, 0x2f, 0x6e, 0x73, 0x2f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e,
+	0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0xc1, 0x01,
+	0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x1a, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x6a, 0x6f, 0x69, 0x6e, 0x22, 0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x55, 0x2a, 0x53, 0x2f, 0x6e,
+	0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x6f, 0x69, 0x6e, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0xaf, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x42, 0x12, 0x40, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0xb2, 0x01, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x45, 0x3a, 0x01, 0x2a, 0x22, 0x40, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xcd, 0x01, 0x0a, 0x0b, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x4d, 0x41, 0x43, 0x12, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x4d, 0x41, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4d, 0x41,
+	0x43, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x75, 0x3a, 0x01, 0x2a, 0x22, 0x70, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x63, 0x1a, 0x2c, 0x92, 0x41, 0x29, 0x12, 0x27, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73,
+	0x20, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x32, 0xae, 0x03, 0x0a, 0x04, 0x41, 0x73, 0x4e, 0x73, 0x12,
+	0x54, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x50, 0x75, 0x73, 0x68, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5f, 0x0a, 0x11, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x73, 0x1a, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x1a, 0x9b, 0x01, 0x92, 0x41, 0x97, 0x01,
+	0x12, 0x94, 0x01, 0x54, 0x68, 0x65, 0x20, 0x41, 0x73, 0x4e, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x20, 0x61, 0x6e, 0x20,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x20, 0x54, 0x68, 0x69, 0x73, 0x20, 0x69, 0x73, 0x20,
+	0x61, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x69,
+	0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x20, 0x74,
+	0x6f, 0x20, 0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x65, 0x6e, 0x64,
+	0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x32, 0xc1, 0x02, 0x0a, 0x04, 0x47, 0x73, 0x4e, 0x73,
+	0x12, 0x45, 0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b,
+	0x12, 0x1d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x2e, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x59, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x78, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x54, 0x78, 0x41, 0x63, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x96, 0x01, 0x92, 0x41, 0x92, 0x01, 0x12, 0x8f, 0x01, 0x54, 0x68, 0x65, 0x20,
+	0x47, 0x73, 0x4e, 0x53, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x73, 0x20, 0x61, 0x20, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x20,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x20, 0x54, 0x68, 0x69, 0x73,
+	0x20, 0x69, 0x73, 0x20, 0x61, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x2d, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x64,
+	0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x62, 0x79,
+	0x20, 0x65, 0x6e, 0x64, 0x20, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x32, 0xb7, 0x01, 0x0a, 0x0e,
+	0x4e, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x45,
+	0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1d,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x55, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x5e, 0x92, 0x41, 0x5b, 0x12, 0x59, 0x49, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x20, 0x75, 0x70, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x20, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x20, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x20, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x20,
+	0x69, 0x6e, 0x74, 0x6f, 0x20, 0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x20,
+	0x53, 0x74, 0x61, 0x63, 0x6b, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x32, 0xf9, 0x06, 0x0a, 0x13, 0x4e, 0x73, 0x45, 0x6e, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0xb2, 0x01,
+	0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x65, 0x12, 0x63, 0x2f,
+	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x86, 0x02, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74, 0x45,
+	0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xbe, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0xb7, 0x01, 0x3a, 0x01, 0x2a, 0x5a, 0x4d, 0x3a, 0x01, 0x2a, 0x22, 0x48, 0x2f, 0x6e,
+	0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x69, 0x64, 0x73, 0x2e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x63, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x69, 0x64, 0x73,
+	0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xce, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x6e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x68, 0x3a, 0x01, 0x2a, 0x32, 0x63, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x2e, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x95, 0x01, 0x0a,
+	0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x2a, 0x45, 0x2f,
+	0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x1a, 0x3b, 0x92, 0x41, 0x38, 0x12, 0x36, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x20, 0x6f, 0x6e,
+	0x20, 0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x53, 0x74, 0x61, 0x63,
+	0x6b, 0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x32, 0xfc, 0x01, 0x0a, 0x18, 0x4e, 0x73, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x97,
+	0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x2a, 0x3f, 0x2f, 0x6e, 0x73, 0x2f, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x46, 0x92, 0x41, 0x43, 0x12, 0x41, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x20, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x65, 0x6e, 0x64, 0x20, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x20, 0x6f, 0x6e, 0x20,
+	0x54, 0x68, 0x65, 0x20, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x20, 0x53, 0x74, 0x61, 0x63, 0x6b,
+	0x20, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
+	0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74,
+	0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ttn_lorawan_v3_networkserver_proto_rawDescOnce sync.Once
+	file_ttn_lorawan_v3_networkserver_proto_rawDescData = file_ttn_lorawan_v3_networkserver_proto_rawDesc
+)
+
+func file_ttn_lorawan_v3_networkserver_proto_rawDescGZIP() []byte {
+	file_ttn_lorawan_v3_networkserver_proto_rawDescOnce.Do(func() {
+		file_ttn_lorawan_v3_networkserver_proto_rawDescData = protoimpl.X.CompressGZIP(file_ttn_lorawan_v3_networkserver_proto_rawDescData)
+	})
+	return file_ttn_lorawan_v3_networkserver_proto_rawDescData
+}
+
+var file_ttn_lorawan_v3_networkserver_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_ttn_lorawan_v3_networkserver_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_ttn_lorawan_v3_networkserver_proto_goTypes = []interface{}{
+	(HandoverRoamingRole)(0),                     // 0: ttn.lorawan.v3.HandoverRoamingRole
+	(DownlinkBudgetScope)(0),                     // 1: ttn.lorawan.v3.DownlinkBudgetScope
+	(RelayAssignmentAction)(0),                   // 2: ttn.lorawan.v3.RelayAssignmentAction
+	(FCntRejection_Reason)(0),                    // 3: ttn.lorawan.v3.FCntRejection.Reason
+	(*GenerateDevAddrResponse)(nil),              // 4: ttn.lorawan.v3.GenerateDevAddrResponse
+	(*GetDefaultMACSettingsRequest)(nil),         // 5: ttn.lorawan.v3.GetDefaultMACSettingsRequest
+	(*GetNetIDResponse)(nil),                     // 6: ttn.lorawan.v3.GetNetIDResponse
+	(*GetDeviceAdressPrefixesResponse)(nil),      // 7: ttn.lorawan.v3.GetDeviceAdressPrefixesResponse
+	(*DevAddrPrefixUtilization)(nil),             // 8: ttn.lorawan.v3.DevAddrPrefixUtilization
+	(*GetDevAddrPrefixUtilizationResponse)(nil),  // 9: ttn.lorawan.v3.GetDevAddrPrefixUtilizationResponse
+	(*EndDeviceHandover)(nil),                    // 10: ttn.lorawan.v3.EndDeviceHandover
+	(*StartEndDeviceHandoverRequest)(nil),        // 11: ttn.lorawan.v3.StartEndDeviceHandoverRequest
+	(*MulticastGroupMembers)(nil),                // 12: ttn.lorawan.v3.MulticastGroupMembers
+	(*MulticastGroupGateways)(nil),               // 13: ttn.lorawan.v3.MulticastGroupGateways
+	(*DownlinkBudget)(nil),                       // 14: ttn.lorawan.v3.DownlinkBudget
+	(*DownlinkBudgets)(nil),                      // 15: ttn.lorawan.v3.DownlinkBudgets
+	(*GetEndDeviceDownlinkBudgetsRequest)(nil),   // 16: ttn.lorawan.v3.GetEndDeviceDownlinkBudgetsRequest
+	(*GetApplicationDownlinkBudgetsRequest)(nil), // 17: ttn.lorawan.v3.GetApplicationDownlinkBudgetsRequest
+	(*EndDeviceLinkStats)(nil),                   // 18: ttn.lorawan.v3.EndDeviceLinkStats
+	(*ExportEndDevicesRequest)(nil),              // 19: ttn.lorawan.v3.ExportEndDevicesRequest
+	(*ExportEndDevicesResponse)(nil),             // 20: ttn.lorawan.v3.ExportEndDevicesResponse
+	(*ImportEndDevicesRequest)(nil),              // 21: ttn.lorawan.v3.ImportEndDevicesRequest
+	(*ImportEndDevicesResponse)(nil),             // 22: ttn.lorawan.v3.ImportEndDevicesResponse
+	(*SimulateMACRequest)(nil),                   // 23: ttn.lorawan.v3.SimulateMACRequest
+	(*MACSimulation)(nil),                        // 24: ttn.lorawan.v3.MACSimulation
+	(*RelayAssignmentSuggestion)(nil),            // 25: ttn.lorawan.v3.RelayAssignmentSuggestion
+	(*EndDeviceRelayAssignment)(nil),             // 26: ttn.lorawan.v3.EndDeviceRelayAssignment
+	(*FCntRejection)(nil),                        // 27: ttn.lorawan.v3.FCntRejection
+	(*FCntResyncRecord)(nil),                     // 28: ttn.lorawan.v3.FCntResyncRecord
+	(*EndDeviceFCntResync)(nil),                  // 29: ttn.lorawan.v3.EndDeviceFCntResync
+	(*GatewayClassBCapacity)(nil),                // 30: ttn.lorawan.v3.GatewayClassBCapacity
+	(*EndDeviceRejoin)(nil),                      // 31: ttn.lorawan.v3.EndDeviceRejoin
+	(*ForceEndDeviceRejoinRequest)(nil),          // 32: ttn.lorawan.v3.ForceEndDeviceRejoinRequest
+	(*SetEndDeviceRejoinPolicyRequest)(nil),      // 33: ttn.lorawan.v3.SetEndDeviceRejoinPolicyRequest
+	(*ForceEndDevicesRejoinRequest)(nil),         // 34: ttn.lorawan.v3.ForceEndDevicesRejoinRequest
+	(*ForceEndDevicesRejoinResponse)(nil),        // 35: ttn.lorawan.v3.ForceEndDevicesRejoinResponse
+	(*EndDeviceLinkStats_Percentiles)(nil),       // 36: ttn.lorawan.v3.EndDeviceLinkStats.Percentiles
+	(*EndDeviceLinkStats_GatewayDiversity)(nil),  // 37: ttn.lorawan.v3.EndDeviceLinkStats.GatewayDiversity
+	nil,                               // 38: ttn.lorawan.v3.EndDeviceLinkStats.DataRatesEntry
+	nil,                               // 39: ttn.lorawan.v3.EndDeviceLinkStats.GatewayDiversity.GatewaysEntry
+	(*SimulateMACRequest_Uplink)(nil), // 40: ttn.lorawan.v3.SimulateMACRequest.Uplink
+	(*MACSimulation_Step)(nil),        // 41: ttn.lorawan.v3.MACSimulation.Step
+	(*GatewayClassBCapacity_BeaconPeriod)(nil), // 42: ttn.lorawan.v3.GatewayClassBCapacity.BeaconPeriod
+	nil,                                 // 43: ttn.lorawan.v3.GatewayClassBCapacity.DevicesByFrequencyEntry
+	(*EndDeviceRejoin_ForceRejoin)(nil), // 44: ttn.lorawan.v3.EndDeviceRejoin.ForceRejoin
+	(*EndDeviceRejoin_Policy)(nil),      // 45: ttn.lorawan.v3.EndDeviceRejoin.Policy
+	(*EndDeviceRejoin_Rejoin)(nil),      // 46: ttn.lorawan.v3.EndDeviceRejoin.Rejoin
+	(*ForceEndDevicesRejoinResponse_Result)(nil), // 47: ttn.lorawan.v3.ForceEndDevicesRejoinResponse.Result
+	(PHYVersion)(0),                      // 48: ttn.lorawan.v3.PHYVersion
+	(*DevAddrPrefix)(nil),                // 49: ttn.lorawan.v3.DevAddrPrefix
+	(*EndDeviceIdentifiers)(nil),         // 50: ttn.lorawan.v3.EndDeviceIdentifiers
+	(*NetworkIdentifiers)(nil),           // 51: ttn.lorawan.v3.NetworkIdentifiers
+	(*timestamppb.Timestamp)(nil),        // 52: google.protobuf.Timestamp
+	(*ClassBCGatewayIdentifiers)(nil),    // 53: ttn.lorawan.v3.ClassBCGatewayIdentifiers
+	(*durationpb.Duration)(nil),          // 54: google.protobuf.Duration
+	(*ApplicationIdentifiers)(nil),       // 55: ttn.lorawan.v3.ApplicationIdentifiers
+	(*EndDevice)(nil),                    // 56: ttn.lorawan.v3.EndDevice
+	(*GatewayIdentifiers)(nil),           // 57: ttn.lorawan.v3.GatewayIdentifiers
+	(*MACCommand_ForceRejoinReq)(nil),    // 58: ttn.lorawan.v3.MACCommand.ForceRejoinReq
+	(*DataRateIndexValue)(nil),           // 59: ttn.lorawan.v3.DataRateIndexValue
+	(*wrapperspb.UInt32Value)(nil),       // 60: google.protobuf.UInt32Value
+	(*wrapperspb.BoolValue)(nil),         // 61: google.protobuf.BoolValue
+	(*MACCommand)(nil),                   // 62: ttn.lorawan.v3.MACCommand
+	(DataRateIndex)(0),                   // 63: ttn.lorawan.v3.DataRateIndex
+	(*structpb.Struct)(nil),              // 64: google.protobuf.Struct
+	(*MACState)(nil),                     // 65: ttn.lorawan.v3.MACState
+	(RejoinRequestType)(0),               // 66: ttn.lorawan.v3.RejoinRequestType
+	(*ErrorDetails)(nil),                 // 67: ttn.lorawan.v3.ErrorDetails
+	(*emptypb.Empty)(nil),                // 68: google.protobuf.Empty
+	(*DownlinkQueueRequest)(nil),         // 69: ttn.lorawan.v3.DownlinkQueueRequest
+	(*UplinkMessage)(nil),                // 70: ttn.lorawan.v3.UplinkMessage
+	(*GatewayTxAcknowledgment)(nil),      // 71: ttn.lorawan.v3.GatewayTxAcknowledgment
+	(*GetEndDeviceRequest)(nil),          // 72: ttn.lorawan.v3.GetEndDeviceRequest
+	(*SetEndDeviceRequest)(nil),          // 73: ttn.lorawan.v3.SetEndDeviceRequest
+	(*ResetAndGetEndDeviceRequest)(nil),  // 74: ttn.lorawan.v3.ResetAndGetEndDeviceRequest
+	(*BatchDeleteEndDevicesRequest)(nil), // 75: ttn.lorawan.v3.BatchDeleteEndDevicesRequest
+	(*MACSettings)(nil),                  // 76: ttn.lorawan.v3.MACSettings
+	(*ApplicationDownlinks)(nil),         // 77: ttn.lorawan.v3.ApplicationDownlinks
+}
+var file_ttn_lorawan_v3_networkserver_proto_depIdxs = []int32{
+	48,  // 0: ttn.lorawan.v3.GetDefaultMACSettingsRequest.lorawan_phy_version:type_name -> ttn.lorawan.v3.PHYVersion
+	49,  // 1: ttn.lorawan.v3.DevAddrPrefixUtilization.prefix:type_name -> ttn.lorawan.v3.DevAddrPrefix
+	8,   // 2: ttn.lorawan.v3.GetDevAddrPrefixUtilizationResponse.prefixes:type_name -> ttn.lorawan.v3.DevAddrPrefixUtilization
+	50,  // 3: ttn.lorawan.v3.EndDeviceHandover.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	0,   // 4: ttn.lorawan.v3.EndDeviceHandover.role:type_name -> ttn.lorawan.v3.HandoverRoamingRole
+	51,  // 5: ttn.lorawan.v3.EndDeviceHandover.partner_ids:type_name -> ttn.lorawan.v3.NetworkIdentifiers
+	52,  // 6: ttn.lorawan.v3.EndDeviceHandover.created_at:type_name -> google.protobuf.Timestamp
+	50,  // 7: ttn.lorawan.v3.StartEndDeviceHandoverRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	51,  // 8: ttn.lorawan.v3.StartEndDeviceHandoverRequest.serving_network_ids:type_name -> ttn.lorawan.v3.NetworkIdentifiers
+	50,  // 9: ttn.lorawan.v3.MulticastGroupMembers.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	53,  // 10: ttn.lorawan.v3.MulticastGroupGateways.gateways:type_name -> ttn.lorawan.v3.ClassBCGatewayIdentifiers
+	1,   // 11: ttn.lorawan.v3.DownlinkBudget.scope:type_name -> ttn.lorawan.v3.DownlinkBudgetScope
+	54,  // 12: ttn.lorawan.v3.DownlinkBudget.airtime:type_name -> google.protobuf.Duration
+	54,  // 13: ttn.lorawan.v3.DownlinkBudget.airtime_limit:type_name -> google.protobuf.Duration
+	52,  // 14: ttn.lorawan.v3.DownlinkBudget.reset_at:type_name -> google.protobuf.Timestamp
+	14,  // 15: ttn.lorawan.v3.DownlinkBudgets.budgets:type_name -> ttn.lorawan.v3.DownlinkBudget
+	50,  // 16: ttn.lorawan.v3.GetEndDeviceDownlinkBudgetsRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	55,  // 17: ttn.lorawan.v3.GetApplicationDownlinkBudgetsRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	52,  // 18: ttn.lorawan.v3.EndDeviceLinkStats.first_uplink_at:type_name -> google.protobuf.Timestamp
+	52,  // 19: ttn.lorawan.v3.EndDeviceLinkStats.last_uplink_at:type_name -> google.protobuf.Timestamp
+	36,  // 20: ttn.lorawan.v3.EndDeviceLinkStats.snr:type_name -> ttn.lorawan.v3.EndDeviceLinkStats.Percentiles
+	36,  // 21: ttn.lorawan.v3.EndDeviceLinkStats.rssi:type_name -> ttn.lorawan.v3.EndDeviceLinkStats.Percentiles
+	38,  // 22: ttn.lorawan.v3.EndDeviceLinkStats.data_rates:type_name -> ttn.lorawan.v3.EndDeviceLinkStats.DataRatesEntry
+	37,  // 23: ttn.lorawan.v3.EndDeviceLinkStats.gateway_diversity:type_name -> ttn.lorawan.v3.EndDeviceLinkStats.GatewayDiversity
+	55,  // 24: ttn.lorawan.v3.ExportEndDevicesRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	56,  // 25: ttn.lorawan.v3.ExportEndDevicesResponse.end_devices:type_name -> ttn.lorawan.v3.EndDevice
+	55,  // 26: ttn.lorawan.v3.ImportEndDevicesRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	56,  // 27: ttn.lorawan.v3.ImportEndDevicesRequest.end_devices:type_name -> ttn.lorawan.v3.EndDevice
+	50,  // 28: ttn.lorawan.v3.SimulateMACRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	56,  // 29: ttn.lorawan.v3.SimulateMACRequest.end_device:type_name -> ttn.lorawan.v3.EndDevice
+	40,  // 30: ttn.lorawan.v3.SimulateMACRequest.uplinks:type_name -> ttn.lorawan.v3.SimulateMACRequest.Uplink
+	41,  // 31: ttn.lorawan.v3.MACSimulation.steps:type_name -> ttn.lorawan.v3.MACSimulation.Step
+	2,   // 32: ttn.lorawan.v3.RelayAssignmentSuggestion.action:type_name -> ttn.lorawan.v3.RelayAssignmentAction
+	52,  // 33: ttn.lorawan.v3.RelayAssignmentSuggestion.suggested_at:type_name -> google.protobuf.Timestamp
+	50,  // 34: ttn.lorawan.v3.EndDeviceRelayAssignment.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	25,  // 35: ttn.lorawan.v3.EndDeviceRelayAssignment.suggestion:type_name -> ttn.lorawan.v3.RelayAssignmentSuggestion
+	3,   // 36: ttn.lorawan.v3.FCntRejection.reason:type_name -> ttn.lorawan.v3.FCntRejection.Reason
+	52,  // 37: ttn.lorawan.v3.FCntRejection.received_at:type_name -> google.protobuf.Timestamp
+	3,   // 38: ttn.lorawan.v3.FCntResyncRecord.reason:type_name -> ttn.lorawan.v3.FCntRejection.Reason
+	52,  // 39: ttn.lorawan.v3.FCntResyncRecord.resynced_at:type_name -> google.protobuf.Timestamp
+	50,  // 40: ttn.lorawan.v3.EndDeviceFCntResync.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	27,  // 41: ttn.lorawan.v3.EndDeviceFCntResync.rejections:type_name -> ttn.lorawan.v3.FCntRejection
+	28,  // 42: ttn.lorawan.v3.EndDeviceFCntResync.audit:type_name -> ttn.lorawan.v3.FCntResyncRecord
+	57,  // 43: ttn.lorawan.v3.GatewayClassBCapacity.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
+	43,  // 44: ttn.lorawan.v3.GatewayClassBCapacity.devices_by_frequency:type_name -> ttn.lorawan.v3.GatewayClassBCapacity.DevicesByFrequencyEntry
+	42,  // 45: ttn.lorawan.v3.GatewayClassBCapacity.beacon_periods:type_name -> ttn.lorawan.v3.GatewayClassBCapacity.BeaconPeriod
+	50,  // 46: ttn.lorawan.v3.EndDeviceRejoin.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	44,  // 47: ttn.lorawan.v3.EndDeviceRejoin.force_rejoin:type_name -> ttn.lorawan.v3.EndDeviceRejoin.ForceRejoin
+	45,  // 48: ttn.lorawan.v3.EndDeviceRejoin.policy:type_name -> ttn.lorawan.v3.EndDeviceRejoin.Policy
+	46,  // 49: ttn.lorawan.v3.EndDeviceRejoin.pending_rejoin:type_name -> ttn.lorawan.v3.EndDeviceRejoin.Rejoin
+	46,  // 50: ttn.lorawan.v3.EndDeviceRejoin.last_rejoin:type_name -> ttn.lorawan.v3.EndDeviceRejoin.Rejoin
+	50,  // 51: ttn.lorawan.v3.ForceEndDeviceRejoinRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	58,  // 52: ttn.lorawan.v3.ForceEndDeviceRejoinRequest.force_rejoin_req:type_name -> ttn.lorawan.v3.MACCommand.ForceRejoinReq
+	50,  // 53: ttn.lorawan.v3.SetEndDeviceRejoinPolicyRequest.end_device_ids:type_name -> ttn.lorawan.v3.EndDeviceIdentifiers
+	58,  // 54: ttn.lorawan.v3.SetEndDeviceRejoinPolicyRequest.force_rejoin_req:type_name -> ttn.lorawan.v3.MACCommand.ForceRejoinReq
+	54,  // 55: ttn.lorawan.v3.SetEndDeviceRejoinPolicyRequest.interval:type_name -> google.protobuf.Duration
+	55,  // 56: ttn.lorawan.v3.ForceEndDevicesRejoinRequest.application_ids:type_name -> ttn.lorawan.v3.ApplicationIdentifiers
+	58,  // 57: ttn.lorawan.v3.ForceEndDevicesRejoinRequest.force_rejoin_req:type_name -> ttn.lorawan.v3.MACCommand.ForceRejoinReq
+	47,  // 58: ttn.lorawan.v3.ForceEndDevicesRejoinResponse.results:type_name -> ttn.lorawan.v3.ForceEndDevicesRejoinResponse.Result
+	39,  // 59: ttn.lorawan.v3.EndDeviceLinkStats.GatewayDiversity.gateways:type_name -> ttn.lorawan.v3.EndDeviceLinkStats.GatewayDiversity.GatewaysEntry
+	52,  // 60: ttn.lorawan.v3.SimulateMACRequest.Uplink.received_at:type_name -> google.protobuf.Timestamp
+	59,  // 61: ttn.lorawan.v3.SimulateMACRequest.Uplink.data_rate_index:type_name -> ttn.lorawan.v3.DataRateIndexValue
+	60,  // 62: ttn.lorawan.v3.SimulateMACRequest.Uplink.channel_index:type_name -> google.protobuf.UInt32Value
+	61,  // 63: ttn.lorawan.v3.SimulateMACRequest.Uplink.adr:type_name -> google.protobuf.BoolValue
+	62,  // 64: ttn.lorawan.v3.SimulateMACRequest.Uplink.mac_commands:type_name -> ttn.lorawan.v3.MACCommand
+	63,  // 65: ttn.lorawan.v3.MACSimulation.Step.data_rate_index:type_name -> ttn.lorawan.v3.DataRateIndex
+	64,  // 66: ttn.lorawan.v3.MACSimulation.Step.adr_decision:type_name -> google.protobuf.Struct
+	62,  // 67: ttn.lorawan.v3.MACSimulation.Step.downlink_mac_commands:type_name -> ttn.lorawan.v3.MACCommand
+	65,  // 68: ttn.lorawan.v3.MACSimulation.Step.mac_state:type_name -> ttn.lorawan.v3.MACState
+	52,  // 69: ttn.lorawan.v3.GatewayClassBCapacity.BeaconPeriod.starts_at:type_name -> google.protobuf.Timestamp
+	54,  // 70: ttn.lorawan.v3.GatewayClassBCapacity.BeaconPeriod.airtime:type_name -> google.protobuf.Duration
+	58,  // 71: ttn.lorawan.v3.EndDeviceRejoin.ForceRejoin.force_rejoin_req:type_name -> ttn.lorawan.v3.MACCommand.ForceRejoinReq
+	52,  // 72: ttn.lorawan.v3.EndDeviceRejoin.ForceRejoin.issued_at:type_name -> google.protobuf.Timestamp
+	52,  // 73: ttn.lorawan.v3.EndDeviceRejoin.ForceRejoin.sent_at:type_name -> google.protobuf.Timestamp
+	58,  // 74: ttn.lorawan.v3.EndDeviceRejoin.Policy.force_rejoin_req:type_name -> ttn.lorawan.v3.MACCommand.ForceRejoinReq
+	54,  // 75: ttn.lorawan.v3.EndDeviceRejoin.Policy.interval:type_name -> google.protobuf.Duration
+	52,  // 76: ttn.lorawan.v3.EndDeviceRejoin.Policy.set_at:type_name -> google.protobuf.Timestamp
+	66,  // 77: ttn.lorawan.v3.EndDeviceRejoin.Rejoin.rejoin_type:type_name -> ttn.lorawan.v3.RejoinRequestType
+	52,  // 78: ttn.lorawan.v3.EndDeviceRejoin.Rejoin.received_at:type_name -> google.protobuf.Timestamp
+	52,  // 79: ttn.lorawan.v3.EndDeviceRejoin.Rejoin.completed_at:type_name -> google.protobuf.Timestamp
+	67,  // 80: ttn.lorawan.v3.ForceEndDevicesRejoinResponse.Result.error:type_name -> ttn.lorawan.v3.ErrorDetails
+	68,  // 81: ttn.lorawan.v3.Ns.GenerateDevAddr:input_type -> google.protobuf.Empty
+	5,   // 82: ttn.lorawan.v3.Ns.GetDefaultMACSettings:input_type -> ttn.lorawan.v3.GetDefaultMACSettingsRequest
+	68,  // 83: ttn.lorawan.v3.Ns.GetNetID:input_type -> google.protobuf.Empty
+	68,  // 84: ttn.lorawan.v3.Ns.GetDeviceAddressPrefixes:input_type -> google.protobuf.Empty
+	68,  // 85: ttn.lorawan.v3.Ns.GetDevAddrPrefixUtilization:input_type -> google.protobuf.Empty
+	50,  // 86: ttn.lorawan.v3.Ns.GetEndDeviceHandover:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	11,  // 87: ttn.lorawan.v3.Ns.StartEndDeviceHandover:input_type -> ttn.lorawan.v3.StartEndDeviceHandoverRequest
+	50,  // 88: ttn.lorawan.v3.Ns.StopEndDeviceHandover:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	50,  // 89: ttn.lorawan.v3.Ns.GetMulticastGroupMembers:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	12,  // 90: ttn.lorawan.v3.Ns.SetMulticastGroupMembers:input_type -> ttn.lorawan.v3.MulticastGroupMembers
+	50,  // 91: ttn.lorawan.v3.Ns.GetMulticastGroupGateways:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	16,  // 92: ttn.lorawan.v3.Ns.GetEndDeviceDownlinkBudgets:input_type -> ttn.lorawan.v3.GetEndDeviceDownlinkBudgetsRequest
+	17,  // 93: ttn.lorawan.v3.Ns.GetApplicationDownlinkBudgets:input_type -> ttn.lorawan.v3.GetApplicationDownlinkBudgetsRequest
+	50,  // 94: ttn.lorawan.v3.Ns.GetEndDeviceLinkStats:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	50,  // 95: ttn.lorawan.v3.Ns.ResetEndDeviceLinkStats:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	50,  // 96: ttn.lorawan.v3.Ns.GetEndDeviceRelayAssignment:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	50,  // 97: ttn.lorawan.v3.Ns.ApproveEndDeviceRelayAssignment:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	50,  // 98: ttn.lorawan.v3.Ns.RejectEndDeviceRelayAssignment:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	50,  // 99: ttn.lorawan.v3.Ns.GetEndDeviceFCntResync:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	50,  // 100: ttn.lorawan.v3.Ns.ResyncEndDeviceFCnt:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	57,  // 101: ttn.lorawan.v3.Ns.GetGatewayClassBCapacity:input_type -> ttn.lorawan.v3.GatewayIdentifiers
+	50,  // 102: ttn.lorawan.v3.Ns.GetEndDeviceRejoin:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	32,  // 103: ttn.lorawan.v3.Ns.ForceEndDeviceRejoin:input_type -> ttn.lorawan.v3.ForceEndDeviceRejoinRequest
+	50,  // 104: ttn.lorawan.v3.Ns.CancelEndDeviceForceRejoin:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	34,  // 105: ttn.lorawan.v3.Ns.ForceEndDevicesRejoin:input_type -> ttn.lorawan.v3.ForceEndDevicesRejoinRequest
+	33,  // 106: ttn.lorawan.v3.Ns.SetEndDeviceRejoinPolicy:input_type -> ttn.lorawan.v3.SetEndDeviceRejoinPolicyRequest
+	50,  // 107: ttn.lorawan.v3.Ns.DeleteEndDeviceRejoinPolicy:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	19,  // 108: ttn.lorawan.v3.Ns.ExportEndDevices:input_type -> ttn.lorawan.v3.ExportEndDevicesRequest
+	21,  // 109: ttn.lorawan.v3.Ns.ImportEndDevices:input_type -> ttn.lorawan.v3.ImportEndDevicesRequest
+	23,  // 110: ttn.lorawan.v3.Ns.SimulateMAC:input_type -> ttn.lorawan.v3.SimulateMACRequest
+	69,  // 111: ttn.lorawan.v3.AsNs.DownlinkQueueReplace:input_type -> ttn.lorawan.v3.DownlinkQueueRequest
+	69,  // 112: ttn.lorawan.v3.AsNs.DownlinkQueuePush:input_type -> ttn.lorawan.v3.DownlinkQueueRequest
+	50,  // 113: ttn.lorawan.v3.AsNs.DownlinkQueueList:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	70,  // 114: ttn.lorawan.v3.GsNs.HandleUplink:input_type -> ttn.lorawan.v3.UplinkMessage
+	71,  // 115: ttn.lorawan.v3.GsNs.ReportTxAcknowledgment:input_type -> ttn.lorawan.v3.GatewayTxAcknowledgment
+	70,  // 116: ttn.lorawan.v3.NsPacketSource.HandleUplink:input_type -> ttn.lorawan.v3.UplinkMessage
+	72,  // 117: ttn.lorawan.v3.NsEndDeviceRegistry.Get:input_type -> ttn.lorawan.v3.GetEndDeviceRequest
+	73,  // 118: ttn.lorawan.v3.NsEndDeviceRegistry.Set:input_type -> ttn.lorawan.v3.SetEndDeviceRequest
+	74,  // 119: ttn.lorawan.v3.NsEndDeviceRegistry.ResetFactoryDefaults:input_type -> ttn.lorawan.v3.ResetAndGetEndDeviceRequest
+	50,  // 120: ttn.lorawan.v3.NsEndDeviceRegistry.Delete:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	75,  // 121: ttn.lorawan.v3.NsEndDeviceBatchRegistry.Delete:input_type -> ttn.lorawan.v3.BatchDeleteEndDevicesRequest
+	4,   // 122: ttn.lorawan.v3.Ns.GenerateDevAddr:output_type -> ttn.lorawan.v3.GenerateDevAddrResponse
+	76,  // 123: ttn.lorawan.v3.Ns.GetDefaultMACSettings:output_type -> ttn.lorawan.v3.MACSettings
+	6,   // 124: ttn.lorawan.v3.Ns.GetNetID:output_type -> ttn.lorawan.v3.GetNetIDResponse
+	7,   // 125: ttn.lorawan.v3.Ns.GetDeviceAddressPrefixes:output_type -> ttn.lorawan.v3.GetDeviceAdressPrefixesResponse
+	9,   // 126: ttn.lorawan.v3.Ns.GetDevAddrPrefixUtilization:output_type -> ttn.lorawan.v3.GetDevAddrPrefixUtilizationResponse
+	10,  // 127: ttn.lorawan.v3.Ns.GetEndDeviceHandover:output_type -> ttn.lorawan.v3.EndDeviceHandover
+	10,  // 128: ttn.lorawan.v3.Ns.StartEndDeviceHandover:output_type -> ttn.lorawan.v3.EndDeviceHandover
+	68,  // 129: ttn.lorawan.v3.Ns.StopEndDeviceHandover:output_type -> google.protobuf.Empty
+	12,  // 130: ttn.lorawan.v3.Ns.GetMulticastGroupMembers:output_type -> ttn.lorawan.v3.MulticastGroupMembers
+	68,  // 131: ttn.lorawan.v3.Ns.SetMulticastGroupMembers:output_type -> google.protobuf.Empty
+	13,  // 132: ttn.lorawan.v3.Ns.GetMulticastGroupGateways:output_type -> ttn.lorawan.v3.MulticastGroupGateways
+	15,  // 133: ttn.lorawan.v3.Ns.GetEndDeviceDownlinkBudgets:output_type -> ttn.lorawan.v3.DownlinkBudgets
+	15,  // 134: ttn.lorawan.v3.Ns.GetApplicationDownlinkBudgets:output_type -> ttn.lorawan.v3.DownlinkBudgets
+	18,  // 135: ttn.lorawan.v3.Ns.GetEndDeviceLinkStats:output_type -> ttn.lorawan.v3.EndDeviceLinkStats
+	68,  // 136: ttn.lorawan.v3.Ns.ResetEndDeviceLinkStats:output_type -> google.protobuf.Empty
+	26,  // 137: ttn.lorawan.v3.Ns.GetEndDeviceRelayAssignment:output_type -> ttn.lorawan.v3.EndDeviceRelayAssignment
+	26,  // 138: ttn.lorawan.v3.Ns.ApproveEndDeviceRelayAssignment:output_type -> ttn.lorawan.v3.EndDeviceRelayAssignment
+	68,  // 139: ttn.lorawan.v3.Ns.RejectEndDeviceRelayAssignment:output_type -> google.protobuf.Empty
+	29,  // 140: ttn.lorawan.v3.Ns.GetEndDeviceFCntResync:output_type -> ttn.lorawan.v3.EndDeviceFCntResync
+	29,  // 141: ttn.lorawan.v3.Ns.ResyncEndDeviceFCnt:output_type -> ttn.lorawan.v3.EndDeviceFCntResync
+	30,  // 142: ttn.lorawan.v3.Ns.GetGatewayClassBCapacity:output_type -> ttn.lorawan.v3.GatewayClassBCapacity
+	31,  // 143: ttn.lorawan.v3.Ns.GetEndDeviceRejoin:output_type -> ttn.lorawan.v3.EndDeviceRejoin
+	31,  // 144: ttn.lorawan.v3.Ns.ForceEndDeviceRejoin:output_type -> ttn.lorawan.v3.EndDeviceRejoin
+	31,  // 145: ttn.lorawan.v3.Ns.CancelEndDeviceForceRejoin:output_type -> ttn.lorawan.v3.EndDeviceRejoin
+	35,  // 146: ttn.lorawan.v3.Ns.ForceEndDevicesRejoin:output_type -> ttn.lorawan.v3.ForceEndDevicesRejoinResponse
+	31,  // 147: ttn.lorawan.v3.Ns.SetEndDeviceRejoinPolicy:output_type -> ttn.lorawan.v3.EndDeviceRejoin
+	31,  // 148: ttn.lorawan.v3.Ns.DeleteEndDeviceRejoinPolicy:output_type -> ttn.lorawan.v3.EndDeviceRejoin
+	20,  // 149: ttn.lorawan.v3.Ns.ExportEndDevices:output_type -> ttn.lorawan.v3.ExportEndDevicesResponse
+	22,  // 150: ttn.lorawan.v3.Ns.ImportEndDevices:output_type -> ttn.lorawan.v3.ImportEndDevicesResponse
+	24,  // 151: ttn.lorawan.v3.Ns.SimulateMAC:output_type -> ttn.lorawan.v3.MACSimulation
+	68,  // 152: ttn.lorawan.v3.AsNs.DownlinkQueueReplace:output_type -> google.protobuf.Empty
+	68,  // 153: ttn.lorawan.v3.AsNs.DownlinkQueuePush:output_type -> google.protobuf.Empty
+	77,  // 154: ttn.lorawan.v3.AsNs.DownlinkQueueList:output_type -> ttn.lorawan.v3.ApplicationDownlinks
+	68,  // 155: ttn.lorawan.v3.GsNs.HandleUplink:output_type -> google.protobuf.Empty
+	68,  // 156: ttn.lorawan.v3.GsNs.ReportTxAcknowledgment:output_type -> google.protobuf.Empty
+	68,  // 157: ttn.lorawan.v3.NsPacketSource.HandleUplink:output_type -> google.protobuf.Empty
+	56,  // 158: ttn.lorawan.v3.NsEndDeviceRegistry.Get:output_type -> ttn.lorawan.v3.EndDevice
+	56,  // 159: ttn.lorawan.v3.NsEndDeviceRegistry.Set:output_type -> ttn.lorawan.v3.EndDevice
+	56,  // 160: ttn.lorawan.v3.NsEndDeviceRegistry.ResetFactoryDefaults:output_type -> ttn.lorawan.v3.EndDevice
+	68,  // 161: ttn.lorawan.v3.NsEndDeviceRegistry.Delete:output_type -> google.protobuf.Empty
+	68,  // 162: ttn.lorawan.v3.NsEndDeviceBatchRegistry.Delete:output_type -> google.protobuf.Empty
+	122, // [122:163] is the sub-list for method output_type
+	81,  // [81:122] is the sub-list for method input_type
+	81,  // [81:81] is the sub-list for extension type_name
+	81,  // [81:81] is the sub-list for extension extendee
+	0,   // [0:81] is the sub-list for field type_name
+}
+
+func init() { file_ttn_lorawan_v3_networkserver_proto_init() }
+func file_ttn_lorawan_v3_networkserver_proto_init() {
+	if File_ttn_lorawan_v3_networkserver_proto != nil {
+		return
+	}
+	file_ttn_lorawan_v3_end_device_proto_init()
+	file_ttn_lorawan_v3_error_proto_init()
+	file_ttn_lorawan_v3_identifiers_proto_init()
+	file_ttn_lorawan_v3_lorawan_proto_init()
+	file_ttn_lorawan_v3_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateDevAddrResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDefaultMACSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeviceAdressPrefixesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevAddrPrefixUtilization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDevAddrPrefixUtilizationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceHandover); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartEndDeviceHandoverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MulticastGroupMembers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MulticastGroupGateways); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownlinkBudget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownlinkBudgets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEndDeviceDownlinkBudgetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApplicationDownlinkBudgetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceLinkStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportEndDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportEndDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEndDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEndDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateMACRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MACSimulation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayAssignmentSuggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceRelayAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FCntRejection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FCntResyncRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceFCntResync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayClassBCapacity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceRejoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceEndDeviceRejoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEndDeviceRejoinPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceEndDevicesRejoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceEndDevicesRejoinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceLinkStats_Percentiles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceLinkStats_GatewayDiversity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateMACRequest_Uplink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MACSimulation_Step); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayClassBCapacity_BeaconPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceRejoin_ForceRejoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceRejoin_Policy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndDeviceRejoin_Rejoin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_networkserver_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceEndDevicesRejoinResponse_Result); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -614,13 +5066,14 @@ func file_ttn_lorawan_v3_networkserver_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ttn_lorawan_v3_networkserver_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      4,
+			NumMessages:   44,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   6,
 		},
 		GoTypes:           file_ttn_lorawan_v3_networkserver_proto_goTypes,
 		DependencyIndexes: file_ttn_lorawan_v3_networkserver_proto_depIdxs,
+		EnumInfos:         file_ttn_lorawan_v3_networkserver_proto_enumTypes,
 		MessageInfos:      file_ttn_lorawan_v3_networkserver_proto_msgTypes,
 	}.Build()
 	File_ttn_lorawan_v3_networkserver_proto = out.File
@@ -93,3 +93,2307 @@ func (dst *GetDeviceAdressPrefixesResponse) SetFields(src *GetDeviceAdressPrefix
 	}
 	return nil
 }
+
+func (dst *DevAddrPrefixUtilization) SetFields(src *DevAddrPrefixUtilization, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "prefix":
+			if len(subs) > 0 {
+				var newDst, newSrc *DevAddrPrefix
+				if (src == nil || src.Prefix == nil) && dst.Prefix == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Prefix
+				}
+				if dst.Prefix != nil {
+					newDst = dst.Prefix
+				} else {
+					newDst = &DevAddrPrefix{}
+					dst.Prefix = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Prefix = src.Prefix
+				} else {
+					dst.Prefix = nil
+				}
+			}
+		case "addresses":
+			if len(subs) > 0 {
+				return fmt.Errorf("'addresses' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Addresses = src.Addresses
+			} else {
+				var zero uint64
+				dst.Addresses = zero
+			}
+		case "used_addresses":
+			if len(subs) > 0 {
+				return fmt.Errorf("'used_addresses' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UsedAddresses = src.UsedAddresses
+			} else {
+				var zero uint64
+				dst.UsedAddresses = zero
+			}
+		case "shared_addresses":
+			if len(subs) > 0 {
+				return fmt.Errorf("'shared_addresses' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SharedAddresses = src.SharedAddresses
+			} else {
+				var zero uint64
+				dst.SharedAddresses = zero
+			}
+		case "sessions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sessions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Sessions = src.Sessions
+			} else {
+				var zero uint64
+				dst.Sessions = zero
+			}
+		case "max_sessions_per_address":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_sessions_per_address' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxSessionsPerAddress = src.MaxSessionsPerAddress
+			} else {
+				var zero uint64
+				dst.MaxSessionsPerAddress = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetDevAddrPrefixUtilizationResponse) SetFields(src *GetDevAddrPrefixUtilizationResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "prefixes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'prefixes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Prefixes = src.Prefixes
+			} else {
+				dst.Prefixes = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceHandover) SetFields(src *EndDeviceHandover, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "role":
+			if len(subs) > 0 {
+				return fmt.Errorf("'role' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Role = src.Role
+			} else {
+				dst.Role = 0
+			}
+		case "partner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *NetworkIdentifiers
+				if (src == nil || src.PartnerIds == nil) && dst.PartnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.PartnerIds
+				}
+				if dst.PartnerIds != nil {
+					newDst = dst.PartnerIds
+				} else {
+					newDst = &NetworkIdentifiers{}
+					dst.PartnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.PartnerIds = src.PartnerIds
+				} else {
+					dst.PartnerIds = nil
+				}
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *StartEndDeviceHandoverRequest) SetFields(src *StartEndDeviceHandoverRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "serving_network_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *NetworkIdentifiers
+				if (src == nil || src.ServingNetworkIds == nil) && dst.ServingNetworkIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ServingNetworkIds
+				}
+				if dst.ServingNetworkIds != nil {
+					newDst = dst.ServingNetworkIds
+				} else {
+					newDst = &NetworkIdentifiers{}
+					dst.ServingNetworkIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ServingNetworkIds = src.ServingNetworkIds
+				} else {
+					dst.ServingNetworkIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MulticastGroupMembers) SetFields(src *MulticastGroupMembers, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "member_device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'member_device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MemberDeviceIds = src.MemberDeviceIds
+			} else {
+				dst.MemberDeviceIds = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MulticastGroupGateways) SetFields(src *MulticastGroupGateways, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateways":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateways' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Gateways = src.Gateways
+			} else {
+				dst.Gateways = nil
+			}
+		case "member_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'member_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MemberCount = src.MemberCount
+			} else {
+				var zero uint32
+				dst.MemberCount = zero
+			}
+		case "covered_member_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'covered_member_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CoveredMemberCount = src.CoveredMemberCount
+			} else {
+				var zero uint32
+				dst.CoveredMemberCount = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DownlinkBudget) SetFields(src *DownlinkBudget, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "scope":
+			if len(subs) > 0 {
+				return fmt.Errorf("'scope' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Scope = src.Scope
+			} else {
+				dst.Scope = 0
+			}
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Id = src.Id
+			} else {
+				var zero string
+				dst.Id = zero
+			}
+		case "airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Airtime = src.Airtime
+			} else {
+				dst.Airtime = nil
+			}
+		case "airtime_limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'airtime_limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AirtimeLimit = src.AirtimeLimit
+			} else {
+				dst.AirtimeLimit = nil
+			}
+		case "downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Downlinks = src.Downlinks
+			} else {
+				var zero uint64
+				dst.Downlinks = zero
+			}
+		case "downlinks_limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlinks_limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinksLimit = src.DownlinksLimit
+			} else {
+				var zero uint64
+				dst.DownlinksLimit = zero
+			}
+		case "exhausted":
+			if len(subs) > 0 {
+				return fmt.Errorf("'exhausted' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Exhausted = src.Exhausted
+			} else {
+				var zero bool
+				dst.Exhausted = zero
+			}
+		case "reset_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reset_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ResetAt = src.ResetAt
+			} else {
+				dst.ResetAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DownlinkBudgets) SetFields(src *DownlinkBudgets, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "budgets":
+			if len(subs) > 0 {
+				return fmt.Errorf("'budgets' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Budgets = src.Budgets
+			} else {
+				dst.Budgets = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetEndDeviceDownlinkBudgetsRequest) SetFields(src *GetEndDeviceDownlinkBudgetsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetApplicationDownlinkBudgetsRequest) SetFields(src *GetApplicationDownlinkBudgetsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceLinkStats) SetFields(src *EndDeviceLinkStats, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "sample_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sample_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SampleCount = src.SampleCount
+			} else {
+				var zero uint32
+				dst.SampleCount = zero
+			}
+		case "first_uplink_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'first_uplink_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FirstUplinkAt = src.FirstUplinkAt
+			} else {
+				dst.FirstUplinkAt = nil
+			}
+		case "last_uplink_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_uplink_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastUplinkAt = src.LastUplinkAt
+			} else {
+				dst.LastUplinkAt = nil
+			}
+		case "last_f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastFCnt = src.LastFCnt
+			} else {
+				var zero uint32
+				dst.LastFCnt = zero
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint32
+				dst.UplinkCount = zero
+			}
+		case "missed_uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'missed_uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MissedUplinkCount = src.MissedUplinkCount
+			} else {
+				var zero uint32
+				dst.MissedUplinkCount = zero
+			}
+		case "retransmission_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'retransmission_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RetransmissionCount = src.RetransmissionCount
+			} else {
+				var zero uint32
+				dst.RetransmissionCount = zero
+			}
+		case "packet_error_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'packet_error_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PacketErrorRate = src.PacketErrorRate
+			} else {
+				var zero float32
+				dst.PacketErrorRate = zero
+			}
+		case "snr":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceLinkStats_Percentiles
+				if (src == nil || src.Snr == nil) && dst.Snr == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Snr
+				}
+				if dst.Snr != nil {
+					newDst = dst.Snr
+				} else {
+					newDst = &EndDeviceLinkStats_Percentiles{}
+					dst.Snr = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Snr = src.Snr
+				} else {
+					dst.Snr = nil
+				}
+			}
+		case "rssi":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceLinkStats_Percentiles
+				if (src == nil || src.Rssi == nil) && dst.Rssi == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Rssi
+				}
+				if dst.Rssi != nil {
+					newDst = dst.Rssi
+				} else {
+					newDst = &EndDeviceLinkStats_Percentiles{}
+					dst.Rssi = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Rssi = src.Rssi
+				} else {
+					dst.Rssi = nil
+				}
+			}
+		case "data_rates":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rates' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRates = src.DataRates
+			} else {
+				dst.DataRates = nil
+			}
+		case "gateway_diversity":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceLinkStats_GatewayDiversity
+				if (src == nil || src.GatewayDiversity == nil) && dst.GatewayDiversity == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayDiversity
+				}
+				if dst.GatewayDiversity != nil {
+					newDst = dst.GatewayDiversity
+				} else {
+					newDst = &EndDeviceLinkStats_GatewayDiversity{}
+					dst.GatewayDiversity = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayDiversity = src.GatewayDiversity
+				} else {
+					dst.GatewayDiversity = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ExportEndDevicesRequest) SetFields(src *ExportEndDevicesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "kek_label":
+			if len(subs) > 0 {
+				return fmt.Errorf("'kek_label' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.KekLabel = src.KekLabel
+			} else {
+				var zero string
+				dst.KekLabel = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ExportEndDevicesResponse) SetFields(src *ExportEndDevicesResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_devices":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end_devices' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndDevices = src.EndDevices
+			} else {
+				dst.EndDevices = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ImportEndDevicesRequest) SetFields(src *ImportEndDevicesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "end_devices":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end_devices' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndDevices = src.EndDevices
+			} else {
+				dst.EndDevices = nil
+			}
+		case "replace":
+			if len(subs) > 0 {
+				return fmt.Errorf("'replace' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Replace = src.Replace
+			} else {
+				var zero bool
+				dst.Replace = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ImportEndDevicesResponse) SetFields(src *ImportEndDevicesResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "imported":
+			if len(subs) > 0 {
+				return fmt.Errorf("'imported' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Imported = src.Imported
+			} else {
+				var zero uint32
+				dst.Imported = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SimulateMACRequest) SetFields(src *SimulateMACRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "end_device":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDevice
+				if (src == nil || src.EndDevice == nil) && dst.EndDevice == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDevice
+				}
+				if dst.EndDevice != nil {
+					newDst = dst.EndDevice
+				} else {
+					newDst = &EndDevice{}
+					dst.EndDevice = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDevice = src.EndDevice
+				} else {
+					dst.EndDevice = nil
+				}
+			}
+		case "uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Uplinks = src.Uplinks
+			} else {
+				dst.Uplinks = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACSimulation) SetFields(src *MACSimulation, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "steps":
+			if len(subs) > 0 {
+				return fmt.Errorf("'steps' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Steps = src.Steps
+			} else {
+				dst.Steps = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RelayAssignmentSuggestion) SetFields(src *RelayAssignmentSuggestion, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "action":
+			if len(subs) > 0 {
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Action = src.Action
+			} else {
+				dst.Action = 0
+			}
+		case "serving_device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'serving_device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ServingDeviceId = src.ServingDeviceId
+			} else {
+				var zero string
+				dst.ServingDeviceId = zero
+			}
+		case "reason":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reason' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reason = src.Reason
+			} else {
+				var zero string
+				dst.Reason = zero
+			}
+		case "suggested_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'suggested_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SuggestedAt = src.SuggestedAt
+			} else {
+				dst.SuggestedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceRelayAssignment) SetFields(src *EndDeviceRelayAssignment, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "relayed_uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'relayed_uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RelayedUplinks = src.RelayedUplinks
+			} else {
+				var zero uint32
+				dst.RelayedUplinks = zero
+			}
+		case "poor_uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'poor_uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PoorUplinks = src.PoorUplinks
+			} else {
+				var zero uint32
+				dst.PoorUplinks = zero
+			}
+		case "covered_uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'covered_uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CoveredUplinks = src.CoveredUplinks
+			} else {
+				var zero uint32
+				dst.CoveredUplinks = zero
+			}
+		case "serving_device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'serving_device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ServingDeviceId = src.ServingDeviceId
+			} else {
+				var zero string
+				dst.ServingDeviceId = zero
+			}
+		case "suggestion":
+			if len(subs) > 0 {
+				var newDst, newSrc *RelayAssignmentSuggestion
+				if (src == nil || src.Suggestion == nil) && dst.Suggestion == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Suggestion
+				}
+				if dst.Suggestion != nil {
+					newDst = dst.Suggestion
+				} else {
+					newDst = &RelayAssignmentSuggestion{}
+					dst.Suggestion = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Suggestion = src.Suggestion
+				} else {
+					dst.Suggestion = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *FCntRejection) SetFields(src *FCntRejection, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCnt = src.FCnt
+			} else {
+				var zero uint32
+				dst.FCnt = zero
+			}
+		case "last_f_cnt_up":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_f_cnt_up' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastFCntUp = src.LastFCntUp
+			} else {
+				var zero uint32
+				dst.LastFCntUp = zero
+			}
+		case "reason":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reason' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reason = src.Reason
+			} else {
+				dst.Reason = 0
+			}
+		case "received_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'received_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReceivedAt = src.ReceivedAt
+			} else {
+				dst.ReceivedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *FCntResyncRecord) SetFields(src *FCntResyncRecord, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "last_f_cnt_up":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_f_cnt_up' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastFCntUp = src.LastFCntUp
+			} else {
+				var zero uint32
+				dst.LastFCntUp = zero
+			}
+		case "f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCnt = src.FCnt
+			} else {
+				var zero uint32
+				dst.FCnt = zero
+			}
+		case "reason":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reason' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reason = src.Reason
+			} else {
+				dst.Reason = 0
+			}
+		case "rejected_uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rejected_uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RejectedUplinks = src.RejectedUplinks
+			} else {
+				var zero uint32
+				dst.RejectedUplinks = zero
+			}
+		case "automatic":
+			if len(subs) > 0 {
+				return fmt.Errorf("'automatic' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Automatic = src.Automatic
+			} else {
+				var zero bool
+				dst.Automatic = zero
+			}
+		case "resynced_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'resynced_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ResyncedAt = src.ResyncedAt
+			} else {
+				dst.ResyncedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceFCntResync) SetFields(src *EndDeviceFCntResync, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "desynchronized":
+			if len(subs) > 0 {
+				return fmt.Errorf("'desynchronized' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Desynchronized = src.Desynchronized
+			} else {
+				var zero bool
+				dst.Desynchronized = zero
+			}
+		case "auto_resync":
+			if len(subs) > 0 {
+				return fmt.Errorf("'auto_resync' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AutoResync = src.AutoResync
+			} else {
+				var zero bool
+				dst.AutoResync = zero
+			}
+		case "rejections":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rejections' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rejections = src.Rejections
+			} else {
+				dst.Rejections = nil
+			}
+		case "audit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'audit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Audit = src.Audit
+			} else {
+				dst.Audit = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayClassBCapacity) SetFields(src *GatewayClassBCapacity, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "device_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceCount = src.DeviceCount
+			} else {
+				var zero uint32
+				dst.DeviceCount = zero
+			}
+		case "ping_slot_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ping_slot_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PingSlotCount = src.PingSlotCount
+			} else {
+				var zero uint32
+				dst.PingSlotCount = zero
+			}
+		case "devices_by_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'devices_by_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevicesByFrequency = src.DevicesByFrequency
+			} else {
+				dst.DevicesByFrequency = nil
+			}
+		case "beacon_periods":
+			if len(subs) > 0 {
+				return fmt.Errorf("'beacon_periods' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BeaconPeriods = src.BeaconPeriods
+			} else {
+				dst.BeaconPeriods = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceRejoin) SetFields(src *EndDeviceRejoin, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "force_rejoin":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceRejoin_ForceRejoin
+				if (src == nil || src.ForceRejoin == nil) && dst.ForceRejoin == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ForceRejoin
+				}
+				if dst.ForceRejoin != nil {
+					newDst = dst.ForceRejoin
+				} else {
+					newDst = &EndDeviceRejoin_ForceRejoin{}
+					dst.ForceRejoin = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ForceRejoin = src.ForceRejoin
+				} else {
+					dst.ForceRejoin = nil
+				}
+			}
+		case "policy":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceRejoin_Policy
+				if (src == nil || src.Policy == nil) && dst.Policy == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Policy
+				}
+				if dst.Policy != nil {
+					newDst = dst.Policy
+				} else {
+					newDst = &EndDeviceRejoin_Policy{}
+					dst.Policy = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Policy = src.Policy
+				} else {
+					dst.Policy = nil
+				}
+			}
+		case "pending_rejoin":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceRejoin_Rejoin
+				if (src == nil || src.PendingRejoin == nil) && dst.PendingRejoin == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.PendingRejoin
+				}
+				if dst.PendingRejoin != nil {
+					newDst = dst.PendingRejoin
+				} else {
+					newDst = &EndDeviceRejoin_Rejoin{}
+					dst.PendingRejoin = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.PendingRejoin = src.PendingRejoin
+				} else {
+					dst.PendingRejoin = nil
+				}
+			}
+		case "last_rejoin":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceRejoin_Rejoin
+				if (src == nil || src.LastRejoin == nil) && dst.LastRejoin == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastRejoin
+				}
+				if dst.LastRejoin != nil {
+					newDst = dst.LastRejoin
+				} else {
+					newDst = &EndDeviceRejoin_Rejoin{}
+					dst.LastRejoin = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastRejoin = src.LastRejoin
+				} else {
+					dst.LastRejoin = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ForceEndDeviceRejoinRequest) SetFields(src *ForceEndDeviceRejoinRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "force_rejoin_req":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACCommand_ForceRejoinReq
+				if (src == nil || src.ForceRejoinReq == nil) && dst.ForceRejoinReq == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ForceRejoinReq
+				}
+				if dst.ForceRejoinReq != nil {
+					newDst = dst.ForceRejoinReq
+				} else {
+					newDst = &MACCommand_ForceRejoinReq{}
+					dst.ForceRejoinReq = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ForceRejoinReq = src.ForceRejoinReq
+				} else {
+					dst.ForceRejoinReq = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetEndDeviceRejoinPolicyRequest) SetFields(src *SetEndDeviceRejoinPolicyRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "force_rejoin_req":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACCommand_ForceRejoinReq
+				if (src == nil || src.ForceRejoinReq == nil) && dst.ForceRejoinReq == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ForceRejoinReq
+				}
+				if dst.ForceRejoinReq != nil {
+					newDst = dst.ForceRejoinReq
+				} else {
+					newDst = &MACCommand_ForceRejoinReq{}
+					dst.ForceRejoinReq = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ForceRejoinReq = src.ForceRejoinReq
+				} else {
+					dst.ForceRejoinReq = nil
+				}
+			}
+		case "interval":
+			if len(subs) > 0 {
+				return fmt.Errorf("'interval' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Interval = src.Interval
+			} else {
+				dst.Interval = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ForceEndDevicesRejoinRequest) SetFields(src *ForceEndDevicesRejoinRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIds = src.DeviceIds
+			} else {
+				dst.DeviceIds = nil
+			}
+		case "force_rejoin_req":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACCommand_ForceRejoinReq
+				if (src == nil || src.ForceRejoinReq == nil) && dst.ForceRejoinReq == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ForceRejoinReq
+				}
+				if dst.ForceRejoinReq != nil {
+					newDst = dst.ForceRejoinReq
+				} else {
+					newDst = &MACCommand_ForceRejoinReq{}
+					dst.ForceRejoinReq = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ForceRejoinReq = src.ForceRejoinReq
+				} else {
+					dst.ForceRejoinReq = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ForceEndDevicesRejoinResponse) SetFields(src *ForceEndDevicesRejoinResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "results":
+			if len(subs) > 0 {
+				return fmt.Errorf("'results' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Results = src.Results
+			} else {
+				dst.Results = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceLinkStats_Percentiles) SetFields(src *EndDeviceLinkStats_Percentiles, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Min = src.Min
+			} else {
+				var zero float32
+				dst.Min = zero
+			}
+		case "p10":
+			if len(subs) > 0 {
+				return fmt.Errorf("'p10' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.P10 = src.P10
+			} else {
+				var zero float32
+				dst.P10 = zero
+			}
+		case "median":
+			if len(subs) > 0 {
+				return fmt.Errorf("'median' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Median = src.Median
+			} else {
+				var zero float32
+				dst.Median = zero
+			}
+		case "p90":
+			if len(subs) > 0 {
+				return fmt.Errorf("'p90' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.P90 = src.P90
+			} else {
+				var zero float32
+				dst.P90 = zero
+			}
+		case "max":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Max = src.Max
+			} else {
+				var zero float32
+				dst.Max = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceLinkStats_GatewayDiversity) SetFields(src *EndDeviceLinkStats_GatewayDiversity, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateways":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateways' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Gateways = src.Gateways
+			} else {
+				dst.Gateways = nil
+			}
+		case "min":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Min = src.Min
+			} else {
+				var zero uint32
+				dst.Min = zero
+			}
+		case "mean":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mean' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mean = src.Mean
+			} else {
+				var zero float32
+				dst.Mean = zero
+			}
+		case "max":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Max = src.Max
+			} else {
+				var zero uint32
+				dst.Max = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SimulateMACRequest_Uplink) SetFields(src *SimulateMACRequest_Uplink, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "received_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'received_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReceivedAt = src.ReceivedAt
+			} else {
+				dst.ReceivedAt = nil
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				var newDst, newSrc *DataRateIndexValue
+				if (src == nil || src.DataRateIndex == nil) && dst.DataRateIndex == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DataRateIndex
+				}
+				if dst.DataRateIndex != nil {
+					newDst = dst.DataRateIndex
+				} else {
+					newDst = &DataRateIndexValue{}
+					dst.DataRateIndex = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DataRateIndex = src.DataRateIndex
+				} else {
+					dst.DataRateIndex = nil
+				}
+			}
+		case "channel_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelIndex = src.ChannelIndex
+			} else {
+				dst.ChannelIndex = nil
+			}
+		case "snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Snr = src.Snr
+			} else {
+				var zero float32
+				dst.Snr = zero
+			}
+		case "rssi":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rssi' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rssi = src.Rssi
+			} else {
+				var zero float32
+				dst.Rssi = zero
+			}
+		case "gateway_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayCount = src.GatewayCount
+			} else {
+				var zero uint32
+				dst.GatewayCount = zero
+			}
+		case "adr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'adr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Adr = src.Adr
+			} else {
+				dst.Adr = nil
+			}
+		case "adr_ack_req":
+			if len(subs) > 0 {
+				return fmt.Errorf("'adr_ack_req' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AdrAckReq = src.AdrAckReq
+			} else {
+				var zero bool
+				dst.AdrAckReq = zero
+			}
+		case "confirmed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Confirmed = src.Confirmed
+			} else {
+				var zero bool
+				dst.Confirmed = zero
+			}
+		case "mac_commands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mac_commands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MacCommands = src.MacCommands
+			} else {
+				dst.MacCommands = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACSimulation_Step) SetFields(src *MACSimulation_Step, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCnt = src.FCnt
+			} else {
+				var zero uint32
+				dst.FCnt = zero
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				dst.DataRateIndex = 0
+			}
+		case "channel_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelIndex = src.ChannelIndex
+			} else {
+				var zero uint32
+				dst.ChannelIndex = zero
+			}
+		case "events":
+			if len(subs) > 0 {
+				return fmt.Errorf("'events' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Events = src.Events
+			} else {
+				dst.Events = nil
+			}
+		case "adr_decision":
+			if len(subs) > 0 {
+				return fmt.Errorf("'adr_decision' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AdrDecision = src.AdrDecision
+			} else {
+				dst.AdrDecision = nil
+			}
+		case "downlink_mac_commands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_mac_commands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkMacCommands = src.DownlinkMacCommands
+			} else {
+				dst.DownlinkMacCommands = nil
+			}
+		case "f_pending":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_pending' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPending = src.FPending
+			} else {
+				var zero bool
+				dst.FPending = zero
+			}
+		case "mac_state":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACState
+				if (src == nil || src.MacState == nil) && dst.MacState == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.MacState
+				}
+				if dst.MacState != nil {
+					newDst = dst.MacState
+				} else {
+					newDst = &MACState{}
+					dst.MacState = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.MacState = src.MacState
+				} else {
+					dst.MacState = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayClassBCapacity_BeaconPeriod) SetFields(src *GatewayClassBCapacity_BeaconPeriod, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "starts_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'starts_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartsAt = src.StartsAt
+			} else {
+				dst.StartsAt = nil
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint32
+				dst.DownlinkCount = zero
+			}
+		case "airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Airtime = src.Airtime
+			} else {
+				dst.Airtime = nil
+			}
+		case "occupancy":
+			if len(subs) > 0 {
+				return fmt.Errorf("'occupancy' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Occupancy = src.Occupancy
+			} else {
+				var zero float32
+				dst.Occupancy = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceRejoin_ForceRejoin) SetFields(src *EndDeviceRejoin_ForceRejoin, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "force_rejoin_req":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACCommand_ForceRejoinReq
+				if (src == nil || src.ForceRejoinReq == nil) && dst.ForceRejoinReq == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ForceRejoinReq
+				}
+				if dst.ForceRejoinReq != nil {
+					newDst = dst.ForceRejoinReq
+				} else {
+					newDst = &MACCommand_ForceRejoinReq{}
+					dst.ForceRejoinReq = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ForceRejoinReq = src.ForceRejoinReq
+				} else {
+					dst.ForceRejoinReq = nil
+				}
+			}
+		case "periodic":
+			if len(subs) > 0 {
+				return fmt.Errorf("'periodic' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Periodic = src.Periodic
+			} else {
+				var zero bool
+				dst.Periodic = zero
+			}
+		case "issued_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'issued_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.IssuedAt = src.IssuedAt
+			} else {
+				dst.IssuedAt = nil
+			}
+		case "transmissions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'transmissions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Transmissions = src.Transmissions
+			} else {
+				var zero uint32
+				dst.Transmissions = zero
+			}
+		case "sent_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sent_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SentAt = src.SentAt
+			} else {
+				dst.SentAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceRejoin_Policy) SetFields(src *EndDeviceRejoin_Policy, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "force_rejoin_req":
+			if len(subs) > 0 {
+				var newDst, newSrc *MACCommand_ForceRejoinReq
+				if (src == nil || src.ForceRejoinReq == nil) && dst.ForceRejoinReq == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ForceRejoinReq
+				}
+				if dst.ForceRejoinReq != nil {
+					newDst = dst.ForceRejoinReq
+				} else {
+					newDst = &MACCommand_ForceRejoinReq{}
+					dst.ForceRejoinReq = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ForceRejoinReq = src.ForceRejoinReq
+				} else {
+					dst.ForceRejoinReq = nil
+				}
+			}
+		case "interval":
+			if len(subs) > 0 {
+				return fmt.Errorf("'interval' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Interval = src.Interval
+			} else {
+				dst.Interval = nil
+			}
+		case "set_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'set_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SetAt = src.SetAt
+			} else {
+				dst.SetAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceRejoin_Rejoin) SetFields(src *EndDeviceRejoin_Rejoin, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "rejoin_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rejoin_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RejoinType = src.RejoinType
+			} else {
+				dst.RejoinType = 0
+			}
+		case "forced":
+			if len(subs) > 0 {
+				return fmt.Errorf("'forced' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Forced = src.Forced
+			} else {
+				var zero bool
+				dst.Forced = zero
+			}
+		case "periodic":
+			if len(subs) > 0 {
+				return fmt.Errorf("'periodic' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Periodic = src.Periodic
+			} else {
+				var zero bool
+				dst.Periodic = zero
+			}
+		case "received_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'received_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReceivedAt = src.ReceivedAt
+			} else {
+				dst.ReceivedAt = nil
+			}
+		case "completed_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'completed_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CompletedAt = src.CompletedAt
+			} else {
+				dst.CompletedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ForceEndDevicesRejoinResponse_Result) SetFields(src *ForceEndDevicesRejoinResponse_Result, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceId = src.DeviceId
+			} else {
+				var zero string
+				dst.DeviceId = zero
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
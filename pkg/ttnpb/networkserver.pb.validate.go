@@ -413,3 +413,4256 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetDeviceAdressPrefixesResponseValidationError{}
+
+// ValidateFields checks the field values on DevAddrPrefixUtilization with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DevAddrPrefixUtilization) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DevAddrPrefixUtilizationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "prefix":
+
+			if v, ok := interface{}(m.GetPrefix()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DevAddrPrefixUtilizationValidationError{
+						field:  "prefix",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "addresses":
+			// no validation rules for Addresses
+		case "used_addresses":
+			// no validation rules for UsedAddresses
+		case "shared_addresses":
+			// no validation rules for SharedAddresses
+		case "sessions":
+			// no validation rules for Sessions
+		case "max_sessions_per_address":
+			// no validation rules for MaxSessionsPerAddress
+		default:
+			return DevAddrPrefixUtilizationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DevAddrPrefixUtilizationValidationError is the validation error returned by
+// DevAddrPrefixUtilization.ValidateFields if the designated constraints
+// aren't met.
+type DevAddrPrefixUtilizationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DevAddrPrefixUtilizationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DevAddrPrefixUtilizationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DevAddrPrefixUtilizationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DevAddrPrefixUtilizationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DevAddrPrefixUtilizationValidationError) ErrorName() string {
+	return "DevAddrPrefixUtilizationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DevAddrPrefixUtilizationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDevAddrPrefixUtilization.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DevAddrPrefixUtilizationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DevAddrPrefixUtilizationValidationError{}
+
+// ValidateFields checks the field values on
+// GetDevAddrPrefixUtilizationResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GetDevAddrPrefixUtilizationResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetDevAddrPrefixUtilizationResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "prefixes":
+
+			for idx, item := range m.GetPrefixes() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GetDevAddrPrefixUtilizationResponseValidationError{
+							field:  fmt.Sprintf("prefixes[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GetDevAddrPrefixUtilizationResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetDevAddrPrefixUtilizationResponseValidationError is the validation error
+// returned by GetDevAddrPrefixUtilizationResponse.ValidateFields if the
+// designated constraints aren't met.
+type GetDevAddrPrefixUtilizationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDevAddrPrefixUtilizationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDevAddrPrefixUtilizationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDevAddrPrefixUtilizationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDevAddrPrefixUtilizationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDevAddrPrefixUtilizationResponseValidationError) ErrorName() string {
+	return "GetDevAddrPrefixUtilizationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDevAddrPrefixUtilizationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDevAddrPrefixUtilizationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDevAddrPrefixUtilizationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDevAddrPrefixUtilizationResponseValidationError{}
+
+// ValidateFields checks the field values on EndDeviceHandover with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceHandover) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceHandoverFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return EndDeviceHandoverValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceHandoverValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "role":
+			// no validation rules for Role
+		case "partner_ids":
+
+			if m.GetPartnerIds() == nil {
+				return EndDeviceHandoverValidationError{
+					field:  "partner_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetPartnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceHandoverValidationError{
+						field:  "partner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceHandoverValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceHandoverValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceHandoverValidationError is the validation error returned by
+// EndDeviceHandover.ValidateFields if the designated constraints aren't met.
+type EndDeviceHandoverValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceHandoverValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceHandoverValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceHandoverValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceHandoverValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceHandoverValidationError) ErrorName() string {
+	return "EndDeviceHandoverValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceHandoverValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceHandover.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceHandoverValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceHandoverValidationError{}
+
+// ValidateFields checks the field values on StartEndDeviceHandoverRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *StartEndDeviceHandoverRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = StartEndDeviceHandoverRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return StartEndDeviceHandoverRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StartEndDeviceHandoverRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "serving_network_ids":
+
+			if m.GetServingNetworkIds() == nil {
+				return StartEndDeviceHandoverRequestValidationError{
+					field:  "serving_network_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetServingNetworkIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StartEndDeviceHandoverRequestValidationError{
+						field:  "serving_network_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return StartEndDeviceHandoverRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// StartEndDeviceHandoverRequestValidationError is the validation error
+// returned by StartEndDeviceHandoverRequest.ValidateFields if the designated
+// constraints aren't met.
+type StartEndDeviceHandoverRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartEndDeviceHandoverRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartEndDeviceHandoverRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartEndDeviceHandoverRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartEndDeviceHandoverRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartEndDeviceHandoverRequestValidationError) ErrorName() string {
+	return "StartEndDeviceHandoverRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartEndDeviceHandoverRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartEndDeviceHandoverRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartEndDeviceHandoverRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartEndDeviceHandoverRequestValidationError{}
+
+// ValidateFields checks the field values on MulticastGroupMembers with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MulticastGroupMembers) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MulticastGroupMembersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return MulticastGroupMembersValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MulticastGroupMembersValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "member_device_ids":
+
+			for idx, item := range m.GetMemberDeviceIds() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 36 {
+					return MulticastGroupMembersValidationError{
+						field:  fmt.Sprintf("member_device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_MulticastGroupMembers_MemberDeviceIds_Pattern.MatchString(item) {
+					return MulticastGroupMembersValidationError{
+						field:  fmt.Sprintf("member_device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		default:
+			return MulticastGroupMembersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MulticastGroupMembersValidationError is the validation error returned by
+// MulticastGroupMembers.ValidateFields if the designated constraints aren't met.
+type MulticastGroupMembersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MulticastGroupMembersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MulticastGroupMembersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MulticastGroupMembersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MulticastGroupMembersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MulticastGroupMembersValidationError) ErrorName() string {
+	return "MulticastGroupMembersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MulticastGroupMembersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMulticastGroupMembers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MulticastGroupMembersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MulticastGroupMembersValidationError{}
+
+var _MulticastGroupMembers_MemberDeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on MulticastGroupGateways with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MulticastGroupGateways) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MulticastGroupGatewaysFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateways":
+
+			for idx, item := range m.GetGateways() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MulticastGroupGatewaysValidationError{
+							field:  fmt.Sprintf("gateways[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "member_count":
+			// no validation rules for MemberCount
+		case "covered_member_count":
+			// no validation rules for CoveredMemberCount
+		default:
+			return MulticastGroupGatewaysValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MulticastGroupGatewaysValidationError is the validation error returned by
+// MulticastGroupGateways.ValidateFields if the designated constraints aren't met.
+type MulticastGroupGatewaysValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MulticastGroupGatewaysValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MulticastGroupGatewaysValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MulticastGroupGatewaysValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MulticastGroupGatewaysValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MulticastGroupGatewaysValidationError) ErrorName() string {
+	return "MulticastGroupGatewaysValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MulticastGroupGatewaysValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMulticastGroupGateways.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MulticastGroupGatewaysValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MulticastGroupGatewaysValidationError{}
+
+// ValidateFields checks the field values on DownlinkBudget with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DownlinkBudget) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownlinkBudgetFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "scope":
+			// no validation rules for Scope
+		case "id":
+			// no validation rules for Id
+		case "airtime":
+
+			if v, ok := interface{}(m.GetAirtime()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkBudgetValidationError{
+						field:  "airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "airtime_limit":
+
+			if v, ok := interface{}(m.GetAirtimeLimit()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkBudgetValidationError{
+						field:  "airtime_limit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlinks":
+			// no validation rules for Downlinks
+		case "downlinks_limit":
+			// no validation rules for DownlinksLimit
+		case "exhausted":
+			// no validation rules for Exhausted
+		case "reset_at":
+
+			if v, ok := interface{}(m.GetResetAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DownlinkBudgetValidationError{
+						field:  "reset_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DownlinkBudgetValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownlinkBudgetValidationError is the validation error returned by
+// DownlinkBudget.ValidateFields if the designated constraints aren't met.
+type DownlinkBudgetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownlinkBudgetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownlinkBudgetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownlinkBudgetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownlinkBudgetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownlinkBudgetValidationError) ErrorName() string { return "DownlinkBudgetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownlinkBudgetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownlinkBudget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownlinkBudgetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownlinkBudgetValidationError{}
+
+// ValidateFields checks the field values on DownlinkBudgets with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DownlinkBudgets) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DownlinkBudgetsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "budgets":
+
+			for idx, item := range m.GetBudgets() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return DownlinkBudgetsValidationError{
+							field:  fmt.Sprintf("budgets[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return DownlinkBudgetsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DownlinkBudgetsValidationError is the validation error returned by
+// DownlinkBudgets.ValidateFields if the designated constraints aren't met.
+type DownlinkBudgetsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownlinkBudgetsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownlinkBudgetsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownlinkBudgetsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownlinkBudgetsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownlinkBudgetsValidationError) ErrorName() string { return "DownlinkBudgetsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownlinkBudgetsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownlinkBudgets.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownlinkBudgetsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownlinkBudgetsValidationError{}
+
+// ValidateFields checks the field values on GetEndDeviceDownlinkBudgetsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GetEndDeviceDownlinkBudgetsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetEndDeviceDownlinkBudgetsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return GetEndDeviceDownlinkBudgetsRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetEndDeviceDownlinkBudgetsRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetEndDeviceDownlinkBudgetsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetEndDeviceDownlinkBudgetsRequestValidationError is the validation error
+// returned by GetEndDeviceDownlinkBudgetsRequest.ValidateFields if the
+// designated constraints aren't met.
+type GetEndDeviceDownlinkBudgetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEndDeviceDownlinkBudgetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEndDeviceDownlinkBudgetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEndDeviceDownlinkBudgetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEndDeviceDownlinkBudgetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEndDeviceDownlinkBudgetsRequestValidationError) ErrorName() string {
+	return "GetEndDeviceDownlinkBudgetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEndDeviceDownlinkBudgetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEndDeviceDownlinkBudgetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEndDeviceDownlinkBudgetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEndDeviceDownlinkBudgetsRequestValidationError{}
+
+// ValidateFields checks the field values on
+// GetApplicationDownlinkBudgetsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GetApplicationDownlinkBudgetsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetApplicationDownlinkBudgetsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return GetApplicationDownlinkBudgetsRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetApplicationDownlinkBudgetsRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetApplicationDownlinkBudgetsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetApplicationDownlinkBudgetsRequestValidationError is the validation error
+// returned by GetApplicationDownlinkBudgetsRequest.ValidateFields if the
+// designated constraints aren't met.
+type GetApplicationDownlinkBudgetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApplicationDownlinkBudgetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApplicationDownlinkBudgetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApplicationDownlinkBudgetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApplicationDownlinkBudgetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApplicationDownlinkBudgetsRequestValidationError) ErrorName() string {
+	return "GetApplicationDownlinkBudgetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApplicationDownlinkBudgetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApplicationDownlinkBudgetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApplicationDownlinkBudgetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApplicationDownlinkBudgetsRequestValidationError{}
+
+// ValidateFields checks the field values on EndDeviceLinkStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceLinkStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceLinkStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "sample_count":
+			// no validation rules for SampleCount
+		case "first_uplink_at":
+
+			if v, ok := interface{}(m.GetFirstUplinkAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkStatsValidationError{
+						field:  "first_uplink_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_uplink_at":
+
+			if v, ok := interface{}(m.GetLastUplinkAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkStatsValidationError{
+						field:  "last_uplink_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_f_cnt":
+			// no validation rules for LastFCnt
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "missed_uplink_count":
+			// no validation rules for MissedUplinkCount
+		case "retransmission_count":
+			// no validation rules for RetransmissionCount
+		case "packet_error_rate":
+			// no validation rules for PacketErrorRate
+		case "snr":
+
+			if v, ok := interface{}(m.GetSnr()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkStatsValidationError{
+						field:  "snr",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rssi":
+
+			if v, ok := interface{}(m.GetRssi()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkStatsValidationError{
+						field:  "rssi",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "data_rates":
+			// no validation rules for DataRates
+		case "gateway_diversity":
+
+			if v, ok := interface{}(m.GetGatewayDiversity()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceLinkStatsValidationError{
+						field:  "gateway_diversity",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceLinkStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceLinkStatsValidationError is the validation error returned by
+// EndDeviceLinkStats.ValidateFields if the designated constraints aren't met.
+type EndDeviceLinkStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceLinkStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceLinkStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceLinkStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceLinkStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceLinkStatsValidationError) ErrorName() string {
+	return "EndDeviceLinkStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceLinkStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceLinkStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceLinkStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceLinkStatsValidationError{}
+
+// ValidateFields checks the field values on ExportEndDevicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportEndDevicesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ExportEndDevicesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return ExportEndDevicesRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ExportEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "kek_label":
+
+			if l := utf8.RuneCountInString(m.GetKekLabel()); l < 1 || l > 2048 {
+				return ExportEndDevicesRequestValidationError{
+					field:  "kek_label",
+					reason: "value length must be between 1 and 2048 runes, inclusive",
+				}
+			}
+
+		default:
+			return ExportEndDevicesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ExportEndDevicesRequestValidationError is the validation error returned by
+// ExportEndDevicesRequest.ValidateFields if the designated constraints aren't met.
+type ExportEndDevicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportEndDevicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportEndDevicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportEndDevicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportEndDevicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportEndDevicesRequestValidationError) ErrorName() string {
+	return "ExportEndDevicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportEndDevicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportEndDevicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportEndDevicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportEndDevicesRequestValidationError{}
+
+// ValidateFields checks the field values on ExportEndDevicesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportEndDevicesResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ExportEndDevicesResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_devices":
+
+			for idx, item := range m.GetEndDevices() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ExportEndDevicesResponseValidationError{
+							field:  fmt.Sprintf("end_devices[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ExportEndDevicesResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ExportEndDevicesResponseValidationError is the validation error returned by
+// ExportEndDevicesResponse.ValidateFields if the designated constraints
+// aren't met.
+type ExportEndDevicesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportEndDevicesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportEndDevicesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportEndDevicesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportEndDevicesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportEndDevicesResponseValidationError) ErrorName() string {
+	return "ExportEndDevicesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportEndDevicesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportEndDevicesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportEndDevicesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportEndDevicesResponseValidationError{}
+
+// ValidateFields checks the field values on ImportEndDevicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportEndDevicesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ImportEndDevicesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return ImportEndDevicesRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ImportEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_devices":
+
+			for idx, item := range m.GetEndDevices() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ImportEndDevicesRequestValidationError{
+							field:  fmt.Sprintf("end_devices[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "replace":
+			// no validation rules for Replace
+		default:
+			return ImportEndDevicesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ImportEndDevicesRequestValidationError is the validation error returned by
+// ImportEndDevicesRequest.ValidateFields if the designated constraints aren't met.
+type ImportEndDevicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportEndDevicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportEndDevicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportEndDevicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportEndDevicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportEndDevicesRequestValidationError) ErrorName() string {
+	return "ImportEndDevicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportEndDevicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportEndDevicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportEndDevicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportEndDevicesRequestValidationError{}
+
+// ValidateFields checks the field values on ImportEndDevicesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ImportEndDevicesResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ImportEndDevicesResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "imported":
+			// no validation rules for Imported
+		default:
+			return ImportEndDevicesResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ImportEndDevicesResponseValidationError is the validation error returned by
+// ImportEndDevicesResponse.ValidateFields if the designated constraints
+// aren't met.
+type ImportEndDevicesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportEndDevicesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportEndDevicesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportEndDevicesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportEndDevicesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportEndDevicesResponseValidationError) ErrorName() string {
+	return "ImportEndDevicesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportEndDevicesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportEndDevicesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportEndDevicesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportEndDevicesResponseValidationError{}
+
+// ValidateFields checks the field values on SimulateMACRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SimulateMACRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SimulateMACRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return SimulateMACRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SimulateMACRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_device":
+
+			if v, ok := interface{}(m.GetEndDevice()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SimulateMACRequestValidationError{
+						field:  "end_device",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplinks":
+
+			if l := len(m.GetUplinks()); l < 1 || l > 1000 {
+				return SimulateMACRequestValidationError{
+					field:  "uplinks",
+					reason: "value must contain between 1 and 1000 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetUplinks() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return SimulateMACRequestValidationError{
+							field:  fmt.Sprintf("uplinks[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return SimulateMACRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SimulateMACRequestValidationError is the validation error returned by
+// SimulateMACRequest.ValidateFields if the designated constraints aren't met.
+type SimulateMACRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulateMACRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulateMACRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulateMACRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulateMACRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulateMACRequestValidationError) ErrorName() string {
+	return "SimulateMACRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SimulateMACRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulateMACRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulateMACRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulateMACRequestValidationError{}
+
+// ValidateFields checks the field values on MACSimulation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACSimulation) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACSimulationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "steps":
+
+			for idx, item := range m.GetSteps() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACSimulationValidationError{
+							field:  fmt.Sprintf("steps[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return MACSimulationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACSimulationValidationError is the validation error returned by
+// MACSimulation.ValidateFields if the designated constraints aren't met.
+type MACSimulationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACSimulationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACSimulationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACSimulationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACSimulationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACSimulationValidationError) ErrorName() string { return "MACSimulationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MACSimulationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACSimulation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACSimulationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACSimulationValidationError{}
+
+// ValidateFields checks the field values on RelayAssignmentSuggestion with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RelayAssignmentSuggestion) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RelayAssignmentSuggestionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "action":
+			// no validation rules for Action
+		case "serving_device_id":
+			// no validation rules for ServingDeviceId
+		case "reason":
+			// no validation rules for Reason
+		case "suggested_at":
+
+			if v, ok := interface{}(m.GetSuggestedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RelayAssignmentSuggestionValidationError{
+						field:  "suggested_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return RelayAssignmentSuggestionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RelayAssignmentSuggestionValidationError is the validation error returned by
+// RelayAssignmentSuggestion.ValidateFields if the designated constraints
+// aren't met.
+type RelayAssignmentSuggestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelayAssignmentSuggestionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelayAssignmentSuggestionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelayAssignmentSuggestionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelayAssignmentSuggestionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelayAssignmentSuggestionValidationError) ErrorName() string {
+	return "RelayAssignmentSuggestionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RelayAssignmentSuggestionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelayAssignmentSuggestion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelayAssignmentSuggestionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelayAssignmentSuggestionValidationError{}
+
+// ValidateFields checks the field values on EndDeviceRelayAssignment with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceRelayAssignment) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceRelayAssignmentFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRelayAssignmentValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "relayed_uplinks":
+			// no validation rules for RelayedUplinks
+		case "poor_uplinks":
+			// no validation rules for PoorUplinks
+		case "covered_uplinks":
+			// no validation rules for CoveredUplinks
+		case "serving_device_id":
+			// no validation rules for ServingDeviceId
+		case "suggestion":
+
+			if v, ok := interface{}(m.GetSuggestion()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRelayAssignmentValidationError{
+						field:  "suggestion",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceRelayAssignmentValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceRelayAssignmentValidationError is the validation error returned by
+// EndDeviceRelayAssignment.ValidateFields if the designated constraints
+// aren't met.
+type EndDeviceRelayAssignmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceRelayAssignmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceRelayAssignmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceRelayAssignmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceRelayAssignmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceRelayAssignmentValidationError) ErrorName() string {
+	return "EndDeviceRelayAssignmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceRelayAssignmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceRelayAssignment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceRelayAssignmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceRelayAssignmentValidationError{}
+
+// ValidateFields checks the field values on FCntRejection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FCntRejection) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = FCntRejectionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "f_cnt":
+			// no validation rules for FCnt
+		case "last_f_cnt_up":
+			// no validation rules for LastFCntUp
+		case "reason":
+			// no validation rules for Reason
+		case "received_at":
+
+			if v, ok := interface{}(m.GetReceivedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FCntRejectionValidationError{
+						field:  "received_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return FCntRejectionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// FCntRejectionValidationError is the validation error returned by
+// FCntRejection.ValidateFields if the designated constraints aren't met.
+type FCntRejectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FCntRejectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FCntRejectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FCntRejectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FCntRejectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FCntRejectionValidationError) ErrorName() string { return "FCntRejectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FCntRejectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFCntRejection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FCntRejectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FCntRejectionValidationError{}
+
+// ValidateFields checks the field values on FCntResyncRecord with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FCntResyncRecord) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = FCntResyncRecordFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "last_f_cnt_up":
+			// no validation rules for LastFCntUp
+		case "f_cnt":
+			// no validation rules for FCnt
+		case "reason":
+			// no validation rules for Reason
+		case "rejected_uplinks":
+			// no validation rules for RejectedUplinks
+		case "automatic":
+			// no validation rules for Automatic
+		case "resynced_at":
+
+			if v, ok := interface{}(m.GetResyncedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FCntResyncRecordValidationError{
+						field:  "resynced_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return FCntResyncRecordValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// FCntResyncRecordValidationError is the validation error returned by
+// FCntResyncRecord.ValidateFields if the designated constraints aren't met.
+type FCntResyncRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FCntResyncRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FCntResyncRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FCntResyncRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FCntResyncRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FCntResyncRecordValidationError) ErrorName() string { return "FCntResyncRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FCntResyncRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFCntResyncRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FCntResyncRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FCntResyncRecordValidationError{}
+
+// ValidateFields checks the field values on EndDeviceFCntResync with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceFCntResync) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceFCntResyncFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceFCntResyncValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "desynchronized":
+			// no validation rules for Desynchronized
+		case "auto_resync":
+			// no validation rules for AutoResync
+		case "rejections":
+
+			for idx, item := range m.GetRejections() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EndDeviceFCntResyncValidationError{
+							field:  fmt.Sprintf("rejections[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "audit":
+
+			for idx, item := range m.GetAudit() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EndDeviceFCntResyncValidationError{
+							field:  fmt.Sprintf("audit[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return EndDeviceFCntResyncValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceFCntResyncValidationError is the validation error returned by
+// EndDeviceFCntResync.ValidateFields if the designated constraints aren't met.
+type EndDeviceFCntResyncValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceFCntResyncValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceFCntResyncValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceFCntResyncValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceFCntResyncValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceFCntResyncValidationError) ErrorName() string {
+	return "EndDeviceFCntResyncValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceFCntResyncValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceFCntResync.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceFCntResyncValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceFCntResyncValidationError{}
+
+// ValidateFields checks the field values on GatewayClassBCapacity with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayClassBCapacity) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayClassBCapacityFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayClassBCapacityValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_count":
+			// no validation rules for DeviceCount
+		case "ping_slot_count":
+			// no validation rules for PingSlotCount
+		case "devices_by_frequency":
+			// no validation rules for DevicesByFrequency
+		case "beacon_periods":
+
+			for idx, item := range m.GetBeaconPeriods() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayClassBCapacityValidationError{
+							field:  fmt.Sprintf("beacon_periods[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayClassBCapacityValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayClassBCapacityValidationError is the validation error returned by
+// GatewayClassBCapacity.ValidateFields if the designated constraints aren't met.
+type GatewayClassBCapacityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayClassBCapacityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayClassBCapacityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayClassBCapacityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayClassBCapacityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayClassBCapacityValidationError) ErrorName() string {
+	return "GatewayClassBCapacityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayClassBCapacityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayClassBCapacity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayClassBCapacityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayClassBCapacityValidationError{}
+
+// ValidateFields checks the field values on EndDeviceRejoin with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceRejoin) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceRejoinFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoinValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "force_rejoin":
+
+			if v, ok := interface{}(m.GetForceRejoin()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoinValidationError{
+						field:  "force_rejoin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "policy":
+
+			if v, ok := interface{}(m.GetPolicy()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoinValidationError{
+						field:  "policy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "pending_rejoin":
+
+			if v, ok := interface{}(m.GetPendingRejoin()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoinValidationError{
+						field:  "pending_rejoin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_rejoin":
+
+			if v, ok := interface{}(m.GetLastRejoin()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoinValidationError{
+						field:  "last_rejoin",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceRejoinValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceRejoinValidationError is the validation error returned by
+// EndDeviceRejoin.ValidateFields if the designated constraints aren't met.
+type EndDeviceRejoinValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceRejoinValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceRejoinValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceRejoinValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceRejoinValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceRejoinValidationError) ErrorName() string { return "EndDeviceRejoinValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EndDeviceRejoinValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceRejoin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceRejoinValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceRejoinValidationError{}
+
+// ValidateFields checks the field values on ForceEndDeviceRejoinRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ForceEndDeviceRejoinRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ForceEndDeviceRejoinRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return ForceEndDeviceRejoinRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ForceEndDeviceRejoinRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "force_rejoin_req":
+
+			if m.GetForceRejoinReq() == nil {
+				return ForceEndDeviceRejoinRequestValidationError{
+					field:  "force_rejoin_req",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetForceRejoinReq()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ForceEndDeviceRejoinRequestValidationError{
+						field:  "force_rejoin_req",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ForceEndDeviceRejoinRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ForceEndDeviceRejoinRequestValidationError is the validation error returned
+// by ForceEndDeviceRejoinRequest.ValidateFields if the designated constraints
+// aren't met.
+type ForceEndDeviceRejoinRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceEndDeviceRejoinRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceEndDeviceRejoinRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceEndDeviceRejoinRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceEndDeviceRejoinRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceEndDeviceRejoinRequestValidationError) ErrorName() string {
+	return "ForceEndDeviceRejoinRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForceEndDeviceRejoinRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceEndDeviceRejoinRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceEndDeviceRejoinRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceEndDeviceRejoinRequestValidationError{}
+
+// ValidateFields checks the field values on SetEndDeviceRejoinPolicyRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *SetEndDeviceRejoinPolicyRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetEndDeviceRejoinPolicyRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return SetEndDeviceRejoinPolicyRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetEndDeviceRejoinPolicyRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "force_rejoin_req":
+
+			if m.GetForceRejoinReq() == nil {
+				return SetEndDeviceRejoinPolicyRequestValidationError{
+					field:  "force_rejoin_req",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetForceRejoinReq()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetEndDeviceRejoinPolicyRequestValidationError{
+						field:  "force_rejoin_req",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "interval":
+
+			if m.GetInterval() == nil {
+				return SetEndDeviceRejoinPolicyRequestValidationError{
+					field:  "interval",
+					reason: "value is required",
+				}
+			}
+
+			if d := m.GetInterval(); d != nil {
+				dur, err := d.AsDuration(), d.CheckValid()
+				if err != nil {
+					return SetEndDeviceRejoinPolicyRequestValidationError{
+						field:  "interval",
+						reason: "value is not a valid duration",
+						cause:  err,
+					}
+				}
+
+				gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+				if dur <= gt {
+					return SetEndDeviceRejoinPolicyRequestValidationError{
+						field:  "interval",
+						reason: "value must be greater than 0s",
+					}
+				}
+
+			}
+
+		default:
+			return SetEndDeviceRejoinPolicyRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetEndDeviceRejoinPolicyRequestValidationError is the validation error
+// returned by SetEndDeviceRejoinPolicyRequest.ValidateFields if the
+// designated constraints aren't met.
+type SetEndDeviceRejoinPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEndDeviceRejoinPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEndDeviceRejoinPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEndDeviceRejoinPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEndDeviceRejoinPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEndDeviceRejoinPolicyRequestValidationError) ErrorName() string {
+	return "SetEndDeviceRejoinPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEndDeviceRejoinPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEndDeviceRejoinPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEndDeviceRejoinPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEndDeviceRejoinPolicyRequestValidationError{}
+
+// ValidateFields checks the field values on ForceEndDevicesRejoinRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ForceEndDevicesRejoinRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ForceEndDevicesRejoinRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return ForceEndDevicesRejoinRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ForceEndDevicesRejoinRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_ids":
+
+			if l := len(m.GetDeviceIds()); l < 1 || l > 100 {
+				return ForceEndDevicesRejoinRequestValidationError{
+					field:  "device_ids",
+					reason: "value must contain between 1 and 100 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetDeviceIds() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 36 {
+					return ForceEndDevicesRejoinRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_ForceEndDevicesRejoinRequest_DeviceIds_Pattern.MatchString(item) {
+					return ForceEndDevicesRejoinRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		case "force_rejoin_req":
+
+			if m.GetForceRejoinReq() == nil {
+				return ForceEndDevicesRejoinRequestValidationError{
+					field:  "force_rejoin_req",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetForceRejoinReq()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ForceEndDevicesRejoinRequestValidationError{
+						field:  "force_rejoin_req",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ForceEndDevicesRejoinRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ForceEndDevicesRejoinRequestValidationError is the validation error returned
+// by ForceEndDevicesRejoinRequest.ValidateFields if the designated
+// constraints aren't met.
+type ForceEndDevicesRejoinRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceEndDevicesRejoinRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceEndDevicesRejoinRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceEndDevicesRejoinRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceEndDevicesRejoinRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceEndDevicesRejoinRequestValidationError) ErrorName() string {
+	return "ForceEndDevicesRejoinRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForceEndDevicesRejoinRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceEndDevicesRejoinRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceEndDevicesRejoinRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceEndDevicesRejoinRequestValidationError{}
+
+var _ForceEndDevicesRejoinRequest_DeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ForceEndDevicesRejoinResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ForceEndDevicesRejoinResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ForceEndDevicesRejoinResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "results":
+
+			for idx, item := range m.GetResults() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ForceEndDevicesRejoinResponseValidationError{
+							field:  fmt.Sprintf("results[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ForceEndDevicesRejoinResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ForceEndDevicesRejoinResponseValidationError is the validation error
+// returned by ForceEndDevicesRejoinResponse.ValidateFields if the designated
+// constraints aren't met.
+type ForceEndDevicesRejoinResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceEndDevicesRejoinResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceEndDevicesRejoinResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceEndDevicesRejoinResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceEndDevicesRejoinResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceEndDevicesRejoinResponseValidationError) ErrorName() string {
+	return "ForceEndDevicesRejoinResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForceEndDevicesRejoinResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceEndDevicesRejoinResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceEndDevicesRejoinResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceEndDevicesRejoinResponseValidationError{}
+
+// ValidateFields checks the field values on EndDeviceLinkStats_Percentiles
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *EndDeviceLinkStats_Percentiles) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceLinkStats_PercentilesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min":
+			// no validation rules for Min
+		case "p10":
+			// no validation rules for P10
+		case "median":
+			// no validation rules for Median
+		case "p90":
+			// no validation rules for P90
+		case "max":
+			// no validation rules for Max
+		default:
+			return EndDeviceLinkStats_PercentilesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceLinkStats_PercentilesValidationError is the validation error
+// returned by EndDeviceLinkStats_Percentiles.ValidateFields if the designated
+// constraints aren't met.
+type EndDeviceLinkStats_PercentilesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceLinkStats_PercentilesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceLinkStats_PercentilesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceLinkStats_PercentilesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceLinkStats_PercentilesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceLinkStats_PercentilesValidationError) ErrorName() string {
+	return "EndDeviceLinkStats_PercentilesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceLinkStats_PercentilesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceLinkStats_Percentiles.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceLinkStats_PercentilesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceLinkStats_PercentilesValidationError{}
+
+// ValidateFields checks the field values on
+// EndDeviceLinkStats_GatewayDiversity with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *EndDeviceLinkStats_GatewayDiversity) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceLinkStats_GatewayDiversityFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateways":
+			// no validation rules for Gateways
+		case "min":
+			// no validation rules for Min
+		case "mean":
+			// no validation rules for Mean
+		case "max":
+			// no validation rules for Max
+		default:
+			return EndDeviceLinkStats_GatewayDiversityValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceLinkStats_GatewayDiversityValidationError is the validation error
+// returned by EndDeviceLinkStats_GatewayDiversity.ValidateFields if the
+// designated constraints aren't met.
+type EndDeviceLinkStats_GatewayDiversityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceLinkStats_GatewayDiversityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceLinkStats_GatewayDiversityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceLinkStats_GatewayDiversityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceLinkStats_GatewayDiversityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceLinkStats_GatewayDiversityValidationError) ErrorName() string {
+	return "EndDeviceLinkStats_GatewayDiversityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceLinkStats_GatewayDiversityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceLinkStats_GatewayDiversity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceLinkStats_GatewayDiversityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceLinkStats_GatewayDiversityValidationError{}
+
+// ValidateFields checks the field values on SimulateMACRequest_Uplink with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SimulateMACRequest_Uplink) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SimulateMACRequest_UplinkFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "received_at":
+
+			if v, ok := interface{}(m.GetReceivedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SimulateMACRequest_UplinkValidationError{
+						field:  "received_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "data_rate_index":
+
+			if v, ok := interface{}(m.GetDataRateIndex()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SimulateMACRequest_UplinkValidationError{
+						field:  "data_rate_index",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "channel_index":
+
+			if v, ok := interface{}(m.GetChannelIndex()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SimulateMACRequest_UplinkValidationError{
+						field:  "channel_index",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "snr":
+			// no validation rules for Snr
+		case "rssi":
+			// no validation rules for Rssi
+		case "gateway_count":
+
+			if m.GetGatewayCount() > 100 {
+				return SimulateMACRequest_UplinkValidationError{
+					field:  "gateway_count",
+					reason: "value must be less than or equal to 100",
+				}
+			}
+
+		case "adr":
+
+			if v, ok := interface{}(m.GetAdr()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SimulateMACRequest_UplinkValidationError{
+						field:  "adr",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "adr_ack_req":
+			// no validation rules for AdrAckReq
+		case "confirmed":
+			// no validation rules for Confirmed
+		case "mac_commands":
+
+			for idx, item := range m.GetMacCommands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return SimulateMACRequest_UplinkValidationError{
+							field:  fmt.Sprintf("mac_commands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return SimulateMACRequest_UplinkValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SimulateMACRequest_UplinkValidationError is the validation error returned by
+// SimulateMACRequest_Uplink.ValidateFields if the designated constraints
+// aren't met.
+type SimulateMACRequest_UplinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulateMACRequest_UplinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulateMACRequest_UplinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulateMACRequest_UplinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulateMACRequest_UplinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulateMACRequest_UplinkValidationError) ErrorName() string {
+	return "SimulateMACRequest_UplinkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SimulateMACRequest_UplinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulateMACRequest_Uplink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulateMACRequest_UplinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulateMACRequest_UplinkValidationError{}
+
+// ValidateFields checks the field values on MACSimulation_Step with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *MACSimulation_Step) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACSimulation_StepFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "f_cnt":
+			// no validation rules for FCnt
+		case "data_rate_index":
+			// no validation rules for DataRateIndex
+		case "channel_index":
+			// no validation rules for ChannelIndex
+		case "events":
+
+		case "adr_decision":
+
+			if v, ok := interface{}(m.GetAdrDecision()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSimulation_StepValidationError{
+						field:  "adr_decision",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink_mac_commands":
+
+			for idx, item := range m.GetDownlinkMacCommands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACSimulation_StepValidationError{
+							field:  fmt.Sprintf("downlink_mac_commands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "f_pending":
+			// no validation rules for FPending
+		case "mac_state":
+
+			if v, ok := interface{}(m.GetMacState()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSimulation_StepValidationError{
+						field:  "mac_state",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return MACSimulation_StepValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACSimulation_StepValidationError is the validation error returned by
+// MACSimulation_Step.ValidateFields if the designated constraints aren't met.
+type MACSimulation_StepValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACSimulation_StepValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACSimulation_StepValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACSimulation_StepValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACSimulation_StepValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACSimulation_StepValidationError) ErrorName() string {
+	return "MACSimulation_StepValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACSimulation_StepValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACSimulation_Step.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACSimulation_StepValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACSimulation_StepValidationError{}
+
+// ValidateFields checks the field values on GatewayClassBCapacity_BeaconPeriod
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GatewayClassBCapacity_BeaconPeriod) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayClassBCapacity_BeaconPeriodFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "starts_at":
+
+			if v, ok := interface{}(m.GetStartsAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayClassBCapacity_BeaconPeriodValidationError{
+						field:  "starts_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		case "airtime":
+
+			if v, ok := interface{}(m.GetAirtime()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayClassBCapacity_BeaconPeriodValidationError{
+						field:  "airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "occupancy":
+			// no validation rules for Occupancy
+		default:
+			return GatewayClassBCapacity_BeaconPeriodValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayClassBCapacity_BeaconPeriodValidationError is the validation error
+// returned by GatewayClassBCapacity_BeaconPeriod.ValidateFields if the
+// designated constraints aren't met.
+type GatewayClassBCapacity_BeaconPeriodValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayClassBCapacity_BeaconPeriodValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayClassBCapacity_BeaconPeriodValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayClassBCapacity_BeaconPeriodValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayClassBCapacity_BeaconPeriodValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayClassBCapacity_BeaconPeriodValidationError) ErrorName() string {
+	return "GatewayClassBCapacity_BeaconPeriodValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayClassBCapacity_BeaconPeriodValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayClassBCapacity_BeaconPeriod.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayClassBCapacity_BeaconPeriodValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayClassBCapacity_BeaconPeriodValidationError{}
+
+// ValidateFields checks the field values on EndDeviceRejoin_ForceRejoin with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *EndDeviceRejoin_ForceRejoin) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceRejoin_ForceRejoinFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "force_rejoin_req":
+
+			if v, ok := interface{}(m.GetForceRejoinReq()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoin_ForceRejoinValidationError{
+						field:  "force_rejoin_req",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "periodic":
+			// no validation rules for Periodic
+		case "issued_at":
+
+			if v, ok := interface{}(m.GetIssuedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoin_ForceRejoinValidationError{
+						field:  "issued_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "transmissions":
+			// no validation rules for Transmissions
+		case "sent_at":
+
+			if v, ok := interface{}(m.GetSentAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoin_ForceRejoinValidationError{
+						field:  "sent_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceRejoin_ForceRejoinValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceRejoin_ForceRejoinValidationError is the validation error returned
+// by EndDeviceRejoin_ForceRejoin.ValidateFields if the designated constraints
+// aren't met.
+type EndDeviceRejoin_ForceRejoinValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceRejoin_ForceRejoinValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceRejoin_ForceRejoinValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceRejoin_ForceRejoinValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceRejoin_ForceRejoinValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceRejoin_ForceRejoinValidationError) ErrorName() string {
+	return "EndDeviceRejoin_ForceRejoinValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceRejoin_ForceRejoinValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceRejoin_ForceRejoin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceRejoin_ForceRejoinValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceRejoin_ForceRejoinValidationError{}
+
+// ValidateFields checks the field values on EndDeviceRejoin_Policy with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceRejoin_Policy) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceRejoin_PolicyFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "force_rejoin_req":
+
+			if v, ok := interface{}(m.GetForceRejoinReq()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoin_PolicyValidationError{
+						field:  "force_rejoin_req",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "interval":
+
+			if v, ok := interface{}(m.GetInterval()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoin_PolicyValidationError{
+						field:  "interval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "set_at":
+
+			if v, ok := interface{}(m.GetSetAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoin_PolicyValidationError{
+						field:  "set_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceRejoin_PolicyValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceRejoin_PolicyValidationError is the validation error returned by
+// EndDeviceRejoin_Policy.ValidateFields if the designated constraints aren't met.
+type EndDeviceRejoin_PolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceRejoin_PolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceRejoin_PolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceRejoin_PolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceRejoin_PolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceRejoin_PolicyValidationError) ErrorName() string {
+	return "EndDeviceRejoin_PolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceRejoin_PolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceRejoin_Policy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceRejoin_PolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceRejoin_PolicyValidationError{}
+
+// ValidateFields checks the field values on EndDeviceRejoin_Rejoin with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceRejoin_Rejoin) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceRejoin_RejoinFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "rejoin_type":
+			// no validation rules for RejoinType
+		case "forced":
+			// no validation rules for Forced
+		case "periodic":
+			// no validation rules for Periodic
+		case "received_at":
+
+			if v, ok := interface{}(m.GetReceivedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoin_RejoinValidationError{
+						field:  "received_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "completed_at":
+
+			if v, ok := interface{}(m.GetCompletedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceRejoin_RejoinValidationError{
+						field:  "completed_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceRejoin_RejoinValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceRejoin_RejoinValidationError is the validation error returned by
+// EndDeviceRejoin_Rejoin.ValidateFields if the designated constraints aren't met.
+type EndDeviceRejoin_RejoinValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceRejoin_RejoinValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceRejoin_RejoinValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceRejoin_RejoinValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceRejoin_RejoinValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceRejoin_RejoinValidationError) ErrorName() string {
+	return "EndDeviceRejoin_RejoinValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceRejoin_RejoinValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceRejoin_Rejoin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceRejoin_RejoinValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceRejoin_RejoinValidationError{}
+
+// ValidateFields checks the field values on
+// ForceEndDevicesRejoinResponse_Result with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ForceEndDevicesRejoinResponse_Result) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ForceEndDevicesRejoinResponse_ResultFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "device_id":
+			// no validation rules for DeviceId
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ForceEndDevicesRejoinResponse_ResultValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ForceEndDevicesRejoinResponse_ResultValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ForceEndDevicesRejoinResponse_ResultValidationError is the validation error
+// returned by ForceEndDevicesRejoinResponse_Result.ValidateFields if the
+// designated constraints aren't met.
+type ForceEndDevicesRejoinResponse_ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForceEndDevicesRejoinResponse_ResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForceEndDevicesRejoinResponse_ResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForceEndDevicesRejoinResponse_ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForceEndDevicesRejoinResponse_ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForceEndDevicesRejoinResponse_ResultValidationError) ErrorName() string {
+	return "ForceEndDevicesRejoinResponse_ResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForceEndDevicesRejoinResponse_ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForceEndDevicesRejoinResponse_Result.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForceEndDevicesRejoinResponse_ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForceEndDevicesRejoinResponse_ResultValidationError{}
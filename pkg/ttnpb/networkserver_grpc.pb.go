@@ -34,10 +34,36 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Ns_GenerateDevAddr_FullMethodName          = "/ttn.lorawan.v3.Ns/GenerateDevAddr"
-	Ns_GetDefaultMACSettings_FullMethodName    = "/ttn.lorawan.v3.Ns/GetDefaultMACSettings"
-	Ns_GetNetID_FullMethodName                 = "/ttn.lorawan.v3.Ns/GetNetID"
-	Ns_GetDeviceAddressPrefixes_FullMethodName = "/ttn.lorawan.v3.Ns/GetDeviceAddressPrefixes"
+	Ns_GenerateDevAddr_FullMethodName                 = "/ttn.lorawan.v3.Ns/GenerateDevAddr"
+	Ns_GetDefaultMACSettings_FullMethodName           = "/ttn.lorawan.v3.Ns/GetDefaultMACSettings"
+	Ns_GetNetID_FullMethodName                        = "/ttn.lorawan.v3.Ns/GetNetID"
+	Ns_GetDeviceAddressPrefixes_FullMethodName        = "/ttn.lorawan.v3.Ns/GetDeviceAddressPrefixes"
+	Ns_GetDevAddrPrefixUtilization_FullMethodName     = "/ttn.lorawan.v3.Ns/GetDevAddrPrefixUtilization"
+	Ns_GetEndDeviceHandover_FullMethodName            = "/ttn.lorawan.v3.Ns/GetEndDeviceHandover"
+	Ns_StartEndDeviceHandover_FullMethodName          = "/ttn.lorawan.v3.Ns/StartEndDeviceHandover"
+	Ns_StopEndDeviceHandover_FullMethodName           = "/ttn.lorawan.v3.Ns/StopEndDeviceHandover"
+	Ns_GetMulticastGroupMembers_FullMethodName        = "/ttn.lorawan.v3.Ns/GetMulticastGroupMembers"
+	Ns_SetMulticastGroupMembers_FullMethodName        = "/ttn.lorawan.v3.Ns/SetMulticastGroupMembers"
+	Ns_GetMulticastGroupGateways_FullMethodName       = "/ttn.lorawan.v3.Ns/GetMulticastGroupGateways"
+	Ns_GetEndDeviceDownlinkBudgets_FullMethodName     = "/ttn.lorawan.v3.Ns/GetEndDeviceDownlinkBudgets"
+	Ns_GetApplicationDownlinkBudgets_FullMethodName   = "/ttn.lorawan.v3.Ns/GetApplicationDownlinkBudgets"
+	Ns_GetEndDeviceLinkStats_FullMethodName           = "/ttn.lorawan.v3.Ns/GetEndDeviceLinkStats"
+	Ns_ResetEndDeviceLinkStats_FullMethodName         = "/ttn.lorawan.v3.Ns/ResetEndDeviceLinkStats"
+	Ns_GetEndDeviceRelayAssignment_FullMethodName     = "/ttn.lorawan.v3.Ns/GetEndDeviceRelayAssignment"
+	Ns_ApproveEndDeviceRelayAssignment_FullMethodName = "/ttn.lorawan.v3.Ns/ApproveEndDeviceRelayAssignment"
+	Ns_RejectEndDeviceRelayAssignment_FullMethodName  = "/ttn.lorawan.v3.Ns/RejectEndDeviceRelayAssignment"
+	Ns_GetEndDeviceFCntResync_FullMethodName          = "/ttn.lorawan.v3.Ns/GetEndDeviceFCntResync"
+	Ns_ResyncEndDeviceFCnt_FullMethodName             = "/ttn.lorawan.v3.Ns/ResyncEndDeviceFCnt"
+	Ns_GetGatewayClassBCapacity_FullMethodName        = "/ttn.lorawan.v3.Ns/GetGatewayClassBCapacity"
+	Ns_GetEndDeviceRejoin_FullMethodName              = "/ttn.lorawan.v3.Ns/GetEndDeviceRejoin"
+	Ns_ForceEndDeviceRejoin_FullMethodName            = "/ttn.lorawan.v3.Ns/ForceEndDeviceRejoin"
+	Ns_CancelEndDeviceForceRejoin_FullMethodName      = "/ttn.lorawan.v3.Ns/CancelEndDeviceForceRejoin"
+	Ns_ForceEndDevicesRejoin_FullMethodName           = "/ttn.lorawan.v3.Ns/ForceEndDevicesRejoin"
+	Ns_SetEndDeviceRejoinPolicy_FullMethodName        = "/ttn.lorawan.v3.Ns/SetEndDeviceRejoinPolicy"
+	Ns_DeleteEndDeviceRejoinPolicy_FullMethodName     = "/ttn.lorawan.v3.Ns/DeleteEndDeviceRejoinPolicy"
+	Ns_ExportEndDevices_FullMethodName                = "/ttn.lorawan.v3.Ns/ExportEndDevices"
+	Ns_ImportEndDevices_FullMethodName                = "/ttn.lorawan.v3.Ns/ImportEndDevices"
+	Ns_SimulateMAC_FullMethodName                     = "/ttn.lorawan.v3.Ns/SimulateMAC"
 )
 
 // NsClient is the client API for Ns service.
@@ -50,6 +76,79 @@ type NsClient interface {
 	GetDefaultMACSettings(ctx context.Context, in *GetDefaultMACSettingsRequest, opts ...grpc.CallOption) (*MACSettings, error)
 	GetNetID(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetNetIDResponse, error)
 	GetDeviceAddressPrefixes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDeviceAdressPrefixesResponse, error)
+	// GetDevAddrPrefixUtilization returns the utilization of the DevAddr prefixes of the Network Server.
+	// This requires DevAddr allocation to be enabled and admin rights.
+	GetDevAddrPrefixUtilization(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDevAddrPrefixUtilizationResponse, error)
+	// GetEndDeviceHandover returns the handover roaming session of the end device.
+	GetEndDeviceHandover(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceHandover, error)
+	// StartEndDeviceHandover hands over the session of the end device to a serving Network Server.
+	StartEndDeviceHandover(ctx context.Context, in *StartEndDeviceHandoverRequest, opts ...grpc.CallOption) (*EndDeviceHandover, error)
+	// StopEndDeviceHandover stops the handover roaming session of the end device and resumes the session.
+	StopEndDeviceHandover(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetMulticastGroupMembers returns the members of the multicast group of the multicast end device.
+	GetMulticastGroupMembers(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*MulticastGroupMembers, error)
+	// SetMulticastGroupMembers sets the members of the multicast group of the multicast end device.
+	// The members are used to select the gateways for multicast downlinks without gateways.
+	SetMulticastGroupMembers(ctx context.Context, in *MulticastGroupMembers, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetMulticastGroupGateways returns the gateways that are selected for multicast downlinks of the multicast end
+	// device, from the recent uplinks of the members of the multicast group.
+	GetMulticastGroupGateways(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*MulticastGroupGateways, error)
+	// GetEndDeviceDownlinkBudgets returns the usage of the daily downlink budgets that apply to the end device.
+	GetEndDeviceDownlinkBudgets(ctx context.Context, in *GetEndDeviceDownlinkBudgetsRequest, opts ...grpc.CallOption) (*DownlinkBudgets, error)
+	// GetApplicationDownlinkBudgets returns the usage of the daily downlink budgets that apply to the application.
+	GetApplicationDownlinkBudgets(ctx context.Context, in *GetApplicationDownlinkBudgetsRequest, opts ...grpc.CallOption) (*DownlinkBudgets, error)
+	// GetEndDeviceLinkStats returns the link statistics of the end device.
+	// This requires link statistics to be enabled.
+	GetEndDeviceLinkStats(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceLinkStats, error)
+	// ResetEndDeviceLinkStats removes the link samples of the end device, which resets its link statistics.
+	// This requires link statistics to be enabled.
+	ResetEndDeviceLinkStats(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetEndDeviceRelayAssignment returns the relay assignment state of the end device.
+	// This requires relay assignment to be enabled.
+	GetEndDeviceRelayAssignment(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceRelayAssignment, error)
+	// ApproveEndDeviceRelayAssignment applies the suggested assignment or release of the end device.
+	// This requires relay assignment to be enabled.
+	ApproveEndDeviceRelayAssignment(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceRelayAssignment, error)
+	// RejectEndDeviceRelayAssignment discards the suggested assignment or release of the end device.
+	// This requires relay assignment to be enabled.
+	RejectEndDeviceRelayAssignment(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetEndDeviceFCntResync returns the frame counter resynchronization state of the end device.
+	// This requires frame counter resynchronization to be enabled.
+	GetEndDeviceFCntResync(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceFCntResync, error)
+	// ResyncEndDeviceFCnt resynchronizes the desynchronized frame counter of the end device to the frame counter of the
+	// last rejected uplink. This requires frame counter resynchronization to be enabled.
+	ResyncEndDeviceFCnt(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceFCntResync, error)
+	// GetGatewayClassBCapacity returns the class B end devices and the ping slot occupancy of the gateway.
+	// This requires class B capacity planning to be enabled.
+	GetGatewayClassBCapacity(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayClassBCapacity, error)
+	// GetEndDeviceRejoin returns the rejoin state of the LoRaWAN 1.1 end device.
+	// This requires rejoin handling to be enabled.
+	GetEndDeviceRejoin(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceRejoin, error)
+	// ForceEndDeviceRejoin issues a ForceRejoinReq to the LoRaWAN 1.1 end device, which is sent in the downlinks to the
+	// end device until it rejoins. This requires rejoin handling to be enabled.
+	ForceEndDeviceRejoin(ctx context.Context, in *ForceEndDeviceRejoinRequest, opts ...grpc.CallOption) (*EndDeviceRejoin, error)
+	// CancelEndDeviceForceRejoin cancels the pending ForceRejoinReq of the LoRaWAN 1.1 end device.
+	// This requires rejoin handling to be enabled.
+	CancelEndDeviceForceRejoin(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceRejoin, error)
+	// ForceEndDevicesRejoin issues a ForceRejoinReq to the LoRaWAN 1.1 end devices of the application.
+	// This requires rejoin handling to be enabled.
+	ForceEndDevicesRejoin(ctx context.Context, in *ForceEndDevicesRejoinRequest, opts ...grpc.CallOption) (*ForceEndDevicesRejoinResponse, error)
+	// SetEndDeviceRejoinPolicy sets the periodic rejoin policy of the LoRaWAN 1.1 end device.
+	// This requires rejoin handling to be enabled.
+	SetEndDeviceRejoinPolicy(ctx context.Context, in *SetEndDeviceRejoinPolicyRequest, opts ...grpc.CallOption) (*EndDeviceRejoin, error)
+	// DeleteEndDeviceRejoinPolicy deletes the periodic rejoin policy of the LoRaWAN 1.1 end device.
+	// This requires rejoin handling to be enabled.
+	DeleteEndDeviceRejoinPolicy(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceRejoin, error)
+	// ExportEndDevices exports the end devices of the application with their session, MAC state and queued downlinks.
+	// The network session keys are wrapped with the KEK with the given label.
+	ExportEndDevices(ctx context.Context, in *ExportEndDevicesRequest, opts ...grpc.CallOption) (*ExportEndDevicesResponse, error)
+	// ImportEndDevices imports end devices exported by ExportEndDevices into the application.
+	// The import is atomic: either all end devices are imported, or none.
+	ImportEndDevices(ctx context.Context, in *ImportEndDevicesRequest, opts ...grpc.CallOption) (*ImportEndDevicesResponse, error)
+	// SimulateMAC runs a sequence of synthetic uplinks through the MAC layer of the end device, starting from its
+	// stored MAC state or from the given end device template, and returns the MAC state and the MAC commands the
+	// Network Server would send after each uplink. The simulation does not change the end device.
+	SimulateMAC(ctx context.Context, in *SimulateMACRequest, opts ...grpc.CallOption) (*MACSimulation, error)
 }
 
 type nsClient struct {
@@ -96,6 +195,240 @@ func (c *nsClient) GetDeviceAddressPrefixes(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
+func (c *nsClient) GetDevAddrPrefixUtilization(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDevAddrPrefixUtilizationResponse, error) {
+	out := new(GetDevAddrPrefixUtilizationResponse)
+	err := c.cc.Invoke(ctx, Ns_GetDevAddrPrefixUtilization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) GetEndDeviceHandover(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceHandover, error) {
+	out := new(EndDeviceHandover)
+	err := c.cc.Invoke(ctx, Ns_GetEndDeviceHandover_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) StartEndDeviceHandover(ctx context.Context, in *StartEndDeviceHandoverRequest, opts ...grpc.CallOption) (*EndDeviceHandover, error) {
+	out := new(EndDeviceHandover)
+	err := c.cc.Invoke(ctx, Ns_StartEndDeviceHandover_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) StopEndDeviceHandover(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Ns_StopEndDeviceHandover_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) GetMulticastGroupMembers(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*MulticastGroupMembers, error) {
+	out := new(MulticastGroupMembers)
+	err := c.cc.Invoke(ctx, Ns_GetMulticastGroupMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) SetMulticastGroupMembers(ctx context.Context, in *MulticastGroupMembers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Ns_SetMulticastGroupMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) GetMulticastGroupGateways(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*MulticastGroupGateways, error) {
+	out := new(MulticastGroupGateways)
+	err := c.cc.Invoke(ctx, Ns_GetMulticastGroupGateways_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) GetEndDeviceDownlinkBudgets(ctx context.Context, in *GetEndDeviceDownlinkBudgetsRequest, opts ...grpc.CallOption) (*DownlinkBudgets, error) {
+	out := new(DownlinkBudgets)
+	err := c.cc.Invoke(ctx, Ns_GetEndDeviceDownlinkBudgets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) GetApplicationDownlinkBudgets(ctx context.Context, in *GetApplicationDownlinkBudgetsRequest, opts ...grpc.CallOption) (*DownlinkBudgets, error) {
+	out := new(DownlinkBudgets)
+	err := c.cc.Invoke(ctx, Ns_GetApplicationDownlinkBudgets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) GetEndDeviceLinkStats(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceLinkStats, error) {
+	out := new(EndDeviceLinkStats)
+	err := c.cc.Invoke(ctx, Ns_GetEndDeviceLinkStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) ResetEndDeviceLinkStats(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Ns_ResetEndDeviceLinkStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) GetEndDeviceRelayAssignment(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceRelayAssignment, error) {
+	out := new(EndDeviceRelayAssignment)
+	err := c.cc.Invoke(ctx, Ns_GetEndDeviceRelayAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) ApproveEndDeviceRelayAssignment(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceRelayAssignment, error) {
+	out := new(EndDeviceRelayAssignment)
+	err := c.cc.Invoke(ctx, Ns_ApproveEndDeviceRelayAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) RejectEndDeviceRelayAssignment(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Ns_RejectEndDeviceRelayAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) GetEndDeviceFCntResync(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceFCntResync, error) {
+	out := new(EndDeviceFCntResync)
+	err := c.cc.Invoke(ctx, Ns_GetEndDeviceFCntResync_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) ResyncEndDeviceFCnt(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceFCntResync, error) {
+	out := new(EndDeviceFCntResync)
+	err := c.cc.Invoke(ctx, Ns_ResyncEndDeviceFCnt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) GetGatewayClassBCapacity(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayClassBCapacity, error) {
+	out := new(GatewayClassBCapacity)
+	err := c.cc.Invoke(ctx, Ns_GetGatewayClassBCapacity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) GetEndDeviceRejoin(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceRejoin, error) {
+	out := new(EndDeviceRejoin)
+	err := c.cc.Invoke(ctx, Ns_GetEndDeviceRejoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) ForceEndDeviceRejoin(ctx context.Context, in *ForceEndDeviceRejoinRequest, opts ...grpc.CallOption) (*EndDeviceRejoin, error) {
+	out := new(EndDeviceRejoin)
+	err := c.cc.Invoke(ctx, Ns_ForceEndDeviceRejoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) CancelEndDeviceForceRejoin(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceRejoin, error) {
+	out := new(EndDeviceRejoin)
+	err := c.cc.Invoke(ctx, Ns_CancelEndDeviceForceRejoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) ForceEndDevicesRejoin(ctx context.Context, in *ForceEndDevicesRejoinRequest, opts ...grpc.CallOption) (*ForceEndDevicesRejoinResponse, error) {
+	out := new(ForceEndDevicesRejoinResponse)
+	err := c.cc.Invoke(ctx, Ns_ForceEndDevicesRejoin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) SetEndDeviceRejoinPolicy(ctx context.Context, in *SetEndDeviceRejoinPolicyRequest, opts ...grpc.CallOption) (*EndDeviceRejoin, error) {
+	out := new(EndDeviceRejoin)
+	err := c.cc.Invoke(ctx, Ns_SetEndDeviceRejoinPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) DeleteEndDeviceRejoinPolicy(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceRejoin, error) {
+	out := new(EndDeviceRejoin)
+	err := c.cc.Invoke(ctx, Ns_DeleteEndDeviceRejoinPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) ExportEndDevices(ctx context.Context, in *ExportEndDevicesRequest, opts ...grpc.CallOption) (*ExportEndDevicesResponse, error) {
+	out := new(ExportEndDevicesResponse)
+	err := c.cc.Invoke(ctx, Ns_ExportEndDevices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) ImportEndDevices(ctx context.Context, in *ImportEndDevicesRequest, opts ...grpc.CallOption) (*ImportEndDevicesResponse, error) {
+	out := new(ImportEndDevicesResponse)
+	err := c.cc.Invoke(ctx, Ns_ImportEndDevices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) SimulateMAC(ctx context.Context, in *SimulateMACRequest, opts ...grpc.CallOption) (*MACSimulation, error) {
+	out := new(MACSimulation)
+	err := c.cc.Invoke(ctx, Ns_SimulateMAC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsServer is the server API for Ns service.
 // All implementations must embed UnimplementedNsServer
 // for forward compatibility
@@ -106,106 +439,725 @@ type NsServer interface {
 	GetDefaultMACSettings(context.Context, *GetDefaultMACSettingsRequest) (*MACSettings, error)
 	GetNetID(context.Context, *emptypb.Empty) (*GetNetIDResponse, error)
 	GetDeviceAddressPrefixes(context.Context, *emptypb.Empty) (*GetDeviceAdressPrefixesResponse, error)
+	// GetDevAddrPrefixUtilization returns the utilization of the DevAddr prefixes of the Network Server.
+	// This requires DevAddr allocation to be enabled and admin rights.
+	GetDevAddrPrefixUtilization(context.Context, *emptypb.Empty) (*GetDevAddrPrefixUtilizationResponse, error)
+	// GetEndDeviceHandover returns the handover roaming session of the end device.
+	GetEndDeviceHandover(context.Context, *EndDeviceIdentifiers) (*EndDeviceHandover, error)
+	// StartEndDeviceHandover hands over the session of the end device to a serving Network Server.
+	StartEndDeviceHandover(context.Context, *StartEndDeviceHandoverRequest) (*EndDeviceHandover, error)
+	// StopEndDeviceHandover stops the handover roaming session of the end device and resumes the session.
+	StopEndDeviceHandover(context.Context, *EndDeviceIdentifiers) (*emptypb.Empty, error)
+	// GetMulticastGroupMembers returns the members of the multicast group of the multicast end device.
+	GetMulticastGroupMembers(context.Context, *EndDeviceIdentifiers) (*MulticastGroupMembers, error)
+	// SetMulticastGroupMembers sets the members of the multicast group of the multicast end device.
+	// The members are used to select the gateways for multicast downlinks without gateways.
+	SetMulticastGroupMembers(context.Context, *MulticastGroupMembers) (*emptypb.Empty, error)
+	// GetMulticastGroupGateways returns the gateways that are selected for multicast downlinks of the multicast end
+	// device, from the recent uplinks of the members of the multicast group.
+	GetMulticastGroupGateways(context.Context, *EndDeviceIdentifiers) (*MulticastGroupGateways, error)
+	// GetEndDeviceDownlinkBudgets returns the usage of the daily downlink budgets that apply to the end device.
+	GetEndDeviceDownlinkBudgets(context.Context, *GetEndDeviceDownlinkBudgetsRequest) (*DownlinkBudgets, error)
+	// GetApplicationDownlinkBudgets returns the usage of the daily downlink budgets that apply to the application.
+	GetApplicationDownlinkBudgets(context.Context, *GetApplicationDownlinkBudgetsRequest) (*DownlinkBudgets, error)
+	// GetEndDeviceLinkStats returns the link statistics of the end device.
+	// This requires link statistics to be enabled.
+	GetEndDeviceLinkStats(context.Context, *EndDeviceIdentifiers) (*EndDeviceLinkStats, error)
+	// ResetEndDeviceLinkStats removes the link samples of the end device, which resets its link statistics.
+	// This requires link statistics to be enabled.
+	ResetEndDeviceLinkStats(context.Context, *EndDeviceIdentifiers) (*emptypb.Empty, error)
+	// GetEndDeviceRelayAssignment returns the relay assignment state of the end device.
+	// This requires relay assignment to be enabled.
+	GetEndDeviceRelayAssignment(context.Context, *EndDeviceIdentifiers) (*EndDeviceRelayAssignment, error)
+	// ApproveEndDeviceRelayAssignment applies the suggested assignment or release of the end device.
+	// This requires relay assignment to be enabled.
+	ApproveEndDeviceRelayAssignment(context.Context, *EndDeviceIdentifiers) (*EndDeviceRelayAssignment, error)
+	// RejectEndDeviceRelayAssignment discards the suggested assignment or release of the end device.
+	// This requires relay assignment to be enabled.
+	RejectEndDeviceRelayAssignment(context.Context, *EndDeviceIdentifiers) (*emptypb.Empty, error)
+	// GetEndDeviceFCntResync returns the frame counter resynchronization state of the end device.
+	// This requires frame counter resynchronization to be enabled.
+	GetEndDeviceFCntResync(context.Context, *EndDeviceIdentifiers) (*EndDeviceFCntResync, error)
+	// ResyncEndDeviceFCnt resynchronizes the desynchronized frame counter of the end device to the frame counter of the
+	// last rejected uplink. This requires frame counter resynchronization to be enabled.
+	ResyncEndDeviceFCnt(context.Context, *EndDeviceIdentifiers) (*EndDeviceFCntResync, error)
+	// GetGatewayClassBCapacity returns the class B end devices and the ping slot occupancy of the gateway.
+	// This requires class B capacity planning to be enabled.
+	GetGatewayClassBCapacity(context.Context, *GatewayIdentifiers) (*GatewayClassBCapacity, error)
+	// GetEndDeviceRejoin returns the rejoin state of the LoRaWAN 1.1 end device.
+	// This requires rejoin handling to be enabled.
+	GetEndDeviceRejoin(context.Context, *EndDeviceIdentifiers) (*EndDeviceRejoin, error)
+	// ForceEndDeviceRejoin issues a ForceRejoinReq to the LoRaWAN 1.1 end device, which is sent in the downlinks to the
+	// end device until it rejoins. This requires rejoin handling to be enabled.
+	ForceEndDeviceRejoin(context.Context, *ForceEndDeviceRejoinRequest) (*EndDeviceRejoin, error)
+	// CancelEndDeviceForceRejoin cancels the pending ForceRejoinReq of the LoRaWAN 1.1 end device.
+	// This requires rejoin handling to be enabled.
+	CancelEndDeviceForceRejoin(context.Context, *EndDeviceIdentifiers) (*EndDeviceRejoin, error)
+	// ForceEndDevicesRejoin issues a ForceRejoinReq to the LoRaWAN 1.1 end devices of the application.
+	// This requires rejoin handling to be enabled.
+	ForceEndDevicesRejoin(context.Context, *ForceEndDevicesRejoinRequest) (*ForceEndDevicesRejoinResponse, error)
+	// SetEndDeviceRejoinPolicy sets the periodic rejoin policy of the LoRaWAN 1.1 end device.
+	// This requires rejoin handling to be enabled.
+	SetEndDeviceRejoinPolicy(context.Context, *SetEndDeviceRejoinPolicyRequest) (*EndDeviceRejoin, error)
+	// DeleteEndDeviceRejoinPolicy deletes the periodic rejoin policy of the LoRaWAN 1.1 end device.
+	// This requires rejoin handling to be enabled.
+	DeleteEndDeviceRejoinPolicy(context.Context, *EndDeviceIdentifiers) (*EndDeviceRejoin, error)
+	// ExportEndDevices exports the end devices of the application with their session, MAC state and queued downlinks.
+	// The network session keys are wrapped with the KEK with the given label.
+	ExportEndDevices(context.Context, *ExportEndDevicesRequest) (*ExportEndDevicesResponse, error)
+	// ImportEndDevices imports end devices exported by ExportEndDevices into the application.
+	// The import is atomic: either all end devices are imported, or none.
+	ImportEndDevices(context.Context, *ImportEndDevicesRequest) (*ImportEndDevicesResponse, error)
+	// SimulateMAC runs a sequence of synthetic uplinks through the MAC layer of the end device, starting from its
+	// stored MAC state or from the given end device template, and returns the MAC state and the MAC commands the
+	// Network Server would send after each uplink. The simulation does not change the end device.
+	SimulateMAC(context.Context, *SimulateMACRequest) (*MACSimulation, error)
 	mustEmbedUnimplementedNsServer()
 }
 
-// UnimplementedNsServer must be embedded to have forward compatible implementations.
-type UnimplementedNsServer struct {
+// UnimplementedNsServer must be embedded to have forward compatible implementations.
+type UnimplementedNsServer struct {
+}
+
+func (UnimplementedNsServer) GenerateDevAddr(context.Context, *emptypb.Empty) (*GenerateDevAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateDevAddr not implemented")
+}
+func (UnimplementedNsServer) GetDefaultMACSettings(context.Context, *GetDefaultMACSettingsRequest) (*MACSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultMACSettings not implemented")
+}
+func (UnimplementedNsServer) GetNetID(context.Context, *emptypb.Empty) (*GetNetIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetID not implemented")
+}
+func (UnimplementedNsServer) GetDeviceAddressPrefixes(context.Context, *emptypb.Empty) (*GetDeviceAdressPrefixesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceAddressPrefixes not implemented")
+}
+func (UnimplementedNsServer) GetDevAddrPrefixUtilization(context.Context, *emptypb.Empty) (*GetDevAddrPrefixUtilizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDevAddrPrefixUtilization not implemented")
+}
+func (UnimplementedNsServer) GetEndDeviceHandover(context.Context, *EndDeviceIdentifiers) (*EndDeviceHandover, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndDeviceHandover not implemented")
+}
+func (UnimplementedNsServer) StartEndDeviceHandover(context.Context, *StartEndDeviceHandoverRequest) (*EndDeviceHandover, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEndDeviceHandover not implemented")
+}
+func (UnimplementedNsServer) StopEndDeviceHandover(context.Context, *EndDeviceIdentifiers) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopEndDeviceHandover not implemented")
+}
+func (UnimplementedNsServer) GetMulticastGroupMembers(context.Context, *EndDeviceIdentifiers) (*MulticastGroupMembers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMulticastGroupMembers not implemented")
+}
+func (UnimplementedNsServer) SetMulticastGroupMembers(context.Context, *MulticastGroupMembers) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMulticastGroupMembers not implemented")
+}
+func (UnimplementedNsServer) GetMulticastGroupGateways(context.Context, *EndDeviceIdentifiers) (*MulticastGroupGateways, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMulticastGroupGateways not implemented")
+}
+func (UnimplementedNsServer) GetEndDeviceDownlinkBudgets(context.Context, *GetEndDeviceDownlinkBudgetsRequest) (*DownlinkBudgets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndDeviceDownlinkBudgets not implemented")
+}
+func (UnimplementedNsServer) GetApplicationDownlinkBudgets(context.Context, *GetApplicationDownlinkBudgetsRequest) (*DownlinkBudgets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationDownlinkBudgets not implemented")
+}
+func (UnimplementedNsServer) GetEndDeviceLinkStats(context.Context, *EndDeviceIdentifiers) (*EndDeviceLinkStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndDeviceLinkStats not implemented")
+}
+func (UnimplementedNsServer) ResetEndDeviceLinkStats(context.Context, *EndDeviceIdentifiers) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetEndDeviceLinkStats not implemented")
+}
+func (UnimplementedNsServer) GetEndDeviceRelayAssignment(context.Context, *EndDeviceIdentifiers) (*EndDeviceRelayAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndDeviceRelayAssignment not implemented")
+}
+func (UnimplementedNsServer) ApproveEndDeviceRelayAssignment(context.Context, *EndDeviceIdentifiers) (*EndDeviceRelayAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveEndDeviceRelayAssignment not implemented")
+}
+func (UnimplementedNsServer) RejectEndDeviceRelayAssignment(context.Context, *EndDeviceIdentifiers) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectEndDeviceRelayAssignment not implemented")
+}
+func (UnimplementedNsServer) GetEndDeviceFCntResync(context.Context, *EndDeviceIdentifiers) (*EndDeviceFCntResync, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndDeviceFCntResync not implemented")
+}
+func (UnimplementedNsServer) ResyncEndDeviceFCnt(context.Context, *EndDeviceIdentifiers) (*EndDeviceFCntResync, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResyncEndDeviceFCnt not implemented")
+}
+func (UnimplementedNsServer) GetGatewayClassBCapacity(context.Context, *GatewayIdentifiers) (*GatewayClassBCapacity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayClassBCapacity not implemented")
+}
+func (UnimplementedNsServer) GetEndDeviceRejoin(context.Context, *EndDeviceIdentifiers) (*EndDeviceRejoin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndDeviceRejoin not implemented")
+}
+func (UnimplementedNsServer) ForceEndDeviceRejoin(context.Context, *ForceEndDeviceRejoinRequest) (*EndDeviceRejoin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceEndDeviceRejoin not implemented")
+}
+func (UnimplementedNsServer) CancelEndDeviceForceRejoin(context.Context, *EndDeviceIdentifiers) (*EndDeviceRejoin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEndDeviceForceRejoin not implemented")
+}
+func (UnimplementedNsServer) ForceEndDevicesRejoin(context.Context, *ForceEndDevicesRejoinRequest) (*ForceEndDevicesRejoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceEndDevicesRejoin not implemented")
+}
+func (UnimplementedNsServer) SetEndDeviceRejoinPolicy(context.Context, *SetEndDeviceRejoinPolicyRequest) (*EndDeviceRejoin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEndDeviceRejoinPolicy not implemented")
+}
+func (UnimplementedNsServer) DeleteEndDeviceRejoinPolicy(context.Context, *EndDeviceIdentifiers) (*EndDeviceRejoin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEndDeviceRejoinPolicy not implemented")
+}
+func (UnimplementedNsServer) ExportEndDevices(context.Context, *ExportEndDevicesRequest) (*ExportEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEndDevices not implemented")
+}
+func (UnimplementedNsServer) ImportEndDevices(context.Context, *ImportEndDevicesRequest) (*ImportEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportEndDevices not implemented")
+}
+func (UnimplementedNsServer) SimulateMAC(context.Context, *SimulateMACRequest) (*MACSimulation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateMAC not implemented")
+}
+func (UnimplementedNsServer) mustEmbedUnimplementedNsServer() {}
+
+// UnsafeNsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NsServer will
+// result in compilation errors.
+type UnsafeNsServer interface {
+	mustEmbedUnimplementedNsServer()
+}
+
+func RegisterNsServer(s grpc.ServiceRegistrar, srv NsServer) {
+	s.RegisterService(&Ns_ServiceDesc, srv)
+}
+
+func _Ns_GenerateDevAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GenerateDevAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GenerateDevAddr_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GenerateDevAddr(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetDefaultMACSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDefaultMACSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetDefaultMACSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetDefaultMACSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetDefaultMACSettings(ctx, req.(*GetDefaultMACSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetNetID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetNetID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetNetID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetNetID(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetDeviceAddressPrefixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetDeviceAddressPrefixes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetDeviceAddressPrefixes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetDeviceAddressPrefixes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetDevAddrPrefixUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetDevAddrPrefixUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetDevAddrPrefixUtilization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetDevAddrPrefixUtilization(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetEndDeviceHandover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetEndDeviceHandover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetEndDeviceHandover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetEndDeviceHandover(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_StartEndDeviceHandover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEndDeviceHandoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).StartEndDeviceHandover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_StartEndDeviceHandover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).StartEndDeviceHandover(ctx, req.(*StartEndDeviceHandoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_StopEndDeviceHandover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).StopEndDeviceHandover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_StopEndDeviceHandover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).StopEndDeviceHandover(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetMulticastGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetMulticastGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetMulticastGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetMulticastGroupMembers(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_SetMulticastGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MulticastGroupMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).SetMulticastGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_SetMulticastGroupMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).SetMulticastGroupMembers(ctx, req.(*MulticastGroupMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetMulticastGroupGateways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetMulticastGroupGateways(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetMulticastGroupGateways_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetMulticastGroupGateways(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetEndDeviceDownlinkBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndDeviceDownlinkBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetEndDeviceDownlinkBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetEndDeviceDownlinkBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetEndDeviceDownlinkBudgets(ctx, req.(*GetEndDeviceDownlinkBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetApplicationDownlinkBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationDownlinkBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetApplicationDownlinkBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetApplicationDownlinkBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetApplicationDownlinkBudgets(ctx, req.(*GetApplicationDownlinkBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetEndDeviceLinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetEndDeviceLinkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetEndDeviceLinkStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetEndDeviceLinkStats(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_ResetEndDeviceLinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).ResetEndDeviceLinkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_ResetEndDeviceLinkStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).ResetEndDeviceLinkStats(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetEndDeviceRelayAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetEndDeviceRelayAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetEndDeviceRelayAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetEndDeviceRelayAssignment(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_ApproveEndDeviceRelayAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).ApproveEndDeviceRelayAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_ApproveEndDeviceRelayAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).ApproveEndDeviceRelayAssignment(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_RejectEndDeviceRelayAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).RejectEndDeviceRelayAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_RejectEndDeviceRelayAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).RejectEndDeviceRelayAssignment(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_GetEndDeviceFCntResync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetEndDeviceFCntResync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetEndDeviceFCntResync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetEndDeviceFCntResync(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedNsServer) GenerateDevAddr(context.Context, *emptypb.Empty) (*GenerateDevAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateDevAddr not implemented")
+func _Ns_ResyncEndDeviceFCnt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).ResyncEndDeviceFCnt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_ResyncEndDeviceFCnt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).ResyncEndDeviceFCnt(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNsServer) GetDefaultMACSettings(context.Context, *GetDefaultMACSettingsRequest) (*MACSettings, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultMACSettings not implemented")
+
+func _Ns_GetGatewayClassBCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetGatewayClassBCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetGatewayClassBCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetGatewayClassBCapacity(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNsServer) GetNetID(context.Context, *emptypb.Empty) (*GetNetIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNetID not implemented")
+
+func _Ns_GetEndDeviceRejoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GetEndDeviceRejoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_GetEndDeviceRejoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GetEndDeviceRejoin(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNsServer) GetDeviceAddressPrefixes(context.Context, *emptypb.Empty) (*GetDeviceAdressPrefixesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceAddressPrefixes not implemented")
+
+func _Ns_ForceEndDeviceRejoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceEndDeviceRejoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).ForceEndDeviceRejoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_ForceEndDeviceRejoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).ForceEndDeviceRejoin(ctx, req.(*ForceEndDeviceRejoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedNsServer) mustEmbedUnimplementedNsServer() {}
 
-// UnsafeNsServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to NsServer will
-// result in compilation errors.
-type UnsafeNsServer interface {
-	mustEmbedUnimplementedNsServer()
+func _Ns_CancelEndDeviceForceRejoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).CancelEndDeviceForceRejoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_CancelEndDeviceForceRejoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).CancelEndDeviceForceRejoin(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterNsServer(s grpc.ServiceRegistrar, srv NsServer) {
-	s.RegisterService(&Ns_ServiceDesc, srv)
+func _Ns_ForceEndDevicesRejoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceEndDevicesRejoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).ForceEndDevicesRejoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_ForceEndDevicesRejoin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).ForceEndDevicesRejoin(ctx, req.(*ForceEndDevicesRejoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Ns_GenerateDevAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Ns_SetEndDeviceRejoinPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEndDeviceRejoinPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NsServer).GenerateDevAddr(ctx, in)
+		return srv.(NsServer).SetEndDeviceRejoinPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ns_GenerateDevAddr_FullMethodName,
+		FullMethod: Ns_SetEndDeviceRejoinPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsServer).GenerateDevAddr(ctx, req.(*emptypb.Empty))
+		return srv.(NsServer).SetEndDeviceRejoinPolicy(ctx, req.(*SetEndDeviceRejoinPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ns_GetDefaultMACSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDefaultMACSettingsRequest)
+func _Ns_DeleteEndDeviceRejoinPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NsServer).GetDefaultMACSettings(ctx, in)
+		return srv.(NsServer).DeleteEndDeviceRejoinPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ns_GetDefaultMACSettings_FullMethodName,
+		FullMethod: Ns_DeleteEndDeviceRejoinPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsServer).GetDefaultMACSettings(ctx, req.(*GetDefaultMACSettingsRequest))
+		return srv.(NsServer).DeleteEndDeviceRejoinPolicy(ctx, req.(*EndDeviceIdentifiers))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ns_GetNetID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Ns_ExportEndDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEndDevicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NsServer).GetNetID(ctx, in)
+		return srv.(NsServer).ExportEndDevices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ns_GetNetID_FullMethodName,
+		FullMethod: Ns_ExportEndDevices_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsServer).GetNetID(ctx, req.(*emptypb.Empty))
+		return srv.(NsServer).ExportEndDevices(ctx, req.(*ExportEndDevicesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Ns_GetDeviceAddressPrefixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Ns_ImportEndDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEndDevicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(NsServer).GetDeviceAddressPrefixes(ctx, in)
+		return srv.(NsServer).ImportEndDevices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Ns_GetDeviceAddressPrefixes_FullMethodName,
+		FullMethod: Ns_ImportEndDevices_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsServer).GetDeviceAddressPrefixes(ctx, req.(*emptypb.Empty))
+		return srv.(NsServer).ImportEndDevices(ctx, req.(*ImportEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_SimulateMAC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateMACRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).SimulateMAC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_SimulateMAC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).SimulateMAC(ctx, req.(*SimulateMACRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -233,6 +1185,110 @@ var Ns_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeviceAddressPrefixes",
 			Handler:    _Ns_GetDeviceAddressPrefixes_Handler,
 		},
+		{
+			MethodName: "GetDevAddrPrefixUtilization",
+			Handler:    _Ns_GetDevAddrPrefixUtilization_Handler,
+		},
+		{
+			MethodName: "GetEndDeviceHandover",
+			Handler:    _Ns_GetEndDeviceHandover_Handler,
+		},
+		{
+			MethodName: "StartEndDeviceHandover",
+			Handler:    _Ns_StartEndDeviceHandover_Handler,
+		},
+		{
+			MethodName: "StopEndDeviceHandover",
+			Handler:    _Ns_StopEndDeviceHandover_Handler,
+		},
+		{
+			MethodName: "GetMulticastGroupMembers",
+			Handler:    _Ns_GetMulticastGroupMembers_Handler,
+		},
+		{
+			MethodName: "SetMulticastGroupMembers",
+			Handler:    _Ns_SetMulticastGroupMembers_Handler,
+		},
+		{
+			MethodName: "GetMulticastGroupGateways",
+			Handler:    _Ns_GetMulticastGroupGateways_Handler,
+		},
+		{
+			MethodName: "GetEndDeviceDownlinkBudgets",
+			Handler:    _Ns_GetEndDeviceDownlinkBudgets_Handler,
+		},
+		{
+			MethodName: "GetApplicationDownlinkBudgets",
+			Handler:    _Ns_GetApplicationDownlinkBudgets_Handler,
+		},
+		{
+			MethodName: "GetEndDeviceLinkStats",
+			Handler:    _Ns_GetEndDeviceLinkStats_Handler,
+		},
+		{
+			MethodName: "ResetEndDeviceLinkStats",
+			Handler:    _Ns_ResetEndDeviceLinkStats_Handler,
+		},
+		{
+			MethodName: "GetEndDeviceRelayAssignment",
+			Handler:    _Ns_GetEndDeviceRelayAssignment_Handler,
+		},
+		{
+			MethodName: "ApproveEndDeviceRelayAssignment",
+			Handler:    _Ns_ApproveEndDeviceRelayAssignment_Handler,
+		},
+		{
+			MethodName: "RejectEndDeviceRelayAssignment",
+			Handler:    _Ns_RejectEndDeviceRelayAssignment_Handler,
+		},
+		{
+			MethodName: "GetEndDeviceFCntResync",
+			Handler:    _Ns_GetEndDeviceFCntResync_Handler,
+		},
+		{
+			MethodName: "ResyncEndDeviceFCnt",
+			Handler:    _Ns_ResyncEndDeviceFCnt_Handler,
+		},
+		{
+			MethodName: "GetGatewayClassBCapacity",
+			Handler:    _Ns_GetGatewayClassBCapacity_Handler,
+		},
+		{
+			MethodName: "GetEndDeviceRejoin",
+			Handler:    _Ns_GetEndDeviceRejoin_Handler,
+		},
+		{
+			MethodName: "ForceEndDeviceRejoin",
+			Handler:    _Ns_ForceEndDeviceRejoin_Handler,
+		},
+		{
+			MethodName: "CancelEndDeviceForceRejoin",
+			Handler:    _Ns_CancelEndDeviceForceRejoin_Handler,
+		},
+		{
+			MethodName: "ForceEndDevicesRejoin",
+			Handler:    _Ns_ForceEndDevicesRejoin_Handler,
+		},
+		{
+			MethodName: "SetEndDeviceRejoinPolicy",
+			Handler:    _Ns_SetEndDeviceRejoinPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteEndDeviceRejoinPolicy",
+			Handler:    _Ns_DeleteEndDeviceRejoinPolicy_Handler,
+		},
+		{
+			MethodName: "ExportEndDevices",
+			Handler:    _Ns_ExportEndDevices_Handler,
+		},
+		{
+			MethodName: "ImportEndDevices",
+			Handler:    _Ns_ImportEndDevices_Handler,
+		},
+		{
+			MethodName: "SimulateMAC",
+			Handler:    _Ns_SimulateMAC_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/networkserver.proto",
@@ -545,6 +1601,100 @@ var GsNs_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "ttn/lorawan/v3/networkserver.proto",
 }
 
+const (
+	NsPacketSource_HandleUplink_FullMethodName = "/ttn.lorawan.v3.NsPacketSource/HandleUplink"
+)
+
+// NsPacketSourceClient is the client API for NsPacketSource service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NsPacketSourceClient interface {
+	// HandleUplink handles an uplink message received by the gateways of the packet source.
+	// The gateway identifiers of the packet source are kept, and the uplink tokens are passed back in downlinks.
+	HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type nsPacketSourceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNsPacketSourceClient(cc grpc.ClientConnInterface) NsPacketSourceClient {
+	return &nsPacketSourceClient{cc}
+}
+
+func (c *nsPacketSourceClient) HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NsPacketSource_HandleUplink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsPacketSourceServer is the server API for NsPacketSource service.
+// All implementations must embed UnimplementedNsPacketSourceServer
+// for forward compatibility
+type NsPacketSourceServer interface {
+	// HandleUplink handles an uplink message received by the gateways of the packet source.
+	// The gateway identifiers of the packet source are kept, and the uplink tokens are passed back in downlinks.
+	HandleUplink(context.Context, *UplinkMessage) (*emptypb.Empty, error)
+	mustEmbedUnimplementedNsPacketSourceServer()
+}
+
+// UnimplementedNsPacketSourceServer must be embedded to have forward compatible implementations.
+type UnimplementedNsPacketSourceServer struct {
+}
+
+func (UnimplementedNsPacketSourceServer) HandleUplink(context.Context, *UplinkMessage) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleUplink not implemented")
+}
+func (UnimplementedNsPacketSourceServer) mustEmbedUnimplementedNsPacketSourceServer() {}
+
+// UnsafeNsPacketSourceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NsPacketSourceServer will
+// result in compilation errors.
+type UnsafeNsPacketSourceServer interface {
+	mustEmbedUnimplementedNsPacketSourceServer()
+}
+
+func RegisterNsPacketSourceServer(s grpc.ServiceRegistrar, srv NsPacketSourceServer) {
+	s.RegisterService(&NsPacketSource_ServiceDesc, srv)
+}
+
+func _NsPacketSource_HandleUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UplinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsPacketSourceServer).HandleUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsPacketSource_HandleUplink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsPacketSourceServer).HandleUplink(ctx, req.(*UplinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NsPacketSource_ServiceDesc is the grpc.ServiceDesc for NsPacketSource service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NsPacketSource_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsPacketSource",
+	HandlerType: (*NsPacketSourceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleUplink",
+			Handler:    _NsPacketSource_HandleUplink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ttn/lorawan/v3/networkserver.proto",
+}
+
 const (
 	NsEndDeviceRegistry_Get_FullMethodName                  = "/ttn.lorawan.v3.NsEndDeviceRegistry/Get"
 	NsEndDeviceRegistry_Set_FullMethodName                  = "/ttn.lorawan.v3.NsEndDeviceRegistry/Set"
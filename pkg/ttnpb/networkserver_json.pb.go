@@ -7,8 +7,10 @@
 package ttnpb
 
 import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
 	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
 	types "go.thethings.network/lorawan-stack/v3/pkg/types"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // MarshalProtoJSON marshals the GenerateDevAddrResponse message to JSON.
@@ -190,3 +192,1996 @@ func (x *GetDeviceAdressPrefixesResponse) UnmarshalProtoJSON(s *jsonplugin.Unmar
 func (x *GetDeviceAdressPrefixesResponse) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the DevAddrPrefixUtilization message to JSON.
+func (x *DevAddrPrefixUtilization) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Prefix != nil || s.HasField("prefix") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("prefix")
+		x.Prefix.MarshalProtoJSON(s.WithField("prefix"))
+	}
+	if x.Addresses != 0 || s.HasField("addresses") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("addresses")
+		s.WriteUint64(x.Addresses)
+	}
+	if x.UsedAddresses != 0 || s.HasField("used_addresses") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("used_addresses")
+		s.WriteUint64(x.UsedAddresses)
+	}
+	if x.SharedAddresses != 0 || s.HasField("shared_addresses") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("shared_addresses")
+		s.WriteUint64(x.SharedAddresses)
+	}
+	if x.Sessions != 0 || s.HasField("sessions") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("sessions")
+		s.WriteUint64(x.Sessions)
+	}
+	if x.MaxSessionsPerAddress != 0 || s.HasField("max_sessions_per_address") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("max_sessions_per_address")
+		s.WriteUint64(x.MaxSessionsPerAddress)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the DevAddrPrefixUtilization to JSON.
+func (x *DevAddrPrefixUtilization) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the DevAddrPrefixUtilization message from JSON.
+func (x *DevAddrPrefixUtilization) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "prefix":
+			if s.ReadNil() {
+				x.Prefix = nil
+				return
+			}
+			x.Prefix = &DevAddrPrefix{}
+			x.Prefix.UnmarshalProtoJSON(s.WithField("prefix", true))
+		case "addresses":
+			s.AddField("addresses")
+			x.Addresses = s.ReadUint64()
+		case "used_addresses", "usedAddresses":
+			s.AddField("used_addresses")
+			x.UsedAddresses = s.ReadUint64()
+		case "shared_addresses", "sharedAddresses":
+			s.AddField("shared_addresses")
+			x.SharedAddresses = s.ReadUint64()
+		case "sessions":
+			s.AddField("sessions")
+			x.Sessions = s.ReadUint64()
+		case "max_sessions_per_address", "maxSessionsPerAddress":
+			s.AddField("max_sessions_per_address")
+			x.MaxSessionsPerAddress = s.ReadUint64()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the DevAddrPrefixUtilization from JSON.
+func (x *DevAddrPrefixUtilization) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetDevAddrPrefixUtilizationResponse message to JSON.
+func (x *GetDevAddrPrefixUtilizationResponse) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Prefixes) > 0 || s.HasField("prefixes") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("prefixes")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Prefixes {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("prefixes"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetDevAddrPrefixUtilizationResponse to JSON.
+func (x *GetDevAddrPrefixUtilizationResponse) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetDevAddrPrefixUtilizationResponse message from JSON.
+func (x *GetDevAddrPrefixUtilizationResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "prefixes":
+			s.AddField("prefixes")
+			if s.ReadNil() {
+				x.Prefixes = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Prefixes = append(x.Prefixes, nil)
+					return
+				}
+				v := &DevAddrPrefixUtilization{}
+				v.UnmarshalProtoJSON(s.WithField("prefixes", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Prefixes = append(x.Prefixes, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetDevAddrPrefixUtilizationResponse from JSON.
+func (x *GetDevAddrPrefixUtilizationResponse) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the EndDeviceHandover message to JSON.
+func (x *EndDeviceHandover) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.Role != 0 || s.HasField("role") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("role")
+		s.WriteEnum(int32(x.Role), HandoverRoamingRole_name)
+	}
+	if x.PartnerIds != nil || s.HasField("partner_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("partner_ids")
+		x.PartnerIds.MarshalProtoJSON(s.WithField("partner_ids"))
+	}
+	if x.CreatedAt != nil || s.HasField("created_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		if x.CreatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.CreatedAt)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the EndDeviceHandover to JSON.
+func (x *EndDeviceHandover) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the EndDeviceHandover message from JSON.
+func (x *EndDeviceHandover) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "role":
+			s.AddField("role")
+			x.Role = HandoverRoamingRole(s.ReadEnum(HandoverRoamingRole_value))
+		case "partner_ids", "partnerIds":
+			if s.ReadNil() {
+				x.PartnerIds = nil
+				return
+			}
+			x.PartnerIds = &NetworkIdentifiers{}
+			x.PartnerIds.UnmarshalProtoJSON(s.WithField("partner_ids", true))
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			if s.ReadNil() {
+				x.CreatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the EndDeviceHandover from JSON.
+func (x *EndDeviceHandover) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StartEndDeviceHandoverRequest message to JSON.
+func (x *StartEndDeviceHandoverRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.ServingNetworkIds != nil || s.HasField("serving_network_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("serving_network_ids")
+		x.ServingNetworkIds.MarshalProtoJSON(s.WithField("serving_network_ids"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StartEndDeviceHandoverRequest to JSON.
+func (x *StartEndDeviceHandoverRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StartEndDeviceHandoverRequest message from JSON.
+func (x *StartEndDeviceHandoverRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "serving_network_ids", "servingNetworkIds":
+			if s.ReadNil() {
+				x.ServingNetworkIds = nil
+				return
+			}
+			x.ServingNetworkIds = &NetworkIdentifiers{}
+			x.ServingNetworkIds.UnmarshalProtoJSON(s.WithField("serving_network_ids", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StartEndDeviceHandoverRequest from JSON.
+func (x *StartEndDeviceHandoverRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the MulticastGroupMembers message to JSON.
+func (x *MulticastGroupMembers) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if len(x.MemberDeviceIds) > 0 || s.HasField("member_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("member_device_ids")
+		s.WriteStringArray(x.MemberDeviceIds)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the MulticastGroupMembers to JSON.
+func (x *MulticastGroupMembers) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the MulticastGroupMembers message from JSON.
+func (x *MulticastGroupMembers) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "member_device_ids", "memberDeviceIds":
+			s.AddField("member_device_ids")
+			if s.ReadNil() {
+				x.MemberDeviceIds = nil
+				return
+			}
+			x.MemberDeviceIds = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the MulticastGroupMembers from JSON.
+func (x *MulticastGroupMembers) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the MulticastGroupGateways message to JSON.
+func (x *MulticastGroupGateways) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Gateways) > 0 || s.HasField("gateways") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateways")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Gateways {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("gateways"))
+		}
+		s.WriteArrayEnd()
+	}
+	if x.MemberCount != 0 || s.HasField("member_count") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("member_count")
+		s.WriteUint32(x.MemberCount)
+	}
+	if x.CoveredMemberCount != 0 || s.HasField("covered_member_count") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("covered_member_count")
+		s.WriteUint32(x.CoveredMemberCount)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the MulticastGroupGateways to JSON.
+func (x *MulticastGroupGateways) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the MulticastGroupGateways message from JSON.
+func (x *MulticastGroupGateways) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateways":
+			s.AddField("gateways")
+			if s.ReadNil() {
+				x.Gateways = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Gateways = append(x.Gateways, nil)
+					return
+				}
+				v := &ClassBCGatewayIdentifiers{}
+				v.UnmarshalProtoJSON(s.WithField("gateways", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Gateways = append(x.Gateways, v)
+			})
+		case "member_count", "memberCount":
+			s.AddField("member_count")
+			x.MemberCount = s.ReadUint32()
+		case "covered_member_count", "coveredMemberCount":
+			s.AddField("covered_member_count")
+			x.CoveredMemberCount = s.ReadUint32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the MulticastGroupGateways from JSON.
+func (x *MulticastGroupGateways) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetEndDeviceDownlinkBudgetsRequest message to JSON.
+func (x *GetEndDeviceDownlinkBudgetsRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetEndDeviceDownlinkBudgetsRequest to JSON.
+func (x *GetEndDeviceDownlinkBudgetsRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetEndDeviceDownlinkBudgetsRequest message from JSON.
+func (x *GetEndDeviceDownlinkBudgetsRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetEndDeviceDownlinkBudgetsRequest from JSON.
+func (x *GetEndDeviceDownlinkBudgetsRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ExportEndDevicesResponse message to JSON.
+func (x *ExportEndDevicesResponse) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.EndDevices) > 0 || s.HasField("end_devices") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_devices")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.EndDevices {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("end_devices"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ExportEndDevicesResponse to JSON.
+func (x *ExportEndDevicesResponse) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ExportEndDevicesResponse message from JSON.
+func (x *ExportEndDevicesResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_devices", "endDevices":
+			s.AddField("end_devices")
+			if s.ReadNil() {
+				x.EndDevices = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.EndDevices = append(x.EndDevices, nil)
+					return
+				}
+				v := &EndDevice{}
+				v.UnmarshalProtoJSON(s.WithField("end_devices", false))
+				if s.Err() != nil {
+					return
+				}
+				x.EndDevices = append(x.EndDevices, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ExportEndDevicesResponse from JSON.
+func (x *ExportEndDevicesResponse) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ImportEndDevicesRequest message to JSON.
+func (x *ImportEndDevicesRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ApplicationIds != nil || s.HasField("application_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("application_ids")
+		// NOTE: ApplicationIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.ApplicationIds)
+	}
+	if len(x.EndDevices) > 0 || s.HasField("end_devices") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_devices")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.EndDevices {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("end_devices"))
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Replace || s.HasField("replace") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("replace")
+		s.WriteBool(x.Replace)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ImportEndDevicesRequest to JSON.
+func (x *ImportEndDevicesRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ImportEndDevicesRequest message from JSON.
+func (x *ImportEndDevicesRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "application_ids", "applicationIds":
+			s.AddField("application_ids")
+			if s.ReadNil() {
+				x.ApplicationIds = nil
+				return
+			}
+			// NOTE: ApplicationIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.ApplicationIds = &v
+		case "end_devices", "endDevices":
+			s.AddField("end_devices")
+			if s.ReadNil() {
+				x.EndDevices = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.EndDevices = append(x.EndDevices, nil)
+					return
+				}
+				v := &EndDevice{}
+				v.UnmarshalProtoJSON(s.WithField("end_devices", false))
+				if s.Err() != nil {
+					return
+				}
+				x.EndDevices = append(x.EndDevices, v)
+			})
+		case "replace":
+			s.AddField("replace")
+			x.Replace = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ImportEndDevicesRequest from JSON.
+func (x *ImportEndDevicesRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the SimulateMACRequest_Uplink message to JSON.
+func (x *SimulateMACRequest_Uplink) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ReceivedAt != nil || s.HasField("received_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("received_at")
+		if x.ReceivedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.ReceivedAt)
+		}
+	}
+	if x.DataRateIndex != nil || s.HasField("data_rate_index") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("data_rate_index")
+		x.DataRateIndex.MarshalProtoJSON(s.WithField("data_rate_index"))
+	}
+	if x.ChannelIndex != nil || s.HasField("channel_index") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("channel_index")
+		if x.ChannelIndex == nil {
+			s.WriteNil()
+		} else {
+			s.WriteUint32(x.ChannelIndex.Value)
+		}
+	}
+	if x.Snr != 0 || s.HasField("snr") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("snr")
+		s.WriteFloat32(x.Snr)
+	}
+	if x.Rssi != 0 || s.HasField("rssi") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rssi")
+		s.WriteFloat32(x.Rssi)
+	}
+	if x.GatewayCount != 0 || s.HasField("gateway_count") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_count")
+		s.WriteUint32(x.GatewayCount)
+	}
+	if x.Adr != nil || s.HasField("adr") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("adr")
+		if x.Adr == nil {
+			s.WriteNil()
+		} else {
+			s.WriteBool(x.Adr.Value)
+		}
+	}
+	if x.AdrAckReq || s.HasField("adr_ack_req") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("adr_ack_req")
+		s.WriteBool(x.AdrAckReq)
+	}
+	if x.Confirmed || s.HasField("confirmed") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("confirmed")
+		s.WriteBool(x.Confirmed)
+	}
+	if len(x.MacCommands) > 0 || s.HasField("mac_commands") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("mac_commands")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.MacCommands {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("mac_commands"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SimulateMACRequest_Uplink to JSON.
+func (x *SimulateMACRequest_Uplink) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SimulateMACRequest_Uplink message from JSON.
+func (x *SimulateMACRequest_Uplink) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "received_at", "receivedAt":
+			s.AddField("received_at")
+			if s.ReadNil() {
+				x.ReceivedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.ReceivedAt = v
+		case "data_rate_index", "dataRateIndex":
+			s.AddField("data_rate_index")
+			if s.ReadNil() {
+				x.DataRateIndex = nil
+				return
+			}
+			x.DataRateIndex = &DataRateIndexValue{}
+			x.DataRateIndex.UnmarshalProtoJSON(s.WithField("data_rate_index", false))
+		case "channel_index", "channelIndex":
+			s.AddField("channel_index")
+			if s.ReadNil() {
+				x.ChannelIndex = nil
+				return
+			}
+			v := s.ReadWrappedUint32()
+			if s.Err() != nil {
+				return
+			}
+			x.ChannelIndex = &wrapperspb.UInt32Value{Value: v}
+		case "snr":
+			s.AddField("snr")
+			x.Snr = s.ReadFloat32()
+		case "rssi":
+			s.AddField("rssi")
+			x.Rssi = s.ReadFloat32()
+		case "gateway_count", "gatewayCount":
+			s.AddField("gateway_count")
+			x.GatewayCount = s.ReadUint32()
+		case "adr":
+			s.AddField("adr")
+			if s.ReadNil() {
+				x.Adr = nil
+				return
+			}
+			v := s.ReadWrappedBool()
+			if s.Err() != nil {
+				return
+			}
+			x.Adr = &wrapperspb.BoolValue{Value: v}
+		case "adr_ack_req", "adrAckReq":
+			s.AddField("adr_ack_req")
+			x.AdrAckReq = s.ReadBool()
+		case "confirmed":
+			s.AddField("confirmed")
+			x.Confirmed = s.ReadBool()
+		case "mac_commands", "macCommands":
+			s.AddField("mac_commands")
+			if s.ReadNil() {
+				x.MacCommands = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.MacCommands = append(x.MacCommands, nil)
+					return
+				}
+				v := &MACCommand{}
+				v.UnmarshalProtoJSON(s.WithField("mac_commands", false))
+				if s.Err() != nil {
+					return
+				}
+				x.MacCommands = append(x.MacCommands, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SimulateMACRequest_Uplink from JSON.
+func (x *SimulateMACRequest_Uplink) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the SimulateMACRequest message to JSON.
+func (x *SimulateMACRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.EndDevice != nil || s.HasField("end_device") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device")
+		x.EndDevice.MarshalProtoJSON(s.WithField("end_device"))
+	}
+	if len(x.Uplinks) > 0 || s.HasField("uplinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uplinks")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Uplinks {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("uplinks"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SimulateMACRequest to JSON.
+func (x *SimulateMACRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SimulateMACRequest message from JSON.
+func (x *SimulateMACRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "end_device", "endDevice":
+			if s.ReadNil() {
+				x.EndDevice = nil
+				return
+			}
+			x.EndDevice = &EndDevice{}
+			x.EndDevice.UnmarshalProtoJSON(s.WithField("end_device", true))
+		case "uplinks":
+			s.AddField("uplinks")
+			if s.ReadNil() {
+				x.Uplinks = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Uplinks = append(x.Uplinks, nil)
+					return
+				}
+				v := &SimulateMACRequest_Uplink{}
+				v.UnmarshalProtoJSON(s.WithField("uplinks", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Uplinks = append(x.Uplinks, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SimulateMACRequest from JSON.
+func (x *SimulateMACRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the MACSimulation_Step message to JSON.
+func (x *MACSimulation_Step) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.FCnt != 0 || s.HasField("f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_cnt")
+		s.WriteUint32(x.FCnt)
+	}
+	if x.DataRateIndex != 0 || s.HasField("data_rate_index") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("data_rate_index")
+		x.DataRateIndex.MarshalProtoJSON(s)
+	}
+	if x.ChannelIndex != 0 || s.HasField("channel_index") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("channel_index")
+		s.WriteUint32(x.ChannelIndex)
+	}
+	if len(x.Events) > 0 || s.HasField("events") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("events")
+		s.WriteStringArray(x.Events)
+	}
+	if x.AdrDecision != nil || s.HasField("adr_decision") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("adr_decision")
+		if x.AdrDecision == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalStruct(s, x.AdrDecision)
+		}
+	}
+	if len(x.DownlinkMacCommands) > 0 || s.HasField("downlink_mac_commands") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("downlink_mac_commands")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.DownlinkMacCommands {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("downlink_mac_commands"))
+		}
+		s.WriteArrayEnd()
+	}
+	if x.FPending || s.HasField("f_pending") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_pending")
+		s.WriteBool(x.FPending)
+	}
+	if x.MacState != nil || s.HasField("mac_state") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("mac_state")
+		x.MacState.MarshalProtoJSON(s.WithField("mac_state"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the MACSimulation_Step to JSON.
+func (x *MACSimulation_Step) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the MACSimulation_Step message from JSON.
+func (x *MACSimulation_Step) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "f_cnt", "fCnt":
+			s.AddField("f_cnt")
+			x.FCnt = s.ReadUint32()
+		case "data_rate_index", "dataRateIndex":
+			s.AddField("data_rate_index")
+			x.DataRateIndex.UnmarshalProtoJSON(s)
+		case "channel_index", "channelIndex":
+			s.AddField("channel_index")
+			x.ChannelIndex = s.ReadUint32()
+		case "events":
+			s.AddField("events")
+			if s.ReadNil() {
+				x.Events = nil
+				return
+			}
+			x.Events = s.ReadStringArray()
+		case "adr_decision", "adrDecision":
+			s.AddField("adr_decision")
+			if s.ReadNil() {
+				x.AdrDecision = nil
+				return
+			}
+			v := golang.UnmarshalStruct(s)
+			if s.Err() != nil {
+				return
+			}
+			x.AdrDecision = v
+		case "downlink_mac_commands", "downlinkMacCommands":
+			s.AddField("downlink_mac_commands")
+			if s.ReadNil() {
+				x.DownlinkMacCommands = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.DownlinkMacCommands = append(x.DownlinkMacCommands, nil)
+					return
+				}
+				v := &MACCommand{}
+				v.UnmarshalProtoJSON(s.WithField("downlink_mac_commands", false))
+				if s.Err() != nil {
+					return
+				}
+				x.DownlinkMacCommands = append(x.DownlinkMacCommands, v)
+			})
+		case "f_pending", "fPending":
+			s.AddField("f_pending")
+			x.FPending = s.ReadBool()
+		case "mac_state", "macState":
+			if s.ReadNil() {
+				x.MacState = nil
+				return
+			}
+			x.MacState = &MACState{}
+			x.MacState.UnmarshalProtoJSON(s.WithField("mac_state", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the MACSimulation_Step from JSON.
+func (x *MACSimulation_Step) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the MACSimulation message to JSON.
+func (x *MACSimulation) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Steps) > 0 || s.HasField("steps") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("steps")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Steps {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("steps"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the MACSimulation to JSON.
+func (x *MACSimulation) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the MACSimulation message from JSON.
+func (x *MACSimulation) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "steps":
+			s.AddField("steps")
+			if s.ReadNil() {
+				x.Steps = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Steps = append(x.Steps, nil)
+					return
+				}
+				v := &MACSimulation_Step{}
+				v.UnmarshalProtoJSON(s.WithField("steps", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Steps = append(x.Steps, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the MACSimulation from JSON.
+func (x *MACSimulation) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the EndDeviceRelayAssignment message to JSON.
+func (x *EndDeviceRelayAssignment) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.RelayedUplinks != 0 || s.HasField("relayed_uplinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("relayed_uplinks")
+		s.WriteUint32(x.RelayedUplinks)
+	}
+	if x.PoorUplinks != 0 || s.HasField("poor_uplinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("poor_uplinks")
+		s.WriteUint32(x.PoorUplinks)
+	}
+	if x.CoveredUplinks != 0 || s.HasField("covered_uplinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("covered_uplinks")
+		s.WriteUint32(x.CoveredUplinks)
+	}
+	if x.ServingDeviceId != "" || s.HasField("serving_device_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("serving_device_id")
+		s.WriteString(x.ServingDeviceId)
+	}
+	if x.Suggestion != nil || s.HasField("suggestion") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("suggestion")
+		// NOTE: RelayAssignmentSuggestion does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Suggestion)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the EndDeviceRelayAssignment to JSON.
+func (x *EndDeviceRelayAssignment) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the EndDeviceRelayAssignment message from JSON.
+func (x *EndDeviceRelayAssignment) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "relayed_uplinks", "relayedUplinks":
+			s.AddField("relayed_uplinks")
+			x.RelayedUplinks = s.ReadUint32()
+		case "poor_uplinks", "poorUplinks":
+			s.AddField("poor_uplinks")
+			x.PoorUplinks = s.ReadUint32()
+		case "covered_uplinks", "coveredUplinks":
+			s.AddField("covered_uplinks")
+			x.CoveredUplinks = s.ReadUint32()
+		case "serving_device_id", "servingDeviceId":
+			s.AddField("serving_device_id")
+			x.ServingDeviceId = s.ReadString()
+		case "suggestion":
+			s.AddField("suggestion")
+			if s.ReadNil() {
+				x.Suggestion = nil
+				return
+			}
+			// NOTE: RelayAssignmentSuggestion does not seem to implement UnmarshalProtoJSON.
+			var v RelayAssignmentSuggestion
+			golang.UnmarshalMessage(s, &v)
+			x.Suggestion = &v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the EndDeviceRelayAssignment from JSON.
+func (x *EndDeviceRelayAssignment) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the EndDeviceFCntResync message to JSON.
+func (x *EndDeviceFCntResync) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.Desynchronized || s.HasField("desynchronized") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("desynchronized")
+		s.WriteBool(x.Desynchronized)
+	}
+	if x.AutoResync || s.HasField("auto_resync") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("auto_resync")
+		s.WriteBool(x.AutoResync)
+	}
+	if len(x.Rejections) > 0 || s.HasField("rejections") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rejections")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Rejections {
+			s.WriteMoreIf(&wroteElement)
+			// NOTE: FCntRejection does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, element)
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.Audit) > 0 || s.HasField("audit") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("audit")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Audit {
+			s.WriteMoreIf(&wroteElement)
+			// NOTE: FCntResyncRecord does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, element)
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the EndDeviceFCntResync to JSON.
+func (x *EndDeviceFCntResync) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the EndDeviceFCntResync message from JSON.
+func (x *EndDeviceFCntResync) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "desynchronized":
+			s.AddField("desynchronized")
+			x.Desynchronized = s.ReadBool()
+		case "auto_resync", "autoResync":
+			s.AddField("auto_resync")
+			x.AutoResync = s.ReadBool()
+		case "rejections":
+			s.AddField("rejections")
+			if s.ReadNil() {
+				x.Rejections = nil
+				return
+			}
+			s.ReadArray(func() {
+				// NOTE: FCntRejection does not seem to implement UnmarshalProtoJSON.
+				var v FCntRejection
+				golang.UnmarshalMessage(s, &v)
+				x.Rejections = append(x.Rejections, &v)
+			})
+		case "audit":
+			s.AddField("audit")
+			if s.ReadNil() {
+				x.Audit = nil
+				return
+			}
+			s.ReadArray(func() {
+				// NOTE: FCntResyncRecord does not seem to implement UnmarshalProtoJSON.
+				var v FCntResyncRecord
+				golang.UnmarshalMessage(s, &v)
+				x.Audit = append(x.Audit, &v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the EndDeviceFCntResync from JSON.
+func (x *EndDeviceFCntResync) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayClassBCapacity message to JSON.
+func (x *GatewayClassBCapacity) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.DeviceCount != 0 || s.HasField("device_count") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_count")
+		s.WriteUint32(x.DeviceCount)
+	}
+	if x.PingSlotCount != 0 || s.HasField("ping_slot_count") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ping_slot_count")
+		s.WriteUint32(x.PingSlotCount)
+	}
+	if x.DevicesByFrequency != nil || s.HasField("devices_by_frequency") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("devices_by_frequency")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.DevicesByFrequency {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectUint64Field(k)
+			s.WriteUint32(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if len(x.BeaconPeriods) > 0 || s.HasField("beacon_periods") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("beacon_periods")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.BeaconPeriods {
+			s.WriteMoreIf(&wroteElement)
+			// NOTE: GatewayClassBCapacity_BeaconPeriod does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, element)
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayClassBCapacity to JSON.
+func (x *GatewayClassBCapacity) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayClassBCapacity message from JSON.
+func (x *GatewayClassBCapacity) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "device_count", "deviceCount":
+			s.AddField("device_count")
+			x.DeviceCount = s.ReadUint32()
+		case "ping_slot_count", "pingSlotCount":
+			s.AddField("ping_slot_count")
+			x.PingSlotCount = s.ReadUint32()
+		case "devices_by_frequency", "devicesByFrequency":
+			s.AddField("devices_by_frequency")
+			if s.ReadNil() {
+				x.DevicesByFrequency = nil
+				return
+			}
+			x.DevicesByFrequency = make(map[uint64]uint32)
+			s.ReadUint64Map(func(key uint64) {
+				x.DevicesByFrequency[key] = s.ReadUint32()
+			})
+		case "beacon_periods", "beaconPeriods":
+			s.AddField("beacon_periods")
+			if s.ReadNil() {
+				x.BeaconPeriods = nil
+				return
+			}
+			s.ReadArray(func() {
+				// NOTE: GatewayClassBCapacity_BeaconPeriod does not seem to implement UnmarshalProtoJSON.
+				var v GatewayClassBCapacity_BeaconPeriod
+				golang.UnmarshalMessage(s, &v)
+				x.BeaconPeriods = append(x.BeaconPeriods, &v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayClassBCapacity from JSON.
+func (x *GatewayClassBCapacity) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the EndDeviceRejoin_ForceRejoin message to JSON.
+func (x *EndDeviceRejoin_ForceRejoin) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ForceRejoinReq != nil || s.HasField("force_rejoin_req") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("force_rejoin_req")
+		x.ForceRejoinReq.MarshalProtoJSON(s.WithField("force_rejoin_req"))
+	}
+	if x.Periodic || s.HasField("periodic") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("periodic")
+		s.WriteBool(x.Periodic)
+	}
+	if x.IssuedAt != nil || s.HasField("issued_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("issued_at")
+		if x.IssuedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.IssuedAt)
+		}
+	}
+	if x.Transmissions != 0 || s.HasField("transmissions") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("transmissions")
+		s.WriteUint32(x.Transmissions)
+	}
+	if x.SentAt != nil || s.HasField("sent_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("sent_at")
+		if x.SentAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.SentAt)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the EndDeviceRejoin_ForceRejoin to JSON.
+func (x *EndDeviceRejoin_ForceRejoin) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the EndDeviceRejoin_ForceRejoin message from JSON.
+func (x *EndDeviceRejoin_ForceRejoin) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "force_rejoin_req", "forceRejoinReq":
+			if s.ReadNil() {
+				x.ForceRejoinReq = nil
+				return
+			}
+			x.ForceRejoinReq = &MACCommand_ForceRejoinReq{}
+			x.ForceRejoinReq.UnmarshalProtoJSON(s.WithField("force_rejoin_req", true))
+		case "periodic":
+			s.AddField("periodic")
+			x.Periodic = s.ReadBool()
+		case "issued_at", "issuedAt":
+			s.AddField("issued_at")
+			if s.ReadNil() {
+				x.IssuedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.IssuedAt = v
+		case "transmissions":
+			s.AddField("transmissions")
+			x.Transmissions = s.ReadUint32()
+		case "sent_at", "sentAt":
+			s.AddField("sent_at")
+			if s.ReadNil() {
+				x.SentAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.SentAt = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the EndDeviceRejoin_ForceRejoin from JSON.
+func (x *EndDeviceRejoin_ForceRejoin) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the EndDeviceRejoin_Policy message to JSON.
+func (x *EndDeviceRejoin_Policy) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ForceRejoinReq != nil || s.HasField("force_rejoin_req") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("force_rejoin_req")
+		x.ForceRejoinReq.MarshalProtoJSON(s.WithField("force_rejoin_req"))
+	}
+	if x.Interval != nil || s.HasField("interval") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("interval")
+		if x.Interval == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Interval)
+		}
+	}
+	if x.SetAt != nil || s.HasField("set_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("set_at")
+		if x.SetAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.SetAt)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the EndDeviceRejoin_Policy to JSON.
+func (x *EndDeviceRejoin_Policy) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the EndDeviceRejoin_Policy message from JSON.
+func (x *EndDeviceRejoin_Policy) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "force_rejoin_req", "forceRejoinReq":
+			if s.ReadNil() {
+				x.ForceRejoinReq = nil
+				return
+			}
+			x.ForceRejoinReq = &MACCommand_ForceRejoinReq{}
+			x.ForceRejoinReq.UnmarshalProtoJSON(s.WithField("force_rejoin_req", true))
+		case "interval":
+			s.AddField("interval")
+			if s.ReadNil() {
+				x.Interval = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Interval = v
+		case "set_at", "setAt":
+			s.AddField("set_at")
+			if s.ReadNil() {
+				x.SetAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.SetAt = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the EndDeviceRejoin_Policy from JSON.
+func (x *EndDeviceRejoin_Policy) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the EndDeviceRejoin_Rejoin message to JSON.
+func (x *EndDeviceRejoin_Rejoin) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.RejoinType != 0 || s.HasField("rejoin_type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rejoin_type")
+		x.RejoinType.MarshalProtoJSON(s)
+	}
+	if x.Forced || s.HasField("forced") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("forced")
+		s.WriteBool(x.Forced)
+	}
+	if x.Periodic || s.HasField("periodic") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("periodic")
+		s.WriteBool(x.Periodic)
+	}
+	if x.ReceivedAt != nil || s.HasField("received_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("received_at")
+		if x.ReceivedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.ReceivedAt)
+		}
+	}
+	if x.CompletedAt != nil || s.HasField("completed_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("completed_at")
+		if x.CompletedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.CompletedAt)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the EndDeviceRejoin_Rejoin to JSON.
+func (x *EndDeviceRejoin_Rejoin) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the EndDeviceRejoin_Rejoin message from JSON.
+func (x *EndDeviceRejoin_Rejoin) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "rejoin_type", "rejoinType":
+			s.AddField("rejoin_type")
+			x.RejoinType.UnmarshalProtoJSON(s)
+		case "forced":
+			s.AddField("forced")
+			x.Forced = s.ReadBool()
+		case "periodic":
+			s.AddField("periodic")
+			x.Periodic = s.ReadBool()
+		case "received_at", "receivedAt":
+			s.AddField("received_at")
+			if s.ReadNil() {
+				x.ReceivedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.ReceivedAt = v
+		case "completed_at", "completedAt":
+			s.AddField("completed_at")
+			if s.ReadNil() {
+				x.CompletedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.CompletedAt = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the EndDeviceRejoin_Rejoin from JSON.
+func (x *EndDeviceRejoin_Rejoin) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the EndDeviceRejoin message to JSON.
+func (x *EndDeviceRejoin) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.ForceRejoin != nil || s.HasField("force_rejoin") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("force_rejoin")
+		x.ForceRejoin.MarshalProtoJSON(s.WithField("force_rejoin"))
+	}
+	if x.Policy != nil || s.HasField("policy") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("policy")
+		x.Policy.MarshalProtoJSON(s.WithField("policy"))
+	}
+	if x.PendingRejoin != nil || s.HasField("pending_rejoin") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("pending_rejoin")
+		x.PendingRejoin.MarshalProtoJSON(s.WithField("pending_rejoin"))
+	}
+	if x.LastRejoin != nil || s.HasField("last_rejoin") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("last_rejoin")
+		x.LastRejoin.MarshalProtoJSON(s.WithField("last_rejoin"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the EndDeviceRejoin to JSON.
+func (x *EndDeviceRejoin) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the EndDeviceRejoin message from JSON.
+func (x *EndDeviceRejoin) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "force_rejoin", "forceRejoin":
+			if s.ReadNil() {
+				x.ForceRejoin = nil
+				return
+			}
+			x.ForceRejoin = &EndDeviceRejoin_ForceRejoin{}
+			x.ForceRejoin.UnmarshalProtoJSON(s.WithField("force_rejoin", true))
+		case "policy":
+			if s.ReadNil() {
+				x.Policy = nil
+				return
+			}
+			x.Policy = &EndDeviceRejoin_Policy{}
+			x.Policy.UnmarshalProtoJSON(s.WithField("policy", true))
+		case "pending_rejoin", "pendingRejoin":
+			if s.ReadNil() {
+				x.PendingRejoin = nil
+				return
+			}
+			x.PendingRejoin = &EndDeviceRejoin_Rejoin{}
+			x.PendingRejoin.UnmarshalProtoJSON(s.WithField("pending_rejoin", true))
+		case "last_rejoin", "lastRejoin":
+			if s.ReadNil() {
+				x.LastRejoin = nil
+				return
+			}
+			x.LastRejoin = &EndDeviceRejoin_Rejoin{}
+			x.LastRejoin.UnmarshalProtoJSON(s.WithField("last_rejoin", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the EndDeviceRejoin from JSON.
+func (x *EndDeviceRejoin) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ForceEndDeviceRejoinRequest message to JSON.
+func (x *ForceEndDeviceRejoinRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.ForceRejoinReq != nil || s.HasField("force_rejoin_req") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("force_rejoin_req")
+		x.ForceRejoinReq.MarshalProtoJSON(s.WithField("force_rejoin_req"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ForceEndDeviceRejoinRequest to JSON.
+func (x *ForceEndDeviceRejoinRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ForceEndDeviceRejoinRequest message from JSON.
+func (x *ForceEndDeviceRejoinRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "force_rejoin_req", "forceRejoinReq":
+			if s.ReadNil() {
+				x.ForceRejoinReq = nil
+				return
+			}
+			x.ForceRejoinReq = &MACCommand_ForceRejoinReq{}
+			x.ForceRejoinReq.UnmarshalProtoJSON(s.WithField("force_rejoin_req", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ForceEndDeviceRejoinRequest from JSON.
+func (x *ForceEndDeviceRejoinRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the SetEndDeviceRejoinPolicyRequest message to JSON.
+func (x *SetEndDeviceRejoinPolicyRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.ForceRejoinReq != nil || s.HasField("force_rejoin_req") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("force_rejoin_req")
+		x.ForceRejoinReq.MarshalProtoJSON(s.WithField("force_rejoin_req"))
+	}
+	if x.Interval != nil || s.HasField("interval") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("interval")
+		if x.Interval == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Interval)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SetEndDeviceRejoinPolicyRequest to JSON.
+func (x *SetEndDeviceRejoinPolicyRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SetEndDeviceRejoinPolicyRequest message from JSON.
+func (x *SetEndDeviceRejoinPolicyRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "force_rejoin_req", "forceRejoinReq":
+			if s.ReadNil() {
+				x.ForceRejoinReq = nil
+				return
+			}
+			x.ForceRejoinReq = &MACCommand_ForceRejoinReq{}
+			x.ForceRejoinReq.UnmarshalProtoJSON(s.WithField("force_rejoin_req", true))
+		case "interval":
+			s.AddField("interval")
+			if s.ReadNil() {
+				x.Interval = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Interval = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SetEndDeviceRejoinPolicyRequest from JSON.
+func (x *SetEndDeviceRejoinPolicyRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ForceEndDevicesRejoinRequest message to JSON.
+func (x *ForceEndDevicesRejoinRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ApplicationIds != nil || s.HasField("application_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("application_ids")
+		// NOTE: ApplicationIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.ApplicationIds)
+	}
+	if len(x.DeviceIds) > 0 || s.HasField("device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_ids")
+		s.WriteStringArray(x.DeviceIds)
+	}
+	if x.ForceRejoinReq != nil || s.HasField("force_rejoin_req") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("force_rejoin_req")
+		x.ForceRejoinReq.MarshalProtoJSON(s.WithField("force_rejoin_req"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ForceEndDevicesRejoinRequest to JSON.
+func (x *ForceEndDevicesRejoinRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ForceEndDevicesRejoinRequest message from JSON.
+func (x *ForceEndDevicesRejoinRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "application_ids", "applicationIds":
+			s.AddField("application_ids")
+			if s.ReadNil() {
+				x.ApplicationIds = nil
+				return
+			}
+			// NOTE: ApplicationIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.ApplicationIds = &v
+		case "device_ids", "deviceIds":
+			s.AddField("device_ids")
+			if s.ReadNil() {
+				x.DeviceIds = nil
+				return
+			}
+			x.DeviceIds = s.ReadStringArray()
+		case "force_rejoin_req", "forceRejoinReq":
+			if s.ReadNil() {
+				x.ForceRejoinReq = nil
+				return
+			}
+			x.ForceRejoinReq = &MACCommand_ForceRejoinReq{}
+			x.ForceRejoinReq.UnmarshalProtoJSON(s.WithField("force_rejoin_req", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ForceEndDevicesRejoinRequest from JSON.
+func (x *ForceEndDevicesRejoinRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -13,6 +13,7 @@ var CreateMACSettingsProfileRequestFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.adr.mode",
 	"mac_settings_profile.mac_settings.adr.mode.disabled",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic",
+	"mac_settings_profile.mac_settings.adr.mode.dynamic.algorithm",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -80,6 +81,8 @@ var CreateMACSettingsProfileRequestFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.adr.mode.static.nb_trans",
 	"mac_settings_profile.mac_settings.adr.mode.static.tx_power_index",
 	"mac_settings_profile.mac_settings.adr_margin",
+	"mac_settings_profile.mac_settings.auto_f_cnt_resync",
+	"mac_settings_profile.mac_settings.auto_f_cnt_resync.value",
 	"mac_settings_profile.mac_settings.beacon_frequency",
 	"mac_settings_profile.mac_settings.beacon_frequency.value",
 	"mac_settings_profile.mac_settings.class_b_c_downlink_interval",
@@ -145,6 +148,7 @@ var CreateMACSettingsProfileRequestFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.desired_rx2_frequency.value",
 	"mac_settings_profile.mac_settings.downlink_dwell_time",
 	"mac_settings_profile.mac_settings.downlink_dwell_time.value",
+	"mac_settings_profile.mac_settings.downlink_path_ranking",
 	"mac_settings_profile.mac_settings.factory_preset_frequencies",
 	"mac_settings_profile.mac_settings.max_duty_cycle",
 	"mac_settings_profile.mac_settings.max_duty_cycle.value",
@@ -226,6 +230,7 @@ var CreateMACSettingsProfileResponseFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.adr.mode",
 	"mac_settings_profile.mac_settings.adr.mode.disabled",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic",
+	"mac_settings_profile.mac_settings.adr.mode.dynamic.algorithm",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -293,6 +298,8 @@ var CreateMACSettingsProfileResponseFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.adr.mode.static.nb_trans",
 	"mac_settings_profile.mac_settings.adr.mode.static.tx_power_index",
 	"mac_settings_profile.mac_settings.adr_margin",
+	"mac_settings_profile.mac_settings.auto_f_cnt_resync",
+	"mac_settings_profile.mac_settings.auto_f_cnt_resync.value",
 	"mac_settings_profile.mac_settings.beacon_frequency",
 	"mac_settings_profile.mac_settings.beacon_frequency.value",
 	"mac_settings_profile.mac_settings.class_b_c_downlink_interval",
@@ -358,6 +365,7 @@ var CreateMACSettingsProfileResponseFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.desired_rx2_frequency.value",
 	"mac_settings_profile.mac_settings.downlink_dwell_time",
 	"mac_settings_profile.mac_settings.downlink_dwell_time.value",
+	"mac_settings_profile.mac_settings.downlink_path_ranking",
 	"mac_settings_profile.mac_settings.factory_preset_frequencies",
 	"mac_settings_profile.mac_settings.max_duty_cycle",
 	"mac_settings_profile.mac_settings.max_duty_cycle.value",
@@ -451,6 +459,7 @@ var GetMACSettingsProfileResponseFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.adr.mode",
 	"mac_settings_profile.mac_settings.adr.mode.disabled",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic",
+	"mac_settings_profile.mac_settings.adr.mode.dynamic.algorithm",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -518,6 +527,8 @@ var GetMACSettingsProfileResponseFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.adr.mode.static.nb_trans",
 	"mac_settings_profile.mac_settings.adr.mode.static.tx_power_index",
 	"mac_settings_profile.mac_settings.adr_margin",
+	"mac_settings_profile.mac_settings.auto_f_cnt_resync",
+	"mac_settings_profile.mac_settings.auto_f_cnt_resync.value",
 	"mac_settings_profile.mac_settings.beacon_frequency",
 	"mac_settings_profile.mac_settings.beacon_frequency.value",
 	"mac_settings_profile.mac_settings.class_b_c_downlink_interval",
@@ -583,6 +594,7 @@ var GetMACSettingsProfileResponseFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.desired_rx2_frequency.value",
 	"mac_settings_profile.mac_settings.downlink_dwell_time",
 	"mac_settings_profile.mac_settings.downlink_dwell_time.value",
+	"mac_settings_profile.mac_settings.downlink_path_ranking",
 	"mac_settings_profile.mac_settings.factory_preset_frequencies",
 	"mac_settings_profile.mac_settings.max_duty_cycle",
 	"mac_settings_profile.mac_settings.max_duty_cycle.value",
@@ -665,6 +677,7 @@ var UpdateMACSettingsProfileRequestFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.adr.mode",
 	"mac_settings_profile.mac_settings.adr.mode.disabled",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic",
+	"mac_settings_profile.mac_settings.adr.mode.dynamic.algorithm",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -732,6 +745,8 @@ var UpdateMACSettingsProfileRequestFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.adr.mode.static.nb_trans",
 	"mac_settings_profile.mac_settings.adr.mode.static.tx_power_index",
 	"mac_settings_profile.mac_settings.adr_margin",
+	"mac_settings_profile.mac_settings.auto_f_cnt_resync",
+	"mac_settings_profile.mac_settings.auto_f_cnt_resync.value",
 	"mac_settings_profile.mac_settings.beacon_frequency",
 	"mac_settings_profile.mac_settings.beacon_frequency.value",
 	"mac_settings_profile.mac_settings.class_b_c_downlink_interval",
@@ -797,6 +812,7 @@ var UpdateMACSettingsProfileRequestFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.desired_rx2_frequency.value",
 	"mac_settings_profile.mac_settings.downlink_dwell_time",
 	"mac_settings_profile.mac_settings.downlink_dwell_time.value",
+	"mac_settings_profile.mac_settings.downlink_path_ranking",
 	"mac_settings_profile.mac_settings.factory_preset_frequencies",
 	"mac_settings_profile.mac_settings.max_duty_cycle",
 	"mac_settings_profile.mac_settings.max_duty_cycle.value",
@@ -884,6 +900,7 @@ var UpdateMACSettingsProfileResponseFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.adr.mode",
 	"mac_settings_profile.mac_settings.adr.mode.disabled",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic",
+	"mac_settings_profile.mac_settings.adr.mode.dynamic.algorithm",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"mac_settings_profile.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -951,6 +968,8 @@ var UpdateMACSettingsProfileResponseFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.adr.mode.static.nb_trans",
 	"mac_settings_profile.mac_settings.adr.mode.static.tx_power_index",
 	"mac_settings_profile.mac_settings.adr_margin",
+	"mac_settings_profile.mac_settings.auto_f_cnt_resync",
+	"mac_settings_profile.mac_settings.auto_f_cnt_resync.value",
 	"mac_settings_profile.mac_settings.beacon_frequency",
 	"mac_settings_profile.mac_settings.beacon_frequency.value",
 	"mac_settings_profile.mac_settings.class_b_c_downlink_interval",
@@ -1016,6 +1035,7 @@ var UpdateMACSettingsProfileResponseFieldPathsNested = []string{
 	"mac_settings_profile.mac_settings.desired_rx2_frequency.value",
 	"mac_settings_profile.mac_settings.downlink_dwell_time",
 	"mac_settings_profile.mac_settings.downlink_dwell_time.value",
+	"mac_settings_profile.mac_settings.downlink_path_ranking",
 	"mac_settings_profile.mac_settings.factory_preset_frequencies",
 	"mac_settings_profile.mac_settings.max_duty_cycle",
 	"mac_settings_profile.mac_settings.max_duty_cycle.value",
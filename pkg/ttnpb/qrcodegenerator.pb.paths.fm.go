@@ -73,6 +73,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode",
 	"end_device.mac_settings.adr.mode.disabled",
 	"end_device.mac_settings.adr.mode.dynamic",
+	"end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -140,6 +141,8 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_settings.adr.mode.static.nb_trans",
 	"end_device.mac_settings.adr.mode.static.tx_power_index",
 	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.auto_f_cnt_resync",
+	"end_device.mac_settings.auto_f_cnt_resync.value",
 	"end_device.mac_settings.beacon_frequency",
 	"end_device.mac_settings.beacon_frequency.value",
 	"end_device.mac_settings.class_b_c_downlink_interval",
@@ -205,6 +208,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_rx2_frequency.value",
 	"end_device.mac_settings.downlink_dwell_time",
 	"end_device.mac_settings.downlink_dwell_time.value",
+	"end_device.mac_settings.downlink_path_ranking",
 	"end_device.mac_settings.factory_preset_frequencies",
 	"end_device.mac_settings.max_duty_cycle",
 	"end_device.mac_settings.max_duty_cycle.value",
@@ -417,11 +421,13 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
 	"end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device.mac_state.pending_application_downlink.ttl",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
 	"end_device.mac_state.pending_join_request.cf_list.ch_masks",
@@ -633,11 +639,13 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
+	"end_device.pending_mac_state.pending_application_downlink.ttl",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
 	"end_device.pending_mac_state.pending_join_request.cf_list.ch_masks",
@@ -861,6 +869,7 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.mac_settings.adr.mode",
 	"end_device_template.end_device.mac_settings.adr.mode.disabled",
 	"end_device_template.end_device.mac_settings.adr.mode.dynamic",
+	"end_device_template.end_device.mac_settings.adr.mode.dynamic.algorithm",
 	"end_device_template.end_device.mac_settings.adr.mode.dynamic.channel_steering",
 	"end_device_template.end_device.mac_settings.adr.mode.dynamic.channel_steering.mode",
 	"end_device_template.end_device.mac_settings.adr.mode.dynamic.channel_steering.mode.disabled",
@@ -928,6 +937,8 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.mac_settings.adr.mode.static.nb_trans",
 	"end_device_template.end_device.mac_settings.adr.mode.static.tx_power_index",
 	"end_device_template.end_device.mac_settings.adr_margin",
+	"end_device_template.end_device.mac_settings.auto_f_cnt_resync",
+	"end_device_template.end_device.mac_settings.auto_f_cnt_resync.value",
 	"end_device_template.end_device.mac_settings.beacon_frequency",
 	"end_device_template.end_device.mac_settings.beacon_frequency.value",
 	"end_device_template.end_device.mac_settings.class_b_c_downlink_interval",
@@ -993,6 +1004,7 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.mac_settings.desired_rx2_frequency.value",
 	"end_device_template.end_device.mac_settings.downlink_dwell_time",
 	"end_device_template.end_device.mac_settings.downlink_dwell_time.value",
+	"end_device_template.end_device.mac_settings.downlink_path_ranking",
 	"end_device_template.end_device.mac_settings.factory_preset_frequencies",
 	"end_device_template.end_device.mac_settings.max_duty_cycle",
 	"end_device_template.end_device.mac_settings.max_duty_cycle.value",
@@ -1205,11 +1217,13 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device_template.end_device.mac_state.pending_application_downlink.decoded_payload",
 	"end_device_template.end_device.mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device_template.end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device_template.end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device_template.end_device.mac_state.pending_application_downlink.f_port",
 	"end_device_template.end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device_template.end_device.mac_state.pending_application_downlink.priority",
 	"end_device_template.end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device_template.end_device.mac_state.pending_application_downlink.ttl",
 	"end_device_template.end_device.mac_state.pending_join_request",
 	"end_device_template.end_device.mac_state.pending_join_request.cf_list",
 	"end_device_template.end_device.mac_state.pending_join_request.cf_list.ch_masks",
@@ -1421,11 +1435,13 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.decoded_payload",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.decoded_payload_warnings",
+	"end_device_template.end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.priority",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.session_key_id",
+	"end_device_template.end_device.pending_mac_state.pending_application_downlink.ttl",
 	"end_device_template.end_device.pending_mac_state.pending_join_request",
 	"end_device_template.end_device.pending_mac_state.pending_join_request.cf_list",
 	"end_device_template.end_device.pending_mac_state.pending_join_request.cf_list.ch_masks",
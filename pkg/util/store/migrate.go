@@ -0,0 +1,58 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/migrate"
+)
+
+// Migrate applies the migrations which have not been applied to db yet.
+// The applied migrations are recorded in the table with the given name, so that separate sets of migrations
+// can share a database. Concurrent calls with the same table, also by other processes, are serialized with a
+// session-level advisory lock, which is released when the migrations are done or the connection is lost.
+func Migrate(ctx context.Context, db *sql.DB, migrations *migrate.Migrations, table string) (err error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return WrapDriverError(err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtextextended($1, 0))", table); err != nil {
+		return WrapDriverError(err)
+	}
+	defer func() {
+		_, unlockErr := conn.ExecContext(ctx, "SELECT pg_advisory_unlock(hashtextextended($1, 0))", table)
+		if err == nil {
+			err = WrapDriverError(unlockErr)
+		}
+	}()
+
+	migrator := migrate.NewMigrator(
+		bun.NewDB(db, pgdialect.New()),
+		migrations,
+		migrate.WithTableName(table),
+		migrate.WithLocksTableName(table+"_locks"),
+		migrate.WithMarkAppliedOnSuccess(true),
+	)
+	if err := migrator.Init(ctx); err != nil {
+		return WrapDriverError(err)
+	}
+	_, err = migrator.Migrate(ctx)
+	return WrapDriverError(err)
+}
@@ -0,0 +1,60 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Transact executes f in a transaction.
+// The transaction is committed if f returns nil, and rolled back otherwise.
+func Transact(ctx context.Context, db *sql.DB, f func(*sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return WrapDriverError(err)
+	}
+	if err := f(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return WrapDriverError(tx.Commit())
+}
+
+// LockNamespace is the namespace of the advisory locks acquired by LockKey.
+type LockNamespace int32
+
+// Lock namespaces of the end device registries.
+const (
+	NetworkServerDevicesLockNamespace LockNamespace = iota + 1
+	ApplicationServerDevicesLockNamespace
+	JoinServerDevicesLockNamespace
+)
+
+// LockKey acquires an exclusive transaction-level advisory lock on key in namespace ns.
+//
+// The lock is held until the transaction ends, either by commit or by rollback, so it does not need to be
+// released explicitly. Other transactions which lock the same key in the same namespace block until then.
+// The device registries use it to serialize the read-modify-write cycles on an end device, like the Redis
+// registries do with their locks.
+//
+// The lock is identified by ns and the 32-bit hash of key, using the two-key form of pg_advisory_xact_lock.
+// Locks in the two-key form never conflict with locks in the single-key form, such as those acquired by
+// Migrate, and the namespaces keep the locks of the different registries apart. Hash collisions between keys
+// in the same namespace only cause unnecessary waiting.
+func LockKey(ctx context.Context, tx *sql.Tx, ns LockNamespace, key string) error {
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, hashtext($2))", int32(ns), key)
+	return WrapDriverError(err)
+}
@@ -0,0 +1,88 @@
+// Copyright © 2026 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	ulid "github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+const (
+	defaultPostgreSQLAddress  = "localhost:5432"
+	defaultPostgreSQLDatabase = "ttn_lorawan_registry_test"
+)
+
+// NewPostgreSQL returns a new PostgreSQL connection, which uses a new schema unique to the test,
+// and a drop function, which should be called after the connection is not needed anymore to drop the schema.
+// NewPostgreSQL respects TEST_POSTGRES, SQL_DB_ADDRESS, TEST_DATABASE_NAME and SQL_DB_AUTH environment variables.
+func NewPostgreSQL(ctx context.Context) (*sql.DB, func()) {
+	t := MustTBFromContext(ctx)
+	if os.Getenv("TEST_POSTGRES") != "1" {
+		t.Skip("TEST_POSTGRES is not set to `1`, skipping PostgreSQL tests")
+	}
+
+	dsn := url.URL{
+		Scheme: "postgresql",
+		Host:   defaultPostgreSQLAddress,
+		Path:   defaultPostgreSQLDatabase,
+		User:   url.UserPassword("root", "root"),
+	}
+	if addr := os.Getenv("SQL_DB_ADDRESS"); addr != "" {
+		dsn.Host = addr
+	}
+	if name := os.Getenv("TEST_DATABASE_NAME"); name != "" {
+		dsn.Path = name
+	}
+	if auth := os.Getenv("SQL_DB_AUTH"); auth != "" {
+		username, password, _ := strings.Cut(auth, ":")
+		dsn.User = url.UserPassword(username, password)
+	}
+	query := make(url.Values)
+	query.Add("sslmode", "disable")
+	dsn.RawQuery = query.Encode()
+
+	schema := fmt.Sprintf("test_%s", strings.ToLower(ulid.MustNew(ulid.Now(), rand.Reader).String()))
+	adminDB, err := store.OpenDB(ctx, dsn.String())
+	if err != nil {
+		t.Fatalf("Failed to open PostgreSQL connection: `%s`", err)
+	}
+	if _, err := adminDB.ExecContext(ctx, fmt.Sprintf("CREATE SCHEMA %s", schema)); err != nil {
+		adminDB.Close()
+		t.Fatalf("Failed to create PostgreSQL schema: `%s`", err)
+	}
+
+	query.Add("search_path", schema)
+	dsn.RawQuery = query.Encode()
+	db, err := store.OpenDB(ctx, dsn.String())
+	if err != nil {
+		adminDB.Close()
+		t.Fatalf("Failed to open PostgreSQL connection: `%s`", err)
+	}
+	return db, func() {
+		db.Close()
+		if _, err := adminDB.Exec(fmt.Sprintf("DROP SCHEMA %s CASCADE", schema)); err != nil {
+			GetLogger(t).WithError(err).Error("Failed to drop PostgreSQL schema")
+		}
+		adminDB.Close()
+	}
+}